@@ -0,0 +1,167 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/github/github-mcp-server/pkg/ghmcp"
+	"github.com/github/github-mcp-server/pkg/github"
+	"github.com/spf13/viper"
+)
+
+// loadConfigFile reads server options from a YAML or JSON file, chosen by its extension. Keys are the names of the
+// command line flags, e.g. toolsets, denied-tools or content-window-size, plus translations, a map of translation
+// key to text. Flags and GITHUB_ environment variables take precedence over the file.
+func loadConfigFile(path string) error {
+	if path == "" {
+		return nil
+	}
+	viper.SetConfigFile(path)
+	if err := viper.ReadInConfig(); err != nil {
+		return fmt.Errorf("failed to read config file %s: %w", path, err)
+	}
+	return nil
+}
+
+// reloadableConfig reads the options that can change while the server runs.
+func reloadableConfig() (ghmcp.ReloadableConfig, error) {
+	// If you're wondering why we're not using viper.GetStringSlice("toolsets"),
+	// it's because viper doesn't handle comma-separated values correctly for env
+	// vars when using GetStringSlice.
+	// https://github.com/spf13/viper/issues/380
+	var enabledToolsets []string
+	if err := viper.UnmarshalKey("toolsets", &enabledToolsets); err != nil {
+		return ghmcp.ReloadableConfig{}, fmt.Errorf("failed to unmarshal toolsets: %w", err)
+	}
+
+	// Parse tools (similar to toolsets)
+	var enabledTools []string
+	if err := viper.UnmarshalKey("tools", &enabledTools); err != nil {
+		return ghmcp.ReloadableConfig{}, fmt.Errorf("failed to unmarshal tools: %w", err)
+	}
+
+	var deniedTools []string
+	if err := viper.UnmarshalKey("denied-tools", &deniedTools); err != nil {
+		return ghmcp.ReloadableConfig{}, fmt.Errorf("failed to unmarshal denied tools: %w", err)
+	}
+
+	// If neither toolset config nor tools config is passed we enable the default toolset
+	if len(enabledToolsets) == 0 && len(enabledTools) == 0 {
+		enabledToolsets = []string{github.ToolsetMetadataDefault.ID}
+	}
+
+	return ghmcp.ReloadableConfig{
+		EnabledToolsets:      enabledToolsets,
+		EnabledTools:         enabledTools,
+		DeniedTools:          deniedTools,
+		TranslationOverrides: viper.GetStringMapString("translations"),
+		RateLimit:            viper.GetFloat64("rate-limit"),
+		RateLimitBurst:       viper.GetInt("rate-limit-burst"),
+	}, nil
+}
+
+// stringMapOption reads an option that is a map of strings. In a config file it is a map; the environment variable
+// holds it as comma-separated key=value pairs, like the flag.
+func stringMapOption(key string) (map[string]string, error) {
+	value, ok := viper.Get(key).(string)
+	if !ok {
+		return viper.GetStringMapString(key), nil
+	}
+	options := map[string]string{}
+	for _, pair := range strings.Split(value, ",") {
+		if pair = strings.TrimSpace(pair); pair == "" {
+			continue
+		}
+		name, option, ok := strings.Cut(pair, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid %s entry %q: expected key=value", key, pair)
+		}
+		options[strings.TrimSpace(name)] = strings.TrimSpace(option)
+	}
+	return options, nil
+}
+
+// toolTimeouts reads the per-tool timeouts, a map of tool name to duration such as 5m.
+func toolTimeouts() (map[string]time.Duration, error) {
+	raw, err := stringMapOption("tool-timeouts")
+	if err != nil {
+		return nil, err
+	}
+
+	timeouts := make(map[string]time.Duration, len(raw))
+	for name, value := range raw {
+		timeout, err := time.ParseDuration(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid timeout for tool %s: %w", name, err)
+		}
+		timeouts[name] = timeout
+	}
+	return timeouts, nil
+}
+
+// projectDefaultStatuses reads the default status of the items added to each project, a map of owner/number to the
+// name of a Status option.
+func projectDefaultStatuses() (github.ProjectDefaultStatuses, error) {
+	raw, err := stringMapOption("project-default-status")
+	if err != nil {
+		return nil, err
+	}
+	for project := range raw {
+		owner, number, ok := strings.Cut(project, "/")
+		if n, err := strconv.Atoi(number); !ok || owner == "" || err != nil || n <= 0 {
+			return nil, fmt.Errorf("invalid project %q in project-default-status: expected owner/number", project)
+		}
+	}
+	return github.ProjectDefaultStatuses(raw), nil
+}
+
+// projectSLAPolicies reads the SLA policies of each project, a map of owner/number to a list of policies. Policies
+// are structured, so they can only be set in a config file.
+func projectSLAPolicies() (github.ProjectSLAPolicies, error) {
+	raw := viper.Get("project-sla-policies")
+	if raw == nil {
+		return nil, nil
+	}
+	data, err := json.Marshal(raw)
+	if err != nil {
+		return nil, fmt.Errorf("invalid project-sla-policies: %w", err)
+	}
+	var policies github.ProjectSLAPolicies
+	if err := json.Unmarshal(data, &policies); err != nil {
+		return nil, fmt.Errorf("invalid project-sla-policies: %w", err)
+	}
+	for project, projectPolicies := range policies {
+		owner, number, ok := strings.Cut(project, "/")
+		if n, err := strconv.Atoi(number); !ok || owner == "" || err != nil || n <= 0 {
+			return nil, fmt.Errorf("invalid project %q in project-sla-policies: expected owner/number", project)
+		}
+		for i, policy := range projectPolicies {
+			if err := policy.Validate(); err != nil {
+				return nil, fmt.Errorf("invalid policy %d of project %s in project-sla-policies: %w", i+1, project, err)
+			}
+		}
+	}
+	return policies, nil
+}
+
+// watchConfigFile re-reads the config file whenever it changes and sends the reloadable options on the returned
+// channel. Options that cannot change while the server runs, such as the host or read-only mode, keep their
+// values until the server restarts.
+func watchConfigFile() <-chan ghmcp.ReloadableConfig {
+	reloads := make(chan ghmcp.ReloadableConfig, 1)
+	viper.OnConfigChange(func(_ fsnotify.Event) {
+		reload, err := reloadableConfig()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Ignoring config file change: %v\n", err)
+			return
+		}
+		reloads <- reload
+	})
+	viper.WatchConfig()
+	return reloads
+}
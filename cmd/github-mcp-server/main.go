@@ -7,7 +7,7 @@ import (
 	"strings"
 	"time"
 
-	"github.com/github/github-mcp-server/internal/ghmcp"
+	"github.com/github/github-mcp-server/pkg/ghmcp"
 	"github.com/github/github-mcp-server/pkg/github"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
@@ -25,6 +25,9 @@ var (
 		Short:   "GitHub MCP Server",
 		Long:    `A GitHub MCP server that handles various tools and resources.`,
 		Version: fmt.Sprintf("Version: %s\nCommit: %s\nBuild Date: %s", version, commit, date),
+		PersistentPreRunE: func(_ *cobra.Command, _ []string) error {
+			return loadConfigFile(viper.GetString("config"))
+		},
 	}
 
 	stdioCmd = &cobra.Command{
@@ -32,52 +35,101 @@ var (
 		Short: "Start stdio server",
 		Long:  `Start a server that communicates via standard input/output streams using JSON-RPC messages.`,
 		RunE: func(_ *cobra.Command, _ []string) error {
-			token := viper.GetString("personal_access_token")
-			if token == "" {
-				return errors.New("GITHUB_PERSONAL_ACCESS_TOKEN not set")
-			}
-
-			// If you're wondering why we're not using viper.GetStringSlice("toolsets"),
-			// it's because viper doesn't handle comma-separated values correctly for env
-			// vars when using GetStringSlice.
-			// https://github.com/spf13/viper/issues/380
-			var enabledToolsets []string
-			if err := viper.UnmarshalKey("toolsets", &enabledToolsets); err != nil {
-				return fmt.Errorf("failed to unmarshal toolsets: %w", err)
-			}
-
-			// Parse tools (similar to toolsets)
-			var enabledTools []string
-			if err := viper.UnmarshalKey("tools", &enabledTools); err != nil {
-				return fmt.Errorf("failed to unmarshal tools: %w", err)
+			stdioServerConfig, err := serverConfig(true)
+			if err != nil {
+				return err
 			}
+			return ghmcp.RunStdioServer(stdioServerConfig)
+		},
+	}
 
-			// If neither toolset config nor tools config is passed we enable the default toolset
-			if len(enabledToolsets) == 0 && len(enabledTools) == 0 {
-				enabledToolsets = []string{github.ToolsetMetadataDefault.ID}
+	httpCmd = &cobra.Command{
+		Use:   "http",
+		Short: "Start HTTP server",
+		Long:  `Start a server that communicates via the MCP streamable HTTP transport at /mcp. Clients can authenticate with their own GitHub token in the Authorization header.`,
+		RunE: func(_ *cobra.Command, _ []string) error {
+			// Clients can send their own token, so a server-wide token is optional
+			stdioServerConfig, err := serverConfig(false)
+			if err != nil {
+				return err
 			}
-
-			ttl := viper.GetDuration("repo-access-cache-ttl")
-			stdioServerConfig := ghmcp.StdioServerConfig{
-				Version:              version,
-				Host:                 viper.GetString("host"),
-				Token:                token,
-				EnabledToolsets:      enabledToolsets,
-				EnabledTools:         enabledTools,
-				DynamicToolsets:      viper.GetBool("dynamic_toolsets"),
-				ReadOnly:             viper.GetBool("read-only"),
-				ExportTranslations:   viper.GetBool("export-translations"),
-				EnableCommandLogging: viper.GetBool("enable-command-logging"),
-				LogFilePath:          viper.GetString("log-file"),
-				ContentWindowSize:    viper.GetInt("content-window-size"),
-				LockdownMode:         viper.GetBool("lockdown-mode"),
-				RepoAccessCacheTTL:   &ttl,
+			var allowedOrigins []string
+			if err := viper.UnmarshalKey("allowed-origins", &allowedOrigins); err != nil {
+				return fmt.Errorf("failed to unmarshal allowed-origins: %w", err)
 			}
-			return ghmcp.RunStdioServer(stdioServerConfig)
+			return ghmcp.RunHTTPServer(ghmcp.HTTPServerConfig{
+				StdioServerConfig: stdioServerConfig,
+				Address:           viper.GetString("listen"),
+				EnableMetrics:     viper.GetBool("enable-metrics"),
+				AllowedOrigins:    allowedOrigins,
+			})
 		},
 	}
 )
 
+// serverConfig reads the options shared by all transports.
+func serverConfig(requireToken bool) (ghmcp.StdioServerConfig, error) {
+	token := viper.GetString("personal_access_token")
+	if token == "" && requireToken {
+		return ghmcp.StdioServerConfig{}, errors.New("GITHUB_PERSONAL_ACCESS_TOKEN not set")
+	}
+
+	reloadable, err := reloadableConfig()
+	if err != nil {
+		return ghmcp.StdioServerConfig{}, err
+	}
+
+	timeouts, err := toolTimeouts()
+	if err != nil {
+		return ghmcp.StdioServerConfig{}, err
+	}
+
+	defaultStatuses, err := projectDefaultStatuses()
+	if err != nil {
+		return ghmcp.StdioServerConfig{}, err
+	}
+
+	slaPolicies, err := projectSLAPolicies()
+	if err != nil {
+		return ghmcp.StdioServerConfig{}, err
+	}
+
+	ttl := viper.GetDuration("repo-access-cache-ttl")
+	cfg := ghmcp.StdioServerConfig{
+		Version:              version,
+		Host:                 viper.GetString("host"),
+		Token:                token,
+		EnabledToolsets:      reloadable.EnabledToolsets,
+		EnabledTools:         reloadable.EnabledTools,
+		DeniedTools:          reloadable.DeniedTools,
+		DynamicToolsets:      viper.GetBool("dynamic_toolsets"),
+		ReadOnly:             viper.GetBool("read-only"),
+		ExportTranslations:   viper.GetBool("export-translations"),
+		EnableCommandLogging: viper.GetBool("enable-command-logging"),
+		LogFilePath:          viper.GetString("log-file"),
+		ContentWindowSize:    viper.GetInt("content-window-size"),
+		LockdownMode:         viper.GetBool("lockdown-mode"),
+		RepoAccessCacheTTL:   &ttl,
+		ShutdownTimeout:      viper.GetDuration("shutdown-timeout"),
+		RateLimit:            reloadable.RateLimit,
+		RateLimitBurst:       reloadable.RateLimitBurst,
+		TranslationOverrides: reloadable.TranslationOverrides,
+
+		MaxConcurrentCalls:      viper.GetInt("max-concurrent-calls"),
+		MaxConcurrentMutations:  viper.GetInt("max-concurrent-mutations"),
+		ConcurrencyQueueTimeout: viper.GetDuration("concurrency-queue-timeout"),
+		ToolTimeout:             viper.GetDuration("tool-timeout"),
+		ToolTimeouts:            timeouts,
+		RelativeTimes:           viper.GetBool("relative-times"),
+		ProjectDefaultStatuses:  defaultStatuses,
+		ProjectSLAPolicies:      slaPolicies,
+	}
+	if viper.ConfigFileUsed() != "" {
+		cfg.Reloads = watchConfigFile()
+	}
+	return cfg, nil
+}
+
 func init() {
 	cobra.OnInitialize(initConfig)
 	rootCmd.SetGlobalNormalizationFunc(wordSepNormalizeFunc)
@@ -85,8 +137,10 @@ func init() {
 	rootCmd.SetVersionTemplate("{{.Short}}\n{{.Version}}\n")
 
 	// Add global flags that will be shared by all commands
+	rootCmd.PersistentFlags().String("config", "", "Path to a YAML or JSON config file with default values for the other flags")
 	rootCmd.PersistentFlags().StringSlice("toolsets", nil, github.GenerateToolsetsHelp())
 	rootCmd.PersistentFlags().StringSlice("tools", nil, "Comma-separated list of specific tools to enable")
+	rootCmd.PersistentFlags().StringSlice("denied-tools", nil, "Comma-separated list of tools to never enable")
 	rootCmd.PersistentFlags().Bool("dynamic-toolsets", false, "Enable dynamic toolsets")
 	rootCmd.PersistentFlags().Bool("read-only", false, "Restrict the server to read-only operations")
 	rootCmd.PersistentFlags().String("log-file", "", "Path to log file")
@@ -96,10 +150,22 @@ func init() {
 	rootCmd.PersistentFlags().Int("content-window-size", 5000, "Specify the content window size")
 	rootCmd.PersistentFlags().Bool("lockdown-mode", false, "Enable lockdown mode")
 	rootCmd.PersistentFlags().Duration("repo-access-cache-ttl", 5*time.Minute, "Override the repo access cache TTL (e.g. 1m, 0s to disable)")
+	rootCmd.PersistentFlags().Duration("shutdown-timeout", 30*time.Second, "Maximum time to wait for in-flight tool calls when shutting down")
+	rootCmd.PersistentFlags().Int("max-concurrent-calls", 10, "Maximum number of tool calls to run at once (0 for no limit)")
+	rootCmd.PersistentFlags().Int("max-concurrent-mutations", 1, "Maximum number of tool calls that modify GitHub data to run at once (0 for no limit)")
+	rootCmd.PersistentFlags().Duration("concurrency-queue-timeout", 30*time.Second, "Maximum time a tool call waits to run when a concurrency limit is reached")
+	rootCmd.PersistentFlags().Duration("tool-timeout", 0, "Maximum time a tool call may run (0 for no limit)")
+	rootCmd.PersistentFlags().StringToString("tool-timeouts", nil, "Comma-separated tool=duration pairs overriding --tool-timeout for specific tools")
+	rootCmd.PersistentFlags().StringToString("project-default-status", nil, "Comma-separated owner/number=status pairs setting the Status of items added to a project")
+	rootCmd.PersistentFlags().Bool("relative-times", false, "Add human-relative times such as \"3 days ago\" next to the timestamps in tool results")
+	rootCmd.PersistentFlags().Float64("rate-limit", 0, "Limit tool calls to this many per second (0 for no limit)")
+	rootCmd.PersistentFlags().Int("rate-limit-burst", 10, "Number of tool calls allowed at once before the rate limit applies")
 
 	// Bind flag to viper
+	_ = viper.BindPFlag("config", rootCmd.PersistentFlags().Lookup("config"))
 	_ = viper.BindPFlag("toolsets", rootCmd.PersistentFlags().Lookup("toolsets"))
 	_ = viper.BindPFlag("tools", rootCmd.PersistentFlags().Lookup("tools"))
+	_ = viper.BindPFlag("denied-tools", rootCmd.PersistentFlags().Lookup("denied-tools"))
 	_ = viper.BindPFlag("dynamic_toolsets", rootCmd.PersistentFlags().Lookup("dynamic-toolsets"))
 	_ = viper.BindPFlag("read-only", rootCmd.PersistentFlags().Lookup("read-only"))
 	_ = viper.BindPFlag("log-file", rootCmd.PersistentFlags().Lookup("log-file"))
@@ -109,9 +175,27 @@ func init() {
 	_ = viper.BindPFlag("content-window-size", rootCmd.PersistentFlags().Lookup("content-window-size"))
 	_ = viper.BindPFlag("lockdown-mode", rootCmd.PersistentFlags().Lookup("lockdown-mode"))
 	_ = viper.BindPFlag("repo-access-cache-ttl", rootCmd.PersistentFlags().Lookup("repo-access-cache-ttl"))
+	_ = viper.BindPFlag("shutdown-timeout", rootCmd.PersistentFlags().Lookup("shutdown-timeout"))
+	_ = viper.BindPFlag("max-concurrent-calls", rootCmd.PersistentFlags().Lookup("max-concurrent-calls"))
+	_ = viper.BindPFlag("max-concurrent-mutations", rootCmd.PersistentFlags().Lookup("max-concurrent-mutations"))
+	_ = viper.BindPFlag("concurrency-queue-timeout", rootCmd.PersistentFlags().Lookup("concurrency-queue-timeout"))
+	_ = viper.BindPFlag("tool-timeout", rootCmd.PersistentFlags().Lookup("tool-timeout"))
+	_ = viper.BindPFlag("tool-timeouts", rootCmd.PersistentFlags().Lookup("tool-timeouts"))
+	_ = viper.BindPFlag("project-default-status", rootCmd.PersistentFlags().Lookup("project-default-status"))
+	_ = viper.BindPFlag("relative-times", rootCmd.PersistentFlags().Lookup("relative-times"))
+	_ = viper.BindPFlag("rate-limit", rootCmd.PersistentFlags().Lookup("rate-limit"))
+	_ = viper.BindPFlag("rate-limit-burst", rootCmd.PersistentFlags().Lookup("rate-limit-burst"))
+
+	httpCmd.Flags().String("listen", "localhost:8080", "Address to listen on")
+	httpCmd.Flags().Bool("enable-metrics", false, "Serve Prometheus metrics at /metrics")
+	httpCmd.Flags().StringSlice("allowed-origins", nil, "Comma-separated list of browser origins allowed to call the server, in addition to localhost (* for any)")
+	_ = viper.BindPFlag("listen", httpCmd.Flags().Lookup("listen"))
+	_ = viper.BindPFlag("enable-metrics", httpCmd.Flags().Lookup("enable-metrics"))
+	_ = viper.BindPFlag("allowed-origins", httpCmd.Flags().Lookup("allowed-origins"))
 
 	// Add subcommands
 	rootCmd.AddCommand(stdioCmd)
+	rootCmd.AddCommand(httpCmd)
 }
 
 func initConfig() {
@@ -15,7 +15,7 @@ import (
 	"testing"
 	"time"
 
-	"github.com/github/github-mcp-server/internal/ghmcp"
+	"github.com/github/github-mcp-server/pkg/ghmcp"
 	"github.com/github/github-mcp-server/pkg/github"
 	"github.com/github/github-mcp-server/pkg/translations"
 	gogithub "github.com/google/go-github/v79/github"
@@ -367,14 +367,17 @@ func TestTags(t *testing.T) {
 	textContent, ok = resp.Content[0].(mcp.TextContent)
 	require.True(t, ok, "expected content to be of type TextContent")
 
-	var trimmedTags []struct {
-		Name   string `json:"name"`
-		Commit struct {
-			SHA string `json:"sha"`
-		} `json:"commit"`
+	var trimmedTagsResponse struct {
+		Tags []struct {
+			Name   string `json:"name"`
+			Commit struct {
+				SHA string `json:"sha"`
+			} `json:"commit"`
+		} `json:"tags"`
 	}
-	err = json.Unmarshal([]byte(textContent.Text), &trimmedTags)
+	err = json.Unmarshal([]byte(textContent.Text), &trimmedTagsResponse)
 	require.NoError(t, err, "expected to unmarshal text content successfully")
+	trimmedTags := trimmedTagsResponse.Tags
 
 	require.Len(t, trimmedTags, 1, "expected to find one tag")
 	require.Equal(t, "v0.0.1", trimmedTags[0].Name, "expected tag name to match")
@@ -549,18 +552,21 @@ func TestFileDeletion(t *testing.T) {
 	textContent, ok = resp.Content[0].(mcp.TextContent)
 	require.True(t, ok, "expected content to be of type TextContent")
 
-	var trimmedListCommitsText []struct {
-		SHA    string `json:"sha"`
-		Commit struct {
-			Message string `json:"message"`
-		}
-		Files []struct {
-			Filename  string `json:"filename"`
-			Deletions int    `json:"deletions"`
-		}
-	}
-	err = json.Unmarshal([]byte(textContent.Text), &trimmedListCommitsText)
+	var trimmedListCommitsTextResponse struct {
+		Commits []struct {
+			SHA    string `json:"sha"`
+			Commit struct {
+				Message string `json:"message"`
+			}
+			Files []struct {
+				Filename  string `json:"filename"`
+				Deletions int    `json:"deletions"`
+			}
+		} `json:"commits"`
+	}
+	err = json.Unmarshal([]byte(textContent.Text), &trimmedListCommitsTextResponse)
 	require.NoError(t, err, "expected to unmarshal text content successfully")
+	trimmedListCommitsText := trimmedListCommitsTextResponse.Commits
 	require.GreaterOrEqual(t, len(trimmedListCommitsText), 1, "expected to find at least one commit")
 
 	deletionCommit := trimmedListCommitsText[0]
@@ -741,18 +747,21 @@ func TestDirectoryDeletion(t *testing.T) {
 	textContent, ok = resp.Content[0].(mcp.TextContent)
 	require.True(t, ok, "expected content to be of type TextContent")
 
-	var trimmedListCommitsText []struct {
-		SHA    string `json:"sha"`
-		Commit struct {
-			Message string `json:"message"`
-		}
-		Files []struct {
-			Filename  string `json:"filename"`
-			Deletions int    `json:"deletions"`
-		} `json:"files"`
-	}
-	err = json.Unmarshal([]byte(textContent.Text), &trimmedListCommitsText)
+	var trimmedListCommitsTextResponse struct {
+		Commits []struct {
+			SHA    string `json:"sha"`
+			Commit struct {
+				Message string `json:"message"`
+			}
+			Files []struct {
+				Filename  string `json:"filename"`
+				Deletions int    `json:"deletions"`
+			} `json:"files"`
+		} `json:"commits"`
+	}
+	err = json.Unmarshal([]byte(textContent.Text), &trimmedListCommitsTextResponse)
 	require.NoError(t, err, "expected to unmarshal text content successfully")
+	trimmedListCommitsText := trimmedListCommitsTextResponse.Commits
 	require.GreaterOrEqual(t, len(trimmedListCommitsText), 1, "expected to find at least one commit")
 
 	deletionCommit := trimmedListCommitsText[0]
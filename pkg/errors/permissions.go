@@ -0,0 +1,194 @@
+package errors
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+)
+
+// fineGrainedPermissionNames maps the permission names GitHub uses in the X-Accepted-GitHub-Permissions header to
+// the names shown in the fine-grained token settings.
+var fineGrainedPermissionNames = map[string]string{
+	"actions":                "Actions",
+	"administration":         "Administration",
+	"checks":                 "Checks",
+	"codespaces":             "Codespaces",
+	"contents":               "Contents",
+	"deployments":            "Deployments",
+	"discussions":            "Discussions",
+	"environments":           "Environments",
+	"gists":                  "Gists",
+	"issues":                 "Issues",
+	"members":                "Members",
+	"metadata":               "Metadata",
+	"organization_projects":  "Projects",
+	"packages":               "Packages",
+	"pages":                  "Pages",
+	"pull_requests":          "Pull requests",
+	"repository_advisories":  "Repository security advisories",
+	"repository_projects":    "Projects",
+	"secret_scanning_alerts": "Secret scanning alerts",
+	"secrets":                "Secrets",
+	"security_events":        "Code scanning alerts",
+	"starring":               "Starring",
+	"statuses":               "Commit statuses",
+	"vulnerability_alerts":   "Dependabot alerts",
+	"workflows":              "Workflows",
+}
+
+// formatFineGrainedPermission formats a permission such as issues=write the way the token settings show it, e.g.
+// "Issues: read and write".
+func formatFineGrainedPermission(name, access string) string {
+	display, ok := fineGrainedPermissionNames[name]
+	if !ok {
+		display = strings.ReplaceAll(name, "_", " ")
+		if display != "" {
+			display = strings.ToUpper(display[:1]) + display[1:]
+		}
+	}
+	switch access {
+	case "write", "admin":
+		return display + ": read and write"
+	default:
+		return display + ": read-only"
+	}
+}
+
+// acceptedGitHubPermissions parses the X-Accepted-GitHub-Permissions header, which lists the fine-grained
+// permissions an endpoint accepts, e.g. "issues=write,pull_requests=write; contents=read". Sets of permissions that
+// are each sufficient on their own are separated by semicolons.
+func acceptedGitHubPermissions(header string) [][]string {
+	var sets [][]string
+	for _, set := range strings.Split(header, ";") {
+		var perms []string
+		for _, perm := range strings.Split(set, ",") {
+			name, access, ok := strings.Cut(strings.TrimSpace(perm), "=")
+			if ok && name != "" {
+				perms = append(perms, formatFineGrainedPermission(name, access))
+			}
+		}
+		if len(perms) > 0 {
+			sets = append(sets, perms)
+		}
+	}
+	return sets
+}
+
+func splitScopes(header string) []string {
+	var scopes []string
+	for _, scope := range strings.Split(header, ",") {
+		if scope = strings.TrimSpace(scope); scope != "" {
+			scopes = append(scopes, scope)
+		}
+	}
+	return scopes
+}
+
+// restPermissionHint names the fine-grained permissions or classic scopes a failed REST call required, using the
+// headers GitHub adds to the response. GitHub only reports the scopes of classic tokens, so the presence of the
+// X-OAuth-Scopes header tells the two kinds of token apart.
+func restPermissionHint(header http.Header) ([]string, string) {
+	if _, classic := header[http.CanonicalHeaderKey("X-OAuth-Scopes")]; classic {
+		scopes := splitScopes(header.Get("X-Accepted-OAuth-Scopes"))
+		if len(scopes) == 0 {
+			return nil, ""
+		}
+		return scopes, fmt.Sprintf("The token is a classic personal access token without the scope this operation needs. Add one of these scopes to the token: %s.",
+			strings.Join(scopes, ", "))
+	}
+
+	sets := acceptedGitHubPermissions(header.Get("X-Accepted-GitHub-Permissions"))
+	if len(sets) == 0 {
+		return nil, ""
+	}
+	alternatives := make([]string, len(sets))
+	for i, set := range sets {
+		alternatives[i] = strings.Join(set, " and ")
+	}
+	return sets[0], fmt.Sprintf("The token is a fine-grained token without the repository or organization permission this operation needs. Grant it %s, and access to the resource's repository or organization.",
+		strings.Join(alternatives, ", or "))
+}
+
+// graphQLScopesPattern matches the scopes GitHub names when a classic token is missing one, e.g. "The 'projectsV2'
+// field requires one of the following scopes: ['read:project']".
+var graphQLScopesPattern = regexp.MustCompile(`requires one of the following scopes: \[([^\]]*)\]`)
+
+// graphQLFineGrainedPattern matches the errors GitHub returns when a fine-grained token or GitHub App lacks a
+// permission. They do not name the permission.
+var graphQLFineGrainedPattern = regexp.MustCompile(`(?i)resource not accessible by (personal access token|integration)`)
+
+// graphQLPermissionResources maps the resources named in GraphQL tool error messages to the fine-grained permission
+// covering them, most specific first.
+var graphQLPermissionResources = []struct {
+	pattern    *regexp.Regexp
+	permission string
+}{
+	{regexp.MustCompile(`(?i)project`), "organization_projects"},
+	{regexp.MustCompile(`(?i)discussion`), "discussions"},
+	{regexp.MustCompile(`(?i)pull request|pull_request|pullrequest|review`), "pull_requests"},
+	{regexp.MustCompile(`(?i)issue|label|milestone|sub-issue`), "issues"},
+	{regexp.MustCompile(`(?i)team|member`), "members"},
+	{regexp.MustCompile(`(?i)commit|file|tree|branch|ref\b`), "contents"},
+}
+
+// graphQLWritePattern matches tool error messages of failed mutations, such as "failed to add project item".
+var graphQLWritePattern = regexp.MustCompile(`(?i)\b(add|create|update|delete|remove|set|close|reopen|merge|mark|resolve|unresolve|lock|unlock|pin|unpin|move|convert|transfer|submit|request|enable|disable)\b`)
+
+// graphQLPermissionHint names the permission a failed GraphQL call required. Classic token errors name the missing
+// scopes; fine-grained token errors do not name the permission, so it is inferred from the resource and the action
+// described by the tool's error message.
+func graphQLPermissionHint(message string, err error) ([]string, string) {
+	text := err.Error()
+	if m := graphQLScopesPattern.FindStringSubmatch(text); m != nil {
+		scopes := splitScopes(strings.NewReplacer(`'`, "", `"`, "").Replace(m[1]))
+		if len(scopes) > 0 {
+			return scopes, fmt.Sprintf("The token is a classic personal access token without the scope this operation needs. Add one of these scopes to the token: %s.",
+				strings.Join(scopes, ", "))
+		}
+	}
+	if !graphQLFineGrainedPattern.MatchString(text) {
+		return nil, ""
+	}
+
+	for _, resource := range graphQLPermissionResources {
+		if !resource.pattern.MatchString(message) {
+			continue
+		}
+		access := "read"
+		if graphQLWritePattern.MatchString(message) {
+			access = "write"
+		}
+		permission := formatFineGrainedPermission(resource.permission, access)
+		hint := fmt.Sprintf("The token is a fine-grained token without the permission this operation needs. Grant it %s, and access to the resource's repository or organization.", permission)
+		if resource.permission == "organization_projects" {
+			hint += " Fine-grained tokens cannot access projects owned by a user; use a classic token with the project scope for those."
+		}
+		return []string{permission}, hint
+	}
+	return nil, "The token is a fine-grained token without the permission this operation needs. Check the token's repository and organization permissions, and that it has access to the resource's repository or organization."
+}
+
+// permissionHint names the permission that the most recent GitHub error recorded in ctx required, if GitHub
+// reported it or it can be inferred.
+func permissionHint(ctx context.Context) ([]string, string) {
+	if ctx == nil {
+		return nil, ""
+	}
+	if apiErrors, err := GetGitHubAPIErrors(ctx); err == nil && len(apiErrors) > 0 {
+		last := apiErrors[len(apiErrors)-1]
+		if last.Response == nil || last.Response.Response == nil {
+			return nil, ""
+		}
+		return restPermissionHint(last.Response.Header)
+	}
+	if gqlErrors, err := GetGitHubGraphQLErrors(ctx); err == nil && len(gqlErrors) > 0 {
+		last := gqlErrors[len(gqlErrors)-1]
+		if last.Err == nil {
+			return nil, ""
+		}
+		return graphQLPermissionHint(last.Message, last.Err)
+	}
+	return nil, ""
+}
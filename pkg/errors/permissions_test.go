@@ -0,0 +1,95 @@
+package errors
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPermissionHint(t *testing.T) {
+	t.Run("fine-grained token over REST", func(t *testing.T) {
+		ctx := ContextWithGitHubErrors(context.Background())
+		header := http.Header{}
+		header.Set("X-Accepted-GitHub-Permissions", "issues=write; pull_requests=write,contents=read")
+		_ = NewGitHubAPIErrorResponse(ctx, "failed to create issue", responseWithStatus(http.StatusForbidden, header), fmt.Errorf("403 Resource not accessible by personal access token"))
+
+		toolErr := ToolErrorFromResult(ctx, "failed to create issue: 403 Resource not accessible by personal access token", nil)
+		assert.Equal(t, CodePermissionDenied, toolErr.Code)
+		assert.Equal(t, []string{"Issues: read and write"}, toolErr.RequiredPermissions)
+		assert.Contains(t, toolErr.Hint, "Grant it Issues: read and write, or Pull requests: read and write and Contents: read-only")
+		assert.NotContains(t, toolErr.Hint, "scope")
+	})
+
+	t.Run("classic token over REST", func(t *testing.T) {
+		ctx := ContextWithGitHubErrors(context.Background())
+		header := http.Header{}
+		header.Set("X-OAuth-Scopes", "public_repo")
+		header.Set("X-Accepted-OAuth-Scopes", "repo, workflow")
+		_ = NewGitHubAPIErrorResponse(ctx, "failed to run workflow", responseWithStatus(http.StatusForbidden, header), fmt.Errorf("403 Forbidden"))
+
+		toolErr := ToolErrorFromResult(ctx, "failed to run workflow: 403 Forbidden", nil)
+		assert.Equal(t, []string{"repo", "workflow"}, toolErr.RequiredPermissions)
+		assert.Contains(t, toolErr.Hint, "Add one of these scopes to the token: repo, workflow.")
+	})
+
+	t.Run("REST error without permission headers keeps the default hint", func(t *testing.T) {
+		ctx := ContextWithGitHubErrors(context.Background())
+		_ = NewGitHubAPIErrorResponse(ctx, "failed to get repo", responseWithStatus(http.StatusUnauthorized, nil), fmt.Errorf("401 Bad credentials"))
+
+		toolErr := ToolErrorFromResult(ctx, "failed to get repo: 401 Bad credentials", nil)
+		assert.Empty(t, toolErr.RequiredPermissions)
+		assert.Equal(t, defaultHint(CodePermissionDenied, ""), toolErr.Hint)
+	})
+
+	t.Run("fine-grained token over GraphQL", func(t *testing.T) {
+		tests := []struct {
+			message  string
+			expected string
+		}{
+			{"failed to add project item", "Projects: read and write"},
+			{"failed to list project fields", "Projects: read-only"},
+			{"failed to get discussion", "Discussions: read-only"},
+			{"failed to update pull request", "Pull requests: read and write"},
+			{"failed to add sub-issue", "Issues: read and write"},
+			{"failed to get team members", "Members: read-only"},
+		}
+		for _, tc := range tests {
+			ctx := ContextWithGitHubErrors(context.Background())
+			_ = NewGitHubGraphQLErrorResponse(ctx, tc.message, fmt.Errorf("Resource not accessible by personal access token"))
+
+			toolErr := ToolErrorFromResult(ctx, tc.message+": Resource not accessible by personal access token", nil)
+			assert.Equal(t, CodePermissionDenied, toolErr.Code, tc.message)
+			assert.Equal(t, []string{tc.expected}, toolErr.RequiredPermissions, tc.message)
+			assert.Contains(t, toolErr.Hint, "Grant it "+tc.expected, tc.message)
+		}
+	})
+
+	t.Run("classic token over GraphQL", func(t *testing.T) {
+		ctx := ContextWithGitHubErrors(context.Background())
+		gqlErr := fmt.Errorf("Your token has not been granted the required scopes to execute this query. The 'projectsV2' field requires one of the following scopes: ['read:project'], but your token has only been granted the: ['repo'] scopes.")
+		_ = NewGitHubGraphQLErrorResponse(ctx, "failed to list projects", gqlErr)
+
+		toolErr := ToolErrorFromResult(ctx, "failed to list projects: "+gqlErr.Error(), nil)
+		assert.Equal(t, CodePermissionDenied, toolErr.Code)
+		assert.Equal(t, []string{"read:project"}, toolErr.RequiredPermissions)
+		assert.Contains(t, toolErr.Hint, "read:project")
+	})
+
+	t.Run("GraphQL error for an unknown resource", func(t *testing.T) {
+		ctx := ContextWithGitHubErrors(context.Background())
+		_ = NewGitHubGraphQLErrorResponse(ctx, "failed to run query", fmt.Errorf("Resource not accessible by integration"))
+
+		toolErr := ToolErrorFromResult(ctx, "failed to run query: Resource not accessible by integration", nil)
+		assert.Empty(t, toolErr.RequiredPermissions)
+		assert.Contains(t, toolErr.Hint, "fine-grained token")
+	})
+}
+
+func TestFormatFineGrainedPermission(t *testing.T) {
+	assert.Equal(t, "Dependabot alerts: read-only", formatFineGrainedPermission("vulnerability_alerts", "read"))
+	assert.Equal(t, "Administration: read and write", formatFineGrainedPermission("administration", "admin"))
+	assert.Equal(t, "Custom properties: read-only", formatFineGrainedPermission("custom_properties", "read"))
+}
@@ -0,0 +1,273 @@
+package errors
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/google/go-github/v79/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// ErrorCode classifies a tool error so that clients can branch on it instead of parsing the message.
+type ErrorCode string
+
+const (
+	// CodeNotFound means the requested resource does not exist or is not visible to the token.
+	CodeNotFound ErrorCode = "NOT_FOUND"
+	// CodePermissionDenied means the token is missing, invalid or lacks the permissions the operation needs.
+	CodePermissionDenied ErrorCode = "PERMISSION_DENIED"
+	// CodeRateLimited means a primary or secondary GitHub rate limit was hit.
+	CodeRateLimited ErrorCode = "RATE_LIMITED"
+	// CodeInvalidInput means the tool arguments, or the request GitHub received, were rejected as invalid.
+	CodeInvalidInput ErrorCode = "INVALID_INPUT"
+	// CodeTimeout means the tool call did not complete within its timeout.
+	CodeTimeout ErrorCode = "TIMEOUT"
+	// CodeConflict means the resource changed since the caller read it, so an edit based on that read was refused.
+	CodeConflict ErrorCode = "CONFLICT"
+	// CodeUnavailable means the server did not run the tool call, for example because it is shutting down.
+	CodeUnavailable ErrorCode = "UNAVAILABLE"
+	// CodeUpstreamError means GitHub failed to complete the request for any other reason.
+	CodeUpstreamError ErrorCode = "UPSTREAM_ERROR"
+)
+
+// ToolError is the structured form of a tool error result.
+type ToolError struct {
+	Code    ErrorCode `json:"code"`
+	Message string    `json:"message"`
+	Param   string    `json:"param,omitempty"`
+	Hint    string    `json:"hint,omitempty"`
+	// RequiredPermissions names the classic scopes or fine-grained permissions a PERMISSION_DENIED error needed,
+	// when GitHub reported them or they could be inferred.
+	RequiredPermissions []string `json:"required_permissions,omitempty"`
+	// Current is the current state of the resource of a CONFLICT error, so that the caller can redo its edit on top
+	// of it without reading it again.
+	Current any `json:"current,omitempty"`
+}
+
+// NewToolError creates a ToolError with the default remediation hint for its code.
+func NewToolError(code ErrorCode, message, param string) *ToolError {
+	return &ToolError{
+		Code:    code,
+		Message: message,
+		Param:   param,
+		Hint:    defaultHint(code, param),
+	}
+}
+
+func (e *ToolError) Error() string {
+	return e.Message
+}
+
+// Result returns the error as an mcp tool error result with a JSON body.
+func (e *ToolError) Result() *mcp.CallToolResult {
+	body, err := json.Marshal(e)
+	if err != nil {
+		return mcp.NewToolResultError(e.Message)
+	}
+	return mcp.NewToolResultError(string(body))
+}
+
+func defaultHint(code ErrorCode, param string) string {
+	switch code {
+	case CodeNotFound:
+		return "Check that the owner, repository and identifiers are correct. GitHub also reports private resources the token cannot access as not found."
+	case CodePermissionDenied:
+		return "The token lacks the permission this operation needs. Use a token with the required scopes or repository permissions."
+	case CodeRateLimited:
+		return "Wait for the rate limit to reset before retrying, and reduce the number of requests, for example by using larger pages."
+	case CodeInvalidInput:
+		if param != "" {
+			return fmt.Sprintf("Correct the %q parameter and call the tool again.", param)
+		}
+		return "Check the arguments against the tool's input schema and call the tool again."
+	case CodeConflict:
+		return "The resource was changed by someone else since it was read. Review its current state, redo the change on top of it if it still applies, and pass the new updated_at as expected_updated_at."
+	case CodeTimeout:
+		return "Retry the call, and narrow it down if it keeps timing out, for example by requesting fewer items per page."
+	case CodeUnavailable:
+		return "The server is not accepting tool calls right now. Retry the call shortly, once the server is available again."
+	default:
+		return "GitHub could not complete the request. Retry later, and check https://www.githubstatus.com if the problem persists."
+	}
+}
+
+// ClassifyAPIError returns the error code of a failed REST API call.
+func ClassifyAPIError(resp *github.Response, err error) ErrorCode {
+	var rateLimitErr *github.RateLimitError
+	var abuseErr *github.AbuseRateLimitError
+	if errors.As(err, &rateLimitErr) || errors.As(err, &abuseErr) {
+		return CodeRateLimited
+	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		return CodeTimeout
+	}
+	if resp == nil || resp.Response == nil {
+		return CodeUpstreamError
+	}
+	switch resp.StatusCode {
+	case http.StatusNotFound, http.StatusGone:
+		return CodeNotFound
+	case http.StatusTooManyRequests:
+		return CodeRateLimited
+	case http.StatusUnauthorized, http.StatusForbidden:
+		if resp.Header.Get("X-RateLimit-Remaining") == "0" {
+			return CodeRateLimited
+		}
+		return CodePermissionDenied
+	case http.StatusBadRequest, http.StatusUnprocessableEntity, http.StatusConflict:
+		return CodeInvalidInput
+	default:
+		return CodeUpstreamError
+	}
+}
+
+// ClassifyGraphQLError returns the error code of a failed GraphQL query or mutation. The GraphQL client only
+// exposes the error messages, so the code is derived from the wording GitHub uses for each kind of error.
+func ClassifyGraphQLError(err error) ErrorCode {
+	if err == nil {
+		return CodeUpstreamError
+	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		return CodeTimeout
+	}
+	if code, ok := classifyMessage(err.Error()); ok {
+		return code
+	}
+	return CodeUpstreamError
+}
+
+var messagePatterns = []struct {
+	code    ErrorCode
+	pattern *regexp.Regexp
+}{
+	{CodeRateLimited, regexp.MustCompile(`(?i)rate limit|secondary rate|abuse detection`)},
+	{CodeTimeout, regexp.MustCompile(`(?i)context deadline exceeded|timed out`)},
+	// Only GitHub's wording counts as not found: a 404 is reported as "404 Not Found", and GraphQL as "Could not
+	// resolve to". The tools' own "... not found" messages are about their input.
+	{CodeNotFound, regexp.MustCompile(`(?i:could not resolve to)|\bNot Found\b|\b404\b`)},
+	{CodePermissionDenied, regexp.MustCompile(`(?i)resource not accessible|must have (admin|push|write)|does not have permission|permission denied|forbidden|bad credentials|requires authentication|required scopes|\b40[13]\b`)},
+}
+
+func classifyMessage(message string) (ErrorCode, bool) {
+	for _, p := range messagePatterns {
+		if p.pattern.MatchString(message) {
+			return p.code, true
+		}
+	}
+	return "", false
+}
+
+// paramPattern matches the parameter named by the errors of the parameter helpers, such as
+// "missing required parameter: owner" and "parameter page is not of type float64".
+var paramPattern = regexp.MustCompile(`\bparameter:? ([A-Za-z_][A-Za-z0-9_.]*)`)
+
+// ToolErrorFromResult converts the text of a tool error result into a ToolError. GitHub errors recorded in ctx
+// during the call decide the code; otherwise it is inferred from the message. args are the tool arguments, used
+// to name the offending parameter of an input error.
+func ToolErrorFromResult(ctx context.Context, message string, args map[string]any) *ToolError {
+	code, recorded := classifyRecordedErrors(ctx)
+	if !recorded {
+		var ok bool
+		if code, ok = classifyMessage(message); !ok {
+			code = CodeInvalidInput
+			if strings.HasPrefix(message, "failed to") {
+				code = CodeUpstreamError
+			}
+		}
+	}
+
+	var param string
+	if code == CodeInvalidInput {
+		param = offendingParam(message, args)
+	}
+	toolErr := NewToolError(code, message, param)
+	if code == CodePermissionDenied && recorded {
+		if permissions, hint := permissionHint(ctx); hint != "" {
+			toolErr.RequiredPermissions = permissions
+			toolErr.Hint = hint
+		}
+	}
+	return toolErr
+}
+
+// classifyRecordedErrors classifies the most recent GitHub error recorded in ctx, if any.
+func classifyRecordedErrors(ctx context.Context) (ErrorCode, bool) {
+	if ctx == nil {
+		return "", false
+	}
+	if apiErrors, err := GetGitHubAPIErrors(ctx); err == nil && len(apiErrors) > 0 {
+		last := apiErrors[len(apiErrors)-1]
+		return ClassifyAPIError(last.Response, last.Err), true
+	}
+	if gqlErrors, err := GetGitHubGraphQLErrors(ctx); err == nil && len(gqlErrors) > 0 {
+		return ClassifyGraphQLError(gqlErrors[len(gqlErrors)-1].Err), true
+	}
+	return "", false
+}
+
+// offendingParam returns the parameter an input error refers to: the one named by a parameter helper error, or
+// else the first tool argument mentioned in the message.
+func offendingParam(message string, args map[string]any) string {
+	if m := paramPattern.FindStringSubmatch(message); m != nil {
+		return m[1]
+	}
+	var found string
+	foundAt := -1
+	for name := range args {
+		at := indexWord(message, name)
+		if at != -1 && (foundAt == -1 || at < foundAt || (at == foundAt && len(name) > len(found))) {
+			found, foundAt = name, at
+		}
+	}
+	return found
+}
+
+// indexWord returns the index of the first occurrence of word in s that is not part of a longer word, or -1.
+func indexWord(s, word string) int {
+	if word == "" {
+		return -1
+	}
+	for offset := 0; offset < len(s); {
+		i := strings.Index(s[offset:], word)
+		if i == -1 {
+			return -1
+		}
+		start, end := offset+i, offset+i+len(word)
+		if (start == 0 || !isWordByte(s[start-1])) && (end == len(s) || !isWordByte(s[end])) {
+			return start
+		}
+		offset = start + 1
+	}
+	return -1
+}
+
+func isWordByte(b byte) bool {
+	return b == '_' || '0' <= b && b <= '9' || 'a' <= b && b <= 'z' || 'A' <= b && b <= 'Z'
+}
+
+// StructuredToolErrors is a tool handler middleware that rewrites the text of every tool error result as a JSON
+// ToolError, so that clients get a machine-readable code, the offending parameter and a remediation hint.
+func StructuredToolErrors(next server.ToolHandlerFunc) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		result, err := next(ctx, request)
+		if err != nil || result == nil || !result.IsError || len(result.Content) != 1 {
+			return result, err
+		}
+		text, ok := result.Content[0].(mcp.TextContent)
+		if !ok {
+			return result, nil
+		}
+		// Results that are already JSON objects, such as ToolErrors and bulk results, are structured already.
+		var existing map[string]any
+		if json.Unmarshal([]byte(text.Text), &existing) == nil {
+			return result, nil
+		}
+		return ToolErrorFromResult(ctx, text.Text, request.GetArguments()).Result(), nil
+	}
+}
@@ -0,0 +1,188 @@
+package errors
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-github/v79/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func responseWithStatus(status int, header http.Header) *github.Response {
+	if header == nil {
+		header = http.Header{}
+	}
+	return &github.Response{Response: &http.Response{StatusCode: status, Header: header}}
+}
+
+func TestClassifyAPIError(t *testing.T) {
+	tests := []struct {
+		name     string
+		resp     *github.Response
+		err      error
+		expected ErrorCode
+	}{
+		{
+			name:     "not found",
+			resp:     responseWithStatus(http.StatusNotFound, nil),
+			err:      fmt.Errorf("404 Not Found"),
+			expected: CodeNotFound,
+		},
+		{
+			name:     "forbidden",
+			resp:     responseWithStatus(http.StatusForbidden, nil),
+			err:      fmt.Errorf("403 Resource not accessible by integration"),
+			expected: CodePermissionDenied,
+		},
+		{
+			name:     "unauthorized",
+			resp:     responseWithStatus(http.StatusUnauthorized, nil),
+			err:      fmt.Errorf("401 Bad credentials"),
+			expected: CodePermissionDenied,
+		},
+		{
+			name:     "forbidden with exhausted rate limit",
+			resp:     responseWithStatus(http.StatusForbidden, http.Header{"X-Ratelimit-Remaining": []string{"0"}}),
+			err:      fmt.Errorf("403 API rate limit exceeded"),
+			expected: CodeRateLimited,
+		},
+		{
+			name:     "rate limit error",
+			resp:     nil,
+			err:      &github.RateLimitError{Message: "API rate limit exceeded"},
+			expected: CodeRateLimited,
+		},
+		{
+			name:     "validation failed",
+			resp:     responseWithStatus(http.StatusUnprocessableEntity, nil),
+			err:      fmt.Errorf("422 Validation Failed"),
+			expected: CodeInvalidInput,
+		},
+		{
+			name:     "server error",
+			resp:     responseWithStatus(http.StatusBadGateway, nil),
+			err:      fmt.Errorf("502 Bad Gateway"),
+			expected: CodeUpstreamError,
+		},
+		{
+			name:     "no response",
+			resp:     nil,
+			err:      fmt.Errorf("connection reset"),
+			expected: CodeUpstreamError,
+		},
+		{
+			name:     "deadline exceeded",
+			resp:     nil,
+			err:      fmt.Errorf("Get \"https://api.github.com/repos/o/r\": %w", context.DeadlineExceeded),
+			expected: CodeTimeout,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, ClassifyAPIError(tc.resp, tc.err))
+		})
+	}
+}
+
+func TestClassifyGraphQLError(t *testing.T) {
+	assert.Equal(t, CodeNotFound, ClassifyGraphQLError(fmt.Errorf("Could not resolve to a Repository with the name 'owner/missing'.")))
+	assert.Equal(t, CodePermissionDenied, ClassifyGraphQLError(fmt.Errorf("Resource not accessible by integration")))
+	assert.Equal(t, CodeRateLimited, ClassifyGraphQLError(fmt.Errorf("API rate limit exceeded for user ID 1.")))
+	assert.Equal(t, CodeUpstreamError, ClassifyGraphQLError(fmt.Errorf("Something went wrong while executing your query.")))
+	assert.Equal(t, CodeTimeout, ClassifyGraphQLError(fmt.Errorf("Post \"https://api.github.com/graphql\": %w", context.DeadlineExceeded)))
+}
+
+func TestToolErrorFromResult(t *testing.T) {
+	t.Run("recorded API error decides the code", func(t *testing.T) {
+		ctx := ContextWithGitHubErrors(context.Background())
+		_ = NewGitHubAPIErrorResponse(ctx, "failed to get issue", responseWithStatus(http.StatusNotFound, nil), fmt.Errorf("404 Not Found"))
+
+		toolErr := ToolErrorFromResult(ctx, "failed to get issue: 404 Not Found", map[string]any{"owner": "owner"})
+		assert.Equal(t, CodeNotFound, toolErr.Code)
+		assert.Equal(t, "failed to get issue: 404 Not Found", toolErr.Message)
+		assert.Empty(t, toolErr.Param)
+		assert.NotEmpty(t, toolErr.Hint)
+	})
+
+	t.Run("recorded GraphQL error decides the code", func(t *testing.T) {
+		ctx := ContextWithGitHubErrors(context.Background())
+		_ = NewGitHubGraphQLErrorResponse(ctx, "failed to update issue", fmt.Errorf("Resource not accessible by integration"))
+
+		toolErr := ToolErrorFromResult(ctx, "failed to update issue: Resource not accessible by integration", nil)
+		assert.Equal(t, CodePermissionDenied, toolErr.Code)
+	})
+
+	t.Run("parameter helper error names the parameter", func(t *testing.T) {
+		toolErr := ToolErrorFromResult(context.Background(), "missing required parameter: repo", map[string]any{"owner": "owner"})
+		assert.Equal(t, CodeInvalidInput, toolErr.Code)
+		assert.Equal(t, "repo", toolErr.Param)
+		assert.Equal(t, `Correct the "repo" parameter and call the tool again.`, toolErr.Hint)
+	})
+
+	t.Run("validation error mentioning an argument", func(t *testing.T) {
+		toolErr := ToolErrorFromResult(context.Background(), "invalid since timestamp: unrecognized format", map[string]any{"owner": "owner", "since": "yesterday"})
+		assert.Equal(t, CodeInvalidInput, toolErr.Code)
+		assert.Equal(t, "since", toolErr.Param)
+	})
+
+	t.Run("tool's own not found message is an input error", func(t *testing.T) {
+		toolErr := ToolErrorFromResult(context.Background(), `option "Done" not found in field Status`, map[string]any{"option": "Done", "field_id": "1"})
+		assert.Equal(t, CodeInvalidInput, toolErr.Code)
+		assert.Equal(t, "option", toolErr.Param)
+	})
+
+	t.Run("unrecorded GitHub not found", func(t *testing.T) {
+		toolErr := ToolErrorFromResult(context.Background(), "failed to get file: 404 Not Found", nil)
+		assert.Equal(t, CodeNotFound, toolErr.Code)
+	})
+
+	t.Run("argument mentioned only inside a longer word", func(t *testing.T) {
+		toolErr := ToolErrorFromResult(context.Background(), "invalid repository_id: must be numeric", map[string]any{"repo": "app", "repository_id": "x"})
+		assert.Equal(t, "repository_id", toolErr.Param)
+	})
+
+	t.Run("unrecorded failure", func(t *testing.T) {
+		toolErr := ToolErrorFromResult(context.Background(), "failed to list alerts: unexpected body", nil)
+		assert.Equal(t, CodeUpstreamError, toolErr.Code)
+		assert.Empty(t, toolErr.Param)
+	})
+}
+
+func TestStructuredToolErrors(t *testing.T) {
+	call := func(result *mcp.CallToolResult) *mcp.CallToolResult {
+		handler := StructuredToolErrors(func(_ context.Context, _ mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return result, nil
+		})
+		request := mcp.CallToolRequest{}
+		request.Params.Arguments = map[string]any{"owner": "owner"}
+		out, err := handler(context.Background(), request)
+		require.NoError(t, err)
+		return out
+	}
+
+	t.Run("successful results are unchanged", func(t *testing.T) {
+		result := mcp.NewToolResultText(`{"ok":true}`)
+		assert.Same(t, result, call(result))
+	})
+
+	t.Run("error results become JSON", func(t *testing.T) {
+		out := call(mcp.NewToolResultError("missing required parameter: owner"))
+		require.True(t, out.IsError)
+		var toolErr ToolError
+		require.NoError(t, json.Unmarshal([]byte(out.Content[0].(mcp.TextContent).Text), &toolErr))
+		assert.Equal(t, CodeInvalidInput, toolErr.Code)
+		assert.Equal(t, "owner", toolErr.Param)
+		assert.Equal(t, "missing required parameter: owner", toolErr.Message)
+	})
+
+	t.Run("structured errors are unchanged", func(t *testing.T) {
+		result := NewToolError(CodeNotFound, "no such project", "").Result()
+		assert.Same(t, result, call(result))
+	})
+}
@@ -0,0 +1,153 @@
+package ghmcp
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"time"
+
+	"github.com/github/github-mcp-server/pkg/github"
+	"github.com/github/github-mcp-server/pkg/middleware"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// defaultContentWindowSize matches the default of the --content-window-size flag.
+const defaultContentWindowSize = 5000
+
+// TokenProvider returns the token to authenticate a GitHub API request with. It is called for every request, so it
+// can return short-lived tokens such as GitHub App installation tokens.
+type TokenProvider func(ctx context.Context) (string, error)
+
+// StaticToken returns a TokenProvider that always returns token.
+func StaticToken(token string) TokenProvider {
+	return func(_ context.Context) (string, error) {
+		return token, nil
+	}
+}
+
+// Options configure a server created with NewServer. Only one of Token and TokenProvider is needed; every other
+// field is optional.
+type Options struct {
+	// Version of the embedding program, reported to clients and in the User-Agent of GitHub API requests.
+	Version string
+
+	// Host is the GitHub host to target, e.g. https://github.com, https://octocorp.ghe.com or the URL of a GitHub
+	// Enterprise Server instance. Defaults to github.com.
+	Host string
+
+	// Token authenticates every GitHub API request.
+	Token string
+
+	// TokenProvider is called for every GitHub API request and takes precedence over Token.
+	TokenProvider TokenProvider
+
+	// EnabledToolsets lists the toolsets to enable. When neither EnabledToolsets nor EnabledTools is set, the
+	// default toolsets are enabled.
+	EnabledToolsets []string
+
+	// EnabledTools lists individual tools to enable in addition to the toolsets.
+	EnabledTools []string
+
+	// DeniedTools lists tools that are never registered, whichever toolsets or tools are enabled.
+	DeniedTools []string
+
+	// DynamicToolsets lets clients enable toolsets at runtime.
+	DynamicToolsets bool
+
+	// ReadOnly registers only the read-only tools.
+	ReadOnly bool
+
+	// Logger receives the server logs. Defaults to discarding them.
+	Logger *slog.Logger
+
+	// Translator overrides tool descriptions and titles. Defaults to the built-in English text; pass the helper
+	// returned by translations.TranslationHelper to read overrides from the environment or a config file.
+	Translator translations.TranslationHelperFunc
+
+	// ContentWindowSize limits the size of large tool outputs such as job logs. Defaults to 5000.
+	ContentWindowSize int
+
+	// LockdownMode hides content from users without push access to public repositories.
+	LockdownMode bool
+
+	// RepoAccessTTL overrides the default TTL for repository access cache entries used by lockdown mode.
+	RepoAccessTTL *time.Duration
+
+	// ToolMiddlewares wrap the handler of every registered tool, the first one outermost.
+	ToolMiddlewares []middleware.ToolMiddleware
+
+	// CustomToolsets add tools defined by the embedding program.
+	CustomToolsets []github.CustomToolset
+
+	// MaxConcurrentCalls and MaxConcurrentMutations limit how many tool calls, and calls to tools that are not
+	// read-only, run at once. Calls over a limit wait up to ConcurrencyQueueTimeout, or until they are cancelled if
+	// it is zero. Zero limits disable them.
+	MaxConcurrentCalls      int
+	MaxConcurrentMutations  int
+	ConcurrencyQueueTimeout time.Duration
+
+	// ToolTimeout bounds how long a tool call may run before it fails with a TIMEOUT error. ToolTimeouts override
+	// it for the named tools. Zero timeouts disable them.
+	ToolTimeout  time.Duration
+	ToolTimeouts map[string]time.Duration
+
+	// RelativeTimes adds a human-relative time, such as "3 days ago", next to every timestamp in tool results.
+	RelativeTimes bool
+
+	// ProjectDefaultStatuses maps projects, written as owner/number, to the Status that add_project_item sets on the
+	// items it adds.
+	ProjectDefaultStatuses github.ProjectDefaultStatuses
+
+	// ProjectSLAPolicies maps projects, written as owner/number, to the SLA policies that check_project_sla_breaches
+	// checks their items against.
+	ProjectSLAPolicies github.ProjectSLAPolicies
+}
+
+// NewServer creates a GitHub MCP server for embedding in another Go program. Serve the returned server with any
+// mcp-go transport, e.g. server.NewStdioServer or server.NewStreamableHTTPServer.
+func NewServer(opts Options) (*server.MCPServer, error) {
+	logger := opts.Logger
+	if logger == nil {
+		logger = slog.New(slog.NewTextHandler(io.Discard, nil))
+	}
+	translator := opts.Translator
+	if translator == nil {
+		translator = translations.NullTranslationHelper
+	}
+	contentWindowSize := opts.ContentWindowSize
+	if contentWindowSize == 0 {
+		contentWindowSize = defaultContentWindowSize
+	}
+	enabledToolsets := opts.EnabledToolsets
+	if len(enabledToolsets) == 0 && len(opts.EnabledTools) == 0 {
+		enabledToolsets = []string{github.ToolsetMetadataDefault.ID}
+	}
+
+	return NewMCPServer(MCPServerConfig{
+		Version:           opts.Version,
+		Host:              opts.Host,
+		Token:             opts.Token,
+		TokenProvider:     opts.TokenProvider,
+		EnabledToolsets:   enabledToolsets,
+		EnabledTools:      opts.EnabledTools,
+		DeniedTools:       opts.DeniedTools,
+		DynamicToolsets:   opts.DynamicToolsets,
+		ReadOnly:          opts.ReadOnly,
+		Translator:        translator,
+		ContentWindowSize: contentWindowSize,
+		LockdownMode:      opts.LockdownMode,
+		RepoAccessTTL:     opts.RepoAccessTTL,
+		ToolMiddlewares:   opts.ToolMiddlewares,
+		CustomToolsets:    opts.CustomToolsets,
+
+		MaxConcurrentCalls:      opts.MaxConcurrentCalls,
+		MaxConcurrentMutations:  opts.MaxConcurrentMutations,
+		ConcurrencyQueueTimeout: opts.ConcurrencyQueueTimeout,
+		ToolTimeout:             opts.ToolTimeout,
+		ToolTimeouts:            opts.ToolTimeouts,
+		RelativeTimes:           opts.RelativeTimes,
+		ProjectDefaultStatuses:  opts.ProjectDefaultStatuses,
+		ProjectSLAPolicies:      opts.ProjectSLAPolicies,
+	}, logger)
+}
@@ -0,0 +1,111 @@
+package ghmcp
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/github/github-mcp-server/pkg/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func listToolNames(t *testing.T, s *server.MCPServer) []string {
+	t.Helper()
+	message := s.HandleMessage(context.Background(), []byte(`{"jsonrpc":"2.0","id":1,"method":"tools/list"}`))
+	response, ok := message.(mcp.JSONRPCResponse)
+	require.True(t, ok, "expected a JSON-RPC response, got %T", message)
+	result, ok := response.Result.(mcp.ListToolsResult)
+	require.True(t, ok, "expected a tools/list result, got %T", response.Result)
+
+	names := make([]string, 0, len(result.Tools))
+	for _, tool := range result.Tools {
+		names = append(names, tool.Name)
+	}
+	return names
+}
+
+func TestNewServer(t *testing.T) {
+	t.Run("defaults enable the default toolsets", func(t *testing.T) {
+		s, err := NewServer(Options{Token: "test-token"})
+		require.NoError(t, err)
+
+		tools := listToolNames(t, s)
+		assert.Contains(t, tools, "get_me")
+		assert.Contains(t, tools, "issue_write")
+		assert.NotContains(t, tools, "list_workflows", "actions is not a default toolset")
+	})
+
+	t.Run("read-only with explicit toolsets", func(t *testing.T) {
+		s, err := NewServer(Options{
+			Token:           "test-token",
+			EnabledToolsets: []string{github.ToolsetMetadataActions.ID},
+			ReadOnly:        true,
+		})
+		require.NoError(t, err)
+
+		tools := listToolNames(t, s)
+		assert.Contains(t, tools, "list_workflows")
+		assert.NotContains(t, tools, "run_workflow")
+		assert.NotContains(t, tools, "get_me")
+	})
+
+	t.Run("invalid host", func(t *testing.T) {
+		_, err := NewServer(Options{Token: "test-token", Host: "github.com"})
+		require.Error(t, err)
+	})
+}
+
+func TestBearerAuthTransport(t *testing.T) {
+	var authorization string
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authorization = r.Header.Get("Authorization")
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer upstream.Close()
+
+	calls := 0
+	client := &http.Client{Transport: &bearerAuthTransport{
+		transport: http.DefaultTransport,
+		tokenProvider: func(_ context.Context) (string, error) {
+			calls++
+			return fmt.Sprintf("token-%d", calls), nil
+		},
+	}}
+
+	for i := 1; i <= 2; i++ {
+		resp, err := client.Get(upstream.URL)
+		require.NoError(t, err)
+		_ = resp.Body.Close()
+		assert.Equal(t, fmt.Sprintf("Bearer token-%d", i), authorization)
+	}
+
+	failing := &http.Client{Transport: &bearerAuthTransport{
+		transport: http.DefaultTransport,
+		tokenProvider: func(_ context.Context) (string, error) {
+			return "", fmt.Errorf("token expired")
+		},
+	}}
+	_, err := failing.Get(upstream.URL) //nolint:bodyclose // the request fails before a response exists
+	require.ErrorContains(t, err, "failed to get GitHub token: token expired")
+}
+
+func TestNewServerValidatesArguments(t *testing.T) {
+	s, err := NewServer(Options{Token: "test-token"})
+	require.NoError(t, err)
+
+	message := s.HandleMessage(context.Background(), []byte(`{"jsonrpc":"2.0","id":1,"method":"tools/call","params":{"name":"list_branches","arguments":{"owner":"octo","repo":"hello","perPage":500}}}`))
+	response, ok := message.(mcp.JSONRPCResponse)
+	require.True(t, ok, "expected a JSON-RPC response, got %T", message)
+	result, ok := response.Result.(mcp.CallToolResult)
+	require.True(t, ok, "expected a tools/call result, got %T", response.Result)
+
+	require.True(t, result.IsError)
+	text, ok := result.Content[0].(mcp.TextContent)
+	require.True(t, ok)
+	assert.JSONEq(t, `{"code":"INVALID_INPUT","message":"parameter perPage must be at most 100, got 500","param":"perPage","hint":"Correct the \"perPage\" parameter and call the tool again."}`, text.Text)
+}
@@ -0,0 +1,102 @@
+package ghmcp
+
+import (
+	"net"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+)
+
+// corsAllowedHeaders are the request headers browser clients need to send to the MCP endpoint.
+const corsAllowedHeaders = "Authorization, Content-Type, Accept, Last-Event-ID, Mcp-Session-Id, Mcp-Protocol-Version"
+
+// originPolicy decides which browser origins may call the server, and protects servers listening on a loopback
+// address against DNS rebinding.
+type originPolicy struct {
+	// allowedOrigins are origins such as https://example.com; "*" allows any origin.
+	allowedOrigins []string
+	// loopbackOnly requires the Host header to name a loopback host, because the server is only reachable locally.
+	loopbackOnly bool
+}
+
+func newOriginPolicy(address string, allowedOrigins []string) originPolicy {
+	normalized := make([]string, 0, len(allowedOrigins))
+	for _, origin := range allowedOrigins {
+		if origin = strings.TrimSpace(origin); origin != "" {
+			normalized = append(normalized, strings.ToLower(strings.TrimSuffix(origin, "/")))
+		}
+	}
+	host, _, err := net.SplitHostPort(address)
+	return originPolicy{
+		allowedOrigins: normalized,
+		loopbackOnly:   err == nil && isLoopbackHost(host),
+	}
+}
+
+// isLoopbackHost reports whether host is localhost or a loopback IP address.
+func isLoopbackHost(host string) bool {
+	if strings.EqualFold(host, "localhost") {
+		return true
+	}
+	ip := net.ParseIP(strings.Trim(host, "[]"))
+	return ip != nil && ip.IsLoopback()
+}
+
+// allowsOrigin reports whether a request with the given Origin header may be served. Requests without an Origin
+// header do not come from a browser page and are always allowed. Loopback origins are allowed by default, so that
+// local browser clients work without configuration.
+func (p originPolicy) allowsOrigin(origin string) bool {
+	if origin == "" {
+		return true
+	}
+	if slices.Contains(p.allowedOrigins, "*") || slices.Contains(p.allowedOrigins, strings.ToLower(origin)) {
+		return true
+	}
+	u, err := url.Parse(origin)
+	return err == nil && (u.Scheme == "http" || u.Scheme == "https") && isLoopbackHost(u.Hostname())
+}
+
+// allowsHost reports whether the Host header of a request is acceptable. A server listening on a loopback address
+// only accepts loopback host names: a page on another site that rebinds its domain to 127.0.0.1 still sends its own
+// domain in the Host header.
+func (p originPolicy) allowsHost(hostHeader string) bool {
+	if !p.loopbackOnly {
+		return true
+	}
+	host, _, err := net.SplitHostPort(hostHeader)
+	if err != nil {
+		host = hostHeader
+	}
+	return isLoopbackHost(host)
+}
+
+// handler rejects requests from disallowed origins and hosts with 403 Forbidden, adds CORS headers for allowed
+// origins and answers CORS preflight requests.
+func (p originPolicy) handler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !p.allowsHost(r.Host) {
+			http.Error(w, "host not allowed", http.StatusForbidden)
+			return
+		}
+		origin := r.Header.Get("Origin")
+		if !p.allowsOrigin(origin) {
+			http.Error(w, "origin not allowed", http.StatusForbidden)
+			return
+		}
+
+		if origin != "" {
+			w.Header().Add("Vary", "Origin")
+			w.Header().Set("Access-Control-Allow-Origin", origin)
+			w.Header().Set("Access-Control-Expose-Headers", "Mcp-Session-Id, WWW-Authenticate")
+			if r.Method == http.MethodOptions && r.Header.Get("Access-Control-Request-Method") != "" {
+				w.Header().Set("Access-Control-Allow-Methods", "GET, POST, DELETE, OPTIONS")
+				w.Header().Set("Access-Control-Allow-Headers", corsAllowedHeaders)
+				w.Header().Set("Access-Control-Max-Age", "600")
+				w.WriteHeader(http.StatusNoContent)
+				return
+			}
+		}
+		next.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,105 @@
+package ghmcp
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOriginPolicy(t *testing.T) {
+	next := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+
+	tests := []struct {
+		name           string
+		address        string
+		allowedOrigins []string
+		host           string
+		origin         string
+		expectedStatus int
+		expectedCORS   string
+	}{
+		{
+			name:           "request without origin",
+			address:        "localhost:8080",
+			host:           "localhost:8080",
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "loopback origin allowed by default",
+			address:        "localhost:8080",
+			host:           "localhost:8080",
+			origin:         "http://localhost:3000",
+			expectedStatus: http.StatusOK,
+			expectedCORS:   "http://localhost:3000",
+		},
+		{
+			name:           "other origin rejected by default",
+			address:        "localhost:8080",
+			host:           "localhost:8080",
+			origin:         "https://evil.example",
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:           "configured origin",
+			address:        "0.0.0.0:8080",
+			allowedOrigins: []string{"https://Chat.Example.com/"},
+			host:           "mcp.example.com",
+			origin:         "https://chat.example.com",
+			expectedStatus: http.StatusOK,
+			expectedCORS:   "https://chat.example.com",
+		},
+		{
+			name:           "wildcard origin",
+			address:        "0.0.0.0:8080",
+			allowedOrigins: []string{"*"},
+			host:           "mcp.example.com",
+			origin:         "https://anything.example",
+			expectedStatus: http.StatusOK,
+			expectedCORS:   "https://anything.example",
+		},
+		{
+			name:           "rebound host on loopback listener",
+			address:        "127.0.0.1:8080",
+			host:           "evil.example:8080",
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:           "any host on public listener",
+			address:        ":8080",
+			host:           "mcp.example.com",
+			expectedStatus: http.StatusOK,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodPost, "/mcp", nil)
+			r.Host = tc.host
+			if tc.origin != "" {
+				r.Header.Set("Origin", tc.origin)
+			}
+			rec := httptest.NewRecorder()
+			newOriginPolicy(tc.address, tc.allowedOrigins).handler(next).ServeHTTP(rec, r)
+
+			assert.Equal(t, tc.expectedStatus, rec.Code)
+			assert.Equal(t, tc.expectedCORS, rec.Header().Get("Access-Control-Allow-Origin"))
+		})
+	}
+
+	t.Run("preflight", func(t *testing.T) {
+		r := httptest.NewRequest(http.MethodOptions, "/mcp", nil)
+		r.Host = "localhost:8080"
+		r.Header.Set("Origin", "http://127.0.0.1:3000")
+		r.Header.Set("Access-Control-Request-Method", http.MethodPost)
+		rec := httptest.NewRecorder()
+		newOriginPolicy("localhost:8080", nil).handler(next).ServeHTTP(rec, r)
+
+		assert.Equal(t, http.StatusNoContent, rec.Code)
+		assert.Contains(t, rec.Header().Get("Access-Control-Allow-Headers"), "Mcp-Session-Id")
+		assert.Contains(t, rec.Header().Get("Access-Control-Allow-Methods"), http.MethodPost)
+	})
+}
@@ -0,0 +1,1042 @@
+package ghmcp
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"log/slog"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"os/signal"
+	"slices"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/github"
+	"github.com/github/github-mcp-server/pkg/lockdown"
+	mcplog "github.com/github/github-mcp-server/pkg/log"
+	"github.com/github/github-mcp-server/pkg/metrics"
+	"github.com/github/github-mcp-server/pkg/middleware"
+	"github.com/github/github-mcp-server/pkg/raw"
+	"github.com/github/github-mcp-server/pkg/redact"
+	"github.com/github/github-mcp-server/pkg/toolsets"
+	"github.com/github/github-mcp-server/pkg/translations"
+	gogithub "github.com/google/go-github/v79/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/shurcooL/githubv4"
+)
+
+type MCPServerConfig struct {
+	// Version of the server
+	Version string
+
+	// GitHub Host to target for API requests (e.g. github.com or github.enterprise.com)
+	Host string
+
+	// GitHub Token to authenticate with the GitHub API
+	Token string
+
+	// TokenProvider, if set, is called for every GitHub API request to get the token to authenticate with,
+	// and takes precedence over Token
+	TokenProvider TokenProvider
+
+	// EnabledToolsets is a list of toolsets to enable
+	// See: https://github.com/github/github-mcp-server?tab=readme-ov-file#tool-configuration
+	EnabledToolsets []string
+
+	// EnabledTools is a list of specific tools to enable (additive to toolsets)
+	// When specified, these tools are registered in addition to any specified toolset tools
+	EnabledTools []string
+
+	// DeniedTools is a list of tools that are never registered, whichever toolsets or tools are enabled
+	DeniedTools []string
+
+	// Whether to enable dynamic toolsets
+	// See: https://github.com/github/github-mcp-server?tab=readme-ov-file#dynamic-tool-discovery
+	DynamicToolsets bool
+
+	// ReadOnly indicates if we should only offer read-only tools
+	ReadOnly bool
+
+	// Translator provides translated text for the server tooling
+	Translator translations.TranslationHelperFunc
+
+	// Content window size
+	ContentWindowSize int
+
+	// LockdownMode indicates if we should enable lockdown mode
+	LockdownMode bool
+
+	// RepoAccessTTL overrides the default TTL for repository access cache entries.
+	RepoAccessTTL *time.Duration
+
+	// ToolMiddlewares wrap the handler of every registered tool, the first one outermost.
+	// See the middleware package for the built-in logging and rate limiting middlewares.
+	ToolMiddlewares []middleware.ToolMiddleware
+
+	// ToolConfigUpdates, if set, receives changes to the exposed tools while the server runs. The server applies
+	// each update and notifies clients with tools/list_changed when the tools change. Toolsets enabled at runtime
+	// through dynamic tool discovery are reset by an update.
+	ToolConfigUpdates <-chan ToolConfig
+
+	// Metrics, if set, collects tool call and GitHub API metrics
+	Metrics *metrics.Metrics
+
+	// MaxConcurrentCalls limits how many tool calls run at once, if greater than zero
+	MaxConcurrentCalls int
+
+	// MaxConcurrentMutations limits how many calls to tools that are not read-only run at once, if greater than zero
+	MaxConcurrentMutations int
+
+	// ConcurrencyQueueTimeout bounds how long a tool call waits for MaxConcurrentCalls or MaxConcurrentMutations
+	// before it fails. Zero waits until the call is cancelled.
+	ConcurrencyQueueTimeout time.Duration
+
+	// ToolTimeout bounds how long a tool call may run before it fails with a TIMEOUT error, if greater than zero
+	ToolTimeout time.Duration
+
+	// ToolTimeouts override ToolTimeout for the named tools; a zero timeout disables it for the tool
+	ToolTimeouts map[string]time.Duration
+
+	// RelativeTimes adds a human-relative time, such as "3 days ago", next to every timestamp in tool results
+	RelativeTimes bool
+
+	// ProjectDefaultStatuses maps projects, as owner/number, to the Status add_project_item sets on new items
+	ProjectDefaultStatuses github.ProjectDefaultStatuses
+
+	// ProjectSLAPolicies maps projects, as owner/number, to the SLA policies check_project_sla_breaches checks
+	ProjectSLAPolicies github.ProjectSLAPolicies
+
+	// CustomToolsets add tools defined outside this module, created with the same clients and translations as the
+	// built-in tools. New toolsets are enabled by their ID like the built-in ones.
+	CustomToolsets []github.CustomToolset
+}
+
+// ToolConfig holds the options that decide which tools the server exposes and how they are described. See
+// MCPServerConfig for their meaning.
+type ToolConfig struct {
+	EnabledToolsets []string
+	EnabledTools    []string
+	DeniedTools     []string
+	// Translator defaults to the translator the server was created with.
+	Translator translations.TranslationHelperFunc
+}
+
+const stdioServerLogPrefix = "stdioserver"
+
+func NewMCPServer(cfg MCPServerConfig, logger *slog.Logger) (*server.MCPServer, error) {
+	apiHost, err := parseAPIHost(cfg.Host)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse API host: %w", err)
+	}
+
+	tokenProvider := cfg.TokenProvider
+	if tokenProvider == nil {
+		tokenProvider = StaticToken(cfg.Token)
+	}
+
+	var baseTransport http.RoundTripper = &userAgentTransport{
+		transport: http.DefaultTransport,
+		agent:     fmt.Sprintf("github-mcp-server/%s", cfg.Version),
+	}
+	if cfg.Metrics != nil {
+		baseTransport = cfg.Metrics.Transport(baseTransport)
+	}
+
+	// Construct our REST client
+	restClient := gogithub.NewClient(&http.Client{
+		Transport: &bearerAuthTransport{
+			transport:     baseTransport,
+			tokenProvider: tokenProvider,
+		},
+	})
+	restClient.UserAgent = fmt.Sprintf("github-mcp-server/%s", cfg.Version)
+	restClient.BaseURL = apiHost.baseRESTURL
+	restClient.UploadURL = apiHost.uploadURL
+
+	// Construct our GraphQL client
+	// We're using NewEnterpriseClient here unconditionally as opposed to NewClient because we already
+	// did the necessary API host parsing so that github.com will return the correct URL anyway.
+	gqlHTTPClient := &http.Client{
+		Transport: &bearerAuthTransport{
+			transport:     baseTransport,
+			tokenProvider: tokenProvider,
+		},
+	}
+	gqlClient := githubv4.NewEnterpriseClient(apiHost.graphqlURL.String(), gqlHTTPClient)
+	repoAccessOpts := []lockdown.RepoAccessOption{}
+	if cfg.RepoAccessTTL != nil {
+		repoAccessOpts = append(repoAccessOpts, lockdown.WithTTL(*cfg.RepoAccessTTL))
+	}
+
+	repoAccessLogger := logger.With("component", "lockdown")
+	repoAccessOpts = append(repoAccessOpts, lockdown.WithLogger(repoAccessLogger))
+	var repoAccessCache *lockdown.RepoAccessCache
+	if cfg.LockdownMode {
+		repoAccessCache = lockdown.GetInstance(gqlClient, repoAccessOpts...)
+	}
+
+	hooks := &server.Hooks{
+		OnBeforeAny: []server.BeforeAnyHookFunc{
+			func(ctx context.Context, _ any, _ mcp.MCPMethod, _ any) {
+				// Ensure the context is cleared of any previous errors
+				// as context isn't propagated through middleware
+				errors.ContextWithGitHubErrors(ctx)
+			},
+		},
+	}
+
+	enabledToolsets := resolveToolsets(cfg.EnabledToolsets, cfg.DynamicToolsets, cfg.CustomToolsets)
+
+	// Generate instructions based on enabled toolsets
+	instructions := github.GenerateInstructions(enabledToolsets)
+
+	serverOpts := []server.ServerOption{
+		server.WithInstructions(instructions),
+		server.WithHooks(hooks),
+	}
+	if cfg.Metrics != nil {
+		serverOpts = append(serverOpts, server.WithToolHandlerMiddleware(cfg.Metrics.ToolMiddleware()))
+	}
+	for _, mw := range cfg.ToolMiddlewares {
+		serverOpts = append(serverOpts, server.WithToolHandlerMiddleware(mw))
+	}
+	if cfg.RelativeTimes {
+		serverOpts = append(serverOpts, server.WithToolHandlerMiddleware(github.RelativeTimestamps(time.Now)))
+	}
+	// tools and mutations are filled in once the toolsets are created, before the server handles any tool call
+	tools := map[string]mcp.Tool{}
+	mutations := map[string]bool{}
+	serverOpts = append(serverOpts, server.WithToolHandlerMiddleware(github.ArgumentValidation(func(name string) (mcp.Tool, bool) {
+		tool, ok := tools[name]
+		return tool, ok
+	})))
+	if cfg.MaxConcurrentCalls > 0 || cfg.MaxConcurrentMutations > 0 {
+		limiter := middleware.NewConcurrencyLimiter(cfg.MaxConcurrentCalls, cfg.MaxConcurrentMutations, cfg.ConcurrencyQueueTimeout,
+			func(tool string) bool { return mutations[tool] })
+		serverOpts = append(serverOpts, server.WithToolHandlerMiddleware(limiter.Middleware()))
+	}
+	if cfg.ToolTimeout > 0 || len(cfg.ToolTimeouts) > 0 {
+		serverOpts = append(serverOpts, server.WithToolHandlerMiddleware(middleware.Timeout(cfg.ToolTimeout, cfg.ToolTimeouts)))
+	}
+	ghServer := github.NewServer(cfg.Version, serverOpts...)
+
+	getClient := func(_ context.Context) (*gogithub.Client, error) {
+		return restClient, nil // closing over client
+	}
+
+	getGQLClient := func(_ context.Context) (*githubv4.Client, error) {
+		return gqlClient, nil // closing over client
+	}
+
+	getRawClient := func(ctx context.Context) (*raw.Client, error) {
+		client, err := getClient(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+		}
+		return raw.NewClient(client, apiHost.rawURL), nil // closing over client
+	}
+
+	newToolsetGroup := func(t translations.TranslationHelperFunc) (*toolsets.ToolsetGroup, error) {
+		// Create default toolsets
+		tsg := github.DefaultToolsetGroup(
+			cfg.ReadOnly,
+			getClient,
+			getGQLClient,
+			getRawClient,
+			t,
+			cfg.ContentWindowSize,
+			github.FeatureFlags{LockdownMode: cfg.LockdownMode},
+			repoAccessCache,
+			cfg.ProjectDefaultStatuses,
+			cfg.ProjectSLAPolicies,
+		)
+
+		if len(cfg.CustomToolsets) > 0 {
+			deps := github.ToolDependencies{
+				GetClient:         getClient,
+				GetGQLClient:      getGQLClient,
+				GetRawClient:      getRawClient,
+				Translator:        t,
+				ContentWindowSize: cfg.ContentWindowSize,
+				Flags:             github.FeatureFlags{LockdownMode: cfg.LockdownMode},
+				RepoAccessCache:   repoAccessCache,
+			}
+			if err := github.AddCustomToolsets(tsg, deps, cfg.CustomToolsets...); err != nil {
+				return nil, fmt.Errorf("failed to add custom toolsets: %w", err)
+			}
+		}
+		return tsg, nil
+	}
+
+	tsg, err := newToolsetGroup(cfg.Translator)
+	if err != nil {
+		return nil, err
+	}
+	// The maps cover the denied tools too, as a tool configuration update can allow them again.
+	for _, toolset := range tsg.Toolsets {
+		for _, tool := range toolset.GetAvailableTools() {
+			tools[tool.Tool.Name] = tool.Tool
+			readOnly := tool.Tool.Annotations.ReadOnlyHint
+			mutations[tool.Tool.Name] = readOnly == nil || !*readOnly
+		}
+	}
+	if len(cfg.DeniedTools) > 0 {
+		tsg.RemoveTools(cfg.DeniedTools...)
+	}
+
+	// Enable and register toolsets if configured
+	// This always happens if toolsets are specified, regardless of whether tools are also specified
+	if len(enabledToolsets) > 0 {
+		err = tsg.EnableToolsets(enabledToolsets, nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to enable toolsets: %w", err)
+		}
+
+		// Register all mcp functionality with the server
+		tsg.RegisterAll(ghServer)
+	}
+
+	// Register specific tools if configured
+	if len(cfg.EnabledTools) > 0 {
+		// Clean and validate tool names
+		enabledTools := github.CleanTools(cfg.EnabledTools)
+
+		// Register the specified tools (additive to any toolsets already enabled)
+		err = tsg.RegisterSpecificTools(ghServer, enabledTools, cfg.ReadOnly)
+		if err != nil {
+			return nil, fmt.Errorf("failed to register tools: %w", err)
+		}
+	}
+
+	// Register dynamic toolsets if configured (additive to toolsets and tools)
+	if cfg.DynamicToolsets {
+		dynamic := github.InitDynamicToolset(ghServer, tsg, cfg.Translator)
+		dynamic.RegisterTools(ghServer)
+	}
+
+	if cfg.ToolConfigUpdates != nil {
+		current, err := configuredTools(ghServer, tsg, enabledToolsets, cfg.EnabledTools, cfg.ReadOnly, cfg.DynamicToolsets, cfg.Translator)
+		if err != nil {
+			return nil, err
+		}
+		go func() {
+			for update := range cfg.ToolConfigUpdates {
+				t := update.Translator
+				if t == nil {
+					t = cfg.Translator
+				}
+				tsg, err := newToolsetGroup(t)
+				if err != nil {
+					logger.Error("failed to apply tool configuration update", "error", err)
+					continue
+				}
+				if len(update.DeniedTools) > 0 {
+					tsg.RemoveTools(update.DeniedTools...)
+				}
+				enabledToolsets := resolveToolsets(update.EnabledToolsets, cfg.DynamicToolsets, cfg.CustomToolsets)
+				tools, err := configuredTools(ghServer, tsg, enabledToolsets, update.EnabledTools, cfg.ReadOnly, cfg.DynamicToolsets, t)
+				if err != nil {
+					logger.Error("failed to apply tool configuration update", "error", err)
+					continue
+				}
+				if sameTools(tools, current) {
+					continue
+				}
+				// SetTools notifies clients with tools/list_changed.
+				ghServer.SetTools(tools...)
+				current = tools
+				logger.Info("applied tool configuration update", "tools", len(tools))
+			}
+		}()
+	}
+
+	return ghServer, nil
+}
+
+// resolveToolsets cleans the configured toolsets and expands the "all" and "default" keywords, warning about
+// unknown toolsets.
+func resolveToolsets(enabledToolsets []string, dynamicToolsets bool, custom []github.CustomToolset) []string {
+	// If dynamic toolsets are enabled, remove "all" from the enabled toolsets
+	if dynamicToolsets {
+		enabledToolsets = github.RemoveToolset(enabledToolsets, github.ToolsetMetadataAll.ID)
+	}
+
+	// Clean up the passed toolsets
+	enabledToolsets, invalidToolsets := github.CleanToolsets(enabledToolsets)
+
+	// If "all" is present, override all other toolsets
+	if github.ContainsToolset(enabledToolsets, github.ToolsetMetadataAll.ID) {
+		enabledToolsets = []string{github.ToolsetMetadataAll.ID}
+	}
+	// If "default" is present, expand to real toolset IDs
+	if github.ContainsToolset(enabledToolsets, github.ToolsetMetadataDefault.ID) {
+		enabledToolsets = github.AddDefaultToolset(enabledToolsets)
+	}
+
+	invalidToolsets = slices.DeleteFunc(invalidToolsets, func(id string) bool {
+		return slices.ContainsFunc(custom, func(c github.CustomToolset) bool { return c.ID == id })
+	})
+	if len(invalidToolsets) > 0 {
+		fmt.Fprintf(os.Stderr, "Invalid toolsets ignored: %s\n", strings.Join(invalidToolsets, ", "))
+	}
+	return enabledToolsets
+}
+
+// configuredTools returns the tools a configuration exposes, in the same way NewMCPServer registers them: the tools
+// of the enabled toolsets, the individually enabled tools and the dynamic toolset tools, sorted by name.
+func configuredTools(s *server.MCPServer, tsg *toolsets.ToolsetGroup, enabledToolsets, enabledTools []string, readOnly, dynamicToolsets bool, t translations.TranslationHelperFunc) ([]server.ServerTool, error) {
+	if err := tsg.EnableToolsets(enabledToolsets, nil); err != nil {
+		return nil, fmt.Errorf("failed to enable toolsets: %w", err)
+	}
+
+	byName := make(map[string]server.ServerTool)
+	for _, toolset := range tsg.Toolsets {
+		for _, tool := range toolset.GetActiveTools() {
+			byName[tool.Tool.Name] = tool
+		}
+	}
+	for _, name := range github.CleanTools(enabledTools) {
+		tool, _, err := tsg.FindToolByName(name)
+		if err != nil {
+			return nil, fmt.Errorf("tool %s not found: %w", name, err)
+		}
+		if readOnly && tool.Tool.Annotations.ReadOnlyHint != nil && !*tool.Tool.Annotations.ReadOnlyHint {
+			continue
+		}
+		byName[name] = *tool
+	}
+	if dynamicToolsets {
+		for _, tool := range github.InitDynamicToolset(s, tsg, t).GetActiveTools() {
+			byName[tool.Tool.Name] = tool
+		}
+	}
+
+	tools := make([]server.ServerTool, 0, len(byName))
+	for _, tool := range byName {
+		tools = append(tools, tool)
+	}
+	slices.SortFunc(tools, func(a, b server.ServerTool) int {
+		return strings.Compare(a.Tool.Name, b.Tool.Name)
+	})
+	return tools, nil
+}
+
+// sameTools reports whether two sorted tool lists expose the same tool definitions to clients.
+func sameTools(a, b []server.ServerTool) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		aJSON, errA := json.Marshal(a[i].Tool)
+		bJSON, errB := json.Marshal(b[i].Tool)
+		if errA != nil || errB != nil || !bytes.Equal(aJSON, bJSON) {
+			return false
+		}
+	}
+	return true
+}
+
+type StdioServerConfig struct {
+	// Version of the server
+	Version string
+
+	// GitHub Host to target for API requests (e.g. github.com or github.enterprise.com)
+	Host string
+
+	// GitHub Token to authenticate with the GitHub API
+	Token string
+
+	// EnabledToolsets is a list of toolsets to enable
+	// See: https://github.com/github/github-mcp-server?tab=readme-ov-file#tool-configuration
+	EnabledToolsets []string
+
+	// EnabledTools is a list of specific tools to enable (additive to toolsets)
+	// When specified, these tools are registered in addition to any specified toolset tools
+	EnabledTools []string
+
+	// DeniedTools is a list of tools that are never registered, whichever toolsets or tools are enabled
+	DeniedTools []string
+
+	// Whether to enable dynamic toolsets
+	// See: https://github.com/github/github-mcp-server?tab=readme-ov-file#dynamic-tool-discovery
+	DynamicToolsets bool
+
+	// ReadOnly indicates if we should only register read-only tools
+	ReadOnly bool
+
+	// ExportTranslations indicates if we should export translations
+	// See: https://github.com/github/github-mcp-server?tab=readme-ov-file#i18n--overriding-descriptions
+	ExportTranslations bool
+
+	// EnableCommandLogging indicates if we should log commands
+	EnableCommandLogging bool
+
+	// Path to the log file if not stderr
+	LogFilePath string
+
+	// Content window size
+	ContentWindowSize int
+
+	// LockdownMode indicates if we should enable lockdown mode
+	LockdownMode bool
+
+	// RepoAccessCacheTTL overrides the default TTL for repository access cache entries.
+	RepoAccessCacheTTL *time.Duration
+
+	// RateLimit limits tool calls to this many calls per second, if greater than zero
+	RateLimit float64
+
+	// RateLimitBurst is the number of tool calls allowed at once before RateLimit applies
+	RateLimitBurst int
+
+	// TranslationOverrides override tool descriptions and titles by translation key, taking precedence over
+	// the github-mcp-server-config.json file and GITHUB_MCP_ environment variables
+	TranslationOverrides map[string]string
+
+	// Reloads, if set, receives configuration changes to apply while the server runs
+	Reloads <-chan ReloadableConfig
+
+	// ShutdownTimeout bounds how long the server waits for in-flight tool calls when it shuts down
+	ShutdownTimeout time.Duration
+
+	// MaxConcurrentCalls limits how many tool calls run at once, if greater than zero
+	MaxConcurrentCalls int
+
+	// MaxConcurrentMutations limits how many mutating tool calls run at once, if greater than zero
+	MaxConcurrentMutations int
+
+	// ConcurrencyQueueTimeout bounds how long a tool call waits for a concurrency slot
+	ConcurrencyQueueTimeout time.Duration
+
+	// ToolTimeout bounds how long a tool call may run, if greater than zero
+	ToolTimeout time.Duration
+
+	// ToolTimeouts override ToolTimeout for the named tools
+	ToolTimeouts map[string]time.Duration
+
+	// RelativeTimes adds human-relative times next to the timestamps in tool results
+	RelativeTimes bool
+
+	// ProjectDefaultStatuses maps projects to the Status add_project_item sets on new items
+	ProjectDefaultStatuses github.ProjectDefaultStatuses
+
+	// ProjectSLAPolicies maps projects to the SLA policies check_project_sla_breaches checks
+	ProjectSLAPolicies github.ProjectSLAPolicies
+}
+
+// ReloadableConfig holds the StdioServerConfig options that can change while the server runs. See
+// StdioServerConfig for their meaning.
+type ReloadableConfig struct {
+	EnabledToolsets      []string
+	EnabledTools         []string
+	DeniedTools          []string
+	TranslationOverrides map[string]string
+	RateLimit            float64
+	RateLimitBurst       int
+}
+
+// HTTPServerConfig configures RunHTTPServer. The embedded StdioServerConfig holds the options shared with the
+// stdio server; EnableCommandLogging does not apply to HTTP.
+type HTTPServerConfig struct {
+	StdioServerConfig
+
+	// Address to listen on, e.g. localhost:8080
+	Address string
+
+	// EnableMetrics serves Prometheus metrics at /metrics
+	EnableMetrics bool
+
+	// AllowedOrigins lists the browser origins, e.g. https://example.com, allowed to call the server, in addition
+	// to loopback origins. "*" allows any origin.
+	AllowedOrigins []string
+}
+
+// RunStdioServer is not concurrent safe.
+func RunStdioServer(cfg StdioServerConfig) error {
+	// Create app context
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	t, dumpTranslations := translations.TranslationHelper()
+
+	redactor := redact.New(cfg.Token)
+	logger, logOutput, closeLog, err := newLogger(cfg.LogFilePath, redactor)
+	if err != nil {
+		return err
+	}
+	defer closeLog()
+	logger.Info("starting server", "version", cfg.Version, "host", cfg.Host, "dynamicToolsets", cfg.DynamicToolsets, "readOnly", cfg.ReadOnly, "lockdownEnabled", cfg.LockdownMode)
+	stdLogger := log.New(logOutput, stdioServerLogPrefix, 0)
+
+	drain := middleware.NewDrain()
+	ghServer, err := newConfiguredServer(cfg, t, logger, redactor, nil, drain, nil)
+	if err != nil {
+		return err
+	}
+
+	stdioServer := server.NewStdioServer(ghServer)
+	stdioServer.SetErrorLogger(stdLogger)
+
+	if cfg.ExportTranslations {
+		// Once server is initialized, all translations are loaded
+		dumpTranslations()
+	}
+
+	// Listen with a context of its own, so that a shutdown signal does not cancel in-flight tool calls
+	listenCtx, cancelListen := context.WithCancel(context.Background())
+	defer cancelListen()
+
+	// Start listening for messages
+	errC := make(chan error, 1)
+	go func() {
+		in, out := io.Reader(os.Stdin), io.Writer(os.Stdout)
+
+		if cfg.EnableCommandLogging {
+			loggedIO := mcplog.NewIOLogger(in, out, logger)
+			in, out = loggedIO, loggedIO
+		}
+		// enable GitHub errors in the context
+		ctx := errors.ContextWithGitHubErrors(listenCtx)
+		errC <- stdioServer.Listen(ctx, in, out)
+	}()
+
+	// Output github-mcp-server string
+	_, _ = fmt.Fprintf(os.Stderr, "GitHub MCP Server running on stdio\n")
+
+	// Wait for shutdown signal
+	select {
+	case <-ctx.Done():
+		logger.Info("shutting down server", "signal", "context done")
+		drainToolCalls(drain, cfg.ShutdownTimeout, logger)
+	case err := <-errC:
+		if err != nil {
+			logger.Error("error running server", "error", err)
+			return fmt.Errorf("error running server: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// RunHTTPServer serves the MCP server over the streamable HTTP transport at /mcp until it receives SIGINT or
+// SIGTERM.
+func RunHTTPServer(cfg HTTPServerConfig) error {
+	// Create app context
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	t, dumpTranslations := translations.TranslationHelper()
+
+	// The token each client sends is scrubbed from the logs and tool results of its own requests.
+	redactor := redact.New(cfg.Token).WithContextSecret(TokenFromContext)
+	logger, _, closeLog, err := newLogger(cfg.LogFilePath, redactor)
+	if err != nil {
+		return err
+	}
+	defer closeLog()
+	logger.Info("starting server", "version", cfg.Version, "host", cfg.Host, "address", cfg.Address, "dynamicToolsets", cfg.DynamicToolsets, "readOnly", cfg.ReadOnly, "lockdownEnabled", cfg.LockdownMode, "metricsEnabled", cfg.EnableMetrics)
+
+	var serverMetrics *metrics.Metrics
+	if cfg.EnableMetrics {
+		serverMetrics = metrics.New()
+	}
+
+	// Each request is authenticated with the token in its Authorization header, or else the server-wide token.
+	// Lockdown mode caches what the token's user can access, so it cannot be shared between users' tokens.
+	tokenProvider := SessionTokenProvider(cfg.Token)
+	if cfg.LockdownMode {
+		if cfg.Token == "" {
+			return fmt.Errorf("lockdown mode requires a server-wide GitHub token")
+		}
+		tokenProvider = nil
+	}
+
+	drain := middleware.NewDrain()
+	ghServer, err := newConfiguredServer(cfg.StdioServerConfig, t, logger, redactor, serverMetrics, drain, tokenProvider)
+	if err != nil {
+		return err
+	}
+
+	if cfg.ExportTranslations {
+		// Once server is initialized, all translations are loaded
+		dumpTranslations()
+	}
+
+	var mcpHandler http.Handler = server.NewStreamableHTTPServer(ghServer,
+		server.WithHTTPContextFunc(sessionTokenContext),
+	)
+	if cfg.Token == "" {
+		mcpHandler = requireToken(mcpHandler)
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/mcp", newOriginPolicy(cfg.Address, cfg.AllowedOrigins).handler(mcpHandler))
+	if serverMetrics != nil {
+		mux.Handle("/metrics", serverMetrics.Handler())
+	}
+	// Requests get a base context of their own, so that a shutdown signal does not cancel in-flight tool calls.
+	// It is cancelled once they are drained, which also ends the long-lived event streams of idle sessions.
+	baseCtx, cancelBase := context.WithCancel(context.Background())
+	defer cancelBase()
+	httpServer := &http.Server{
+		Addr:              cfg.Address,
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+		BaseContext:       func(net.Listener) context.Context { return baseCtx },
+	}
+
+	errC := make(chan error, 1)
+	go func() {
+		errC <- httpServer.ListenAndServe()
+	}()
+
+	_, _ = fmt.Fprintf(os.Stderr, "GitHub MCP Server running on http://%s/mcp\n", cfg.Address)
+
+	// Wait for shutdown signal
+	select {
+	case <-ctx.Done():
+		logger.Info("shutting down server", "signal", "context done")
+		drainToolCalls(drain, cfg.ShutdownTimeout, logger)
+		cancelBase()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := httpServer.Shutdown(shutdownCtx); err != nil {
+			_ = httpServer.Close()
+			return fmt.Errorf("error shutting down server: %w", err)
+		}
+	case err := <-errC:
+		logger.Error("error running server", "error", err)
+		return fmt.Errorf("error running server: %w", err)
+	}
+
+	return nil
+}
+
+// newLogger creates the server logger, writing debug logs to the log file if one is configured and info logs to
+// stderr otherwise. Secrets are scrubbed with redactor from the logs and from the returned writer. The returned
+// function flushes and closes the log file.
+func newLogger(logFilePath string, redactor *redact.Redactor) (*slog.Logger, io.Writer, func(), error) {
+	var slogHandler slog.Handler
+	var logOutput io.Writer
+	closeLog := func() {}
+	if logFilePath != "" {
+		file, err := os.OpenFile(logFilePath, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0600)
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("failed to open log file: %w", err)
+		}
+		logOutput = file
+		slogHandler = slog.NewTextHandler(logOutput, &slog.HandlerOptions{Level: slog.LevelDebug})
+		closeLog = func() {
+			_ = file.Sync()
+			_ = file.Close()
+		}
+	} else {
+		logOutput = os.Stderr
+		slogHandler = slog.NewTextHandler(logOutput, &slog.HandlerOptions{Level: slog.LevelInfo})
+	}
+	return slog.New(redactor.Handler(slogHandler)), redactor.Writer(logOutput), closeLog, nil
+}
+
+// defaultShutdownTimeout is used when StdioServerConfig.ShutdownTimeout is not set.
+const defaultShutdownTimeout = 30 * time.Second
+
+// drainToolCalls stops accepting tool calls and waits, for at most timeout, for the in-flight ones to complete.
+func drainToolCalls(drain *middleware.Drain, timeout time.Duration, logger *slog.Logger) {
+	if timeout <= 0 {
+		timeout = defaultShutdownTimeout
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	if err := drain.Wait(ctx); err != nil {
+		logger.Warn("in-flight tool calls did not complete before the shutdown timeout", "timeout", timeout)
+		return
+	}
+	logger.Info("in-flight tool calls completed")
+}
+
+// newConfiguredServer creates the MCP server for the binary's configuration: it applies the translation
+// overrides and the rate limit, tracks tool calls with drain, scrubs secrets from tool results with redactor, and
+// forwards configuration reloads to the server.
+func newConfiguredServer(cfg StdioServerConfig, t translations.TranslationHelperFunc, logger *slog.Logger, redactor *redact.Redactor, serverMetrics *metrics.Metrics, drain *middleware.Drain, tokenProvider TokenProvider) (*server.MCPServer, error) {
+	baseTranslator := t
+	if len(cfg.TranslationOverrides) > 0 {
+		t = translations.WithOverrides(t, cfg.TranslationOverrides)
+	}
+
+	toolMiddlewares := []middleware.ToolMiddleware{drain.Middleware(), redactor.ToolMiddleware()}
+	var rateLimiter *middleware.RateLimiter
+	if cfg.RateLimit > 0 || cfg.Reloads != nil {
+		rateLimiter = middleware.NewRateLimiter(cfg.RateLimit, max(cfg.RateLimitBurst, 1))
+		toolMiddlewares = append(toolMiddlewares, rateLimiter.Middleware())
+	}
+
+	var toolConfigUpdates chan ToolConfig
+	if cfg.Reloads != nil {
+		toolConfigUpdates = make(chan ToolConfig)
+		go func() {
+			defer close(toolConfigUpdates)
+			for reload := range cfg.Reloads {
+				logger.Info("reloading configuration")
+				rateLimiter.SetLimit(reload.RateLimit, max(reload.RateLimitBurst, 1))
+				toolConfigUpdates <- ToolConfig{
+					EnabledToolsets: reload.EnabledToolsets,
+					EnabledTools:    reload.EnabledTools,
+					DeniedTools:     reload.DeniedTools,
+					Translator:      translations.WithOverrides(baseTranslator, reload.TranslationOverrides),
+				}
+			}
+		}()
+	}
+
+	ghServer, err := NewMCPServer(MCPServerConfig{
+		Version:           cfg.Version,
+		Host:              cfg.Host,
+		Token:             cfg.Token,
+		TokenProvider:     tokenProvider,
+		EnabledToolsets:   cfg.EnabledToolsets,
+		EnabledTools:      cfg.EnabledTools,
+		DeniedTools:       cfg.DeniedTools,
+		DynamicToolsets:   cfg.DynamicToolsets,
+		ReadOnly:          cfg.ReadOnly,
+		Translator:        t,
+		ContentWindowSize: cfg.ContentWindowSize,
+		LockdownMode:      cfg.LockdownMode,
+		RepoAccessTTL:     cfg.RepoAccessCacheTTL,
+		ToolMiddlewares:   toolMiddlewares,
+		ToolConfigUpdates: toolConfigUpdates,
+		Metrics:           serverMetrics,
+
+		MaxConcurrentCalls:      cfg.MaxConcurrentCalls,
+		MaxConcurrentMutations:  cfg.MaxConcurrentMutations,
+		ConcurrencyQueueTimeout: cfg.ConcurrencyQueueTimeout,
+		ToolTimeout:             cfg.ToolTimeout,
+		ToolTimeouts:            cfg.ToolTimeouts,
+		RelativeTimes:           cfg.RelativeTimes,
+		ProjectDefaultStatuses:  cfg.ProjectDefaultStatuses,
+		ProjectSLAPolicies:      cfg.ProjectSLAPolicies,
+	}, logger)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create MCP server: %w", err)
+	}
+	return ghServer, nil
+}
+
+type apiHost struct {
+	baseRESTURL *url.URL
+	graphqlURL  *url.URL
+	uploadURL   *url.URL
+	rawURL      *url.URL
+}
+
+func newDotcomHost() (apiHost, error) {
+	baseRestURL, err := url.Parse("https://api.github.com/")
+	if err != nil {
+		return apiHost{}, fmt.Errorf("failed to parse dotcom REST URL: %w", err)
+	}
+
+	gqlURL, err := url.Parse("https://api.github.com/graphql")
+	if err != nil {
+		return apiHost{}, fmt.Errorf("failed to parse dotcom GraphQL URL: %w", err)
+	}
+
+	uploadURL, err := url.Parse("https://uploads.github.com")
+	if err != nil {
+		return apiHost{}, fmt.Errorf("failed to parse dotcom Upload URL: %w", err)
+	}
+
+	rawURL, err := url.Parse("https://raw.githubusercontent.com/")
+	if err != nil {
+		return apiHost{}, fmt.Errorf("failed to parse dotcom Raw URL: %w", err)
+	}
+
+	return apiHost{
+		baseRESTURL: baseRestURL,
+		graphqlURL:  gqlURL,
+		uploadURL:   uploadURL,
+		rawURL:      rawURL,
+	}, nil
+}
+
+func newGHECHost(hostname string) (apiHost, error) {
+	u, err := url.Parse(hostname)
+	if err != nil {
+		return apiHost{}, fmt.Errorf("failed to parse GHEC URL: %w", err)
+	}
+
+	// Unsecured GHEC would be an error
+	if u.Scheme == "http" {
+		return apiHost{}, fmt.Errorf("GHEC URL must be HTTPS")
+	}
+
+	restURL, err := url.Parse(fmt.Sprintf("https://api.%s/", u.Hostname()))
+	if err != nil {
+		return apiHost{}, fmt.Errorf("failed to parse GHEC REST URL: %w", err)
+	}
+
+	gqlURL, err := url.Parse(fmt.Sprintf("https://api.%s/graphql", u.Hostname()))
+	if err != nil {
+		return apiHost{}, fmt.Errorf("failed to parse GHEC GraphQL URL: %w", err)
+	}
+
+	uploadURL, err := url.Parse(fmt.Sprintf("https://uploads.%s", u.Hostname()))
+	if err != nil {
+		return apiHost{}, fmt.Errorf("failed to parse GHEC Upload URL: %w", err)
+	}
+
+	rawURL, err := url.Parse(fmt.Sprintf("https://raw.%s/", u.Hostname()))
+	if err != nil {
+		return apiHost{}, fmt.Errorf("failed to parse GHEC Raw URL: %w", err)
+	}
+
+	return apiHost{
+		baseRESTURL: restURL,
+		graphqlURL:  gqlURL,
+		uploadURL:   uploadURL,
+		rawURL:      rawURL,
+	}, nil
+}
+
+func newGHESHost(hostname string) (apiHost, error) {
+	u, err := url.Parse(hostname)
+	if err != nil {
+		return apiHost{}, fmt.Errorf("failed to parse GHES URL: %w", err)
+	}
+
+	restURL, err := url.Parse(fmt.Sprintf("%s://%s/api/v3/", u.Scheme, u.Hostname()))
+	if err != nil {
+		return apiHost{}, fmt.Errorf("failed to parse GHES REST URL: %w", err)
+	}
+
+	gqlURL, err := url.Parse(fmt.Sprintf("%s://%s/api/graphql", u.Scheme, u.Hostname()))
+	if err != nil {
+		return apiHost{}, fmt.Errorf("failed to parse GHES GraphQL URL: %w", err)
+	}
+
+	// Check if subdomain isolation is enabled
+	// See https://docs.github.com/en/enterprise-server@3.17/admin/configuring-settings/hardening-security-for-your-enterprise/enabling-subdomain-isolation#about-subdomain-isolation
+	hasSubdomainIsolation := checkSubdomainIsolation(u.Scheme, u.Hostname())
+
+	var uploadURL *url.URL
+	if hasSubdomainIsolation {
+		// With subdomain isolation: https://uploads.hostname/
+		uploadURL, err = url.Parse(fmt.Sprintf("%s://uploads.%s/", u.Scheme, u.Hostname()))
+	} else {
+		// Without subdomain isolation: https://hostname/api/uploads/
+		uploadURL, err = url.Parse(fmt.Sprintf("%s://%s/api/uploads/", u.Scheme, u.Hostname()))
+	}
+	if err != nil {
+		return apiHost{}, fmt.Errorf("failed to parse GHES Upload URL: %w", err)
+	}
+
+	var rawURL *url.URL
+	if hasSubdomainIsolation {
+		// With subdomain isolation: https://raw.hostname/
+		rawURL, err = url.Parse(fmt.Sprintf("%s://raw.%s/", u.Scheme, u.Hostname()))
+	} else {
+		// Without subdomain isolation: https://hostname/raw/
+		rawURL, err = url.Parse(fmt.Sprintf("%s://%s/raw/", u.Scheme, u.Hostname()))
+	}
+	if err != nil {
+		return apiHost{}, fmt.Errorf("failed to parse GHES Raw URL: %w", err)
+	}
+
+	return apiHost{
+		baseRESTURL: restURL,
+		graphqlURL:  gqlURL,
+		uploadURL:   uploadURL,
+		rawURL:      rawURL,
+	}, nil
+}
+
+// checkSubdomainIsolation detects if GitHub Enterprise Server has subdomain isolation enabled
+// by attempting to ping the raw.<host>/_ping endpoint on the subdomain. The raw subdomain must always exist for subdomain isolation.
+func checkSubdomainIsolation(scheme, hostname string) bool {
+	subdomainURL := fmt.Sprintf("%s://raw.%s/_ping", scheme, hostname)
+
+	client := &http.Client{
+		Timeout: 5 * time.Second,
+		// Don't follow redirects - we just want to check if the endpoint exists
+		//nolint:revive // parameters are required by http.Client.CheckRedirect signature
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+
+	resp, err := client.Get(subdomainURL)
+	if err != nil {
+		return false
+	}
+	defer resp.Body.Close()
+
+	return resp.StatusCode == http.StatusOK
+}
+
+// Note that this does not handle ports yet, so development environments are out.
+func parseAPIHost(s string) (apiHost, error) {
+	if s == "" {
+		return newDotcomHost()
+	}
+
+	u, err := url.Parse(s)
+	if err != nil {
+		return apiHost{}, fmt.Errorf("could not parse host as URL: %s", s)
+	}
+
+	if u.Scheme == "" {
+		return apiHost{}, fmt.Errorf("host must have a scheme (http or https): %s", s)
+	}
+
+	if strings.HasSuffix(u.Hostname(), "github.com") {
+		return newDotcomHost()
+	}
+
+	if strings.HasSuffix(u.Hostname(), "ghe.com") {
+		return newGHECHost(s)
+	}
+
+	return newGHESHost(s)
+}
+
+// userAgentTransport sets the User-Agent of every request to agent, followed by the name and version of the MCP
+// client whose session made the request, when the session recorded them on initialize. The clients are shared by
+// all sessions, so the client info is read from the request context rather than stored in them.
+type userAgentTransport struct {
+	transport http.RoundTripper
+	agent     string
+}
+
+func (t *userAgentTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	agent := t.agent
+	if session, ok := server.ClientSessionFromContext(req.Context()).(server.SessionWithClientInfo); ok {
+		if info := session.GetClientInfo(); info.Name != "" {
+			agent = fmt.Sprintf("%s (%s/%s)", agent, info.Name, info.Version)
+		}
+	}
+	req = req.Clone(req.Context())
+	req.Header.Set("User-Agent", agent)
+	return t.transport.RoundTrip(req)
+}
+
+type bearerAuthTransport struct {
+	transport     http.RoundTripper
+	tokenProvider TokenProvider
+}
+
+func (t *bearerAuthTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	token, err := t.tokenProvider(req.Context())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get GitHub token: %w", err)
+	}
+	req = req.Clone(req.Context())
+	req.Header.Set("Authorization", "Bearer "+token)
+	return t.transport.RoundTrip(req)
+}
@@ -0,0 +1,147 @@
+package ghmcp
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/mark3labs/mcp-go/client"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewMCPServer_ToolConfigUpdates(t *testing.T) {
+	updates := make(chan ToolConfig)
+	defer close(updates)
+
+	s, err := NewMCPServer(MCPServerConfig{
+		Token:             "test-token",
+		EnabledToolsets:   []string{"gists"},
+		Translator:        translations.NullTranslationHelper,
+		ContentWindowSize: 5000,
+		ToolConfigUpdates: updates,
+	}, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"create_gist", "get_gist", "list_gists", "update_gist"}, listToolNames(t, s))
+
+	updates <- ToolConfig{
+		EnabledToolsets: []string{"gists"},
+		EnabledTools:    []string{"get_me"},
+		DeniedTools:     []string{"create_gist", "update_gist"},
+	}
+	assert.Eventually(t, func() bool {
+		names := listToolNames(t, s)
+		return assert.ObjectsAreEqual([]string{"get_gist", "get_me", "list_gists"}, names)
+	}, time.Second, 10*time.Millisecond)
+}
+
+func TestNewMCPServer_ToolConfigUpdatesAllowDeniedTool(t *testing.T) {
+	updates := make(chan ToolConfig)
+	defer close(updates)
+
+	s, err := NewMCPServer(MCPServerConfig{
+		Token:             "test-token",
+		EnabledToolsets:   []string{"gists"},
+		DeniedTools:       []string{"list_gists"},
+		Translator:        translations.NullTranslationHelper,
+		ContentWindowSize: 5000,
+		ToolConfigUpdates: updates,
+	}, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	require.NoError(t, err)
+	assert.NotContains(t, listToolNames(t, s), "list_gists")
+
+	updates <- ToolConfig{EnabledToolsets: []string{"gists"}}
+	require.Eventually(t, func() bool {
+		return assert.ObjectsAreEqual([]string{"create_gist", "get_gist", "list_gists", "update_gist"}, listToolNames(t, s))
+	}, time.Second, 10*time.Millisecond)
+
+	// The tool was denied at startup, yet its arguments are still validated once it is allowed.
+	c, err := client.NewInProcessClient(s)
+	require.NoError(t, err)
+	defer func() { _ = c.Close() }()
+	request := mcp.InitializeRequest{}
+	request.Params.ProtocolVersion = mcp.LATEST_PROTOCOL_VERSION
+	_, err = c.Initialize(context.Background(), request)
+	require.NoError(t, err)
+	call := mcp.CallToolRequest{}
+	call.Params.Name = "list_gists"
+	call.Params.Arguments = map[string]any{"perPage": 500}
+	result, err := c.CallTool(context.Background(), call)
+	require.NoError(t, err)
+	require.True(t, result.IsError)
+	assert.Contains(t, result.Content[0].(mcp.TextContent).Text, "INVALID_INPUT")
+}
+
+func TestNewMCPServer_HTTPSessions(t *testing.T) {
+	s, err := NewMCPServer(MCPServerConfig{
+		Version: "test",
+		// Failing to get a token stops API requests in the transport, after the clients have been used.
+		TokenProvider:     func(context.Context) (string, error) { return "", fmt.Errorf("no token") },
+		EnabledToolsets:   []string{"gists"},
+		Translator:        translations.NullTranslationHelper,
+		ContentWindowSize: 5000,
+	}, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	require.NoError(t, err)
+	httpServer := httptest.NewServer(server.NewStreamableHTTPServer(s))
+	defer httpServer.Close()
+
+	// Sessions initialize and call tools concurrently with the clients they share, which must not be modified per
+	// session.
+	var wg sync.WaitGroup
+	for i := range 2 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			c, err := client.NewStreamableHttpClient(httpServer.URL)
+			if !assert.NoError(t, err) {
+				return
+			}
+			defer func() { _ = c.Close() }()
+			request := mcp.InitializeRequest{}
+			request.Params.ProtocolVersion = mcp.LATEST_PROTOCOL_VERSION
+			request.Params.ClientInfo = mcp.Implementation{Name: fmt.Sprintf("client-%d", i), Version: "1.0"}
+			if _, err := c.Initialize(context.Background(), request); !assert.NoError(t, err) {
+				return
+			}
+			call := mcp.CallToolRequest{}
+			call.Params.Name = "list_gists"
+			_, err = c.CallTool(context.Background(), call)
+			assert.ErrorContains(t, err, "failed to get GitHub token")
+		}()
+	}
+	wg.Wait()
+}
+
+func TestUserAgentTransport(t *testing.T) {
+	var userAgent string
+	upstream := httptest.NewServer(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		userAgent = r.Header.Get("User-Agent")
+	}))
+	defer upstream.Close()
+	httpClient := &http.Client{Transport: &userAgentTransport{transport: http.DefaultTransport, agent: "github-mcp-server/1.0"}}
+	get := func(ctx context.Context) string {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, upstream.URL, nil)
+		require.NoError(t, err)
+		resp, err := httpClient.Do(req)
+		require.NoError(t, err)
+		_ = resp.Body.Close()
+		return userAgent
+	}
+
+	assert.Equal(t, "github-mcp-server/1.0", get(context.Background()))
+
+	session := server.NewInProcessSession("session-1", nil)
+	ctx := server.NewMCPServer("test", "1.0").WithContext(context.Background(), session)
+	assert.Equal(t, "github-mcp-server/1.0", get(ctx), "the session has not initialized yet")
+	session.SetClientInfo(mcp.Implementation{Name: "vscode", Version: "1.99"})
+	assert.Equal(t, "github-mcp-server/1.0 (vscode/1.99)", get(ctx))
+}
@@ -0,0 +1,72 @@
+package ghmcp
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/github/github-mcp-server/pkg/errors"
+)
+
+type tokenContextKey struct{}
+
+// ContextWithToken returns a context whose GitHub API requests are authenticated with token instead of the
+// server-wide token, when the server uses SessionTokenProvider.
+func ContextWithToken(ctx context.Context, token string) context.Context {
+	return context.WithValue(ctx, tokenContextKey{}, token)
+}
+
+// TokenFromContext returns the token stored by ContextWithToken, if any.
+func TokenFromContext(ctx context.Context) (string, bool) {
+	token, ok := ctx.Value(tokenContextKey{}).(string)
+	return token, ok && token != ""
+}
+
+// SessionTokenProvider returns a TokenProvider that uses the token stored in the request context by
+// ContextWithToken, so that each client can act with its own permissions. Requests without a token in their
+// context use fallback, and fail if it is empty.
+func SessionTokenProvider(fallback string) TokenProvider {
+	return func(ctx context.Context) (string, error) {
+		if token, ok := TokenFromContext(ctx); ok {
+			return token, nil
+		}
+		if fallback == "" {
+			return "", fmt.Errorf("no GitHub token: send one in the Authorization header")
+		}
+		return fallback, nil
+	}
+}
+
+// sessionTokenContext is the context function of the HTTP transport. It stores the token of each request's
+// Authorization header in the context for SessionTokenProvider, where the redactor also finds it to scrub it from
+// the logs and tool results of the request.
+func sessionTokenContext(ctx context.Context, r *http.Request) context.Context {
+	if token := bearerToken(r); token != "" {
+		ctx = ContextWithToken(ctx, token)
+	}
+	// enable GitHub errors in the context
+	return errors.ContextWithGitHubErrors(ctx)
+}
+
+// bearerToken returns the token of a request's "Authorization: Bearer" or "Authorization: token" header.
+func bearerToken(r *http.Request) string {
+	scheme, token, ok := strings.Cut(r.Header.Get("Authorization"), " ")
+	if !ok || (!strings.EqualFold(scheme, "Bearer") && !strings.EqualFold(scheme, "token")) {
+		return ""
+	}
+	return strings.TrimSpace(token)
+}
+
+// requireToken rejects requests without an Authorization header with 401 Unauthorized. It is used when the server
+// has no token of its own to fall back to.
+func requireToken(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if bearerToken(r) == "" {
+			w.Header().Set("WWW-Authenticate", `Bearer realm="github-mcp-server"`)
+			http.Error(w, "a GitHub token is required in the Authorization header", http.StatusUnauthorized)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,91 @@
+package ghmcp
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/github/github-mcp-server/pkg/redact"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSessionTokenProvider(t *testing.T) {
+	ctx := context.Background()
+
+	token, err := SessionTokenProvider("server-token")(ContextWithToken(ctx, "session-token"))
+	require.NoError(t, err)
+	assert.Equal(t, "session-token", token)
+
+	token, err = SessionTokenProvider("server-token")(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "server-token", token)
+
+	_, err = SessionTokenProvider("")(ctx)
+	require.ErrorContains(t, err, "no GitHub token")
+}
+
+func TestSessionTokenContext(t *testing.T) {
+	// A token of no known shape is only scrubbed because the redactor finds it in the request's context.
+	sessionToken := "opaque-session-credential"
+	redactor := redact.New("server-token").WithContextSecret(TokenFromContext)
+	r := httptest.NewRequest(http.MethodPost, "/mcp", nil)
+	r.Header.Set("Authorization", "Bearer "+sessionToken)
+
+	ctx := sessionTokenContext(context.Background(), r)
+	token, ok := TokenFromContext(ctx)
+	require.True(t, ok)
+	assert.Equal(t, sessionToken, token)
+
+	handler := redactor.ToolMiddleware()(func(_ context.Context, _ mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return mcp.NewToolResultError("401 Bad credentials for " + sessionToken), nil
+	})
+	result, err := handler(ctx, mcp.CallToolRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, "401 Bad credentials for [REDACTED]", result.Content[0].(mcp.TextContent).Text)
+
+	var buf bytes.Buffer
+	slog.New(redactor.Handler(slog.NewTextHandler(&buf, nil))).InfoContext(ctx, "request", "authorization", sessionToken)
+	assert.NotContains(t, buf.String(), sessionToken)
+}
+
+func TestBearerToken(t *testing.T) {
+	tests := []struct {
+		header   string
+		expected string
+	}{
+		{header: "Bearer ghp_abc", expected: "ghp_abc"},
+		{header: "bearer ghp_abc", expected: "ghp_abc"},
+		{header: "token ghp_abc", expected: "ghp_abc"},
+		{header: "Basic dXNlcjpwYXNz", expected: ""},
+		{header: "ghp_abc", expected: ""},
+		{header: "", expected: ""},
+	}
+
+	for _, tc := range tests {
+		r := httptest.NewRequest(http.MethodPost, "/mcp", nil)
+		r.Header.Set("Authorization", tc.header)
+		assert.Equal(t, tc.expected, bearerToken(r), tc.header)
+	}
+}
+
+func TestRequireToken(t *testing.T) {
+	handler := requireToken(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/mcp", nil))
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	assert.Contains(t, rec.Header().Get("WWW-Authenticate"), "Bearer")
+
+	rec = httptest.NewRecorder()
+	r := httptest.NewRequest(http.MethodPost, "/mcp", nil)
+	r.Header.Set("Authorization", "Bearer ghp_abc")
+	handler.ServeHTTP(rec, r)
+	assert.Equal(t, http.StatusOK, rec.Code)
+}
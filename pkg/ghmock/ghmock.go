@@ -0,0 +1,366 @@
+// Package ghmock provides a programmable fake of the GitHub REST and GraphQL APIs for behavior tests of tools, both
+// the built-in tools and those of programs that embed the server. A Server records every request it receives, so
+// tests can assert which endpoints a tool called and which queries and mutations it sent, not only what it returned.
+//
+//	gh := ghmock.New(t)
+//	gh.Respond("DELETE /orgs/{org}/projectsV2/{project}/items/{item}", http.StatusNoContent, nil)
+//	_, handler := github.DeleteProjectItem(gh.GetClient(), translations.NullTranslationHelper)
+//	result := ghmock.CallTool(t, handler, map[string]any{
+//		"owner": "octo-org", "owner_type": "org", "project_number": 7.0, "item_id": 9001.0,
+//	})
+//	gh.AssertRequested("DELETE /orgs/octo-org/projectsV2/7/items/9001")
+package ghmock
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/github/github-mcp-server/pkg/raw"
+	gogithub "github.com/google/go-github/v79/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/shurcooL/githubv4"
+)
+
+// graphQLPath is where the Server serves the GraphQL API.
+const graphQLPath = "/graphql"
+
+// rawPrefix is where the Server serves raw file contents, as raw.githubusercontent.com does.
+const rawPrefix = "/raw/"
+
+// Request is a request received by a Server.
+type Request struct {
+	Method string
+	Path   string
+	Query  url.Values
+	Header http.Header
+	Body   []byte
+
+	// GraphQLQuery and Variables are set for GraphQL requests.
+	GraphQLQuery string
+	Variables    map[string]any
+}
+
+// IsGraphQL reports whether r is a GraphQL query or mutation.
+func (r Request) IsGraphQL() bool {
+	return r.GraphQLQuery != ""
+}
+
+// IsMutation reports whether r is a GraphQL mutation.
+func (r Request) IsMutation() bool {
+	return strings.HasPrefix(strings.TrimSpace(r.GraphQLQuery), "mutation")
+}
+
+// String returns the method and path of a REST request, or the query of a GraphQL request.
+func (r Request) String() string {
+	if r.IsGraphQL() {
+		return "GraphQL " + strings.Join(strings.Fields(r.GraphQLQuery), " ")
+	}
+	return r.Method + " " + r.Path
+}
+
+// DecodeBody decodes the JSON body of r into v.
+func (r Request) DecodeBody(v any) error {
+	return json.Unmarshal(r.Body, v)
+}
+
+// GraphQLHandler answers a GraphQL request. It returns the data of the response, or errors to return instead.
+type GraphQLHandler func(query string, variables map[string]any) (data any, errs []string)
+
+type graphQLRoute struct {
+	match   string
+	handler GraphQLHandler
+}
+
+// Server is a fake GitHub API server. Create it with New; it is closed when the test ends.
+type Server struct {
+	t      testing.TB
+	server *httptest.Server
+	rest   *http.ServeMux
+
+	mu       sync.Mutex
+	graphQL  []graphQLRoute
+	requests []Request
+}
+
+// New starts a Server for the test. Requests that no handler matches fail the test and receive a 404 response.
+func New(t testing.TB) *Server {
+	t.Helper()
+	s := &Server{t: t, rest: http.NewServeMux()}
+	s.server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	t.Cleanup(s.server.Close)
+	return s
+}
+
+// URL returns the base URL of the Server.
+func (s *Server) URL() string {
+	return s.server.URL
+}
+
+// HandleFunc registers a handler for REST requests matching pattern, a net/http.ServeMux pattern such as
+// "GET /repos/{owner}/{repo}/issues/{number}". Raw file contents are served under /raw/, e.g.
+// "GET /raw/{owner}/{repo}/HEAD/README.md".
+func (s *Server) HandleFunc(pattern string, handler http.HandlerFunc) {
+	s.rest.HandleFunc(pattern, handler)
+}
+
+// Respond registers a REST handler that responds to requests matching pattern with status and body. The body is
+// encoded as JSON, except for strings and byte slices, which are sent as they are.
+func (s *Server) Respond(pattern string, status int, body any) {
+	s.HandleFunc(pattern, func(w http.ResponseWriter, _ *http.Request) {
+		writeBody(s.t, w, status, body)
+	})
+}
+
+// HandleGraphQL registers a handler for GraphQL requests whose query contains match, e.g. "createIssue(" or
+// "repository(owner:$owner". Handlers are tried in the order they were registered.
+func (s *Server) HandleGraphQL(match string, handler GraphQLHandler) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.graphQL = append(s.graphQL, graphQLRoute{match: match, handler: handler})
+}
+
+// RespondGraphQL registers a handler that responds to GraphQL requests whose query contains match with data.
+func (s *Server) RespondGraphQL(match string, data any) {
+	s.HandleGraphQL(match, func(string, map[string]any) (any, []string) {
+		return data, nil
+	})
+}
+
+// RespondGraphQLError registers a handler that responds to GraphQL requests whose query contains match with an
+// error, as GitHub does for example when a node cannot be resolved.
+func (s *Server) RespondGraphQLError(match string, message string) {
+	s.HandleGraphQL(match, func(string, map[string]any) (any, []string) {
+		return nil, []string{message}
+	})
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		s.t.Errorf("ghmock: failed to read request body: %v", err)
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	r.Body = io.NopCloser(bytes.NewReader(body))
+
+	request := Request{
+		Method: r.Method,
+		Path:   r.URL.Path,
+		Query:  r.URL.Query(),
+		Header: r.Header.Clone(),
+		Body:   body,
+	}
+	if r.Method == http.MethodPost && r.URL.Path == graphQLPath {
+		var payload struct {
+			Query     string         `json:"query"`
+			Variables map[string]any `json:"variables"`
+		}
+		if err := json.Unmarshal(body, &payload); err != nil {
+			s.t.Errorf("ghmock: invalid GraphQL request: %v", err)
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		request.GraphQLQuery, request.Variables = payload.Query, payload.Variables
+	}
+
+	s.mu.Lock()
+	s.requests = append(s.requests, request)
+	routes := s.graphQL
+	s.mu.Unlock()
+
+	if request.IsGraphQL() {
+		for _, route := range routes {
+			if strings.Contains(request.GraphQLQuery, route.match) {
+				data, errs := route.handler(request.GraphQLQuery, request.Variables)
+				writeGraphQL(s.t, w, data, errs)
+				return
+			}
+		}
+		s.t.Errorf("ghmock: unexpected GraphQL request: %s", request.GraphQLQuery)
+		writeGraphQL(s.t, w, nil, []string{"ghmock: no handler for this query"})
+		return
+	}
+
+	if _, pattern := s.rest.Handler(r); pattern == "" {
+		s.t.Errorf("ghmock: unexpected request: %s %s", r.Method, r.URL.Path)
+		writeBody(s.t, w, http.StatusNotFound, map[string]string{"message": "Not Found"})
+		return
+	}
+	s.rest.ServeHTTP(w, r)
+}
+
+func writeBody(t testing.TB, w http.ResponseWriter, status int, body any) {
+	var b []byte
+	switch v := body.(type) {
+	case nil:
+	case string:
+		b = []byte(v)
+	case []byte:
+		b = v
+	default:
+		var err error
+		if b, err = json.Marshal(v); err != nil {
+			t.Errorf("ghmock: failed to encode response body: %v", err)
+		}
+		w.Header().Set("Content-Type", "application/json")
+	}
+	w.WriteHeader(status)
+	_, _ = w.Write(b)
+}
+
+func writeGraphQL(t testing.TB, w http.ResponseWriter, data any, errs []string) {
+	response := map[string]any{"data": data}
+	if len(errs) > 0 {
+		messages := make([]map[string]string, len(errs))
+		for i, message := range errs {
+			messages[i] = map[string]string{"message": message}
+		}
+		response["errors"] = messages
+	}
+	writeBody(t, w, http.StatusOK, response)
+}
+
+// Requests returns the requests received so far, in order.
+func (s *Server) Requests() []Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]Request(nil), s.requests...)
+}
+
+// Mutations returns the GraphQL mutations received so far, in order.
+func (s *Server) Mutations() []Request {
+	var mutations []Request
+	for _, r := range s.Requests() {
+		if r.IsMutation() {
+			mutations = append(mutations, r)
+		}
+	}
+	return mutations
+}
+
+// AssertRequested fails the test unless the Server received a REST request for methodAndPath, e.g.
+// "PATCH /repos/octo/repo/issues/1", and returns the last such request.
+func (s *Server) AssertRequested(methodAndPath string) Request {
+	s.t.Helper()
+	method, path, _ := strings.Cut(methodAndPath, " ")
+	requests := s.Requests()
+	for i := len(requests) - 1; i >= 0; i-- {
+		if requests[i].Method == method && requests[i].Path == path {
+			return requests[i]
+		}
+	}
+	s.t.Errorf("ghmock: expected a %s request, got: %s", methodAndPath, summarize(requests))
+	return Request{}
+}
+
+// AssertNotRequested fails the test if the Server received a REST request with the given method, e.g. to check that
+// a read-only tool made no changes. An empty method matches any write method.
+func (s *Server) AssertNotRequested(method string) {
+	s.t.Helper()
+	for _, r := range s.Requests() {
+		write := r.Method != http.MethodGet && r.Method != http.MethodHead && !r.IsGraphQL()
+		if r.Method == method || (method == "" && (write || r.IsMutation())) {
+			s.t.Errorf("ghmock: unexpected %s request to %s", r.Method, r.Path)
+		}
+	}
+}
+
+// AssertGraphQL fails the test unless the Server received a GraphQL request whose query contains match, and
+// returns the last such request, whose Variables hold the variables and mutation input.
+func (s *Server) AssertGraphQL(match string) Request {
+	s.t.Helper()
+	requests := s.Requests()
+	for i := len(requests) - 1; i >= 0; i-- {
+		if requests[i].IsGraphQL() && strings.Contains(requests[i].GraphQLQuery, match) {
+			return requests[i]
+		}
+	}
+	s.t.Errorf("ghmock: expected a GraphQL request containing %q, got: %s", match, summarize(requests))
+	return Request{}
+}
+
+func summarize(requests []Request) string {
+	if len(requests) == 0 {
+		return "no requests"
+	}
+	parts := make([]string, len(requests))
+	for i, r := range requests {
+		parts[i] = r.String()
+	}
+	return strings.Join(parts, ", ")
+}
+
+// RESTClient returns a REST client for the Server.
+func (s *Server) RESTClient() *gogithub.Client {
+	client := gogithub.NewClient(s.server.Client())
+	baseURL, _ := url.Parse(s.URL() + "/")
+	client.BaseURL = baseURL
+	client.UploadURL = baseURL
+	return client
+}
+
+// GraphQLClient returns a GraphQL client for the Server.
+func (s *Server) GraphQLClient() *githubv4.Client {
+	return githubv4.NewEnterpriseClient(s.URL()+graphQLPath, s.server.Client())
+}
+
+// RawClient returns a client for raw file contents served by the Server.
+func (s *Server) RawClient() *raw.Client {
+	rawURL, _ := url.Parse(s.URL() + rawPrefix)
+	return raw.NewClient(s.RESTClient(), rawURL)
+}
+
+// GetClient returns a function that can be passed to tool constructors as their github.GetClientFn.
+func (s *Server) GetClient() func(context.Context) (*gogithub.Client, error) {
+	client := s.RESTClient()
+	return func(context.Context) (*gogithub.Client, error) { return client, nil }
+}
+
+// GetGQLClient returns a function that can be passed to tool constructors as their github.GetGQLClientFn.
+func (s *Server) GetGQLClient() func(context.Context) (*githubv4.Client, error) {
+	client := s.GraphQLClient()
+	return func(context.Context) (*githubv4.Client, error) { return client, nil }
+}
+
+// GetRawClient returns a raw.GetRawClientFn for tool constructors.
+func (s *Server) GetRawClient() raw.GetRawClientFn {
+	client := s.RawClient()
+	return func(context.Context) (*raw.Client, error) { return client, nil }
+}
+
+// CallTool calls a tool handler with args and returns its result, failing the test if the handler returns an error.
+func CallTool(t testing.TB, handler server.ToolHandlerFunc, args map[string]any) *mcp.CallToolResult {
+	t.Helper()
+	request := mcp.CallToolRequest{}
+	request.Params.Arguments = args
+	result, err := handler(context.Background(), request)
+	if err != nil {
+		t.Fatalf("ghmock: tool handler failed: %v", err)
+	}
+	if result == nil {
+		t.Fatalf("ghmock: tool handler returned no result")
+	}
+	return result
+}
+
+// ResultText returns the text of a tool result with a single text content, failing the test otherwise.
+func ResultText(t testing.TB, result *mcp.CallToolResult) string {
+	t.Helper()
+	if len(result.Content) != 1 {
+		t.Fatalf("ghmock: expected one content item, got %d", len(result.Content))
+	}
+	text, ok := result.Content[0].(mcp.TextContent)
+	if !ok {
+		t.Fatalf("ghmock: expected text content, got %T", result.Content[0])
+	}
+	return text.Text
+}
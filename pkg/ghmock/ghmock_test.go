@@ -0,0 +1,140 @@
+package ghmock
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	gogithub "github.com/google/go-github/v79/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/shurcooL/githubv4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// recordingTB records the failures of a Server instead of failing the test.
+type recordingTB struct {
+	testing.TB
+	errors []string
+}
+
+func (r *recordingTB) Errorf(format string, args ...any) {
+	r.errors = append(r.errors, fmt.Sprintf(format, args...))
+}
+
+func TestServer_REST(t *testing.T) {
+	gh := New(t)
+	gh.Respond("GET /repos/{owner}/{repo}/issues/{number}", http.StatusOK, map[string]any{"number": 42, "title": "Bug"})
+	gh.Respond("PATCH /repos/{owner}/{repo}/issues/{number}", http.StatusOK, map[string]any{"number": 42, "state": "closed"})
+
+	client := gh.RESTClient()
+	issue, _, err := client.Issues.Get(context.Background(), "octo", "repo", 42)
+	require.NoError(t, err)
+	assert.Equal(t, "Bug", issue.GetTitle())
+
+	_, _, err = client.Issues.Edit(context.Background(), "octo", "repo", 42, &gogithub.IssueRequest{State: gogithub.Ptr("closed")})
+	require.NoError(t, err)
+
+	edit := gh.AssertRequested("PATCH /repos/octo/repo/issues/42")
+	var body map[string]any
+	require.NoError(t, edit.DecodeBody(&body))
+	assert.Equal(t, map[string]any{"state": "closed"}, body)
+	assert.Len(t, gh.Requests(), 2)
+}
+
+func TestServer_GraphQL(t *testing.T) {
+	gh := New(t)
+	gh.RespondGraphQL("repository(", map[string]any{
+		"repository": map[string]any{"name": "repo"},
+	})
+	gh.HandleGraphQL("addStar(", func(_ string, variables map[string]any) (any, []string) {
+		input := variables["input"].(map[string]any)
+		return map[string]any{"addStar": map[string]any{"clientMutationId": input["starrableId"]}}, nil
+	})
+
+	client := gh.GraphQLClient()
+	var query struct {
+		Repository struct {
+			Name githubv4.String
+		} `graphql:"repository(owner: $owner, name: $name)"`
+	}
+	err := client.Query(context.Background(), &query, map[string]any{
+		"owner": githubv4.String("octo"),
+		"name":  githubv4.String("repo"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "repo", string(query.Repository.Name))
+
+	var mutation struct {
+		AddStar struct {
+			ClientMutationID githubv4.String
+		} `graphql:"addStar(input: $input)"`
+	}
+	err = client.Mutate(context.Background(), &mutation, githubv4.AddStarInput{StarrableID: githubv4.ID("R_1")}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "R_1", string(mutation.AddStar.ClientMutationID))
+
+	request := gh.AssertGraphQL("repository(")
+	assert.Equal(t, "octo", request.Variables["owner"])
+	require.Len(t, gh.Mutations(), 1)
+	assert.Equal(t, map[string]any{"starrableId": "R_1"}, gh.Mutations()[0].Variables["input"])
+}
+
+func TestServer_GraphQLError(t *testing.T) {
+	gh := New(t)
+	gh.RespondGraphQLError("repository(", "Could not resolve to a Repository with the name 'octo/missing'.")
+
+	var query struct {
+		Repository struct {
+			Name githubv4.String
+		} `graphql:"repository(owner: \"octo\", name: \"missing\")"`
+	}
+	err := gh.GraphQLClient().Query(context.Background(), &query, nil)
+	require.ErrorContains(t, err, "Could not resolve to a Repository")
+}
+
+func TestServer_Raw(t *testing.T) {
+	gh := New(t)
+	gh.Respond("GET /raw/{owner}/{repo}/HEAD/README.md", http.StatusOK, "# Hello")
+
+	resp, err := gh.RawClient().GetRawContent(context.Background(), "octo", "repo", "README.md", nil)
+	require.NoError(t, err)
+	defer func() { _ = resp.Body.Close() }()
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	assert.Equal(t, "# Hello", string(body))
+}
+
+func TestServer_UnexpectedRequests(t *testing.T) {
+	tb := &recordingTB{TB: t}
+	gh := New(tb)
+
+	_, resp, err := gh.RESTClient().Issues.Get(context.Background(), "octo", "repo", 1)
+	require.Error(t, err)
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+
+	var query struct {
+		Viewer struct {
+			Login githubv4.String
+		}
+	}
+	require.Error(t, gh.GraphQLClient().Query(context.Background(), &query, nil))
+
+	gh.AssertRequested("DELETE /repos/octo/repo")
+	gh.AssertNotRequested(http.MethodGet)
+	require.Len(t, tb.errors, 4)
+	assert.Contains(t, tb.errors[0], "unexpected request: GET /repos/octo/repo/issues/1")
+	assert.Contains(t, tb.errors[1], "unexpected GraphQL request")
+	assert.Contains(t, tb.errors[2], "expected a DELETE /repos/octo/repo request, got: GET /repos/octo/repo/issues/1, GraphQL {viewer{login}}")
+	assert.Contains(t, tb.errors[3], "unexpected GET request to /repos/octo/repo/issues/1")
+}
+
+func TestCallTool(t *testing.T) {
+	handler := func(_ context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return mcp.NewToolResultText(fmt.Sprintf("hello %s", request.GetArguments()["name"])), nil
+	}
+	result := CallTool(t, handler, map[string]any{"name": "octocat"})
+	assert.Equal(t, "hello octocat", ResultText(t, result))
+}
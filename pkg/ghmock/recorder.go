@@ -0,0 +1,294 @@
+package ghmock
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/github/github-mcp-server/pkg/redact"
+	gogithub "github.com/google/go-github/v79/github"
+	"github.com/shurcooL/githubv4"
+)
+
+// Mode selects whether a Recorder records exchanges with GitHub or replays them from a fixture.
+type Mode int
+
+const (
+	// ModeReplay serves responses from the fixture and fails the test on requests it does not contain.
+	ModeReplay Mode = iota
+	// ModeRecord sends requests to GitHub and saves the exchanges to the fixture when the test ends.
+	ModeRecord
+)
+
+// recordEnv enables ModeRecord for recorders created without WithMode.
+const recordEnv = "GHMOCK_RECORD"
+
+// tokenEnv holds the token recorders authenticate with in ModeRecord.
+const tokenEnv = "GITHUB_PERSONAL_ACCESS_TOKEN"
+
+// recordedHeaders are the response headers kept in fixtures; the others are dropped as noise or as potentially
+// sensitive.
+var recordedHeaders = []string{"Content-Type", "Link", "Location"}
+
+// Interaction is a recorded request and its response.
+type Interaction struct {
+	Request  RecordedRequest  `json:"request"`
+	Response RecordedResponse `json:"response"`
+}
+
+// RecordedRequest is the part of a request that replayed requests are matched on.
+type RecordedRequest struct {
+	Method string `json:"method"`
+	URL    string `json:"url"`
+	Body   string `json:"body,omitempty"`
+}
+
+// RecordedResponse is a recorded response.
+type RecordedResponse struct {
+	Status  int               `json:"status"`
+	Headers map[string]string `json:"headers,omitempty"`
+	Body    string            `json:"body"`
+}
+
+type fixture struct {
+	Interactions []Interaction `json:"interactions"`
+}
+
+// Recorder is an http.RoundTripper that records the REST and GraphQL exchanges of a test into a fixture file, or
+// replays them from it, so that tests of complex queries can run against real GitHub responses without network
+// access or a token. Recorded requests and responses are scrubbed of the token and other secrets, and response
+// headers other than Content-Type, Link and Location are dropped.
+//
+// Record a fixture by running the test with GHMOCK_RECORD=1 and a GITHUB_PERSONAL_ACCESS_TOKEN, then commit it.
+type Recorder struct {
+	t         testing.TB
+	path      string
+	mode      Mode
+	token     string
+	transport http.RoundTripper
+	redactor  *redact.Redactor
+
+	mu           sync.Mutex
+	interactions []Interaction
+	used         []bool
+}
+
+// RecorderOption configures a Recorder.
+type RecorderOption func(*Recorder)
+
+// WithMode overrides the mode, which is otherwise ModeRecord if GHMOCK_RECORD is set to a true value and
+// ModeReplay if not.
+func WithMode(mode Mode) RecorderOption {
+	return func(r *Recorder) {
+		r.mode = mode
+	}
+}
+
+// WithToken sets the token to authenticate with in ModeRecord, instead of GITHUB_PERSONAL_ACCESS_TOKEN.
+func WithToken(token string) RecorderOption {
+	return func(r *Recorder) {
+		r.token = token
+	}
+}
+
+// WithTransport sets the transport that sends requests in ModeRecord, instead of http.DefaultTransport.
+func WithTransport(transport http.RoundTripper) RecorderOption {
+	return func(r *Recorder) {
+		r.transport = transport
+	}
+}
+
+// NewRecorder creates a Recorder for the fixture at path, usually under testdata. In ModeReplay the fixture must
+// exist; in ModeRecord it is written, and its directory created, when the test ends.
+func NewRecorder(t testing.TB, path string, opts ...RecorderOption) *Recorder {
+	t.Helper()
+	r := &Recorder{t: t, path: path, transport: http.DefaultTransport}
+	if record, _ := strconv.ParseBool(os.Getenv(recordEnv)); record {
+		r.mode = ModeRecord
+	}
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	if r.mode == ModeRecord {
+		if r.token == "" {
+			r.token = os.Getenv(tokenEnv)
+		}
+		if r.token == "" {
+			t.Fatalf("ghmock: recording %s requires %s", path, tokenEnv)
+		}
+		r.redactor = redact.New(r.token)
+		t.Cleanup(r.save)
+		return r
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("ghmock: failed to read fixture, record it with %s=1: %v", recordEnv, err)
+	}
+	var f fixture
+	if err := json.Unmarshal(data, &f); err != nil {
+		t.Fatalf("ghmock: invalid fixture %s: %v", path, err)
+	}
+	r.interactions = f.Interactions
+	r.used = make([]bool, len(f.Interactions))
+	return r
+}
+
+// RoundTrip records or replays req.
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	recorded, err := r.recordRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	if r.mode == ModeRecord {
+		return r.record(req, recorded)
+	}
+	return r.replay(req, recorded)
+}
+
+func (r *Recorder) recordRequest(req *http.Request) (RecordedRequest, error) {
+	var body []byte
+	if req.Body != nil {
+		var err error
+		if body, err = io.ReadAll(req.Body); err != nil {
+			return RecordedRequest{}, fmt.Errorf("ghmock: failed to read request body: %w", err)
+		}
+		_ = req.Body.Close()
+		req.Body = io.NopCloser(bytes.NewReader(body))
+	}
+	return RecordedRequest{
+		Method: req.Method,
+		URL:    r.redactor.String(req.URL.String()),
+		Body:   r.redactor.String(normalizeJSON(body)),
+	}, nil
+}
+
+func (r *Recorder) record(req *http.Request, recorded RecordedRequest) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	req.Header.Set("Authorization", "Bearer "+r.token)
+	resp, err := r.transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	body, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	if err != nil {
+		return nil, fmt.Errorf("ghmock: failed to read response body: %w", err)
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	headers := map[string]string{}
+	for _, name := range recordedHeaders {
+		if value := resp.Header.Get(name); value != "" {
+			headers[name] = r.redactor.String(value)
+		}
+	}
+	r.mu.Lock()
+	r.interactions = append(r.interactions, Interaction{
+		Request: recorded,
+		Response: RecordedResponse{
+			Status:  resp.StatusCode,
+			Headers: headers,
+			Body:    r.redactor.String(string(body)),
+		},
+	})
+	r.mu.Unlock()
+	return resp, nil
+}
+
+// replay returns the response of the first unused interaction that matches the request, so that a request made
+// twice gets the responses recorded for it in order.
+func (r *Recorder) replay(req *http.Request, recorded RecordedRequest) (*http.Response, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for i, interaction := range r.interactions {
+		if r.used[i] || interaction.Request != recorded {
+			continue
+		}
+		r.used[i] = true
+		resp := &http.Response{
+			Status:        fmt.Sprintf("%d %s", interaction.Response.Status, http.StatusText(interaction.Response.Status)),
+			StatusCode:    interaction.Response.Status,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        http.Header{},
+			Body:          io.NopCloser(bytes.NewReader([]byte(interaction.Response.Body))),
+			ContentLength: int64(len(interaction.Response.Body)),
+			Request:       req,
+		}
+		for name, value := range interaction.Response.Headers {
+			resp.Header.Set(name, value)
+		}
+		return resp, nil
+	}
+	r.t.Errorf("ghmock: no recorded response in %s for %s %s %s; re-record it with %s=1", r.path, recorded.Method, recorded.URL, recorded.Body, recordEnv)
+	return nil, fmt.Errorf("ghmock: no recorded response for %s %s", recorded.Method, recorded.URL)
+}
+
+func (r *Recorder) save() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	data, err := json.MarshalIndent(fixture{Interactions: r.interactions}, "", "  ")
+	if err != nil {
+		r.t.Errorf("ghmock: failed to encode fixture: %v", err)
+		return
+	}
+	if err := os.MkdirAll(filepath.Dir(r.path), 0o755); err != nil {
+		r.t.Errorf("ghmock: failed to create fixture directory: %v", err)
+		return
+	}
+	if err := os.WriteFile(r.path, append(data, '\n'), 0o600); err != nil {
+		r.t.Errorf("ghmock: failed to write fixture: %v", err)
+	}
+}
+
+// normalizeJSON re-encodes a JSON body so that fixtures do not depend on key order or whitespace. Other bodies are
+// returned as they are.
+func normalizeJSON(body []byte) string {
+	var v any
+	if len(body) == 0 || json.Unmarshal(body, &v) != nil {
+		return string(body)
+	}
+	normalized, err := json.Marshal(v)
+	if err != nil {
+		return string(body)
+	}
+	return string(normalized)
+}
+
+// HTTPClient returns an HTTP client that sends its requests through the Recorder.
+func (r *Recorder) HTTPClient() *http.Client {
+	return &http.Client{Transport: r}
+}
+
+// RESTClient returns a REST client for api.github.com that sends its requests through the Recorder.
+func (r *Recorder) RESTClient() *gogithub.Client {
+	return gogithub.NewClient(r.HTTPClient())
+}
+
+// GraphQLClient returns a GraphQL client for api.github.com that sends its requests through the Recorder.
+func (r *Recorder) GraphQLClient() *githubv4.Client {
+	return githubv4.NewClient(r.HTTPClient())
+}
+
+// GetClient returns a function that can be passed to tool constructors as their github.GetClientFn.
+func (r *Recorder) GetClient() func(context.Context) (*gogithub.Client, error) {
+	client := r.RESTClient()
+	return func(context.Context) (*gogithub.Client, error) { return client, nil }
+}
+
+// GetGQLClient returns a function that can be passed to tool constructors as their github.GetGQLClientFn.
+func (r *Recorder) GetGQLClient() func(context.Context) (*githubv4.Client, error) {
+	client := r.GraphQLClient()
+	return func(context.Context) (*githubv4.Client, error) { return client, nil }
+}
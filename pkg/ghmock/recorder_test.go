@@ -0,0 +1,71 @@
+package ghmock
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+
+	gogithub "github.com/google/go-github/v79/github"
+	"github.com/shurcooL/githubv4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecorder(t *testing.T) {
+	token := "ghp_" + "0123456789abcdefghijklmnopqrstuvwxyz"
+	upstream := New(t)
+	upstream.Respond("GET /repos/{owner}/{repo}", http.StatusOK, map[string]any{
+		"name":      "repo",
+		"clone_url": "https://x-access-token:" + token + "@github.com/octo/repo.git",
+	})
+	upstream.RespondGraphQL("viewer", map[string]any{"viewer": map[string]any{"login": "octocat"}})
+	fixturePath := filepath.Join(t.TempDir(), "testdata", "fixture.json")
+
+	exercise := func(t *testing.T, rec *Recorder) {
+		t.Helper()
+		rest := gogithub.NewClient(rec.HTTPClient())
+		rest.BaseURL, _ = url.Parse(upstream.URL() + "/")
+		repo, _, err := rest.Repositories.Get(context.Background(), "octo", "repo")
+		require.NoError(t, err)
+		assert.Equal(t, "repo", repo.GetName())
+
+		var query struct {
+			Viewer struct {
+				Login githubv4.String
+			}
+		}
+		gql := githubv4.NewEnterpriseClient(upstream.URL()+"/graphql", rec.HTTPClient())
+		require.NoError(t, gql.Query(context.Background(), &query, nil))
+		assert.Equal(t, "octocat", string(query.Viewer.Login))
+	}
+
+	t.Run("record", func(t *testing.T) {
+		exercise(t, NewRecorder(t, fixturePath, WithMode(ModeRecord), WithToken(token)))
+		assert.Equal(t, "Bearer "+token, upstream.AssertRequested("GET /repos/octo/repo").Header.Get("Authorization"))
+	})
+
+	data, err := os.ReadFile(fixturePath)
+	require.NoError(t, err)
+	assert.NotContains(t, string(data), token, "fixtures must not contain the token")
+	assert.Contains(t, string(data), "[REDACTED]")
+
+	t.Run("replay", func(t *testing.T) {
+		recorded := len(upstream.Requests())
+		rec := NewRecorder(t, fixturePath, WithMode(ModeReplay))
+		exercise(t, rec)
+		assert.Len(t, upstream.Requests(), recorded, "replayed requests must not reach GitHub")
+	})
+
+	t.Run("replay of an unrecorded request", func(t *testing.T) {
+		tb := &recordingTB{TB: t}
+		rec := NewRecorder(tb, fixturePath, WithMode(ModeReplay))
+		rest := gogithub.NewClient(rec.HTTPClient())
+		_, _, err := rest.Repositories.Get(context.Background(), "octo", "other")
+		require.Error(t, err)
+		require.Len(t, tb.errors, 1)
+		assert.Contains(t, tb.errors[0], "no recorded response")
+	})
+}
@@ -73,7 +73,7 @@ func ListWorkflows(getClient GetClientFn, t translations.TranslationHelperFunc)
 			}
 			defer func() { _ = resp.Body.Close() }()
 
-			r, err := json.Marshal(workflows)
+			r, err := json.Marshal(NewListResponse("workflows", workflows.Workflows, NewRESTPageInfo(resp), workflows.TotalCount))
 			if err != nil {
 				return nil, fmt.Errorf("failed to marshal response: %w", err)
 			}
@@ -212,7 +212,7 @@ func ListWorkflowRuns(getClient GetClientFn, t translations.TranslationHelperFun
 			}
 			defer func() { _ = resp.Body.Close() }()
 
-			r, err := json.Marshal(workflowRuns)
+			r, err := json.Marshal(NewListResponse("workflow_runs", workflowRuns.WorkflowRuns, NewRESTPageInfo(resp), workflowRuns.TotalCount))
 			if err != nil {
 				return nil, fmt.Errorf("failed to marshal response: %w", err)
 			}
@@ -226,8 +226,10 @@ func RunWorkflow(getClient GetClientFn, t translations.TranslationHelperFunc) (t
 	return mcp.NewTool("run_workflow",
 			mcp.WithDescription(t("TOOL_RUN_WORKFLOW_DESCRIPTION", "Run an Actions workflow by workflow ID or filename")),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
-				Title:        t("TOOL_RUN_WORKFLOW_USER_TITLE", "Run workflow"),
-				ReadOnlyHint: ToBoolPtr(false),
+				Title:           t("TOOL_RUN_WORKFLOW_USER_TITLE", "Run workflow"),
+				ReadOnlyHint:    ToBoolPtr(false),
+				DestructiveHint: ToBoolPtr(false),
+				IdempotentHint:  ToBoolPtr(false),
 			}),
 			mcp.WithString("owner",
 				mcp.Required(),
@@ -516,10 +518,8 @@ func ListWorkflowJobs(getClient GetClientFn, t translations.TranslationHelperFun
 			defer func() { _ = resp.Body.Close() }()
 
 			// Add optimization tip for failed job debugging
-			response := map[string]any{
-				"jobs":             jobs,
-				"optimization_tip": "For debugging failed jobs, consider using get_job_logs with failed_only=true and run_id=" + fmt.Sprintf("%d", runID) + " to get logs directly without needing to list jobs first",
-			}
+			response := NewListResponse("jobs", jobs.Jobs, NewRESTPageInfo(resp), jobs.TotalCount)
+			response["optimization_tip"] = "For debugging failed jobs, consider using get_job_logs with failed_only=true and run_id=" + fmt.Sprintf("%d", runID) + " to get logs directly without needing to list jobs first"
 
 			r, err := json.Marshal(response)
 			if err != nil {
@@ -783,8 +783,10 @@ func RerunWorkflowRun(getClient GetClientFn, t translations.TranslationHelperFun
 	return mcp.NewTool("rerun_workflow_run",
 			mcp.WithDescription(t("TOOL_RERUN_WORKFLOW_RUN_DESCRIPTION", "Re-run an entire workflow run")),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
-				Title:        t("TOOL_RERUN_WORKFLOW_RUN_USER_TITLE", "Rerun workflow run"),
-				ReadOnlyHint: ToBoolPtr(false),
+				Title:           t("TOOL_RERUN_WORKFLOW_RUN_USER_TITLE", "Rerun workflow run"),
+				ReadOnlyHint:    ToBoolPtr(false),
+				DestructiveHint: ToBoolPtr(false),
+				IdempotentHint:  ToBoolPtr(false),
 			}),
 			mcp.WithString("owner",
 				mcp.Required(),
@@ -846,8 +848,10 @@ func RerunFailedJobs(getClient GetClientFn, t translations.TranslationHelperFunc
 	return mcp.NewTool("rerun_failed_jobs",
 			mcp.WithDescription(t("TOOL_RERUN_FAILED_JOBS_DESCRIPTION", "Re-run only the failed jobs in a workflow run")),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
-				Title:        t("TOOL_RERUN_FAILED_JOBS_USER_TITLE", "Rerun failed jobs"),
-				ReadOnlyHint: ToBoolPtr(false),
+				Title:           t("TOOL_RERUN_FAILED_JOBS_USER_TITLE", "Rerun failed jobs"),
+				ReadOnlyHint:    ToBoolPtr(false),
+				DestructiveHint: ToBoolPtr(false),
+				IdempotentHint:  ToBoolPtr(false),
 			}),
 			mcp.WithString("owner",
 				mcp.Required(),
@@ -909,8 +913,10 @@ func CancelWorkflowRun(getClient GetClientFn, t translations.TranslationHelperFu
 	return mcp.NewTool("cancel_workflow_run",
 			mcp.WithDescription(t("TOOL_CANCEL_WORKFLOW_RUN_DESCRIPTION", "Cancel a workflow run")),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
-				Title:        t("TOOL_CANCEL_WORKFLOW_RUN_USER_TITLE", "Cancel workflow run"),
-				ReadOnlyHint: ToBoolPtr(false),
+				Title:           t("TOOL_CANCEL_WORKFLOW_RUN_USER_TITLE", "Cancel workflow run"),
+				ReadOnlyHint:    ToBoolPtr(false),
+				DestructiveHint: ToBoolPtr(true),
+				IdempotentHint:  ToBoolPtr(true),
 			}),
 			mcp.WithString("owner",
 				mcp.Required(),
@@ -1029,7 +1035,7 @@ func ListWorkflowRunArtifacts(getClient GetClientFn, t translations.TranslationH
 			}
 			defer func() { _ = resp.Body.Close() }()
 
-			r, err := json.Marshal(artifacts)
+			r, err := json.Marshal(NewListResponse("artifacts", artifacts.Artifacts, NewRESTPageInfo(resp), github.Ptr(int(artifacts.GetTotalCount()))))
 			if err != nil {
 				return nil, fmt.Errorf("failed to marshal response: %w", err)
 			}
@@ -1111,6 +1117,7 @@ func DeleteWorkflowRunLogs(getClient GetClientFn, t translations.TranslationHelp
 				Title:           t("TOOL_DELETE_WORKFLOW_RUN_LOGS_USER_TITLE", "Delete workflow logs"),
 				ReadOnlyHint:    ToBoolPtr(false),
 				DestructiveHint: ToBoolPtr(true),
+				IdempotentHint:  ToBoolPtr(true),
 			}),
 			mcp.WithString("owner",
 				mcp.Required(),
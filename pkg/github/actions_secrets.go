@@ -0,0 +1,147 @@
+package github
+
+import (
+	"context"
+	"fmt"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v79/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// Where a secret available to a repository's workflows is defined.
+const (
+	SecretScopeRepository   = "repository"
+	SecretScopeEnvironment  = "environment"
+	SecretScopeOrganization = "organization"
+)
+
+// SecretMetadata describes a secret without its value, which GitHub never returns.
+type SecretMetadata struct {
+	Name      string `json:"name"`
+	Scope     string `json:"scope"`
+	CreatedAt string `json:"created_at,omitempty"`
+	UpdatedAt string `json:"updated_at,omitempty"`
+}
+
+// listAllSecrets pages through a list of secrets.
+func listAllSecrets(list func(opts *github.ListOptions) (*github.Secrets, *github.Response, error)) ([]*github.Secret, *github.Response, error) {
+	opts := &github.ListOptions{PerPage: 100}
+	var secrets []*github.Secret
+	for {
+		page, resp, err := list(opts)
+		if err != nil {
+			return nil, resp, err
+		}
+		_ = resp.Body.Close()
+		secrets = append(secrets, page.Secrets...)
+		if resp.NextPage == 0 {
+			return secrets, resp, nil
+		}
+		opts.Page = resp.NextPage
+	}
+}
+
+// ListRepositorySecrets creates a tool that lists the names of the Actions secrets of a repository or one of its
+// environments.
+func ListRepositorySecrets(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_repository_secrets",
+			mcp.WithDescription(t("TOOL_LIST_REPOSITORY_SECRETS_DESCRIPTION", "List the names of the GitHub Actions secrets of a repository, or of one of its deployment environments, with when they were created and last updated. Secret values are never returned. Use it to audit which credentials workflows can access.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_LIST_REPOSITORY_SECRETS_USER_TITLE", "List repository secrets"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryOwner),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryName),
+			),
+			mcp.WithString("environment",
+				mcp.Description("Name of a deployment environment to list the secrets of instead of the repository's"),
+			),
+			mcp.WithBoolean("include_org_secrets",
+				mcp.Description("Also list the organization secrets shared with the repository"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			environment, err := OptionalParam[string](request, "environment")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			includeOrgSecrets, err := OptionalParam[bool](request, "include_org_secrets")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			result := []SecretMetadata{}
+			add := func(secrets []*github.Secret, scope string) {
+				for _, secret := range secrets {
+					result = append(result, SecretMetadata{
+						Name:      secret.Name,
+						Scope:     scope,
+						CreatedAt: FormatTimestamp(secret.CreatedAt.Time),
+						UpdatedAt: FormatTimestamp(secret.UpdatedAt.Time),
+					})
+				}
+			}
+
+			if environment != "" {
+				// Environment secrets are only addressable by repository ID.
+				repository, resp, err := client.Repositories.Get(ctx, owner, repo)
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("failed to get repository %s/%s", owner, repo), resp, err), nil
+				}
+				_ = resp.Body.Close()
+				secrets, resp, err := listAllSecrets(func(opts *github.ListOptions) (*github.Secrets, *github.Response, error) {
+					return client.Actions.ListEnvSecrets(ctx, int(repository.GetID()), environment, opts)
+				})
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("failed to list secrets of environment '%s'", environment), resp, err), nil
+				}
+				add(secrets, SecretScopeEnvironment)
+			} else {
+				secrets, resp, err := listAllSecrets(func(opts *github.ListOptions) (*github.Secrets, *github.Response, error) {
+					return client.Actions.ListRepoSecrets(ctx, owner, repo, opts)
+				})
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("failed to list secrets of %s/%s", owner, repo), resp, err), nil
+				}
+				add(secrets, SecretScopeRepository)
+			}
+
+			if includeOrgSecrets {
+				secrets, resp, err := listAllSecrets(func(opts *github.ListOptions) (*github.Secrets, *github.Response, error) {
+					return client.Actions.ListRepoOrgSecrets(ctx, owner, repo, opts)
+				})
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("failed to list organization secrets of %s/%s", owner, repo), resp, err), nil
+				}
+				add(secrets, SecretScopeOrganization)
+			}
+
+			// Every page of every scope has been fetched, so there is no next page to point to.
+			response := NewListResponse("secrets", result, PageInfo{}, github.Ptr(len(result)))
+			if environment != "" {
+				response["environment"] = environment
+			}
+			return MarshalledTextResult(response), nil
+		}
+}
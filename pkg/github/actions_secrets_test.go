@@ -0,0 +1,78 @@
+package github
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/ghmock"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ListRepositorySecrets(t *testing.T) {
+	tool, _ := ListRepositorySecrets(nil, translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+	assert.True(t, *tool.Annotations.ReadOnlyHint)
+	assert.ElementsMatch(t, []string{"owner", "repo"}, tool.InputSchema.Required)
+
+	secrets := func(names ...string) map[string]any {
+		var list []map[string]any
+		for _, name := range names {
+			list = append(list, map[string]any{"name": name, "created_at": "2024-01-01T00:00:00Z", "updated_at": "2025-01-01T00:00:00Z"})
+		}
+		return map[string]any{"total_count": len(list), "secrets": list}
+	}
+	newServer := func(t *testing.T) *ghmock.Server {
+		server := ghmock.New(t)
+		server.Respond("GET /repos/octo/app", http.StatusOK, map[string]any{"id": 99, "full_name": "octo/app"})
+		server.Respond("GET /repos/octo/app/actions/secrets", http.StatusOK, secrets("NPM_TOKEN"))
+		server.Respond("GET /repos/octo/app/actions/organization-secrets", http.StatusOK, secrets("ORG_TOKEN"))
+		server.Respond("GET /repositories/99/environments/production/secrets", http.StatusOK, secrets("DEPLOY_KEY"))
+		return server
+	}
+	type response struct {
+		Secrets     []SecretMetadata `json:"secrets"`
+		TotalCount  int              `json:"total_count"`
+		Environment string           `json:"environment"`
+	}
+	call := func(t *testing.T, args map[string]any) response {
+		server := newServer(t)
+		_, handler := ListRepositorySecrets(server.GetClient(), translations.NullTranslationHelper)
+		args["owner"], args["repo"] = "octo", "app"
+		result := ghmock.CallTool(t, handler, args)
+		require.False(t, result.IsError, ghmock.ResultText(t, result))
+		var resp response
+		require.NoError(t, json.Unmarshal([]byte(ghmock.ResultText(t, result)), &resp))
+		return resp
+	}
+
+	t.Run("repository secrets", func(t *testing.T) {
+		resp := call(t, map[string]any{})
+		assert.Equal(t, []SecretMetadata{
+			{Name: "NPM_TOKEN", Scope: SecretScopeRepository, CreatedAt: "2024-01-01T00:00:00Z", UpdatedAt: "2025-01-01T00:00:00Z"},
+		}, resp.Secrets)
+		assert.Equal(t, 1, resp.TotalCount)
+	})
+
+	t.Run("environment and organization secrets", func(t *testing.T) {
+		resp := call(t, map[string]any{"environment": "production", "include_org_secrets": true})
+		assert.Equal(t, "production", resp.Environment)
+		require.Len(t, resp.Secrets, 2)
+		assert.Equal(t, SecretMetadata{Name: "DEPLOY_KEY", Scope: SecretScopeEnvironment, CreatedAt: "2024-01-01T00:00:00Z", UpdatedAt: "2025-01-01T00:00:00Z"}, resp.Secrets[0])
+		assert.Equal(t, "ORG_TOKEN", resp.Secrets[1].Name)
+		assert.Equal(t, SecretScopeOrganization, resp.Secrets[1].Scope)
+	})
+
+	t.Run("unknown environment", func(t *testing.T) {
+		server := ghmock.New(t)
+		server.Respond("GET /repos/octo/app", http.StatusOK, map[string]any{"id": 99})
+		server.Respond("GET /repositories/99/environments/staging/secrets", http.StatusNotFound, map[string]any{"message": "Not Found"})
+		_, handler := ListRepositorySecrets(server.GetClient(), translations.NullTranslationHelper)
+		result := ghmock.CallTool(t, handler, map[string]any{"owner": "octo", "repo": "app", "environment": "staging"})
+		require.True(t, result.IsError)
+		assert.Contains(t, ghmock.ResultText(t, result), "failed to list secrets of environment 'staging'")
+	})
+}
@@ -0,0 +1,490 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"sort"
+	"strings"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v79/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/shurcooL/githubv4"
+)
+
+// openAlertListers lists the open alerts of each kind of a repository, or of an organization when repo is empty.
+var openAlertListers = map[string]func(context.Context, *github.Client, string, string) ([]securityAlert, *github.Response, error){
+	alertKindDependabot:     listOpenDependabotAlerts,
+	alertKindCodeScanning:   listOpenCodeScanningAlerts,
+	alertKindSecretScanning: listOpenSecretScanningAlerts,
+}
+
+// AlertSyncChange is a change sync_alerts_to_project made to a project, or would make in a dry run.
+type AlertSyncChange struct {
+	Alert    string `json:"alert"`
+	Action   string `json:"action"`
+	ItemID   int64  `json:"item_id,omitempty"`
+	Severity string `json:"severity,omitempty"`
+	URL      string `json:"url,omitempty"`
+}
+
+// alertSyncItem is an item of the project that tracks an alert.
+type alertSyncItem struct {
+	id       int64
+	archived bool
+	severity string
+}
+
+// alertProjectSyncer creates, updates and archives the items of a project that track security alerts, recording
+// every change in result.
+type alertProjectSyncer struct {
+	client        *github.Client
+	getGQLClient  GetGQLClientFn
+	ownerType     string
+	owner         string
+	number        int
+	projectNodeID string
+	itemType      string
+	alertIDField  *github.ProjectV2Field
+	severityField *github.ProjectV2Field
+	dryRun        bool
+	result        *BulkResult[AlertSyncChange]
+}
+
+// alertItemTitle is the title of the draft issue or issue that tracks an alert.
+func alertItemTitle(alert securityAlert) string {
+	kinds := map[string]string{
+		alertKindDependabot:     "Dependabot",
+		alertKindCodeScanning:   "Code scanning",
+		alertKindSecretScanning: "Secret scanning",
+	}
+	title := fmt.Sprintf("%s alert in %s: %s", kinds[alert.kind], alert.repository, alert.title)
+	if alert.severity != "" {
+		title = fmt.Sprintf("[%s] %s", alert.severity, title)
+	}
+	return title
+}
+
+// alertItemBody is the body of the draft issue or issue that tracks an alert.
+func alertItemBody(alert securityAlert) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Alert: %s\n", alert.htmlURL)
+	fmt.Fprintf(&b, "Alert ID: %s\n", alert.id())
+	if alert.severity != "" {
+		fmt.Fprintf(&b, "Severity: %s\n", alert.severity)
+	}
+	if alert.validity != "" {
+		fmt.Fprintf(&b, "Secret validity: %s\n", alert.validity)
+	}
+	return b.String()
+}
+
+// severityValue returns the value that sets the severity field of the project to a severity: the ID of the single
+// select option of that name, or the severity itself for a text field.
+func (s *alertProjectSyncer) severityValue(severity string) (any, error) {
+	if s.severityField.GetDataType() != "single_select" {
+		return severity, nil
+	}
+	for _, option := range s.severityField.Options {
+		if strings.EqualFold(option.GetName().GetRaw(), severity) {
+			return option.GetID(), nil
+		}
+	}
+	return nil, fmt.Errorf("the %s field has no option %q", s.severityField.GetName(), severity)
+}
+
+// updateItem applies an update to an item of the project.
+func (s *alertProjectSyncer) updateItem(ctx context.Context, itemID int64, update *github.UpdateProjectItemOptions) (*github.Response, error) {
+	var resp *github.Response
+	var err error
+	if s.ownerType == "org" {
+		_, resp, err = s.client.Projects.UpdateOrganizationProjectItem(ctx, s.owner, s.number, itemID, update)
+	} else {
+		_, resp, err = s.client.Projects.UpdateUserProjectItem(ctx, s.owner, s.number, itemID, update)
+	}
+	if err != nil {
+		return resp, err
+	}
+	_ = resp.Body.Close()
+	return resp, nil
+}
+
+// addDraftIssue adds a draft issue for an alert to the project and returns its item ID.
+func (s *alertProjectSyncer) addDraftIssue(ctx context.Context, alert securityAlert) (int64, error) {
+	gqlClient, err := s.getGQLClient(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get GitHub GraphQL client: %w", err)
+	}
+	item, err := addProjectDraftIssue(ctx, gqlClient, githubv4.AddProjectV2DraftIssueInput{
+		ProjectID: githubv4.ID(s.projectNodeID),
+		Title:     githubv4.String(alertItemTitle(alert)),
+		Body:      githubv4.NewString(githubv4.String(alertItemBody(alert))),
+	})
+	if err != nil {
+		return 0, err
+	}
+	return int64(item.DatabaseID), nil
+}
+
+// addIssue opens an issue for an alert in the repository of the alert and adds it to the project.
+func (s *alertProjectSyncer) addIssue(ctx context.Context, alert securityAlert) (int64, *github.Response, error) {
+	repoOwner, repoName, _ := strings.Cut(alert.repository, "/")
+	issue, resp, err := s.client.Issues.Create(ctx, repoOwner, repoName, &github.IssueRequest{
+		Title: github.Ptr(alertItemTitle(alert)),
+		Body:  github.Ptr(alertItemBody(alert)),
+	})
+	if err != nil {
+		return 0, resp, err
+	}
+	_ = resp.Body.Close()
+
+	opts := &github.AddProjectItemOptions{ID: issue.GetID(), Type: "Issue"}
+	var added *github.ProjectV2Item
+	if s.ownerType == "org" {
+		added, resp, err = s.client.Projects.AddOrganizationProjectItem(ctx, s.owner, s.number, opts)
+	} else {
+		added, resp, err = s.client.Projects.AddUserProjectItem(ctx, s.owner, s.number, opts)
+	}
+	if err != nil {
+		return 0, resp, err
+	}
+	_ = resp.Body.Close()
+	return added.GetID(), resp, nil
+}
+
+// create adds an item for an alert to the project and sets its alert ID and severity fields.
+func (s *alertProjectSyncer) create(ctx context.Context, alert securityAlert) {
+	change := AlertSyncChange{Alert: alert.id(), Action: "create", Severity: alert.severity, URL: alert.htmlURL}
+	update := &github.UpdateProjectItemOptions{
+		Fields: []*github.UpdateProjectV2Field{{ID: s.alertIDField.GetID(), Value: alert.id()}},
+	}
+	if s.severityField != nil && alert.severity != "" {
+		value, err := s.severityValue(alert.severity)
+		if err != nil {
+			s.result.AddSkipped(alert.id(), err.Error())
+			return
+		}
+		update.Fields = append(update.Fields, &github.UpdateProjectV2Field{ID: s.severityField.GetID(), Value: value})
+	}
+	if s.dryRun {
+		s.result.AddSuccess(change)
+		return
+	}
+
+	var err error
+	if s.itemType == "issue" {
+		var resp *github.Response
+		if change.ItemID, resp, err = s.addIssue(ctx, alert); err != nil {
+			s.result.AddAPIFailure(alert.id(), resp, err)
+			return
+		}
+	} else if change.ItemID, err = s.addDraftIssue(ctx, alert); err != nil {
+		s.result.AddGraphQLFailure(alert.id(), err)
+		return
+	}
+	if resp, err := s.updateItem(ctx, change.ItemID, update); err != nil {
+		s.result.AddAPIFailure(alert.id(), resp, fmt.Errorf("item %d was added but its fields could not be set: %w", change.ItemID, err))
+		return
+	}
+	s.result.AddSuccess(change)
+}
+
+// refresh brings the item of an open alert up to date: it sets the severity, which can change when an advisory is
+// revised, and unarchives the item of an alert that was reopened.
+func (s *alertProjectSyncer) refresh(ctx context.Context, alert securityAlert, item alertSyncItem) {
+	update := &github.UpdateProjectItemOptions{}
+	var actions []string
+	if s.severityField != nil && alert.severity != "" && !strings.EqualFold(item.severity, alert.severity) {
+		value, err := s.severityValue(alert.severity)
+		if err != nil {
+			s.result.AddSkipped(alert.id(), err.Error())
+			return
+		}
+		update.Fields = append(update.Fields, &github.UpdateProjectV2Field{ID: s.severityField.GetID(), Value: value})
+		actions = append(actions, "update")
+	}
+	if item.archived {
+		update.Archived = github.Ptr(false)
+		actions = append(actions, "unarchive")
+	}
+	if len(actions) == 0 {
+		return
+	}
+	if !s.dryRun {
+		if resp, err := s.updateItem(ctx, item.id, update); err != nil {
+			s.result.AddAPIFailure(alert.id(), resp, err)
+			return
+		}
+	}
+	for _, action := range actions {
+		s.result.AddSuccess(AlertSyncChange{Alert: alert.id(), Action: action, ItemID: item.id, Severity: alert.severity, URL: alert.htmlURL})
+	}
+}
+
+// archive archives the item of an alert that is no longer open.
+func (s *alertProjectSyncer) archive(ctx context.Context, alertID string, item alertSyncItem) {
+	if !s.dryRun {
+		if resp, err := s.updateItem(ctx, item.id, &github.UpdateProjectItemOptions{Archived: github.Ptr(true)}); err != nil {
+			s.result.AddAPIFailure(alertID, resp, err)
+			return
+		}
+	}
+	s.result.AddSuccess(AlertSyncChange{Alert: alertID, Action: "archive", ItemID: item.id})
+}
+
+// SyncAlertsToProject creates a tool that keeps a project in sync with the open security alerts of a repository or
+// an organization.
+func SyncAlertsToProject(getClient GetClientFn, getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("sync_alerts_to_project",
+			mcp.WithDescription(t("TOOL_SYNC_ALERTS_TO_PROJECT_DESCRIPTION", "Sync the open Dependabot, code scanning and secret scanning alerts of a repository, or of all repositories of an organization, onto a Project. Adds a draft issue, or an issue, for every open alert matching the severity filter, sets the Alert ID and Severity fields of the items, and archives the items of alerts that were resolved. Items are matched to alerts by the Alert ID field, which must be a text field of the project. Issues are opened in the repository of the alert, so do not use item_type issue for public repositories. Use dry_run to see the changes first.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:           t("TOOL_SYNC_ALERTS_TO_PROJECT_USER_TITLE", "Sync security alerts to project"),
+				ReadOnlyHint:    ToBoolPtr(false),
+				DestructiveHint: ToBoolPtr(false),
+				IdempotentHint:  ToBoolPtr(true),
+			}),
+			mcp.WithString("owner_type",
+				mcp.Required(),
+				mcp.Description("Owner type of the project"),
+				mcp.Enum("user", "org"),
+			),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("If owner_type == user it is the handle for the GitHub user account. If owner_type == org it is the name of the organization. The name is not case sensitive."),
+			),
+			mcp.WithNumber("project_number",
+				mcp.Required(),
+				mcp.Description("The project's number."),
+			),
+			mcp.WithString("alerts_owner",
+				mcp.Required(),
+				mcp.Description("Owner of the repository, or the organization, whose alerts to sync"),
+			),
+			mcp.WithString("alerts_repo",
+				mcp.Description("Repository whose alerts to sync. Omit to sync the alerts of all repositories of the organization."),
+			),
+			mcp.WithArray("alert_types",
+				mcp.Description("Kinds of alerts to sync. Defaults to all."),
+				mcp.Items(map[string]any{
+					"type": "string",
+					"enum": []string{alertKindDependabot, alertKindCodeScanning, alertKindSecretScanning},
+				}),
+			),
+			mcp.WithArray("severities",
+				mcp.Description("Severities of the alerts to add, e.g. ['critical', 'high']. Defaults to all. Secret scanning alerts have no severity and are always added."),
+				mcp.WithStringItems(),
+			),
+			mcp.WithString("item_type",
+				mcp.Description("Whether to track alerts as draft issues of the project or as issues in the repository of the alert"),
+				mcp.Enum("draft_issue", "issue"),
+				mcp.DefaultString("draft_issue"),
+			),
+			mcp.WithString("alert_id_field",
+				mcp.Description("Name of the text field that holds the alert ID"),
+				mcp.DefaultString("Alert ID"),
+			),
+			mcp.WithString("severity_field",
+				mcp.Description("Name of the single select or text field that holds the severity. Skipped when the project has no such field."),
+				mcp.DefaultString("Severity"),
+			),
+			mcp.WithBoolean("dry_run",
+				mcp.Description("Report the changes without making them."),
+			),
+		), func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](req, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			ownerType, err := RequiredParam[string](req, "owner_type")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			projectNumber, err := ValidateProjectNumber(req)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			alertsOwner, err := RequiredParam[string](req, "alerts_owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			alertsRepo, err := OptionalParam[string](req, "alerts_repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			alertTypes, err := OptionalStringArrayParam(req, "alert_types")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if len(alertTypes) == 0 {
+				alertTypes = []string{alertKindDependabot, alertKindCodeScanning, alertKindSecretScanning}
+			}
+			for _, kind := range alertTypes {
+				if openAlertListers[kind] == nil {
+					return mcp.NewToolResultError(fmt.Sprintf("unknown alert type %q", kind)), nil
+				}
+			}
+			severities, err := OptionalStringArrayParam(req, "severities")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			itemType, err := OptionalParam[string](req, "item_type")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if itemType == "" {
+				itemType = "draft_issue"
+			}
+			if itemType != "draft_issue" && itemType != "issue" {
+				return mcp.NewToolResultError(fmt.Sprintf("invalid item_type %q: must be draft_issue or issue", itemType)), nil
+			}
+			alertIDFieldName, err := OptionalParam[string](req, "alert_id_field")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if alertIDFieldName == "" {
+				alertIDFieldName = "Alert ID"
+			}
+			severityFieldName, err := OptionalParam[string](req, "severity_field")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if severityFieldName == "" {
+				severityFieldName = "Severity"
+			}
+			dryRun, err := OptionalParam[bool](req, "dry_run")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			project, resp, err := getProjectV2(ctx, client, ownerType, owner, projectNumber)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get project", resp, err), nil
+			}
+			_ = resp.Body.Close()
+
+			s := &alertProjectSyncer{
+				client:        client,
+				getGQLClient:  getGQLClient,
+				ownerType:     ownerType,
+				owner:         owner,
+				number:        projectNumber,
+				projectNodeID: project.GetNodeID(),
+				itemType:      itemType,
+				dryRun:        dryRun,
+				result:        NewBulkResult[AlertSyncChange](),
+			}
+
+			fields, resp, err := listAllProjectFields(ctx, client, ownerType, owner, projectNumber)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to list project fields", resp, err), nil
+			}
+			fieldTypes := map[string]string{}
+			for _, field := range fields {
+				fieldTypes[field.GetName()] = field.GetDataType()
+				switch {
+				case strings.EqualFold(field.GetName(), alertIDFieldName):
+					s.alertIDField = field
+				case strings.EqualFold(field.GetName(), severityFieldName):
+					s.severityField = field
+				}
+			}
+			if s.alertIDField == nil || s.alertIDField.GetDataType() != "text" {
+				return mcp.NewToolResultError(fmt.Sprintf("the project has no text field named %q to hold the alert ID; create it first", alertIDFieldName)), nil
+			}
+			fieldIDs := []int64{s.alertIDField.GetID()}
+			if s.severityField == nil {
+				s.result.AddSkipped("field "+severityFieldName, "the project has no such field, so severities are not set")
+			} else {
+				fieldIDs = append(fieldIDs, s.severityField.GetID())
+			}
+
+			items, resp, err := listAllProjectItems(ctx, client, ownerType, owner, projectNumber, fieldIDs)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, ProjectListFailedError, resp, err), nil
+			}
+			tracked := map[string]alertSyncItem{}
+			for _, item := range items {
+				snapshotItem := toSnapshotItem(item, fieldTypes)
+				alertID, _ := snapshotItem.FieldValues[s.alertIDField.GetName()].(string)
+				if alertID == "" {
+					continue
+				}
+				severity := ""
+				if s.severityField != nil {
+					severity, _ = snapshotItem.FieldValues[s.severityField.GetName()].(string)
+				}
+				tracked[alertID] = alertSyncItem{id: snapshotItem.ID, archived: snapshotItem.Archived, severity: severity}
+			}
+
+			// Only items of alert kinds that could be listed, in the repositories that were listed, may be archived.
+			scope := alertsOwner + "/"
+			if alertsRepo != "" {
+				scope = alertsOwner + "/" + alertsRepo + "#"
+			}
+			open := map[string]bool{}
+			var listed []string
+			for _, kind := range alertTypes {
+				alerts, resp, err := openAlertListers[kind](ctx, client, alertsOwner, alertsRepo)
+				if err != nil {
+					if resp != nil && (resp.StatusCode == http.StatusForbidden || resp.StatusCode == http.StatusNotFound) {
+						s.result.AddSkipped(kind+" alerts", fmt.Sprintf("the alerts are not enabled or not accessible: %v", err))
+						continue
+					}
+					return ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("failed to list %s alerts", kind), resp, err), nil
+				}
+				listed = append(listed, kind+":")
+				for _, alert := range alerts {
+					open[alert.id()] = true
+					if item, ok := tracked[alert.id()]; ok {
+						s.refresh(ctx, alert, item)
+						continue
+					}
+					if alert.severity != "" && len(severities) > 0 && !slices.ContainsFunc(severities, func(severity string) bool { return strings.EqualFold(severity, alert.severity) }) {
+						continue
+					}
+					s.create(ctx, alert)
+				}
+			}
+			alertIDs := make([]string, 0, len(tracked))
+			for alertID := range tracked {
+				alertIDs = append(alertIDs, alertID)
+			}
+			sort.Strings(alertIDs)
+			for _, alertID := range alertIDs {
+				item := tracked[alertID]
+				if open[alertID] || item.archived {
+					continue
+				}
+				for _, prefix := range listed {
+					if strings.HasPrefix(alertID, prefix+scope) {
+						s.archive(ctx, alertID, item)
+						break
+					}
+				}
+			}
+
+			summary := s.result.Summary()
+			body, err := json.Marshal(struct {
+				DryRun bool `json:"dry_run"`
+				*BulkResult[AlertSyncChange]
+				Summary BulkSummary `json:"summary"`
+			}{dryRun, s.result, summary})
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+			if summary.Failed > 0 && summary.Succeeded == 0 {
+				return mcp.NewToolResultError(string(body)), nil
+			}
+			return mcp.NewToolResultText(string(body)), nil
+		}
+}
@@ -0,0 +1,189 @@
+package github
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/ghmock"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// alertSyncItemJSON is a project item that tracks an alert, as returned by the REST API.
+func alertSyncItemJSON(id int, alertID, severity string, archived bool) map[string]any {
+	item := map[string]any{
+		"id": id, "content_type": "DraftIssue",
+		"fields": []map[string]any{
+			{"id": 10, "name": "Alert ID", "data_type": "text", "value": alertID},
+			{"id": 11, "name": "Severity", "data_type": "single_select", "value": map[string]any{"name": map[string]any{"raw": severity}}},
+		},
+	}
+	if archived {
+		item["archived_at"] = "2025-01-01T00:00:00Z"
+	}
+	return item
+}
+
+// respondAlertSyncProject serves an organization project with Alert ID and Severity fields that tracks five alerts:
+// two open Dependabot alerts of octo/app, one of them with an outdated severity, a code scanning alert of octo/app and
+// of another repository, and an archived secret scanning alert of octo/app that was reopened.
+func respondAlertSyncProject(server *ghmock.Server) {
+	server.Respond("GET /orgs/octo/projectsV2/1", http.StatusOK, map[string]any{"id": 1, "node_id": "PVT_1", "number": 1, "title": "Security"})
+	server.Respond("GET /orgs/octo/projectsV2/1/fields", http.StatusOK, []map[string]any{
+		{"id": 9, "name": "Title", "data_type": "title"},
+		{"id": 10, "name": "Alert ID", "data_type": "text"},
+		{
+			"id": 11, "name": "Severity", "data_type": "single_select",
+			"options": []map[string]any{
+				{"id": "sev-critical", "name": map[string]any{"raw": "Critical"}},
+				{"id": "sev-high", "name": map[string]any{"raw": "High"}},
+				{"id": "sev-medium", "name": map[string]any{"raw": "Medium"}},
+			},
+		},
+	})
+	server.Respond("GET /orgs/octo/projectsV2/1/items", http.StatusOK, []map[string]any{
+		alertSyncItemJSON(1, "dependabot:octo/app#1", "Medium", false),
+		alertSyncItemJSON(2, "dependabot:octo/app#2", "High", false),
+		alertSyncItemJSON(3, "code_scanning:octo/other#5", "High", false),
+		alertSyncItemJSON(4, "secret_scanning:octo/app#9", "", true),
+		alertSyncItemJSON(5, "code_scanning:octo/app#7", "Medium", false),
+		{"id": 6, "content_type": "Issue", "fields": []map[string]any{}},
+	})
+	server.Respond("GET /repos/octo/app/dependabot/alerts", http.StatusOK, []map[string]any{
+		{"number": 1, "html_url": "https://github.com/octo/app/security/dependabot/1", "security_advisory": map[string]any{"severity": "high", "summary": "Prototype pollution"}},
+		{"number": 3, "html_url": "https://github.com/octo/app/security/dependabot/3", "security_advisory": map[string]any{"severity": "critical", "summary": "Remote code execution"}, "dependency": map[string]any{"package": map[string]any{"name": "lodash"}}},
+		{"number": 4, "html_url": "https://github.com/octo/app/security/dependabot/4", "security_advisory": map[string]any{"severity": "low", "summary": "Minor"}},
+	})
+	server.Respond("GET /repos/octo/app/code-scanning/alerts", http.StatusNotFound, map[string]any{"message": "no analysis found"})
+	server.Respond("GET /repos/octo/app/secret-scanning/alerts", http.StatusOK, []map[string]any{
+		{"number": 9, "html_url": "https://github.com/octo/app/security/secret-scanning/9", "secret_type_display_name": "GitHub PAT"},
+		{"number": 10, "html_url": "https://github.com/octo/app/security/secret-scanning/10", "secret_type_display_name": "AWS key"},
+	})
+}
+
+type alertSyncResponse struct {
+	DryRun    bool              `json:"dry_run"`
+	Succeeded []AlertSyncChange `json:"succeeded"`
+	Skipped   []BulkSkip        `json:"skipped"`
+	Failed    []BulkFailure     `json:"failed"`
+}
+
+func callSyncAlertsToProject(t *testing.T, server *ghmock.Server, args map[string]any) alertSyncResponse {
+	_, handler := SyncAlertsToProject(server.GetClient(), server.GetGQLClient(), translations.NullTranslationHelper)
+	args["owner_type"], args["owner"], args["project_number"] = "org", "octo", float64(1)
+	args["alerts_owner"], args["alerts_repo"] = "octo", "app"
+	result := ghmock.CallTool(t, handler, args)
+	require.False(t, result.IsError, ghmock.ResultText(t, result))
+	var resp alertSyncResponse
+	require.NoError(t, json.Unmarshal([]byte(ghmock.ResultText(t, result)), &resp))
+	return resp
+}
+
+// alertSyncActions lists the changes of a sync as "action alert".
+func alertSyncActions(changes []AlertSyncChange) []string {
+	actions := make([]string, len(changes))
+	for i, change := range changes {
+		actions[i] = change.Action + " " + change.Alert
+	}
+	return actions
+}
+
+func Test_SyncAlertsToProject(t *testing.T) {
+	tool, _ := SyncAlertsToProject(nil, nil, translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+	assert.False(t, *tool.Annotations.ReadOnlyHint)
+	assert.ElementsMatch(t, []string{"owner_type", "owner", "project_number", "alerts_owner"}, tool.InputSchema.Required)
+
+	wantActions := []string{
+		"update dependabot:octo/app#1",
+		"create dependabot:octo/app#3",
+		"unarchive secret_scanning:octo/app#9",
+		"create secret_scanning:octo/app#10",
+		"archive dependabot:octo/app#2",
+	}
+
+	t.Run("draft issues", func(t *testing.T) {
+		server := ghmock.New(t)
+		respondAlertSyncProject(server)
+		nextItemID := 100
+		var drafts []map[string]any
+		server.HandleGraphQL("addProjectV2DraftIssue(", func(_ string, vars map[string]any) (any, []string) {
+			drafts = append(drafts, vars["input"].(map[string]any))
+			nextItemID++
+			return map[string]any{"addProjectV2DraftIssue": map[string]any{"projectItem": map[string]any{"databaseId": nextItemID}}}, nil
+		})
+		updates := map[string]map[string]any{}
+		server.HandleFunc("PATCH /orgs/octo/projectsV2/1/items/{item}", func(w http.ResponseWriter, r *http.Request) {
+			var body map[string]any
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			updates[r.PathValue("item")] = body
+			_ = json.NewEncoder(w).Encode(map[string]any{"id": 1})
+		})
+
+		resp := callSyncAlertsToProject(t, server, map[string]any{"severities": []any{"critical", "high"}})
+		assert.Equal(t, wantActions, alertSyncActions(resp.Succeeded))
+		assert.Empty(t, resp.Failed)
+		require.Len(t, resp.Skipped, 1)
+		assert.Equal(t, "code_scanning alerts", resp.Skipped[0].Item)
+
+		require.Len(t, drafts, 2)
+		assert.Equal(t, "PVT_1", drafts[0]["projectId"])
+		assert.Equal(t, "[critical] Dependabot alert in octo/app: Remote code execution (lodash)", drafts[0]["title"])
+		assert.Contains(t, drafts[0]["body"], "https://github.com/octo/app/security/dependabot/3")
+		assert.Equal(t, "Secret scanning alert in octo/app: AWS key", drafts[1]["title"])
+
+		assert.Equal(t, []any{
+			map[string]any{"id": float64(10), "value": "dependabot:octo/app#3"},
+			map[string]any{"id": float64(11), "value": "sev-critical"},
+		}, updates["101"]["fields"])
+		assert.Equal(t, []any{map[string]any{"id": float64(10), "value": "secret_scanning:octo/app#10"}}, updates["102"]["fields"])
+		assert.Equal(t, []any{map[string]any{"id": float64(11), "value": "sev-high"}}, updates["1"]["fields"])
+		assert.Equal(t, map[string]any{"archived": true}, updates["2"])
+		assert.Equal(t, map[string]any{"archived": false}, updates["4"])
+		assert.NotContains(t, updates, "3", "alerts of other repositories are left alone")
+		assert.NotContains(t, updates, "5", "alerts that could not be listed are left alone")
+	})
+
+	t.Run("dry run", func(t *testing.T) {
+		server := ghmock.New(t)
+		respondAlertSyncProject(server)
+		resp := callSyncAlertsToProject(t, server, map[string]any{"severities": []any{"critical", "high"}, "dry_run": true})
+		assert.True(t, resp.DryRun)
+		assert.Equal(t, wantActions, alertSyncActions(resp.Succeeded))
+		server.AssertNotRequested("PATCH")
+		assert.Empty(t, server.Mutations())
+	})
+
+	t.Run("issues", func(t *testing.T) {
+		server := ghmock.New(t)
+		respondAlertSyncProject(server)
+		server.Respond("POST /repos/octo/app/issues", http.StatusCreated, map[string]any{"id": 555, "number": 42})
+		server.Respond("POST /orgs/octo/projectsV2/1/items", http.StatusCreated, map[string]any{"id": 200})
+		server.Respond("PATCH /orgs/octo/projectsV2/1/items/{item}", http.StatusOK, map[string]any{"id": 1})
+
+		resp := callSyncAlertsToProject(t, server, map[string]any{"alert_types": []any{"dependabot"}, "severities": []any{"critical"}, "item_type": "issue"})
+		assert.Equal(t, []string{"update dependabot:octo/app#1", "create dependabot:octo/app#3", "archive dependabot:octo/app#2"}, alertSyncActions(resp.Succeeded))
+		assert.Equal(t, int64(200), resp.Succeeded[1].ItemID)
+		var added struct {
+			Type string `json:"type"`
+			ID   int64  `json:"id"`
+		}
+		require.NoError(t, server.AssertRequested("POST /orgs/octo/projectsV2/1/items").DecodeBody(&added))
+		assert.Equal(t, "Issue", added.Type)
+		assert.Equal(t, int64(555), added.ID)
+		assert.Empty(t, server.Mutations())
+	})
+
+	t.Run("missing alert ID field", func(t *testing.T) {
+		server := ghmock.New(t)
+		server.Respond("GET /orgs/octo/projectsV2/1", http.StatusOK, map[string]any{"id": 1, "node_id": "PVT_1"})
+		server.Respond("GET /orgs/octo/projectsV2/1/fields", http.StatusOK, []map[string]any{{"id": 9, "name": "Title", "data_type": "title"}})
+		_, handler := SyncAlertsToProject(server.GetClient(), server.GetGQLClient(), translations.NullTranslationHelper)
+		result := ghmock.CallTool(t, handler, map[string]any{"owner_type": "org", "owner": "octo", "project_number": float64(1), "alerts_owner": "octo"})
+		require.True(t, result.IsError)
+		assert.Contains(t, ghmock.ResultText(t, result), `the project has no text field named "Alert ID"`)
+	})
+}
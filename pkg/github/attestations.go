@@ -0,0 +1,318 @@
+package github
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/x509"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v79/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+const (
+	// SLSAProvenancePredicateType is the predicate type of the build provenance attestations GitHub Actions creates.
+	SLSAProvenancePredicateType = "https://slsa.dev/provenance/v1"
+	// inTotoPayloadType is the DSSE payload type of an in-toto statement.
+	inTotoPayloadType = "application/vnd.in-toto+json"
+	// attestationNotChecked is what verify_attestation does not verify itself.
+	attestationNotChecked = "The signing certificate is not checked against the Sigstore or GitHub trust root and the transparency log inclusion is not checked. Run 'gh attestation verify' for full cryptographic verification."
+)
+
+// Fulcio certificate extensions that describe the GitHub Actions run that signed an attestation.
+var (
+	oidSourceRepositoryURI    = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 57264, 1, 12}
+	oidSourceRepositoryDigest = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 57264, 1, 13}
+	oidSourceRepositoryRef    = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 57264, 1, 14}
+	oidRunInvocationURI       = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 57264, 1, 21}
+)
+
+// sigstoreBundle is the part of a Sigstore bundle verify_attestation looks at.
+type sigstoreBundle struct {
+	VerificationMaterial struct {
+		Certificate *struct {
+			RawBytes string `json:"rawBytes"`
+		} `json:"certificate"`
+		X509CertificateChain *struct {
+			Certificates []struct {
+				RawBytes string `json:"rawBytes"`
+			} `json:"certificates"`
+		} `json:"x509CertificateChain"`
+		TlogEntries []struct {
+			IntegratedTime string `json:"integratedTime"`
+		} `json:"tlogEntries"`
+	} `json:"verificationMaterial"`
+	DSSEEnvelope struct {
+		Payload     string `json:"payload"`
+		PayloadType string `json:"payloadType"`
+		Signatures  []struct {
+			Sig string `json:"sig"`
+		} `json:"signatures"`
+	} `json:"dsseEnvelope"`
+}
+
+// inTotoStatement is the statement an attestation signs.
+type inTotoStatement struct {
+	Subject []struct {
+		Name   string            `json:"name"`
+		Digest map[string]string `json:"digest"`
+	} `json:"subject"`
+	PredicateType string `json:"predicateType"`
+}
+
+// AttestationVerification is the result of checking one attestation of an artifact.
+type AttestationVerification struct {
+	RepositoryID     int64    `json:"repository_id"`
+	PredicateType    string   `json:"predicate_type,omitempty"`
+	Subjects         []string `json:"subjects,omitempty"`
+	SignerIdentity   string   `json:"signer_identity,omitempty"`
+	SourceRepository string   `json:"source_repository,omitempty"`
+	SourceRef        string   `json:"source_ref,omitempty"`
+	SourceCommit     string   `json:"source_commit,omitempty"`
+	RunURL           string   `json:"run_url,omitempty"`
+	SignedAt         string   `json:"signed_at,omitempty"`
+	Verified         bool     `json:"verified"`
+	Problems         []string `json:"problems"`
+}
+
+// dssePAE is the DSSE pre-authentication encoding of a payload, which is what the signature covers.
+func dssePAE(payloadType string, payload []byte) []byte {
+	return []byte(fmt.Sprintf("DSSEv1 %d %s %d %s", len(payloadType), payloadType, len(payload), payload))
+}
+
+// certificateExtension returns a Fulcio extension of a certificate, which holds a DER encoded string.
+func certificateExtension(cert *x509.Certificate, oid asn1.ObjectIdentifier) string {
+	for _, ext := range cert.Extensions {
+		if ext.Id.Equal(oid) {
+			var value string
+			if _, err := asn1.Unmarshal(ext.Value, &value); err == nil {
+				return value
+			}
+			return string(ext.Value)
+		}
+	}
+	return ""
+}
+
+// verifyDSSESignature checks a DSSE signature with the ECDSA key of the signing certificate.
+func verifyDSSESignature(cert *x509.Certificate, pae, signature []byte) error {
+	key, ok := cert.PublicKey.(*ecdsa.PublicKey)
+	if !ok {
+		return fmt.Errorf("unsupported signing key type %T", cert.PublicKey)
+	}
+	hash := crypto.SHA256
+	if key.Curve == elliptic.P384() {
+		hash = crypto.SHA384
+	}
+	h := hash.New()
+	h.Write(pae)
+	if !ecdsa.VerifyASN1(key, h.Sum(nil), signature) {
+		return fmt.Errorf("the signature does not match the signing certificate")
+	}
+	return nil
+}
+
+// verifyAttestation checks an attestation for an artifact digest such as "sha256:abc…".
+func verifyAttestation(attestation *github.Attestation, digest, predicateType, signerWorkflow string) AttestationVerification {
+	result := AttestationVerification{RepositoryID: attestation.RepositoryID, Problems: []string{}}
+	problem := func(format string, args ...any) AttestationVerification {
+		result.Problems = append(result.Problems, fmt.Sprintf(format, args...))
+		return result
+	}
+
+	var bundle sigstoreBundle
+	if err := json.Unmarshal(attestation.Bundle, &bundle); err != nil {
+		return problem("the Sigstore bundle cannot be parsed: %v", err)
+	}
+	envelope := bundle.DSSEEnvelope
+	if envelope.PayloadType != inTotoPayloadType {
+		return problem("the bundle does not sign an in-toto statement but %q", envelope.PayloadType)
+	}
+	payload, err := base64.StdEncoding.DecodeString(envelope.Payload)
+	if err != nil {
+		return problem("the signed payload cannot be decoded: %v", err)
+	}
+	var statement inTotoStatement
+	if err := json.Unmarshal(payload, &statement); err != nil {
+		return problem("the signed statement cannot be parsed: %v", err)
+	}
+	result.PredicateType = statement.PredicateType
+
+	algorithm, hexDigest, _ := strings.Cut(digest, ":")
+	subjectMatches := false
+	for _, subject := range statement.Subject {
+		result.Subjects = append(result.Subjects, subject.Name)
+		if strings.EqualFold(subject.Digest[algorithm], hexDigest) {
+			subjectMatches = true
+		}
+	}
+	if !subjectMatches {
+		problem("no subject of the statement has the digest %s", digest)
+	}
+	if predicateType != "" && statement.PredicateType != predicateType {
+		problem("the predicate type is %s, not %s", statement.PredicateType, predicateType)
+	}
+
+	var rawCert string
+	switch material := bundle.VerificationMaterial; {
+	case material.Certificate != nil:
+		rawCert = material.Certificate.RawBytes
+	case material.X509CertificateChain != nil && len(material.X509CertificateChain.Certificates) > 0:
+		rawCert = material.X509CertificateChain.Certificates[0].RawBytes
+	}
+	der, err := base64.StdEncoding.DecodeString(rawCert)
+	if err != nil || len(der) == 0 {
+		return problem("the bundle has no signing certificate")
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		return problem("the signing certificate cannot be parsed: %v", err)
+	}
+	if len(cert.URIs) > 0 {
+		result.SignerIdentity = cert.URIs[0].String()
+	}
+	result.SourceRepository = certificateExtension(cert, oidSourceRepositoryURI)
+	result.SourceRef = certificateExtension(cert, oidSourceRepositoryRef)
+	result.SourceCommit = certificateExtension(cert, oidSourceRepositoryDigest)
+	result.RunURL = certificateExtension(cert, oidRunInvocationURI)
+
+	if len(envelope.Signatures) == 0 {
+		return problem("the statement is not signed")
+	}
+	signature, err := base64.StdEncoding.DecodeString(envelope.Signatures[0].Sig)
+	if err != nil {
+		return problem("the signature cannot be decoded: %v", err)
+	}
+	if err := verifyDSSESignature(cert, dssePAE(envelope.PayloadType, payload), signature); err != nil {
+		problem("%v", err)
+	}
+
+	// Signing certificates live for minutes, so the signature must have been logged while the certificate was valid.
+	if entries := bundle.VerificationMaterial.TlogEntries; len(entries) > 0 {
+		if seconds, err := strconv.ParseInt(entries[0].IntegratedTime, 10, 64); err == nil {
+			signedAt := time.Unix(seconds, 0).UTC()
+			result.SignedAt = FormatTimestamp(signedAt)
+			if signedAt.Before(cert.NotBefore) || signedAt.After(cert.NotAfter) {
+				problem("the statement was signed at %s, outside the validity of the signing certificate", result.SignedAt)
+			}
+		}
+	}
+
+	if signerWorkflow != "" {
+		// The identity is the workflow file followed by the ref it ran at, e.g.
+		// https://github.com/octo/app/.github/workflows/release.yml@refs/tags/v1.0.0.
+		workflow, _, _ := strings.Cut(strings.TrimPrefix(result.SignerIdentity, "https://github.com/"), "@")
+		if !strings.EqualFold(workflow, strings.TrimPrefix(signerWorkflow, "https://github.com/")) {
+			problem("the statement was signed by %s, not %s", result.SignerIdentity, signerWorkflow)
+		}
+	}
+
+	result.Verified = len(result.Problems) == 0
+	return result
+}
+
+// VerifyAttestation creates a tool that fetches and checks the artifact attestations of a digest.
+func VerifyAttestation(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("verify_attestation",
+			mcp.WithDescription(t("TOOL_VERIFY_ATTESTATION_DESCRIPTION", "Fetch the artifact attestations, such as build provenance, GitHub has for an artifact digest and check them: that a statement covers the digest and has the expected predicate type, that its signature matches the signing certificate, and optionally that it was signed by a given workflow. Reports the repository, ref, commit and run the artifact was built from. "+attestationNotChecked)),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_VERIFY_ATTESTATION_USER_TITLE", "Verify artifact attestation"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Owner of the repository, or the organization, the attestations belong to"),
+			),
+			mcp.WithString("repo",
+				mcp.Description("Repository the attestations belong to. Omit to look in the whole organization."),
+			),
+			mcp.WithString("digest",
+				mcp.Required(),
+				mcp.Description("Digest of the artifact, e.g. 'sha256:9f86d0…'. A bare hex digest is taken as SHA-256."),
+			),
+			mcp.WithString("predicate_type",
+				mcp.Description("Predicate type the attestation must have. Pass an empty string to accept any."),
+				mcp.DefaultString(SLSAProvenancePredicateType),
+			),
+			mcp.WithString("signer_workflow",
+				mcp.Description("Workflow that must have signed the attestation, e.g. 'octo/app/.github/workflows/release.yml'"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := OptionalParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			digest, err := RequiredParam[string](request, "digest")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			// OptionalParam cannot tell an empty string from a missing one, and an empty string accepts any type.
+			predicateType := SLSAProvenancePredicateType
+			if _, ok := request.GetArguments()["predicate_type"]; ok {
+				if predicateType, err = OptionalParam[string](request, "predicate_type"); err != nil {
+					return mcp.NewToolResultError(err.Error()), nil
+				}
+			}
+			signerWorkflow, err := OptionalParam[string](request, "signer_workflow")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if !strings.Contains(digest, ":") {
+				digest = "sha256:" + digest
+			}
+			digest = strings.ToLower(digest)
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			var attestations *github.AttestationsResponse
+			var resp *github.Response
+			opts := &github.ListOptions{PerPage: 100}
+			if repo != "" {
+				attestations, resp, err = client.Repositories.ListAttestations(ctx, owner, repo, digest, opts)
+			} else {
+				attestations, resp, err = client.Organizations.ListAttestations(ctx, owner, digest, opts)
+			}
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("failed to get attestations for %s", digest), resp, err), nil
+			}
+			_ = resp.Body.Close()
+
+			results := []AttestationVerification{}
+			verified := false
+			for _, attestation := range attestations.Attestations {
+				result := verifyAttestation(attestation, digest, predicateType, signerWorkflow)
+				verified = verified || result.Verified
+				results = append(results, result)
+			}
+
+			response := map[string]any{
+				"digest":       digest,
+				"verified":     verified,
+				"attestations": results,
+				"not_checked":  attestationNotChecked,
+			}
+			if len(results) == 0 {
+				response["message"] = "no attestations found for this digest"
+			}
+			return MarshalledTextResult(response), nil
+		}
+}
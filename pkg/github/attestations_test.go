@@ -0,0 +1,168 @@
+package github
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/ghmock"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const attestedDigest = "sha256:9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"
+
+// signAttestationBundle builds a Sigstore bundle for a provenance statement about subjectDigest, signed by a
+// short-lived certificate of the release workflow of octo/app.
+func signAttestationBundle(t *testing.T, subjectDigest string) map[string]any {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	identity, err := url.Parse("https://github.com/octo/app/.github/workflows/release.yml@refs/tags/v1.0.0")
+	require.NoError(t, err)
+	extension := func(oid asn1.ObjectIdentifier, value string) pkix.Extension {
+		der, err := asn1.Marshal(value)
+		require.NoError(t, err)
+		return pkix.Extension{Id: oid, Value: der}
+	}
+	signedAt := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "sigstore-intermediate"},
+		NotBefore:    signedAt.Add(-5 * time.Minute),
+		NotAfter:     signedAt.Add(5 * time.Minute),
+		URIs:         []*url.URL{identity},
+		ExtraExtensions: []pkix.Extension{
+			extension(oidSourceRepositoryURI, "https://github.com/octo/app"),
+			extension(oidSourceRepositoryDigest, "abc123def456"),
+			extension(oidSourceRepositoryRef, "refs/tags/v1.0.0"),
+			extension(oidRunInvocationURI, "https://github.com/octo/app/actions/runs/99/attempts/1"),
+		},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+
+	algorithm, hexDigest, _ := strings.Cut(subjectDigest, ":")
+	statement, err := json.Marshal(map[string]any{
+		"_type":         "https://in-toto.io/Statement/v1",
+		"subject":       []map[string]any{{"name": "app.tar.gz", "digest": map[string]string{algorithm: hexDigest}}},
+		"predicateType": SLSAProvenancePredicateType,
+		"predicate":     map[string]any{},
+	})
+	require.NoError(t, err)
+	hash := sha256.Sum256(dssePAE(inTotoPayloadType, statement))
+	signature, err := ecdsa.SignASN1(rand.Reader, key, hash[:])
+	require.NoError(t, err)
+
+	return map[string]any{
+		"mediaType": "application/vnd.dev.sigstore.bundle.v0.3+json",
+		"verificationMaterial": map[string]any{
+			"certificate": map[string]any{"rawBytes": base64.StdEncoding.EncodeToString(der)},
+			"tlogEntries": []map[string]any{{"integratedTime": strconv.FormatInt(signedAt.Unix(), 10)}},
+		},
+		"dsseEnvelope": map[string]any{
+			"payload":     base64.StdEncoding.EncodeToString(statement),
+			"payloadType": inTotoPayloadType,
+			"signatures":  []map[string]any{{"sig": base64.StdEncoding.EncodeToString(signature)}},
+		},
+	}
+}
+
+func Test_VerifyAttestation(t *testing.T) {
+	tool, _ := VerifyAttestation(nil, translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+	assert.True(t, *tool.Annotations.ReadOnlyHint)
+	assert.ElementsMatch(t, []string{"owner", "digest"}, tool.InputSchema.Required)
+
+	type response struct {
+		Digest       string                    `json:"digest"`
+		Verified     bool                      `json:"verified"`
+		Attestations []AttestationVerification `json:"attestations"`
+	}
+	call := func(t *testing.T, bundle map[string]any, args map[string]any) (*ghmock.Server, response) {
+		server := ghmock.New(t)
+		body := map[string]any{"attestations": []map[string]any{{"repository_id": 7, "bundle": bundle}}}
+		server.Respond("GET /repos/octo/app/attestations/"+attestedDigest, http.StatusOK, body)
+		server.Respond("GET /orgs/octo/attestations/"+attestedDigest, http.StatusOK, body)
+		_, handler := VerifyAttestation(server.GetClient(), translations.NullTranslationHelper)
+		if _, ok := args["owner"]; !ok {
+			args["owner"] = "octo"
+		}
+		result := ghmock.CallTool(t, handler, args)
+		require.False(t, result.IsError, ghmock.ResultText(t, result))
+		var resp response
+		require.NoError(t, json.Unmarshal([]byte(ghmock.ResultText(t, result)), &resp))
+		return server, resp
+	}
+
+	t.Run("valid provenance", func(t *testing.T) {
+		_, resp := call(t, signAttestationBundle(t, attestedDigest), map[string]any{
+			"repo": "app", "digest": attestedDigest[len("sha256:"):],
+			"signer_workflow": "octo/app/.github/workflows/release.yml",
+		})
+		assert.Equal(t, attestedDigest, resp.Digest)
+		assert.True(t, resp.Verified)
+		require.Len(t, resp.Attestations, 1)
+		attestation := resp.Attestations[0]
+		assert.Empty(t, attestation.Problems)
+		assert.Equal(t, int64(7), attestation.RepositoryID)
+		assert.Equal(t, []string{"app.tar.gz"}, attestation.Subjects)
+		assert.Equal(t, "https://github.com/octo/app", attestation.SourceRepository)
+		assert.Equal(t, "refs/tags/v1.0.0", attestation.SourceRef)
+		assert.Equal(t, "abc123def456", attestation.SourceCommit)
+		assert.Equal(t, "https://github.com/octo/app/actions/runs/99/attempts/1", attestation.RunURL)
+		assert.Equal(t, "2025-03-01T12:00:00Z", attestation.SignedAt)
+	})
+
+	t.Run("organization", func(t *testing.T) {
+		server, resp := call(t, signAttestationBundle(t, attestedDigest), map[string]any{"digest": attestedDigest})
+		assert.True(t, resp.Verified)
+		server.AssertRequested("GET /orgs/octo/attestations/" + attestedDigest)
+	})
+
+	t.Run("other subject", func(t *testing.T) {
+		other := "sha256:0000000000000000000000000000000000000000000000000000000000000000"
+		_, resp := call(t, signAttestationBundle(t, other), map[string]any{"repo": "app", "digest": attestedDigest})
+		assert.False(t, resp.Verified)
+		assert.Equal(t, []string{"no subject of the statement has the digest " + attestedDigest}, resp.Attestations[0].Problems)
+	})
+
+	t.Run("signature of another key", func(t *testing.T) {
+		bundle := signAttestationBundle(t, attestedDigest)
+		envelope := bundle["dsseEnvelope"].(map[string]any)
+		forged := signAttestationBundle(t, attestedDigest)["dsseEnvelope"].(map[string]any)
+		envelope["signatures"] = forged["signatures"]
+		_, resp := call(t, bundle, map[string]any{"repo": "app", "digest": attestedDigest})
+		assert.False(t, resp.Verified)
+		assert.Equal(t, []string{"the signature does not match the signing certificate"}, resp.Attestations[0].Problems)
+	})
+
+	t.Run("other signer and predicate", func(t *testing.T) {
+		_, resp := call(t, signAttestationBundle(t, attestedDigest), map[string]any{
+			"repo": "app", "digest": attestedDigest,
+			"signer_workflow": "octo/app/.github/workflows/ci.yml",
+			"predicate_type":  "https://spdx.dev/Document/v2.3",
+		})
+		assert.False(t, resp.Verified)
+		assert.Len(t, resp.Attestations[0].Problems, 2)
+
+		_, resp = call(t, signAttestationBundle(t, attestedDigest), map[string]any{
+			"repo": "app", "digest": attestedDigest, "predicate_type": "",
+		})
+		assert.True(t, resp.Verified)
+	})
+}
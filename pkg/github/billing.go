@@ -0,0 +1,353 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v79/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// CopilotDailyMetrics is a flattened view of one day of Copilot usage metrics.
+type CopilotDailyMetrics struct {
+	Date                 string `json:"date"`
+	ActiveUsers          int    `json:"active_users"`
+	EngagedUsers         int    `json:"engaged_users"`
+	CodeSuggestions      int    `json:"code_suggestions"`
+	CodeAcceptances      int    `json:"code_acceptances"`
+	CodeLinesSuggested   int    `json:"code_lines_suggested"`
+	CodeLinesAccepted    int    `json:"code_lines_accepted"`
+	IDEChats             int    `json:"ide_chats"`
+	DotcomChats          int    `json:"dotcom_chats"`
+	PullRequestSummaries int    `json:"pull_request_summaries"`
+}
+
+// ActionsUsageSummary aggregates the Actions line items of a billing usage report by SKU.
+type ActionsUsageSummary struct {
+	SKU         string  `json:"sku"`
+	UnitType    string  `json:"unit_type"`
+	Quantity    int     `json:"quantity"`
+	GrossAmount float64 `json:"gross_amount"`
+	NetAmount   float64 `json:"net_amount"`
+}
+
+// GetCopilotMetrics creates a tool to fetch daily Copilot usage metrics for an organization or one of its teams.
+func GetCopilotMetrics(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_copilot_metrics",
+			mcp.WithDescription(t("TOOL_GET_COPILOT_METRICS_DESCRIPTION", "Get daily GitHub Copilot usage metrics for an organization, or a team within it. Returns active and engaged users, code completion suggestions and acceptances, and chat usage per day, plus totals for the period. Requires an organization owner or billing manager token.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_GET_COPILOT_METRICS_USER_TITLE", "Get Copilot usage metrics"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("org",
+				mcp.Required(),
+				mcp.Description("Organization name"),
+			),
+			mcp.WithString("team_slug",
+				mcp.Description("Only return metrics for this team"),
+			),
+			mcp.WithString("since",
+				mcp.Description("Only return metrics from this day onwards (ISO 8601 date or timestamp). GitHub keeps at most 28 days of history."),
+			),
+			mcp.WithString("until",
+				mcp.Description("Only return metrics up to this day (ISO 8601 date or timestamp)"),
+			),
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			org, err := RequiredParam[string](request, "org")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			teamSlug, err := OptionalParam[string](request, "team_slug")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			since, err := OptionalParam[string](request, "since")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			until, err := OptionalParam[string](request, "until")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			opts := &github.CopilotMetricsListOptions{
+				ListOptions: github.ListOptions{
+					Page:    pagination.Page,
+					PerPage: pagination.PerPage,
+				},
+			}
+			if since != "" {
+				sinceTime, err := parseISOTimestamp(since)
+				if err != nil {
+					return mcp.NewToolResultError(fmt.Sprintf("invalid since timestamp: %v", err)), nil
+				}
+				opts.Since = &sinceTime
+			}
+			if until != "" {
+				untilTime, err := parseISOTimestamp(until)
+				if err != nil {
+					return mcp.NewToolResultError(fmt.Sprintf("invalid until timestamp: %v", err)), nil
+				}
+				opts.Until = &untilTime
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			var metrics []*github.CopilotMetrics
+			var resp *github.Response
+			if teamSlug != "" {
+				metrics, resp, err = client.Copilot.GetOrganizationTeamMetrics(ctx, org, teamSlug, opts)
+			} else {
+				metrics, resp, err = client.Copilot.GetOrganizationMetrics(ctx, org, opts)
+			}
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to get Copilot metrics for '%s'", org),
+					resp,
+					err,
+				), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to get Copilot metrics: %s", string(body))), nil
+			}
+
+			days := make([]CopilotDailyMetrics, 0, len(metrics))
+			totals := CopilotDailyMetrics{}
+			for _, m := range metrics {
+				day := convertToCopilotDailyMetrics(m)
+				days = append(days, day)
+
+				totals.CodeSuggestions += day.CodeSuggestions
+				totals.CodeAcceptances += day.CodeAcceptances
+				totals.CodeLinesSuggested += day.CodeLinesSuggested
+				totals.CodeLinesAccepted += day.CodeLinesAccepted
+				totals.IDEChats += day.IDEChats
+				totals.DotcomChats += day.DotcomChats
+				totals.PullRequestSummaries += day.PullRequestSummaries
+				totals.ActiveUsers = max(totals.ActiveUsers, day.ActiveUsers)
+				totals.EngagedUsers = max(totals.EngagedUsers, day.EngagedUsers)
+			}
+
+			var acceptanceRate float64
+			if totals.CodeSuggestions > 0 {
+				acceptanceRate = float64(totals.CodeAcceptances) / float64(totals.CodeSuggestions)
+			}
+
+			r, err := json.Marshal(map[string]any{
+				"org":       org,
+				"team_slug": teamSlug,
+				"days":      days,
+				"totals": map[string]any{
+					"peak_active_users":      totals.ActiveUsers,
+					"peak_engaged_users":     totals.EngagedUsers,
+					"code_suggestions":       totals.CodeSuggestions,
+					"code_acceptances":       totals.CodeAcceptances,
+					"code_lines_suggested":   totals.CodeLinesSuggested,
+					"code_lines_accepted":    totals.CodeLinesAccepted,
+					"acceptance_rate":        acceptanceRate,
+					"ide_chats":              totals.IDEChats,
+					"dotcom_chats":           totals.DotcomChats,
+					"pull_request_summaries": totals.PullRequestSummaries,
+				},
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// GetActionsBillingSummary creates a tool to summarize the Actions minutes and storage billed to an organization.
+func GetActionsBillingSummary(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_actions_billing_summary",
+			mcp.WithDescription(t("TOOL_GET_ACTIONS_BILLING_SUMMARY_DESCRIPTION", "Summarize GitHub Actions consumption for an organization from its billing usage report. Returns minutes and storage grouped by SKU (for example runner operating system or storage), with gross and net amounts. Defaults to the current year; narrow it down with month and day. Requires an organization on the enhanced billing platform and an owner or billing manager token.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_GET_ACTIONS_BILLING_SUMMARY_USER_TITLE", "Get Actions billing summary"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("org",
+				mcp.Required(),
+				mcp.Description("Organization name"),
+			),
+			mcp.WithNumber("year",
+				mcp.Description("Four digit year to report on. Defaults to the current year."),
+			),
+			mcp.WithNumber("month",
+				mcp.Description("Month to report on (1-12)"),
+				mcp.Min(1),
+				mcp.Max(12),
+			),
+			mcp.WithNumber("day",
+				mcp.Description("Day of the month to report on (1-31). Requires month."),
+				mcp.Min(1),
+				mcp.Max(31),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			org, err := RequiredParam[string](request, "org")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			year, err := OptionalIntParam(request, "year")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			month, err := OptionalIntParam(request, "month")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			day, err := OptionalIntParam(request, "day")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if day != 0 && month == 0 {
+				return mcp.NewToolResultError("month is required when day is set"), nil
+			}
+
+			opts := &github.UsageReportOptions{}
+			if year != 0 {
+				opts.Year = github.Ptr(year)
+			}
+			if month != 0 {
+				opts.Month = github.Ptr(month)
+			}
+			if day != 0 {
+				opts.Day = github.Ptr(day)
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			report, resp, err := client.Billing.GetOrganizationUsageReport(ctx, org, opts)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to get billing usage report for '%s'", org),
+					resp,
+					err,
+				), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to get billing usage report: %s", string(body))), nil
+			}
+
+			skus := summarizeActionsUsage(report.UsageItems)
+			var gross, net float64
+			for _, sku := range skus {
+				gross += sku.GrossAmount
+				net += sku.NetAmount
+			}
+
+			r, err := json.Marshal(map[string]any{
+				"org":                org,
+				"skus":               skus,
+				"total_gross_amount": gross,
+				"total_net_amount":   net,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+func convertToCopilotDailyMetrics(m *github.CopilotMetrics) CopilotDailyMetrics {
+	day := CopilotDailyMetrics{Date: m.Date}
+	if m.TotalActiveUsers != nil {
+		day.ActiveUsers = *m.TotalActiveUsers
+	}
+	if m.TotalEngagedUsers != nil {
+		day.EngagedUsers = *m.TotalEngagedUsers
+	}
+	if completions := m.CopilotIDECodeCompletions; completions != nil {
+		for _, editor := range completions.Editors {
+			for _, model := range editor.Models {
+				for _, language := range model.Languages {
+					day.CodeSuggestions += language.TotalCodeSuggestions
+					day.CodeAcceptances += language.TotalCodeAcceptances
+					day.CodeLinesSuggested += language.TotalCodeLinesSuggested
+					day.CodeLinesAccepted += language.TotalCodeLinesAccepted
+				}
+			}
+		}
+	}
+	if chat := m.CopilotIDEChat; chat != nil {
+		for _, editor := range chat.Editors {
+			for _, model := range editor.Models {
+				day.IDEChats += model.TotalChats
+			}
+		}
+	}
+	if chat := m.CopilotDotcomChat; chat != nil {
+		for _, model := range chat.Models {
+			day.DotcomChats += model.TotalChats
+		}
+	}
+	if pulls := m.CopilotDotcomPullRequests; pulls != nil {
+		for _, repo := range pulls.Repositories {
+			for _, model := range repo.Models {
+				day.PullRequestSummaries += model.TotalPRSummariesCreated
+			}
+		}
+	}
+	return day
+}
+
+// summarizeActionsUsage keeps the Actions line items of a usage report and sums them per SKU.
+func summarizeActionsUsage(items []*github.UsageItem) []ActionsUsageSummary {
+	bySKU := make(map[string]*ActionsUsageSummary)
+	for _, item := range items {
+		if !strings.EqualFold(item.Product, "actions") {
+			continue
+		}
+		summary, ok := bySKU[item.SKU]
+		if !ok {
+			summary = &ActionsUsageSummary{SKU: item.SKU, UnitType: item.UnitType}
+			bySKU[item.SKU] = summary
+		}
+		summary.Quantity += item.Quantity
+		summary.GrossAmount += item.GrossAmount
+		summary.NetAmount += item.NetAmount
+	}
+
+	summaries := make([]ActionsUsageSummary, 0, len(bySKU))
+	for _, summary := range bySKU {
+		summaries = append(summaries, *summary)
+	}
+	sort.Slice(summaries, func(i, j int) bool {
+		return summaries[i].SKU < summaries[j].SKU
+	})
+	return summaries
+}
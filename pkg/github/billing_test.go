@@ -0,0 +1,295 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v79/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_GetCopilotMetrics(t *testing.T) {
+	mockClient := github.NewClient(nil)
+	tool, _ := GetCopilotMetrics(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "get_copilot_metrics", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "org")
+	assert.Contains(t, tool.InputSchema.Properties, "team_slug")
+	assert.Contains(t, tool.InputSchema.Properties, "since")
+	assert.Contains(t, tool.InputSchema.Properties, "until")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"org"})
+
+	mockMetrics := []*github.CopilotMetrics{
+		{
+			Date:              "2025-03-01",
+			TotalActiveUsers:  github.Ptr(10),
+			TotalEngagedUsers: github.Ptr(8),
+			CopilotIDECodeCompletions: &github.CopilotIDECodeCompletions{
+				Editors: []*github.CopilotIDECodeCompletionsEditor{
+					{
+						Name: "vscode",
+						Models: []*github.CopilotIDECodeCompletionsModel{
+							{
+								Name: "default",
+								Languages: []*github.CopilotIDECodeCompletionsModelLanguage{
+									{Name: "go", TotalCodeSuggestions: 100, TotalCodeAcceptances: 30, TotalCodeLinesSuggested: 200, TotalCodeLinesAccepted: 50},
+									{Name: "python", TotalCodeSuggestions: 50, TotalCodeAcceptances: 20, TotalCodeLinesSuggested: 80, TotalCodeLinesAccepted: 25},
+								},
+							},
+						},
+					},
+				},
+			},
+			CopilotIDEChat: &github.CopilotIDEChat{
+				Editors: []*github.CopilotIDEChatEditor{
+					{Name: "vscode", Models: []*github.CopilotIDEChatModel{{Name: "default", TotalChats: 12}}},
+				},
+			},
+		},
+		{
+			Date:              "2025-03-02",
+			TotalActiveUsers:  github.Ptr(12),
+			TotalEngagedUsers: github.Ptr(6),
+			CopilotDotcomChat: &github.CopilotDotcomChat{
+				Models: []*github.CopilotDotcomChatModel{{Name: "default", TotalChats: 4}},
+			},
+			CopilotDotcomPullRequests: &github.CopilotDotcomPullRequests{
+				Repositories: []*github.CopilotDotcomPullRequestsRepository{
+					{Name: "octo-org/app", Models: []*github.CopilotDotcomPullRequestsModel{{Name: "default", TotalPRSummariesCreated: 3}}},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedErrMsg string
+	}{
+		{
+			name: "organization metrics for a date range",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetOrgsCopilotMetricsByOrg,
+					expectQueryParams(t, map[string]string{
+						"since":    "2025-03-01T00:00:00Z",
+						"until":    "2025-03-02T00:00:00Z",
+						"page":     "1",
+						"per_page": "30",
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockMetrics),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"org":   "octo-org",
+				"since": "2025-03-01",
+				"until": "2025-03-02",
+			},
+		},
+		{
+			name: "team metrics",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetOrgsTeamCopilotMetricsByOrgByTeamSlug,
+					expectPath(t, "/orgs/octo-org/team/platform/copilot/metrics").andThen(
+						mockResponse(t, http.StatusOK, mockMetrics),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"org":       "octo-org",
+				"team_slug": "platform",
+			},
+		},
+		{
+			name:         "invalid since",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"org":   "octo-org",
+				"since": "last week",
+			},
+			expectError:    true,
+			expectedErrMsg: "invalid since timestamp",
+		},
+		{
+			name: "metrics disabled",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetOrgsCopilotMetricsByOrg,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusUnprocessableEntity)
+						_, _ = w.Write([]byte(`{"message": "Copilot Usage Metrics API setting is disabled at the organization level."}`))
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"org": "octo-org",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to get Copilot metrics for 'octo-org'",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := GetCopilotMetrics(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+			require.NoError(t, err)
+
+			if tc.expectError {
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			textContent := getTextResult(t, result)
+			var response struct {
+				Days   []CopilotDailyMetrics `json:"days"`
+				Totals map[string]float64    `json:"totals"`
+			}
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &response))
+			require.Len(t, response.Days, 2)
+			assert.Equal(t, CopilotDailyMetrics{
+				Date:               "2025-03-01",
+				ActiveUsers:        10,
+				EngagedUsers:       8,
+				CodeSuggestions:    150,
+				CodeAcceptances:    50,
+				CodeLinesSuggested: 280,
+				CodeLinesAccepted:  75,
+				IDEChats:           12,
+			}, response.Days[0])
+			assert.Equal(t, 4, response.Days[1].DotcomChats)
+			assert.Equal(t, 3, response.Days[1].PullRequestSummaries)
+			assert.Equal(t, float64(12), response.Totals["peak_active_users"])
+			assert.Equal(t, float64(8), response.Totals["peak_engaged_users"])
+			assert.InDelta(t, 1.0/3.0, response.Totals["acceptance_rate"], 0.0001)
+		})
+	}
+}
+
+func Test_GetActionsBillingSummary(t *testing.T) {
+	mockClient := github.NewClient(nil)
+	tool, _ := GetActionsBillingSummary(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "get_actions_billing_summary", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "year")
+	assert.Contains(t, tool.InputSchema.Properties, "month")
+	assert.Contains(t, tool.InputSchema.Properties, "day")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"org"})
+
+	mockReport := &github.UsageReport{
+		UsageItems: []*github.UsageItem{
+			{Date: "2025-03-01", Product: "actions", SKU: "Actions Linux", Quantity: 120, UnitType: "minutes", GrossAmount: 0.96, NetAmount: 0.96},
+			{Date: "2025-03-02", Product: "actions", SKU: "Actions Linux", Quantity: 80, UnitType: "minutes", GrossAmount: 0.64, NetAmount: 0.64},
+			{Date: "2025-03-01", Product: "Actions", SKU: "Actions Storage", Quantity: 2, UnitType: "GigabyteHours", GrossAmount: 0.01, NetAmount: 0},
+			{Date: "2025-03-01", Product: "packages", SKU: "Packages storage", Quantity: 5, UnitType: "GigabyteHours", GrossAmount: 0.02, NetAmount: 0.02},
+		},
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedSKUs   []ActionsUsageSummary
+		expectedErrMsg string
+	}{
+		{
+			name: "summarize a month",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetOrganizationsSettingsBillingUsageByOrg,
+					expectQueryParams(t, map[string]string{
+						"year":  "2025",
+						"month": "3",
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockReport),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"org":   "octo-org",
+				"year":  float64(2025),
+				"month": float64(3),
+			},
+			expectedSKUs: []ActionsUsageSummary{
+				{SKU: "Actions Linux", UnitType: "minutes", Quantity: 200, GrossAmount: 1.6, NetAmount: 1.6},
+				{SKU: "Actions Storage", UnitType: "GigabyteHours", Quantity: 2, GrossAmount: 0.01, NetAmount: 0},
+			},
+		},
+		{
+			name:         "day without month",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"org": "octo-org",
+				"day": float64(3),
+			},
+			expectError:    true,
+			expectedErrMsg: "month is required when day is set",
+		},
+		{
+			name: "enhanced billing not available",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetOrganizationsSettingsBillingUsageByOrg,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusNotFound)
+						_, _ = w.Write([]byte(`{"message": "Not Found"}`))
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"org": "octo-org",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to get billing usage report for 'octo-org'",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := GetActionsBillingSummary(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+			require.NoError(t, err)
+
+			if tc.expectError {
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			textContent := getTextResult(t, result)
+			var response struct {
+				SKUs           []ActionsUsageSummary `json:"skus"`
+				TotalNetAmount float64               `json:"total_net_amount"`
+			}
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &response))
+			require.Len(t, response.SKUs, len(tc.expectedSKUs))
+			for i, expected := range tc.expectedSKUs {
+				assert.Equal(t, expected.SKU, response.SKUs[i].SKU)
+				assert.Equal(t, expected.UnitType, response.SKUs[i].UnitType)
+				assert.Equal(t, expected.Quantity, response.SKUs[i].Quantity)
+				assert.InDelta(t, expected.NetAmount, response.SKUs[i].NetAmount, 0.0001)
+			}
+			assert.InDelta(t, 1.6, response.TotalNetAmount, 0.0001)
+		})
+	}
+}
@@ -0,0 +1,558 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v79/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// BranchProtectionSource is the source name used for requirements that come from classic branch protection.
+const BranchProtectionSource = "branch_protection"
+
+// AppliedRuleset describes a ruleset that has at least one active rule on a branch.
+type AppliedRuleset struct {
+	ID          int64    `json:"id"`
+	Name        string   `json:"name,omitempty"`
+	SourceType  string   `json:"source_type"`
+	Source      string   `json:"source"`
+	Enforcement string   `json:"enforcement,omitempty"`
+	Rules       []string `json:"rules"`
+}
+
+// PullRequestRequirement is the strictest combination of the pull request rules that apply to a branch.
+type PullRequestRequirement struct {
+	RequiredApprovingReviewCount   int      `json:"required_approving_review_count"`
+	RequireCodeOwnerReview         bool     `json:"require_code_owner_review"`
+	DismissStaleReviewsOnPush      bool     `json:"dismiss_stale_reviews_on_push"`
+	RequireLastPushApproval        bool     `json:"require_last_push_approval"`
+	RequiredReviewThreadResolution bool     `json:"required_review_thread_resolution"`
+	AllowedMergeMethods            []string `json:"allowed_merge_methods,omitempty"`
+	Sources                        []string `json:"sources"`
+}
+
+// RequiredCheck is a status check that must pass before a branch can be updated.
+type RequiredCheck struct {
+	Context       string   `json:"context"`
+	IntegrationID *int64   `json:"integration_id,omitempty"`
+	Sources       []string `json:"sources"`
+}
+
+// BranchRequirements summarizes what a push or merge to a branch has to satisfy.
+type BranchRequirements struct {
+	PullRequest           *PullRequestRequirement `json:"pull_request,omitempty"`
+	RequiredStatusChecks  []RequiredCheck         `json:"required_status_checks,omitempty"`
+	StrictStatusChecks    bool                    `json:"strict_status_checks"`
+	RequiredSignatures    bool                    `json:"required_signatures"`
+	RequiredLinearHistory bool                    `json:"required_linear_history"`
+	RequiredDeployments   []string                `json:"required_deployments,omitempty"`
+	MergeQueue            bool                    `json:"merge_queue"`
+	BlockCreation         bool                    `json:"block_creation"`
+	BlockUpdate           bool                    `json:"block_update"`
+	BlockDeletion         bool                    `json:"block_deletion"`
+	BlockForcePush        bool                    `json:"block_force_push"`
+	EnforceAdmins         bool                    `json:"enforce_admins"`
+}
+
+// branchRulesEvaluation is the combined view of the rulesets and classic branch protection of a branch.
+type branchRulesEvaluation struct {
+	Branch           string             `json:"branch"`
+	Rulesets         []AppliedRuleset   `json:"rulesets"`
+	BranchProtection bool               `json:"branch_protection"`
+	Requirements     BranchRequirements `json:"requirements"`
+	Notes            []string           `json:"notes,omitempty"`
+}
+
+// EvaluateRulesets creates a tool to report the rulesets and branch protection that apply to a branch.
+func EvaluateRulesets(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("evaluate_rulesets",
+			mcp.WithDescription(t("TOOL_EVALUATE_RULESETS_DESCRIPTION", "Report which repository and organization rulesets and which classic branch protection rules apply to a branch, and the combined requirements (reviews, status checks, signatures, linear history, deployments, merge queue and push restrictions) a push or merge to it must satisfy.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_EVALUATE_RULESETS_USER_TITLE", "Evaluate rulesets for a branch"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryOwner),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryName),
+			),
+			mcp.WithString("branch",
+				mcp.Required(),
+				mcp.Description("Branch name to evaluate"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			branch, err := RequiredParam[string](request, "branch")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			evaluation, result, err := evaluateBranchRules(ctx, client, owner, repo, branch)
+			if result != nil || err != nil {
+				return result, err
+			}
+
+			r, err := json.Marshal(evaluation)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// evaluateBranchRules fetches the active rules and the classic branch protection of a branch and merges them into
+// a single set of requirements. A non-nil result is an error response that should be returned to the caller as is.
+func evaluateBranchRules(ctx context.Context, client *github.Client, owner, repo, branch string) (*branchRulesEvaluation, *mcp.CallToolResult, error) {
+	rules, resp, err := client.Repositories.GetRulesForBranch(ctx, owner, repo, branch, &github.ListOptions{PerPage: 100})
+	if err != nil {
+		return nil, ghErrors.NewGitHubAPIErrorResponse(ctx,
+			fmt.Sprintf("failed to get rules for branch %s", branch),
+			resp,
+			err,
+		), nil
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		body, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to read response body: %w", err)
+		}
+		return nil, mcp.NewToolResultError(fmt.Sprintf("failed to get rules for branch: %s", string(body))), nil
+	}
+
+	evaluation := &branchRulesEvaluation{
+		Branch:   branch,
+		Rulesets: []AppliedRuleset{},
+	}
+
+	// The branch rules only reference rulesets by ID, so look up their names and enforcement separately.
+	rulesets := make(map[int64]*github.RepositoryRuleset)
+	if rules != nil {
+		list, resp, err := client.Repositories.GetAllRulesets(ctx, owner, repo, &github.RepositoryListRulesetsOptions{
+			IncludesParents: github.Ptr(true),
+			ListOptions:     github.ListOptions{PerPage: 100},
+		})
+		if err != nil {
+			return nil, ghErrors.NewGitHubAPIErrorResponse(ctx,
+				fmt.Sprintf("failed to list rulesets for %s/%s", owner, repo),
+				resp,
+				err,
+			), nil
+		}
+		_ = resp.Body.Close()
+		for _, rs := range list {
+			rulesets[rs.GetID()] = rs
+		}
+	}
+
+	rulesetIndex := make(map[int64]int)
+	source := func(meta github.BranchRuleMetadata, rule string) string {
+		i, ok := rulesetIndex[meta.RulesetID]
+		if !ok {
+			applied := AppliedRuleset{
+				ID:         meta.RulesetID,
+				SourceType: string(meta.RulesetSourceType),
+				Source:     meta.RulesetSource,
+			}
+			if rs, ok := rulesets[meta.RulesetID]; ok {
+				applied.Name = rs.Name
+				applied.Enforcement = string(rs.Enforcement)
+			}
+			i = len(evaluation.Rulesets)
+			rulesetIndex[meta.RulesetID] = i
+			evaluation.Rulesets = append(evaluation.Rulesets, applied)
+		}
+		evaluation.Rulesets[i].Rules = append(evaluation.Rulesets[i].Rules, rule)
+		if evaluation.Rulesets[i].Name != "" {
+			return "ruleset:" + evaluation.Rulesets[i].Name
+		}
+		return fmt.Sprintf("ruleset:%d", meta.RulesetID)
+	}
+
+	req := &evaluation.Requirements
+	if rules != nil {
+		for _, rule := range rules.Creation {
+			source(*rule, "creation")
+			req.BlockCreation = true
+		}
+		for _, rule := range rules.Update {
+			source(rule.BranchRuleMetadata, "update")
+			req.BlockUpdate = true
+		}
+		for _, rule := range rules.Deletion {
+			source(*rule, "deletion")
+			req.BlockDeletion = true
+		}
+		for _, rule := range rules.NonFastForward {
+			source(*rule, "non_fast_forward")
+			req.BlockForcePush = true
+		}
+		for _, rule := range rules.RequiredLinearHistory {
+			source(*rule, "required_linear_history")
+			req.RequiredLinearHistory = true
+		}
+		for _, rule := range rules.RequiredSignatures {
+			source(*rule, "required_signatures")
+			req.RequiredSignatures = true
+		}
+		for _, rule := range rules.MergeQueue {
+			source(rule.BranchRuleMetadata, "merge_queue")
+			req.MergeQueue = true
+		}
+		for _, rule := range rules.RequiredDeployments {
+			source(rule.BranchRuleMetadata, "required_deployments")
+			req.RequiredDeployments = appendUnique(req.RequiredDeployments, rule.Parameters.RequiredDeploymentEnvironments...)
+		}
+		for _, rule := range rules.PullRequest {
+			src := source(rule.BranchRuleMetadata, "pull_request")
+			methods := make([]string, 0, len(rule.Parameters.AllowedMergeMethods))
+			for _, m := range rule.Parameters.AllowedMergeMethods {
+				methods = append(methods, string(m))
+			}
+			mergePullRequestRequirement(req, src, PullRequestRequirement{
+				RequiredApprovingReviewCount:   rule.Parameters.RequiredApprovingReviewCount,
+				RequireCodeOwnerReview:         rule.Parameters.RequireCodeOwnerReview,
+				DismissStaleReviewsOnPush:      rule.Parameters.DismissStaleReviewsOnPush,
+				RequireLastPushApproval:        rule.Parameters.RequireLastPushApproval,
+				RequiredReviewThreadResolution: rule.Parameters.RequiredReviewThreadResolution,
+				AllowedMergeMethods:            methods,
+			})
+		}
+		for _, rule := range rules.RequiredStatusChecks {
+			src := source(rule.BranchRuleMetadata, "required_status_checks")
+			req.StrictStatusChecks = req.StrictStatusChecks || rule.Parameters.StrictRequiredStatusChecksPolicy
+			for _, check := range rule.Parameters.RequiredStatusChecks {
+				addRequiredCheck(req, src, check.Context, check.IntegrationID)
+			}
+		}
+	}
+
+	protection, resp, err := client.Repositories.GetBranchProtection(ctx, owner, repo, branch)
+	switch {
+	case errors.Is(err, github.ErrBranchNotProtected):
+		// No classic branch protection, only rulesets apply.
+	case err != nil && resp != nil && (resp.StatusCode == http.StatusNotFound || resp.StatusCode == http.StatusForbidden):
+		// Reading branch protection requires admin access, so report the rulesets on their own.
+		evaluation.Notes = append(evaluation.Notes, "Classic branch protection could not be read with the current token; only rulesets are included.")
+	case err != nil:
+		return nil, ghErrors.NewGitHubAPIErrorResponse(ctx,
+			fmt.Sprintf("failed to get branch protection for %s", branch),
+			resp,
+			err,
+		), nil
+	default:
+		_ = resp.Body.Close()
+		evaluation.BranchProtection = true
+		applyBranchProtection(req, protection)
+	}
+
+	return evaluation, nil, nil
+}
+
+// applyBranchProtection merges the requirements of classic branch protection into req.
+func applyBranchProtection(req *BranchRequirements, protection *github.Protection) {
+	if reviews := protection.RequiredPullRequestReviews; reviews != nil {
+		mergePullRequestRequirement(req, BranchProtectionSource, PullRequestRequirement{
+			RequiredApprovingReviewCount:   reviews.RequiredApprovingReviewCount,
+			RequireCodeOwnerReview:         reviews.RequireCodeOwnerReviews,
+			DismissStaleReviewsOnPush:      reviews.DismissStaleReviews,
+			RequireLastPushApproval:        reviews.RequireLastPushApproval,
+			RequiredReviewThreadResolution: protection.RequiredConversationResolution != nil && protection.RequiredConversationResolution.Enabled,
+		})
+	}
+	if checks := protection.RequiredStatusChecks; checks != nil {
+		req.StrictStatusChecks = req.StrictStatusChecks || checks.Strict
+		if checks.Checks != nil {
+			for _, check := range *checks.Checks {
+				addRequiredCheck(req, BranchProtectionSource, check.Context, check.AppID)
+			}
+		} else if checks.Contexts != nil {
+			for _, name := range *checks.Contexts {
+				addRequiredCheck(req, BranchProtectionSource, name, nil)
+			}
+		}
+	}
+	if protection.RequiredSignatures.GetEnabled() {
+		req.RequiredSignatures = true
+	}
+	if protection.RequireLinearHistory != nil && protection.RequireLinearHistory.Enabled {
+		req.RequiredLinearHistory = true
+	}
+	if protection.AllowForcePushes == nil || !protection.AllowForcePushes.Enabled {
+		req.BlockForcePush = true
+	}
+	if protection.AllowDeletions == nil || !protection.AllowDeletions.Enabled {
+		req.BlockDeletion = true
+	}
+	if protection.BlockCreations.GetEnabled() {
+		req.BlockCreation = true
+	}
+	if protection.LockBranch.GetEnabled() {
+		req.BlockUpdate = true
+	}
+	if protection.EnforceAdmins != nil && protection.EnforceAdmins.Enabled {
+		req.EnforceAdmins = true
+	}
+}
+
+// mergePullRequestRequirement combines a pull request rule into req, keeping the strictest value of each setting.
+func mergePullRequestRequirement(req *BranchRequirements, source string, rule PullRequestRequirement) {
+	if req.PullRequest == nil {
+		req.PullRequest = &PullRequestRequirement{Sources: []string{}}
+	}
+	pr := req.PullRequest
+	pr.RequiredApprovingReviewCount = max(pr.RequiredApprovingReviewCount, rule.RequiredApprovingReviewCount)
+	pr.RequireCodeOwnerReview = pr.RequireCodeOwnerReview || rule.RequireCodeOwnerReview
+	pr.DismissStaleReviewsOnPush = pr.DismissStaleReviewsOnPush || rule.DismissStaleReviewsOnPush
+	pr.RequireLastPushApproval = pr.RequireLastPushApproval || rule.RequireLastPushApproval
+	pr.RequiredReviewThreadResolution = pr.RequiredReviewThreadResolution || rule.RequiredReviewThreadResolution
+	if len(rule.AllowedMergeMethods) > 0 {
+		if len(pr.AllowedMergeMethods) == 0 {
+			pr.AllowedMergeMethods = rule.AllowedMergeMethods
+		} else {
+			pr.AllowedMergeMethods = intersectStrings(pr.AllowedMergeMethods, rule.AllowedMergeMethods)
+		}
+	}
+	pr.Sources = appendUnique(pr.Sources, source)
+}
+
+// addRequiredCheck records a required status check, merging duplicates that come from different sources.
+func addRequiredCheck(req *BranchRequirements, source, name string, integrationID *int64) {
+	for i := range req.RequiredStatusChecks {
+		if req.RequiredStatusChecks[i].Context == name {
+			req.RequiredStatusChecks[i].Sources = appendUnique(req.RequiredStatusChecks[i].Sources, source)
+			return
+		}
+	}
+	req.RequiredStatusChecks = append(req.RequiredStatusChecks, RequiredCheck{
+		Context:       name,
+		IntegrationID: integrationID,
+		Sources:       []string{source},
+	})
+	sort.SliceStable(req.RequiredStatusChecks, func(i, j int) bool {
+		return req.RequiredStatusChecks[i].Context < req.RequiredStatusChecks[j].Context
+	})
+}
+
+func appendUnique(values []string, add ...string) []string {
+	for _, v := range add {
+		found := false
+		for _, existing := range values {
+			if existing == v {
+				found = true
+				break
+			}
+		}
+		if !found {
+			values = append(values, v)
+		}
+	}
+	return values
+}
+
+func intersectStrings(a, b []string) []string {
+	result := []string{}
+	for _, v := range a {
+		for _, w := range b {
+			if v == w {
+				result = append(result, v)
+				break
+			}
+		}
+	}
+	return result
+}
+
+// RequiredCheckState is the state of a required status check on the head commit of a branch.
+type RequiredCheckState struct {
+	RequiredCheck
+	// State is one of success, failure, pending or missing.
+	State      string `json:"state"`
+	Conclusion string `json:"conclusion,omitempty"`
+	DetailsURL string `json:"details_url,omitempty"`
+}
+
+// GetRequiredChecks creates a tool to report the required status checks of a branch and their state on its head commit.
+func GetRequiredChecks(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_required_checks",
+			mcp.WithDescription(t("TOOL_GET_REQUIRED_CHECKS_DESCRIPTION", "List the status checks required by rulesets and branch protection on a branch, together with the state of each one on the latest commit of the branch. Checks that have not passed are listed under 'blocking', answering what is blocking a merge in one call.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_GET_REQUIRED_CHECKS_USER_TITLE", "Get required status checks"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryOwner),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryName),
+			),
+			mcp.WithString("branch",
+				mcp.Required(),
+				mcp.Description("Branch name whose required checks to report"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			branch, err := RequiredParam[string](request, "branch")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			evaluation, result, err := evaluateBranchRules(ctx, client, owner, repo, branch)
+			if result != nil || err != nil {
+				return result, err
+			}
+
+			b, resp, err := client.Repositories.GetBranch(ctx, owner, repo, branch, 1)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to get branch %s", branch),
+					resp,
+					err,
+				), nil
+			}
+			_ = resp.Body.Close()
+			sha := b.GetCommit().GetSHA()
+
+			var checkRuns []*github.CheckRun
+			opts := &github.ListCheckRunsOptions{ListOptions: github.ListOptions{PerPage: 100}}
+			for {
+				page, resp, err := client.Checks.ListCheckRunsForRef(ctx, owner, repo, sha, opts)
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx,
+						fmt.Sprintf("failed to list check runs for %s", sha),
+						resp,
+						err,
+					), nil
+				}
+				_ = resp.Body.Close()
+				checkRuns = append(checkRuns, page.CheckRuns...)
+				if resp.NextPage == 0 {
+					break
+				}
+				opts.Page = resp.NextPage
+			}
+
+			status, resp, err := client.Repositories.GetCombinedStatus(ctx, owner, repo, sha, &github.ListOptions{PerPage: 100})
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to get combined status for %s", sha),
+					resp,
+					err,
+				), nil
+			}
+			_ = resp.Body.Close()
+
+			checks := make([]RequiredCheckState, 0, len(evaluation.Requirements.RequiredStatusChecks))
+			blocking := []string{}
+			for _, required := range evaluation.Requirements.RequiredStatusChecks {
+				state := requiredCheckState(required, checkRuns, status.Statuses)
+				if state.State != "success" {
+					blocking = append(blocking, required.Context)
+				}
+				checks = append(checks, state)
+			}
+
+			r, err := json.Marshal(map[string]any{
+				"branch":   branch,
+				"sha":      sha,
+				"strict":   evaluation.Requirements.StrictStatusChecks,
+				"checks":   checks,
+				"blocking": blocking,
+				"passing":  len(blocking) == 0,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// requiredCheckState finds the check run or commit status that satisfies a required check. Check runs take
+// precedence over commit statuses, and a check pinned to an integration only matches check runs from that app.
+func requiredCheckState(required RequiredCheck, checkRuns []*github.CheckRun, statuses []*github.RepoStatus) RequiredCheckState {
+	state := RequiredCheckState{RequiredCheck: required, State: "missing"}
+	anyApp := required.IntegrationID == nil || *required.IntegrationID == -1
+
+	for _, run := range checkRuns {
+		if run.GetName() != required.Context || (!anyApp && run.GetApp().GetID() != *required.IntegrationID) {
+			continue
+		}
+		state.DetailsURL = run.GetHTMLURL()
+		if run.GetStatus() != "completed" {
+			state.State = "pending"
+			return state
+		}
+		state.Conclusion = run.GetConclusion()
+		switch run.GetConclusion() {
+		case "success", "neutral", "skipped":
+			state.State = "success"
+		default:
+			state.State = "failure"
+		}
+		return state
+	}
+
+	if !anyApp {
+		return state
+	}
+	for _, s := range statuses {
+		if s.GetContext() != required.Context {
+			continue
+		}
+		state.DetailsURL = s.GetTargetURL()
+		switch s.GetState() {
+		case "success":
+			state.State = "success"
+		case "pending":
+			state.State = "pending"
+		default:
+			state.State = "failure"
+		}
+		state.Conclusion = s.GetState()
+		return state
+	}
+	return state
+}
@@ -0,0 +1,277 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v79/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const mockBranchRules = `[
+	{"type": "deletion", "ruleset_source_type": "Repository", "ruleset_source": "owner/repo", "ruleset_id": 1},
+	{"type": "non_fast_forward", "ruleset_source_type": "Repository", "ruleset_source": "owner/repo", "ruleset_id": 1},
+	{"type": "pull_request", "ruleset_source_type": "Repository", "ruleset_source": "owner/repo", "ruleset_id": 1,
+	 "parameters": {"required_approving_review_count": 1, "dismiss_stale_reviews_on_push": true, "require_code_owner_review": false,
+	  "require_last_push_approval": false, "required_review_thread_resolution": true, "allowed_merge_methods": ["squash", "merge"]}},
+	{"type": "required_status_checks", "ruleset_source_type": "Organization", "ruleset_source": "owner", "ruleset_id": 7,
+	 "parameters": {"strict_required_status_checks_policy": true, "required_status_checks": [{"context": "build"}, {"context": "lint", "integration_id": 15368}]}},
+	{"type": "required_signatures", "ruleset_source_type": "Organization", "ruleset_source": "owner", "ruleset_id": 7}
+]`
+
+func mockBranchRulesHandler(body string) http.HandlerFunc {
+	return func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(body))
+	}
+}
+
+var mockRulesets = []*github.RepositoryRuleset{
+	{ID: github.Ptr(int64(1)), Name: "main protection", Enforcement: github.RulesetEnforcementActive},
+	{ID: github.Ptr(int64(7)), Name: "org checks", Enforcement: github.RulesetEnforcementActive},
+}
+
+func Test_EvaluateRulesets(t *testing.T) {
+	mockClient := github.NewClient(nil)
+	tool, _ := EvaluateRulesets(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "evaluate_rulesets", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "branch")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "branch"})
+
+	protection := &github.Protection{
+		RequiredPullRequestReviews: &github.PullRequestReviewsEnforcement{
+			RequiredApprovingReviewCount: 2,
+			RequireCodeOwnerReviews:      true,
+		},
+		RequiredStatusChecks: &github.RequiredStatusChecks{
+			Strict:   false,
+			Contexts: &[]string{"build", "test"},
+		},
+		AllowForcePushes: &github.AllowForcePushes{Enabled: false},
+		AllowDeletions:   &github.AllowDeletions{Enabled: false},
+		EnforceAdmins:    &github.AdminEnforcement{Enabled: true},
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		expectError    bool
+		expectedErrMsg string
+		verify         func(t *testing.T, evaluation branchRulesEvaluation)
+	}{
+		{
+			name: "rulesets and branch protection are combined",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(mock.GetReposRulesBranchesByOwnerByRepoByBranch, mockBranchRulesHandler(mockBranchRules)),
+				mock.WithRequestMatchHandler(
+					mock.GetReposRulesetsByOwnerByRepo,
+					expectQueryParams(t, map[string]string{
+						"includes_parents": "true",
+						"per_page":         "100",
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockRulesets),
+					),
+				),
+				mock.WithRequestMatch(mock.GetReposBranchesProtectionByOwnerByRepoByBranch, protection),
+			),
+			verify: func(t *testing.T, evaluation branchRulesEvaluation) {
+				assert.True(t, evaluation.BranchProtection)
+				assert.Equal(t, []AppliedRuleset{
+					{ID: 1, Name: "main protection", SourceType: "Repository", Source: "owner/repo", Enforcement: "active", Rules: []string{"deletion", "non_fast_forward", "pull_request"}},
+					{ID: 7, Name: "org checks", SourceType: "Organization", Source: "owner", Enforcement: "active", Rules: []string{"required_signatures", "required_status_checks"}},
+				}, evaluation.Rulesets)
+
+				req := evaluation.Requirements
+				require.NotNil(t, req.PullRequest)
+				assert.Equal(t, PullRequestRequirement{
+					RequiredApprovingReviewCount:   2,
+					RequireCodeOwnerReview:         true,
+					DismissStaleReviewsOnPush:      true,
+					RequiredReviewThreadResolution: true,
+					AllowedMergeMethods:            []string{"squash", "merge"},
+					Sources:                        []string{"ruleset:main protection", BranchProtectionSource},
+				}, *req.PullRequest)
+				assert.Equal(t, []RequiredCheck{
+					{Context: "build", Sources: []string{"ruleset:org checks", BranchProtectionSource}},
+					{Context: "lint", IntegrationID: github.Ptr(int64(15368)), Sources: []string{"ruleset:org checks"}},
+					{Context: "test", Sources: []string{BranchProtectionSource}},
+				}, req.RequiredStatusChecks)
+				assert.True(t, req.StrictStatusChecks)
+				assert.True(t, req.RequiredSignatures)
+				assert.True(t, req.BlockDeletion)
+				assert.True(t, req.BlockForcePush)
+				assert.True(t, req.EnforceAdmins)
+				assert.False(t, req.RequiredLinearHistory)
+				assert.False(t, req.MergeQueue)
+			},
+		},
+		{
+			name: "branch without protection or rules",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(mock.GetReposRulesBranchesByOwnerByRepoByBranch, mockBranchRulesHandler(`[]`)),
+				mock.WithRequestMatch(mock.GetReposRulesetsByOwnerByRepo, []*github.RepositoryRuleset{}),
+				mock.WithRequestMatchHandler(
+					mock.GetReposBranchesProtectionByOwnerByRepoByBranch,
+					mockResponse(t, http.StatusNotFound, map[string]string{"message": "Branch not protected"}),
+				),
+			),
+			verify: func(t *testing.T, evaluation branchRulesEvaluation) {
+				assert.False(t, evaluation.BranchProtection)
+				assert.Empty(t, evaluation.Rulesets)
+				assert.Nil(t, evaluation.Requirements.PullRequest)
+				assert.Empty(t, evaluation.Requirements.RequiredStatusChecks)
+				assert.Empty(t, evaluation.Notes)
+			},
+		},
+		{
+			name: "branch protection not readable",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(mock.GetReposRulesBranchesByOwnerByRepoByBranch, mockBranchRulesHandler(mockBranchRules)),
+				mock.WithRequestMatch(mock.GetReposRulesetsByOwnerByRepo, mockRulesets),
+				mock.WithRequestMatchHandler(
+					mock.GetReposBranchesProtectionByOwnerByRepoByBranch,
+					mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"}),
+				),
+			),
+			verify: func(t *testing.T, evaluation branchRulesEvaluation) {
+				assert.False(t, evaluation.BranchProtection)
+				assert.Len(t, evaluation.Rulesets, 2)
+				assert.Len(t, evaluation.Notes, 1)
+				assert.Equal(t, 1, evaluation.Requirements.PullRequest.RequiredApprovingReviewCount)
+			},
+		},
+		{
+			name: "rules request fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposRulesBranchesByOwnerByRepoByBranch,
+					mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"}),
+				),
+			),
+			expectError:    true,
+			expectedErrMsg: "failed to get rules for branch main",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := EvaluateRulesets(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+				"owner":  "owner",
+				"repo":   "repo",
+				"branch": "main",
+			}))
+			require.NoError(t, err)
+
+			if tc.expectError {
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			textContent := getTextResult(t, result)
+			var evaluation branchRulesEvaluation
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &evaluation))
+			assert.Equal(t, "main", evaluation.Branch)
+			tc.verify(t, evaluation)
+		})
+	}
+}
+
+func Test_GetRequiredChecks(t *testing.T) {
+	mockClient := github.NewClient(nil)
+	tool, _ := GetRequiredChecks(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "get_required_checks", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "branch"})
+
+	protection := &github.Protection{
+		RequiredStatusChecks: &github.RequiredStatusChecks{
+			Contexts: &[]string{"build", "test", "ci/jenkins", "deploy-preview"},
+		},
+	}
+	branch := &github.Branch{
+		Name:   github.Ptr("main"),
+		Commit: &github.RepositoryCommit{SHA: github.Ptr("abc123")},
+	}
+	checkRuns := &github.ListCheckRunsResults{
+		Total: github.Ptr(4),
+		CheckRuns: []*github.CheckRun{
+			{Name: github.Ptr("build"), Status: github.Ptr("completed"), Conclusion: github.Ptr("success"), App: &github.App{ID: github.Ptr(int64(15368))}},
+			{Name: github.Ptr("test"), Status: github.Ptr("completed"), Conclusion: github.Ptr("failure"), HTMLURL: github.Ptr("https://github.com/owner/repo/runs/2")},
+			{Name: github.Ptr("lint"), Status: github.Ptr("completed"), Conclusion: github.Ptr("success"), App: &github.App{ID: github.Ptr(int64(1))}},
+			{Name: github.Ptr("deploy-preview"), Status: github.Ptr("in_progress")},
+		},
+	}
+	status := &github.CombinedStatus{
+		State:      github.Ptr("success"),
+		TotalCount: github.Ptr(1),
+		Statuses: []*github.RepoStatus{
+			{Context: github.Ptr("ci/jenkins"), State: github.Ptr("success"), TargetURL: github.Ptr("https://ci.example.com/1")},
+		},
+	}
+
+	client := github.NewClient(mock.NewMockedHTTPClient(
+		mock.WithRequestMatchHandler(mock.GetReposRulesBranchesByOwnerByRepoByBranch, mockBranchRulesHandler(mockBranchRules)),
+		mock.WithRequestMatch(mock.GetReposRulesetsByOwnerByRepo, mockRulesets),
+		mock.WithRequestMatch(mock.GetReposBranchesProtectionByOwnerByRepoByBranch, protection),
+		mock.WithRequestMatch(mock.GetReposBranchesByOwnerByRepoByBranch, branch),
+		mock.WithRequestMatchHandler(
+			mock.GetReposCommitsCheckRunsByOwnerByRepoByRef,
+			expectPath(t, "/repos/owner/repo/commits/abc123/check-runs").andThen(
+				mockResponse(t, http.StatusOK, checkRuns),
+			),
+		),
+		mock.WithRequestMatch(mock.GetReposCommitsStatusByOwnerByRepoByRef, status),
+	))
+	_, handler := GetRequiredChecks(stubGetClientFn(client), translations.NullTranslationHelper)
+
+	result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+		"owner":  "owner",
+		"repo":   "repo",
+		"branch": "main",
+	}))
+	require.NoError(t, err)
+
+	textContent := getTextResult(t, result)
+	var response struct {
+		Branch   string               `json:"branch"`
+		SHA      string               `json:"sha"`
+		Strict   bool                 `json:"strict"`
+		Checks   []RequiredCheckState `json:"checks"`
+		Blocking []string             `json:"blocking"`
+		Passing  bool                 `json:"passing"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(textContent.Text), &response))
+
+	assert.Equal(t, "abc123", response.SHA)
+	assert.True(t, response.Strict)
+	assert.False(t, response.Passing)
+
+	states := make(map[string]string)
+	for _, check := range response.Checks {
+		states[check.Context] = check.State
+	}
+	assert.Equal(t, map[string]string{
+		"build":          "success",
+		"ci/jenkins":     "success",
+		"deploy-preview": "pending",
+		// The ruleset pins lint to app 15368 but the only lint run comes from another app.
+		"lint": "missing",
+		"test": "failure",
+	}, states)
+	assert.Equal(t, []string{"deploy-preview", "lint", "test"}, response.Blocking)
+}
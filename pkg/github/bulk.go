@@ -0,0 +1,106 @@
+package github
+
+import (
+	"encoding/json"
+	"fmt"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/google/go-github/v79/github"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// BulkFailure is an item of a bulk operation that failed.
+type BulkFailure struct {
+	Item    string             `json:"item"`
+	Code    ghErrors.ErrorCode `json:"code"`
+	Message string             `json:"message"`
+}
+
+// BulkSkip is an item of a bulk operation that was not attempted.
+type BulkSkip struct {
+	Item   string `json:"item"`
+	Reason string `json:"reason"`
+}
+
+// BulkSummary counts the outcomes of a bulk operation.
+type BulkSummary struct {
+	Total     int `json:"total"`
+	Succeeded int `json:"succeeded"`
+	Failed    int `json:"failed"`
+	Skipped   int `json:"skipped"`
+}
+
+// BulkResult is the response of every tool that applies an operation to several items. A bulk tool processes every
+// item it can and reports each one as succeeded, failed or skipped, so that clients handle partial failures the same
+// way across tools.
+type BulkResult[T any] struct {
+	Succeeded []T           `json:"succeeded"`
+	Failed    []BulkFailure `json:"failed"`
+	Skipped   []BulkSkip    `json:"skipped"`
+}
+
+// NewBulkResult creates an empty BulkResult.
+func NewBulkResult[T any]() *BulkResult[T] {
+	return &BulkResult[T]{
+		Succeeded: []T{},
+		Failed:    []BulkFailure{},
+		Skipped:   []BulkSkip{},
+	}
+}
+
+// AddSuccess records the result of an item that succeeded.
+func (r *BulkResult[T]) AddSuccess(result T) {
+	r.Succeeded = append(r.Succeeded, result)
+}
+
+// AddFailure records an item that failed with the given code.
+func (r *BulkResult[T]) AddFailure(item string, code ghErrors.ErrorCode, err error) {
+	r.Failed = append(r.Failed, BulkFailure{
+		Item:    item,
+		Code:    code,
+		Message: err.Error(),
+	})
+}
+
+// AddAPIFailure records an item whose REST API call failed, classifying the error from the response.
+func (r *BulkResult[T]) AddAPIFailure(item string, resp *github.Response, err error) {
+	r.AddFailure(item, ghErrors.ClassifyAPIError(resp, err), err)
+}
+
+// AddGraphQLFailure records an item whose GraphQL query or mutation failed.
+func (r *BulkResult[T]) AddGraphQLFailure(item string, err error) {
+	r.AddFailure(item, ghErrors.ClassifyGraphQLError(err), err)
+}
+
+// AddSkipped records an item that was not attempted.
+func (r *BulkResult[T]) AddSkipped(item, reason string) {
+	r.Skipped = append(r.Skipped, BulkSkip{Item: item, Reason: reason})
+}
+
+// Summary counts the outcomes recorded so far.
+func (r *BulkResult[T]) Summary() BulkSummary {
+	return BulkSummary{
+		Total:     len(r.Succeeded) + len(r.Failed) + len(r.Skipped),
+		Succeeded: len(r.Succeeded),
+		Failed:    len(r.Failed),
+		Skipped:   len(r.Skipped),
+	}
+}
+
+// ToolResult returns the bulk result as a tool result. Partial failures are reported in the result rather than as a
+// tool error, so that clients can see which items succeeded; only a result in which every attempted item failed is
+// flagged as an error.
+func (r *BulkResult[T]) ToolResult() (*mcp.CallToolResult, error) {
+	summary := r.Summary()
+	body, err := json.Marshal(struct {
+		*BulkResult[T]
+		Summary BulkSummary `json:"summary"`
+	}{r, summary})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal bulk result: %w", err)
+	}
+	if summary.Failed > 0 && summary.Succeeded == 0 {
+		return mcp.NewToolResultError(string(body)), nil
+	}
+	return mcp.NewToolResultText(string(body)), nil
+}
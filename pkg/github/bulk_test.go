@@ -0,0 +1,72 @@
+package github
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/google/go-github/v79/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_BulkResult(t *testing.T) {
+	type moved struct {
+		Item string `json:"item"`
+	}
+
+	t.Run("partial failure", func(t *testing.T) {
+		result := NewBulkResult[moved]()
+		result.AddSuccess(moved{Item: "PVTI_1"})
+		result.AddAPIFailure("PVTI_2",
+			&github.Response{Response: &http.Response{StatusCode: http.StatusNotFound}},
+			fmt.Errorf("404 Not Found"),
+		)
+		result.AddSkipped("PVTI_3", "already in the target column")
+
+		toolResult, err := result.ToolResult()
+		require.NoError(t, err)
+		require.False(t, toolResult.IsError)
+
+		textContent := getTextResult(t, toolResult)
+		var response struct {
+			Succeeded []moved       `json:"succeeded"`
+			Failed    []BulkFailure `json:"failed"`
+			Skipped   []BulkSkip    `json:"skipped"`
+			Summary   BulkSummary   `json:"summary"`
+		}
+		require.NoError(t, json.Unmarshal([]byte(textContent.Text), &response))
+		assert.Equal(t, []moved{{Item: "PVTI_1"}}, response.Succeeded)
+		assert.Equal(t, []BulkFailure{{Item: "PVTI_2", Code: ghErrors.CodeNotFound, Message: "404 Not Found"}}, response.Failed)
+		assert.Equal(t, []BulkSkip{{Item: "PVTI_3", Reason: "already in the target column"}}, response.Skipped)
+		assert.Equal(t, BulkSummary{Total: 3, Succeeded: 1, Failed: 1, Skipped: 1}, response.Summary)
+	})
+
+	t.Run("every item failed", func(t *testing.T) {
+		result := NewBulkResult[moved]()
+		result.AddGraphQLFailure("PVTI_1", fmt.Errorf("Resource not accessible by integration"))
+
+		toolResult, err := result.ToolResult()
+		require.NoError(t, err)
+		require.True(t, toolResult.IsError)
+
+		errorContent := getErrorResult(t, toolResult)
+		assert.JSONEq(t, `{
+			"succeeded": [],
+			"failed": [{"item": "PVTI_1", "code": "PERMISSION_DENIED", "message": "Resource not accessible by integration"}],
+			"skipped": [],
+			"summary": {"total": 1, "succeeded": 0, "failed": 1, "skipped": 0}
+		}`, errorContent.Text)
+	})
+
+	t.Run("nothing to do", func(t *testing.T) {
+		result := NewBulkResult[moved]()
+		result.AddSkipped("PVTI_1", "archived")
+
+		toolResult, err := result.ToolResult()
+		require.NoError(t, err)
+		assert.False(t, toolResult.IsError)
+	})
+}
@@ -170,6 +170,8 @@ func Test_ListCodeScanningAlerts(t *testing.T) {
 						"state":     "open",
 						"severity":  "high",
 						"tool_name": "codeql",
+						"page":      "1",
+						"per_page":  "30",
 					}).andThen(
 						mockResponse(t, http.StatusOK, mockAlerts),
 					),
@@ -234,8 +236,11 @@ func Test_ListCodeScanningAlerts(t *testing.T) {
 			textContent := getTextResult(t, result)
 
 			// Unmarshal and verify the result
-			var returnedAlerts []*github.Alert
-			err = json.Unmarshal([]byte(textContent.Text), &returnedAlerts)
+			var response struct {
+				Alerts []*github.Alert `json:"alerts"`
+			}
+			err = json.Unmarshal([]byte(textContent.Text), &response)
+			returnedAlerts := response.Alerts
 			assert.NoError(t, err)
 			assert.Len(t, returnedAlerts, len(tc.expectedAlerts))
 			for i, alert := range returnedAlerts {
@@ -0,0 +1,176 @@
+package github
+
+import (
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/google/go-github/v79/github"
+)
+
+// SymbolCandidate is a code search hit whose matched text looks like the definition of the searched symbol.
+type SymbolCandidate struct {
+	Repository string `json:"repository"`
+	Path       string `json:"path"`
+	HTMLURL    string `json:"html_url"`
+	Language   string `json:"language"`
+	Kind       string `json:"kind"`
+	Line       string `json:"line"`
+}
+
+// symbolDefinitionPattern is a regular expression template for a definition, with %s standing for the symbol name.
+type symbolDefinitionPattern struct {
+	kind     string
+	template string
+}
+
+// symbolLanguages maps file extensions to the language whose definition patterns apply.
+var symbolLanguages = map[string]string{
+	".go":    "go",
+	".py":    "python",
+	".js":    "javascript",
+	".jsx":   "javascript",
+	".mjs":   "javascript",
+	".cjs":   "javascript",
+	".ts":    "typescript",
+	".tsx":   "typescript",
+	".java":  "java",
+	".kt":    "kotlin",
+	".kts":   "kotlin",
+	".cs":    "csharp",
+	".rb":    "ruby",
+	".rs":    "rust",
+	".php":   "php",
+	".swift": "swift",
+	".c":     "c",
+	".h":     "c",
+	".cc":    "cpp",
+	".cpp":   "cpp",
+	".hpp":   "cpp",
+}
+
+var (
+	jsDefinitionPatterns = []symbolDefinitionPattern{
+		{"function", `\bfunction\s*\*?\s*%s\s*[(<]`},
+		{"class", `\bclass\s+%s\b`},
+		{"variable", `\b(?:const|let|var)\s+%s\s*[=:]`},
+		{"method", `^\s*(?:(?:static|async|public|private|protected|readonly|override)\s+)*\*?%s\s*(?:<[^>]*>)?\s*\([^)]*\)\s*(?::\s*[^{]+)?\{`},
+	}
+	javaLikeDefinitionPatterns = []symbolDefinitionPattern{
+		{"class", `\b(?:class|interface|enum|record|struct)\s+%s\b`},
+		{"method", `^\s*(?:[\w<>\[\],?]+\s+)+%s\s*\([^;]*$`},
+	}
+	cDefinitionPatterns = []symbolDefinitionPattern{
+		{"type", `\b(?:struct|union|enum|class|typedef\s+[\w\s*]+)\s*%s\b`},
+		{"function", `^\s*[\w][\w\s\*&:<>,]*[\s\*&]%s\s*\([^;]*$`},
+		{"macro", `^\s*#\s*define\s+%s\b`},
+	}
+
+	// symbolDefinitionPatterns holds the definition patterns of each supported language.
+	symbolDefinitionPatterns = map[string][]symbolDefinitionPattern{
+		"go": {
+			{"function", `^\s*func\s+(?:\([^)]*\)\s*)?%s\s*[(\[]`},
+			{"type", `^\s*(?:type\s+)?%s\s+(?:struct|interface)\b|^\s*type\s+%[1]s\b`},
+			{"variable", `^\s*(?:var|const)\s+%s\b`},
+		},
+		"python": {
+			{"function", `^\s*(?:async\s+)?def\s+%s\s*\(`},
+			{"class", `^\s*class\s+%s\b`},
+			{"variable", `^%s\s*(?::[^=]+)?=[^=]`},
+		},
+		"javascript": jsDefinitionPatterns,
+		"typescript": append([]symbolDefinitionPattern{
+			{"type", `\b(?:interface|type|enum)\s+%s\b`},
+		}, jsDefinitionPatterns...),
+		"java":   javaLikeDefinitionPatterns,
+		"csharp": javaLikeDefinitionPatterns,
+		"kotlin": {
+			{"function", `\bfun\s+(?:<[^>]*>\s*)?(?:[\w.]+\.)?%s\s*\(`},
+			{"class", `\b(?:class|interface|object)\s+%s\b`},
+			{"variable", `\b(?:val|var)\s+%s\b`},
+		},
+		"ruby": {
+			{"method", `^\s*def\s+(?:self\.)?%s\b`},
+			{"class", `^\s*(?:class|module)\s+%s\b`},
+		},
+		"rust": {
+			{"function", `\bfn\s+%s\s*[(<]`},
+			{"type", `\b(?:struct|enum|trait|type|union)\s+%s\b`},
+			{"variable", `\b(?:const|static)\s+%s\s*:`},
+		},
+		"php": {
+			{"function", `\bfunction\s+&?%s\s*\(`},
+			{"class", `\b(?:class|interface|trait|enum)\s+%s\b`},
+		},
+		"swift": {
+			{"function", `\bfunc\s+%s\s*[(<]`},
+			{"type", `\b(?:class|struct|enum|protocol|actor)\s+%s\b`},
+		},
+		"c":   cDefinitionPatterns,
+		"cpp": cDefinitionPatterns,
+	}
+)
+
+// parseSymbolQuery returns the symbol name of a symbol mode query, ignoring the search qualifiers next to it.
+func parseSymbolQuery(query string) (string, error) {
+	var symbol string
+	for _, term := range strings.Fields(query) {
+		if strings.Contains(term, ":") {
+			continue
+		}
+		if symbol != "" {
+			return "", fmt.Errorf("symbol mode expects a single symbol name, got %q and %q", symbol, term)
+		}
+		symbol = term
+	}
+	if symbol == "" {
+		return "", fmt.Errorf("symbol mode requires a symbol name in the query")
+	}
+	return symbol, nil
+}
+
+// findSymbolDefinition reports the first line of text that defines symbol in the given language.
+func findSymbolDefinition(language, symbol, text string) (kind, line string, ok bool) {
+	quoted := regexp.QuoteMeta(symbol)
+	patterns := symbolDefinitionPatterns[language]
+	compiled := make([]*regexp.Regexp, len(patterns))
+	for i, p := range patterns {
+		compiled[i] = regexp.MustCompile(fmt.Sprintf(p.template, quoted))
+	}
+	for _, l := range strings.Split(text, "\n") {
+		for i, re := range compiled {
+			if re.MatchString(l) {
+				return patterns[i].kind, strings.TrimSpace(l), true
+			}
+		}
+	}
+	return "", "", false
+}
+
+// filterSymbolDefinitions keeps the code search results whose text matches contain a definition of symbol.
+func filterSymbolDefinitions(results []*github.CodeResult, symbol string) []SymbolCandidate {
+	candidates := []SymbolCandidate{}
+	for _, result := range results {
+		language, ok := symbolLanguages[strings.ToLower(path.Ext(result.GetPath()))]
+		if !ok {
+			continue
+		}
+		for _, match := range result.TextMatches {
+			kind, line, ok := findSymbolDefinition(language, symbol, match.GetFragment())
+			if !ok {
+				continue
+			}
+			candidates = append(candidates, SymbolCandidate{
+				Repository: result.GetRepository().GetFullName(),
+				Path:       result.GetPath(),
+				HTMLURL:    result.GetHTMLURL(),
+				Language:   language,
+				Kind:       kind,
+				Line:       line,
+			})
+			break
+		}
+	}
+	return candidates
+}
@@ -0,0 +1,62 @@
+package github
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_parseSymbolQuery(t *testing.T) {
+	symbol, err := parseSymbolQuery("ParseConfig language:go org:github")
+	require.NoError(t, err)
+	assert.Equal(t, "ParseConfig", symbol)
+
+	_, err = parseSymbolQuery("language:go")
+	assert.ErrorContains(t, err, "requires a symbol name")
+
+	_, err = parseSymbolQuery("Parse Config")
+	assert.ErrorContains(t, err, "expects a single symbol name")
+}
+
+func Test_findSymbolDefinition(t *testing.T) {
+	tests := []struct {
+		language     string
+		symbol       string
+		text         string
+		expectedKind string
+		expectedLine string
+	}{
+		{"go", "ParseConfig", "cfg, err := ParseConfig(path)\nfunc ParseConfig(path string) (*Config, error) {", "function", "func ParseConfig(path string) (*Config, error) {"},
+		{"go", "Close", "func (s *Server) Close() error {", "function", "func (s *Server) Close() error {"},
+		{"go", "Config", "type Config struct {", "type", "type Config struct {"},
+		{"go", "Map", "func Map[T any](xs []T) []T {", "function", "func Map[T any](xs []T) []T {"},
+		{"python", "load", "    async def load(self, path):", "function", "async def load(self, path):"},
+		{"python", "Loader", "class Loader(Base):", "class", "class Loader(Base):"},
+		{"typescript", "Options", "export interface Options {", "type", "export interface Options {"},
+		{"typescript", "render", "  render(props: Props): Node {", "method", "render(props: Props): Node {"},
+		{"javascript", "handler", "export const handler = async (event) => {", "variable", "export const handler = async (event) => {"},
+		{"java", "parse", "    public static Config parse(String input) {", "method", "public static Config parse(String input) {"},
+		{"ruby", "call", "  def self.call(env)", "method", "def self.call(env)"},
+		{"rust", "new", "    pub fn new() -> Self {", "function", "pub fn new() -> Self {"},
+		{"c", "parse_args", "static int parse_args(int argc, char **argv)", "function", "static int parse_args(int argc, char **argv)"},
+	}
+	for _, tc := range tests {
+		kind, line, ok := findSymbolDefinition(tc.language, tc.symbol, tc.text)
+		require.True(t, ok, "%s: %s", tc.language, tc.text)
+		assert.Equal(t, tc.expectedKind, kind, tc.text)
+		assert.Equal(t, tc.expectedLine, line)
+	}
+
+	// Calls and references are not definitions.
+	for _, tc := range []struct{ language, symbol, text string }{
+		{"go", "ParseConfig", "cfg, err := ParseConfig(path)"},
+		{"python", "load", "data = self.load(path)"},
+		{"java", "parse", "    Config c = parse(input);"},
+		{"c", "parse_args", "    if (parse_args(argc, argv) != 0) {"},
+		{"go", "Parse", "func ParseConfig(path string) {"},
+	} {
+		_, _, ok := findSymbolDefinition(tc.language, tc.symbol, tc.text)
+		assert.False(t, ok, "%s: %s", tc.language, tc.text)
+	}
+}
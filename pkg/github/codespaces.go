@@ -0,0 +1,354 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v79/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// MinimalCodespace is the trimmed output type for codespace objects.
+type MinimalCodespace struct {
+	Name        string `json:"name"`
+	DisplayName string `json:"display_name,omitempty"`
+	State       string `json:"state"`
+	Repository  string `json:"repository,omitempty"`
+	Ref         string `json:"ref,omitempty"`
+	Machine     string `json:"machine,omitempty"`
+	WebURL      string `json:"web_url,omitempty"`
+	CreatedAt   string `json:"created_at,omitempty"`
+	LastUsedAt  string `json:"last_used_at,omitempty"`
+}
+
+func convertToMinimalCodespace(codespace *github.Codespace) MinimalCodespace {
+	minimal := MinimalCodespace{
+		Name:        codespace.GetName(),
+		DisplayName: codespace.GetDisplayName(),
+		State:       codespace.GetState(),
+		Repository:  codespace.GetRepository().GetFullName(),
+		Ref:         codespace.GetGitStatus().GetRef(),
+		Machine:     codespace.GetMachine().GetName(),
+		WebURL:      codespace.GetWebURL(),
+	}
+	if codespace.CreatedAt != nil {
+		minimal.CreatedAt = FormatTimestamp(codespace.CreatedAt.Time)
+	}
+	if codespace.LastUsedAt != nil {
+		minimal.LastUsedAt = FormatTimestamp(codespace.LastUsedAt.Time)
+	}
+	return minimal
+}
+
+// ListCodespaces creates a tool to list the authenticated user's codespaces.
+func ListCodespaces(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_codespaces",
+			mcp.WithDescription(t("TOOL_LIST_CODESPACES_DESCRIPTION", "List the authenticated user's codespaces, optionally limited to a single repository.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_LIST_CODESPACES_USER_TITLE", "List codespaces"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Description("Repository owner. When provided together with repo, only codespaces for that repository are returned."),
+			),
+			mcp.WithString("repo",
+				mcp.Description("Repository name. When provided together with owner, only codespaces for that repository are returned."),
+			),
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := OptionalParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := OptionalParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if (owner == "") != (repo == "") {
+				return mcp.NewToolResultError("owner and repo must be provided together"), nil
+			}
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			listOpts := github.ListOptions{
+				Page:    pagination.Page,
+				PerPage: pagination.PerPage,
+			}
+
+			var codespaces *github.ListCodespaces
+			var resp *github.Response
+			if repo != "" {
+				codespaces, resp, err = client.Codespaces.ListInRepo(ctx, owner, repo, &listOpts)
+			} else {
+				codespaces, resp, err = client.Codespaces.List(ctx, &github.ListCodespacesOptions{ListOptions: listOpts})
+			}
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					"failed to list codespaces",
+					resp,
+					err,
+				), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to list codespaces: %s", string(body))), nil
+			}
+
+			minimalCodespaces := make([]MinimalCodespace, 0, len(codespaces.Codespaces))
+			for _, codespace := range codespaces.Codespaces {
+				minimalCodespaces = append(minimalCodespaces, convertToMinimalCodespace(codespace))
+			}
+
+			r, err := json.Marshal(NewListResponse("codespaces", minimalCodespaces, NewRESTPageInfo(resp), codespaces.TotalCount))
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// CreateCodespace creates a tool to create a codespace for a repository.
+func CreateCodespace(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("create_codespace",
+			mcp.WithDescription(t("TOOL_CREATE_CODESPACE_DESCRIPTION", "Create a codespace for the authenticated user on a repository branch, optionally choosing the machine type.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:           t("TOOL_CREATE_CODESPACE_USER_TITLE", "Create codespace"),
+				ReadOnlyHint:    ToBoolPtr(false),
+				DestructiveHint: ToBoolPtr(false),
+				IdempotentHint:  ToBoolPtr(false),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryOwner),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryName),
+			),
+			mcp.WithString("ref",
+				mcp.Description("Git ref (typically a branch name) for the codespace. Defaults to the repository's default branch."),
+			),
+			mcp.WithString("machine",
+				mcp.Description("Machine type to use, for example 'basicLinux32gb' or 'standardLinux32gb'."),
+			),
+			mcp.WithString("display_name",
+				mcp.Description("Display name for the codespace"),
+			),
+			mcp.WithString("devcontainer_path",
+				mcp.Description("Path to the devcontainer.json configuration to use"),
+			),
+			mcp.WithString("geo",
+				mcp.Description("Geographic area for the codespace. Assigned by IP when omitted."),
+				mcp.Enum("EuropeWest", "SoutheastAsia", "UsEast", "UsWest"),
+			),
+			mcp.WithNumber("idle_timeout_minutes",
+				mcp.Description("Minutes of inactivity after which the codespace is stopped"),
+				mcp.Min(5),
+				mcp.Max(240),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			ref, err := OptionalParam[string](request, "ref")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			machine, err := OptionalParam[string](request, "machine")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			displayName, err := OptionalParam[string](request, "display_name")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			devcontainerPath, err := OptionalParam[string](request, "devcontainer_path")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			geo, err := OptionalParam[string](request, "geo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			idleTimeout, err := OptionalIntParam(request, "idle_timeout_minutes")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			opts := &github.CreateCodespaceOptions{
+				Ref:              ToStringPtr(ref),
+				Machine:          ToStringPtr(machine),
+				DisplayName:      ToStringPtr(displayName),
+				DevcontainerPath: ToStringPtr(devcontainerPath),
+				Geo:              ToStringPtr(geo),
+			}
+			if idleTimeout > 0 {
+				opts.IdleTimeoutMinutes = github.Ptr(idleTimeout)
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			codespace, resp, err := client.Codespaces.CreateInRepo(ctx, owner, repo, opts)
+			if err != nil {
+				// Check if it's an acceptedError. An acceptedError indicates that the codespace is still being provisioned.
+				if resp != nil && resp.StatusCode == http.StatusAccepted && isAcceptedError(err) {
+					return mcp.NewToolResultText("Codespace creation is in progress, use list_codespaces to check its state"), nil
+				}
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to create codespace for %s/%s", owner, repo),
+					resp,
+					err,
+				), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusCreated {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to create codespace: %s", string(body))), nil
+			}
+
+			r, err := json.Marshal(convertToMinimalCodespace(codespace))
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// StopCodespace creates a tool to stop a running codespace.
+func StopCodespace(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("stop_codespace",
+			mcp.WithDescription(t("TOOL_STOP_CODESPACE_DESCRIPTION", "Stop a running codespace owned by the authenticated user.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:           t("TOOL_STOP_CODESPACE_USER_TITLE", "Stop codespace"),
+				ReadOnlyHint:    ToBoolPtr(false),
+				DestructiveHint: ToBoolPtr(false),
+				IdempotentHint:  ToBoolPtr(true),
+			}),
+			mcp.WithString("codespace_name",
+				mcp.Required(),
+				mcp.Description("The name of the codespace, as returned by list_codespaces"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			name, err := RequiredParam[string](request, "codespace_name")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			codespace, resp, err := client.Codespaces.Stop(ctx, name)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to stop codespace '%s'", name),
+					resp,
+					err,
+				), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to stop codespace: %s", string(body))), nil
+			}
+
+			r, err := json.Marshal(convertToMinimalCodespace(codespace))
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// DeleteCodespace creates a tool to delete a codespace.
+func DeleteCodespace(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("delete_codespace",
+			mcp.WithDescription(t("TOOL_DELETE_CODESPACE_DESCRIPTION", "Delete a codespace owned by the authenticated user. Any unpushed changes in the codespace are lost.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:           t("TOOL_DELETE_CODESPACE_USER_TITLE", "Delete codespace"),
+				ReadOnlyHint:    ToBoolPtr(false),
+				DestructiveHint: ToBoolPtr(true),
+				IdempotentHint:  ToBoolPtr(true),
+			}),
+			mcp.WithString("codespace_name",
+				mcp.Required(),
+				mcp.Description("The name of the codespace, as returned by list_codespaces"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			name, err := RequiredParam[string](request, "codespace_name")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			resp, err := client.Codespaces.Delete(ctx, name)
+			if err != nil {
+				// Deletion is processed asynchronously, so an acceptedError is the expected outcome.
+				if resp != nil && resp.StatusCode == http.StatusAccepted && isAcceptedError(err) {
+					return mcp.NewToolResultText(fmt.Sprintf("codespace '%s' deletion requested", name)), nil
+				}
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to delete codespace '%s'", name),
+					resp,
+					err,
+				), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusNoContent {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to delete codespace: %s", string(body))), nil
+			}
+
+			return mcp.NewToolResultText(fmt.Sprintf("codespace '%s' deletion requested", name)), nil
+		}
+}
@@ -0,0 +1,298 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v79/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var mockCodespace = &github.Codespace{
+	Name:        github.Ptr("octocat-hello-world-abc123"),
+	DisplayName: github.Ptr("hello world"),
+	State:       github.Ptr("Available"),
+	Repository:  &github.Repository{FullName: github.Ptr("octocat/hello-world")},
+	GitStatus:   &github.CodespacesGitStatus{Ref: github.Ptr("main")},
+	Machine:     &github.CodespacesMachine{Name: github.Ptr("standardLinux32gb")},
+	WebURL:      github.Ptr("https://octocat-hello-world-abc123.github.dev"),
+	CreatedAt:   &github.Timestamp{Time: time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)},
+}
+
+func Test_ListCodespaces(t *testing.T) {
+	mockClient := github.NewClient(nil)
+	tool, _ := ListCodespaces(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "list_codespaces", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "page")
+	assert.Contains(t, tool.InputSchema.Properties, "perPage")
+	assert.Empty(t, tool.InputSchema.Required)
+
+	listResult := &github.ListCodespaces{
+		TotalCount: github.Ptr(1),
+		Codespaces: []*github.Codespace{mockCodespace},
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedErrMsg string
+	}{
+		{
+			name: "list all codespaces for the user",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(mock.GetUserCodespaces, listResult),
+			),
+			requestArgs: map[string]interface{}{},
+		},
+		{
+			name: "list codespaces in a repository",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposCodespacesByOwnerByRepo,
+					expectPath(t, "/repos/octocat/hello-world/codespaces").andThen(
+						mockResponse(t, http.StatusOK, listResult),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "octocat",
+				"repo":  "hello-world",
+			},
+		},
+		{
+			name:         "owner without repo",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner": "octocat",
+			},
+			expectError:    true,
+			expectedErrMsg: "owner and repo must be provided together",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := ListCodespaces(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+			require.NoError(t, err)
+
+			if tc.expectError {
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			textContent := getTextResult(t, result)
+			var response struct {
+				TotalCount int                `json:"total_count"`
+				Codespaces []MinimalCodespace `json:"codespaces"`
+			}
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &response))
+			assert.Equal(t, 1, response.TotalCount)
+			require.Len(t, response.Codespaces, 1)
+			assert.Equal(t, "octocat-hello-world-abc123", response.Codespaces[0].Name)
+			assert.Equal(t, "octocat/hello-world", response.Codespaces[0].Repository)
+			assert.Equal(t, "main", response.Codespaces[0].Ref)
+			assert.Equal(t, "standardLinux32gb", response.Codespaces[0].Machine)
+			assert.Equal(t, "2025-01-02T03:04:05Z", response.Codespaces[0].CreatedAt)
+		})
+	}
+}
+
+func Test_CreateCodespace(t *testing.T) {
+	mockClient := github.NewClient(nil)
+	tool, _ := CreateCodespace(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "create_codespace", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "ref")
+	assert.Contains(t, tool.InputSchema.Properties, "machine")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedErrMsg string
+	}{
+		{
+			name: "create codespace on a branch with a machine type",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposCodespacesByOwnerByRepo,
+					expectRequestBody(t, map[string]any{
+						"ref":                  "main",
+						"machine":              "standardLinux32gb",
+						"idle_timeout_minutes": float64(30),
+					}).andThen(
+						mockResponse(t, http.StatusCreated, mockCodespace),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":                "octocat",
+				"repo":                 "hello-world",
+				"ref":                  "main",
+				"machine":              "standardLinux32gb",
+				"idle_timeout_minutes": float64(30),
+			},
+		},
+		{
+			name: "create fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposCodespacesByOwnerByRepo,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusUnprocessableEntity)
+						_, _ = w.Write([]byte(`{"message": "Machine type not allowed"}`))
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":   "octocat",
+				"repo":    "hello-world",
+				"machine": "premiumLinux",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to create codespace for octocat/hello-world",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := CreateCodespace(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+			require.NoError(t, err)
+
+			if tc.expectError {
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			textContent := getTextResult(t, result)
+			var codespace MinimalCodespace
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &codespace))
+			assert.Equal(t, "octocat-hello-world-abc123", codespace.Name)
+			assert.Equal(t, "Available", codespace.State)
+		})
+	}
+}
+
+func Test_StopCodespace(t *testing.T) {
+	mockClient := github.NewClient(nil)
+	tool, _ := StopCodespace(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "stop_codespace", tool.Name)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"codespace_name"})
+
+	stopped := *mockCodespace
+	stopped.State = github.Ptr("ShuttingDown")
+
+	client := github.NewClient(mock.NewMockedHTTPClient(
+		mock.WithRequestMatchHandler(
+			mock.PostUserCodespacesStopByCodespaceName,
+			expectPath(t, "/user/codespaces/octocat-hello-world-abc123/stop").andThen(
+				mockResponse(t, http.StatusOK, &stopped),
+			),
+		),
+	))
+	_, handler := StopCodespace(stubGetClientFn(client), translations.NullTranslationHelper)
+
+	result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+		"codespace_name": "octocat-hello-world-abc123",
+	}))
+	require.NoError(t, err)
+
+	textContent := getTextResult(t, result)
+	var codespace MinimalCodespace
+	require.NoError(t, json.Unmarshal([]byte(textContent.Text), &codespace))
+	assert.Equal(t, "ShuttingDown", codespace.State)
+}
+
+func Test_DeleteCodespace(t *testing.T) {
+	mockClient := github.NewClient(nil)
+	tool, _ := DeleteCodespace(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "delete_codespace", tool.Name)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"codespace_name"})
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		expectError    bool
+		expectedText   string
+		expectedErrMsg string
+	}{
+		{
+			name: "delete accepted",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.DeleteUserCodespacesByCodespaceName,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusAccepted)
+						_, _ = w.Write([]byte(`{}`))
+					}),
+				),
+			),
+			expectedText: "codespace 'octocat-hello-world-abc123' deletion requested",
+		},
+		{
+			name: "delete not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.DeleteUserCodespacesByCodespaceName,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusNotFound)
+						_, _ = w.Write([]byte(`{"message": "Not Found"}`))
+					}),
+				),
+			),
+			expectError:    true,
+			expectedErrMsg: "failed to delete codespace 'octocat-hello-world-abc123'",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := DeleteCodespace(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+				"codespace_name": "octocat-hello-world-abc123",
+			}))
+			require.NoError(t, err)
+
+			if tc.expectError {
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			textContent := getTextResult(t, result)
+			assert.Equal(t, tc.expectedText, textContent.Text)
+		})
+	}
+}
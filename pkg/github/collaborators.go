@@ -0,0 +1,459 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v79/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// RepositoryPermissions are the permissions a collaborator or team can be given on a repository, from least to most.
+var RepositoryPermissions = []string{"pull", "triage", "push", "maintain", "admin"}
+
+// RepositoryCollaborator is a user with access to a repository.
+type RepositoryCollaborator struct {
+	Login      string `json:"login"`
+	ID         int64  `json:"id"`
+	ProfileURL string `json:"profile_url,omitempty"`
+	RoleName   string `json:"role_name,omitempty"`
+}
+
+// RepositoryTeam is a team with access to a repository.
+type RepositoryTeam struct {
+	ID         int64  `json:"id"`
+	Name       string `json:"name"`
+	Slug       string `json:"slug"`
+	Permission string `json:"permission"`
+}
+
+// ListRepositoryCollaborators creates a tool that lists the collaborators of a repository.
+func ListRepositoryCollaborators(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_repository_collaborators",
+			mcp.WithDescription(t("TOOL_LIST_REPOSITORY_COLLABORATORS_DESCRIPTION", "List the users with access to a repository and their role. For repositories of an organization this includes organization members with access through teams or base permissions unless affiliation is 'direct' or 'outside'.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_LIST_REPOSITORY_COLLABORATORS_USER_TITLE", "List repository collaborators"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryOwner),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryName),
+			),
+			mcp.WithString("affiliation",
+				mcp.Description("Filter collaborators by affiliation: 'outside' for outside collaborators of an organization, 'direct' for users given access directly rather than through a team or the organization"),
+				mcp.Enum("all", "direct", "outside"),
+				mcp.DefaultString("all"),
+			),
+			mcp.WithString("permission",
+				mcp.Description("Only collaborators with this permission"),
+				mcp.Enum(RepositoryPermissions...),
+			),
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			affiliation, err := OptionalParam[string](request, "affiliation")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			permission, err := OptionalParam[string](request, "permission")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			users, resp, err := client.Repositories.ListCollaborators(ctx, owner, repo, &github.ListCollaboratorsOptions{
+				Affiliation: affiliation,
+				Permission:  permission,
+				ListOptions: github.ListOptions{
+					Page:    pagination.Page,
+					PerPage: pagination.PerPage,
+				},
+			})
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to list collaborators of %s/%s", owner, repo),
+					resp,
+					err,
+				), nil
+			}
+			_ = resp.Body.Close()
+
+			collaborators := make([]RepositoryCollaborator, 0, len(users))
+			for _, user := range users {
+				collaborators = append(collaborators, RepositoryCollaborator{
+					Login:      user.GetLogin(),
+					ID:         user.GetID(),
+					ProfileURL: user.GetHTMLURL(),
+					RoleName:   user.GetRoleName(),
+				})
+			}
+
+			return MarshalledTextResult(NewListResponse("collaborators", collaborators, NewRESTPageInfo(resp), nil)), nil
+		}
+}
+
+// AddRepositoryCollaborator creates a tool that gives a user access to a repository, or changes the permission of a
+// collaborator.
+func AddRepositoryCollaborator(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("add_repository_collaborator",
+			mcp.WithDescription(t("TOOL_ADD_REPOSITORY_COLLABORATOR_DESCRIPTION", "Give a user access to a repository with a permission, or change the permission of an existing collaborator. Users who are not yet collaborators are sent an invitation they must accept, unless they are members of the organization that owns the repository.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:           t("TOOL_ADD_REPOSITORY_COLLABORATOR_USER_TITLE", "Add repository collaborator"),
+				ReadOnlyHint:    ToBoolPtr(false),
+				DestructiveHint: ToBoolPtr(false),
+				IdempotentHint:  ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryOwner),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryName),
+			),
+			mcp.WithString("username",
+				mcp.Required(),
+				mcp.Description("Username of the user"),
+			),
+			mcp.WithString("permission",
+				mcp.Description("Permission to give the user"),
+				mcp.Enum(RepositoryPermissions...),
+				mcp.DefaultString("push"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			username, err := RequiredParam[string](request, "username")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			permission, err := OptionalParam[string](request, "permission")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if permission == "" {
+				permission = "push"
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			invitation, resp, err := client.Repositories.AddCollaborator(ctx, owner, repo, username, &github.RepositoryAddCollaboratorOptions{
+				Permission: permission,
+			})
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to add %s as a collaborator of %s/%s", username, owner, repo),
+					resp,
+					err,
+				), nil
+			}
+			_ = resp.Body.Close()
+
+			// GitHub answers 201 with an invitation for new collaborators and 204 when the user got access directly.
+			result := map[string]any{
+				"repository": fmt.Sprintf("%s/%s", owner, repo),
+				"username":   username,
+				"permission": permission,
+			}
+			if resp.StatusCode == http.StatusCreated {
+				result["status"] = "invited"
+				result["invitation_id"] = invitation.GetID()
+			} else {
+				result["status"] = "added"
+			}
+			return MarshalledTextResult(result), nil
+		}
+}
+
+// RemoveRepositoryCollaborator creates a tool that removes a collaborator from a repository.
+func RemoveRepositoryCollaborator(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("remove_repository_collaborator",
+			mcp.WithDescription(t("TOOL_REMOVE_REPOSITORY_COLLABORATOR_DESCRIPTION", "Remove a collaborator from a repository, along with any pending invitation. Access the user has through teams or the organization is not affected.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:           t("TOOL_REMOVE_REPOSITORY_COLLABORATOR_USER_TITLE", "Remove repository collaborator"),
+				ReadOnlyHint:    ToBoolPtr(false),
+				DestructiveHint: ToBoolPtr(true),
+				IdempotentHint:  ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryOwner),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryName),
+			),
+			mcp.WithString("username",
+				mcp.Required(),
+				mcp.Description("Username of the collaborator"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			username, err := RequiredParam[string](request, "username")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			resp, err := client.Repositories.RemoveCollaborator(ctx, owner, repo, username)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to remove %s from %s/%s", username, owner, repo),
+					resp,
+					err,
+				), nil
+			}
+			_ = resp.Body.Close()
+
+			return mcp.NewToolResultText(fmt.Sprintf("Removed %s from %s/%s", username, owner, repo)), nil
+		}
+}
+
+// ListRepositoryTeams creates a tool that lists the teams with access to a repository.
+func ListRepositoryTeams(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_repository_teams",
+			mcp.WithDescription(t("TOOL_LIST_REPOSITORY_TEAMS_DESCRIPTION", "List the teams with access to a repository of an organization and their permission.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_LIST_REPOSITORY_TEAMS_USER_TITLE", "List repository teams"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryOwner),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryName),
+			),
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			teams, resp, err := client.Repositories.ListTeams(ctx, owner, repo, &github.ListOptions{
+				Page:    pagination.Page,
+				PerPage: pagination.PerPage,
+			})
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to list teams of %s/%s", owner, repo),
+					resp,
+					err,
+				), nil
+			}
+			_ = resp.Body.Close()
+
+			result := make([]RepositoryTeam, 0, len(teams))
+			for _, team := range teams {
+				result = append(result, RepositoryTeam{
+					ID:         team.GetID(),
+					Name:       team.GetName(),
+					Slug:       team.GetSlug(),
+					Permission: team.GetPermission(),
+				})
+			}
+
+			return MarshalledTextResult(NewListResponse("teams", result, NewRESTPageInfo(resp), nil)), nil
+		}
+}
+
+// SetTeamRepositoryPermission creates a tool that gives a team access to a repository, or changes its permission.
+func SetTeamRepositoryPermission(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("set_team_repository_permission",
+			mcp.WithDescription(t("TOOL_SET_TEAM_REPOSITORY_PERMISSION_DESCRIPTION", "Give a team of an organization access to a repository with a permission, or change the permission the team has. The repository must belong to the team's organization or be a fork of one of its repositories.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:           t("TOOL_SET_TEAM_REPOSITORY_PERMISSION_USER_TITLE", "Set team repository permission"),
+				ReadOnlyHint:    ToBoolPtr(false),
+				DestructiveHint: ToBoolPtr(false),
+				IdempotentHint:  ToBoolPtr(true),
+			}),
+			mcp.WithString("org",
+				mcp.Required(),
+				mcp.Description("Organization of the team"),
+			),
+			mcp.WithString("team_slug",
+				mcp.Required(),
+				mcp.Description("Team slug"),
+			),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryOwner),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryName),
+			),
+			mcp.WithString("permission",
+				mcp.Required(),
+				mcp.Description("Permission to give the team"),
+				mcp.Enum(RepositoryPermissions...),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			org, err := RequiredParam[string](request, "org")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			teamSlug, err := RequiredParam[string](request, "team_slug")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			permission, err := RequiredParam[string](request, "permission")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			resp, err := client.Teams.AddTeamRepoBySlug(ctx, org, teamSlug, owner, repo, &github.TeamAddTeamRepoOptions{
+				Permission: permission,
+			})
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to give team %s/%s access to %s/%s", org, teamSlug, owner, repo),
+					resp,
+					err,
+				), nil
+			}
+			_ = resp.Body.Close()
+
+			return mcp.NewToolResultText(fmt.Sprintf("Team %s/%s has %s permission on %s/%s", org, teamSlug, permission, owner, repo)), nil
+		}
+}
+
+// RemoveTeamRepositoryAccess creates a tool that removes the access of a team to a repository.
+func RemoveTeamRepositoryAccess(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("remove_team_repository_access",
+			mcp.WithDescription(t("TOOL_REMOVE_TEAM_REPOSITORY_ACCESS_DESCRIPTION", "Remove the access of a team to a repository. Members keep any access they have directly or through other teams.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:           t("TOOL_REMOVE_TEAM_REPOSITORY_ACCESS_USER_TITLE", "Remove team repository access"),
+				ReadOnlyHint:    ToBoolPtr(false),
+				DestructiveHint: ToBoolPtr(true),
+				IdempotentHint:  ToBoolPtr(true),
+			}),
+			mcp.WithString("org",
+				mcp.Required(),
+				mcp.Description("Organization of the team"),
+			),
+			mcp.WithString("team_slug",
+				mcp.Required(),
+				mcp.Description("Team slug"),
+			),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryOwner),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryName),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			org, err := RequiredParam[string](request, "org")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			teamSlug, err := RequiredParam[string](request, "team_slug")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			resp, err := client.Teams.RemoveTeamRepoBySlug(ctx, org, teamSlug, owner, repo)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to remove team %s/%s from %s/%s", org, teamSlug, owner, repo),
+					resp,
+					err,
+				), nil
+			}
+			_ = resp.Body.Close()
+
+			return mcp.NewToolResultText(fmt.Sprintf("Removed team %s/%s from %s/%s", org, teamSlug, owner, repo)), nil
+		}
+}
@@ -0,0 +1,152 @@
+package github
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/ghmock"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ListRepositoryCollaborators(t *testing.T) {
+	tool, _ := ListRepositoryCollaborators(nil, translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+	assert.True(t, *tool.Annotations.ReadOnlyHint)
+
+	server := ghmock.New(t)
+	server.HandleFunc("GET /repos/octo/app/collaborators", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "outside", r.URL.Query().Get("affiliation"))
+		assert.Equal(t, "admin", r.URL.Query().Get("permission"))
+		_ = json.NewEncoder(w).Encode([]map[string]any{
+			{"login": "mona", "id": 1, "html_url": "https://github.com/mona", "role_name": "admin"},
+		})
+	})
+	_, handler := ListRepositoryCollaborators(server.GetClient(), translations.NullTranslationHelper)
+
+	result := ghmock.CallTool(t, handler, map[string]any{"owner": "octo", "repo": "app", "affiliation": "outside", "permission": "admin"})
+	require.False(t, result.IsError, ghmock.ResultText(t, result))
+	var resp struct {
+		Collaborators []RepositoryCollaborator `json:"collaborators"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(ghmock.ResultText(t, result)), &resp))
+	assert.Equal(t, []RepositoryCollaborator{{Login: "mona", ID: 1, ProfileURL: "https://github.com/mona", RoleName: "admin"}}, resp.Collaborators)
+}
+
+func Test_AddRepositoryCollaborator(t *testing.T) {
+	tool, _ := AddRepositoryCollaborator(nil, translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+	assert.ElementsMatch(t, []string{"owner", "repo", "username"}, tool.InputSchema.Required)
+
+	tests := []struct {
+		name     string
+		status   int
+		body     any
+		args     map[string]any
+		expected string
+	}{
+		{
+			name:     "invites a new collaborator",
+			status:   http.StatusCreated,
+			body:     map[string]any{"id": 42},
+			args:     map[string]any{"permission": "maintain"},
+			expected: `{"repository": "octo/app", "username": "mona", "permission": "maintain", "status": "invited", "invitation_id": 42}`,
+		},
+		{
+			name:     "adds an organization member directly",
+			status:   http.StatusNoContent,
+			args:     map[string]any{},
+			expected: `{"repository": "octo/app", "username": "mona", "permission": "push", "status": "added"}`,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			server := ghmock.New(t)
+			server.Respond("PUT /repos/octo/app/collaborators/mona", tc.status, tc.body)
+			_, handler := AddRepositoryCollaborator(server.GetClient(), translations.NullTranslationHelper)
+
+			tc.args["owner"], tc.args["repo"], tc.args["username"] = "octo", "app", "mona"
+			result := ghmock.CallTool(t, handler, tc.args)
+			require.False(t, result.IsError, ghmock.ResultText(t, result))
+			assert.JSONEq(t, tc.expected, ghmock.ResultText(t, result))
+		})
+	}
+}
+
+func Test_RemoveRepositoryCollaborator(t *testing.T) {
+	tool, _ := RemoveRepositoryCollaborator(nil, translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+	assert.True(t, *tool.Annotations.DestructiveHint)
+
+	server := ghmock.New(t)
+	server.Respond("DELETE /repos/octo/app/collaborators/mona", http.StatusNoContent, nil)
+	_, handler := RemoveRepositoryCollaborator(server.GetClient(), translations.NullTranslationHelper)
+
+	result := ghmock.CallTool(t, handler, map[string]any{"owner": "octo", "repo": "app", "username": "mona"})
+	require.False(t, result.IsError, ghmock.ResultText(t, result))
+	assert.Equal(t, "Removed mona from octo/app", ghmock.ResultText(t, result))
+}
+
+func Test_ListRepositoryTeams(t *testing.T) {
+	tool, _ := ListRepositoryTeams(nil, translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	server := ghmock.New(t)
+	server.Respond("GET /repos/octo/app/teams", http.StatusOK, []map[string]any{
+		{"id": 7, "name": "Platform", "slug": "platform", "permission": "maintain"},
+	})
+	_, handler := ListRepositoryTeams(server.GetClient(), translations.NullTranslationHelper)
+
+	result := ghmock.CallTool(t, handler, map[string]any{"owner": "octo", "repo": "app"})
+	require.False(t, result.IsError, ghmock.ResultText(t, result))
+	var resp struct {
+		Teams []RepositoryTeam `json:"teams"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(ghmock.ResultText(t, result)), &resp))
+	assert.Equal(t, []RepositoryTeam{{ID: 7, Name: "Platform", Slug: "platform", Permission: "maintain"}}, resp.Teams)
+}
+
+func Test_SetTeamRepositoryPermission(t *testing.T) {
+	tool, _ := SetTeamRepositoryPermission(nil, translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+	assert.ElementsMatch(t, []string{"org", "team_slug", "owner", "repo", "permission"}, tool.InputSchema.Required)
+
+	t.Run("sets the permission", func(t *testing.T) {
+		server := ghmock.New(t)
+		server.Respond("PUT /orgs/octo/teams/platform/repos/octo/app", http.StatusNoContent, nil)
+		_, handler := SetTeamRepositoryPermission(server.GetClient(), translations.NullTranslationHelper)
+
+		result := ghmock.CallTool(t, handler, map[string]any{"org": "octo", "team_slug": "platform", "owner": "octo", "repo": "app", "permission": "triage"})
+		require.False(t, result.IsError, ghmock.ResultText(t, result))
+		assert.Equal(t, "Team octo/platform has triage permission on octo/app", ghmock.ResultText(t, result))
+		var body map[string]any
+		require.NoError(t, server.AssertRequested("PUT /orgs/octo/teams/platform/repos/octo/app").DecodeBody(&body))
+		assert.Equal(t, map[string]any{"permission": "triage"}, body)
+	})
+
+	t.Run("repository outside the organization", func(t *testing.T) {
+		server := ghmock.New(t)
+		server.Respond("PUT /orgs/octo/teams/platform/repos/other/app", http.StatusUnprocessableEntity, map[string]any{"message": "Validation Failed"})
+		_, handler := SetTeamRepositoryPermission(server.GetClient(), translations.NullTranslationHelper)
+
+		result := ghmock.CallTool(t, handler, map[string]any{"org": "octo", "team_slug": "platform", "owner": "other", "repo": "app", "permission": "pull"})
+		require.True(t, result.IsError)
+		assert.Contains(t, ghmock.ResultText(t, result), "failed to give team octo/platform access to other/app")
+	})
+}
+
+func Test_RemoveTeamRepositoryAccess(t *testing.T) {
+	tool, _ := RemoveTeamRepositoryAccess(nil, translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	server := ghmock.New(t)
+	server.Respond("DELETE /orgs/octo/teams/platform/repos/octo/app", http.StatusNoContent, nil)
+	_, handler := RemoveTeamRepositoryAccess(server.GetClient(), translations.NullTranslationHelper)
+
+	result := ghmock.CallTool(t, handler, map[string]any{"org": "octo", "team_slug": "platform", "owner": "octo", "repo": "app"})
+	require.False(t, result.IsError, ghmock.ResultText(t, result))
+	assert.Equal(t, "Removed team octo/platform from octo/app", ghmock.ResultText(t, result))
+}
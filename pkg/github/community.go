@@ -0,0 +1,243 @@
+package github
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v79/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// CommunityFile describes one of the community health files GitHub looks for in a repository.
+type CommunityFile struct {
+	Present bool   `json:"present"`
+	Name    string `json:"name,omitempty"`
+	HTMLURL string `json:"html_url,omitempty"`
+}
+
+// CommunityProfile is the community health summary of a repository.
+type CommunityProfile struct {
+	HealthPercentage int                      `json:"health_percentage"`
+	Description      string                   `json:"description,omitempty"`
+	Documentation    string                   `json:"documentation,omitempty"`
+	Files            map[string]CommunityFile `json:"files"`
+	Missing          []string                 `json:"missing"`
+	UpdatedAt        string                   `json:"updated_at,omitempty"`
+}
+
+// MinimalLicense is the license detected for a repository.
+type MinimalLicense struct {
+	Key         string   `json:"key"`
+	Name        string   `json:"name"`
+	SPDXID      string   `json:"spdx_id,omitempty"`
+	URL         string   `json:"url,omitempty"`
+	Path        string   `json:"path"`
+	HTMLURL     string   `json:"html_url,omitempty"`
+	Permissions []string `json:"permissions,omitempty"`
+	Conditions  []string `json:"conditions,omitempty"`
+	Limitations []string `json:"limitations,omitempty"`
+	Content     string   `json:"content,omitempty"`
+}
+
+// convertToCommunityProfile flattens the community health metrics into presence flags per file.
+func convertToCommunityProfile(metrics *github.CommunityHealthMetrics) CommunityProfile {
+	profile := CommunityProfile{
+		HealthPercentage: metrics.GetHealthPercentage(),
+		Description:      metrics.GetDescription(),
+		Documentation:    metrics.GetDocumentation(),
+		Files:            make(map[string]CommunityFile),
+		Missing:          []string{},
+	}
+	if metrics.UpdatedAt != nil {
+		profile.UpdatedAt = FormatTimestamp(metrics.UpdatedAt.Time)
+	}
+
+	files := metrics.GetFiles()
+	if files == nil {
+		files = &github.CommunityHealthFiles{}
+	}
+	codeOfConduct := files.CodeOfConduct
+	if codeOfConduct == nil {
+		codeOfConduct = files.CodeOfConductFile
+	}
+	for _, f := range []struct {
+		key    string
+		metric *github.Metric
+	}{
+		{"readme", files.Readme},
+		{"license", files.License},
+		{"code_of_conduct", codeOfConduct},
+		{"contributing", files.Contributing},
+		{"issue_template", files.IssueTemplate},
+		{"pull_request_template", files.PullRequestTemplate},
+	} {
+		if f.metric == nil {
+			profile.Files[f.key] = CommunityFile{}
+			profile.Missing = append(profile.Missing, f.key)
+			continue
+		}
+		profile.Files[f.key] = CommunityFile{
+			Present: true,
+			Name:    f.metric.GetName(),
+			HTMLURL: f.metric.GetHTMLURL(),
+		}
+	}
+	return profile
+}
+
+// GetCommunityProfile creates a tool to get the community health profile of a repository.
+func GetCommunityProfile(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_community_profile",
+			mcp.WithDescription(t("TOOL_GET_COMMUNITY_PROFILE_DESCRIPTION", "Get the community health profile of a public repository: the health percentage and whether it has a README, license, code of conduct, contributing guide, issue templates and a pull request template.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_GET_COMMUNITY_PROFILE_USER_TITLE", "Get community profile"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryOwner),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryName),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			metrics, resp, err := client.Repositories.GetCommunityHealthMetrics(ctx, owner, repo)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to get community profile for %s/%s", owner, repo),
+					resp,
+					err,
+				), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			r, err := json.Marshal(convertToCommunityProfile(metrics))
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// GetLicense creates a tool to get the license detected for a repository.
+func GetLicense(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_license",
+			mcp.WithDescription(t("TOOL_GET_LICENSE_DESCRIPTION", "Get the license detected for a repository, including its SPDX identifier and the permissions, conditions and limitations it grants. Optionally include the full license text.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_GET_LICENSE_USER_TITLE", "Get repository license"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryOwner),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryName),
+			),
+			mcp.WithBoolean("include_content",
+				mcp.Description("Include the full text of the license file"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			includeContent, err := OptionalParam[bool](request, "include_content")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			repoLicense, resp, err := client.Repositories.License(ctx, owner, repo)
+			if err != nil {
+				if resp != nil && resp.StatusCode == http.StatusNotFound {
+					return mcp.NewToolResultError(fmt.Sprintf("%s/%s has no detectable license", owner, repo)), nil
+				}
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to get license for %s/%s", owner, repo),
+					resp,
+					err,
+				), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			license := repoLicense.GetLicense()
+			// The repository endpoint only identifies the license; its terms come from the licenses API.
+			if key := license.GetKey(); key != "" && key != "other" {
+				details, resp, err := client.Licenses.Get(ctx, key)
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx,
+						fmt.Sprintf("failed to get license %s", key),
+						resp,
+						err,
+					), nil
+				}
+				_ = resp.Body.Close()
+				license = details
+			}
+			result := MinimalLicense{
+				Key:     license.GetKey(),
+				Name:    license.GetName(),
+				SPDXID:  license.GetSPDXID(),
+				URL:     license.GetHTMLURL(),
+				Path:    repoLicense.GetPath(),
+				HTMLURL: repoLicense.GetHTMLURL(),
+			}
+			if license.Permissions != nil {
+				result.Permissions = *license.Permissions
+			}
+			if license.Conditions != nil {
+				result.Conditions = *license.Conditions
+			}
+			if license.Limitations != nil {
+				result.Limitations = *license.Limitations
+			}
+			if includeContent && repoLicense.GetEncoding() == "base64" {
+				content, err := base64.StdEncoding.DecodeString(repoLicense.GetContent())
+				if err != nil {
+					return nil, fmt.Errorf("failed to decode license content: %w", err)
+				}
+				result.Content = string(content)
+			}
+
+			r, err := json.Marshal(result)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
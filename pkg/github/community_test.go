@@ -0,0 +1,232 @@
+package github
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v79/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_GetCommunityProfile(t *testing.T) {
+	mockClient := github.NewClient(nil)
+	tool, _ := GetCommunityProfile(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "get_community_profile", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+
+	metrics := &github.CommunityHealthMetrics{
+		HealthPercentage: github.Ptr(57),
+		Description:      github.Ptr("A project"),
+		UpdatedAt:        &github.Timestamp{Time: time.Date(2025, 4, 1, 12, 0, 0, 0, time.UTC)},
+		Files: &github.CommunityHealthFiles{
+			Readme: &github.Metric{HTMLURL: github.Ptr("https://github.com/owner/repo/blob/main/README.md")},
+			License: &github.Metric{
+				Name:    github.Ptr("MIT License"),
+				HTMLURL: github.Ptr("https://github.com/owner/repo/blob/main/LICENSE"),
+			},
+			CodeOfConductFile: &github.Metric{HTMLURL: github.Ptr("https://github.com/owner/.github/blob/main/CODE_OF_CONDUCT.md")},
+		},
+	}
+
+	tests := []struct {
+		name            string
+		mockedClient    *http.Client
+		expectError     bool
+		expectedErrMsg  string
+		expectedProfile CommunityProfile
+	}{
+		{
+			name: "profile with missing files",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(mock.GetReposCommunityProfileByOwnerByRepo, metrics),
+			),
+			expectedProfile: CommunityProfile{
+				HealthPercentage: 57,
+				Description:      "A project",
+				Files: map[string]CommunityFile{
+					"readme":                {Present: true, HTMLURL: "https://github.com/owner/repo/blob/main/README.md"},
+					"license":               {Present: true, Name: "MIT License", HTMLURL: "https://github.com/owner/repo/blob/main/LICENSE"},
+					"code_of_conduct":       {Present: true, HTMLURL: "https://github.com/owner/.github/blob/main/CODE_OF_CONDUCT.md"},
+					"contributing":          {},
+					"issue_template":        {},
+					"pull_request_template": {},
+				},
+				Missing:   []string{"contributing", "issue_template", "pull_request_template"},
+				UpdatedAt: "2025-04-01T12:00:00Z",
+			},
+		},
+		{
+			name: "profile fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposCommunityProfileByOwnerByRepo,
+					mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"}),
+				),
+			),
+			expectError:    true,
+			expectedErrMsg: "failed to get community profile for owner/repo",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := GetCommunityProfile(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+			}))
+			require.NoError(t, err)
+
+			if tc.expectError {
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			textContent := getTextResult(t, result)
+			var profile CommunityProfile
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &profile))
+			assert.Equal(t, tc.expectedProfile, profile)
+		})
+	}
+}
+
+func Test_GetLicense(t *testing.T) {
+	mockClient := github.NewClient(nil)
+	tool, _ := GetLicense(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "get_license", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "include_content")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+
+	licenseText := "MIT License\n\nCopyright (c) 2025 owner\n"
+	repoLicense := &github.RepositoryLicense{
+		Path:     github.Ptr("LICENSE"),
+		HTMLURL:  github.Ptr("https://github.com/owner/repo/blob/main/LICENSE"),
+		Encoding: github.Ptr("base64"),
+		Content:  github.Ptr(base64.StdEncoding.EncodeToString([]byte(licenseText))),
+		License: &github.License{
+			Key:    github.Ptr("mit"),
+			Name:   github.Ptr("MIT License"),
+			SPDXID: github.Ptr("MIT"),
+		},
+	}
+	mitLicense := &github.License{
+		Key:         github.Ptr("mit"),
+		Name:        github.Ptr("MIT License"),
+		SPDXID:      github.Ptr("MIT"),
+		HTMLURL:     github.Ptr("http://choosealicense.com/licenses/mit/"),
+		Permissions: &[]string{"commercial-use", "modifications"},
+		Conditions:  &[]string{"include-copyright"},
+		Limitations: &[]string{"liability", "warranty"},
+	}
+
+	tests := []struct {
+		name            string
+		mockedClient    *http.Client
+		requestArgs     map[string]interface{}
+		expectError     bool
+		expectedErrMsg  string
+		expectedLicense MinimalLicense
+	}{
+		{
+			name: "license with terms and content",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(mock.GetReposLicenseByOwnerByRepo, repoLicense),
+				mock.WithRequestMatchHandler(
+					mock.GetLicensesByLicense,
+					expectPath(t, "/licenses/mit").andThen(
+						mockResponse(t, http.StatusOK, mitLicense),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":           "owner",
+				"repo":            "repo",
+				"include_content": true,
+			},
+			expectedLicense: MinimalLicense{
+				Key:         "mit",
+				Name:        "MIT License",
+				SPDXID:      "MIT",
+				URL:         "http://choosealicense.com/licenses/mit/",
+				Path:        "LICENSE",
+				HTMLURL:     "https://github.com/owner/repo/blob/main/LICENSE",
+				Permissions: []string{"commercial-use", "modifications"},
+				Conditions:  []string{"include-copyright"},
+				Limitations: []string{"liability", "warranty"},
+				Content:     licenseText,
+			},
+		},
+		{
+			name: "unrecognized license",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(mock.GetReposLicenseByOwnerByRepo, &github.RepositoryLicense{
+					Path:    github.Ptr("COPYING"),
+					License: &github.License{Key: github.Ptr("other"), Name: github.Ptr("Other"), SPDXID: github.Ptr("NOASSERTION")},
+				}),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+			},
+			expectedLicense: MinimalLicense{
+				Key:    "other",
+				Name:   "Other",
+				SPDXID: "NOASSERTION",
+				Path:   "COPYING",
+			},
+		},
+		{
+			name: "no license",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposLicenseByOwnerByRepo,
+					mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+			},
+			expectError:    true,
+			expectedErrMsg: "owner/repo has no detectable license",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := GetLicense(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+			require.NoError(t, err)
+
+			if tc.expectError {
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			textContent := getTextResult(t, result)
+			var license MinimalLicense
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &license))
+			assert.Equal(t, tc.expectedLicense, license)
+		})
+	}
+}
@@ -0,0 +1,165 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v79/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// DefaultCompareCommits is the number of commits compare_refs lists unless the caller asks for another number.
+const DefaultCompareCommits = 20
+
+// RefComparison is how two refs, possibly of different repositories of a fork network, relate.
+type RefComparison struct {
+	Base             string            `json:"base"`
+	Head             string            `json:"head"`
+	Status           string            `json:"status"`
+	AheadBy          int               `json:"ahead_by"`
+	BehindBy         int               `json:"behind_by"`
+	MergeBaseSHA     string            `json:"merge_base_sha,omitempty"`
+	CanFastForward   bool              `json:"can_fast_forward"`
+	FilesChanged     int               `json:"files_changed"`
+	Additions        int               `json:"additions"`
+	Deletions        int               `json:"deletions"`
+	Commits          []PRContextCommit `json:"commits"`
+	CommitsTruncated bool              `json:"commits_truncated,omitempty"`
+	HTMLURL          string            `json:"html_url,omitempty"`
+}
+
+// validateCompareRef checks a ref given to compare_refs: a branch, tag or SHA, optionally prefixed with the owner
+// of another repository of the fork network as owner:branch.
+func validateCompareRef(name, ref string) error {
+	owner, branch, found := strings.Cut(ref, ":")
+	if found && (owner == "" || branch == "" || strings.Contains(branch, ":")) {
+		return fmt.Errorf("%s must be a ref or owner:ref, got '%s'", name, ref)
+	}
+	return nil
+}
+
+// convertToRefComparison summarizes a comparison, keeping the most recent maxCommits commits.
+func convertToRefComparison(base, head string, comparison *github.CommitsComparison, maxCommits int) RefComparison {
+	result := RefComparison{
+		Base:         base,
+		Head:         head,
+		Status:       comparison.GetStatus(),
+		AheadBy:      comparison.GetAheadBy(),
+		BehindBy:     comparison.GetBehindBy(),
+		MergeBaseSHA: comparison.GetMergeBaseCommit().GetSHA(),
+		// Base can be moved to head without a merge commit only if head contains all of base.
+		CanFastForward: comparison.GetBehindBy() == 0,
+		FilesChanged:   len(comparison.Files),
+		Commits:        []PRContextCommit{},
+		HTMLURL:        comparison.GetHTMLURL(),
+	}
+	for _, file := range comparison.Files {
+		result.Additions += file.GetAdditions()
+		result.Deletions += file.GetDeletions()
+	}
+
+	// Commits are listed oldest first.
+	commits := comparison.Commits
+	if len(commits) > maxCommits {
+		commits = commits[len(commits)-maxCommits:]
+	}
+	result.CommitsTruncated = len(commits) < result.AheadBy
+	for _, commit := range commits {
+		message, _, _ := strings.Cut(commit.GetCommit().GetMessage(), "\n")
+		author := commit.GetAuthor().GetLogin()
+		if author == "" {
+			author = commit.GetCommit().GetAuthor().GetName()
+		}
+		sha := commit.GetSHA()
+		if len(sha) > 7 {
+			sha = sha[:7]
+		}
+		result.Commits = append(result.Commits, PRContextCommit{SHA: sha, Author: author, Message: message})
+	}
+	return result
+}
+
+// CompareRefs creates a tool that compares two refs, including refs of different repositories of a fork network.
+func CompareRefs(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("compare_refs",
+			mcp.WithDescription(t("TOOL_COMPARE_REFS_DESCRIPTION", "Compare two refs of a repository, or of two repositories of the same fork network, and report how many commits head is ahead of and behind base, whether base can be fast-forwarded to head, their merge base and the most recent commits only head has. To see how far a fork has drifted from upstream, compare base 'BRANCH' with head 'UPSTREAM_OWNER:BRANCH' in the fork: ahead_by is what the fork is missing and behind_by what the fork has on top.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_COMPARE_REFS_USER_TITLE", "Compare refs"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryOwner),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryName),
+			),
+			mcp.WithString("base",
+				mcp.Required(),
+				mcp.Description("Branch, tag or commit SHA to compare against. For a ref of another repository of the fork network, use owner:ref."),
+			),
+			mcp.WithString("head",
+				mcp.Required(),
+				mcp.Description("Branch, tag or commit SHA to compare. For a ref of another repository of the fork network, use owner:ref."),
+			),
+			mcp.WithNumber("max_commits",
+				mcp.Description("Maximum number of the most recent commits of head to list"),
+				mcp.Min(0),
+				mcp.Max(250),
+				mcp.DefaultNumber(DefaultCompareCommits),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			base, err := RequiredParam[string](request, "base")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			head, err := RequiredParam[string](request, "head")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			// OptionalIntParamWithDefault treats 0 as unset, but listing no commits is meaningful here.
+			maxCommits := DefaultCompareCommits
+			if _, ok := request.GetArguments()["max_commits"]; ok {
+				if maxCommits, err = OptionalIntParam(request, "max_commits"); err != nil {
+					return mcp.NewToolResultError(err.Error()), nil
+				}
+			}
+			if err := validateCompareRef("base", base); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if err := validateCompareRef("head", head); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			comparison, resp, err := client.Repositories.CompareCommits(ctx, owner, repo, base, head, nil)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to compare %s...%s in %s/%s", base, head, owner, repo),
+					resp,
+					err,
+				), nil
+			}
+			_ = resp.Body.Close()
+
+			return MarshalledTextResult(convertToRefComparison(base, head, comparison, maxCommits)), nil
+		}
+}
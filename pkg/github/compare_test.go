@@ -0,0 +1,82 @@
+package github
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/ghmock"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_CompareRefs(t *testing.T) {
+	tool, _ := CompareRefs(nil, translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+	assert.True(t, *tool.Annotations.ReadOnlyHint)
+	assert.ElementsMatch(t, []string{"owner", "repo", "base", "head"}, tool.InputSchema.Required)
+
+	commit := func(sha, login, message string) map[string]any {
+		return map[string]any{"sha": sha, "author": map[string]any{"login": login}, "commit": map[string]any{"message": message}}
+	}
+	comparison := map[string]any{
+		"status": "diverged", "ahead_by": 3, "behind_by": 1, "total_commits": 3,
+		"merge_base_commit": map[string]any{"sha": "abc123"},
+		"html_url":          "https://github.com/octo/app/compare/main...upstream:main",
+		"commits": []map[string]any{
+			commit("1111111111", "mona", "Oldest"),
+			commit("2222222222", "hubot", "Middle\n\nbody"),
+			commit("3333333333", "mona", "Newest"),
+		},
+		"files": []map[string]any{
+			{"filename": "a.go", "additions": 10, "deletions": 2},
+			{"filename": "b.go", "additions": 1, "deletions": 0},
+		},
+	}
+	call := func(t *testing.T, args map[string]any) RefComparison {
+		server := ghmock.New(t)
+		server.Respond("GET /repos/octo/app/compare/main...upstream:main", http.StatusOK, comparison)
+		_, handler := CompareRefs(server.GetClient(), translations.NullTranslationHelper)
+		args["owner"], args["repo"], args["base"] = "octo", "app", "main"
+		args["head"] = "upstream:main"
+		result := ghmock.CallTool(t, handler, args)
+		require.False(t, result.IsError, ghmock.ResultText(t, result))
+		var resp RefComparison
+		require.NoError(t, json.Unmarshal([]byte(ghmock.ResultText(t, result)), &resp))
+		return resp
+	}
+
+	t.Run("across forks", func(t *testing.T) {
+		resp := call(t, map[string]any{"max_commits": float64(2)})
+		assert.Equal(t, "diverged", resp.Status)
+		assert.Equal(t, 3, resp.AheadBy)
+		assert.Equal(t, 1, resp.BehindBy)
+		assert.False(t, resp.CanFastForward)
+		assert.Equal(t, "abc123", resp.MergeBaseSHA)
+		assert.Equal(t, 2, resp.FilesChanged)
+		assert.Equal(t, 11, resp.Additions)
+		assert.Equal(t, 2, resp.Deletions)
+		assert.Equal(t, []PRContextCommit{
+			{SHA: "2222222", Author: "hubot", Message: "Middle"},
+			{SHA: "3333333", Author: "mona", Message: "Newest"},
+		}, resp.Commits)
+		assert.True(t, resp.CommitsTruncated)
+	})
+
+	t.Run("no commits", func(t *testing.T) {
+		resp := call(t, map[string]any{"max_commits": float64(0)})
+		assert.Empty(t, resp.Commits)
+		assert.True(t, resp.CommitsTruncated)
+	})
+
+	t.Run("malformed ref", func(t *testing.T) {
+		server := ghmock.New(t)
+		_, handler := CompareRefs(server.GetClient(), translations.NullTranslationHelper)
+		result := ghmock.CallTool(t, handler, map[string]any{"owner": "octo", "repo": "app", "base": "main", "head": ":main"})
+		require.True(t, result.IsError)
+		assert.Equal(t, "head must be a ref or owner:ref, got ':main'", ghmock.ResultText(t, result))
+		assert.Empty(t, server.Requests())
+	})
+}
@@ -0,0 +1,103 @@
+package github
+
+import (
+	"fmt"
+
+	"github.com/github/github-mcp-server/pkg/lockdown"
+	"github.com/github/github-mcp-server/pkg/raw"
+	"github.com/github/github-mcp-server/pkg/toolsets"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// ToolDependencies are the clients and settings the built-in tools are created with. Custom tools receive the same
+// dependencies, so they authenticate with the same token, honour the same translations and can use the shared
+// parameter and error helpers of this package.
+type ToolDependencies struct {
+	GetClient         GetClientFn
+	GetGQLClient      GetGQLClientFn
+	GetRawClient      raw.GetRawClientFn
+	Translator        translations.TranslationHelperFunc
+	ContentWindowSize int
+	Flags             FeatureFlags
+	RepoAccessCache   *lockdown.RepoAccessCache
+}
+
+// ToolConstructor creates a custom tool and its handler. It has the same shape as the constructors of the built-in
+// tools, with the dependencies passed as one value.
+type ToolConstructor func(deps ToolDependencies) (mcp.Tool, server.ToolHandlerFunc)
+
+// CustomToolset describes tools defined outside this package. If ID names a built-in toolset the tools are added to
+// it; otherwise a new toolset is created, which can be enabled by its ID like any other toolset.
+type CustomToolset struct {
+	ID          string
+	Description string
+	// ReadTools must be annotated with ReadOnlyHint set to true.
+	ReadTools []ToolConstructor
+	// WriteTools must be annotated with ReadOnlyHint set to false. They are not registered in read-only mode.
+	WriteTools []ToolConstructor
+}
+
+// AddCustomToolsets adds custom toolsets to a toolset group, typically the one returned by DefaultToolsetGroup.
+// It fails without changing the group if a tool has no read-only annotation matching its kind, or if a tool name
+// is already taken.
+func AddCustomToolsets(tsg *toolsets.ToolsetGroup, deps ToolDependencies, custom ...CustomToolset) error {
+	names := make(map[string]bool)
+	for _, ts := range tsg.Toolsets {
+		for _, tool := range ts.GetAvailableTools() {
+			names[tool.Tool.Name] = true
+		}
+	}
+
+	type built struct {
+		toolset    *toolsets.Toolset
+		readTools  []server.ServerTool
+		writeTools []server.ServerTool
+	}
+	pending := make([]built, 0, len(custom))
+	created := make(map[string]*toolsets.Toolset)
+	for _, c := range custom {
+		if c.ID == "" {
+			return fmt.Errorf("custom toolset must have an ID")
+		}
+		toolset, ok := tsg.Toolsets[c.ID]
+		if !ok {
+			if toolset, ok = created[c.ID]; !ok {
+				toolset = toolsets.NewToolset(c.ID, c.Description)
+				created[c.ID] = toolset
+			}
+		}
+		b := built{toolset: toolset}
+		for _, kind := range []struct {
+			constructors []ToolConstructor
+			readOnly     bool
+			dest         *[]server.ServerTool
+		}{
+			{c.ReadTools, true, &b.readTools},
+			{c.WriteTools, false, &b.writeTools},
+		} {
+			for _, constructor := range kind.constructors {
+				tool, handler := constructor(deps)
+				if names[tool.Name] {
+					return fmt.Errorf("custom tool %s in toolset %s: a tool with this name already exists", tool.Name, c.ID)
+				}
+				if hint := tool.Annotations.ReadOnlyHint; hint == nil || *hint != kind.readOnly {
+					return fmt.Errorf("custom tool %s in toolset %s: ReadOnlyHint must be %t", tool.Name, c.ID, kind.readOnly)
+				}
+				names[tool.Name] = true
+				*kind.dest = append(*kind.dest, toolsets.NewServerTool(tool, handler))
+			}
+		}
+		pending = append(pending, b)
+	}
+
+	for _, b := range pending {
+		if _, ok := tsg.Toolsets[b.toolset.Name]; !ok {
+			tsg.AddToolset(b.toolset)
+		}
+		b.toolset.AddReadTools(b.readTools...)
+		b.toolset.AddWriteTools(b.writeTools...)
+	}
+	return nil
+}
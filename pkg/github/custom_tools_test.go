@@ -0,0 +1,123 @@
+package github
+
+import (
+	"context"
+	"testing"
+
+	"github.com/github/github-mcp-server/pkg/toolsets"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func customTool(name string, readOnly bool) ToolConstructor {
+	return func(deps ToolDependencies) (mcp.Tool, server.ToolHandlerFunc) {
+		return mcp.NewTool(name,
+				mcp.WithDescription(deps.Translator("TOOL_"+name+"_DESCRIPTION", "A custom tool")),
+				mcp.WithToolAnnotation(mcp.ToolAnnotation{ReadOnlyHint: ToBoolPtr(readOnly)}),
+			), func(ctx context.Context, _ mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+				if _, err := deps.GetClient(ctx); err != nil {
+					return nil, err
+				}
+				return mcp.NewToolResultText("ok"), nil
+			}
+	}
+}
+
+func Test_AddCustomToolsets(t *testing.T) {
+	newDeps := func() ToolDependencies {
+		return ToolDependencies{
+			GetClient:  stubGetClientFn(nil),
+			Translator: translations.NullTranslationHelper,
+		}
+	}
+	newGroup := func(readOnly bool) *toolsets.ToolsetGroup {
+		return DefaultToolsetGroup(readOnly, stubGetClientFn(nil), nil, nil, translations.NullTranslationHelper, 5000, FeatureFlags{}, nil, nil, nil)
+	}
+
+	t.Run("adds a new toolset", func(t *testing.T) {
+		group := newGroup(false)
+		err := AddCustomToolsets(group, newDeps(), CustomToolset{
+			ID:          "acme",
+			Description: "ACME conventions",
+			ReadTools:   []ToolConstructor{customTool("acme_check_conventions", true)},
+			WriteTools:  []ToolConstructor{customTool("acme_apply_conventions", false)},
+		})
+		require.NoError(t, err)
+
+		require.NoError(t, group.EnableToolset("acme"))
+		tool, toolsetName, err := group.FindToolByName("acme_apply_conventions")
+		require.NoError(t, err)
+		assert.Equal(t, "acme", toolsetName)
+
+		result, err := tool.Handler(context.Background(), createMCPRequest(map[string]any{}))
+		require.NoError(t, err)
+		assert.Equal(t, "ok", getTextResult(t, result).Text)
+	})
+
+	t.Run("extends a built-in toolset", func(t *testing.T) {
+		group := newGroup(false)
+		err := AddCustomToolsets(group, newDeps(), CustomToolset{
+			ID:        ToolsetMetadataRepos.ID,
+			ReadTools: []ToolConstructor{customTool("acme_list_service_repos", true)},
+		})
+		require.NoError(t, err)
+
+		_, toolsetName, err := group.FindToolByName("acme_list_service_repos")
+		require.NoError(t, err)
+		assert.Equal(t, ToolsetMetadataRepos.ID, toolsetName)
+	})
+
+	t.Run("read-only group drops write tools", func(t *testing.T) {
+		group := newGroup(true)
+		err := AddCustomToolsets(group, newDeps(), CustomToolset{
+			ID:         "acme",
+			ReadTools:  []ToolConstructor{customTool("acme_check_conventions", true)},
+			WriteTools: []ToolConstructor{customTool("acme_apply_conventions", false)},
+		})
+		require.NoError(t, err)
+
+		_, _, err = group.FindToolByName("acme_check_conventions")
+		require.NoError(t, err)
+		_, _, err = group.FindToolByName("acme_apply_conventions")
+		require.Error(t, err)
+	})
+
+	t.Run("rejects invalid tools without changing the group", func(t *testing.T) {
+		tests := []struct {
+			name          string
+			toolset       CustomToolset
+			expectedError string
+		}{
+			{
+				name:          "name taken by a built-in tool",
+				toolset:       CustomToolset{ID: "acme", ReadTools: []ToolConstructor{customTool("get_me", true)}},
+				expectedError: "custom tool get_me in toolset acme: a tool with this name already exists",
+			},
+			{
+				name:          "write tool in read tools",
+				toolset:       CustomToolset{ID: "acme", ReadTools: []ToolConstructor{customTool("acme_apply_conventions", false)}},
+				expectedError: "custom tool acme_apply_conventions in toolset acme: ReadOnlyHint must be true",
+			},
+			{
+				name:          "missing ID",
+				toolset:       CustomToolset{ReadTools: []ToolConstructor{customTool("acme_check_conventions", true)}},
+				expectedError: "custom toolset must have an ID",
+			},
+		}
+
+		for _, tc := range tests {
+			t.Run(tc.name, func(t *testing.T) {
+				group := newGroup(false)
+				err := AddCustomToolsets(group, newDeps(),
+					CustomToolset{ID: "other", ReadTools: []ToolConstructor{customTool("acme_other", true)}},
+					tc.toolset,
+				)
+				require.EqualError(t, err, tc.expectedError)
+				assert.NotContains(t, group.Toolsets, "other")
+			})
+		}
+	})
+}
@@ -106,6 +106,7 @@ func ListDependabotAlerts(getClient GetClientFn, t translations.TranslationHelpe
 				mcp.Description("Filter dependabot alerts by severity"),
 				mcp.Enum("low", "medium", "high", "critical"),
 			),
+			WithPagination(),
 		),
 		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			owner, err := RequiredParam[string](request, "owner")
@@ -124,6 +125,10 @@ func ListDependabotAlerts(getClient GetClientFn, t translations.TranslationHelpe
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
 
 			client, err := getClient(ctx)
 			if err != nil {
@@ -131,8 +136,9 @@ func ListDependabotAlerts(getClient GetClientFn, t translations.TranslationHelpe
 			}
 
 			alerts, resp, err := client.Dependabot.ListRepoAlerts(ctx, owner, repo, &github.ListAlertsOptions{
-				State:    ToStringPtr(state),
-				Severity: ToStringPtr(severity),
+				State:       ToStringPtr(state),
+				Severity:    ToStringPtr(severity),
+				ListOptions: github.ListOptions{Page: pagination.Page, PerPage: pagination.PerPage},
 			})
 			if err != nil {
 				return ghErrors.NewGitHubAPIErrorResponse(ctx,
@@ -151,7 +157,7 @@ func ListDependabotAlerts(getClient GetClientFn, t translations.TranslationHelpe
 				return mcp.NewToolResultError(fmt.Sprintf("failed to list alerts: %s", string(body))), nil
 			}
 
-			r, err := json.Marshal(alerts)
+			r, err := json.Marshal(NewListResponse("alerts", alerts, NewRESTPageInfo(resp), nil))
 			if err != nil {
 				return nil, fmt.Errorf("failed to marshal alerts: %w", err)
 			}
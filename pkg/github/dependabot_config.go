@@ -0,0 +1,428 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v79/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"go.yaml.in/yaml/v3"
+)
+
+// dependabotConfigPaths lists the locations GitHub reads the Dependabot configuration from, in order of precedence.
+var dependabotConfigPaths = []string{".github/dependabot.yml", ".github/dependabot.yaml"}
+
+// dependabotEcosystems are the package-ecosystem values accepted by Dependabot version updates.
+var dependabotEcosystems = []string{
+	"bun", "bundler", "cargo", "composer", "devcontainers", "docker", "docker-compose", "dotnet-sdk",
+	"elm", "github-actions", "gitsubmodule", "gomod", "gradle", "helm", "maven", "mix", "npm", "nuget",
+	"opentofu", "pip", "pub", "rust-toolchain", "swift", "terraform", "uv", "vcpkg",
+}
+
+var dependabotIntervals = []string{"daily", "weekly", "monthly", "quarterly", "semiannually", "yearly", "cron"}
+
+var dependabotTopLevelKeys = []string{"version", "updates", "registries", "enable-beta-ecosystems", "multi-ecosystem-groups"}
+
+type dependabotConfig struct {
+	Version int                `yaml:"version"`
+	Updates []dependabotUpdate `yaml:"updates"`
+}
+
+type dependabotUpdate struct {
+	PackageEcosystem      string             `yaml:"package-ecosystem"`
+	Directory             string             `yaml:"directory"`
+	Directories           []string           `yaml:"directories"`
+	Schedule              dependabotSchedule `yaml:"schedule"`
+	OpenPullRequestsLimit *int               `yaml:"open-pull-requests-limit"`
+	TargetBranch          string             `yaml:"target-branch"`
+}
+
+type dependabotSchedule struct {
+	Interval string `yaml:"interval"`
+	Day      string `yaml:"day"`
+	Time     string `yaml:"time"`
+	Cronjob  string `yaml:"cronjob"`
+}
+
+// DependabotUpdateSummary is a condensed view of one entry of the updates list.
+type DependabotUpdateSummary struct {
+	PackageEcosystem string   `json:"package_ecosystem"`
+	Directories      []string `json:"directories"`
+	Interval         string   `json:"interval"`
+	TargetBranch     string   `json:"target_branch,omitempty"`
+}
+
+// validateDependabotConfig checks a dependabot.yml document against the version 2 schema and returns
+// the problems found, along with a summary of the configured updates.
+func validateDependabotConfig(content string) ([]string, []DependabotUpdateSummary) {
+	var raw map[string]any
+	if err := yaml.Unmarshal([]byte(content), &raw); err != nil {
+		return []string{fmt.Sprintf("invalid YAML: %v", err)}, nil
+	}
+	var config dependabotConfig
+	if err := yaml.Unmarshal([]byte(content), &config); err != nil {
+		return []string{fmt.Sprintf("invalid configuration: %v", err)}, nil
+	}
+
+	var problems []string
+	for key := range raw {
+		if !slices.Contains(dependabotTopLevelKeys, key) {
+			problems = append(problems, fmt.Sprintf("unknown top-level key %q", key))
+		}
+	}
+	slices.Sort(problems)
+
+	if config.Version != 2 {
+		problems = append(problems, "version must be 2")
+	}
+	if len(config.Updates) == 0 {
+		problems = append(problems, "updates must contain at least one entry")
+	}
+
+	summaries := make([]DependabotUpdateSummary, 0, len(config.Updates))
+	for i, update := range config.Updates {
+		prefix := fmt.Sprintf("updates[%d]", i)
+		if update.PackageEcosystem == "" {
+			problems = append(problems, prefix+": package-ecosystem is required")
+		} else if !slices.Contains(dependabotEcosystems, update.PackageEcosystem) {
+			problems = append(problems, fmt.Sprintf("%s: unsupported package-ecosystem %q", prefix, update.PackageEcosystem))
+		}
+
+		directories := update.Directories
+		switch {
+		case update.Directory != "" && len(update.Directories) > 0:
+			problems = append(problems, prefix+": directory and directories are mutually exclusive")
+		case update.Directory != "":
+			directories = []string{update.Directory}
+		case len(update.Directories) == 0:
+			problems = append(problems, prefix+": directory or directories is required")
+		}
+
+		switch interval := update.Schedule.Interval; {
+		case interval == "":
+			problems = append(problems, prefix+": schedule.interval is required")
+		case !slices.Contains(dependabotIntervals, interval):
+			problems = append(problems, fmt.Sprintf("%s: unsupported schedule.interval %q", prefix, interval))
+		case interval == "cron" && update.Schedule.Cronjob == "":
+			problems = append(problems, prefix+": schedule.cronjob is required when interval is cron")
+		}
+		if day := update.Schedule.Day; day != "" && !slices.Contains([]string{"monday", "tuesday", "wednesday", "thursday", "friday", "saturday", "sunday"}, strings.ToLower(day)) {
+			problems = append(problems, fmt.Sprintf("%s: invalid schedule.day %q", prefix, day))
+		}
+		if limit := update.OpenPullRequestsLimit; limit != nil && *limit < 0 {
+			problems = append(problems, prefix+": open-pull-requests-limit must not be negative")
+		}
+
+		summaries = append(summaries, DependabotUpdateSummary{
+			PackageEcosystem: update.PackageEcosystem,
+			Directories:      directories,
+			Interval:         update.Schedule.Interval,
+			TargetBranch:     update.TargetBranch,
+		})
+	}
+
+	return problems, summaries
+}
+
+// getDependabotConfigFile returns the Dependabot configuration file of a repository, or nil if it has none.
+func getDependabotConfigFile(ctx context.Context, client *github.Client, owner, repo, ref string) (*github.RepositoryContent, *github.Response, error) {
+	var resp *github.Response
+	for _, path := range dependabotConfigPaths {
+		var file *github.RepositoryContent
+		var err error
+		file, _, resp, err = client.Repositories.GetContents(ctx, owner, repo, path, &github.RepositoryContentGetOptions{Ref: ref})
+		if resp != nil {
+			_ = resp.Body.Close()
+		}
+		if err == nil {
+			return file, resp, nil
+		}
+		if resp == nil || resp.StatusCode != http.StatusNotFound {
+			return nil, resp, err
+		}
+	}
+	return nil, resp, nil
+}
+
+// GetDependabotConfig creates a tool to read and validate the Dependabot configuration of a repository.
+func GetDependabotConfig(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_dependabot_config",
+			mcp.WithDescription(t("TOOL_GET_DEPENDABOT_CONFIG_DESCRIPTION", "Get the Dependabot version updates configuration (.github/dependabot.yml) of a repository, with a summary of the configured ecosystems and any schema validation problems.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_GET_DEPENDABOT_CONFIG_USER_TITLE", "Get Dependabot configuration"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryOwner),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryName),
+			),
+			mcp.WithString("ref",
+				mcp.Description("Branch, tag or commit to read the configuration from. Defaults to the default branch."),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			ref, err := OptionalParam[string](request, "ref")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			file, resp, err := getDependabotConfigFile(ctx, client, owner, repo, ref)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to get Dependabot configuration for %s/%s", owner, repo),
+					resp,
+					err,
+				), nil
+			}
+			if file == nil {
+				return mcp.NewToolResultError(fmt.Sprintf("%s/%s has no Dependabot configuration", owner, repo)), nil
+			}
+
+			content, err := file.GetContent()
+			if err != nil {
+				return nil, fmt.Errorf("failed to decode file content: %w", err)
+			}
+			problems, updates := validateDependabotConfig(content)
+
+			r, err := json.Marshal(map[string]any{
+				"path":              file.GetPath(),
+				"sha":               file.GetSHA(),
+				"content":           content,
+				"updates":           updates,
+				"valid":             len(problems) == 0,
+				"validation_errors": problems,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// UpdateDependabotConfig creates a tool to validate and commit a Dependabot configuration.
+func UpdateDependabotConfig(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("update_dependabot_config",
+			mcp.WithDescription(t("TOOL_UPDATE_DEPENDABOT_CONFIG_DESCRIPTION", "Create or replace the Dependabot configuration (.github/dependabot.yml) of a repository. The content is validated against the version 2 schema first and nothing is committed if it is invalid.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:           t("TOOL_UPDATE_DEPENDABOT_CONFIG_USER_TITLE", "Update Dependabot configuration"),
+				ReadOnlyHint:    ToBoolPtr(false),
+				DestructiveHint: ToBoolPtr(true),
+				IdempotentHint:  ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryOwner),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryName),
+			),
+			mcp.WithString("content",
+				mcp.Required(),
+				mcp.Description("The full YAML content of the Dependabot configuration"),
+			),
+			mcp.WithString("branch",
+				mcp.Description("Branch to commit to. Defaults to the default branch."),
+			),
+			mcp.WithString("message",
+				mcp.Description("Commit message. Defaults to 'Update Dependabot configuration'."),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			content, err := RequiredParam[string](request, "content")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			branch, err := OptionalParam[string](request, "branch")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			message, err := OptionalParam[string](request, "message")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if message == "" {
+				message = "Update Dependabot configuration"
+			}
+
+			if problems, _ := validateDependabotConfig(content); len(problems) > 0 {
+				return mcp.NewToolResultError(fmt.Sprintf("invalid Dependabot configuration:\n- %s", strings.Join(problems, "\n- "))), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			existing, resp, err := getDependabotConfigFile(ctx, client, owner, repo, branch)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to get Dependabot configuration for %s/%s", owner, repo),
+					resp,
+					err,
+				), nil
+			}
+
+			path := dependabotConfigPaths[0]
+			opts := &github.RepositoryContentFileOptions{
+				Message: github.Ptr(message),
+				Content: []byte(content),
+			}
+			if branch != "" {
+				opts.Branch = github.Ptr(branch)
+			}
+			if existing != nil {
+				path = existing.GetPath()
+				opts.SHA = existing.SHA
+			}
+
+			result, resp, err := client.Repositories.CreateFile(ctx, owner, repo, path, opts)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to update %s in %s/%s", path, owner, repo),
+					resp,
+					err,
+				), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			r, err := json.Marshal(map[string]any{
+				"path":    path,
+				"created": existing == nil,
+				"commit":  result.Commit.GetSHA(),
+				"url":     result.Content.GetHTMLURL(),
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// ListReposMissingDependabotConfig creates a tool to find the repositories of an organization without a Dependabot configuration.
+func ListReposMissingDependabotConfig(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_repos_missing_dependabot_config",
+			mcp.WithDescription(t("TOOL_LIST_REPOS_MISSING_DEPENDABOT_CONFIG_DESCRIPTION", "List the repositories of an organization that have no Dependabot version updates configuration on their default branch. Archived repositories are skipped. Works one page of organization repositories at a time; use the page parameter to continue.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_LIST_REPOS_MISSING_DEPENDABOT_CONFIG_USER_TITLE", "List repositories missing Dependabot configuration"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("org",
+				mcp.Required(),
+				mcp.Description("Organization name"),
+			),
+			mcp.WithBoolean("include_forks",
+				mcp.Description("Also check forked repositories. Defaults to false."),
+			),
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			org, err := RequiredParam[string](request, "org")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			includeForks, err := OptionalParam[bool](request, "include_forks")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			repos, resp, err := client.Repositories.ListByOrg(ctx, org, &github.RepositoryListByOrgOptions{
+				Type: "all",
+				ListOptions: github.ListOptions{
+					Page:    pagination.Page,
+					PerPage: pagination.PerPage,
+				},
+			})
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to list repositories for '%s'", org),
+					resp,
+					err,
+				), nil
+			}
+			_ = resp.Body.Close()
+			pageInfo := NewRESTPageInfo(resp)
+
+			checked := 0
+			missing := []MinimalRepository{}
+			for _, repo := range repos {
+				if repo.GetArchived() || (repo.GetFork() && !includeForks) {
+					continue
+				}
+				checked++
+				file, resp, err := getDependabotConfigFile(ctx, client, org, repo.GetName(), "")
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx,
+						fmt.Sprintf("failed to get Dependabot configuration for %s", repo.GetFullName()),
+						resp,
+						err,
+					), nil
+				}
+				if file == nil {
+					missing = append(missing, MinimalRepository{
+						ID:            repo.GetID(),
+						Name:          repo.GetName(),
+						FullName:      repo.GetFullName(),
+						HTMLURL:       repo.GetHTMLURL(),
+						Private:       repo.GetPrivate(),
+						Fork:          repo.GetFork(),
+						Archived:      repo.GetArchived(),
+						DefaultBranch: repo.GetDefaultBranch(),
+					})
+				}
+			}
+
+			result := NewListResponse("missing", missing, pageInfo, nil)
+			result["repositories_checked"] = checked
+
+			r, err := json.Marshal(result)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
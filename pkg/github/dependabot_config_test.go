@@ -0,0 +1,361 @@
+package github
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v79/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// The generic contents endpoint pattern does not match nested paths, so the Dependabot
+// tests register the .github directory explicitly.
+var (
+	getDotGithubContents = mock.EndpointPattern{Pattern: "/repos/{owner}/{repo}/contents/.github/{file}", Method: "GET"}
+	putDotGithubContents = mock.EndpointPattern{Pattern: "/repos/{owner}/{repo}/contents/.github/{file}", Method: "PUT"}
+)
+
+const validDependabotConfig = `version: 2
+updates:
+  - package-ecosystem: gomod
+    directory: /
+    schedule:
+      interval: weekly
+      day: monday
+  - package-ecosystem: github-actions
+    directories: ["/", "/tools"]
+    schedule:
+      interval: monthly
+`
+
+func dependabotConfigContent(path, content string) *github.RepositoryContent {
+	return &github.RepositoryContent{
+		Type:     github.Ptr("file"),
+		Path:     github.Ptr(path),
+		SHA:      github.Ptr("cfg123"),
+		Encoding: github.Ptr("base64"),
+		Content:  github.Ptr(base64.StdEncoding.EncodeToString([]byte(content))),
+	}
+}
+
+func notFoundHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"message": "Not Found"}`))
+	}
+}
+
+func Test_ValidateDependabotConfig(t *testing.T) {
+	tests := []struct {
+		name             string
+		content          string
+		expectedProblems []string
+	}{
+		{
+			name:    "valid configuration",
+			content: validDependabotConfig,
+		},
+		{
+			name: "schema violations",
+			content: `version: 1
+schedule: daily
+updates:
+  - package-ecosystem: maven2
+    directory: /
+    directories: [/app]
+    schedule:
+      interval: hourly
+  - directory: /
+    schedule:
+      interval: cron
+      day: someday
+    open-pull-requests-limit: -1
+`,
+			expectedProblems: []string{
+				`unknown top-level key "schedule"`,
+				"version must be 2",
+				`updates[0]: unsupported package-ecosystem "maven2"`,
+				"updates[0]: directory and directories are mutually exclusive",
+				`updates[0]: unsupported schedule.interval "hourly"`,
+				"updates[1]: package-ecosystem is required",
+				"updates[1]: schedule.cronjob is required when interval is cron",
+				`updates[1]: invalid schedule.day "someday"`,
+				"updates[1]: open-pull-requests-limit must not be negative",
+			},
+		},
+		{
+			name:             "missing updates",
+			content:          "version: 2\n",
+			expectedProblems: []string{"updates must contain at least one entry"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			problems, _ := validateDependabotConfig(tc.content)
+			assert.Equal(t, tc.expectedProblems, problems)
+		})
+	}
+
+	problems, _ := validateDependabotConfig("version: [2")
+	require.Len(t, problems, 1)
+	assert.Contains(t, problems[0], "invalid YAML")
+}
+
+func Test_GetDependabotConfig(t *testing.T) {
+	mockClient := github.NewClient(nil)
+	tool, _ := GetDependabotConfig(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "get_dependabot_config", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "ref")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		expectError    bool
+		expectedPath   string
+		expectedErrMsg string
+	}{
+		{
+			name: "falls back to dependabot.yaml",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					getDotGithubContents,
+					http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+						if r.URL.Path == "/repos/owner/repo/contents/.github/dependabot.yml" {
+							notFoundHandler()(w, r)
+							return
+						}
+						mockResponse(t, http.StatusOK, dependabotConfigContent(".github/dependabot.yaml", validDependabotConfig))(w, r)
+					}),
+				),
+			),
+			expectedPath: ".github/dependabot.yaml",
+		},
+		{
+			name: "no configuration",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(getDotGithubContents, notFoundHandler()),
+			),
+			expectError:    true,
+			expectedErrMsg: "owner/repo has no Dependabot configuration",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := GetDependabotConfig(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+			}))
+			require.NoError(t, err)
+
+			if tc.expectError {
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			textContent := getTextResult(t, result)
+			var response struct {
+				Path    string                    `json:"path"`
+				SHA     string                    `json:"sha"`
+				Content string                    `json:"content"`
+				Valid   bool                      `json:"valid"`
+				Updates []DependabotUpdateSummary `json:"updates"`
+			}
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &response))
+			assert.Equal(t, tc.expectedPath, response.Path)
+			assert.Equal(t, "cfg123", response.SHA)
+			assert.Equal(t, validDependabotConfig, response.Content)
+			assert.True(t, response.Valid)
+			assert.Equal(t, []DependabotUpdateSummary{
+				{PackageEcosystem: "gomod", Directories: []string{"/"}, Interval: "weekly"},
+				{PackageEcosystem: "github-actions", Directories: []string{"/", "/tools"}, Interval: "monthly"},
+			}, response.Updates)
+		})
+	}
+}
+
+func Test_UpdateDependabotConfig(t *testing.T) {
+	mockClient := github.NewClient(nil)
+	tool, _ := UpdateDependabotConfig(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "update_dependabot_config", tool.Name)
+	assert.Contains(t, tool.InputSchema.Properties, "content")
+	assert.Contains(t, tool.InputSchema.Properties, "branch")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo", "content"})
+
+	commitResponse := &github.RepositoryContentResponse{
+		Content: &github.RepositoryContent{HTMLURL: github.Ptr("https://github.com/owner/repo/blob/main/.github/dependabot.yml")},
+		Commit:  github.Commit{SHA: github.Ptr("commit123")},
+	}
+
+	tests := []struct {
+		name            string
+		mockedClient    *http.Client
+		requestArgs     map[string]interface{}
+		expectError     bool
+		expectedCreated bool
+		expectedErrMsg  string
+	}{
+		{
+			name: "replaces the existing configuration",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					getDotGithubContents,
+					mockResponse(t, http.StatusOK, dependabotConfigContent(".github/dependabot.yml", "version: 2\n")),
+				),
+				mock.WithRequestMatchHandler(
+					putDotGithubContents,
+					expectRequestBody(t, map[string]any{
+						"message": "Update Dependabot configuration",
+						"content": base64.StdEncoding.EncodeToString([]byte(validDependabotConfig)),
+						"sha":     "cfg123",
+						"branch":  "deps",
+					}).andThen(
+						mockResponse(t, http.StatusOK, commitResponse),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":   "owner",
+				"repo":    "repo",
+				"content": validDependabotConfig,
+				"branch":  "deps",
+			},
+		},
+		{
+			name: "creates a new configuration",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(getDotGithubContents, notFoundHandler()),
+				mock.WithRequestMatchHandler(
+					putDotGithubContents,
+					expectPath(t, "/repos/owner/repo/contents/.github/dependabot.yml").andThen(
+						mockResponse(t, http.StatusCreated, commitResponse),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":   "owner",
+				"repo":    "repo",
+				"content": validDependabotConfig,
+				"message": "Enable Dependabot",
+			},
+			expectedCreated: true,
+		},
+		{
+			name:         "rejects an invalid configuration",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner":   "owner",
+				"repo":    "repo",
+				"content": "version: 2\nupdates: []\n",
+			},
+			expectError:    true,
+			expectedErrMsg: "updates must contain at least one entry",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := UpdateDependabotConfig(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+			require.NoError(t, err)
+
+			if tc.expectError {
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			textContent := getTextResult(t, result)
+			var response struct {
+				Path    string `json:"path"`
+				Created bool   `json:"created"`
+				Commit  string `json:"commit"`
+			}
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &response))
+			assert.Equal(t, ".github/dependabot.yml", response.Path)
+			assert.Equal(t, tc.expectedCreated, response.Created)
+			assert.Equal(t, "commit123", response.Commit)
+		})
+	}
+}
+
+func Test_ListReposMissingDependabotConfig(t *testing.T) {
+	mockClient := github.NewClient(nil)
+	tool, _ := ListReposMissingDependabotConfig(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "list_repos_missing_dependabot_config", tool.Name)
+	assert.Contains(t, tool.InputSchema.Properties, "include_forks")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"org"})
+
+	repos := []*github.Repository{
+		{ID: github.Ptr(int64(1)), Name: github.Ptr("configured"), FullName: github.Ptr("octo-org/configured")},
+		{ID: github.Ptr(int64(2)), Name: github.Ptr("missing"), FullName: github.Ptr("octo-org/missing"), DefaultBranch: github.Ptr("main")},
+		{ID: github.Ptr(int64(3)), Name: github.Ptr("old"), FullName: github.Ptr("octo-org/old"), Archived: github.Ptr(true)},
+		{ID: github.Ptr(int64(4)), Name: github.Ptr("fork"), FullName: github.Ptr("octo-org/fork"), Fork: github.Ptr(true)},
+	}
+
+	client := github.NewClient(mock.NewMockedHTTPClient(
+		mock.WithRequestMatchHandler(
+			mock.GetOrgsReposByOrg,
+			http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Link", `<https://api.github.com/orgs/octo-org/repos?page=2>; rel="next"`)
+				mockResponse(t, http.StatusOK, repos)(w, r)
+			}),
+		),
+		mock.WithRequestMatchHandler(
+			getDotGithubContents,
+			http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch r.URL.Path {
+				case "/repos/octo-org/configured/contents/.github/dependabot.yml":
+					mockResponse(t, http.StatusOK, dependabotConfigContent(".github/dependabot.yml", validDependabotConfig))(w, r)
+				case "/repos/octo-org/missing/contents/.github/dependabot.yml",
+					"/repos/octo-org/missing/contents/.github/dependabot.yaml":
+					notFoundHandler()(w, r)
+				default:
+					t.Errorf("unexpected request for %s", r.URL.Path)
+				}
+			}),
+		),
+	))
+	_, handler := ListReposMissingDependabotConfig(stubGetClientFn(client), translations.NullTranslationHelper)
+
+	result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+		"org": "octo-org",
+	}))
+	require.NoError(t, err)
+
+	textContent := getTextResult(t, result)
+	var response struct {
+		RepositoriesChecked int                 `json:"repositories_checked"`
+		Missing             []MinimalRepository `json:"missing"`
+		PageInfo            PageInfo            `json:"page_info"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(textContent.Text), &response))
+	assert.Equal(t, 2, response.RepositoriesChecked)
+	require.Len(t, response.Missing, 1)
+	assert.Equal(t, "octo-org/missing", response.Missing[0].FullName)
+	assert.Equal(t, "main", response.Missing[0].DefaultBranch)
+	assert.True(t, response.PageInfo.HasNextPage)
+	assert.Equal(t, 2, response.PageInfo.NextPage)
+}
@@ -164,7 +164,9 @@ func Test_ListDependabotAlerts(t *testing.T) {
 				mock.WithRequestMatchHandler(
 					mock.GetReposDependabotAlertsByOwnerByRepo,
 					expectQueryParams(t, map[string]string{
-						"state": "open",
+						"state":    "open",
+						"page":     "1",
+						"per_page": "30",
 					}).andThen(
 						mockResponse(t, http.StatusOK, []*github.DependabotAlert{&criticalAlert}),
 					),
@@ -185,6 +187,8 @@ func Test_ListDependabotAlerts(t *testing.T) {
 					mock.GetReposDependabotAlertsByOwnerByRepo,
 					expectQueryParams(t, map[string]string{
 						"severity": "high",
+						"page":     "1",
+						"per_page": "30",
 					}).andThen(
 						mockResponse(t, http.StatusOK, []*github.DependabotAlert{&highSeverityAlert}),
 					),
@@ -203,7 +207,10 @@ func Test_ListDependabotAlerts(t *testing.T) {
 			mockedClient: mock.NewMockedHTTPClient(
 				mock.WithRequestMatchHandler(
 					mock.GetReposDependabotAlertsByOwnerByRepo,
-					expectQueryParams(t, map[string]string{}).andThen(
+					expectQueryParams(t, map[string]string{
+						"page":     "1",
+						"per_page": "30",
+					}).andThen(
 						mockResponse(t, http.StatusOK, []*github.DependabotAlert{&criticalAlert, &highSeverityAlert}),
 					),
 				),
@@ -258,8 +265,11 @@ func Test_ListDependabotAlerts(t *testing.T) {
 			textContent := getTextResult(t, result)
 
 			// Unmarshal and verify the result
-			var returnedAlerts []*github.DependabotAlert
-			err = json.Unmarshal([]byte(textContent.Text), &returnedAlerts)
+			var response struct {
+				Alerts []*github.DependabotAlert `json:"alerts"`
+			}
+			err = json.Unmarshal([]byte(textContent.Text), &response)
+			returnedAlerts := response.Alerts
 			assert.NoError(t, err)
 			assert.Len(t, returnedAlerts, len(tc.expectedAlerts))
 			for i, alert := range returnedAlerts {
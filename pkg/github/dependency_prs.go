@@ -0,0 +1,319 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v79/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// dependencyBots are the logins of the bots whose pull requests are treated as dependency updates.
+var dependencyBots = map[string]string{
+	"dependabot[bot]": "dependabot",
+	"renovate[bot]":   "renovate",
+}
+
+var (
+	// Dependabot: "Bump lodash from 4.17.20 to 4.17.21" or "chore(deps): bump lodash from 4.17.20 to 4.17.21 in /web".
+	dependabotTitlePattern = regexp.MustCompile(`(?i)\bbump (\S+) from v?(\S+) to v?(\S+)`)
+	// Renovate: "Update dependency lodash to v4.17.21" or "chore(deps): update lodash to v5 (major)".
+	renovateTitlePattern = regexp.MustCompile(`(?i)\bupdate (?:dependency )?(\S+) to v?(\S+)`)
+	// Renovate bodies carry a table row with "`from` -> `to`".
+	renovateBodyVersionPattern = regexp.MustCompile("`v?([^`\\s]+)` -> `v?([^`\\s]+)`")
+)
+
+// Semver impact levels of a dependency update, ordered from least to most risky.
+const (
+	SemverImpactPatch   = "patch"
+	SemverImpactMinor   = "minor"
+	SemverImpactMajor   = "major"
+	SemverImpactUnknown = "unknown"
+)
+
+var semverImpactRank = map[string]int{
+	SemverImpactPatch:   0,
+	SemverImpactMinor:   1,
+	SemverImpactMajor:   2,
+	SemverImpactUnknown: 3,
+}
+
+// DependencyPR describes an open dependency update pull request.
+type DependencyPR struct {
+	Number      int    `json:"number"`
+	Title       string `json:"title"`
+	HTMLURL     string `json:"html_url"`
+	Bot         string `json:"bot"`
+	Package     string `json:"package"`
+	FromVersion string `json:"from_version,omitempty"`
+	ToVersion   string `json:"to_version,omitempty"`
+	Impact      string `json:"impact"`
+	CIState     string `json:"ci_state"`
+	CreatedAt   string `json:"created_at"`
+}
+
+// DependencyPRGroup collects the dependency pull requests that touch the same package.
+type DependencyPRGroup struct {
+	Package      string         `json:"package"`
+	PullRequests []DependencyPR `json:"pull_requests"`
+}
+
+// SummarizeDependencyPRs creates a tool to group open dependency update pull requests and suggest a merge order.
+func SummarizeDependencyPRs(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("summarize_dependency_prs",
+			mcp.WithDescription(t("TOOL_SUMMARIZE_DEPENDENCY_PRS_DESCRIPTION", "Summarize the open Dependabot and Renovate pull requests of a repository. Groups them by package, classifies each update as a patch, minor or major version bump, reports the CI state of its head commit, and suggests a merge order: passing low-risk updates first, failing ones last.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_SUMMARIZE_DEPENDENCY_PRS_USER_TITLE", "Summarize dependency update pull requests"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryOwner),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryName),
+			),
+			mcp.WithString("base",
+				mcp.Description("Only include pull requests targeting this branch"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			base, err := OptionalParam[string](request, "base")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			opts := &github.PullRequestListOptions{
+				State:       "open",
+				Base:        base,
+				Sort:        "created",
+				Direction:   "asc",
+				ListOptions: github.ListOptions{PerPage: 100},
+			}
+			var prs []DependencyPR
+			for {
+				page, resp, err := client.PullRequests.List(ctx, owner, repo, opts)
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx,
+						fmt.Sprintf("failed to list pull requests for %s/%s", owner, repo),
+						resp,
+						err,
+					), nil
+				}
+				_ = resp.Body.Close()
+				nextPage := resp.NextPage
+
+				for _, pr := range page {
+					bot, ok := dependencyBots[pr.GetUser().GetLogin()]
+					if !ok {
+						continue
+					}
+					depPR := parseDependencyPR(pr, bot)
+					depPR.CIState, resp, err = getCommitCIState(ctx, client, owner, repo, pr.GetHead().GetSHA())
+					if err != nil {
+						return ghErrors.NewGitHubAPIErrorResponse(ctx,
+							fmt.Sprintf("failed to get CI state for pull request #%d", pr.GetNumber()),
+							resp,
+							err,
+						), nil
+					}
+					prs = append(prs, depPR)
+				}
+
+				if nextPage == 0 {
+					break
+				}
+				opts.Page = nextPage
+			}
+
+			groups := []DependencyPRGroup{}
+			groupIndex := make(map[string]int)
+			for _, pr := range prs {
+				key := strings.ToLower(pr.Package)
+				i, ok := groupIndex[key]
+				if !ok {
+					i = len(groups)
+					groupIndex[key] = i
+					groups = append(groups, DependencyPRGroup{Package: pr.Package})
+				}
+				groups[i].PullRequests = append(groups[i].PullRequests, pr)
+			}
+			sort.Slice(groups, func(i, j int) bool {
+				return strings.ToLower(groups[i].Package) < strings.ToLower(groups[j].Package)
+			})
+
+			r, err := json.Marshal(map[string]any{
+				"total":       len(prs),
+				"groups":      groups,
+				"merge_order": suggestDependencyMergeOrder(prs),
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// parseDependencyPR extracts the package and versions of a dependency update from its title, falling back to
+// the version table Renovate puts in the body.
+func parseDependencyPR(pr *github.PullRequest, bot string) DependencyPR {
+	depPR := DependencyPR{
+		Number:  pr.GetNumber(),
+		Title:   pr.GetTitle(),
+		HTMLURL: pr.GetHTMLURL(),
+		Bot:     bot,
+		Package: pr.GetTitle(),
+		Impact:  SemverImpactUnknown,
+	}
+	if pr.CreatedAt != nil {
+		depPR.CreatedAt = FormatTimestamp(pr.CreatedAt.Time)
+	}
+
+	if m := dependabotTitlePattern.FindStringSubmatch(pr.GetTitle()); m != nil {
+		depPR.Package, depPR.FromVersion, depPR.ToVersion = m[1], m[2], m[3]
+	} else if m := renovateTitlePattern.FindStringSubmatch(pr.GetTitle()); m != nil {
+		depPR.Package, depPR.ToVersion = m[1], m[2]
+		if b := renovateBodyVersionPattern.FindStringSubmatch(pr.GetBody()); b != nil {
+			depPR.FromVersion, depPR.ToVersion = b[1], b[2]
+		}
+	}
+
+	depPR.Impact = semverImpact(depPR.FromVersion, depPR.ToVersion)
+	return depPR
+}
+
+// semverImpact classifies the bump between two versions as patch, minor or major.
+func semverImpact(from, to string) string {
+	fromParts, ok := parseVersionParts(from)
+	if !ok {
+		return SemverImpactUnknown
+	}
+	toParts, ok := parseVersionParts(to)
+	if !ok {
+		return SemverImpactUnknown
+	}
+	switch {
+	case fromParts[0] != toParts[0]:
+		return SemverImpactMajor
+	// Below 1.0.0 a minor bump may break the API, so treat it as major.
+	case fromParts[0] == 0 && fromParts[1] != toParts[1]:
+		return SemverImpactMajor
+	case fromParts[1] != toParts[1]:
+		return SemverImpactMinor
+	default:
+		return SemverImpactPatch
+	}
+}
+
+// parseVersionParts returns the major, minor and patch numbers of a version such as v1.2.3 or 1.2.
+func parseVersionParts(version string) ([3]int, bool) {
+	var parts [3]int
+	version = strings.TrimPrefix(version, "v")
+	version, _, _ = strings.Cut(version, "-")
+	version, _, _ = strings.Cut(version, "+")
+	if version == "" {
+		return parts, false
+	}
+	for i, field := range strings.SplitN(version, ".", 3) {
+		n, err := strconv.Atoi(field)
+		if err != nil {
+			return parts, false
+		}
+		parts[i] = n
+	}
+	return parts, true
+}
+
+// getCommitCIState reduces the check runs and commit statuses of a commit to success, failure, pending or none.
+func getCommitCIState(ctx context.Context, client *github.Client, owner, repo, sha string) (string, *github.Response, error) {
+	checkRuns, resp, err := client.Checks.ListCheckRunsForRef(ctx, owner, repo, sha, &github.ListCheckRunsOptions{
+		ListOptions: github.ListOptions{PerPage: 100},
+	})
+	if err != nil {
+		return "", resp, err
+	}
+	_ = resp.Body.Close()
+
+	status, resp, err := client.Repositories.GetCombinedStatus(ctx, owner, repo, sha, nil)
+	if err != nil {
+		return "", resp, err
+	}
+	_ = resp.Body.Close()
+
+	var failed, pending bool
+	for _, run := range checkRuns.CheckRuns {
+		if run.GetStatus() != "completed" {
+			pending = true
+			continue
+		}
+		switch run.GetConclusion() {
+		case "failure", "timed_out", "cancelled", "action_required", "startup_failure":
+			failed = true
+		}
+	}
+	if status.GetTotalCount() > 0 {
+		switch status.GetState() {
+		case "failure", "error":
+			failed = true
+		case "pending":
+			pending = true
+		}
+	}
+
+	switch {
+	case failed:
+		return "failure", resp, nil
+	case pending:
+		return "pending", resp, nil
+	case len(checkRuns.CheckRuns) == 0 && status.GetTotalCount() == 0:
+		return "none", resp, nil
+	default:
+		return "success", resp, nil
+	}
+}
+
+// suggestDependencyMergeOrder orders pull requests so that green, low-impact updates come first and failing ones last.
+// Within the same CI state and impact, older pull requests come first.
+func suggestDependencyMergeOrder(prs []DependencyPR) []int {
+	ciRank := map[string]int{"success": 0, "none": 1, "pending": 2, "failure": 3}
+	ordered := make([]DependencyPR, len(prs))
+	copy(ordered, prs)
+	sort.SliceStable(ordered, func(i, j int) bool {
+		if ciRank[ordered[i].CIState] != ciRank[ordered[j].CIState] {
+			return ciRank[ordered[i].CIState] < ciRank[ordered[j].CIState]
+		}
+		if semverImpactRank[ordered[i].Impact] != semverImpactRank[ordered[j].Impact] {
+			return semverImpactRank[ordered[i].Impact] < semverImpactRank[ordered[j].Impact]
+		}
+		return ordered[i].CreatedAt < ordered[j].CreatedAt
+	})
+
+	numbers := make([]int, 0, len(ordered))
+	for _, pr := range ordered {
+		numbers = append(numbers, pr.Number)
+	}
+	return numbers
+}
@@ -0,0 +1,155 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v79/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_SemverImpact(t *testing.T) {
+	tests := []struct {
+		from, to string
+		expected string
+	}{
+		{"1.2.3", "1.2.4", SemverImpactPatch},
+		{"v1.2.3", "v1.3.0", SemverImpactMinor},
+		{"1.2.3", "2.0.0", SemverImpactMajor},
+		{"0.4.1", "0.5.0", SemverImpactMajor},
+		{"0.4.1", "0.4.2", SemverImpactPatch},
+		{"4.17", "4.18", SemverImpactMinor},
+		{"1.2.3-beta.1", "1.2.3", SemverImpactPatch},
+		{"", "1.0.0", SemverImpactUnknown},
+		{"abc", "1.0.0", SemverImpactUnknown},
+	}
+	for _, tc := range tests {
+		assert.Equal(t, tc.expected, semverImpact(tc.from, tc.to), "%s -> %s", tc.from, tc.to)
+	}
+}
+
+func Test_SummarizeDependencyPRs(t *testing.T) {
+	mockClient := github.NewClient(nil)
+	tool, _ := SummarizeDependencyPRs(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "summarize_dependency_prs", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "base")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+
+	created := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+	pr := func(number int, login, title, body string, age time.Duration) *github.PullRequest {
+		return &github.PullRequest{
+			Number:    github.Ptr(number),
+			Title:     github.Ptr(title),
+			Body:      github.Ptr(body),
+			HTMLURL:   github.Ptr("https://github.com/owner/repo/pull/" + title),
+			User:      &github.User{Login: github.Ptr(login)},
+			Head:      &github.PullRequestBranch{SHA: github.Ptr(strings.Repeat(string(rune('a'+number)), 7))},
+			CreatedAt: &github.Timestamp{Time: created.Add(age)},
+		}
+	}
+	pulls := []*github.PullRequest{
+		pr(1, "dependabot[bot]", "Bump lodash from 4.17.20 to 4.17.21", "", 0),
+		pr(2, "octocat", "Add feature", "", time.Hour),
+		pr(3, "renovate[bot]", "Update dependency react to v19", "| react | `18.3.1` -> `19.0.0` |", 2*time.Hour),
+		pr(4, "dependabot[bot]", "chore(deps): bump lodash from 4.17.20 to 4.18.0 in /web", "", 3*time.Hour),
+		pr(5, "dependabot[bot]", "Bump golang.org/x/net from 0.38.0 to 0.38.1", "", 4*time.Hour),
+	}
+
+	// PR 5 has a failing check, PR 3 has a pending status, everything else is green.
+	checkRuns := map[string]*github.ListCheckRunsResults{
+		"fffffff": {Total: github.Ptr(1), CheckRuns: []*github.CheckRun{{Status: github.Ptr("completed"), Conclusion: github.Ptr("failure")}}},
+	}
+	statuses := map[string]*github.CombinedStatus{
+		"ddddddd": {State: github.Ptr("pending"), TotalCount: github.Ptr(1)},
+	}
+
+	client := github.NewClient(mock.NewMockedHTTPClient(
+		mock.WithRequestMatchHandler(
+			mock.GetReposPullsByOwnerByRepo,
+			expectQueryParams(t, map[string]string{
+				"state":     "open",
+				"sort":      "created",
+				"direction": "asc",
+				"per_page":  "100",
+			}).andThen(
+				mockResponse(t, http.StatusOK, pulls),
+			),
+		),
+		mock.WithRequestMatchHandler(
+			mock.GetReposCommitsCheckRunsByOwnerByRepoByRef,
+			http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				sha := strings.Split(r.URL.Path, "/")[5]
+				result, ok := checkRuns[sha]
+				if !ok {
+					result = &github.ListCheckRunsResults{
+						Total:     github.Ptr(1),
+						CheckRuns: []*github.CheckRun{{Status: github.Ptr("completed"), Conclusion: github.Ptr("success")}},
+					}
+				}
+				mockResponse(t, http.StatusOK, result)(w, r)
+			}),
+		),
+		mock.WithRequestMatchHandler(
+			mock.GetReposCommitsStatusByOwnerByRepoByRef,
+			http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				sha := strings.Split(r.URL.Path, "/")[5]
+				result, ok := statuses[sha]
+				if !ok {
+					result = &github.CombinedStatus{State: github.Ptr("pending"), TotalCount: github.Ptr(0)}
+				}
+				mockResponse(t, http.StatusOK, result)(w, r)
+			}),
+		),
+	))
+	_, handler := SummarizeDependencyPRs(stubGetClientFn(client), translations.NullTranslationHelper)
+
+	result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+		"owner": "owner",
+		"repo":  "repo",
+	}))
+	require.NoError(t, err)
+
+	textContent := getTextResult(t, result)
+	var response struct {
+		Total      int                 `json:"total"`
+		Groups     []DependencyPRGroup `json:"groups"`
+		MergeOrder []int               `json:"merge_order"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(textContent.Text), &response))
+
+	assert.Equal(t, 4, response.Total)
+	require.Len(t, response.Groups, 3)
+	assert.Equal(t, "golang.org/x/net", response.Groups[0].Package)
+	assert.Equal(t, "lodash", response.Groups[1].Package)
+	require.Len(t, response.Groups[1].PullRequests, 2)
+	assert.Equal(t, SemverImpactPatch, response.Groups[1].PullRequests[0].Impact)
+	assert.Equal(t, SemverImpactMinor, response.Groups[1].PullRequests[1].Impact)
+	assert.Equal(t, "react", response.Groups[2].Package)
+	assert.Equal(t, DependencyPR{
+		Number:      3,
+		Title:       "Update dependency react to v19",
+		HTMLURL:     "https://github.com/owner/repo/pull/Update dependency react to v19",
+		Bot:         "renovate",
+		Package:     "react",
+		FromVersion: "18.3.1",
+		ToVersion:   "19.0.0",
+		Impact:      SemverImpactMajor,
+		CIState:     "pending",
+		CreatedAt:   "2025-03-01T02:00:00Z",
+	}, response.Groups[2].PullRequests[0])
+	assert.Equal(t, "failure", response.Groups[0].PullRequests[0].CIState)
+
+	// Green patch, green minor, pending major, failing patch.
+	assert.Equal(t, []int{1, 4, 3, 5}, response.MergeOrder)
+}
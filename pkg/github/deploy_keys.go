@@ -0,0 +1,230 @@
+package github
+
+import (
+	"context"
+	"fmt"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v79/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// MinimalDeployKey is a deploy key of a repository, without the key itself.
+type MinimalDeployKey struct {
+	ID        int64  `json:"id"`
+	Title     string `json:"title"`
+	ReadOnly  bool   `json:"read_only"`
+	Verified  bool   `json:"verified"`
+	AddedBy   string `json:"added_by,omitempty"`
+	CreatedAt string `json:"created_at,omitempty"`
+	LastUsed  string `json:"last_used,omitempty"`
+}
+
+func toMinimalDeployKey(key *github.Key) MinimalDeployKey {
+	result := MinimalDeployKey{
+		ID:       key.GetID(),
+		Title:    key.GetTitle(),
+		ReadOnly: key.GetReadOnly(),
+		Verified: key.GetVerified(),
+		AddedBy:  key.GetAddedBy(),
+	}
+	if key.CreatedAt != nil {
+		result.CreatedAt = FormatTimestamp(key.CreatedAt.Time)
+	}
+	if key.LastUsed != nil {
+		result.LastUsed = FormatTimestamp(key.LastUsed.Time)
+	}
+	return result
+}
+
+// ListDeployKeys creates a tool that lists the deploy keys of a repository.
+func ListDeployKeys(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_deploy_keys",
+			mcp.WithDescription(t("TOOL_LIST_DEPLOY_KEYS_DESCRIPTION", "List the deploy keys of a repository: their title, whether they can write, who added them and when they were last used. Use it to audit which machines can access a repository.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_LIST_DEPLOY_KEYS_USER_TITLE", "List deploy keys"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryOwner),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryName),
+			),
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			keys, resp, err := client.Repositories.ListKeys(ctx, owner, repo, &github.ListOptions{
+				Page:    pagination.Page,
+				PerPage: pagination.PerPage,
+			})
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to list deploy keys of %s/%s", owner, repo),
+					resp,
+					err,
+				), nil
+			}
+			_ = resp.Body.Close()
+
+			result := make([]MinimalDeployKey, 0, len(keys))
+			for _, key := range keys {
+				result = append(result, toMinimalDeployKey(key))
+			}
+			return MarshalledTextResult(NewListResponse("deploy_keys", result, NewRESTPageInfo(resp), nil)), nil
+		}
+}
+
+// AddDeployKey creates a tool that adds a deploy key to a repository.
+func AddDeployKey(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("add_deploy_key",
+			mcp.WithDescription(t("TOOL_ADD_DEPLOY_KEY_DESCRIPTION", "Add a deploy key to a repository. Keys are read-only unless read_only is false.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:           t("TOOL_ADD_DEPLOY_KEY_USER_TITLE", "Add deploy key"),
+				ReadOnlyHint:    ToBoolPtr(false),
+				DestructiveHint: ToBoolPtr(false),
+				IdempotentHint:  ToBoolPtr(false),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryOwner),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryName),
+			),
+			mcp.WithString("title",
+				mcp.Required(),
+				mcp.Description("Name of the key, e.g. the machine that uses it"),
+			),
+			mcp.WithString("key",
+				mcp.Required(),
+				mcp.Description("Public SSH key, e.g. 'ssh-ed25519 AAAA...'"),
+			),
+			mcp.WithBoolean("read_only",
+				mcp.Description("Whether the key can only read the repository"),
+				mcp.DefaultBool(true),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			title, err := RequiredParam[string](request, "title")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			key, err := RequiredParam[string](request, "key")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			readOnly, err := OptionalBoolParamWithDefault(request, "read_only", true)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			created, resp, err := client.Repositories.CreateKey(ctx, owner, repo, &github.Key{
+				Title:    github.Ptr(title),
+				Key:      github.Ptr(key),
+				ReadOnly: github.Ptr(readOnly),
+			})
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to add deploy key to %s/%s", owner, repo),
+					resp,
+					err,
+				), nil
+			}
+			_ = resp.Body.Close()
+
+			return MarshalledTextResult(toMinimalDeployKey(created)), nil
+		}
+}
+
+// DeleteDeployKey creates a tool that deletes a deploy key of a repository.
+func DeleteDeployKey(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("delete_deploy_key",
+			mcp.WithDescription(t("TOOL_DELETE_DEPLOY_KEY_DESCRIPTION", "Delete a deploy key of a repository, revoking its access.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:           t("TOOL_DELETE_DEPLOY_KEY_USER_TITLE", "Delete deploy key"),
+				ReadOnlyHint:    ToBoolPtr(false),
+				DestructiveHint: ToBoolPtr(true),
+				IdempotentHint:  ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryOwner),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryName),
+			),
+			mcp.WithNumber("key_id",
+				mcp.Required(),
+				mcp.Description("ID of the deploy key, as returned by list_deploy_keys"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			keyID, err := RequiredBigInt(request, "key_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			resp, err := client.Repositories.DeleteKey(ctx, owner, repo, keyID)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to delete deploy key %d of %s/%s", keyID, owner, repo),
+					resp,
+					err,
+				), nil
+			}
+			_ = resp.Body.Close()
+
+			return mcp.NewToolResultText(fmt.Sprintf("Deleted deploy key %d of %s/%s", keyID, owner, repo)), nil
+		}
+}
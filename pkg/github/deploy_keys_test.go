@@ -0,0 +1,82 @@
+package github
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/ghmock"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ListDeployKeys(t *testing.T) {
+	tool, _ := ListDeployKeys(nil, translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+	assert.True(t, *tool.Annotations.ReadOnlyHint)
+
+	server := ghmock.New(t)
+	server.Respond("GET /repos/octo/app/keys", http.StatusOK, []map[string]any{{
+		"id": 1, "key": "ssh-ed25519 AAAA", "title": "ci", "read_only": false, "verified": true,
+		"added_by": "mona", "created_at": "2024-01-01T00:00:00Z", "last_used": "2025-02-01T00:00:00Z",
+	}})
+	_, handler := ListDeployKeys(server.GetClient(), translations.NullTranslationHelper)
+
+	result := ghmock.CallTool(t, handler, map[string]any{"owner": "octo", "repo": "app"})
+	require.False(t, result.IsError, ghmock.ResultText(t, result))
+	text := ghmock.ResultText(t, result)
+	assert.NotContains(t, text, "ssh-ed25519")
+	var resp struct {
+		DeployKeys []MinimalDeployKey `json:"deploy_keys"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(text), &resp))
+	assert.Equal(t, []MinimalDeployKey{{
+		ID: 1, Title: "ci", ReadOnly: false, Verified: true, AddedBy: "mona",
+		CreatedAt: "2024-01-01T00:00:00Z", LastUsed: "2025-02-01T00:00:00Z",
+	}}, resp.DeployKeys)
+}
+
+func Test_AddDeployKey(t *testing.T) {
+	tool, _ := AddDeployKey(nil, translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+	assert.ElementsMatch(t, []string{"owner", "repo", "title", "key"}, tool.InputSchema.Required)
+
+	tests := []struct {
+		name     string
+		args     map[string]any
+		readOnly bool
+	}{
+		{"read-only by default", map[string]any{}, true},
+		{"write access", map[string]any{"read_only": false}, false},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			server := ghmock.New(t)
+			server.Respond("POST /repos/octo/app/keys", http.StatusCreated, map[string]any{"id": 2, "title": "ci", "read_only": tc.readOnly})
+			_, handler := AddDeployKey(server.GetClient(), translations.NullTranslationHelper)
+
+			tc.args["owner"], tc.args["repo"], tc.args["title"], tc.args["key"] = "octo", "app", "ci", "ssh-ed25519 AAAA"
+			result := ghmock.CallTool(t, handler, tc.args)
+			require.False(t, result.IsError, ghmock.ResultText(t, result))
+			var body map[string]any
+			require.NoError(t, server.AssertRequested("POST /repos/octo/app/keys").DecodeBody(&body))
+			assert.Equal(t, map[string]any{"title": "ci", "key": "ssh-ed25519 AAAA", "read_only": tc.readOnly}, body)
+		})
+	}
+}
+
+func Test_DeleteDeployKey(t *testing.T) {
+	tool, _ := DeleteDeployKey(nil, translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+	assert.True(t, *tool.Annotations.DestructiveHint)
+
+	server := ghmock.New(t)
+	server.Respond("DELETE /repos/octo/app/keys/1", http.StatusNoContent, nil)
+	_, handler := DeleteDeployKey(server.GetClient(), translations.NullTranslationHelper)
+
+	result := ghmock.CallTool(t, handler, map[string]any{"owner": "octo", "repo": "app", "key_id": float64(1)})
+	require.False(t, result.IsError, ghmock.ResultText(t, result))
+	assert.Equal(t, "Deleted deploy key 1 of octo/app", ghmock.ResultText(t, result))
+}
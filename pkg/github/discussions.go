@@ -240,16 +240,10 @@ func ListDiscussions(getGQLClient GetGQLClientFn, t translations.TranslationHelp
 			}
 
 			// Create response with pagination info
-			response := map[string]interface{}{
-				"discussions": discussions,
-				"pageInfo": map[string]interface{}{
-					"hasNextPage":     pageInfo.HasNextPage,
-					"hasPreviousPage": pageInfo.HasPreviousPage,
-					"startCursor":     string(pageInfo.StartCursor),
-					"endCursor":       string(pageInfo.EndCursor),
-				},
-				"totalCount": totalCount,
-			}
+			response := NewListResponse("discussions", discussions,
+				NewGraphQLPageInfo(bool(pageInfo.HasNextPage), bool(pageInfo.HasPreviousPage), string(pageInfo.StartCursor), string(pageInfo.EndCursor)),
+				github.Ptr(int(totalCount)),
+			)
 
 			out, err := json.Marshal(response)
 			if err != nil {
@@ -440,16 +434,11 @@ func GetDiscussionComments(getGQLClient GetGQLClientFn, t translations.Translati
 			}
 
 			// Create response with pagination info
-			response := map[string]interface{}{
-				"comments": comments,
-				"pageInfo": map[string]interface{}{
-					"hasNextPage":     q.Repository.Discussion.Comments.PageInfo.HasNextPage,
-					"hasPreviousPage": q.Repository.Discussion.Comments.PageInfo.HasPreviousPage,
-					"startCursor":     string(q.Repository.Discussion.Comments.PageInfo.StartCursor),
-					"endCursor":       string(q.Repository.Discussion.Comments.PageInfo.EndCursor),
-				},
-				"totalCount": q.Repository.Discussion.Comments.TotalCount,
-			}
+			pageInfo := q.Repository.Discussion.Comments.PageInfo
+			response := NewListResponse("comments", comments,
+				NewGraphQLPageInfo(bool(pageInfo.HasNextPage), bool(pageInfo.HasPreviousPage), string(pageInfo.StartCursor), string(pageInfo.EndCursor)),
+				github.Ptr(int(q.Repository.Discussion.Comments.TotalCount)),
+			)
 
 			out, err := json.Marshal(response)
 			if err != nil {
@@ -530,16 +519,11 @@ func ListDiscussionCategories(getGQLClient GetGQLClientFn, t translations.Transl
 			}
 
 			// Create response with pagination info
-			response := map[string]interface{}{
-				"categories": categories,
-				"pageInfo": map[string]interface{}{
-					"hasNextPage":     q.Repository.DiscussionCategories.PageInfo.HasNextPage,
-					"hasPreviousPage": q.Repository.DiscussionCategories.PageInfo.HasPreviousPage,
-					"startCursor":     string(q.Repository.DiscussionCategories.PageInfo.StartCursor),
-					"endCursor":       string(q.Repository.DiscussionCategories.PageInfo.EndCursor),
-				},
-				"totalCount": q.Repository.DiscussionCategories.TotalCount,
-			}
+			pageInfo := q.Repository.DiscussionCategories.PageInfo
+			response := NewListResponse("categories", categories,
+				NewGraphQLPageInfo(bool(pageInfo.HasNextPage), bool(pageInfo.HasPreviousPage), string(pageInfo.StartCursor), string(pageInfo.EndCursor)),
+				github.Ptr(int(q.Repository.DiscussionCategories.TotalCount)),
+			)
 
 			out, err := json.Marshal(response)
 			if err != nil {
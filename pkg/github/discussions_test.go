@@ -457,13 +457,8 @@ func Test_ListDiscussions(t *testing.T) {
 			// Parse the structured response with pagination info
 			var response struct {
 				Discussions []*github.Discussion `json:"discussions"`
-				PageInfo    struct {
-					HasNextPage     bool   `json:"hasNextPage"`
-					HasPreviousPage bool   `json:"hasPreviousPage"`
-					StartCursor     string `json:"startCursor"`
-					EndCursor       string `json:"endCursor"`
-				} `json:"pageInfo"`
-				TotalCount int `json:"totalCount"`
+				PageInfo    PageInfo             `json:"page_info"`
+				TotalCount  int                  `json:"total_count"`
 			}
 			err = json.Unmarshal([]byte(text), &response)
 			require.NoError(t, err)
@@ -636,18 +631,15 @@ func Test_GetDiscussionComments(t *testing.T) {
 	// (Lines removed)
 
 	var response struct {
-		Comments []*github.IssueComment `json:"comments"`
-		PageInfo struct {
-			HasNextPage     bool   `json:"hasNextPage"`
-			HasPreviousPage bool   `json:"hasPreviousPage"`
-			StartCursor     string `json:"startCursor"`
-			EndCursor       string `json:"endCursor"`
-		} `json:"pageInfo"`
-		TotalCount int `json:"totalCount"`
+		Comments   []*github.IssueComment `json:"comments"`
+		PageInfo   PageInfo               `json:"page_info"`
+		TotalCount int                    `json:"total_count"`
 	}
 	err = json.Unmarshal([]byte(textContent.Text), &response)
 	require.NoError(t, err)
 	assert.Len(t, response.Comments, 2)
+	assert.Equal(t, 2, response.TotalCount)
+	assert.False(t, response.PageInfo.HasNextPage)
 	expectedBodies := []string{"This is the first comment", "This is the second comment"}
 	for i, comment := range response.Comments {
 		assert.Equal(t, expectedBodies[i], *comment.Body)
@@ -780,13 +772,8 @@ func Test_ListDiscussionCategories(t *testing.T) {
 
 			var response struct {
 				Categories []map[string]string `json:"categories"`
-				PageInfo   struct {
-					HasNextPage     bool   `json:"hasNextPage"`
-					HasPreviousPage bool   `json:"hasPreviousPage"`
-					StartCursor     string `json:"startCursor"`
-					EndCursor       string `json:"endCursor"`
-				} `json:"pageInfo"`
-				TotalCount int `json:"totalCount"`
+				PageInfo   PageInfo            `json:"page_info"`
+				TotalCount int                 `json:"total_count"`
 			}
 			require.NoError(t, json.Unmarshal([]byte(text), &response))
 			assert.Equal(t, tc.expectedCategories, response.Categories)
@@ -0,0 +1,104 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v79/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// SyncForkResult is the outcome of updating a branch of a fork from its upstream repository.
+type SyncForkResult struct {
+	Repository string `json:"repository"`
+	Branch     string `json:"branch"`
+	Synced     bool   `json:"synced"`
+	Conflict   bool   `json:"conflict,omitempty"`
+	// MergeType is "fast-forward", "merge" or "none" when the branch was already up to date.
+	MergeType  string `json:"merge_type,omitempty"`
+	BaseBranch string `json:"base_branch,omitempty"`
+	Message    string `json:"message"`
+}
+
+// SyncFork creates a tool that updates a branch of a fork with the changes of its upstream repository.
+func SyncFork(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("sync_fork",
+			mcp.WithDescription(t("TOOL_SYNC_FORK_DESCRIPTION", "Update a branch of a fork with the changes of the same branch of its upstream repository, like the 'Sync fork' button: the branch is fast-forwarded, or upstream is merged into it when the fork has commits of its own. If the two conflict nothing is changed and conflict is true; use compare_refs to see how they diverged and resolve the conflict in a pull request.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:           t("TOOL_SYNC_FORK_USER_TITLE", "Sync fork with upstream"),
+				ReadOnlyHint:    ToBoolPtr(false),
+				DestructiveHint: ToBoolPtr(false),
+				IdempotentHint:  ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Owner of the fork"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Name of the fork"),
+			),
+			mcp.WithString("branch",
+				mcp.Description("Branch to update. Defaults to the default branch of the fork."),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			branch, err := OptionalParam[string](request, "branch")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			if branch == "" {
+				repository, resp, err := client.Repositories.Get(ctx, owner, repo)
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("failed to get repository %s/%s", owner, repo), resp, err), nil
+				}
+				_ = resp.Body.Close()
+				branch = repository.GetDefaultBranch()
+			}
+
+			result := SyncForkResult{
+				Repository: fmt.Sprintf("%s/%s", owner, repo),
+				Branch:     branch,
+			}
+			merged, resp, err := client.Repositories.MergeUpstream(ctx, owner, repo, &github.RepoMergeUpstreamRequest{
+				Branch: github.Ptr(branch),
+			})
+			if err != nil {
+				if resp != nil && resp.StatusCode == http.StatusConflict {
+					result.Conflict = true
+					result.Message = fmt.Sprintf("branch %s of %s/%s conflicts with upstream and was not changed", branch, owner, repo)
+					return MarshalledTextResult(result), nil
+				}
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to sync branch %s of %s/%s with upstream", branch, owner, repo),
+					resp,
+					err,
+				), nil
+			}
+			_ = resp.Body.Close()
+
+			result.Synced = true
+			result.MergeType = merged.GetMergeType()
+			result.BaseBranch = merged.GetBaseBranch()
+			result.Message = merged.GetMessage()
+			return MarshalledTextResult(result), nil
+		}
+}
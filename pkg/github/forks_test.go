@@ -0,0 +1,68 @@
+package github
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/ghmock"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_SyncFork(t *testing.T) {
+	tool, _ := SyncFork(nil, translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+	assert.False(t, *tool.Annotations.ReadOnlyHint)
+	assert.ElementsMatch(t, []string{"owner", "repo"}, tool.InputSchema.Required)
+
+	call := func(t *testing.T, server *ghmock.Server, args map[string]any) SyncForkResult {
+		_, handler := SyncFork(server.GetClient(), translations.NullTranslationHelper)
+		args["owner"], args["repo"] = "octo", "app"
+		result := ghmock.CallTool(t, handler, args)
+		require.False(t, result.IsError, ghmock.ResultText(t, result))
+		var resp SyncForkResult
+		require.NoError(t, json.Unmarshal([]byte(ghmock.ResultText(t, result)), &resp))
+		return resp
+	}
+
+	t.Run("default branch", func(t *testing.T) {
+		server := ghmock.New(t)
+		server.Respond("GET /repos/octo/app", http.StatusOK, map[string]any{"default_branch": "main"})
+		server.Respond("POST /repos/octo/app/merge-upstream", http.StatusOK, map[string]any{
+			"message": "Successfully fetched and fast-forwarded from upstream github:main", "merge_type": "fast-forward", "base_branch": "github:main",
+		})
+
+		resp := call(t, server, map[string]any{})
+		assert.Equal(t, SyncForkResult{
+			Repository: "octo/app", Branch: "main", Synced: true, MergeType: "fast-forward", BaseBranch: "github:main",
+			Message: "Successfully fetched and fast-forwarded from upstream github:main",
+		}, resp)
+		var body map[string]any
+		require.NoError(t, server.AssertRequested("POST /repos/octo/app/merge-upstream").DecodeBody(&body))
+		assert.Equal(t, map[string]any{"branch": "main"}, body)
+	})
+
+	t.Run("conflict", func(t *testing.T) {
+		server := ghmock.New(t)
+		server.Respond("POST /repos/octo/app/merge-upstream", http.StatusConflict, map[string]any{"message": "There are merge conflicts"})
+
+		resp := call(t, server, map[string]any{"branch": "develop"})
+		assert.False(t, resp.Synced)
+		assert.True(t, resp.Conflict)
+		assert.Equal(t, "branch develop of octo/app conflicts with upstream and was not changed", resp.Message)
+		server.AssertNotRequested(http.MethodGet)
+	})
+
+	t.Run("not a fork", func(t *testing.T) {
+		server := ghmock.New(t)
+		server.Respond("POST /repos/octo/app/merge-upstream", http.StatusUnprocessableEntity, map[string]any{"message": "This branch can't be synced"})
+		_, handler := SyncFork(server.GetClient(), translations.NullTranslationHelper)
+
+		result := ghmock.CallTool(t, handler, map[string]any{"owner": "octo", "repo": "app", "branch": "main"})
+		require.True(t, result.IsError)
+		assert.Contains(t, ghmock.ResultText(t, result), "failed to sync branch main of octo/app with upstream")
+	})
+}
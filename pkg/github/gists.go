@@ -80,7 +80,7 @@ func ListGists(getClient GetClientFn, t translations.TranslationHelperFunc) (too
 				return mcp.NewToolResultError(fmt.Sprintf("failed to list gists: %s", string(body))), nil
 			}
 
-			r, err := json.Marshal(gists)
+			r, err := json.Marshal(NewListResponse("gists", gists, NewRESTPageInfo(resp), nil))
 			if err != nil {
 				return nil, fmt.Errorf("failed to marshal response: %w", err)
 			}
@@ -141,8 +141,10 @@ func CreateGist(getClient GetClientFn, t translations.TranslationHelperFunc) (to
 	return mcp.NewTool("create_gist",
 			mcp.WithDescription(t("TOOL_CREATE_GIST_DESCRIPTION", "Create a new gist")),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
-				Title:        t("TOOL_CREATE_GIST", "Create Gist"),
-				ReadOnlyHint: ToBoolPtr(false),
+				Title:           t("TOOL_CREATE_GIST", "Create Gist"),
+				ReadOnlyHint:    ToBoolPtr(false),
+				DestructiveHint: ToBoolPtr(false),
+				IdempotentHint:  ToBoolPtr(false),
 			}),
 			mcp.WithString("description",
 				mcp.Description("Description of the gist"),
@@ -231,8 +233,10 @@ func UpdateGist(getClient GetClientFn, t translations.TranslationHelperFunc) (to
 	return mcp.NewTool("update_gist",
 			mcp.WithDescription(t("TOOL_UPDATE_GIST_DESCRIPTION", "Update an existing gist")),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
-				Title:        t("TOOL_UPDATE_GIST", "Update Gist"),
-				ReadOnlyHint: ToBoolPtr(false),
+				Title:           t("TOOL_UPDATE_GIST", "Update Gist"),
+				ReadOnlyHint:    ToBoolPtr(false),
+				DestructiveHint: ToBoolPtr(true),
+				IdempotentHint:  ToBoolPtr(true),
 			}),
 			mcp.WithString("gist_id",
 				mcp.Required(),
@@ -177,8 +177,11 @@ func Test_ListGists(t *testing.T) {
 			textContent := getTextResult(t, result)
 
 			// Unmarshal and verify the result
-			var returnedGists []*github.Gist
-			err = json.Unmarshal([]byte(textContent.Text), &returnedGists)
+			var response struct {
+				Gists []*github.Gist `json:"gists"`
+			}
+			err = json.Unmarshal([]byte(textContent.Text), &response)
+			returnedGists := response.Gists
 			require.NoError(t, err)
 
 			assert.Len(t, returnedGists, len(tc.expectedGists))
@@ -80,9 +80,9 @@ Field usage:
 	- Only include filters for fields that exist and are relevant.
 
 Pagination (mandatory):
-	- Loop while pageInfo.hasNextPage=true using after=pageInfo.nextCursor.
+	- Loop while page_info.has_next_page=true using after=page_info.end_cursor.
 	- Keep query, fields, per_page IDENTICAL on every page.
-	- Use before=pageInfo.prevCursor only when explicitly navigating to a previous page.
+	- Use before=page_info.start_cursor only when explicitly navigating to a previous page.
 
 Counting rules:
 	- Count items array length after full pagination.
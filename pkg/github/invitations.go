@@ -0,0 +1,623 @@
+package github
+
+import (
+	"context"
+	"fmt"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v79/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// MinimalOrgInvitation is a pending invitation to join an organization.
+type MinimalOrgInvitation struct {
+	ID        int64  `json:"id"`
+	Login     string `json:"login,omitempty"`
+	Email     string `json:"email,omitempty"`
+	Role      string `json:"role"`
+	Inviter   string `json:"inviter,omitempty"`
+	TeamCount int    `json:"team_count"`
+	CreatedAt string `json:"created_at,omitempty"`
+}
+
+// MinimalRepositoryInvitation is a pending invitation to collaborate on a repository.
+type MinimalRepositoryInvitation struct {
+	ID          int64  `json:"id"`
+	Repository  string `json:"repository"`
+	Invitee     string `json:"invitee,omitempty"`
+	Inviter     string `json:"inviter,omitempty"`
+	Permissions string `json:"permissions"`
+	Expired     bool   `json:"expired"`
+	CreatedAt   string `json:"created_at,omitempty"`
+	HTMLURL     string `json:"html_url,omitempty"`
+}
+
+func toMinimalOrgInvitation(invitation *github.Invitation) MinimalOrgInvitation {
+	result := MinimalOrgInvitation{
+		ID:        invitation.GetID(),
+		Login:     invitation.GetLogin(),
+		Email:     invitation.GetEmail(),
+		Role:      invitation.GetRole(),
+		Inviter:   invitation.GetInviter().GetLogin(),
+		TeamCount: invitation.GetTeamCount(),
+	}
+	if invitation.CreatedAt != nil {
+		result.CreatedAt = FormatTimestamp(invitation.CreatedAt.Time)
+	}
+	return result
+}
+
+func toMinimalRepositoryInvitation(invitation *github.RepositoryInvitation) MinimalRepositoryInvitation {
+	result := MinimalRepositoryInvitation{
+		ID:          invitation.GetID(),
+		Repository:  invitation.GetRepo().GetFullName(),
+		Invitee:     invitation.GetInvitee().GetLogin(),
+		Inviter:     invitation.GetInviter().GetLogin(),
+		Permissions: invitation.GetPermissions(),
+		Expired:     invitation.GetExpired(),
+		HTMLURL:     invitation.GetHTMLURL(),
+	}
+	if invitation.CreatedAt != nil {
+		result.CreatedAt = FormatTimestamp(invitation.CreatedAt.Time)
+	}
+	return result
+}
+
+// findOrgInvitation pages through the pending invitations of an organization for the one with the given ID. It
+// returns nil if there is none.
+func findOrgInvitation(ctx context.Context, client *github.Client, org string, id int64) (*github.Invitation, *github.Response, error) {
+	opts := &github.ListOptions{PerPage: 100}
+	for {
+		invitations, resp, err := client.Organizations.ListPendingOrgInvitations(ctx, org, opts)
+		if err != nil {
+			return nil, resp, err
+		}
+		_ = resp.Body.Close()
+		for _, invitation := range invitations {
+			if invitation.GetID() == id {
+				return invitation, resp, nil
+			}
+		}
+		if resp.NextPage == 0 {
+			return nil, resp, nil
+		}
+		opts.Page = resp.NextPage
+	}
+}
+
+// findRepositoryInvitation pages through the pending invitations of a repository for the one with the given ID. It
+// returns nil if there is none.
+func findRepositoryInvitation(ctx context.Context, client *github.Client, owner, repo string, id int64) (*github.RepositoryInvitation, *github.Response, error) {
+	opts := &github.ListOptions{PerPage: 100}
+	for {
+		invitations, resp, err := client.Repositories.ListInvitations(ctx, owner, repo, opts)
+		if err != nil {
+			return nil, resp, err
+		}
+		_ = resp.Body.Close()
+		for _, invitation := range invitations {
+			if invitation.GetID() == id {
+				return invitation, resp, nil
+			}
+		}
+		if resp.NextPage == 0 {
+			return nil, resp, nil
+		}
+		opts.Page = resp.NextPage
+	}
+}
+
+// collaboratorPermission maps the permissions of a repository invitation to the permission that grants them. GitHub
+// reports pull and push as read and write on invitations.
+func collaboratorPermission(invitationPermissions string) string {
+	switch invitationPermissions {
+	case "read":
+		return "pull"
+	case "write":
+		return "push"
+	default:
+		return invitationPermissions
+	}
+}
+
+// ListOrgInvitations creates a tool that lists the pending invitations of an organization.
+func ListOrgInvitations(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_org_invitations",
+			mcp.WithDescription(t("TOOL_LIST_ORG_INVITATIONS_DESCRIPTION", "List the pending invitations to join an organization. Requires being an owner of the organization.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_LIST_ORG_INVITATIONS_USER_TITLE", "List organization invitations"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("org",
+				mcp.Required(),
+				mcp.Description("Organization name"),
+			),
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			org, err := RequiredParam[string](request, "org")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			invitations, resp, err := client.Organizations.ListPendingOrgInvitations(ctx, org, &github.ListOptions{
+				Page:    pagination.Page,
+				PerPage: pagination.PerPage,
+			})
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to list invitations of '%s'", org),
+					resp,
+					err,
+				), nil
+			}
+			_ = resp.Body.Close()
+
+			result := make([]MinimalOrgInvitation, 0, len(invitations))
+			for _, invitation := range invitations {
+				result = append(result, toMinimalOrgInvitation(invitation))
+			}
+			return MarshalledTextResult(NewListResponse("invitations", result, NewRESTPageInfo(resp), nil)), nil
+		}
+}
+
+// CancelOrgInvitation creates a tool that cancels a pending invitation to join an organization.
+func CancelOrgInvitation(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("cancel_org_invitation",
+			mcp.WithDescription(t("TOOL_CANCEL_ORG_INVITATION_DESCRIPTION", "Cancel a pending invitation to join an organization. Requires being an owner of the organization.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:           t("TOOL_CANCEL_ORG_INVITATION_USER_TITLE", "Cancel organization invitation"),
+				ReadOnlyHint:    ToBoolPtr(false),
+				DestructiveHint: ToBoolPtr(true),
+				IdempotentHint:  ToBoolPtr(true),
+			}),
+			mcp.WithString("org",
+				mcp.Required(),
+				mcp.Description("Organization name"),
+			),
+			mcp.WithNumber("invitation_id",
+				mcp.Required(),
+				mcp.Description("ID of the invitation, as returned by list_org_invitations"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			org, err := RequiredParam[string](request, "org")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			invitationID, err := RequiredBigInt(request, "invitation_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			resp, err := client.Organizations.CancelInvite(ctx, org, invitationID)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to cancel invitation %d to '%s'", invitationID, org),
+					resp,
+					err,
+				), nil
+			}
+			_ = resp.Body.Close()
+
+			return mcp.NewToolResultText(fmt.Sprintf("Cancelled invitation %d to %s", invitationID, org)), nil
+		}
+}
+
+// ResendOrgInvitation creates a tool that sends a pending invitation to join an organization again.
+func ResendOrgInvitation(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("resend_org_invitation",
+			mcp.WithDescription(t("TOOL_RESEND_ORG_INVITATION_DESCRIPTION", "Send a pending invitation to join an organization again, e.g. because it expired or the invitee lost the email. The invitation is cancelled and recreated with the same role and teams, so it gets a new ID. Requires being an owner of the organization.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:           t("TOOL_RESEND_ORG_INVITATION_USER_TITLE", "Resend organization invitation"),
+				ReadOnlyHint:    ToBoolPtr(false),
+				DestructiveHint: ToBoolPtr(false),
+				IdempotentHint:  ToBoolPtr(false),
+			}),
+			mcp.WithString("org",
+				mcp.Required(),
+				mcp.Description("Organization name"),
+			),
+			mcp.WithNumber("invitation_id",
+				mcp.Required(),
+				mcp.Description("ID of the invitation, as returned by list_org_invitations"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			org, err := RequiredParam[string](request, "org")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			invitationID, err := RequiredBigInt(request, "invitation_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			invitation, resp, err := findOrgInvitation(ctx, client, org, invitationID)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("failed to list invitations of '%s'", org), resp, err), nil
+			}
+			if invitation == nil {
+				return mcp.NewToolResultError(fmt.Sprintf("no pending invitation %d to %s", invitationID, org)), nil
+			}
+
+			// Read everything needed to recreate the invitation before cancelling it.
+			opts := &github.CreateOrgInvitationOptions{Role: invitation.Role}
+			if invitation.GetLogin() != "" {
+				invitee, resp, err := client.Users.Get(ctx, invitation.GetLogin())
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("failed to get user %s", invitation.GetLogin()), resp, err), nil
+				}
+				_ = resp.Body.Close()
+				opts.InviteeID = invitee.ID
+			} else {
+				opts.Email = invitation.Email
+			}
+			if invitation.GetTeamCount() > 0 {
+				teams, resp, err := client.Organizations.ListOrgInvitationTeams(ctx, org, fmt.Sprint(invitationID), &github.ListOptions{PerPage: 100})
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("failed to list the teams of invitation %d", invitationID), resp, err), nil
+				}
+				_ = resp.Body.Close()
+				for _, team := range teams {
+					opts.TeamID = append(opts.TeamID, team.GetID())
+				}
+			}
+
+			resp, err = client.Organizations.CancelInvite(ctx, org, invitationID)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("failed to cancel invitation %d to '%s'", invitationID, org), resp, err), nil
+			}
+			_ = resp.Body.Close()
+
+			created, resp, err := client.Organizations.CreateOrgInvitation(ctx, org, opts)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("cancelled invitation %d but failed to invite %s to '%s' again", invitationID, orgInviteeName(invitation), org),
+					resp,
+					err,
+				), nil
+			}
+			_ = resp.Body.Close()
+
+			return MarshalledTextResult(toMinimalOrgInvitation(created)), nil
+		}
+}
+
+// orgInviteeName names the invitee of an organization invitation.
+func orgInviteeName(invitation *github.Invitation) string {
+	if invitation.GetLogin() != "" {
+		return invitation.GetLogin()
+	}
+	return invitation.GetEmail()
+}
+
+// ListRepositoryInvitations creates a tool that lists the pending invitations to collaborate on a repository.
+func ListRepositoryInvitations(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_repository_invitations",
+			mcp.WithDescription(t("TOOL_LIST_REPOSITORY_INVITATIONS_DESCRIPTION", "List the pending invitations to collaborate on a repository. Requires admin access to the repository.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_LIST_REPOSITORY_INVITATIONS_USER_TITLE", "List repository invitations"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryOwner),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryName),
+			),
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			invitations, resp, err := client.Repositories.ListInvitations(ctx, owner, repo, &github.ListOptions{
+				Page:    pagination.Page,
+				PerPage: pagination.PerPage,
+			})
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to list invitations of %s/%s", owner, repo),
+					resp,
+					err,
+				), nil
+			}
+			_ = resp.Body.Close()
+
+			result := make([]MinimalRepositoryInvitation, 0, len(invitations))
+			for _, invitation := range invitations {
+				result = append(result, toMinimalRepositoryInvitation(invitation))
+			}
+			return MarshalledTextResult(NewListResponse("invitations", result, NewRESTPageInfo(resp), nil)), nil
+		}
+}
+
+// CancelRepositoryInvitation creates a tool that cancels a pending invitation to collaborate on a repository.
+func CancelRepositoryInvitation(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("cancel_repository_invitation",
+			mcp.WithDescription(t("TOOL_CANCEL_REPOSITORY_INVITATION_DESCRIPTION", "Cancel a pending invitation to collaborate on a repository. Requires admin access to the repository.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:           t("TOOL_CANCEL_REPOSITORY_INVITATION_USER_TITLE", "Cancel repository invitation"),
+				ReadOnlyHint:    ToBoolPtr(false),
+				DestructiveHint: ToBoolPtr(true),
+				IdempotentHint:  ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryOwner),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryName),
+			),
+			mcp.WithNumber("invitation_id",
+				mcp.Required(),
+				mcp.Description("ID of the invitation, as returned by list_repository_invitations"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			invitationID, err := RequiredBigInt(request, "invitation_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			resp, err := client.Repositories.DeleteInvitation(ctx, owner, repo, invitationID)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to cancel invitation %d to %s/%s", invitationID, owner, repo),
+					resp,
+					err,
+				), nil
+			}
+			_ = resp.Body.Close()
+
+			return mcp.NewToolResultText(fmt.Sprintf("Cancelled invitation %d to %s/%s", invitationID, owner, repo)), nil
+		}
+}
+
+// ResendRepositoryInvitation creates a tool that sends a pending invitation to collaborate on a repository again.
+func ResendRepositoryInvitation(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("resend_repository_invitation",
+			mcp.WithDescription(t("TOOL_RESEND_REPOSITORY_INVITATION_DESCRIPTION", "Send a pending invitation to collaborate on a repository again, e.g. because it expired. The invitation is cancelled and recreated with the same permission, so it gets a new ID. Requires admin access to the repository.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:           t("TOOL_RESEND_REPOSITORY_INVITATION_USER_TITLE", "Resend repository invitation"),
+				ReadOnlyHint:    ToBoolPtr(false),
+				DestructiveHint: ToBoolPtr(false),
+				IdempotentHint:  ToBoolPtr(false),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryOwner),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryName),
+			),
+			mcp.WithNumber("invitation_id",
+				mcp.Required(),
+				mcp.Description("ID of the invitation, as returned by list_repository_invitations"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			invitationID, err := RequiredBigInt(request, "invitation_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			invitation, resp, err := findRepositoryInvitation(ctx, client, owner, repo, invitationID)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("failed to list invitations of %s/%s", owner, repo), resp, err), nil
+			}
+			if invitation == nil {
+				return mcp.NewToolResultError(fmt.Sprintf("no pending invitation %d to %s/%s", invitationID, owner, repo)), nil
+			}
+			invitee := invitation.GetInvitee().GetLogin()
+			permission := collaboratorPermission(invitation.GetPermissions())
+
+			resp, err = client.Repositories.DeleteInvitation(ctx, owner, repo, invitationID)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("failed to cancel invitation %d to %s/%s", invitationID, owner, repo), resp, err), nil
+			}
+			_ = resp.Body.Close()
+
+			created, resp, err := client.Repositories.AddCollaborator(ctx, owner, repo, invitee, &github.RepositoryAddCollaboratorOptions{
+				Permission: permission,
+			})
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("cancelled invitation %d but failed to invite %s to %s/%s again", invitationID, invitee, owner, repo),
+					resp,
+					err,
+				), nil
+			}
+			_ = resp.Body.Close()
+
+			return MarshalledTextResult(map[string]any{
+				"repository":    fmt.Sprintf("%s/%s", owner, repo),
+				"invitee":       invitee,
+				"permission":    permission,
+				"invitation_id": created.GetID(),
+			}), nil
+		}
+}
+
+// ListReceivedInvitations creates a tool that lists the pending invitations of the authenticated user.
+func ListReceivedInvitations(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_received_invitations",
+			mcp.WithDescription(t("TOOL_LIST_RECEIVED_INVITATIONS_DESCRIPTION", "List the pending invitations of the authenticated user: invitations to collaborate on repositories and to join organizations. Use accept_invitation to accept one.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_LIST_RECEIVED_INVITATIONS_USER_TITLE", "List received invitations"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+		),
+		func(ctx context.Context, _ mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			repositoryInvitations := []MinimalRepositoryInvitation{}
+			opts := &github.ListOptions{PerPage: 100}
+			for {
+				invitations, resp, err := client.Users.ListInvitations(ctx, opts)
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to list repository invitations", resp, err), nil
+				}
+				_ = resp.Body.Close()
+				for _, invitation := range invitations {
+					repositoryInvitations = append(repositoryInvitations, toMinimalRepositoryInvitation(invitation))
+				}
+				if resp.NextPage == 0 {
+					break
+				}
+				opts.Page = resp.NextPage
+			}
+
+			type orgInvitation struct {
+				Org  string `json:"org"`
+				Role string `json:"role"`
+			}
+			orgInvitations := []orgInvitation{}
+			membershipOpts := &github.ListOrgMembershipsOptions{State: "pending", ListOptions: github.ListOptions{PerPage: 100}}
+			for {
+				memberships, resp, err := client.Organizations.ListOrgMemberships(ctx, membershipOpts)
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to list organization invitations", resp, err), nil
+				}
+				_ = resp.Body.Close()
+				for _, membership := range memberships {
+					orgInvitations = append(orgInvitations, orgInvitation{
+						Org:  membership.GetOrganization().GetLogin(),
+						Role: membership.GetRole(),
+					})
+				}
+				if resp.NextPage == 0 {
+					break
+				}
+				membershipOpts.Page = resp.NextPage
+			}
+
+			return MarshalledTextResult(map[string]any{
+				"repository_invitations":   repositoryInvitations,
+				"organization_invitations": orgInvitations,
+			}), nil
+		}
+}
+
+// AcceptInvitation creates a tool that accepts an invitation of the authenticated user.
+func AcceptInvitation(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("accept_invitation",
+			mcp.WithDescription(t("TOOL_ACCEPT_INVITATION_DESCRIPTION", "Accept an invitation of the authenticated user: give invitation_id to accept an invitation to collaborate on a repository, or org to accept an invitation to join an organization. Use list_received_invitations to find them.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:           t("TOOL_ACCEPT_INVITATION_USER_TITLE", "Accept invitation"),
+				ReadOnlyHint:    ToBoolPtr(false),
+				DestructiveHint: ToBoolPtr(false),
+				IdempotentHint:  ToBoolPtr(true),
+			}),
+			mcp.WithNumber("invitation_id",
+				mcp.Description("ID of a repository invitation to accept"),
+			),
+			mcp.WithString("org",
+				mcp.Description("Organization whose invitation to accept"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			invitationID, err := OptionalIntParam(request, "invitation_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			org, err := OptionalParam[string](request, "org")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if (invitationID == 0) == (org == "") {
+				return mcp.NewToolResultError("exactly one of invitation_id and org is required"), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			if org != "" {
+				membership, resp, err := client.Organizations.EditOrgMembership(ctx, "", org, &github.Membership{State: github.Ptr("active")})
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("failed to accept the invitation to '%s'", org), resp, err), nil
+				}
+				_ = resp.Body.Close()
+				return mcp.NewToolResultText(fmt.Sprintf("Joined %s as %s", org, membership.GetRole())), nil
+			}
+
+			resp, err := client.Users.AcceptInvitation(ctx, int64(invitationID))
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("failed to accept invitation %d", invitationID), resp, err), nil
+			}
+			_ = resp.Body.Close()
+			return mcp.NewToolResultText(fmt.Sprintf("Accepted invitation %d", invitationID)), nil
+		}
+}
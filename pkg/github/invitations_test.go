@@ -0,0 +1,219 @@
+package github
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/ghmock"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ListOrgInvitations(t *testing.T) {
+	tool, _ := ListOrgInvitations(nil, translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+	assert.True(t, *tool.Annotations.ReadOnlyHint)
+
+	server := ghmock.New(t)
+	server.Respond("GET /orgs/octo/invitations", http.StatusOK, []map[string]any{
+		{"id": 1, "login": "mona", "role": "direct_member", "inviter": map[string]any{"login": "hubot"}, "team_count": 2, "created_at": "2025-01-02T03:04:05Z"},
+		{"id": 2, "email": "new@example.com", "role": "admin"},
+	})
+	_, handler := ListOrgInvitations(server.GetClient(), translations.NullTranslationHelper)
+
+	result := ghmock.CallTool(t, handler, map[string]any{"org": "octo"})
+	require.False(t, result.IsError, ghmock.ResultText(t, result))
+	var resp struct {
+		Invitations []MinimalOrgInvitation `json:"invitations"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(ghmock.ResultText(t, result)), &resp))
+	assert.Equal(t, []MinimalOrgInvitation{
+		{ID: 1, Login: "mona", Role: "direct_member", Inviter: "hubot", TeamCount: 2, CreatedAt: "2025-01-02T03:04:05Z"},
+		{ID: 2, Email: "new@example.com", Role: "admin"},
+	}, resp.Invitations)
+}
+
+func Test_CancelOrgInvitation(t *testing.T) {
+	tool, _ := CancelOrgInvitation(nil, translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+	assert.True(t, *tool.Annotations.DestructiveHint)
+
+	server := ghmock.New(t)
+	server.Respond("DELETE /orgs/octo/invitations/1", http.StatusNoContent, nil)
+	_, handler := CancelOrgInvitation(server.GetClient(), translations.NullTranslationHelper)
+
+	result := ghmock.CallTool(t, handler, map[string]any{"org": "octo", "invitation_id": float64(1)})
+	require.False(t, result.IsError, ghmock.ResultText(t, result))
+	assert.Equal(t, "Cancelled invitation 1 to octo", ghmock.ResultText(t, result))
+}
+
+func Test_ResendOrgInvitation(t *testing.T) {
+	tool, _ := ResendOrgInvitation(nil, translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	newServer := func(t *testing.T) *ghmock.Server {
+		server := ghmock.New(t)
+		server.Respond("GET /orgs/octo/invitations", http.StatusOK, []map[string]any{
+			{"id": 1, "login": "mona", "role": "direct_member", "team_count": 1},
+			{"id": 2, "email": "new@example.com", "role": "billing_manager"},
+		})
+		server.Respond("GET /users/mona", http.StatusOK, map[string]any{"login": "mona", "id": 583231})
+		server.Respond("GET /orgs/octo/invitations/1/teams", http.StatusOK, []map[string]any{{"id": 7, "slug": "platform"}})
+		server.Respond("DELETE /orgs/octo/invitations/{id}", http.StatusNoContent, nil)
+		server.Respond("POST /orgs/octo/invitations", http.StatusCreated, map[string]any{"id": 3, "login": "mona", "role": "direct_member", "team_count": 1})
+		return server
+	}
+
+	t.Run("recreates an invitation of a user with its teams", func(t *testing.T) {
+		server := newServer(t)
+		_, handler := ResendOrgInvitation(server.GetClient(), translations.NullTranslationHelper)
+
+		result := ghmock.CallTool(t, handler, map[string]any{"org": "octo", "invitation_id": float64(1)})
+		require.False(t, result.IsError, ghmock.ResultText(t, result))
+		assert.JSONEq(t, `{"id": 3, "login": "mona", "role": "direct_member", "team_count": 1}`, ghmock.ResultText(t, result))
+		server.AssertRequested("DELETE /orgs/octo/invitations/1")
+		var body map[string]any
+		require.NoError(t, server.AssertRequested("POST /orgs/octo/invitations").DecodeBody(&body))
+		assert.Equal(t, map[string]any{"invitee_id": float64(583231), "role": "direct_member", "team_ids": []any{float64(7)}}, body)
+	})
+
+	t.Run("recreates an invitation by email", func(t *testing.T) {
+		server := newServer(t)
+		_, handler := ResendOrgInvitation(server.GetClient(), translations.NullTranslationHelper)
+
+		result := ghmock.CallTool(t, handler, map[string]any{"org": "octo", "invitation_id": float64(2)})
+		require.False(t, result.IsError, ghmock.ResultText(t, result))
+		var body map[string]any
+		require.NoError(t, server.AssertRequested("POST /orgs/octo/invitations").DecodeBody(&body))
+		assert.Equal(t, map[string]any{"email": "new@example.com", "role": "billing_manager"}, body)
+	})
+
+	t.Run("unknown invitation", func(t *testing.T) {
+		server := newServer(t)
+		_, handler := ResendOrgInvitation(server.GetClient(), translations.NullTranslationHelper)
+
+		result := ghmock.CallTool(t, handler, map[string]any{"org": "octo", "invitation_id": float64(9)})
+		require.True(t, result.IsError)
+		assert.Equal(t, "no pending invitation 9 to octo", ghmock.ResultText(t, result))
+		server.AssertNotRequested(http.MethodDelete)
+	})
+}
+
+func Test_ListRepositoryInvitations(t *testing.T) {
+	tool, _ := ListRepositoryInvitations(nil, translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	server := ghmock.New(t)
+	server.Respond("GET /repos/octo/app/invitations", http.StatusOK, []map[string]any{{
+		"id": 5, "repository": map[string]any{"full_name": "octo/app"},
+		"invitee": map[string]any{"login": "mona"}, "inviter": map[string]any{"login": "hubot"},
+		"permissions": "write", "expired": true,
+	}})
+	_, handler := ListRepositoryInvitations(server.GetClient(), translations.NullTranslationHelper)
+
+	result := ghmock.CallTool(t, handler, map[string]any{"owner": "octo", "repo": "app"})
+	require.False(t, result.IsError, ghmock.ResultText(t, result))
+	var resp struct {
+		Invitations []MinimalRepositoryInvitation `json:"invitations"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(ghmock.ResultText(t, result)), &resp))
+	assert.Equal(t, []MinimalRepositoryInvitation{
+		{ID: 5, Repository: "octo/app", Invitee: "mona", Inviter: "hubot", Permissions: "write", Expired: true},
+	}, resp.Invitations)
+}
+
+func Test_CancelRepositoryInvitation(t *testing.T) {
+	tool, _ := CancelRepositoryInvitation(nil, translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	server := ghmock.New(t)
+	server.Respond("DELETE /repos/octo/app/invitations/5", http.StatusNoContent, nil)
+	_, handler := CancelRepositoryInvitation(server.GetClient(), translations.NullTranslationHelper)
+
+	result := ghmock.CallTool(t, handler, map[string]any{"owner": "octo", "repo": "app", "invitation_id": float64(5)})
+	require.False(t, result.IsError, ghmock.ResultText(t, result))
+	assert.Equal(t, "Cancelled invitation 5 to octo/app", ghmock.ResultText(t, result))
+}
+
+func Test_ResendRepositoryInvitation(t *testing.T) {
+	tool, _ := ResendRepositoryInvitation(nil, translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	server := ghmock.New(t)
+	server.Respond("GET /repos/octo/app/invitations", http.StatusOK, []map[string]any{{
+		"id": 5, "invitee": map[string]any{"login": "mona"}, "permissions": "write",
+	}})
+	server.Respond("DELETE /repos/octo/app/invitations/5", http.StatusNoContent, nil)
+	server.Respond("PUT /repos/octo/app/collaborators/mona", http.StatusCreated, map[string]any{"id": 6})
+	_, handler := ResendRepositoryInvitation(server.GetClient(), translations.NullTranslationHelper)
+
+	result := ghmock.CallTool(t, handler, map[string]any{"owner": "octo", "repo": "app", "invitation_id": float64(5)})
+	require.False(t, result.IsError, ghmock.ResultText(t, result))
+	assert.JSONEq(t, `{"repository": "octo/app", "invitee": "mona", "permission": "push", "invitation_id": 6}`, ghmock.ResultText(t, result))
+	var body map[string]any
+	require.NoError(t, server.AssertRequested("PUT /repos/octo/app/collaborators/mona").DecodeBody(&body))
+	assert.Equal(t, map[string]any{"permission": "push"}, body)
+}
+
+func Test_ListReceivedInvitations(t *testing.T) {
+	tool, _ := ListReceivedInvitations(nil, translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	server := ghmock.New(t)
+	server.Respond("GET /user/repository_invitations", http.StatusOK, []map[string]any{{
+		"id": 5, "repository": map[string]any{"full_name": "octo/app"}, "inviter": map[string]any{"login": "hubot"}, "permissions": "admin",
+	}})
+	server.HandleFunc("GET /user/memberships/orgs", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "pending", r.URL.Query().Get("state"))
+		_ = json.NewEncoder(w).Encode([]map[string]any{{"state": "pending", "role": "member", "organization": map[string]any{"login": "octo"}}})
+	})
+	_, handler := ListReceivedInvitations(server.GetClient(), translations.NullTranslationHelper)
+
+	result := ghmock.CallTool(t, handler, map[string]any{})
+	require.False(t, result.IsError, ghmock.ResultText(t, result))
+	assert.JSONEq(t, `{
+		"repository_invitations": [{"id": 5, "repository": "octo/app", "inviter": "hubot", "permissions": "admin", "expired": false}],
+		"organization_invitations": [{"org": "octo", "role": "member"}]
+	}`, ghmock.ResultText(t, result))
+}
+
+func Test_AcceptInvitation(t *testing.T) {
+	tool, _ := AcceptInvitation(nil, translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+	assert.Empty(t, tool.InputSchema.Required)
+
+	t.Run("repository invitation", func(t *testing.T) {
+		server := ghmock.New(t)
+		server.Respond("PATCH /user/repository_invitations/5", http.StatusNoContent, nil)
+		_, handler := AcceptInvitation(server.GetClient(), translations.NullTranslationHelper)
+
+		result := ghmock.CallTool(t, handler, map[string]any{"invitation_id": float64(5)})
+		require.False(t, result.IsError, ghmock.ResultText(t, result))
+		assert.Equal(t, "Accepted invitation 5", ghmock.ResultText(t, result))
+	})
+
+	t.Run("organization invitation", func(t *testing.T) {
+		server := ghmock.New(t)
+		server.Respond("PATCH /user/memberships/orgs/octo", http.StatusOK, map[string]any{"state": "active", "role": "member"})
+		_, handler := AcceptInvitation(server.GetClient(), translations.NullTranslationHelper)
+
+		result := ghmock.CallTool(t, handler, map[string]any{"org": "octo"})
+		require.False(t, result.IsError, ghmock.ResultText(t, result))
+		assert.Equal(t, "Joined octo as member", ghmock.ResultText(t, result))
+		var body map[string]any
+		require.NoError(t, server.AssertRequested("PATCH /user/memberships/orgs/octo").DecodeBody(&body))
+		assert.Equal(t, map[string]any{"state": "active"}, body)
+	})
+
+	t.Run("exactly one of invitation_id and org", func(t *testing.T) {
+		_, handler := AcceptInvitation(ghmock.New(t).GetClient(), translations.NullTranslationHelper)
+		for _, args := range []map[string]any{{}, {"invitation_id": float64(5), "org": "octo"}} {
+			result := ghmock.CallTool(t, handler, args)
+			require.True(t, result.IsError)
+			assert.Equal(t, "exactly one of invitation_id and org is required", ghmock.ResultText(t, result))
+		}
+	})
+}
@@ -0,0 +1,450 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v79/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/shurcooL/githubv4"
+)
+
+// Relationships link_issues records and get_linked_issues reports.
+const (
+	IssueLinkCloses       = "closes"
+	IssueLinkRelatesTo    = "relates_to"
+	IssueLinkDuplicates   = "duplicates"
+	IssueLinkClosedBy     = "closed_by"
+	IssueLinkDuplicateOf  = "duplicate_of"
+	IssueLinkDuplicatedBy = "duplicated_by"
+	IssueLinkReferencedBy = "referenced_by"
+)
+
+// The section of an issue or pull request body in which link_issues records links. GitHub treats the closing
+// keywords in it like any other in the body of a pull request.
+const (
+	issueLinksStart  = "<!-- linked-issues:start -->"
+	issueLinksEnd    = "<!-- linked-issues:end -->"
+	issueLinksHeader = "**Linked issues**"
+)
+
+var issueLinkLinePattern = regexp.MustCompile(`(?m)^- (Relates to|Closes) ([\w.-]+/[\w.-]+)#(\d+)$`)
+
+// LinkedIssue is an issue or pull request linked to another.
+type LinkedIssue struct {
+	Relationship string `json:"relationship"`
+	Repository   string `json:"repository"`
+	Number       int    `json:"number"`
+	Title        string `json:"title,omitempty"`
+	State        string `json:"state,omitempty"`
+	URL          string `json:"url"`
+}
+
+// issueLinkLine is the line of the linked issues section recording a link to ref.
+func issueLinkLine(relationship, ref string) string {
+	if relationship == IssueLinkCloses {
+		return "- Closes " + ref
+	}
+	return "- Relates to " + ref
+}
+
+// addIssueLink adds line to the linked issues section of body, creating the section if needed. It returns false if
+// the body already has the line.
+func addIssueLink(body, line string) (string, bool) {
+	start := strings.Index(body, issueLinksStart)
+	end := strings.Index(body, issueLinksEnd)
+	if start < 0 || end < start {
+		section := strings.Join([]string{issueLinksStart, issueLinksHeader, line, issueLinksEnd}, "\n")
+		if strings.TrimSpace(body) == "" {
+			return section, true
+		}
+		return strings.TrimRight(body, "\n") + "\n\n" + section, true
+	}
+	for _, existing := range strings.Split(body[start:end], "\n") {
+		if strings.TrimSpace(existing) == line {
+			return body, false
+		}
+	}
+	return body[:end] + line + "\n" + body[end:], true
+}
+
+// linksInBody returns the relates_to links recorded in the linked issues section of body. Closing links are reported
+// by GitHub itself.
+func linksInBody(body string) []LinkedIssue {
+	start := strings.Index(body, issueLinksStart)
+	end := strings.Index(body, issueLinksEnd)
+	if start < 0 || end < start {
+		return nil
+	}
+	var links []LinkedIssue
+	for _, m := range issueLinkLinePattern.FindAllStringSubmatch(body[start:end], -1) {
+		if m[1] != "Relates to" {
+			continue
+		}
+		number, _ := strconv.Atoi(m[3])
+		links = append(links, LinkedIssue{
+			Relationship: IssueLinkRelatesTo,
+			Repository:   m[2],
+			Number:       number,
+			URL:          fmt.Sprintf("https://github.com/%s/issues/%d", m[2], number),
+		})
+	}
+	return links
+}
+
+// fetchIssueNodeID returns the GraphQL node ID of an issue.
+func fetchIssueNodeID(ctx context.Context, gqlClient *githubv4.Client, owner, repo string, number int) (githubv4.ID, error) {
+	var query struct {
+		Repository struct {
+			Issue struct {
+				ID githubv4.ID
+			} `graphql:"issue(number: $issueNumber)"`
+		} `graphql:"repository(owner: $owner, name: $repo)"`
+	}
+	vars := map[string]any{
+		"owner":       githubv4.String(owner),
+		"repo":        githubv4.String(repo),
+		"issueNumber": githubv4.Int(number), // #nosec G115 - issue numbers are always small positive integers
+	}
+	if err := gqlClient.Query(ctx, &query, vars); err != nil {
+		return "", err
+	}
+	return query.Repository.Issue.ID, nil
+}
+
+// LinkIssues creates a tool that records a relationship between two issues, possibly in different repositories.
+func LinkIssues(getClient GetClientFn, getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("link_issues",
+			mcp.WithDescription(t("TOOL_LINK_ISSUES_DESCRIPTION", `Record a relationship from an issue or pull request to another issue, in the same or another repository.
+- 'relates_to' adds the target to a "Linked issues" section of the source's body.
+- 'closes' does the same with a closing keyword, so that merging the source pull request closes the target. The source must be a pull request.
+- 'duplicates' closes the source issue as a duplicate of the target.
+Use get_linked_issues to read the relationships of an issue.`)),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:           t("TOOL_LINK_ISSUES_USER_TITLE", "Link issues"),
+				ReadOnlyHint:    ToBoolPtr(false),
+				DestructiveHint: ToBoolPtr(true),
+				IdempotentHint:  ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Owner of the source repository"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Name of the source repository"),
+			),
+			mcp.WithNumber("issue_number",
+				mcp.Required(),
+				mcp.Description("Number of the source issue or pull request"),
+			),
+			mcp.WithString("relationship",
+				mcp.Required(),
+				mcp.Description("Relationship of the source to the target"),
+				mcp.Enum(IssueLinkRelatesTo, IssueLinkCloses, IssueLinkDuplicates),
+			),
+			mcp.WithString("target_owner",
+				mcp.Description("Owner of the target repository. Defaults to owner."),
+			),
+			mcp.WithString("target_repo",
+				mcp.Description("Name of the target repository. Defaults to repo."),
+			),
+			mcp.WithNumber("target_issue_number",
+				mcp.Required(),
+				mcp.Description("Number of the target issue"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			number, err := RequiredInt(request, "issue_number")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			relationship, err := RequiredParam[string](request, "relationship")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			targetOwner, err := OptionalParam[string](request, "target_owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			targetRepo, err := OptionalParam[string](request, "target_repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			targetNumber, err := RequiredInt(request, "target_issue_number")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if targetOwner == "" {
+				targetOwner = owner
+			}
+			if targetRepo == "" {
+				targetRepo = repo
+			}
+			source := fmt.Sprintf("%s/%s#%d", owner, repo, number)
+			target := fmt.Sprintf("%s/%s#%d", targetOwner, targetRepo, targetNumber)
+			if strings.EqualFold(source, target) {
+				return mcp.NewToolResultError("an issue cannot be linked to itself"), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			if relationship == IssueLinkDuplicates {
+				gqlClient, err := getGQLClient(ctx)
+				if err != nil {
+					return nil, fmt.Errorf("failed to get GraphQL client: %w", err)
+				}
+				sourceID, err := fetchIssueNodeID(ctx, gqlClient, owner, repo, number)
+				if err != nil {
+					return ghErrors.NewGitHubGraphQLErrorResponse(ctx, fmt.Sprintf("failed to find issue %s", source), err), nil
+				}
+				targetID, err := fetchIssueNodeID(ctx, gqlClient, targetOwner, targetRepo, targetNumber)
+				if err != nil {
+					return ghErrors.NewGitHubGraphQLErrorResponse(ctx, fmt.Sprintf("failed to find issue %s", target), err), nil
+				}
+				var mutation struct {
+					CloseIssue struct {
+						Issue struct {
+							ID githubv4.ID
+						}
+					} `graphql:"closeIssue(input: $input)"`
+				}
+				stateReason := IssueClosedStateReasonDuplicate
+				if err := gqlClient.Mutate(ctx, &mutation, CloseIssueInput{
+					IssueID:          sourceID,
+					StateReason:      &stateReason,
+					DuplicateIssueID: &targetID,
+				}, nil); err != nil {
+					return ghErrors.NewGitHubGraphQLErrorResponse(ctx, fmt.Sprintf("failed to close %s as a duplicate", source), err), nil
+				}
+				return MarshalledTextResult(map[string]any{"source": source, "target": target, "relationship": relationship, "changed": true}), nil
+			}
+
+			issue, resp, err := client.Issues.Get(ctx, owner, repo, number)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("failed to get %s", source), resp, err), nil
+			}
+			_ = resp.Body.Close()
+			if relationship == IssueLinkCloses && !issue.IsPullRequest() {
+				return mcp.NewToolResultError(fmt.Sprintf("%s is an issue; only pull requests can close issues, use relates_to instead", source)), nil
+			}
+
+			body, changed := addIssueLink(issue.GetBody(), issueLinkLine(relationship, target))
+			if changed {
+				_, resp, err = client.Issues.Edit(ctx, owner, repo, number, &github.IssueRequest{Body: github.Ptr(body)})
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("failed to update %s", source), resp, err), nil
+				}
+				_ = resp.Body.Close()
+			}
+			return MarshalledTextResult(map[string]any{"source": source, "target": target, "relationship": relationship, "changed": changed}), nil
+		}
+}
+
+// linkedIssueNode is an issue or pull request in a get_linked_issues query.
+type linkedIssueNode struct {
+	Number     githubv4.Int
+	Title      githubv4.String
+	State      githubv4.String
+	URL        githubv4.URI
+	Repository struct {
+		NameWithOwner githubv4.String
+	}
+}
+
+func (n linkedIssueNode) toLinkedIssue(relationship string) LinkedIssue {
+	return LinkedIssue{
+		Relationship: relationship,
+		Repository:   string(n.Repository.NameWithOwner),
+		Number:       int(n.Number),
+		Title:        string(n.Title),
+		State:        strings.ToLower(string(n.State)),
+		URL:          n.URL.String(),
+	}
+}
+
+// linkedPullRequestNode is a pull request next to issues in a get_linked_issues query. The states of issues and
+// pull requests are different enums, which GraphQL does not allow under the same name in one selection.
+type linkedPullRequestNode struct {
+	Number     githubv4.Int
+	Title      githubv4.String
+	State      githubv4.String `graphql:"pullRequestState: state"`
+	URL        githubv4.URI
+	Repository struct {
+		NameWithOwner githubv4.String
+	}
+}
+
+// issueOrPullRequestNode is an issue or pull request in a get_linked_issues query.
+type issueOrPullRequestNode struct {
+	TypeName githubv4.String       `graphql:"__typename"`
+	Issue    linkedIssueNode       `graphql:"... on Issue"`
+	Pull     linkedPullRequestNode `graphql:"... on PullRequest"`
+}
+
+func (n issueOrPullRequestNode) node() linkedIssueNode {
+	if n.TypeName == "PullRequest" {
+		return linkedIssueNode(n.Pull)
+	}
+	return n.Issue
+}
+
+type linkedIssuesTimeline struct {
+	Nodes []struct {
+		TypeName             githubv4.String `graphql:"__typename"`
+		CrossReferencedEvent struct {
+			Source issueOrPullRequestNode
+		} `graphql:"... on CrossReferencedEvent"`
+		MarkedAsDuplicateEvent struct {
+			Canonical issueOrPullRequestNode
+			Duplicate issueOrPullRequestNode
+		} `graphql:"... on MarkedAsDuplicateEvent"`
+	}
+}
+
+type linkedIssuesQuery struct {
+	Repository struct {
+		IssueOrPullRequest struct {
+			TypeName githubv4.String `graphql:"__typename"`
+			Issue    struct {
+				Body                           githubv4.String
+				ClosedByPullRequestsReferences struct {
+					Nodes []linkedIssueNode
+				} `graphql:"closedByPullRequestsReferences(first: 25, includeClosedPrs: true)"`
+				TimelineItems linkedIssuesTimeline `graphql:"issueTimeline: timelineItems(first: 100, itemTypes: [CROSS_REFERENCED_EVENT, MARKED_AS_DUPLICATE_EVENT])"`
+			} `graphql:"... on Issue"`
+			PullRequest struct {
+				Body                    githubv4.String
+				ClosingIssuesReferences struct {
+					Nodes []linkedIssueNode
+				} `graphql:"closingIssuesReferences(first: 25)"`
+				TimelineItems linkedIssuesTimeline `graphql:"pullRequestTimeline: timelineItems(first: 100, itemTypes: [CROSS_REFERENCED_EVENT])"`
+			} `graphql:"... on PullRequest"`
+		} `graphql:"issueOrPullRequest(number: $number)"`
+	} `graphql:"repository(owner: $owner, name: $repo)"`
+}
+
+// GetLinkedIssues creates a tool that lists the issues and pull requests linked to an issue, across repositories.
+func GetLinkedIssues(getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_linked_issues",
+			mcp.WithDescription(t("TOOL_GET_LINKED_ISSUES_DESCRIPTION", "Get the issues and pull requests linked to an issue or pull request, across repositories: pull requests that close it or issues it closes, duplicates, issues recorded with link_issues, and issues and pull requests that reference it. Use it to build dependency maps that span repositories.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_GET_LINKED_ISSUES_USER_TITLE", "Get linked issues"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryOwner),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryName),
+			),
+			mcp.WithNumber("issue_number",
+				mcp.Required(),
+				mcp.Description("Number of the issue or pull request"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			number, err := RequiredInt(request, "issue_number")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			gqlClient, err := getGQLClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GraphQL client: %w", err)
+			}
+
+			var query linkedIssuesQuery
+			vars := map[string]any{
+				"owner":  githubv4.String(owner),
+				"repo":   githubv4.String(repo),
+				"number": githubv4.Int(number), // #nosec G115 - issue numbers are always small positive integers
+			}
+			if err := gqlClient.Query(ctx, &query, vars); err != nil {
+				return ghErrors.NewGitHubGraphQLErrorResponse(ctx, fmt.Sprintf("failed to get %s/%s#%d", owner, repo, number), err), nil
+			}
+
+			self := fmt.Sprintf("%s/%s#%d", owner, repo, number)
+			links := []LinkedIssue{}
+			seen := map[string]bool{}
+			add := func(link LinkedIssue) {
+				ref := fmt.Sprintf("%s#%d", link.Repository, link.Number)
+				key := link.Relationship + " " + ref
+				if link.Number == 0 || strings.EqualFold(ref, self) || seen[key] {
+					return
+				}
+				seen[key] = true
+				links = append(links, link)
+			}
+
+			node := query.Repository.IssueOrPullRequest
+			isPullRequest := node.TypeName == "PullRequest"
+			body := string(node.Issue.Body)
+			timeline := node.Issue.TimelineItems
+			if isPullRequest {
+				body = string(node.PullRequest.Body)
+				timeline = node.PullRequest.TimelineItems
+				for _, closes := range node.PullRequest.ClosingIssuesReferences.Nodes {
+					add(closes.toLinkedIssue(IssueLinkCloses))
+				}
+			} else {
+				for _, pr := range node.Issue.ClosedByPullRequestsReferences.Nodes {
+					add(pr.toLinkedIssue(IssueLinkClosedBy))
+				}
+			}
+			for _, link := range linksInBody(body) {
+				add(link)
+			}
+			for _, event := range timeline.Nodes {
+				switch event.TypeName {
+				case "MarkedAsDuplicateEvent":
+					canonical := event.MarkedAsDuplicateEvent.Canonical.node()
+					duplicate := event.MarkedAsDuplicateEvent.Duplicate.node()
+					if int(duplicate.Number) == number && strings.EqualFold(string(duplicate.Repository.NameWithOwner), owner+"/"+repo) {
+						add(canonical.toLinkedIssue(IssueLinkDuplicateOf))
+					} else {
+						add(duplicate.toLinkedIssue(IssueLinkDuplicatedBy))
+					}
+				case "CrossReferencedEvent":
+					add(event.CrossReferencedEvent.Source.node().toLinkedIssue(IssueLinkReferencedBy))
+				}
+			}
+
+			kind := "issue"
+			if isPullRequest {
+				kind = "pull_request"
+			}
+			return MarshalledTextResult(map[string]any{
+				"issue": self,
+				"type":  kind,
+				"links": links,
+			}), nil
+		}
+}
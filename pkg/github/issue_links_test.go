@@ -0,0 +1,154 @@
+package github
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/ghmock"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_AddIssueLink(t *testing.T) {
+	body, changed := addIssueLink("Steps to reproduce.\n", "- Relates to octo/api#12")
+	assert.True(t, changed)
+	assert.Equal(t, "Steps to reproduce.\n\n<!-- linked-issues:start -->\n**Linked issues**\n- Relates to octo/api#12\n<!-- linked-issues:end -->", body)
+
+	body, changed = addIssueLink(body, "- Closes octo/app#3")
+	assert.True(t, changed)
+	assert.Equal(t, "Steps to reproduce.\n\n<!-- linked-issues:start -->\n**Linked issues**\n- Relates to octo/api#12\n- Closes octo/app#3\n<!-- linked-issues:end -->", body)
+
+	_, changed = addIssueLink(body, "- Relates to octo/api#12")
+	assert.False(t, changed)
+
+	assert.Equal(t, []LinkedIssue{
+		{Relationship: IssueLinkRelatesTo, Repository: "octo/api", Number: 12, URL: "https://github.com/octo/api/issues/12"},
+	}, linksInBody(body))
+	assert.Empty(t, linksInBody("- Relates to octo/api#12"), "links outside the section are ignored")
+}
+
+func Test_LinkIssues(t *testing.T) {
+	tool, _ := LinkIssues(nil, nil, translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+	assert.ElementsMatch(t, []string{"owner", "repo", "issue_number", "relationship", "target_issue_number"}, tool.InputSchema.Required)
+
+	t.Run("relates_to edits the body of the source", func(t *testing.T) {
+		server := ghmock.New(t)
+		server.Respond("GET /repos/octo/app/issues/1", http.StatusOK, map[string]any{"number": 1, "body": "Details"})
+		server.Respond("PATCH /repos/octo/app/issues/1", http.StatusOK, map[string]any{"number": 1})
+		_, handler := LinkIssues(server.GetClient(), server.GetGQLClient(), translations.NullTranslationHelper)
+
+		result := ghmock.CallTool(t, handler, map[string]any{
+			"owner": "octo", "repo": "app", "issue_number": float64(1), "relationship": "relates_to",
+			"target_owner": "octo", "target_repo": "api", "target_issue_number": float64(12),
+		})
+		require.False(t, result.IsError, ghmock.ResultText(t, result))
+		assert.JSONEq(t, `{"source": "octo/app#1", "target": "octo/api#12", "relationship": "relates_to", "changed": true}`, ghmock.ResultText(t, result))
+		var edited map[string]any
+		require.NoError(t, server.AssertRequested("PATCH /repos/octo/app/issues/1").DecodeBody(&edited))
+		assert.Contains(t, edited["body"], "- Relates to octo/api#12")
+	})
+
+	t.Run("closes requires a pull request", func(t *testing.T) {
+		server := ghmock.New(t)
+		server.Respond("GET /repos/octo/app/issues/1", http.StatusOK, map[string]any{"number": 1})
+		_, handler := LinkIssues(server.GetClient(), server.GetGQLClient(), translations.NullTranslationHelper)
+
+		result := ghmock.CallTool(t, handler, map[string]any{
+			"owner": "octo", "repo": "app", "issue_number": float64(1), "relationship": "closes", "target_issue_number": float64(2),
+		})
+		require.True(t, result.IsError)
+		assert.Equal(t, "octo/app#1 is an issue; only pull requests can close issues, use relates_to instead", ghmock.ResultText(t, result))
+		server.AssertNotRequested(http.MethodPatch)
+	})
+
+	t.Run("duplicates closes the source as a duplicate", func(t *testing.T) {
+		server := ghmock.New(t)
+		server.HandleGraphQL("issue(number: $issueNumber)", func(_ string, variables map[string]any) (any, []string) {
+			return map[string]any{"repository": map[string]any{"issue": map[string]any{"id": "I_" + variables["repo"].(string)}}}, nil
+		})
+		server.RespondGraphQL("closeIssue(", map[string]any{"closeIssue": map[string]any{"issue": map[string]any{"id": "I_app"}}})
+		_, handler := LinkIssues(server.GetClient(), server.GetGQLClient(), translations.NullTranslationHelper)
+
+		result := ghmock.CallTool(t, handler, map[string]any{
+			"owner": "octo", "repo": "app", "issue_number": float64(1), "relationship": "duplicates",
+			"target_repo": "api", "target_issue_number": float64(12),
+		})
+		require.False(t, result.IsError, ghmock.ResultText(t, result))
+		var mutation struct {
+			Variables struct {
+				Input map[string]any `json:"input"`
+			} `json:"variables"`
+		}
+		require.NoError(t, server.AssertGraphQL("closeIssue(").DecodeBody(&mutation))
+		assert.Equal(t, map[string]any{"issueId": "I_app", "stateReason": "DUPLICATE", "duplicateIssueId": "I_api"}, mutation.Variables.Input)
+	})
+
+	t.Run("an issue cannot be linked to itself", func(t *testing.T) {
+		_, handler := LinkIssues(ghmock.New(t).GetClient(), nil, translations.NullTranslationHelper)
+		result := ghmock.CallTool(t, handler, map[string]any{
+			"owner": "octo", "repo": "app", "issue_number": float64(1), "relationship": "relates_to", "target_issue_number": float64(1),
+		})
+		require.True(t, result.IsError)
+	})
+}
+
+func Test_GetLinkedIssues(t *testing.T) {
+	tool, _ := GetLinkedIssues(nil, translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+	assert.True(t, *tool.Annotations.ReadOnlyHint)
+
+	ref := func(repo string, number int, title, state string) map[string]any {
+		return map[string]any{
+			"number": number, "title": title, "state": state,
+			"url":        "https://github.com/" + repo + "/issues/" + title,
+			"repository": map[string]any{"nameWithOwner": repo},
+		}
+	}
+	// source is an issue or pull request in a union, where the state of pull requests is aliased.
+	source := func(repo string, number int, title, state string, pullRequest bool) map[string]any {
+		node := ref(repo, number, title, state)
+		node["__typename"] = "Issue"
+		if pullRequest {
+			node["__typename"] = "PullRequest"
+			node["pullRequestState"] = node["state"]
+			delete(node, "state")
+		}
+		return node
+	}
+	server := ghmock.New(t)
+	server.RespondGraphQL("issueOrPullRequest(number: $number)", map[string]any{"repository": map[string]any{"issueOrPullRequest": map[string]any{
+		"__typename": "Issue",
+		"body":       "Details\n\n<!-- linked-issues:start -->\n**Linked issues**\n- Relates to octo/api#12\n<!-- linked-issues:end -->",
+		"closedByPullRequestsReferences": map[string]any{"nodes": []any{
+			ref("octo/app", 5, "fix", "MERGED"),
+		}},
+		"issueTimeline": map[string]any{"nodes": []any{
+			map[string]any{"__typename": "CrossReferencedEvent", "source": source("octo/web", 8, "web", "OPEN", false)},
+			map[string]any{"__typename": "CrossReferencedEvent", "source": source("octo/app", 5, "fix", "MERGED", true)},
+			map[string]any{"__typename": "MarkedAsDuplicateEvent", "canonical": source("octo/app", 1, "self", "OPEN", false), "duplicate": source("octo/app", 9, "dup", "CLOSED", false)},
+		}},
+	}}})
+	_, handler := GetLinkedIssues(server.GetGQLClient(), translations.NullTranslationHelper)
+
+	result := ghmock.CallTool(t, handler, map[string]any{"owner": "octo", "repo": "app", "issue_number": float64(1)})
+	require.False(t, result.IsError, ghmock.ResultText(t, result))
+	var resp struct {
+		Issue string        `json:"issue"`
+		Type  string        `json:"type"`
+		Links []LinkedIssue `json:"links"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(ghmock.ResultText(t, result)), &resp))
+	assert.Equal(t, "octo/app#1", resp.Issue)
+	assert.Equal(t, "issue", resp.Type)
+	assert.Equal(t, []LinkedIssue{
+		{Relationship: IssueLinkClosedBy, Repository: "octo/app", Number: 5, Title: "fix", State: "merged", URL: "https://github.com/octo/app/issues/fix"},
+		{Relationship: IssueLinkRelatesTo, Repository: "octo/api", Number: 12, URL: "https://github.com/octo/api/issues/12"},
+		{Relationship: IssueLinkReferencedBy, Repository: "octo/web", Number: 8, Title: "web", State: "open", URL: "https://github.com/octo/web/issues/web"},
+		{Relationship: IssueLinkReferencedBy, Repository: "octo/app", Number: 5, Title: "fix", State: "merged", URL: "https://github.com/octo/app/issues/fix"},
+		{Relationship: IssueLinkDuplicatedBy, Repository: "octo/app", Number: 9, Title: "dup", State: "closed", URL: "https://github.com/octo/app/issues/dup"},
+	}, resp.Links)
+}
@@ -0,0 +1,438 @@
+package github
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"net/http"
+	"path"
+	"slices"
+	"strings"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v79/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"go.yaml.in/yaml/v3"
+)
+
+// issueTemplateDir is the directory GitHub reads issue forms and issue templates from.
+const issueTemplateDir = ".github/ISSUE_TEMPLATE"
+
+// issueFormNoResponse is what GitHub renders for a field of an issue form that was left empty.
+const issueFormNoResponse = "_No response_"
+
+// IssueTemplate is an issue form (.yml) or a Markdown issue template (.md) of a repository.
+type IssueTemplate struct {
+	File        string               `json:"file"`
+	Kind        string               `json:"kind"`
+	Name        string               `json:"name"`
+	Description string               `json:"description,omitempty"`
+	Title       string               `json:"title,omitempty"`
+	Labels      []string             `json:"labels,omitempty"`
+	Assignees   []string             `json:"assignees,omitempty"`
+	Type        string               `json:"type,omitempty"`
+	Fields      []IssueTemplateField `json:"fields,omitempty"`
+	Body        string               `json:"body,omitempty"`
+}
+
+// IssueTemplateField is an input of an issue form, to be given in the template_fields of issue_write by ID or label.
+type IssueTemplateField struct {
+	ID              string   `json:"id,omitempty"`
+	Label           string   `json:"label"`
+	Type            string   `json:"type"`
+	Description     string   `json:"description,omitempty"`
+	Required        bool     `json:"required,omitempty"`
+	Options         []string `json:"options,omitempty"`
+	RequiredOptions []string `json:"required_options,omitempty"`
+	Multiple        bool     `json:"multiple,omitempty"`
+	Render          string   `json:"render,omitempty"`
+}
+
+// yamlStringList is a list of strings that issue templates may also write as a comma-separated string.
+type yamlStringList []string
+
+func (l *yamlStringList) UnmarshalYAML(node *yaml.Node) error {
+	if node.Kind == yaml.ScalarNode {
+		*l = nil
+		for _, s := range strings.Split(node.Value, ",") {
+			if s = strings.TrimSpace(s); s != "" {
+				*l = append(*l, s)
+			}
+		}
+		return nil
+	}
+	var list []string
+	if err := node.Decode(&list); err != nil {
+		return err
+	}
+	*l = list
+	return nil
+}
+
+type issueTemplateHeader struct {
+	Name        string         `yaml:"name"`
+	Description string         `yaml:"description"`
+	About       string         `yaml:"about"`
+	Title       string         `yaml:"title"`
+	Labels      yamlStringList `yaml:"labels"`
+	Assignees   yamlStringList `yaml:"assignees"`
+	Type        string         `yaml:"type"`
+}
+
+type issueForm struct {
+	issueTemplateHeader `yaml:",inline"`
+	Body                []issueFormElement `yaml:"body"`
+}
+
+type issueFormElement struct {
+	Type       string `yaml:"type"`
+	ID         string `yaml:"id"`
+	Attributes struct {
+		Label       string      `yaml:"label"`
+		Description string      `yaml:"description"`
+		Options     []yaml.Node `yaml:"options"`
+		Multiple    bool        `yaml:"multiple"`
+		Render      string      `yaml:"render"`
+	} `yaml:"attributes"`
+	Validations struct {
+		Required bool `yaml:"required"`
+	} `yaml:"validations"`
+}
+
+// parseIssueTemplate parses an issue form or a Markdown issue template.
+func parseIssueTemplate(file, content string) (*IssueTemplate, error) {
+	if strings.HasSuffix(file, ".md") {
+		return parseMarkdownIssueTemplate(file, content)
+	}
+
+	var form issueForm
+	if err := yaml.Unmarshal([]byte(content), &form); err != nil {
+		return nil, fmt.Errorf("invalid issue form %s: %w", file, err)
+	}
+	template := &IssueTemplate{
+		File:        file,
+		Kind:        "form",
+		Name:        form.Name,
+		Description: form.Description,
+		Title:       form.Title,
+		Labels:      form.Labels,
+		Assignees:   form.Assignees,
+		Type:        form.Type,
+	}
+	for _, element := range form.Body {
+		if element.Type == "markdown" {
+			continue
+		}
+		field := IssueTemplateField{
+			ID:          element.ID,
+			Label:       element.Attributes.Label,
+			Type:        element.Type,
+			Description: element.Attributes.Description,
+			Required:    element.Validations.Required,
+			Multiple:    element.Attributes.Multiple,
+			Render:      element.Attributes.Render,
+		}
+		for _, option := range element.Attributes.Options {
+			// Dropdown options are strings; checkbox options are objects with a label.
+			var checkbox struct {
+				Label    string `yaml:"label"`
+				Required bool   `yaml:"required"`
+			}
+			if option.Kind == yaml.ScalarNode {
+				field.Options = append(field.Options, option.Value)
+			} else if err := option.Decode(&checkbox); err == nil {
+				field.Options = append(field.Options, checkbox.Label)
+				if checkbox.Required {
+					field.RequiredOptions = append(field.RequiredOptions, checkbox.Label)
+				}
+			}
+		}
+		template.Fields = append(template.Fields, field)
+	}
+	return template, nil
+}
+
+func parseMarkdownIssueTemplate(file, content string) (*IssueTemplate, error) {
+	template := &IssueTemplate{File: file, Kind: "markdown", Body: content}
+	rest, ok := strings.CutPrefix(strings.ReplaceAll(content, "\r\n", "\n"), "---\n")
+	if !ok {
+		return template, nil
+	}
+	frontMatter, body, ok := strings.Cut(rest, "\n---")
+	if !ok {
+		return nil, fmt.Errorf("invalid issue template %s: unterminated front matter", file)
+	}
+	var header issueTemplateHeader
+	if err := yaml.Unmarshal([]byte(frontMatter), &header); err != nil {
+		return nil, fmt.Errorf("invalid issue template %s: %w", file, err)
+	}
+	template.Name = header.Name
+	template.Description = header.About
+	template.Title = header.Title
+	template.Labels = header.Labels
+	template.Assignees = header.Assignees
+	template.Type = header.Type
+	template.Body = strings.TrimLeft(body, "\n")
+	return template, nil
+}
+
+// listIssueTemplates returns the issue forms and issue templates of a repository, skipping files that cannot be
+// parsed, which GitHub does not offer either.
+func listIssueTemplates(ctx context.Context, client *github.Client, owner, repo string) ([]*IssueTemplate, *github.Response, error) {
+	_, entries, resp, err := client.Repositories.GetContents(ctx, owner, repo, issueTemplateDir, nil)
+	if resp != nil {
+		_ = resp.Body.Close()
+	}
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			return []*IssueTemplate{}, resp, nil
+		}
+		return nil, resp, err
+	}
+
+	templates := []*IssueTemplate{}
+	for _, entry := range entries {
+		name := entry.GetName()
+		ext := path.Ext(name)
+		if entry.GetType() != "file" || !slices.Contains([]string{".yml", ".yaml", ".md"}, ext) || strings.TrimSuffix(name, ext) == "config" {
+			continue
+		}
+		file, _, resp, err := client.Repositories.GetContents(ctx, owner, repo, entry.GetPath(), nil)
+		if resp != nil {
+			_ = resp.Body.Close()
+		}
+		if err != nil {
+			return nil, resp, err
+		}
+		content, err := file.GetContent()
+		if err != nil {
+			continue
+		}
+		if template, err := parseIssueTemplate(name, content); err == nil {
+			templates = append(templates, template)
+		}
+	}
+	return templates, resp, nil
+}
+
+// findIssueTemplate returns the template with the given file name or name.
+func findIssueTemplate(templates []*IssueTemplate, name string) (*IssueTemplate, error) {
+	names := make([]string, 0, len(templates))
+	for _, template := range templates {
+		if strings.EqualFold(template.File, name) || strings.EqualFold(template.Name, name) {
+			return template, nil
+		}
+		names = append(names, template.File)
+	}
+	if len(names) == 0 {
+		return nil, fmt.Errorf("the repository has no issue templates")
+	}
+	return nil, fmt.Errorf("issue template %q not found; available templates: %s", name, strings.Join(names, ", "))
+}
+
+// issueDraft is the content of an issue to create.
+type issueDraft struct {
+	Title     string
+	Body      string
+	Labels    []string
+	Assignees []string
+	Type      string
+}
+
+// apply fills in draft from the template the way GitHub does when an issue is filed with it: it prefixes the title,
+// adds the template's labels and assignees, and renders the body from the form's field values or, for a Markdown
+// template, uses the template as the body unless one is given.
+func (t *IssueTemplate) apply(draft issueDraft, values map[string]any) (issueDraft, error) {
+	if t.Title != "" && !strings.HasPrefix(draft.Title, t.Title) {
+		draft.Title = t.Title + draft.Title
+	}
+	for _, label := range t.Labels {
+		if !slices.Contains(draft.Labels, label) {
+			draft.Labels = append(draft.Labels, label)
+		}
+	}
+	for _, assignee := range t.Assignees {
+		if !slices.Contains(draft.Assignees, assignee) {
+			draft.Assignees = append(draft.Assignees, assignee)
+		}
+	}
+	if draft.Type == "" {
+		draft.Type = t.Type
+	}
+
+	if t.Kind == "markdown" {
+		if len(values) > 0 {
+			return draft, fmt.Errorf("template_fields can only be used with issue forms; %s is a Markdown template, so give its filled-in content as body", t.File)
+		}
+		if draft.Body == "" {
+			draft.Body = t.Body
+		}
+		return draft, nil
+	}
+
+	if draft.Body != "" {
+		return draft, fmt.Errorf("body cannot be used with the issue form %s; give its fields in template_fields", t.File)
+	}
+	body, err := t.render(values)
+	if err != nil {
+		return draft, err
+	}
+	draft.Body = body
+	return draft, nil
+}
+
+// render renders the body of an issue filed with the form from the values of its fields, keyed by field ID or
+// label, as GitHub does: a heading per field followed by its value.
+func (t *IssueTemplate) render(values map[string]any) (string, error) {
+	used := map[string]bool{}
+	sections := make([]string, 0, len(t.Fields))
+	for _, field := range t.Fields {
+		key := field.ID
+		value, ok := values[key]
+		if !ok || key == "" {
+			key = field.Label
+			value, ok = values[key]
+		}
+		if ok {
+			used[key] = true
+		}
+		rendered, err := field.render(value)
+		if err != nil {
+			return "", err
+		}
+		sections = append(sections, fmt.Sprintf("### %s\n\n%s", field.Label, rendered))
+	}
+
+	for key := range values {
+		if !used[key] {
+			keys := make([]string, 0, len(t.Fields))
+			for _, field := range t.Fields {
+				keys = append(keys, cmp.Or(field.ID, field.Label))
+			}
+			return "", fmt.Errorf("unknown field %q for issue form %s; fields are: %s", key, t.File, strings.Join(keys, ", "))
+		}
+	}
+	return strings.Join(sections, "\n\n"), nil
+}
+
+// stringValues returns the value of a field as a list of strings.
+func stringValues(value any) ([]string, error) {
+	switch v := value.(type) {
+	case nil:
+		return nil, nil
+	case string:
+		if v == "" {
+			return nil, nil
+		}
+		return []string{v}, nil
+	case []any:
+		values := make([]string, 0, len(v))
+		for _, item := range v {
+			s, ok := item.(string)
+			if !ok {
+				return nil, fmt.Errorf("expected strings, got %T", item)
+			}
+			values = append(values, s)
+		}
+		return values, nil
+	}
+	return nil, fmt.Errorf("expected a string or a list of strings, got %T", value)
+}
+
+func (f IssueTemplateField) render(value any) (string, error) {
+	values, err := stringValues(value)
+	if err != nil {
+		return "", fmt.Errorf("field %q: %w", f.Label, err)
+	}
+	if f.Required && len(values) == 0 {
+		return "", fmt.Errorf("field %q is required", f.Label)
+	}
+
+	switch f.Type {
+	case "checkboxes":
+		for _, v := range values {
+			if !slices.Contains(f.Options, v) {
+				return "", fmt.Errorf("field %q: unknown option %q; options are: %s", f.Label, v, strings.Join(f.Options, ", "))
+			}
+		}
+		for _, option := range f.RequiredOptions {
+			if !slices.Contains(values, option) {
+				return "", fmt.Errorf("field %q: option %q must be checked", f.Label, option)
+			}
+		}
+		lines := make([]string, len(f.Options))
+		for i, option := range f.Options {
+			mark := " "
+			if slices.Contains(values, option) {
+				mark = "X"
+			}
+			lines[i] = fmt.Sprintf("- [%s] %s", mark, option)
+		}
+		return strings.Join(lines, "\n"), nil
+	case "dropdown":
+		if len(values) > 1 && !f.Multiple {
+			return "", fmt.Errorf("field %q accepts a single option", f.Label)
+		}
+		for _, v := range values {
+			if !slices.Contains(f.Options, v) {
+				return "", fmt.Errorf("field %q: unknown option %q; options are: %s", f.Label, v, strings.Join(f.Options, ", "))
+			}
+		}
+	default:
+		if len(values) > 1 {
+			return "", fmt.Errorf("field %q accepts a single value", f.Label)
+		}
+	}
+
+	if len(values) == 0 {
+		return issueFormNoResponse, nil
+	}
+	text := strings.Join(values, ", ")
+	if f.Render != "" {
+		return fmt.Sprintf("```%s\n%s\n```", f.Render, text), nil
+	}
+	return text, nil
+}
+
+// ListIssueTemplates creates a tool to list the issue forms and issue templates of a repository.
+func ListIssueTemplates(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_issue_templates",
+			mcp.WithDescription(t("TOOL_LIST_ISSUE_TEMPLATES_DESCRIPTION", "List the issue forms and Markdown issue templates of a repository, with the fields of each form, their types, options and whether they are required. Use it before creating an issue in a repository that has templates, and pass the template and its field values to issue_write so that the issue follows the repository's intake process.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_LIST_ISSUE_TEMPLATES_USER_TITLE", "List issue templates"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryOwner),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryName),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			templates, resp, err := listIssueTemplates(ctx, client, owner, repo)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to list issue templates", resp, err), nil
+			}
+			// The template directory is read in one request, so there is no next page to point to.
+			return MarshalledTextResult(NewListResponse("templates", templates, PageInfo{}, nil)), nil
+		}
+}
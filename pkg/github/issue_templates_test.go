@@ -0,0 +1,244 @@
+package github
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/ghmock"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const bugReportForm = `name: Bug report
+description: File a bug report
+title: "[Bug]: "
+labels: ["bug", "triage"]
+assignees: octocat
+body:
+  - type: markdown
+    attributes:
+      value: Thanks for taking the time to fill out this bug report!
+  - type: textarea
+    id: what-happened
+    attributes:
+      label: What happened?
+    validations:
+      required: true
+  - type: dropdown
+    id: version
+    attributes:
+      label: Version
+      options:
+        - "1.0"
+        - "2.0"
+  - type: textarea
+    id: logs
+    attributes:
+      label: Relevant log output
+      render: shell
+  - type: checkboxes
+    id: terms
+    attributes:
+      label: Code of Conduct
+      options:
+        - label: I agree to follow this project's Code of Conduct
+          required: true
+        - label: I searched for duplicates
+`
+
+const featureRequestTemplate = `---
+name: Feature request
+about: Suggest an idea
+title: "[Feature] "
+labels: enhancement, idea
+---
+
+## Problem
+
+## Proposal
+`
+
+func Test_ParseIssueTemplate(t *testing.T) {
+	form, err := parseIssueTemplate("bug_report.yml", bugReportForm)
+	require.NoError(t, err)
+	assert.Equal(t, &IssueTemplate{
+		File:        "bug_report.yml",
+		Kind:        "form",
+		Name:        "Bug report",
+		Description: "File a bug report",
+		Title:       "[Bug]: ",
+		Labels:      []string{"bug", "triage"},
+		Assignees:   []string{"octocat"},
+		Fields: []IssueTemplateField{
+			{ID: "what-happened", Label: "What happened?", Type: "textarea", Required: true},
+			{ID: "version", Label: "Version", Type: "dropdown", Options: []string{"1.0", "2.0"}},
+			{ID: "logs", Label: "Relevant log output", Type: "textarea", Render: "shell"},
+			{
+				ID: "terms", Label: "Code of Conduct", Type: "checkboxes",
+				Options:         []string{"I agree to follow this project's Code of Conduct", "I searched for duplicates"},
+				RequiredOptions: []string{"I agree to follow this project's Code of Conduct"},
+			},
+		},
+	}, form)
+
+	markdown, err := parseIssueTemplate("feature_request.md", featureRequestTemplate)
+	require.NoError(t, err)
+	assert.Equal(t, &IssueTemplate{
+		File:        "feature_request.md",
+		Kind:        "markdown",
+		Name:        "Feature request",
+		Description: "Suggest an idea",
+		Title:       "[Feature] ",
+		Labels:      []string{"enhancement", "idea"},
+		Body:        "## Problem\n\n## Proposal\n",
+	}, markdown)
+}
+
+func Test_IssueTemplateApply(t *testing.T) {
+	form, err := parseIssueTemplate("bug_report.yml", bugReportForm)
+	require.NoError(t, err)
+
+	t.Run("renders the form", func(t *testing.T) {
+		draft, err := form.apply(issueDraft{Title: "Crash on start", Labels: []string{"bug", "p1"}}, map[string]any{
+			"what-happened":       "It crashes.",
+			"Relevant log output": "panic: nil map",
+			"terms":               []any{"I agree to follow this project's Code of Conduct"},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "[Bug]: Crash on start", draft.Title)
+		assert.Equal(t, []string{"bug", "p1", "triage"}, draft.Labels)
+		assert.Equal(t, []string{"octocat"}, draft.Assignees)
+		assert.Equal(t, "### What happened?\n\nIt crashes.\n\n"+
+			"### Version\n\n_No response_\n\n"+
+			"### Relevant log output\n\n```shell\npanic: nil map\n```\n\n"+
+			"### Code of Conduct\n\n- [X] I agree to follow this project's Code of Conduct\n- [ ] I searched for duplicates", draft.Body)
+	})
+
+	tests := []struct {
+		name        string
+		draft       issueDraft
+		values      map[string]any
+		expectedErr string
+	}{
+		{
+			name:        "missing required field",
+			values:      map[string]any{"terms": "I agree to follow this project's Code of Conduct"},
+			expectedErr: `field "What happened?" is required`,
+		},
+		{
+			name:        "unknown dropdown option",
+			values:      map[string]any{"what-happened": "x", "version": "3.0", "terms": "I agree to follow this project's Code of Conduct"},
+			expectedErr: `field "Version": unknown option "3.0"; options are: 1.0, 2.0`,
+		},
+		{
+			name:        "required checkbox unchecked",
+			values:      map[string]any{"what-happened": "x"},
+			expectedErr: `field "Code of Conduct": option "I agree to follow this project's Code of Conduct" must be checked`,
+		},
+		{
+			name:        "unknown field",
+			values:      map[string]any{"what-happened": "x", "terms": "I agree to follow this project's Code of Conduct", "severity": "high"},
+			expectedErr: `unknown field "severity" for issue form bug_report.yml; fields are: what-happened, version, logs, terms`,
+		},
+		{
+			name:        "body with a form",
+			draft:       issueDraft{Body: "free text"},
+			expectedErr: "body cannot be used with the issue form bug_report.yml; give its fields in template_fields",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := form.apply(tc.draft, tc.values)
+			require.Error(t, err)
+			assert.Equal(t, tc.expectedErr, err.Error())
+		})
+	}
+
+	t.Run("markdown template", func(t *testing.T) {
+		markdown, err := parseIssueTemplate("feature_request.md", featureRequestTemplate)
+		require.NoError(t, err)
+		draft, err := markdown.apply(issueDraft{Title: "Dark mode"}, nil)
+		require.NoError(t, err)
+		assert.Equal(t, issueDraft{Title: "[Feature] Dark mode", Body: markdown.Body, Labels: []string{"enhancement", "idea"}}, draft)
+
+		_, err = markdown.apply(issueDraft{Title: "Dark mode"}, map[string]any{"problem": "x"})
+		assert.Error(t, err)
+	})
+}
+
+// respondIssueTemplates serves the issue template directory of octo/app with the given files.
+func respondIssueTemplates(server *ghmock.Server, files map[string]string) {
+	entries := []map[string]any{}
+	for name := range files {
+		entries = append(entries, map[string]any{"type": "file", "name": name, "path": issueTemplateDir + "/" + name})
+	}
+	entries = append(entries, map[string]any{"type": "file", "name": "config.yml", "path": issueTemplateDir + "/config.yml"})
+	server.Respond("GET /repos/octo/app/contents/.github/ISSUE_TEMPLATE", http.StatusOK, entries)
+	for name, content := range files {
+		server.Respond("GET /repos/octo/app/contents/.github/ISSUE_TEMPLATE/"+name, http.StatusOK, map[string]any{
+			"type": "file", "name": name, "path": issueTemplateDir + "/" + name,
+			"encoding": "base64", "content": base64.StdEncoding.EncodeToString([]byte(content)),
+		})
+	}
+}
+
+func Test_ListIssueTemplates(t *testing.T) {
+	tool, _ := ListIssueTemplates(nil, translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+	assert.True(t, *tool.Annotations.ReadOnlyHint)
+
+	server := ghmock.New(t)
+	respondIssueTemplates(server, map[string]string{"bug_report.yml": bugReportForm})
+	_, handler := ListIssueTemplates(server.GetClient(), translations.NullTranslationHelper)
+	result := ghmock.CallTool(t, handler, map[string]any{"owner": "octo", "repo": "app"})
+	require.False(t, result.IsError, ghmock.ResultText(t, result))
+	var response struct {
+		Templates []IssueTemplate `json:"templates"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(ghmock.ResultText(t, result)), &response))
+	require.Len(t, response.Templates, 1)
+	assert.Equal(t, "Bug report", response.Templates[0].Name)
+	assert.Len(t, response.Templates[0].Fields, 4)
+
+	server = ghmock.New(t)
+	server.Respond("GET /repos/octo/app/contents/.github/ISSUE_TEMPLATE", http.StatusNotFound, map[string]any{"message": "Not Found"})
+	_, handler = ListIssueTemplates(server.GetClient(), translations.NullTranslationHelper)
+	result = ghmock.CallTool(t, handler, map[string]any{"owner": "octo", "repo": "app"})
+	require.False(t, result.IsError, ghmock.ResultText(t, result))
+	assert.JSONEq(t, `{"templates": [], "page_info": {"has_next_page": false, "has_previous_page": false}}`, ghmock.ResultText(t, result))
+}
+
+func Test_IssueWriteWithTemplate(t *testing.T) {
+	server := ghmock.New(t)
+	respondIssueTemplates(server, map[string]string{"bug_report.yml": bugReportForm})
+	server.Respond("POST /repos/octo/app/issues", http.StatusCreated, map[string]any{"id": 1, "html_url": "https://github.com/octo/app/issues/1"})
+	_, handler := IssueWrite(server.GetClient(), server.GetGQLClient(), translations.NullTranslationHelper)
+
+	result := ghmock.CallTool(t, handler, map[string]any{
+		"method": "create", "owner": "octo", "repo": "app", "title": "Crash on start", "template": "Bug report",
+		"template_fields": map[string]any{"what-happened": "It crashes.", "terms": []any{"I agree to follow this project's Code of Conduct"}},
+	})
+	require.False(t, result.IsError, ghmock.ResultText(t, result))
+	var created map[string]any
+	require.NoError(t, server.AssertRequested("POST /repos/octo/app/issues").DecodeBody(&created))
+	assert.Equal(t, "[Bug]: Crash on start", created["title"])
+	assert.Equal(t, []any{"bug", "triage"}, created["labels"])
+	assert.Equal(t, []any{"octocat"}, created["assignees"])
+	assert.Contains(t, created["body"], "### What happened?\n\nIt crashes.")
+
+	result = ghmock.CallTool(t, handler, map[string]any{
+		"method": "create", "owner": "octo", "repo": "app", "title": "Crash", "template": "question.yml",
+	})
+	require.True(t, result.IsError)
+	assert.Equal(t, `issue template "question.yml" not found; available templates: bug_report.yml`, ghmock.ResultText(t, result))
+
+	result = ghmock.CallTool(t, handler, map[string]any{
+		"method": "update", "owner": "octo", "repo": "app", "issue_number": float64(1), "template": "bug_report.yml",
+	})
+	require.True(t, result.IsError)
+	assert.Equal(t, "template and template_fields can only be used when creating an issue", ghmock.ResultText(t, result))
+}
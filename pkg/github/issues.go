@@ -512,10 +512,8 @@ func GetIssueLabels(ctx context.Context, client *githubv4.Client, owner string,
 		}
 	}
 
-	response := map[string]any{
-		"labels":     issueLabels,
-		"totalCount": int(query.Repository.Issue.Labels.TotalCount),
-	}
+	totalCount := int(query.Repository.Issue.Labels.TotalCount)
+	response := NewListResponse("labels", issueLabels, NewGraphQLPageInfo(totalCount > len(issueLabels), false, "", ""), &totalCount)
 
 	out, err := json.Marshal(response)
 	if err != nil {
@@ -564,7 +562,8 @@ func ListIssueTypes(getClient GetClientFn, t translations.TranslationHelperFunc)
 				return mcp.NewToolResultError(fmt.Sprintf("failed to list issue types: %s", string(body))), nil
 			}
 
-			r, err := json.Marshal(issueTypes)
+			// The endpoint returns every issue type at once, so there is no next page to point to.
+			r, err := json.Marshal(NewListResponse("issue_types", issueTypes, PageInfo{}, github.Ptr(len(issueTypes))))
 			if err != nil {
 				return nil, fmt.Errorf("failed to marshal issue types: %w", err)
 			}
@@ -578,8 +577,10 @@ func AddIssueComment(getClient GetClientFn, t translations.TranslationHelperFunc
 	return mcp.NewTool("add_issue_comment",
 			mcp.WithDescription(t("TOOL_ADD_ISSUE_COMMENT_DESCRIPTION", "Add a comment to a specific issue in a GitHub repository. Use this tool to add comments to pull requests as well (in this case pass pull request number as issue_number), but only if user is not asking specifically to add review comments.")),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
-				Title:        t("TOOL_ADD_ISSUE_COMMENT_USER_TITLE", "Add comment to issue"),
-				ReadOnlyHint: ToBoolPtr(false),
+				Title:           t("TOOL_ADD_ISSUE_COMMENT_USER_TITLE", "Add comment to issue"),
+				ReadOnlyHint:    ToBoolPtr(false),
+				DestructiveHint: ToBoolPtr(false),
+				IdempotentHint:  ToBoolPtr(false),
 			}),
 			mcp.WithString("owner",
 				mcp.Required(),
@@ -652,8 +653,10 @@ func SubIssueWrite(getClient GetClientFn, t translations.TranslationHelperFunc)
 	return mcp.NewTool("sub_issue_write",
 			mcp.WithDescription(t("TOOL_SUB_ISSUE_WRITE_DESCRIPTION", "Add a sub-issue to a parent issue in a GitHub repository.")),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
-				Title:        t("TOOL_SUB_ISSUE_WRITE_USER_TITLE", "Change sub-issue"),
-				ReadOnlyHint: ToBoolPtr(false),
+				Title:           t("TOOL_SUB_ISSUE_WRITE_USER_TITLE", "Change sub-issue"),
+				ReadOnlyHint:    ToBoolPtr(false),
+				DestructiveHint: ToBoolPtr(true),
+				IdempotentHint:  ToBoolPtr(false),
 			}),
 			mcp.WithString("method",
 				mcp.Required(),
@@ -860,7 +863,7 @@ func ReprioritizeSubIssue(ctx context.Context, client *github.Client, owner stri
 }
 
 // SearchIssues creates a tool to search for issues.
-func SearchIssues(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+func SearchIssues(getClient GetClientFn, getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
 	return mcp.NewTool("search_issues",
 			mcp.WithDescription(t("TOOL_SEARCH_ISSUES_DESCRIPTION", "Search for issues in GitHub repositories using issues search syntax already scoped to is:issue")),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
@@ -897,10 +900,13 @@ func SearchIssues(getClient GetClientFn, t translations.TranslationHelperFunc) (
 				mcp.Description("Sort order"),
 				mcp.Enum("asc", "desc"),
 			),
+			mcp.WithString("path_prefix",
+				mcp.Description(DescriptionPathPrefix),
+			),
 			WithPagination(),
 		),
 		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-			return searchHandler(ctx, getClient, request, "issue", "failed to search issues")
+			return searchHandler(ctx, getClient, getGQLClient, request, "issue", "failed to search issues")
 		}
 }
 
@@ -909,8 +915,10 @@ func IssueWrite(getClient GetClientFn, getGQLClient GetGQLClientFn, t translatio
 	return mcp.NewTool("issue_write",
 			mcp.WithDescription(t("TOOL_ISSUE_WRITE_DESCRIPTION", "Create a new or update an existing issue in a GitHub repository.")),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
-				Title:        t("TOOL_ISSUE_WRITE_USER_TITLE", "Create or update issue."),
-				ReadOnlyHint: ToBoolPtr(false),
+				Title:           t("TOOL_ISSUE_WRITE_USER_TITLE", "Create or update issue."),
+				ReadOnlyHint:    ToBoolPtr(false),
+				DestructiveHint: ToBoolPtr(true),
+				IdempotentHint:  ToBoolPtr(false),
 			}),
 			mcp.WithString("method",
 				mcp.Required(),
@@ -971,6 +979,12 @@ Options are:
 			mcp.WithNumber("duplicate_of",
 				mcp.Description("Issue number that this issue is a duplicate of. Only used when state_reason is 'duplicate'."),
 			),
+			mcp.WithString("template",
+				mcp.Description("File name or name of the issue form or issue template to create the issue with, from list_issue_templates. The template's title prefix, labels, assignees and type are applied. Only used when creating an issue."),
+			),
+			mcp.WithObject("template_fields",
+				mcp.Description("Values of the fields of the issue form given in template, keyed by field ID or label: a string, or a list of strings for multi-select dropdowns and checkboxes. The issue body is rendered from them, so omit body."),
+			),
 		),
 		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			method, err := RequiredParam[string](request, "method")
@@ -1045,6 +1059,21 @@ Options are:
 				return mcp.NewToolResultError("duplicate_of can only be used when state_reason is 'duplicate'"), nil
 			}
 
+			templateName, err := OptionalParam[string](request, "template")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			templateFields, err := OptionalParam[map[string]any](request, "template_fields")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if method != "create" && (templateName != "" || len(templateFields) > 0) {
+				return mcp.NewToolResultError("template and template_fields can only be used when creating an issue"), nil
+			}
+			if templateName == "" && len(templateFields) > 0 {
+				return mcp.NewToolResultError("template_fields requires template"), nil
+			}
+
 			client, err := getClient(ctx)
 			if err != nil {
 				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
@@ -1057,6 +1086,24 @@ Options are:
 
 			switch method {
 			case "create":
+				if templateName != "" {
+					templates, resp, err := listIssueTemplates(ctx, client, owner, repo)
+					if err != nil {
+						return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to list issue templates", resp, err), nil
+					}
+					template, err := findIssueTemplate(templates, templateName)
+					if err != nil {
+						return mcp.NewToolResultError(err.Error()), nil
+					}
+					if title == "" {
+						return mcp.NewToolResultError("missing required parameter: title"), nil
+					}
+					draft, err := template.apply(issueDraft{Title: title, Body: body, Labels: labels, Assignees: assignees, Type: issueType}, templateFields)
+					if err != nil {
+						return mcp.NewToolResultError(err.Error()), nil
+					}
+					title, body, labels, assignees, issueType = draft.Title, draft.Body, draft.Labels, draft.Assignees, draft.Type
+				}
 				return CreateIssue(ctx, client, owner, repo, title, body, assignees, labels, milestoneNum, issueType)
 			case "update":
 				issueNumber, err := RequiredInt(request, "issue_number")
@@ -1245,9 +1292,9 @@ func UpdateIssue(ctx context.Context, client *github.Client, gqlClient *githubv4
 }
 
 // ListIssues creates a tool to list and filter repository issues
-func ListIssues(getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+func ListIssues(getClient GetClientFn, getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
 	return mcp.NewTool("list_issues",
-			mcp.WithDescription(t("TOOL_LIST_ISSUES_DESCRIPTION", "List issues in a GitHub repository. For pagination, use the 'endCursor' from the previous response's 'pageInfo' in the 'after' parameter.")),
+			mcp.WithDescription(t("TOOL_LIST_ISSUES_DESCRIPTION", "List issues in a GitHub repository. For pagination, use the 'end_cursor' from the previous response's 'page_info' in the 'after' parameter.")),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
 				Title:        t("TOOL_LIST_ISSUES_USER_TITLE", "List issues"),
 				ReadOnlyHint: ToBoolPtr(true),
@@ -1283,6 +1330,9 @@ func ListIssues(getGQLClient GetGQLClientFn, t translations.TranslationHelperFun
 			mcp.WithString("since",
 				mcp.Description("Filter by date (ISO 8601 timestamp)"),
 			),
+			mcp.WithString("path_prefix",
+				mcp.Description(DescriptionPathPrefix),
+			),
 			WithCursorPagination(),
 		),
 		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -1352,6 +1402,11 @@ func ListIssues(getGQLClient GetGQLClientFn, t translations.TranslationHelperFun
 			}
 			hasLabels := len(labels) > 0
 
+			pathPrefix, err := OptionalParam[string](request, "path_prefix")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
 			// Get pagination parameters and convert to GraphQL format
 			pagination, err := OptionalCursorPaginationParams(request)
 			if err != nil {
@@ -1360,7 +1415,7 @@ func ListIssues(getGQLClient GetGQLClientFn, t translations.TranslationHelperFun
 
 			// Check if someone tried to use page-based pagination instead of cursor-based
 			if _, pageProvided := request.GetArguments()["page"]; pageProvided {
-				return mcp.NewToolResultError("This tool uses cursor-based pagination. Use the 'after' parameter with the 'endCursor' value from the previous response instead of 'page'."), nil
+				return mcp.NewToolResultError("This tool uses cursor-based pagination. Use the 'after' parameter with the 'end_cursor' value from the previous response's 'page_info' instead of 'page'."), nil
 			}
 
 			// Check if pagination parameters were explicitly provided
@@ -1437,17 +1492,22 @@ func ListIssues(getGQLClient GetGQLClientFn, t translations.TranslationHelperFun
 				totalCount = fragment.TotalCount
 			}
 
-			// Create response with issues
-			response := map[string]interface{}{
-				"issues": issues,
-				"pageInfo": map[string]interface{}{
-					"hasNextPage":     pageInfo.HasNextPage,
-					"hasPreviousPage": pageInfo.HasPreviousPage,
-					"startCursor":     string(pageInfo.StartCursor),
-					"endCursor":       string(pageInfo.EndCursor),
-				},
-				"totalCount": totalCount,
+			if pathPrefix != "" {
+				restClient, err := getClient(ctx)
+				if err != nil {
+					return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+				}
+				var errResult *mcp.CallToolResult
+				if issues, errResult = filterIssuesByPath(ctx, restClient, client, owner, repo, issues, normalizePathPrefix(pathPrefix)); errResult != nil {
+					return errResult, nil
+				}
 			}
+
+			// Create response with issues
+			response := NewListResponse("issues", issues,
+				NewGraphQLPageInfo(bool(pageInfo.HasNextPage), bool(pageInfo.HasPreviousPage), string(pageInfo.StartCursor), string(pageInfo.EndCursor)),
+				&totalCount,
+			)
 			out, err := json.Marshal(response)
 			if err != nil {
 				return nil, fmt.Errorf("failed to marshal issues: %w", err)
@@ -1500,9 +1560,10 @@ func AssignCopilotToIssue(getGQLClient GetGQLClientFn, t translations.Translatio
 	return mcp.NewTool("assign_copilot_to_issue",
 			mcp.WithDescription(t("TOOL_ASSIGN_COPILOT_TO_ISSUE_DESCRIPTION", description.String())),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
-				Title:          t("TOOL_ASSIGN_COPILOT_TO_ISSUE_USER_TITLE", "Assign Copilot to issue"),
-				ReadOnlyHint:   ToBoolPtr(false),
-				IdempotentHint: ToBoolPtr(true),
+				Title:           t("TOOL_ASSIGN_COPILOT_TO_ISSUE_USER_TITLE", "Assign Copilot to issue"),
+				ReadOnlyHint:    ToBoolPtr(false),
+				IdempotentHint:  ToBoolPtr(true),
+				DestructiveHint: ToBoolPtr(false),
 			}),
 			mcp.WithString("owner",
 				mcp.Required(),
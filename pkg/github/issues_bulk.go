@@ -0,0 +1,336 @@
+package github
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v79/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+const (
+	// MaxBulkIssues is the maximum number of issues create_issues_bulk creates in one call.
+	MaxBulkIssues = 50
+	// DefaultBulkIssueConcurrency is the number of issues create_issues_bulk creates at a time unless the caller
+	// gives another limit.
+	DefaultBulkIssueConcurrency = 4
+	// MaxBulkIssueConcurrency caps the concurrency of create_issues_bulk, to stay clear of secondary rate limits.
+	MaxBulkIssueConcurrency = 8
+)
+
+// bulkIssueSpec is an issue to create with create_issues_bulk.
+type bulkIssueSpec struct {
+	Title     string   `json:"title"`
+	Body      string   `json:"body"`
+	Labels    []string `json:"labels"`
+	Assignees []string `json:"assignees"`
+	Milestone int      `json:"milestone"`
+	Type      string   `json:"type"`
+	Status    string   `json:"status"`
+}
+
+// BulkCreatedIssue is an issue created by create_issues_bulk.
+type BulkCreatedIssue struct {
+	Index         int    `json:"index"`
+	Number        int    `json:"number"`
+	Title         string `json:"title"`
+	URL           string `json:"url"`
+	ProjectItemID int64  `json:"project_item_id,omitempty"`
+	Status        string `json:"status,omitempty"`
+	ProjectError  string `json:"project_error,omitempty"`
+}
+
+// bulkIssueTarget is the project board create_issues_bulk adds issues to, and its Status field.
+type bulkIssueTarget struct {
+	ownerType     string
+	owner         string
+	number        int
+	statusField   *github.ProjectV2Field
+	defaultStatus string
+}
+
+// statusOptionID returns the ID of the option of the Status field with the given name.
+func (p *bulkIssueTarget) statusOptionID(name string) (string, error) {
+	if p.statusField == nil {
+		return "", fmt.Errorf("the project has no single select Status field")
+	}
+	names := make([]string, 0, len(p.statusField.Options))
+	for _, option := range p.statusField.Options {
+		if strings.EqualFold(option.GetName().GetRaw(), name) {
+			return option.GetID(), nil
+		}
+		names = append(names, option.GetName().GetRaw())
+	}
+	return "", fmt.Errorf("status %q is not an option of the project's Status field; options are: %s", name, strings.Join(names, ", "))
+}
+
+// bulkIssueOutcome is the outcome of creating one issue.
+type bulkIssueOutcome struct {
+	created *BulkCreatedIssue
+	resp    *github.Response
+	err     error
+}
+
+// CreateIssuesBulk creates a tool to create several issues at once, optionally adding them to a project board.
+func CreateIssuesBulk(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("create_issues_bulk",
+			mcp.WithDescription(t("TOOL_CREATE_ISSUES_BULK_DESCRIPTION", fmt.Sprintf("Create up to %d issues in a repository in one call, for example to break an epic into tasks, optionally adding each to a Project board with an initial Status. Reports the result of every issue: issues that could not be created are listed as failed, and issues created but not added to the project carry a project_error.", MaxBulkIssues))),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:           t("TOOL_CREATE_ISSUES_BULK_USER_TITLE", "Create issues in bulk"),
+				ReadOnlyHint:    ToBoolPtr(false),
+				DestructiveHint: ToBoolPtr(false),
+				IdempotentHint:  ToBoolPtr(false),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryOwner),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryName),
+			),
+			mcp.WithArray("issues",
+				mcp.Required(),
+				mcp.Description("Issues to create"),
+				mcp.Items(map[string]any{
+					"type":     "object",
+					"required": []string{"title"},
+					"properties": map[string]any{
+						"title":     map[string]any{"type": "string", "description": "Issue title"},
+						"body":      map[string]any{"type": "string", "description": "Issue body content"},
+						"labels":    map[string]any{"type": "array", "items": map[string]any{"type": "string"}, "description": "Labels to apply"},
+						"assignees": map[string]any{"type": "array", "items": map[string]any{"type": "string"}, "description": "Usernames to assign"},
+						"milestone": map[string]any{"type": "number", "description": "Milestone number"},
+						"type":      map[string]any{"type": "string", "description": "Issue type"},
+						"status":    map[string]any{"type": "string", "description": "Initial Status on the project board, overriding status"},
+					},
+				}),
+			),
+			mcp.WithString("project_owner_type",
+				mcp.Description("Owner type of the project to add the issues to"),
+				mcp.Enum("user", "org"),
+			),
+			mcp.WithString("project_owner",
+				mcp.Description("Owner of the project to add the issues to. Requires project_owner_type and project_number."),
+			),
+			mcp.WithNumber("project_number",
+				mcp.Description("Number of the project to add the issues to"),
+			),
+			mcp.WithString("status",
+				mcp.Description("Initial value of the project's Status field for every added issue, e.g. \"Todo\""),
+			),
+			mcp.WithNumber("concurrency",
+				mcp.Description("Number of issues to create at a time"),
+				mcp.Min(1),
+				mcp.Max(MaxBulkIssueConcurrency),
+				mcp.DefaultNumber(DefaultBulkIssueConcurrency),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			specs, err := bulkIssueSpecsParam(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			projectOwnerType, err := OptionalParam[string](request, "project_owner_type")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			projectOwner, err := OptionalParam[string](request, "project_owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			projectNumber, err := OptionalIntParam(request, "project_number")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			status, err := OptionalParam[string](request, "status")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			concurrency, err := OptionalIntParamWithDefault(request, "concurrency", DefaultBulkIssueConcurrency)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			concurrency = min(max(concurrency, 1), MaxBulkIssueConcurrency)
+
+			useProject := projectOwnerType != "" || projectOwner != "" || projectNumber != 0
+			if useProject && (projectOwnerType == "" || projectOwner == "" || projectNumber == 0) {
+				return mcp.NewToolResultError("project_owner_type, project_owner and project_number must be given together"), nil
+			}
+			if !useProject {
+				if status != "" {
+					return mcp.NewToolResultError("status requires a project"), nil
+				}
+				for i, spec := range specs {
+					if spec.Status != "" {
+						return mcp.NewToolResultError(fmt.Sprintf("issues[%d].status requires a project", i)), nil
+					}
+				}
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			// Resolve every status before creating anything, so that a typo does not leave issues behind.
+			var target *bulkIssueTarget
+			if useProject {
+				target = &bulkIssueTarget{ownerType: projectOwnerType, owner: projectOwner, number: projectNumber, defaultStatus: status}
+				fields, resp, err := listAllProjectFields(ctx, client, projectOwnerType, projectOwner, projectNumber)
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to list project fields", resp, err), nil
+				}
+				for _, field := range fields {
+					if strings.EqualFold(field.GetName(), "Status") && field.GetDataType() == "single_select" {
+						target.statusField = field
+					}
+				}
+				for i, spec := range specs {
+					if s := cmp.Or(spec.Status, status); s != "" {
+						if _, err := target.statusOptionID(s); err != nil {
+							return ghErrors.NewToolError(ghErrors.CodeInvalidInput, fmt.Sprintf("issues[%d]: %s", i, err), "status").Result(), nil
+						}
+					}
+				}
+			}
+
+			outcomes := make([]bulkIssueOutcome, len(specs))
+			sem := make(chan struct{}, concurrency)
+			var wg sync.WaitGroup
+			for i, spec := range specs {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					sem <- struct{}{}
+					defer func() { <-sem }()
+					outcomes[i] = createBulkIssue(ctx, client, owner, repo, i, spec, target)
+				}()
+			}
+			wg.Wait()
+
+			result := NewBulkResult[BulkCreatedIssue]()
+			for i, outcome := range outcomes {
+				if outcome.err != nil {
+					result.AddAPIFailure(fmt.Sprintf("issues[%d]: %s", i, specs[i].Title), outcome.resp, outcome.err)
+					continue
+				}
+				result.AddSuccess(*outcome.created)
+			}
+			return result.ToolResult()
+		}
+}
+
+// bulkIssueSpecsParam decodes and checks the issues parameter.
+func bulkIssueSpecsParam(request mcp.CallToolRequest) ([]bulkIssueSpec, error) {
+	raw, ok := request.GetArguments()["issues"].([]any)
+	if !ok {
+		return nil, fmt.Errorf("missing required parameter: issues")
+	}
+	if len(raw) == 0 || len(raw) > MaxBulkIssues {
+		return nil, fmt.Errorf("issues must contain between 1 and %d issues, got %d", MaxBulkIssues, len(raw))
+	}
+	data, err := json.Marshal(raw)
+	if err != nil {
+		return nil, fmt.Errorf("invalid issues: %w", err)
+	}
+	var specs []bulkIssueSpec
+	if err := json.Unmarshal(data, &specs); err != nil {
+		return nil, fmt.Errorf("invalid issues: %w", err)
+	}
+	for i, spec := range specs {
+		if strings.TrimSpace(spec.Title) == "" {
+			return nil, fmt.Errorf("issues[%d]: missing required field: title", i)
+		}
+	}
+	return specs, nil
+}
+
+// createBulkIssue creates one issue and adds it to the target project, if any.
+func createBulkIssue(ctx context.Context, client *github.Client, owner, repo string, index int, spec bulkIssueSpec, target *bulkIssueTarget) bulkIssueOutcome {
+	issueRequest := &github.IssueRequest{
+		Title:     github.Ptr(spec.Title),
+		Body:      github.Ptr(spec.Body),
+		Labels:    &spec.Labels,
+		Assignees: &spec.Assignees,
+	}
+	if spec.Milestone != 0 {
+		issueRequest.Milestone = &spec.Milestone
+	}
+	if spec.Type != "" {
+		issueRequest.Type = github.Ptr(spec.Type)
+	}
+	issue, resp, err := client.Issues.Create(ctx, owner, repo, issueRequest)
+	if err != nil {
+		return bulkIssueOutcome{resp: resp, err: err}
+	}
+	_ = resp.Body.Close()
+
+	created := &BulkCreatedIssue{
+		Index:  index,
+		Number: issue.GetNumber(),
+		Title:  issue.GetTitle(),
+		URL:    issue.GetHTMLURL(),
+	}
+	if target != nil {
+		if err := target.add(ctx, client, issue, cmp.Or(spec.Status, target.defaultStatus), created); err != nil {
+			created.ProjectError = err.Error()
+		}
+	}
+	return bulkIssueOutcome{created: created}
+}
+
+// add adds an issue to the project and sets its Status, recording the outcome in created.
+func (p *bulkIssueTarget) add(ctx context.Context, client *github.Client, issue *github.Issue, status string, created *BulkCreatedIssue) error {
+	opts := &github.AddProjectItemOptions{Type: "Issue", ID: issue.GetID()}
+	var item *github.ProjectV2Item
+	var resp *github.Response
+	var err error
+	if p.ownerType == "org" {
+		item, resp, err = client.Projects.AddOrganizationProjectItem(ctx, p.owner, p.number, opts)
+	} else {
+		item, resp, err = client.Projects.AddUserProjectItem(ctx, p.owner, p.number, opts)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to add the issue to the project: %w", err)
+	}
+	_ = resp.Body.Close()
+	created.ProjectItemID = item.GetID()
+
+	if status == "" {
+		return nil
+	}
+	optionID, err := p.statusOptionID(status)
+	if err != nil {
+		return err
+	}
+	update := &github.UpdateProjectItemOptions{
+		Fields: []*github.UpdateProjectV2Field{{ID: p.statusField.GetID(), Value: optionID}},
+	}
+	if p.ownerType == "org" {
+		_, resp, err = client.Projects.UpdateOrganizationProjectItem(ctx, p.owner, p.number, item.GetID(), update)
+	} else {
+		_, resp, err = client.Projects.UpdateUserProjectItem(ctx, p.owner, p.number, item.GetID(), update)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to set the status: %w", err)
+	}
+	_ = resp.Body.Close()
+	created.Status = status
+	return nil
+}
@@ -0,0 +1,139 @@
+package github
+
+import (
+	"encoding/json"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/ghmock"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_CreateIssuesBulk(t *testing.T) {
+	tool, _ := CreateIssuesBulk(nil, translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+	assert.False(t, *tool.Annotations.DestructiveHint)
+	assert.ElementsMatch(t, []string{"owner", "repo", "issues"}, tool.InputSchema.Required)
+
+	// newServer serves a repository whose issue creation fails for titles starting with "fail", and an
+	// organization project with a Status field.
+	newServer := func(t *testing.T) *ghmock.Server {
+		server := ghmock.New(t)
+		var mu sync.Mutex
+		next := 100
+		server.HandleFunc("POST /repos/octo/app/issues", func(w http.ResponseWriter, r *http.Request) {
+			var body map[string]any
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			title := body["title"].(string)
+			if len(title) >= 4 && title[:4] == "fail" {
+				w.WriteHeader(http.StatusUnprocessableEntity)
+				_, _ = w.Write([]byte(`{"message": "Validation Failed"}`))
+				return
+			}
+			mu.Lock()
+			next++
+			number := next
+			mu.Unlock()
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write(mock.MustMarshal(map[string]any{
+				"id": number * 10, "number": number, "title": title, "html_url": "https://github.com/octo/app/issues/" + title,
+			}))
+		})
+		server.Respond("GET /orgs/{org}/projectsV2/{project}/fields", http.StatusOK, projectFields(200))
+		server.HandleFunc("POST /orgs/{org}/projectsV2/{project}/items", func(w http.ResponseWriter, r *http.Request) {
+			var body map[string]any
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write(mock.MustMarshal(map[string]any{"id": int(body["id"].(float64)) + 1}))
+		})
+		server.Respond("PATCH /orgs/{org}/projectsV2/{project}/items/{item}", http.StatusOK, map[string]any{"id": 1})
+		return server
+	}
+	type response struct {
+		Succeeded []BulkCreatedIssue `json:"succeeded"`
+		Failed    []BulkFailure      `json:"failed"`
+		Summary   BulkSummary        `json:"summary"`
+	}
+
+	t.Run("creates issues and adds them to the project", func(t *testing.T) {
+		server := newServer(t)
+		_, handler := CreateIssuesBulk(server.GetClient(), translations.NullTranslationHelper)
+		result := ghmock.CallTool(t, handler, map[string]any{
+			"owner": "octo", "repo": "app",
+			"issues": []any{
+				map[string]any{"title": "a", "labels": []any{"task"}},
+				map[string]any{"title": "fail-b"},
+				map[string]any{"title": "c", "status": "done"},
+			},
+			"project_owner_type": "org", "project_owner": "octo", "project_number": float64(7),
+			"status": "Todo", "concurrency": float64(2),
+		})
+		require.False(t, result.IsError, ghmock.ResultText(t, result))
+
+		var resp response
+		require.NoError(t, json.Unmarshal([]byte(ghmock.ResultText(t, result)), &resp))
+		assert.Equal(t, BulkSummary{Total: 3, Succeeded: 2, Failed: 1}, resp.Summary)
+		require.Len(t, resp.Succeeded, 2)
+		assert.Equal(t, 0, resp.Succeeded[0].Index)
+		assert.Equal(t, "Todo", resp.Succeeded[0].Status)
+		assert.Equal(t, int64(resp.Succeeded[0].Number*10+1), resp.Succeeded[0].ProjectItemID)
+		assert.Equal(t, 2, resp.Succeeded[1].Index)
+		assert.Equal(t, "done", resp.Succeeded[1].Status)
+		assert.Equal(t, "issues[1]: fail-b", resp.Failed[0].Item)
+
+		var statuses []string
+		for _, req := range server.Requests() {
+			if req.Method != http.MethodPatch {
+				continue
+			}
+			var body struct {
+				Fields []struct {
+					ID    int    `json:"id"`
+					Value string `json:"value"`
+				} `json:"fields"`
+			}
+			require.NoError(t, req.DecodeBody(&body))
+			assert.Equal(t, 201, body.Fields[0].ID)
+			statuses = append(statuses, body.Fields[0].Value)
+		}
+		assert.ElementsMatch(t, []string{"todo-200", "done-200"}, statuses)
+	})
+
+	t.Run("unknown status fails before creating issues", func(t *testing.T) {
+		server := newServer(t)
+		_, handler := CreateIssuesBulk(server.GetClient(), translations.NullTranslationHelper)
+		result := ghmock.CallTool(t, handler, map[string]any{
+			"owner": "octo", "repo": "app",
+			"issues":             []any{map[string]any{"title": "a"}, map[string]any{"title": "b", "status": "Blocked"}},
+			"project_owner_type": "org", "project_owner": "octo", "project_number": float64(7),
+		})
+		require.True(t, result.IsError)
+		assert.Contains(t, ghmock.ResultText(t, result), `issues[1]: status \"Blocked\" is not an option of the project's Status field; options are: Todo, Done`)
+		server.AssertNotRequested(http.MethodPost)
+	})
+
+	t.Run("invalid input", func(t *testing.T) {
+		_, handler := CreateIssuesBulk(ghmock.New(t).GetClient(), translations.NullTranslationHelper)
+		one := []any{map[string]any{"title": "a"}}
+		tests := []struct {
+			args     map[string]any
+			expected string
+		}{
+			{map[string]any{"issues": []any{}}, "issues must contain between 1 and 50 issues, got 0"},
+			{map[string]any{"issues": []any{map[string]any{"body": "x"}}}, "issues[0]: missing required field: title"},
+			{map[string]any{"issues": one, "status": "Todo"}, "status requires a project"},
+			{map[string]any{"issues": one, "project_number": float64(7)}, "project_owner_type, project_owner and project_number must be given together"},
+		}
+		for _, tc := range tests {
+			tc.args["owner"], tc.args["repo"] = "octo", "app"
+			result := ghmock.CallTool(t, handler, tc.args)
+			require.True(t, result.IsError)
+			assert.Equal(t, tc.expected, ghmock.ResultText(t, result))
+		}
+	})
+}
@@ -483,7 +483,7 @@ func Test_AddIssueComment(t *testing.T) {
 func Test_SearchIssues(t *testing.T) {
 	// Verify tool definition once
 	mockClient := github.NewClient(nil)
-	tool, _ := SearchIssues(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	tool, _ := SearchIssues(stubGetClientFn(mockClient), stubGetGQLClientFn(githubv4.NewClient(nil)), translations.NullTranslationHelper)
 	require.NoError(t, toolsnaps.Test(tool.Name, tool))
 
 	assert.Equal(t, "search_issues", tool.Name)
@@ -772,7 +772,7 @@ func Test_SearchIssues(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			// Setup client with mock
 			client := github.NewClient(tc.mockedClient)
-			_, handler := SearchIssues(stubGetClientFn(client), translations.NullTranslationHelper)
+			_, handler := SearchIssues(stubGetClientFn(client), stubGetGQLClientFn(githubv4.NewClient(nil)), translations.NullTranslationHelper)
 
 			// Create call request
 			request := createMCPRequest(tc.requestArgs)
@@ -975,7 +975,7 @@ func Test_CreateIssue(t *testing.T) {
 func Test_ListIssues(t *testing.T) {
 	// Verify tool definition
 	mockClient := githubv4.NewClient(nil)
-	tool, _ := ListIssues(stubGetGQLClientFn(mockClient), translations.NullTranslationHelper)
+	tool, _ := ListIssues(stubGetClientFn(github.NewClient(nil)), stubGetGQLClientFn(mockClient), translations.NullTranslationHelper)
 	require.NoError(t, toolsnaps.Test(tool.Name, tool))
 
 	assert.Equal(t, "list_issues", tool.Name)
@@ -1236,7 +1236,7 @@ func Test_ListIssues(t *testing.T) {
 			}
 
 			gqlClient := githubv4.NewClient(httpClient)
-			_, handler := ListIssues(stubGetGQLClientFn(gqlClient), translations.NullTranslationHelper)
+			_, handler := ListIssues(stubGetClientFn(github.NewClient(nil)), stubGetGQLClientFn(gqlClient), translations.NullTranslationHelper)
 
 			req := createMCPRequest(tc.reqParams)
 			res, err := handler(context.Background(), req)
@@ -1251,14 +1251,9 @@ func Test_ListIssues(t *testing.T) {
 
 			// Parse the structured response with pagination info
 			var response struct {
-				Issues   []*github.Issue `json:"issues"`
-				PageInfo struct {
-					HasNextPage     bool   `json:"hasNextPage"`
-					HasPreviousPage bool   `json:"hasPreviousPage"`
-					StartCursor     string `json:"startCursor"`
-					EndCursor       string `json:"endCursor"`
-				} `json:"pageInfo"`
-				TotalCount int `json:"totalCount"`
+				Issues     []*github.Issue `json:"issues"`
+				PageInfo   PageInfo        `json:"page_info"`
+				TotalCount int             `json:"total_count"`
 			}
 			err = json.Unmarshal([]byte(text), &response)
 			require.NoError(t, err)
@@ -3669,9 +3664,16 @@ func Test_ListIssueTypes(t *testing.T) {
 			textContent := getTextResult(t, result)
 
 			// Unmarshal and verify the result
-			var returnedIssueTypes []*github.IssueType
-			err = json.Unmarshal([]byte(textContent.Text), &returnedIssueTypes)
+			var response struct {
+				IssueTypes []*github.IssueType `json:"issue_types"`
+				PageInfo   PageInfo            `json:"page_info"`
+				TotalCount int                 `json:"total_count"`
+			}
+			err = json.Unmarshal([]byte(textContent.Text), &response)
 			require.NoError(t, err)
+			returnedIssueTypes := response.IssueTypes
+			assert.False(t, response.PageInfo.HasNextPage)
+			assert.Equal(t, len(returnedIssueTypes), response.TotalCount)
 
 			if tc.expectedIssueTypes != nil {
 				require.Equal(t, len(tc.expectedIssueTypes), len(returnedIssueTypes))
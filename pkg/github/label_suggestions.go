@@ -0,0 +1,244 @@
+package github
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+	"unicode"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v79/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+const (
+	// DefaultLabelSuggestions is the number of labels suggest_labels returns unless the caller asks for another number.
+	DefaultLabelSuggestions = 5
+
+	// similarIssuesForLabels is the number of similar issues whose labels suggest_labels learns from.
+	similarIssuesForLabels = 30
+)
+
+// labelStopWords are words too common in issues to tell labels apart.
+var labelStopWords = map[string]bool{
+	"the": true, "and": true, "for": true, "with": true, "this": true, "that": true, "from": true, "when": true,
+	"are": true, "was": true, "not": true, "but": true, "have": true, "has": true, "can": true, "should": true,
+	"would": true, "could": true, "does": true, "into": true, "after": true, "before": true, "there": true,
+	"what": true, "which": true, "will": true, "been": true, "also": true, "some": true, "any": true, "all": true,
+	"issue": true, "issues": true, "please": true, "using": true, "use": true, "get": true, "set": true,
+}
+
+// LabelSuggestions is the labels suggested for an issue, best first, with the similar issues they were learned from.
+type LabelSuggestions struct {
+	Issue         int               `json:"issue,omitempty"`
+	SimilarIssues []int             `json:"similar_issues"`
+	Suggestions   []LabelSuggestion `json:"suggestions"`
+}
+
+// LabelSuggestion is a label of the repository that fits an issue, with a score between 0 and 1 and why it fits.
+type LabelSuggestion struct {
+	Name        string   `json:"name"`
+	Description string   `json:"description,omitempty"`
+	Score       float64  `json:"score"`
+	Reasons     []string `json:"reasons"`
+}
+
+// labelWords splits text into lower case words that can tell labels apart, dropping short and common words and a
+// plural s so that "tests" matches "test".
+func labelWords(text string) []string {
+	var words []string
+	for _, word := range strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}) {
+		if len(word) < 3 || labelStopWords[word] {
+			continue
+		}
+		if len(word) > 4 && strings.HasSuffix(word, "s") && !strings.HasSuffix(word, "ss") {
+			word = strings.TrimSuffix(word, "s")
+		}
+		if !slices.Contains(words, word) {
+			words = append(words, word)
+		}
+	}
+	return words
+}
+
+// matchingWords returns the words found in text.
+func matchingWords(words []string, text []string) []string {
+	var found []string
+	for _, word := range words {
+		if slices.Contains(text, word) {
+			found = append(found, word)
+		}
+	}
+	return found
+}
+
+// listAllRepositoryLabels lists every label of a repository.
+func listAllRepositoryLabels(ctx context.Context, client *github.Client, owner, repo string) ([]*github.Label, *github.Response, error) {
+	var labels []*github.Label
+	opts := &github.ListOptions{PerPage: 100}
+	for {
+		page, resp, err := client.Issues.ListLabels(ctx, owner, repo, opts)
+		if err != nil {
+			return nil, resp, err
+		}
+		_ = resp.Body.Close()
+		labels = append(labels, page...)
+		if resp.NextPage == 0 {
+			return labels, resp, nil
+		}
+		opts.Page = resp.NextPage
+	}
+}
+
+// SuggestLabels creates a tool that suggests labels for an issue from its content and the labels of similar issues.
+func SuggestLabels(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("suggest_labels",
+			mcp.WithDescription(t("TOOL_SUGGEST_LABELS_DESCRIPTION", "Suggest labels of a repository for an issue, best first: labels whose name or description matches the issue's title and body, and labels of similar issues in the repository. Give an existing issue by number, or the title and body of an issue about to be created. Labels the issue already has are not suggested. Apply suggestions with the labels or issues tools.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_SUGGEST_LABELS_USER_TITLE", "Suggest labels"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryOwner),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryName),
+			),
+			mcp.WithNumber("issue_number",
+				mcp.Description("Number of an existing issue"),
+			),
+			mcp.WithString("title",
+				mcp.Description("Title of an issue not created yet, instead of issue_number"),
+			),
+			mcp.WithString("body",
+				mcp.Description("Body of an issue not created yet"),
+			),
+			mcp.WithNumber("limit",
+				mcp.Description("Maximum number of labels to suggest"),
+				mcp.Min(1),
+				mcp.DefaultNumber(DefaultLabelSuggestions),
+			),
+		), func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](req, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](req, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			issueNumber, err := OptionalIntParam(req, "issue_number")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			title, err := OptionalParam[string](req, "title")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			body, err := OptionalParam[string](req, "body")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			limit, err := OptionalIntParamWithDefault(req, "limit", DefaultLabelSuggestions)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if (issueNumber == 0) == (title == "") {
+				return mcp.NewToolResultError("exactly one of issue_number or title is required"), nil
+			}
+			if issueNumber != 0 && body != "" {
+				return mcp.NewToolResultError("body can only be given with title"), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			var current []string
+			if issueNumber != 0 {
+				issue, resp, err := client.Issues.Get(ctx, owner, repo, issueNumber)
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get issue", resp, err), nil
+				}
+				_ = resp.Body.Close()
+				title, body = issue.GetTitle(), issue.GetBody()
+				for _, label := range issue.Labels {
+					current = append(current, label.GetName())
+				}
+			}
+			text := labelWords(title + "\n" + body)
+
+			labels, resp, err := listAllRepositoryLabels(ctx, client, owner, repo)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to list labels", resp, err), nil
+			}
+
+			// Similar issues are found by the words of the title, which describe an issue better than its body.
+			suggestions := LabelSuggestions{Issue: issueNumber, SimilarIssues: []int{}, Suggestions: []LabelSuggestion{}}
+			votes := map[string]int{}
+			if keywords := labelWords(title); len(keywords) > 0 {
+				query := fmt.Sprintf("repo:%s/%s is:issue %s", owner, repo, strings.Join(keywords[:min(len(keywords), 5)], " OR "))
+				result, resp, err := client.Search.Issues(ctx, query, &github.SearchOptions{ListOptions: github.ListOptions{PerPage: similarIssuesForLabels + 1}})
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to search similar issues", resp, err), nil
+				}
+				_ = resp.Body.Close()
+				for _, issue := range result.Issues {
+					if issue.GetNumber() == issueNumber || len(suggestions.SimilarIssues) == similarIssuesForLabels {
+						continue
+					}
+					suggestions.SimilarIssues = append(suggestions.SimilarIssues, issue.GetNumber())
+					for _, label := range issue.Labels {
+						votes[label.GetName()]++
+					}
+				}
+			}
+
+			// A label scores up to 0.4 for its name, 0.2 for its description and 0.4 for how often similar issues have it.
+			for _, label := range labels {
+				if containsFold(current, label.GetName()) {
+					continue
+				}
+				suggestion := LabelSuggestion{Name: label.GetName(), Description: label.GetDescription(), Reasons: []string{}}
+				score := 0.0
+				if words := labelWords(label.GetName()); len(words) > 0 {
+					if found := matchingWords(words, text); len(found) > 0 {
+						score += 0.4 * float64(len(found)) / float64(len(words))
+						suggestion.Reasons = append(suggestion.Reasons, fmt.Sprintf("name matches %q", strings.Join(found, ", ")))
+					}
+				}
+				if words := labelWords(label.GetDescription()); len(words) > 0 {
+					if found := matchingWords(words, text); len(found) > 0 {
+						score += 0.2 * float64(len(found)) / float64(len(words))
+						suggestion.Reasons = append(suggestion.Reasons, fmt.Sprintf("description matches %q", strings.Join(found, ", ")))
+					}
+				}
+				if n := votes[label.GetName()]; n > 0 {
+					score += 0.4 * float64(n) / float64(len(suggestions.SimilarIssues))
+					suggestion.Reasons = append(suggestion.Reasons, fmt.Sprintf("on %d of %d similar issues", n, len(suggestions.SimilarIssues)))
+				}
+				if score == 0 {
+					continue
+				}
+				suggestion.Score = math.Round(score*100) / 100
+				suggestions.Suggestions = append(suggestions.Suggestions, suggestion)
+			}
+			slices.SortStableFunc(suggestions.Suggestions, func(a, b LabelSuggestion) int {
+				return cmp.Compare(b.Score, a.Score)
+			})
+			if len(suggestions.Suggestions) > limit {
+				suggestions.Suggestions = suggestions.Suggestions[:limit]
+			}
+			return MarshalledTextResult(suggestions), nil
+		}
+}
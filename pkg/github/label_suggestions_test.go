@@ -0,0 +1,103 @@
+package github
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/ghmock"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_SuggestLabels(t *testing.T) {
+	tool, _ := SuggestLabels(nil, translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+	assert.True(t, *tool.Annotations.ReadOnlyHint)
+	assert.ElementsMatch(t, []string{"owner", "repo"}, tool.InputSchema.Required)
+
+	label := func(name, description string) map[string]any {
+		return map[string]any{"name": name, "description": description}
+	}
+	newServer := func(t *testing.T) *ghmock.Server {
+		server := ghmock.New(t)
+		server.Respond("GET /repos/octo/app/issues/7", http.StatusOK, map[string]any{
+			"number": 7, "title": "Login crash on Safari", "body": "The page crashes with an exception after signing in.",
+			"labels": []any{label("needs-triage", "")},
+		})
+		server.Respond("GET /repos/octo/app/labels", http.StatusOK, []any{
+			label("bug", "Something is not working"),
+			label("area: auth", "Login, sessions and tokens"),
+			label("browser", "Browser compatibility"),
+			label("documentation", "Improvements or additions to documentation"),
+			label("needs-triage", ""),
+		})
+		server.Respond("GET /search/issues", http.StatusOK, map[string]any{"total_count": 3, "items": []any{
+			map[string]any{"number": 7, "labels": []any{label("needs-triage", "")}},
+			map[string]any{"number": 3, "labels": []any{label("bug", ""), label("area: auth", "")}},
+			map[string]any{"number": 5, "labels": []any{label("bug", "")}},
+		}})
+		return server
+	}
+	call := func(t *testing.T, server *ghmock.Server, args map[string]any) LabelSuggestions {
+		_, handler := SuggestLabels(server.GetClient(), translations.NullTranslationHelper)
+		result := ghmock.CallTool(t, handler, args)
+		require.False(t, result.IsError, ghmock.ResultText(t, result))
+		var suggestions LabelSuggestions
+		require.NoError(t, json.Unmarshal([]byte(ghmock.ResultText(t, result)), &suggestions))
+		return suggestions
+	}
+
+	t.Run("existing issue", func(t *testing.T) {
+		server := newServer(t)
+		suggestions := call(t, server, map[string]any{"owner": "octo", "repo": "app", "issue_number": float64(7)})
+		assert.Equal(t, LabelSuggestions{
+			Issue:         7,
+			SimilarIssues: []int{3, 5},
+			Suggestions: []LabelSuggestion{
+				{Name: "bug", Description: "Something is not working", Score: 0.4, Reasons: []string{"on 2 of 2 similar issues"}},
+				{Name: "area: auth", Description: "Login, sessions and tokens", Score: 0.27, Reasons: []string{
+					`description matches "login"`, "on 1 of 2 similar issues",
+				}},
+			},
+		}, suggestions)
+		assert.Equal(t, "repo:octo/app is:issue login OR crash OR safari", server.AssertRequested("GET /search/issues").Query.Get("q"))
+	})
+
+	t.Run("new issue", func(t *testing.T) {
+		server := newServer(t)
+		suggestions := call(t, server, map[string]any{
+			"owner": "octo", "repo": "app", "title": "Browser docs", "body": "Document browser support", "limit": float64(1),
+		})
+		assert.Zero(t, suggestions.Issue)
+		require.Len(t, suggestions.Suggestions, 1)
+		assert.Equal(t, LabelSuggestion{Name: "browser", Description: "Browser compatibility", Score: 0.5, Reasons: []string{
+			`name matches "browser"`, `description matches "browser"`,
+		}}, suggestions.Suggestions[0])
+		server.AssertNotRequested("GET /repos/octo/app/issues/7")
+	})
+
+	for name, tc := range map[string]struct {
+		args map[string]any
+		want string
+	}{
+		"no issue":           {map[string]any{}, "exactly one of issue_number or title is required"},
+		"issue and title":    {map[string]any{"issue_number": float64(7), "title": "Crash"}, "exactly one of issue_number or title is required"},
+		"body without title": {map[string]any{"issue_number": float64(7), "body": "Crash"}, "body can only be given with title"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			server := ghmock.New(t)
+			args := map[string]any{"owner": "octo", "repo": "app"}
+			for k, v := range tc.args {
+				args[k] = v
+			}
+			_, handler := SuggestLabels(server.GetClient(), translations.NullTranslationHelper)
+			result := ghmock.CallTool(t, handler, args)
+			require.True(t, result.IsError)
+			assert.Equal(t, tc.want, ghmock.ResultText(t, result))
+			assert.Empty(t, server.Requests())
+		})
+	}
+}
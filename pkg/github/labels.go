@@ -164,10 +164,9 @@ func ListLabels(getGQLClient GetGQLClientFn, t translations.TranslationHelperFun
 				}
 			}
 
-			response := map[string]any{
-				"labels":     labels,
-				"totalCount": int(query.Repository.Labels.TotalCount),
-			}
+			// Only the first 100 labels are fetched, so there is no cursor to continue from.
+			totalCount := int(query.Repository.Labels.TotalCount)
+			response := NewListResponse("labels", labels, NewGraphQLPageInfo(totalCount > len(labels), false, "", ""), &totalCount)
 
 			out, err := json.Marshal(response)
 			if err != nil {
@@ -184,8 +183,10 @@ func LabelWrite(getGQLClient GetGQLClientFn, t translations.TranslationHelperFun
 			"label_write",
 			mcp.WithDescription(t("TOOL_LABEL_WRITE_DESCRIPTION", "Perform write operations on repository labels. To set labels on issues, use the 'update_issue' tool.")),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
-				Title:        t("TOOL_LABEL_WRITE_TITLE", "Write operations on repository labels."),
-				ReadOnlyHint: ToBoolPtr(false),
+				Title:           t("TOOL_LABEL_WRITE_TITLE", "Write operations on repository labels."),
+				ReadOnlyHint:    ToBoolPtr(false),
+				DestructiveHint: ToBoolPtr(true),
+				IdempotentHint:  ToBoolPtr(false),
 			}),
 			mcp.WithString("method",
 				mcp.Required(),
@@ -7,6 +7,7 @@ import (
 
 	"github.com/github/github-mcp-server/internal/githubv4mock"
 	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/ghmock"
 	"github.com/github/github-mcp-server/pkg/translations"
 	"github.com/shurcooL/githubv4"
 	"github.com/stretchr/testify/assert"
@@ -138,6 +139,23 @@ func TestGetLabel(t *testing.T) {
 	}
 }
 
+func TestGetLabel_Replay(t *testing.T) {
+	t.Parallel()
+
+	// Replays GraphQL exchanges recorded in the fixture; run with GHMOCK_RECORD=1 and a token to re-record them.
+	recorder := ghmock.NewRecorder(t, "testdata/fixtures/get_label.json")
+	_, handler := GetLabel(recorder.GetGQLClient(), translations.NullTranslationHelper)
+
+	result := ghmock.CallTool(t, handler, map[string]any{"owner": "octo", "repo": "hello-world", "name": "bug"})
+	require.False(t, result.IsError)
+	assert.JSONEq(t, `{"id":"LA_kwDOABCD5M8AAAABxyz123","name":"bug","color":"d73a4a","description":"Something isn't working"}`,
+		ghmock.ResultText(t, result))
+
+	result = ghmock.CallTool(t, handler, map[string]any{"owner": "octo", "repo": "hello-world", "name": "wontfix"})
+	require.True(t, result.IsError)
+	assert.Equal(t, "label 'wontfix' not found in octo/hello-world", ghmock.ResultText(t, result))
+}
+
 func TestListLabels(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,329 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v79/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+var (
+	// "- [ ] task", "* [x] task" or "1. [ ] task", with the checkbox mark in the second group.
+	markdownTaskPattern = regexp.MustCompile(`^([ \t]*)(?:[-*+]|\d{1,9}[.)])[ \t]+\[([ xX])\](?:[ \t]+(.*))?$`)
+	// "## Heading", with optional closing hashes.
+	markdownHeadingPattern = regexp.MustCompile(`^ {0,3}(#{1,6})(?:[ \t]+(.*?))?(?:[ \t]+#+)?[ \t]*$`)
+	// A list item that is not a task, which keeps the nesting of the tasks around it.
+	markdownListItemPattern = regexp.MustCompile(`^([ \t]*)(?:[-*+]|\d{1,9}[.)])(?:[ \t]|$)`)
+	// "```" or "~~~" opening or closing a fenced code block.
+	markdownFencePattern = regexp.MustCompile("^ {0,3}(`{3,}|~{3,})")
+	// "https://github.com/octo/app/issues/12" or ".../pull/12".
+	issueURLPattern = regexp.MustCompile(`https://github\.com/([\w.-]+/[\w.-]+)/(?:issues|pull)/(\d+)`)
+	// "`code`" spans, whose content is not parsed for references.
+	markdownCodeSpanPattern = regexp.MustCompile("`[^`]*`")
+)
+
+// MarkdownTask is a checkbox of a task list in a Markdown body.
+type MarkdownTask struct {
+	Index   int    `json:"index"`
+	Line    int    `json:"line"`
+	Checked bool   `json:"checked"`
+	Text    string `json:"text"`
+	Depth   int    `json:"depth"`
+	Section string `json:"section,omitempty"`
+	// offset is the byte offset of the checkbox mark in the body.
+	offset int
+}
+
+// MarkdownHeading is a heading of a Markdown body.
+type MarkdownHeading struct {
+	Level int    `json:"level"`
+	Text  string `json:"text"`
+	Line  int    `json:"line"`
+}
+
+// MarkdownReference is an issue or pull request a Markdown body refers to, by number or URL.
+type MarkdownReference struct {
+	Repository string `json:"repository,omitempty"`
+	Number     int    `json:"number"`
+	Closes     bool   `json:"closes,omitempty"`
+	Line       int    `json:"line"`
+}
+
+// MarkdownStructure is the structure of a Markdown body: its headings, its task list checkboxes and the issues and
+// pull requests it refers to. Content of code blocks and HTML comments is ignored.
+type MarkdownStructure struct {
+	Headings   []MarkdownHeading   `json:"headings"`
+	Tasks      []MarkdownTask      `json:"tasks"`
+	References []MarkdownReference `json:"references"`
+	Completed  int                 `json:"completed"`
+	Total      int                 `json:"total"`
+}
+
+// indentWidth is the width of the indentation of a line, counting tabs as four columns.
+func indentWidth(indent string) int {
+	return len(indent) + 3*strings.Count(indent, "\t")
+}
+
+// parseMarkdownStructure parses the headings, task list checkboxes and references of a Markdown body. Task indexes
+// start at 1, in the order the tasks appear, and line numbers start at 1.
+func parseMarkdownStructure(body string) MarkdownStructure {
+	structure := MarkdownStructure{Headings: []MarkdownHeading{}, Tasks: []MarkdownTask{}, References: []MarkdownReference{}}
+	fence := ""
+	inComment := false
+	section := ""
+	// The indentation of the enclosing list items of the current line.
+	var indents []int
+
+	offset := 0
+	for i, rawLine := range strings.SplitAfter(body, "\n") {
+		lineNumber, lineOffset := i+1, offset
+		offset += len(rawLine)
+		line := strings.TrimRight(rawLine, "\r\n")
+
+		if fence != "" {
+			if m := markdownFencePattern.FindStringSubmatch(line); m != nil && m[1][0] == fence[0] && len(m[1]) >= len(fence) && strings.TrimSpace(line[len(m[0]):]) == "" {
+				fence = ""
+			}
+			continue
+		}
+		if inComment {
+			if strings.Contains(line, "-->") {
+				inComment = false
+			}
+			continue
+		}
+		if m := markdownFencePattern.FindStringSubmatch(line); m != nil {
+			fence = m[1]
+			continue
+		}
+		if start := strings.Index(line, "<!--"); start >= 0 && !strings.Contains(line[start:], "-->") {
+			inComment = true
+			line = line[:start]
+		}
+
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		if m := markdownHeadingPattern.FindStringSubmatch(line); m != nil {
+			section = strings.TrimSpace(m[2])
+			structure.Headings = append(structure.Headings, MarkdownHeading{Level: len(m[1]), Text: section, Line: lineNumber})
+			indents = nil
+		} else if m := markdownListItemPattern.FindStringSubmatch(line); m != nil {
+			indent := indentWidth(m[1])
+			for len(indents) > 0 && indent <= indents[len(indents)-1] {
+				indents = indents[:len(indents)-1]
+			}
+			depth := len(indents)
+			indents = append(indents, indent)
+
+			if loc := markdownTaskPattern.FindStringSubmatchIndex(line); loc != nil {
+				task := MarkdownTask{
+					Index:   len(structure.Tasks) + 1,
+					Line:    lineNumber,
+					Checked: line[loc[4]] != ' ',
+					Depth:   depth,
+					Section: section,
+					offset:  lineOffset + loc[4],
+				}
+				if loc[6] >= 0 {
+					task.Text = strings.TrimSpace(line[loc[6]:loc[7]])
+				}
+				structure.Tasks = append(structure.Tasks, task)
+				structure.Total++
+				if task.Checked {
+					structure.Completed++
+				}
+			}
+		} else if indentWidth(line[:len(line)-len(strings.TrimLeft(line, " \t"))]) == 0 {
+			// An unindented paragraph ends the list.
+			indents = nil
+		}
+
+		structure.References = append(structure.References, findMarkdownReferences(line, lineNumber)...)
+	}
+	return structure
+}
+
+// findMarkdownReferences finds the issue and pull request references of a line outside code spans.
+func findMarkdownReferences(line string, lineNumber int) []MarkdownReference {
+	line = markdownCodeSpanPattern.ReplaceAllString(line, "")
+	var refs []MarkdownReference
+	for _, m := range issueURLPattern.FindAllStringSubmatch(line, -1) {
+		if n, err := strconv.Atoi(m[2]); err == nil {
+			refs = append(refs, MarkdownReference{Repository: m[1], Number: n, Line: lineNumber})
+		}
+	}
+	// URLs were reported above; their "#" fragments are not references.
+	line = issueURLPattern.ReplaceAllString(line, "")
+	for _, m := range issueReferencePattern.FindAllStringSubmatch(line, -1) {
+		if n, err := strconv.Atoi(m[3]); err == nil {
+			refs = append(refs, MarkdownReference{Repository: m[2], Number: n, Closes: m[1] != "", Line: lineNumber})
+		}
+	}
+	return refs
+}
+
+// setMarkdownTask returns the body with the checkbox of a task set, changing nothing else.
+func setMarkdownTask(body string, task MarkdownTask, checked bool) string {
+	mark := " "
+	if checked {
+		mark = "x"
+	}
+	return body[:task.offset] + mark + body[task.offset+1:]
+}
+
+// ParseMarkdownTasks creates a tool that returns the task lists, headings and references of an issue or pull
+// request body.
+func ParseMarkdownTasks(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("parse_markdown_tasks",
+			mcp.WithDescription(t("TOOL_PARSE_MARKDOWN_TASKS_DESCRIPTION", "Parse the body of an issue or pull request into its task list checkboxes, with their index, state, nesting depth and section, its headings, and the issues and pull requests it references. Content of code blocks and HTML comments is ignored. Use update_markdown_task with a task's index to check or uncheck it instead of editing the body text.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_PARSE_MARKDOWN_TASKS_USER_TITLE", "Parse issue or pull request tasks"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryOwner),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryName),
+			),
+			mcp.WithNumber("issue_number",
+				mcp.Required(),
+				mcp.Description("Number of the issue or pull request"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			issueNumber, err := RequiredInt(request, "issue_number")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			issue, resp, err := client.Issues.Get(ctx, owner, repo, issueNumber)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("failed to get issue #%d", issueNumber), resp, err), nil
+			}
+			_ = resp.Body.Close()
+
+			return MarshalledTextResult(parseMarkdownStructure(issue.GetBody())), nil
+		}
+}
+
+// UpdateMarkdownTask creates a tool that checks or unchecks one task list checkbox of an issue or pull request body.
+func UpdateMarkdownTask(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("update_markdown_task",
+			mcp.WithDescription(t("TOOL_UPDATE_MARKDOWN_TASK_DESCRIPTION", "Check or uncheck one task list checkbox of an issue or pull request body, identified by its index from parse_markdown_tasks. Only the checkbox changes; the rest of the body is written back unchanged. Pass expected_text so that the update is refused if the body was edited and the index now refers to another task.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:           t("TOOL_UPDATE_MARKDOWN_TASK_USER_TITLE", "Update issue or pull request task"),
+				ReadOnlyHint:    ToBoolPtr(false),
+				DestructiveHint: ToBoolPtr(false),
+				IdempotentHint:  ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryOwner),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryName),
+			),
+			mcp.WithNumber("issue_number",
+				mcp.Required(),
+				mcp.Description("Number of the issue or pull request"),
+			),
+			mcp.WithNumber("task_index",
+				mcp.Required(),
+				mcp.Description("Index of the task, as returned by parse_markdown_tasks"),
+				mcp.Min(1),
+			),
+			mcp.WithBoolean("checked",
+				mcp.Required(),
+				mcp.Description("Whether the checkbox should be checked"),
+			),
+			mcp.WithString("expected_text",
+				mcp.Description("Text the task must have, as returned by parse_markdown_tasks"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			issueNumber, err := RequiredInt(request, "issue_number")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			taskIndex, err := RequiredInt(request, "task_index")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			checked, err := RequiredParam[bool](request, "checked")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			expectedText, err := OptionalParam[string](request, "expected_text")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			issue, resp, err := client.Issues.Get(ctx, owner, repo, issueNumber)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("failed to get issue #%d", issueNumber), resp, err), nil
+			}
+			_ = resp.Body.Close()
+
+			body := issue.GetBody()
+			tasks := parseMarkdownStructure(body).Tasks
+			if taskIndex < 1 || taskIndex > len(tasks) {
+				return mcp.NewToolResultError(fmt.Sprintf("task %d does not exist: #%d has %d tasks", taskIndex, issueNumber, len(tasks))), nil
+			}
+			task := tasks[taskIndex-1]
+			if expectedText != "" && strings.TrimSpace(expectedText) != task.Text {
+				return mcp.NewToolResultError(fmt.Sprintf("task %d is now %q, not %q: the body was edited, parse it again", taskIndex, task.Text, expectedText)), nil
+			}
+			if task.Checked == checked {
+				return MarshalledTextResult(map[string]any{"task": task, "changed": false}), nil
+			}
+
+			_, resp, err = client.Issues.Edit(ctx, owner, repo, issueNumber, &github.IssueRequest{
+				Body: github.Ptr(setMarkdownTask(body, task, checked)),
+			})
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("failed to update #%d", issueNumber), resp, err), nil
+			}
+			_ = resp.Body.Close()
+
+			task.Checked = checked
+			return MarshalledTextResult(map[string]any{"task": task, "changed": true}), nil
+		}
+}
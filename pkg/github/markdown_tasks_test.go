@@ -0,0 +1,141 @@
+package github
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/ghmock"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const markdownTasksBody = "## Plan\r\n" +
+	"Part of octo/app#1, see https://github.com/octo/lib/issues/7#issuecomment-1.\r\n" +
+	"\r\n" +
+	"- [x] Write the parser\r\n" +
+	"  - [ ] Handle `#42` in code\r\n" +
+	"  * notes\r\n" +
+	"    1. [X] Nested under notes\r\n" +
+	"- [ ]\r\n" +
+	"\r\n" +
+	"```markdown\r\n" +
+	"- [ ] Not a task\r\n" +
+	"```\r\n" +
+	"<!-- template:\r\n" +
+	"- [ ] Not a task either\r\n" +
+	"-->\r\n" +
+	"## Done ##\r\n" +
+	"Fixes #9\r\n" +
+	"1) [ ] Release"
+
+func Test_parseMarkdownStructure(t *testing.T) {
+	structure := parseMarkdownStructure(markdownTasksBody)
+	assert.Equal(t, []MarkdownHeading{{Level: 2, Text: "Plan", Line: 1}, {Level: 2, Text: "Done", Line: 16}}, structure.Headings)
+
+	tasks := make([]MarkdownTask, len(structure.Tasks))
+	for i, task := range structure.Tasks {
+		task.offset = 0
+		tasks[i] = task
+	}
+	assert.Equal(t, []MarkdownTask{
+		{Index: 1, Line: 4, Checked: true, Text: "Write the parser", Depth: 0, Section: "Plan"},
+		{Index: 2, Line: 5, Checked: false, Text: "Handle `#42` in code", Depth: 1, Section: "Plan"},
+		{Index: 3, Line: 7, Checked: true, Text: "Nested under notes", Depth: 2, Section: "Plan"},
+		{Index: 4, Line: 8, Checked: false, Text: "", Depth: 0, Section: "Plan"},
+		{Index: 5, Line: 18, Checked: false, Text: "Release", Depth: 0, Section: "Done"},
+	}, tasks)
+	assert.Equal(t, 2, structure.Completed)
+	assert.Equal(t, 5, structure.Total)
+
+	assert.Equal(t, []MarkdownReference{
+		{Repository: "octo/lib", Number: 7, Line: 2},
+		{Repository: "octo/app", Number: 1, Line: 2},
+		{Number: 9, Closes: true, Line: 17},
+	}, structure.References)
+}
+
+func Test_setMarkdownTask(t *testing.T) {
+	tasks := parseMarkdownStructure(markdownTasksBody).Tasks
+	body := setMarkdownTask(markdownTasksBody, tasks[1], true)
+	assert.Equal(t, len(markdownTasksBody), len(body))
+	assert.Contains(t, body, "  - [x] Handle `#42` in code\r\n")
+
+	updated := parseMarkdownStructure(body)
+	assert.True(t, updated.Tasks[1].Checked)
+	assert.Equal(t, 3, updated.Completed)
+	assert.Equal(t, markdownTasksBody, setMarkdownTask(body, updated.Tasks[1], false))
+}
+
+func Test_ParseMarkdownTasks(t *testing.T) {
+	tool, _ := ParseMarkdownTasks(nil, translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+	assert.True(t, *tool.Annotations.ReadOnlyHint)
+
+	server := ghmock.New(t)
+	server.Respond("GET /repos/octo/app/issues/5", http.StatusOK, map[string]any{"number": 5, "body": markdownTasksBody})
+	_, handler := ParseMarkdownTasks(server.GetClient(), translations.NullTranslationHelper)
+
+	result := ghmock.CallTool(t, handler, map[string]any{"owner": "octo", "repo": "app", "issue_number": float64(5)})
+	require.False(t, result.IsError, ghmock.ResultText(t, result))
+	var structure MarkdownStructure
+	require.NoError(t, json.Unmarshal([]byte(ghmock.ResultText(t, result)), &structure))
+	assert.Len(t, structure.Tasks, 5)
+	assert.Equal(t, "Write the parser", structure.Tasks[0].Text)
+}
+
+func Test_UpdateMarkdownTask(t *testing.T) {
+	tool, _ := UpdateMarkdownTask(nil, translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+	assert.True(t, *tool.Annotations.IdempotentHint)
+	assert.ElementsMatch(t, []string{"owner", "repo", "issue_number", "task_index", "checked"}, tool.InputSchema.Required)
+
+	newServer := func(t *testing.T) *ghmock.Server {
+		server := ghmock.New(t)
+		server.Respond("GET /repos/octo/app/issues/5", http.StatusOK, map[string]any{"number": 5, "body": markdownTasksBody})
+		server.Respond("PATCH /repos/octo/app/issues/5", http.StatusOK, map[string]any{"number": 5})
+		return server
+	}
+	call := func(t *testing.T, server *ghmock.Server, args map[string]any) (string, bool) {
+		_, handler := UpdateMarkdownTask(server.GetClient(), translations.NullTranslationHelper)
+		args["owner"], args["repo"], args["issue_number"] = "octo", "app", float64(5)
+		result := ghmock.CallTool(t, handler, args)
+		return ghmock.ResultText(t, result), result.IsError
+	}
+
+	t.Run("checks one task", func(t *testing.T) {
+		server := newServer(t)
+		text, isError := call(t, server, map[string]any{"task_index": float64(5), "checked": true, "expected_text": "Release"})
+		require.False(t, isError, text)
+		var body struct {
+			Body string `json:"body"`
+		}
+		require.NoError(t, server.AssertRequested("PATCH /repos/octo/app/issues/5").DecodeBody(&body))
+		assert.Equal(t, markdownTasksBody[:len(markdownTasksBody)-len("[ ] Release")]+"[x] Release", body.Body)
+		assert.Contains(t, text, `"changed":true`)
+	})
+
+	t.Run("already in state", func(t *testing.T) {
+		server := newServer(t)
+		text, isError := call(t, server, map[string]any{"task_index": float64(1), "checked": true})
+		require.False(t, isError, text)
+		assert.Contains(t, text, `"changed":false`)
+		server.AssertNotRequested("PATCH")
+	})
+
+	t.Run("body was edited", func(t *testing.T) {
+		server := newServer(t)
+		text, isError := call(t, server, map[string]any{"task_index": float64(2), "checked": true, "expected_text": "Write the parser"})
+		require.True(t, isError)
+		assert.Contains(t, text, "the body was edited")
+		server.AssertNotRequested("PATCH")
+	})
+
+	t.Run("no such task", func(t *testing.T) {
+		text, isError := call(t, newServer(t), map[string]any{"task_index": float64(6), "checked": true})
+		require.True(t, isError)
+		assert.Contains(t, text, "task 6 does not exist: #5 has 5 tasks")
+	})
+}
@@ -136,7 +136,7 @@ func ListNotifications(getClient GetClientFn, t translations.TranslationHelperFu
 			}
 
 			// Marshal response to JSON
-			r, err := json.Marshal(notifications)
+			r, err := json.Marshal(NewListResponse("notifications", notifications, NewRESTPageInfo(resp), nil))
 			if err != nil {
 				return nil, fmt.Errorf("failed to marshal response: %w", err)
 			}
@@ -150,8 +150,10 @@ func DismissNotification(getclient GetClientFn, t translations.TranslationHelper
 	return mcp.NewTool("dismiss_notification",
 			mcp.WithDescription(t("TOOL_DISMISS_NOTIFICATION_DESCRIPTION", "Dismiss a notification by marking it as read or done")),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
-				Title:        t("TOOL_DISMISS_NOTIFICATION_USER_TITLE", "Dismiss notification"),
-				ReadOnlyHint: ToBoolPtr(false),
+				Title:           t("TOOL_DISMISS_NOTIFICATION_USER_TITLE", "Dismiss notification"),
+				ReadOnlyHint:    ToBoolPtr(false),
+				DestructiveHint: ToBoolPtr(false),
+				IdempotentHint:  ToBoolPtr(true),
 			}),
 			mcp.WithString("threadID",
 				mcp.Required(),
@@ -217,8 +219,10 @@ func MarkAllNotificationsRead(getClient GetClientFn, t translations.TranslationH
 	return mcp.NewTool("mark_all_notifications_read",
 			mcp.WithDescription(t("TOOL_MARK_ALL_NOTIFICATIONS_READ_DESCRIPTION", "Mark all notifications as read")),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
-				Title:        t("TOOL_MARK_ALL_NOTIFICATIONS_READ_USER_TITLE", "Mark all notifications as read"),
-				ReadOnlyHint: ToBoolPtr(false),
+				Title:           t("TOOL_MARK_ALL_NOTIFICATIONS_READ_USER_TITLE", "Mark all notifications as read"),
+				ReadOnlyHint:    ToBoolPtr(false),
+				DestructiveHint: ToBoolPtr(false),
+				IdempotentHint:  ToBoolPtr(true),
 			}),
 			mcp.WithString("lastReadAt",
 				mcp.Description("Describes the last point that notifications were checked (optional). Default: Now"),
@@ -354,8 +358,10 @@ func ManageNotificationSubscription(getClient GetClientFn, t translations.Transl
 	return mcp.NewTool("manage_notification_subscription",
 			mcp.WithDescription(t("TOOL_MANAGE_NOTIFICATION_SUBSCRIPTION_DESCRIPTION", "Manage a notification subscription: ignore, watch, or delete a notification thread subscription.")),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
-				Title:        t("TOOL_MANAGE_NOTIFICATION_SUBSCRIPTION_USER_TITLE", "Manage notification subscription"),
-				ReadOnlyHint: ToBoolPtr(false),
+				Title:           t("TOOL_MANAGE_NOTIFICATION_SUBSCRIPTION_USER_TITLE", "Manage notification subscription"),
+				ReadOnlyHint:    ToBoolPtr(false),
+				DestructiveHint: ToBoolPtr(true),
+				IdempotentHint:  ToBoolPtr(true),
 			}),
 			mcp.WithString("notificationID",
 				mcp.Required(),
@@ -439,8 +445,10 @@ func ManageRepositoryNotificationSubscription(getClient GetClientFn, t translati
 	return mcp.NewTool("manage_repository_notification_subscription",
 			mcp.WithDescription(t("TOOL_MANAGE_REPOSITORY_NOTIFICATION_SUBSCRIPTION_DESCRIPTION", "Manage a repository notification subscription: ignore, watch, or delete repository notifications subscription for the provided repository.")),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
-				Title:        t("TOOL_MANAGE_REPOSITORY_NOTIFICATION_SUBSCRIPTION_USER_TITLE", "Manage repository notification subscription"),
-				ReadOnlyHint: ToBoolPtr(false),
+				Title:           t("TOOL_MANAGE_REPOSITORY_NOTIFICATION_SUBSCRIPTION_USER_TITLE", "Manage repository notification subscription"),
+				ReadOnlyHint:    ToBoolPtr(false),
+				DestructiveHint: ToBoolPtr(true),
+				IdempotentHint:  ToBoolPtr(true),
 			}),
 			mcp.WithString("owner",
 				mcp.Required(),
@@ -140,8 +140,11 @@ func Test_ListNotifications(t *testing.T) {
 			require.False(t, result.IsError)
 			textContent := getTextResult(t, result)
 			t.Logf("textContent: %s", textContent.Text)
-			var returned []*github.Notification
-			err = json.Unmarshal([]byte(textContent.Text), &returned)
+			var response struct {
+				Notifications []*github.Notification `json:"notifications"`
+			}
+			err = json.Unmarshal([]byte(textContent.Text), &response)
+			returned := response.Notifications
 			require.NoError(t, err)
 			require.NotEmpty(t, returned)
 			assert.Equal(t, *tc.expectedResult[0].ID, *returned[0].ID)
@@ -0,0 +1,235 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v79/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// DefaultOrgRepositoriesLimit is the number of matching repositories list_org_repositories returns unless the caller
+// asks for another number, and MaxOrgRepositoriesLimit the most it returns.
+const (
+	DefaultOrgRepositoriesLimit = 1000
+	MaxOrgRepositoriesLimit     = 5000
+)
+
+// OrgRepository is a repository in the inventory of an organization's repositories.
+type OrgRepository struct {
+	MinimalRepository
+	Visibility string `json:"visibility"`
+	PushedAt   string `json:"pushed_at,omitempty"`
+}
+
+// orgRepositoryFilter selects repositories of an organization.
+type orgRepositoryFilter struct {
+	language     string
+	topic        string
+	archived     *bool
+	visibility   string
+	pushedAfter  time.Time
+	pushedBefore time.Time
+}
+
+func (f orgRepositoryFilter) matches(repo *github.Repository) bool {
+	if f.language != "" && !strings.EqualFold(repo.GetLanguage(), f.language) {
+		return false
+	}
+	if f.topic != "" && !slices.ContainsFunc(repo.Topics, func(topic string) bool { return strings.EqualFold(topic, f.topic) }) {
+		return false
+	}
+	if f.archived != nil && repo.GetArchived() != *f.archived {
+		return false
+	}
+	if f.visibility != "" && f.visibility != "all" && repo.GetVisibility() != f.visibility {
+		return false
+	}
+	pushedAt := repo.GetPushedAt().Time
+	if !f.pushedAfter.IsZero() && pushedAt.Before(f.pushedAfter) {
+		return false
+	}
+	if !f.pushedBefore.IsZero() && !pushedAt.Before(f.pushedBefore) {
+		return false
+	}
+	return true
+}
+
+func toOrgRepository(repo *github.Repository) OrgRepository {
+	result := OrgRepository{
+		MinimalRepository: MinimalRepository{
+			ID:            repo.GetID(),
+			Name:          repo.GetName(),
+			FullName:      repo.GetFullName(),
+			Description:   repo.GetDescription(),
+			HTMLURL:       repo.GetHTMLURL(),
+			Language:      repo.GetLanguage(),
+			Stars:         repo.GetStargazersCount(),
+			Forks:         repo.GetForksCount(),
+			OpenIssues:    repo.GetOpenIssuesCount(),
+			Topics:        repo.Topics,
+			Private:       repo.GetPrivate(),
+			Fork:          repo.GetFork(),
+			Archived:      repo.GetArchived(),
+			DefaultBranch: repo.GetDefaultBranch(),
+		},
+		Visibility: repo.GetVisibility(),
+	}
+	if repo.UpdatedAt != nil {
+		result.UpdatedAt = FormatTimestamp(repo.UpdatedAt.Time)
+	}
+	if repo.CreatedAt != nil {
+		result.CreatedAt = FormatTimestamp(repo.CreatedAt.Time)
+	}
+	if repo.PushedAt != nil {
+		result.PushedAt = FormatTimestamp(repo.PushedAt.Time)
+	}
+	return result
+}
+
+// ListOrgRepositories creates a tool that lists the repositories of an organization matching some filters, across
+// all pages.
+func ListOrgRepositories(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_org_repositories",
+			mcp.WithDescription(t("TOOL_LIST_ORG_REPOSITORIES_DESCRIPTION", `List the repositories of an organization matching filters, across all pages, most recently pushed first. Use it to select a fleet of repositories, e.g. "all active Go services", to pass to other tools. All filters are optional and combine with AND.`)),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_LIST_ORG_REPOSITORIES_USER_TITLE", "List organization repositories"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("org",
+				mcp.Required(),
+				mcp.Description("Organization name"),
+			),
+			mcp.WithString("language",
+				mcp.Description("Only repositories whose primary language is this, e.g. 'Go'. Not case sensitive."),
+			),
+			mcp.WithString("topic",
+				mcp.Description("Only repositories with this topic"),
+			),
+			mcp.WithBoolean("archived",
+				mcp.Description("Only archived repositories if true, only unarchived repositories if false. Omit to include both."),
+			),
+			mcp.WithString("visibility",
+				mcp.Description("Only repositories with this visibility"),
+				mcp.Enum("all", "public", "private", "internal"),
+				mcp.DefaultString("all"),
+			),
+			mcp.WithString("pushed_after",
+				mcp.Description("Only repositories last pushed to at or after this time (ISO 8601, e.g. 2025-01-15 or 2025-01-15T10:00:00Z)"),
+			),
+			mcp.WithString("pushed_before",
+				mcp.Description("Only repositories last pushed to before this time (ISO 8601)"),
+			),
+			mcp.WithNumber("max_results",
+				mcp.Description("Maximum number of matching repositories to return"),
+				mcp.Min(1),
+				mcp.Max(MaxOrgRepositoriesLimit),
+				mcp.DefaultNumber(DefaultOrgRepositoriesLimit),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			org, err := RequiredParam[string](request, "org")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			var filter orgRepositoryFilter
+			filter.language, err = OptionalParam[string](request, "language")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			filter.topic, err = OptionalParam[string](request, "topic")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if _, ok := request.GetArguments()["archived"]; ok {
+				archived, err := OptionalParam[bool](request, "archived")
+				if err != nil {
+					return mcp.NewToolResultError(err.Error()), nil
+				}
+				filter.archived = &archived
+			}
+			filter.visibility, err = OptionalParam[string](request, "visibility")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			for _, param := range []struct {
+				name string
+				dst  *time.Time
+			}{{"pushed_after", &filter.pushedAfter}, {"pushed_before", &filter.pushedBefore}} {
+				value, err := OptionalParam[string](request, param.name)
+				if err != nil {
+					return mcp.NewToolResultError(err.Error()), nil
+				}
+				if value == "" {
+					continue
+				}
+				if *param.dst, err = parseISOTimestamp(value); err != nil {
+					return mcp.NewToolResultError(fmt.Sprintf("invalid %s: %s", param.name, err)), nil
+				}
+			}
+			maxResults, err := OptionalIntParamWithDefault(request, "max_results", DefaultOrgRepositoriesLimit)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			maxResults = min(maxResults, MaxOrgRepositoriesLimit)
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			// Newest pushes come first, so once a page reaches past pushed_after no later page can match.
+			opts := &github.RepositoryListByOrgOptions{
+				Type:        "all",
+				Sort:        "pushed",
+				Direction:   "desc",
+				ListOptions: github.ListOptions{PerPage: 100},
+			}
+			repos := []OrgRepository{}
+			scanned := 0
+			truncated := false
+		pages:
+			for {
+				page, resp, err := client.Repositories.ListByOrg(ctx, org, opts)
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx,
+						fmt.Sprintf("failed to list repositories for '%s'", org),
+						resp,
+						err,
+					), nil
+				}
+				_ = resp.Body.Close()
+
+				for _, repo := range page {
+					if !filter.pushedAfter.IsZero() && repo.GetPushedAt().Before(filter.pushedAfter) {
+						break pages
+					}
+					scanned++
+					if !filter.matches(repo) {
+						continue
+					}
+					if len(repos) == maxResults {
+						truncated = true
+						break pages
+					}
+					repos = append(repos, toOrgRepository(repo))
+				}
+				if resp.NextPage == 0 {
+					break
+				}
+				opts.Page = resp.NextPage
+			}
+
+			// The pages are walked here, so the page info only tells whether max_results cut the list short.
+			response := NewListResponse("repositories", repos, PageInfo{HasNextPage: truncated}, nil)
+			response["scanned"] = scanned
+			response["truncated"] = truncated
+			return MarshalledTextResult(response), nil
+		}
+}
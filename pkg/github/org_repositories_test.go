@@ -0,0 +1,117 @@
+package github
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/ghmock"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ListOrgRepositories(t *testing.T) {
+	tool, _ := ListOrgRepositories(nil, translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+	assert.True(t, *tool.Annotations.ReadOnlyHint)
+	assert.Equal(t, []string{"org"}, tool.InputSchema.Required)
+
+	// The organization has two pages of repositories, most recently pushed first.
+	pages := [][]map[string]any{
+		{
+			{"name": "api", "full_name": "octo/api", "language": "Go", "topics": []string{"service"}, "visibility": "private", "pushed_at": "2025-06-01T00:00:00Z"},
+			{"name": "web", "full_name": "octo/web", "language": "TypeScript", "topics": []string{"service"}, "visibility": "public", "pushed_at": "2025-05-01T00:00:00Z"},
+		},
+		{
+			{"name": "old", "full_name": "octo/old", "language": "Go", "archived": true, "visibility": "internal", "pushed_at": "2024-01-01T00:00:00Z"},
+			{"name": "tool", "full_name": "octo/tool", "language": "go", "topics": []string{"Service"}, "visibility": "public", "pushed_at": "2023-01-01T00:00:00Z"},
+		},
+	}
+	newServer := func(t *testing.T) *ghmock.Server {
+		server := ghmock.New(t)
+		server.HandleFunc("GET /orgs/octo/repos", func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "pushed", r.URL.Query().Get("sort"))
+			assert.Equal(t, "desc", r.URL.Query().Get("direction"))
+			page := 0
+			if r.URL.Query().Get("page") == "2" {
+				page = 1
+			} else {
+				w.Header().Set("Link", fmt.Sprintf(`<%s/orgs/octo/repos?page=2>; rel="next"`, server.URL()))
+			}
+			_, _ = w.Write(mock.MustMarshal(pages[page]))
+		})
+		return server
+	}
+	type response struct {
+		Repositories []OrgRepository `json:"repositories"`
+		PageInfo     PageInfo        `json:"page_info"`
+		Scanned      int             `json:"scanned"`
+		Truncated    bool            `json:"truncated"`
+	}
+	call := func(t *testing.T, args map[string]any) (response, *ghmock.Server) {
+		server := newServer(t)
+		_, handler := ListOrgRepositories(server.GetClient(), translations.NullTranslationHelper)
+		args["org"] = "octo"
+		result := ghmock.CallTool(t, handler, args)
+		require.False(t, result.IsError, ghmock.ResultText(t, result))
+		var resp response
+		require.NoError(t, json.Unmarshal([]byte(ghmock.ResultText(t, result)), &resp))
+		return resp, server
+	}
+	names := func(repos []OrgRepository) []string {
+		var names []string
+		for _, repo := range repos {
+			names = append(names, repo.Name)
+		}
+		return names
+	}
+
+	tests := []struct {
+		name     string
+		args     map[string]any
+		expected []string
+	}{
+		{"no filters", map[string]any{}, []string{"api", "web", "old", "tool"}},
+		{"language", map[string]any{"language": "go"}, []string{"api", "old", "tool"}},
+		{"topic", map[string]any{"topic": "service"}, []string{"api", "web", "tool"}},
+		{"archived", map[string]any{"archived": true}, []string{"old"}},
+		{"unarchived", map[string]any{"archived": false, "language": "Go"}, []string{"api", "tool"}},
+		{"visibility", map[string]any{"visibility": "public"}, []string{"web", "tool"}},
+		{"pushed_before", map[string]any{"pushed_before": "2025-05-01"}, []string{"old", "tool"}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			resp, _ := call(t, tc.args)
+			assert.Equal(t, tc.expected, names(resp.Repositories))
+			assert.False(t, resp.PageInfo.HasNextPage)
+			assert.Equal(t, 4, resp.Scanned)
+			assert.False(t, resp.Truncated)
+		})
+	}
+
+	t.Run("pushed_after stops paging", func(t *testing.T) {
+		resp, server := call(t, map[string]any{"pushed_after": "2025-05-15"})
+		assert.Equal(t, []string{"api"}, names(resp.Repositories))
+		assert.Equal(t, "2025-06-01T00:00:00Z", resp.Repositories[0].PushedAt)
+		assert.Len(t, server.Requests(), 1)
+	})
+
+	t.Run("max_results", func(t *testing.T) {
+		resp, server := call(t, map[string]any{"max_results": float64(1)})
+		assert.Equal(t, []string{"api"}, names(resp.Repositories))
+		assert.True(t, resp.Truncated)
+		assert.True(t, resp.PageInfo.HasNextPage)
+		assert.Len(t, server.Requests(), 1)
+	})
+
+	t.Run("invalid timestamp", func(t *testing.T) {
+		_, handler := ListOrgRepositories(ghmock.New(t).GetClient(), translations.NullTranslationHelper)
+		result := ghmock.CallTool(t, handler, map[string]any{"org": "octo", "pushed_after": "last week"})
+		require.True(t, result.IsError)
+		assert.Contains(t, ghmock.ResultText(t, result), "invalid pushed_after")
+	})
+}
@@ -0,0 +1,158 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v79/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// MinimalContainerTag is the trimmed output type for a published container image version.
+type MinimalContainerTag struct {
+	VersionID  int64    `json:"version_id"`
+	Digest     string   `json:"digest"`
+	Tags       []string `json:"tags"`
+	SizeBytes  int64    `json:"size_bytes,omitempty"`
+	CreatedAt  string   `json:"created_at,omitempty"`
+	LastPushAt string   `json:"last_push_at,omitempty"`
+	HTMLURL    string   `json:"html_url,omitempty"`
+}
+
+// ListContainerTags creates a tool to list the tagged versions of a GHCR container image.
+func ListContainerTags(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_container_tags",
+			mcp.WithDescription(t("TOOL_LIST_CONTAINER_TAGS_DESCRIPTION", "List the published versions of a GitHub Container Registry (ghcr.io) image, including tags, digests, sizes (when reported by the registry) and last push times. Use this to verify what is published before rolling out a deployment.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_LIST_CONTAINER_TAGS_USER_TITLE", "List container image tags"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner_type",
+				mcp.Required(),
+				mcp.Description("Owner type"),
+				mcp.Enum("user", "org"),
+			),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("If owner_type == user it is the handle for the GitHub user account. If owner_type == org it is the name of the organization. The name is not case sensitive."),
+			),
+			mcp.WithString("package_name",
+				mcp.Required(),
+				mcp.Description("The name of the container image, without the ghcr.io/<owner>/ prefix. Give nested image names as they are, e.g. team/app; they are encoded for the API."),
+			),
+			mcp.WithBoolean("tagged_only",
+				mcp.Description("Only return versions that have at least one tag. Defaults to true."),
+				mcp.DefaultBool(true),
+			),
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			ownerType, err := RequiredParam[string](request, "owner_type")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			packageName, err := RequiredParam[string](request, "package_name")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			taggedOnly, err := OptionalBoolParamWithDefault(request, "tagged_only", true)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			opts := &github.PackageListOptions{
+				State: github.Ptr("active"),
+				ListOptions: github.ListOptions{
+					Page:    pagination.Page,
+					PerPage: pagination.PerPage,
+				},
+			}
+
+			var versions []*github.PackageVersion
+			var resp *github.Response
+			if ownerType == "org" {
+				versions, resp, err = client.Organizations.PackageGetAllVersions(ctx, owner, "container", packageName, opts)
+			} else {
+				versions, resp, err = client.Users.PackageGetAllVersions(ctx, owner, "container", packageName, opts)
+			}
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to list versions for container '%s'", packageName),
+					resp,
+					err,
+				), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to list container versions: %s", string(body))), nil
+			}
+
+			tags := make([]MinimalContainerTag, 0, len(versions))
+			for _, version := range versions {
+				tag := convertToMinimalContainerTag(version)
+				if taggedOnly && len(tag.Tags) == 0 {
+					continue
+				}
+				tags = append(tags, tag)
+			}
+
+			response := NewListResponse("tags", tags, NewRESTPageInfo(resp), nil)
+			response["package"] = packageName
+			response["image"] = fmt.Sprintf("ghcr.io/%s/%s", owner, packageName)
+
+			r, err := json.Marshal(response)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// convertToMinimalContainerTag flattens a container package version. For container
+// packages the version name is the manifest digest, and the tags live in the metadata.
+func convertToMinimalContainerTag(version *github.PackageVersion) MinimalContainerTag {
+	tag := MinimalContainerTag{
+		VersionID: version.GetID(),
+		Digest:    version.GetName(),
+		Tags:      []string{},
+		HTMLURL:   version.GetHTMLURL(),
+	}
+	if metadata, ok := version.GetMetadata(); ok && metadata.Container != nil {
+		tag.Tags = metadata.Container.Tags
+	}
+	for _, file := range version.PackageFiles {
+		tag.SizeBytes += file.GetSize()
+	}
+	if version.CreatedAt != nil {
+		tag.CreatedAt = FormatTimestamp(version.CreatedAt.Time)
+	}
+	if version.UpdatedAt != nil {
+		tag.LastPushAt = FormatTimestamp(version.UpdatedAt.Time)
+	}
+	return tag
+}
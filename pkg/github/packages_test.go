@@ -0,0 +1,199 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/ghmock"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v79/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ListContainerTags(t *testing.T) {
+	// Verify tool definition once
+	mockClient := github.NewClient(nil)
+	tool, _ := ListContainerTags(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "list_container_tags", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner_type")
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "package_name")
+	assert.Contains(t, tool.InputSchema.Properties, "tagged_only")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner_type", "owner", "package_name"})
+
+	pushedAt := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	mockVersions := []*github.PackageVersion{
+		{
+			ID:        github.Ptr(int64(101)),
+			Name:      github.Ptr("sha256:aaa"),
+			HTMLURL:   github.Ptr("https://github.com/orgs/octo-org/packages/container/app/101"),
+			CreatedAt: &github.Timestamp{Time: pushedAt},
+			UpdatedAt: &github.Timestamp{Time: pushedAt},
+			Metadata:  json.RawMessage(`{"package_type":"container","container":{"tags":["v1.2.0","latest"]}}`),
+		},
+		{
+			ID:        github.Ptr(int64(100)),
+			Name:      github.Ptr("sha256:bbb"),
+			CreatedAt: &github.Timestamp{Time: pushedAt.Add(-time.Hour)},
+			UpdatedAt: &github.Timestamp{Time: pushedAt.Add(-time.Hour)},
+			Metadata:  json.RawMessage(`{"package_type":"container","container":{"tags":[]}}`),
+		},
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedTags   []MinimalContainerTag
+		expectedErrMsg string
+	}{
+		{
+			name: "org image skips untagged versions by default",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetOrgsPackagesVersionsByOrgByPackageTypeByPackageName,
+					expectQueryParams(t, map[string]string{
+						"state":    "active",
+						"page":     "1",
+						"per_page": "30",
+					}).andThen(
+						mockResponse(t, http.StatusOK, mockVersions),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner_type":   "org",
+				"owner":        "octo-org",
+				"package_name": "app",
+			},
+			expectedTags: []MinimalContainerTag{
+				{
+					VersionID:  101,
+					Digest:     "sha256:aaa",
+					Tags:       []string{"v1.2.0", "latest"},
+					CreatedAt:  "2025-03-01T12:00:00Z",
+					LastPushAt: "2025-03-01T12:00:00Z",
+					HTMLURL:    "https://github.com/orgs/octo-org/packages/container/app/101",
+				},
+			},
+		},
+		{
+			name: "user image including untagged versions",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.GetUsersPackagesVersionsByUsernameByPackageTypeByPackageName,
+					mockVersions,
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner_type":   "user",
+				"owner":        "octocat",
+				"package_name": "app",
+				"tagged_only":  false,
+			},
+			expectedTags: []MinimalContainerTag{
+				{
+					VersionID:  101,
+					Digest:     "sha256:aaa",
+					Tags:       []string{"v1.2.0", "latest"},
+					CreatedAt:  "2025-03-01T12:00:00Z",
+					LastPushAt: "2025-03-01T12:00:00Z",
+					HTMLURL:    "https://github.com/orgs/octo-org/packages/container/app/101",
+				},
+				{
+					VersionID:  100,
+					Digest:     "sha256:bbb",
+					Tags:       []string{},
+					CreatedAt:  "2025-03-01T11:00:00Z",
+					LastPushAt: "2025-03-01T11:00:00Z",
+				},
+			},
+		},
+		{
+			name: "package not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetOrgsPackagesVersionsByOrgByPackageTypeByPackageName,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusNotFound)
+						_, _ = w.Write([]byte(`{"message": "Package not found."}`))
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner_type":   "org",
+				"owner":        "octo-org",
+				"package_name": "missing",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to list versions for container 'missing'",
+		},
+		{
+			name:         "missing package name",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner_type": "org",
+				"owner":      "octo-org",
+			},
+			expectError:    true,
+			expectedErrMsg: "missing required parameter: package_name",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := ListContainerTags(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			request := createMCPRequest(tc.requestArgs)
+			result, err := handler(context.Background(), request)
+			require.NoError(t, err)
+
+			if tc.expectError {
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			require.False(t, result.IsError)
+			textContent := getTextResult(t, result)
+
+			var response struct {
+				Package string                `json:"package"`
+				Image   string                `json:"image"`
+				Tags    []MinimalContainerTag `json:"tags"`
+			}
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &response))
+			assert.Equal(t, "app", response.Package)
+			assert.Equal(t, tc.expectedTags, response.Tags)
+		})
+	}
+}
+
+func Test_ListContainerTags_NestedName(t *testing.T) {
+	server := ghmock.New(t)
+	server.HandleFunc("GET /orgs/octo-org/packages/container/{name}/versions", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "team/app", r.PathValue("name"))
+		assert.Equal(t, "/orgs/octo-org/packages/container/team%2Fapp/versions", r.URL.EscapedPath())
+		_, _ = w.Write(mock.MustMarshal([]*github.PackageVersion{{
+			ID:       github.Ptr(int64(101)),
+			Name:     github.Ptr("sha256:aaa"),
+			Metadata: json.RawMessage(`{"package_type":"container","container":{"tags":["v1"]}}`),
+		}}))
+	})
+
+	_, handler := ListContainerTags(server.GetClient(), translations.NullTranslationHelper)
+	result := ghmock.CallTool(t, handler, map[string]any{"owner_type": "org", "owner": "octo-org", "package_name": "team/app"})
+	require.False(t, result.IsError, ghmock.ResultText(t, result))
+	assert.Contains(t, ghmock.ResultText(t, result), `"v1"`)
+}
@@ -0,0 +1,190 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/google/go-github/v79/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/shurcooL/githubv4"
+)
+
+// DescriptionPathPrefix describes the path_prefix parameter of the issue and pull request listing and search tools.
+const DescriptionPathPrefix = "Only return pull requests that change files in this directory of the repository, e.g. 'services/auth', and issues that a pull request linked to close them does. The filter applies to each page of results, so a page can hold fewer items than perPage."
+
+// maxLinkedPullRequests caps the number of pull requests linked to an issue that path_prefix looks at.
+const maxLinkedPullRequests = 10
+
+// linkedPullRequestsQuery gets the pull requests linked to close an issue, through a closing keyword or the
+// Development sidebar.
+type linkedPullRequestsQuery struct {
+	Repository struct {
+		Issue struct {
+			ClosedByPullRequestsReferences struct {
+				Nodes []struct {
+					Number     githubv4.Int
+					Repository struct {
+						Name  githubv4.String
+						Owner struct {
+							Login githubv4.String
+						}
+					}
+				}
+			} `graphql:"closedByPullRequestsReferences(first: $first, includeClosedPrs: true)"`
+		} `graphql:"issue(number: $number)"`
+	} `graphql:"repository(owner: $owner, name: $repo)"`
+}
+
+// normalizePathPrefix turns a directory given as 'services/auth/', '/services/auth' or './services/auth' into
+// 'services/auth'.
+func normalizePathPrefix(prefix string) string {
+	return strings.Trim(strings.TrimPrefix(prefix, "./"), "/")
+}
+
+// pathHasPrefix reports whether a file path is prefix or lies below it, so that 'services/auth' matches
+// 'services/auth/main.go' but not 'services/authz/main.go'.
+func pathHasPrefix(path, prefix string) bool {
+	return prefix == "" || path == prefix || strings.HasPrefix(path, prefix+"/")
+}
+
+// repositoryFromAPIURL extracts owner and repo from the repository_url of a search result,
+// https://api.github.com/repos/OWNER/REPO.
+func repositoryFromAPIURL(repositoryURL string) (owner, repo string, ok bool) {
+	u, err := url.Parse(repositoryURL)
+	if err != nil {
+		return "", "", false
+	}
+	_, rest, found := strings.Cut(u.Path, "/repos/")
+	if !found {
+		return "", "", false
+	}
+	owner, repo, found = strings.Cut(rest, "/")
+	if !found || owner == "" || repo == "" || strings.Contains(repo, "/") {
+		return "", "", false
+	}
+	return owner, repo, true
+}
+
+// pullRequestTouchesPath reports whether a pull request changes, adds, removes or renames a file at or below prefix.
+func pullRequestTouchesPath(ctx context.Context, client *github.Client, owner, repo string, number int, prefix string) (bool, *github.Response, error) {
+	opts := &github.ListOptions{PerPage: 100}
+	for {
+		files, resp, err := client.PullRequests.ListFiles(ctx, owner, repo, number, opts)
+		if err != nil {
+			return false, resp, err
+		}
+		_ = resp.Body.Close()
+		for _, file := range files {
+			if pathHasPrefix(file.GetFilename(), prefix) || (file.GetPreviousFilename() != "" && pathHasPrefix(file.GetPreviousFilename(), prefix)) {
+				return true, resp, nil
+			}
+		}
+		if resp.NextPage == 0 {
+			return false, resp, nil
+		}
+		opts.Page = resp.NextPage
+	}
+}
+
+// filterPullRequestsByPath keeps the pull requests of owner/repo that touch prefix. On failure it returns the error
+// result to hand back to the caller.
+func filterPullRequestsByPath(ctx context.Context, client *github.Client, owner, repo string, prs []*github.PullRequest, prefix string) ([]*github.PullRequest, *mcp.CallToolResult) {
+	filtered := []*github.PullRequest{}
+	for _, pr := range prs {
+		touches, resp, err := pullRequestTouchesPath(ctx, client, owner, repo, pr.GetNumber(), prefix)
+		if err != nil {
+			return nil, ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("failed to list files of pull request #%d", pr.GetNumber()), resp, err)
+		}
+		if touches {
+			filtered = append(filtered, pr)
+		}
+	}
+	return filtered, nil
+}
+
+// issueTouchesPath reports whether a pull request linked to close an issue touches prefix. On failure it returns the
+// error result to hand back to the caller.
+func issueTouchesPath(ctx context.Context, client *github.Client, gqlClient *githubv4.Client, owner, repo string, number int, prefix string) (bool, *mcp.CallToolResult) {
+	var query linkedPullRequestsQuery
+	vars := map[string]any{
+		"owner":  githubv4.String(owner),
+		"repo":   githubv4.String(repo),
+		"number": githubv4.Int(number), // #nosec G115 - issue numbers are always small positive integers
+		"first":  githubv4.Int(maxLinkedPullRequests),
+	}
+	if err := gqlClient.Query(ctx, &query, vars); err != nil {
+		return false, ghErrors.NewGitHubGraphQLErrorResponse(ctx, fmt.Sprintf("failed to get pull requests linked to issue #%d", number), err)
+	}
+	for _, pr := range query.Repository.Issue.ClosedByPullRequestsReferences.Nodes {
+		prOwner, prRepo := string(pr.Repository.Owner.Login), string(pr.Repository.Name)
+		touches, resp, err := pullRequestTouchesPath(ctx, client, prOwner, prRepo, int(pr.Number), prefix)
+		if err != nil {
+			return false, ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("failed to list files of pull request %s/%s#%d", prOwner, prRepo, pr.Number), resp, err)
+		}
+		if touches {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// filterIssuesByPath keeps the issues of owner/repo that a pull request touching prefix is linked to close. On failure
+// it returns the error result to hand back to the caller.
+func filterIssuesByPath(ctx context.Context, client *github.Client, gqlClient *githubv4.Client, owner, repo string, issues []*github.Issue, prefix string) ([]*github.Issue, *mcp.CallToolResult) {
+	filtered := []*github.Issue{}
+	for _, issue := range issues {
+		touches, errResult := issueTouchesPath(ctx, client, gqlClient, owner, repo, issue.GetNumber(), prefix)
+		if errResult != nil {
+			return nil, errResult
+		}
+		if touches {
+			filtered = append(filtered, issue)
+		}
+	}
+	return filtered, nil
+}
+
+// filterSearchResultsByPath keeps the pull requests of a search that touch prefix and the issues that a pull request
+// touching prefix is linked to close. getGQLClient is only used when the results include issues. On failure it
+// returns the error result to hand back to the caller.
+func filterSearchResultsByPath(ctx context.Context, client *github.Client, getGQLClient GetGQLClientFn, items []*github.Issue, prefix string) ([]*github.Issue, *mcp.CallToolResult) {
+	filtered := []*github.Issue{}
+	var gqlClient *githubv4.Client
+	for _, item := range items {
+		owner, repo, ok := repositoryFromAPIURL(item.GetRepositoryURL())
+		if !ok {
+			return nil, mcp.NewToolResultError(fmt.Sprintf("failed to filter by path: unexpected repository URL '%s'", item.GetRepositoryURL()))
+		}
+
+		var touches bool
+		if item.IsPullRequest() {
+			var resp *github.Response
+			var err error
+			touches, resp, err = pullRequestTouchesPath(ctx, client, owner, repo, item.GetNumber(), prefix)
+			if err != nil {
+				return nil, ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("failed to list files of pull request %s/%s#%d", owner, repo, item.GetNumber()), resp, err)
+			}
+		} else {
+			if getGQLClient == nil {
+				return nil, mcp.NewToolResultError("failed to filter by path: issues are not supported here")
+			}
+			if gqlClient == nil {
+				var err error
+				if gqlClient, err = getGQLClient(ctx); err != nil {
+					return nil, mcp.NewToolResultError(fmt.Sprintf("failed to get GitHub GQL client: %v", err))
+				}
+			}
+			var errResult *mcp.CallToolResult
+			if touches, errResult = issueTouchesPath(ctx, client, gqlClient, owner, repo, item.GetNumber(), prefix); errResult != nil {
+				return nil, errResult
+			}
+		}
+		if touches {
+			filtered = append(filtered, item)
+		}
+	}
+	return filtered, nil
+}
@@ -0,0 +1,170 @@
+package github
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/pkg/ghmock"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_pathHasPrefix(t *testing.T) {
+	assert.True(t, pathHasPrefix("services/auth/main.go", "services/auth"))
+	assert.True(t, pathHasPrefix("services/auth", "services/auth"))
+	assert.False(t, pathHasPrefix("services/authz/main.go", "services/auth"))
+	assert.False(t, pathHasPrefix("docs/services/auth/README.md", "services/auth"))
+	assert.Equal(t, "services/auth", normalizePathPrefix("./services/auth/"))
+	assert.Equal(t, "services/auth", normalizePathPrefix("/services/auth"))
+}
+
+// respondPullRequestFiles serves the files of pull requests of octo/app, keyed by number.
+func respondPullRequestFiles(server *ghmock.Server, files map[int][]string) {
+	server.HandleFunc("GET /repos/octo/app/pulls/{number}/files", func(w http.ResponseWriter, r *http.Request) {
+		var number int
+		_, _ = fmt.Sscan(r.PathValue("number"), &number)
+		var body []map[string]any
+		for _, name := range files[number] {
+			body = append(body, map[string]any{"filename": name, "status": "modified"})
+		}
+		_ = json.NewEncoder(w).Encode(body)
+	})
+}
+
+// respondLinkedPullRequests serves the pull requests of octo/app linked to close issues of octo/app, keyed by issue
+// number.
+func respondLinkedPullRequests(server *ghmock.Server, links map[int][]int) {
+	server.HandleGraphQL("closedByPullRequestsReferences(", func(_ string, vars map[string]any) (any, []string) {
+		var nodes []map[string]any
+		for _, number := range links[int(vars["number"].(float64))] {
+			nodes = append(nodes, map[string]any{
+				"number":     number,
+				"repository": map[string]any{"name": "app", "owner": map[string]any{"login": "octo"}},
+			})
+		}
+		return map[string]any{"repository": map[string]any{"issue": map[string]any{
+			"closedByPullRequestsReferences": map[string]any{"nodes": nodes},
+		}}}, nil
+	})
+}
+
+func Test_ListPullRequests_PathPrefix(t *testing.T) {
+	server := ghmock.New(t)
+	server.Respond("GET /repos/octo/app/pulls", http.StatusOK, []map[string]any{
+		{"number": 1, "title": "Auth change"},
+		{"number": 2, "title": "Billing change"},
+		{"number": 3, "title": "Auth move"},
+	})
+	respondPullRequestFiles(server, map[int][]string{
+		1: {"services/auth/login.go"},
+		2: {"services/billing/invoice.go", "services/authz/policy.go"},
+	})
+	server.Respond("GET /repos/octo/app/pulls/3/files", http.StatusOK, []map[string]any{
+		{"filename": "libs/session.go", "previous_filename": "services/auth/session.go", "status": "renamed"},
+	})
+	_, handler := ListPullRequests(server.GetClient(), translations.NullTranslationHelper)
+
+	result := ghmock.CallTool(t, handler, map[string]any{"owner": "octo", "repo": "app", "path_prefix": "services/auth/"})
+	require.False(t, result.IsError, ghmock.ResultText(t, result))
+	var resp struct {
+		PullRequests []struct {
+			Number int `json:"number"`
+		} `json:"pull_requests"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(ghmock.ResultText(t, result)), &resp))
+	require.Len(t, resp.PullRequests, 2)
+	assert.Equal(t, 1, resp.PullRequests[0].Number)
+	assert.Equal(t, 3, resp.PullRequests[1].Number)
+}
+
+func Test_ListIssues_PathPrefix(t *testing.T) {
+	server := ghmock.New(t)
+	server.RespondGraphQL("issues(", map[string]any{"repository": map[string]any{"issues": map[string]any{
+		"nodes": []map[string]any{
+			{"number": 10, "title": "Login broken", "state": "OPEN"},
+			{"number": 11, "title": "Invoice rounding", "state": "OPEN"},
+			{"number": 12, "title": "No fix yet", "state": "OPEN"},
+		},
+		"totalCount": 3,
+	}}})
+	respondLinkedPullRequests(server, map[int][]int{10: {1}, 11: {2}})
+	respondPullRequestFiles(server, map[int][]string{
+		1: {"services/auth/login.go"},
+		2: {"services/billing/invoice.go"},
+	})
+	_, handler := ListIssues(server.GetClient(), server.GetGQLClient(), translations.NullTranslationHelper)
+
+	result := ghmock.CallTool(t, handler, map[string]any{"owner": "octo", "repo": "app", "path_prefix": "services/auth"})
+	require.False(t, result.IsError, ghmock.ResultText(t, result))
+	var resp struct {
+		Issues []struct {
+			Number int `json:"number"`
+		} `json:"issues"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(ghmock.ResultText(t, result)), &resp))
+	require.Len(t, resp.Issues, 1)
+	assert.Equal(t, 10, resp.Issues[0].Number)
+}
+
+func Test_SearchIssues_PathPrefix(t *testing.T) {
+	server := ghmock.New(t)
+	repositoryURL := "https://api.github.com/repos/octo/app"
+	server.Respond("GET /search/issues", http.StatusOK, map[string]any{
+		"total_count": 2,
+		"items": []map[string]any{
+			{"number": 10, "title": "Login broken", "repository_url": repositoryURL},
+			{"number": 11, "title": "Invoice rounding", "repository_url": repositoryURL},
+		},
+	})
+	respondLinkedPullRequests(server, map[int][]int{10: {1}, 11: {2}})
+	respondPullRequestFiles(server, map[int][]string{
+		1: {"services/auth/login.go"},
+		2: {"services/billing/invoice.go"},
+	})
+	_, handler := SearchIssues(server.GetClient(), server.GetGQLClient(), translations.NullTranslationHelper)
+
+	result := ghmock.CallTool(t, handler, map[string]any{"query": "repo:octo/app is:open", "path_prefix": "services/billing"})
+	require.False(t, result.IsError, ghmock.ResultText(t, result))
+	var resp struct {
+		Items []struct {
+			Number int `json:"number"`
+		} `json:"items"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(ghmock.ResultText(t, result)), &resp))
+	require.Len(t, resp.Items, 1)
+	assert.Equal(t, 11, resp.Items[0].Number)
+}
+
+func Test_SearchPullRequests_PathPrefix(t *testing.T) {
+	server := ghmock.New(t)
+	repositoryURL := "https://api.github.com/repos/octo/app"
+	server.Respond("GET /search/issues", http.StatusOK, map[string]any{
+		"total_count": 2,
+		"items": []map[string]any{
+			{"number": 1, "repository_url": repositoryURL, "pull_request": map[string]any{"url": repositoryURL + "/pulls/1"}},
+			{"number": 2, "repository_url": repositoryURL, "pull_request": map[string]any{"url": repositoryURL + "/pulls/2"}},
+		},
+	})
+	respondPullRequestFiles(server, map[int][]string{
+		1: {"services/auth/login.go"},
+		2: {"services/billing/invoice.go"},
+	})
+	_, handler := SearchPullRequests(server.GetClient(), translations.NullTranslationHelper)
+
+	result := ghmock.CallTool(t, handler, map[string]any{"query": "repo:octo/app", "path_prefix": "services/auth"})
+	require.False(t, result.IsError, ghmock.ResultText(t, result))
+	var resp struct {
+		Items []struct {
+			Number int `json:"number"`
+		} `json:"items"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(ghmock.ResultText(t, result)), &resp))
+	require.Len(t, resp.Items, 1)
+	assert.Equal(t, 1, resp.Items[0].Number)
+	for _, request := range server.Requests() {
+		assert.False(t, request.IsGraphQL(), "pull requests need no GraphQL lookup")
+	}
+}
@@ -0,0 +1,308 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"path"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+const (
+	// DefaultPRContextPatchChars is the number of characters of patches get_pr_context includes unless the caller
+	// gives another budget.
+	DefaultPRContextPatchChars = 20000
+	// maxPRContextLinkedIssues caps the number of referenced issues get_pr_context looks up.
+	maxPRContextLinkedIssues = 10
+	// maxPRContextCommitMessage caps the length of each commit message in get_pr_context.
+	maxPRContextCommitMessage = 2000
+)
+
+var (
+	// "#123", "fixes #123" or "octo/app#123" in commit messages.
+	issueReferencePattern = regexp.MustCompile(`(?i)(?:\b(close[sd]?|fix(?:e[sd])?|resolve[sd]?)\s*:?\s+)?(?:([\w.-]+/[\w.-]+))?#(\d+)\b`)
+	// "123-login-crash" or "fix/123-login-crash" as a branch name.
+	branchIssuePattern = regexp.MustCompile(`(?:^|/)(\d+)[-_]`)
+)
+
+// PRContextCommit is a commit of the compared range.
+type PRContextCommit struct {
+	SHA     string `json:"sha"`
+	Author  string `json:"author,omitempty"`
+	Message string `json:"message"`
+}
+
+// PRContextIssue is an issue or pull request referenced by the commits or the head branch.
+type PRContextIssue struct {
+	Number        int    `json:"number"`
+	Title         string `json:"title"`
+	State         string `json:"state"`
+	URL           string `json:"url"`
+	IsPullRequest bool   `json:"is_pull_request,omitempty"`
+	Closes        bool   `json:"closes,omitempty"`
+}
+
+// PRContextFile is a file changed in the compared range.
+type PRContextFile struct {
+	Filename         string `json:"filename"`
+	PreviousFilename string `json:"previous_filename,omitempty"`
+	Status           string `json:"status"`
+	Additions        int    `json:"additions"`
+	Deletions        int    `json:"deletions"`
+	Patch            string `json:"patch,omitempty"`
+	PatchOmitted     bool   `json:"patch_omitted,omitempty"`
+}
+
+// PRContextDiffSummary totals the changes of the compared range.
+type PRContextDiffSummary struct {
+	FilesChanged int            `json:"files_changed"`
+	Additions    int            `json:"additions"`
+	Deletions    int            `json:"deletions"`
+	ByStatus     map[string]int `json:"by_status"`
+}
+
+// PRContext is everything needed to write the description of a pull request from head into base.
+type PRContext struct {
+	Base         string               `json:"base"`
+	Head         string               `json:"head"`
+	CompareURL   string               `json:"compare_url"`
+	AheadBy      int                  `json:"ahead_by"`
+	BehindBy     int                  `json:"behind_by"`
+	DiffSummary  PRContextDiffSummary `json:"diff_summary"`
+	Commits      []PRContextCommit    `json:"commits"`
+	LinkedIssues []PRContextIssue     `json:"linked_issues"`
+	FileTree     string               `json:"file_tree"`
+	Files        []PRContextFile      `json:"files"`
+}
+
+// issueReference is an issue number referenced in a commit message or branch name.
+type issueReference struct {
+	number int
+	closes bool
+}
+
+// findIssueReferences returns the issues of owner/repo referenced by the head branch name and the commit messages,
+// in order of first reference, noting those a closing keyword refers to.
+func findIssueReferences(owner, repo, head string, messages []string) []issueReference {
+	var refs []issueReference
+	add := func(number int, closes bool) {
+		for i := range refs {
+			if refs[i].number == number {
+				refs[i].closes = refs[i].closes || closes
+				return
+			}
+		}
+		refs = append(refs, issueReference{number: number, closes: closes})
+	}
+
+	_, branch, found := strings.Cut(head, ":")
+	if !found {
+		branch = head
+	}
+	if m := branchIssuePattern.FindStringSubmatch(branch); m != nil {
+		if n, err := strconv.Atoi(m[1]); err == nil {
+			add(n, false)
+		}
+	}
+	for _, message := range messages {
+		for _, m := range issueReferencePattern.FindAllStringSubmatch(message, -1) {
+			if m[2] != "" && !strings.EqualFold(m[2], owner+"/"+repo) {
+				continue
+			}
+			if n, err := strconv.Atoi(m[3]); err == nil {
+				add(n, m[1] != "")
+			}
+		}
+	}
+	return refs
+}
+
+// renderFileTree renders changed files as an indented directory tree with their line counts.
+func renderFileTree(files []PRContextFile) string {
+	sorted := slices.Clone(files)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Filename < sorted[j].Filename })
+
+	var b strings.Builder
+	var previous []string
+	for _, file := range sorted {
+		dirs := strings.Split(path.Dir(file.Filename), "/")
+		if dirs[0] == "." {
+			dirs = nil
+		}
+		common := 0
+		for common < len(dirs) && common < len(previous) && dirs[common] == previous[common] {
+			common++
+		}
+		for i := common; i < len(dirs); i++ {
+			fmt.Fprintf(&b, "%s%s/\n", strings.Repeat("  ", i), dirs[i])
+		}
+		fmt.Fprintf(&b, "%s%s (%s, +%d -%d)\n", strings.Repeat("  ", len(dirs)), path.Base(file.Filename), file.Status, file.Additions, file.Deletions)
+		previous = dirs
+	}
+	return b.String()
+}
+
+// GetPRContext creates a tool that gathers what is needed to write a pull request description for a branch pair.
+func GetPRContext(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_pr_context",
+			mcp.WithDescription(t("TOOL_GET_PR_CONTEXT_DESCRIPTION", "Get everything needed to write the description of a pull request from head into base in one response: a diff summary, the commit messages, the issues the commits and branch name reference, a tree of the changed files, and the patches of the changed files up to a size budget. Use it for \"write my PR description\" requests, before or after the pull request is opened.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_GET_PR_CONTEXT_USER_TITLE", "Get pull request description context"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryOwner),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryName),
+			),
+			mcp.WithString("base",
+				mcp.Required(),
+				mcp.Description("Branch the changes would be merged into"),
+			),
+			mcp.WithString("head",
+				mcp.Required(),
+				mcp.Description("Branch with the changes. For a branch of a fork, use owner:branch."),
+			),
+			mcp.WithNumber("max_patch_chars",
+				mcp.Description("Maximum total characters of file patches to include. Patches of files past the budget are omitted. Use 0 to omit all patches."),
+				mcp.Min(0),
+				mcp.DefaultNumber(DefaultPRContextPatchChars),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			base, err := RequiredParam[string](request, "base")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			head, err := RequiredParam[string](request, "head")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			// OptionalIntParamWithDefault treats 0 as unset, but 0 is a meaningful budget here.
+			patchBudget := DefaultPRContextPatchChars
+			if _, ok := request.GetArguments()["max_patch_chars"]; ok {
+				if patchBudget, err = OptionalIntParam(request, "max_patch_chars"); err != nil {
+					return mcp.NewToolResultError(err.Error()), nil
+				}
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			comparison, resp, err := client.Repositories.CompareCommits(ctx, owner, repo, base, head, nil)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to compare %s...%s", base, head),
+					resp,
+					err,
+				), nil
+			}
+			_ = resp.Body.Close()
+
+			prContext := PRContext{
+				Base:         base,
+				Head:         head,
+				CompareURL:   comparison.GetHTMLURL(),
+				AheadBy:      comparison.GetAheadBy(),
+				BehindBy:     comparison.GetBehindBy(),
+				DiffSummary:  PRContextDiffSummary{ByStatus: map[string]int{}},
+				Commits:      []PRContextCommit{},
+				LinkedIssues: []PRContextIssue{},
+				Files:        []PRContextFile{},
+			}
+
+			messages := make([]string, 0, len(comparison.Commits))
+			for _, commit := range comparison.Commits {
+				message := commit.GetCommit().GetMessage()
+				messages = append(messages, message)
+				if len(message) > maxPRContextCommitMessage {
+					message = message[:maxPRContextCommitMessage] + "…"
+				}
+				author := commit.GetAuthor().GetLogin()
+				if author == "" {
+					author = commit.GetCommit().GetAuthor().GetName()
+				}
+				sha := commit.GetSHA()
+				if len(sha) > 7 {
+					sha = sha[:7]
+				}
+				prContext.Commits = append(prContext.Commits, PRContextCommit{SHA: sha, Author: author, Message: message})
+			}
+
+			for _, file := range comparison.Files {
+				contextFile := PRContextFile{
+					Filename:         file.GetFilename(),
+					PreviousFilename: file.GetPreviousFilename(),
+					Status:           file.GetStatus(),
+					Additions:        file.GetAdditions(),
+					Deletions:        file.GetDeletions(),
+				}
+				if patch := file.GetPatch(); patch != "" {
+					if len(patch) <= patchBudget {
+						contextFile.Patch = patch
+						patchBudget -= len(patch)
+					} else {
+						contextFile.PatchOmitted = true
+					}
+				}
+				prContext.Files = append(prContext.Files, contextFile)
+				prContext.DiffSummary.FilesChanged++
+				prContext.DiffSummary.Additions += contextFile.Additions
+				prContext.DiffSummary.Deletions += contextFile.Deletions
+				prContext.DiffSummary.ByStatus[contextFile.Status]++
+			}
+			prContext.FileTree = renderFileTree(prContext.Files)
+
+			refs := findIssueReferences(owner, repo, head, messages)
+			if len(refs) > maxPRContextLinkedIssues {
+				refs = refs[:maxPRContextLinkedIssues]
+			}
+			for _, ref := range refs {
+				issue, resp, err := client.Issues.Get(ctx, owner, repo, ref.number)
+				if err != nil {
+					// Numbers in commit messages are not always issue references.
+					if resp != nil && (resp.StatusCode == http.StatusNotFound || resp.StatusCode == http.StatusGone) {
+						continue
+					}
+					return ghErrors.NewGitHubAPIErrorResponse(ctx,
+						fmt.Sprintf("failed to get issue #%d", ref.number),
+						resp,
+						err,
+					), nil
+				}
+				_ = resp.Body.Close()
+				prContext.LinkedIssues = append(prContext.LinkedIssues, PRContextIssue{
+					Number:        issue.GetNumber(),
+					Title:         issue.GetTitle(),
+					State:         issue.GetState(),
+					URL:           issue.GetHTMLURL(),
+					IsPullRequest: issue.IsPullRequest(),
+					Closes:        ref.closes,
+				})
+			}
+
+			return MarshalledTextResult(prContext), nil
+		}
+}
@@ -0,0 +1,123 @@
+package github
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/ghmock"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_FindIssueReferences(t *testing.T) {
+	refs := findIssueReferences("octo", "app", "octocat:fix/42-login-crash", []string{
+		"Handle nil session\n\nFixes #7 and relates to #42",
+		"Bump version (see other/repo#9), closes octo/app#12",
+		"Refs #7",
+	})
+	assert.Equal(t, []issueReference{{number: 42}, {number: 7, closes: true}, {number: 12, closes: true}}, refs)
+	assert.Empty(t, findIssueReferences("octo", "app", "main", []string{"No references"}))
+}
+
+func Test_RenderFileTree(t *testing.T) {
+	tree := renderFileTree([]PRContextFile{
+		{Filename: "pkg/github/tools.go", Status: "modified", Additions: 1},
+		{Filename: "README.md", Status: "modified", Additions: 3, Deletions: 1},
+		{Filename: "pkg/github/pr_context.go", Status: "added", Additions: 200},
+		{Filename: "pkg/errors/error.go", Status: "removed", Deletions: 5},
+	})
+	assert.Equal(t, strings.Join([]string{
+		"README.md (modified, +3 -1)",
+		"pkg/",
+		"  errors/",
+		"    error.go (removed, +0 -5)",
+		"  github/",
+		"    pr_context.go (added, +200 -0)",
+		"    tools.go (modified, +1 -0)",
+		"",
+	}, "\n"), tree)
+}
+
+func Test_GetPRContext(t *testing.T) {
+	tool, _ := GetPRContext(nil, translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+	assert.True(t, *tool.Annotations.ReadOnlyHint)
+	assert.ElementsMatch(t, []string{"owner", "repo", "base", "head"}, tool.InputSchema.Required)
+
+	newServer := func(t *testing.T) *ghmock.Server {
+		server := ghmock.New(t)
+		server.Respond("GET /repos/octo/app/compare/{basehead}", http.StatusOK, map[string]any{
+			"html_url": "https://github.com/octo/app/compare/main...42-login-crash",
+			"ahead_by": 2, "behind_by": 1,
+			"commits": []map[string]any{
+				{
+					"sha":    "0123456789abcdef",
+					"author": map[string]any{"login": "octocat"},
+					"commit": map[string]any{"message": "Handle nil session\n\nFixes #7"},
+				},
+				{
+					"sha":    "fedcba9876543210",
+					"commit": map[string]any{"message": "Mention #99 in docs", "author": map[string]any{"name": "Mona"}},
+				},
+			},
+			"files": []map[string]any{
+				{"filename": "auth/session.go", "status": "modified", "additions": 10, "deletions": 2, "patch": "@@ -1 +1 @@\n-old\n+new"},
+				{"filename": "docs/auth.md", "status": "added", "additions": 5, "patch": strings.Repeat("+line\n", 10)},
+			},
+		})
+		server.Respond("GET /repos/octo/app/issues/42", http.StatusOK, map[string]any{
+			"number": 42, "title": "Login crash", "state": "open", "html_url": "https://github.com/octo/app/issues/42",
+		})
+		server.Respond("GET /repos/octo/app/issues/7", http.StatusOK, map[string]any{
+			"number": 7, "title": "Nil session", "state": "open", "html_url": "https://github.com/octo/app/issues/7",
+			"pull_request": map[string]any{"url": "https://api.github.com/repos/octo/app/pulls/7"},
+		})
+		server.Respond("GET /repos/octo/app/issues/99", http.StatusNotFound, map[string]any{"message": "Not Found"})
+		return server
+	}
+	call := func(t *testing.T, args map[string]any) PRContext {
+		server := newServer(t)
+		_, handler := GetPRContext(server.GetClient(), translations.NullTranslationHelper)
+		result := ghmock.CallTool(t, handler, args)
+		require.False(t, result.IsError, ghmock.ResultText(t, result))
+		server.AssertRequested("GET /repos/octo/app/compare/main...42-login-crash")
+		var prContext PRContext
+		require.NoError(t, json.Unmarshal([]byte(ghmock.ResultText(t, result)), &prContext))
+		return prContext
+	}
+	args := map[string]any{"owner": "octo", "repo": "app", "base": "main", "head": "42-login-crash"}
+
+	t.Run("bundles the comparison", func(t *testing.T) {
+		prContext := call(t, args)
+		assert.Equal(t, 2, prContext.AheadBy)
+		assert.Equal(t, PRContextDiffSummary{FilesChanged: 2, Additions: 15, Deletions: 2, ByStatus: map[string]int{"modified": 1, "added": 1}}, prContext.DiffSummary)
+		assert.Equal(t, []PRContextCommit{
+			{SHA: "0123456", Author: "octocat", Message: "Handle nil session\n\nFixes #7"},
+			{SHA: "fedcba9", Author: "Mona", Message: "Mention #99 in docs"},
+		}, prContext.Commits)
+		assert.Equal(t, []PRContextIssue{
+			{Number: 42, Title: "Login crash", State: "open", URL: "https://github.com/octo/app/issues/42"},
+			{Number: 7, Title: "Nil session", State: "open", URL: "https://github.com/octo/app/issues/7", IsPullRequest: true, Closes: true},
+		}, prContext.LinkedIssues)
+		assert.Equal(t, "auth/\n  session.go (modified, +10 -2)\ndocs/\n  auth.md (added, +5 -0)\n", prContext.FileTree)
+		assert.Equal(t, "@@ -1 +1 @@\n-old\n+new", prContext.Files[0].Patch)
+		assert.NotEmpty(t, prContext.Files[1].Patch)
+	})
+
+	t.Run("patch budget", func(t *testing.T) {
+		args := map[string]any{"owner": "octo", "repo": "app", "base": "main", "head": "42-login-crash", "max_patch_chars": float64(30)}
+		prContext := call(t, args)
+		assert.NotEmpty(t, prContext.Files[0].Patch)
+		assert.Empty(t, prContext.Files[1].Patch)
+		assert.True(t, prContext.Files[1].PatchOmitted)
+
+		args["max_patch_chars"] = float64(0)
+		prContext = call(t, args)
+		assert.True(t, prContext.Files[0].PatchOmitted)
+		assert.True(t, prContext.Files[1].PatchOmitted)
+	})
+}
@@ -0,0 +1,293 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v79/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+const (
+	// MaxFanOutRepositories is the maximum number of repositories create_pr_across_repos changes in one call.
+	MaxFanOutRepositories = 30
+	// DefaultFanOutConcurrency is the number of repositories create_pr_across_repos changes at a time unless the
+	// caller gives another limit.
+	DefaultFanOutConcurrency = 4
+	// MaxFanOutConcurrency caps the concurrency of create_pr_across_repos, to stay clear of secondary rate limits.
+	MaxFanOutConcurrency = 8
+)
+
+// FanOutPullRequest is a pull request opened by create_pr_across_repos.
+type FanOutPullRequest struct {
+	Repository string `json:"repository"`
+	Number     int    `json:"number"`
+	URL        string `json:"url"`
+	Branch     string `json:"branch"`
+	Base       string `json:"base"`
+}
+
+// prFanOutChange is the file change create_pr_across_repos applies to every repository, and the pull request that
+// proposes it.
+type prFanOutChange struct {
+	path    string
+	content string
+	message string
+	branch  string
+	base    string
+	title   string
+	body    string
+	draft   bool
+}
+
+// prFanOutOutcome is the outcome of changing one repository.
+type prFanOutOutcome struct {
+	created *FanOutPullRequest
+	skipped string
+	resp    *github.Response
+	err     error
+}
+
+// fanOutRepositoriesParam returns the repositories parameter, split into owners and names.
+func fanOutRepositoriesParam(request mcp.CallToolRequest) ([][2]string, error) {
+	names, err := OptionalStringArrayParam(request, "repositories")
+	if err != nil {
+		return nil, err
+	}
+	if len(names) == 0 || len(names) > MaxFanOutRepositories {
+		return nil, fmt.Errorf("repositories must contain between 1 and %d repositories, got %d", MaxFanOutRepositories, len(names))
+	}
+	repositories := make([][2]string, 0, len(names))
+	seen := map[string]bool{}
+	for i, name := range names {
+		owner, repo, found := strings.Cut(name, "/")
+		if !found || owner == "" || repo == "" || strings.Contains(repo, "/") {
+			return nil, fmt.Errorf("repositories[%d]: expected owner/repo, got %q", i, name)
+		}
+		if seen[strings.ToLower(name)] {
+			return nil, fmt.Errorf("repositories[%d]: %s is listed twice", i, name)
+		}
+		seen[strings.ToLower(name)] = true
+		repositories = append(repositories, [2]string{owner, repo})
+	}
+	return repositories, nil
+}
+
+// createFanOutPullRequest applies the change to one repository on a new branch and opens a pull request for it. A
+// repository whose file already has the content is skipped, without creating a branch. If committing the file or
+// opening the pull request fails, the branch is deleted again so that a retry can create it.
+func createFanOutPullRequest(ctx context.Context, client *github.Client, owner, repo string, change prFanOutChange) prFanOutOutcome {
+	base := change.base
+	if base == "" {
+		repository, resp, err := client.Repositories.Get(ctx, owner, repo)
+		if err != nil {
+			return prFanOutOutcome{resp: resp, err: fmt.Errorf("failed to get repository: %w", err)}
+		}
+		_ = resp.Body.Close()
+		base = repository.GetDefaultBranch()
+	}
+
+	// The SHA of the file on the base branch is needed to update it on the new branch.
+	var fileSHA *string
+	file, _, resp, err := client.Repositories.GetContents(ctx, owner, repo, change.path, &github.RepositoryContentGetOptions{Ref: base})
+	if resp != nil {
+		_ = resp.Body.Close()
+	}
+	switch {
+	case err == nil && file == nil:
+		return prFanOutOutcome{err: fmt.Errorf("%s is a directory", change.path)}
+	case err == nil:
+		content, err := file.GetContent()
+		if err != nil {
+			return prFanOutOutcome{err: fmt.Errorf("failed to decode %s: %w", change.path, err)}
+		}
+		if content == change.content {
+			return prFanOutOutcome{skipped: fmt.Sprintf("%s already has the content on %s", change.path, base)}
+		}
+		fileSHA = file.SHA
+	case resp == nil || resp.StatusCode != http.StatusNotFound:
+		return prFanOutOutcome{resp: resp, err: fmt.Errorf("failed to get %s: %w", change.path, err)}
+	}
+
+	ref, resp, err := client.Git.GetRef(ctx, owner, repo, "refs/heads/"+base)
+	if err != nil {
+		return prFanOutOutcome{resp: resp, err: fmt.Errorf("failed to get branch %s: %w", base, err)}
+	}
+	_ = resp.Body.Close()
+	_, resp, err = client.Git.CreateRef(ctx, owner, repo, github.CreateRef{Ref: "refs/heads/" + change.branch, SHA: ref.GetObject().GetSHA()})
+	if err != nil {
+		return prFanOutOutcome{resp: resp, err: fmt.Errorf("failed to create branch %s: %w", change.branch, err)}
+	}
+	_ = resp.Body.Close()
+	failed := func(resp *github.Response, err error) prFanOutOutcome {
+		deleteResp, deleteErr := client.Git.DeleteRef(ctx, owner, repo, "refs/heads/"+change.branch)
+		if deleteErr != nil {
+			err = fmt.Errorf("%w; the branch could not be deleted: %v", err, deleteErr)
+		} else {
+			_ = deleteResp.Body.Close()
+		}
+		return prFanOutOutcome{resp: resp, err: err}
+	}
+
+	_, resp, err = client.Repositories.CreateFile(ctx, owner, repo, change.path, &github.RepositoryContentFileOptions{
+		Message: github.Ptr(change.message),
+		Content: []byte(change.content),
+		Branch:  github.Ptr(change.branch),
+		SHA:     fileSHA,
+	})
+	if err != nil {
+		return failed(resp, fmt.Errorf("failed to commit %s to branch %s: %w", change.path, change.branch, err))
+	}
+	_ = resp.Body.Close()
+
+	pr, resp, err := client.PullRequests.Create(ctx, owner, repo, &github.NewPullRequest{
+		Title: github.Ptr(change.title),
+		Body:  github.Ptr(change.body),
+		Head:  github.Ptr(change.branch),
+		Base:  github.Ptr(base),
+		Draft: github.Ptr(change.draft),
+	})
+	if err != nil {
+		return failed(resp, fmt.Errorf("failed to create pull request from branch %s: %w", change.branch, err))
+	}
+	_ = resp.Body.Close()
+
+	return prFanOutOutcome{created: &FanOutPullRequest{
+		Repository: owner + "/" + repo,
+		Number:     pr.GetNumber(),
+		URL:        pr.GetHTMLURL(),
+		Branch:     change.branch,
+		Base:       base,
+	}}
+}
+
+// CreatePRAcrossRepos creates a tool that applies the same file change to several repositories, opening a pull
+// request in each.
+func CreatePRAcrossRepos(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("create_pr_across_repos",
+			mcp.WithDescription(t("TOOL_CREATE_PR_ACROSS_REPOS_DESCRIPTION", fmt.Sprintf("Apply the same file change, such as a configuration bump, to up to %d repositories: in each, create a branch from the base branch, commit the file to it and open a pull request. If the commit or the pull request fails, the branch is deleted again. Repositories whose file already has the content are skipped. Reports the pull request, failure or skip of every repository, with a summary.", MaxFanOutRepositories))),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:           t("TOOL_CREATE_PR_ACROSS_REPOS_USER_TITLE", "Create pull requests across repositories"),
+				ReadOnlyHint:    ToBoolPtr(false),
+				DestructiveHint: ToBoolPtr(false),
+				IdempotentHint:  ToBoolPtr(false),
+			}),
+			mcp.WithArray("repositories",
+				mcp.Required(),
+				mcp.Description("Repositories to change, as owner/repo"),
+				mcp.WithStringItems(),
+			),
+			mcp.WithString("path",
+				mcp.Required(),
+				mcp.Description("Path of the file to create or replace in every repository"),
+			),
+			mcp.WithString("content",
+				mcp.Required(),
+				mcp.Description("New content of the file"),
+			),
+			mcp.WithString("message",
+				mcp.Required(),
+				mcp.Description("Commit message"),
+			),
+			mcp.WithString("branch",
+				mcp.Required(),
+				mcp.Description("Name of the branch to create in every repository"),
+			),
+			mcp.WithString("base",
+				mcp.Description("Branch to create the branch from and open the pull requests against. Defaults to the default branch of each repository."),
+			),
+			mcp.WithString("title",
+				mcp.Required(),
+				mcp.Description("Pull request title"),
+			),
+			mcp.WithString("body",
+				mcp.Description("Pull request description"),
+			),
+			mcp.WithBoolean("draft",
+				mcp.Description("Create draft pull requests"),
+			),
+			mcp.WithNumber("concurrency",
+				mcp.Description("Number of repositories to change at a time"),
+				mcp.Min(1),
+				mcp.Max(MaxFanOutConcurrency),
+				mcp.DefaultNumber(DefaultFanOutConcurrency),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			repositories, err := fanOutRepositoriesParam(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			var change prFanOutChange
+			if change.path, err = RequiredParam[string](request, "path"); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if change.content, err = RequiredParam[string](request, "content"); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if change.message, err = RequiredParam[string](request, "message"); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if change.branch, err = RequiredParam[string](request, "branch"); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if change.base, err = OptionalParam[string](request, "base"); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if change.title, err = RequiredParam[string](request, "title"); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if change.body, err = OptionalParam[string](request, "body"); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if change.draft, err = OptionalParam[bool](request, "draft"); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			concurrency, err := OptionalIntParamWithDefault(request, "concurrency", DefaultFanOutConcurrency)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			concurrency = min(max(concurrency, 1), MaxFanOutConcurrency)
+			if change.branch == change.base {
+				return mcp.NewToolResultError("branch must differ from base"), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			outcomes := make([]prFanOutOutcome, len(repositories))
+			sem := make(chan struct{}, concurrency)
+			var wg sync.WaitGroup
+			for i, repository := range repositories {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					sem <- struct{}{}
+					defer func() { <-sem }()
+					outcomes[i] = createFanOutPullRequest(ctx, client, repository[0], repository[1], change)
+				}()
+			}
+			wg.Wait()
+
+			result := NewBulkResult[FanOutPullRequest]()
+			for i, outcome := range outcomes {
+				name := repositories[i][0] + "/" + repositories[i][1]
+				switch {
+				case outcome.err != nil:
+					result.AddAPIFailure(name, outcome.resp, outcome.err)
+				case outcome.skipped != "":
+					result.AddSkipped(name, outcome.skipped)
+				default:
+					result.AddSuccess(*outcome.created)
+				}
+			}
+			return result.ToolResult()
+		}
+}
@@ -0,0 +1,145 @@
+package github
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/ghmock"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_CreatePRAcrossRepos(t *testing.T) {
+	tool, _ := CreatePRAcrossRepos(nil, translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+	assert.False(t, *tool.Annotations.DestructiveHint)
+	assert.ElementsMatch(t, []string{"repositories", "path", "content", "message", "branch", "title"}, tool.InputSchema.Required)
+
+	args := func(repositories ...any) map[string]any {
+		return map[string]any{
+			"repositories": repositories,
+			"path":         "config.yml",
+			"content":      "version: 2\n",
+			"message":      "Bump config",
+			"branch":       "bump-config",
+			"title":        "Bump config to version 2",
+			"concurrency":  float64(2),
+		}
+	}
+	file := func(content string) map[string]any {
+		return map[string]any{"type": "file", "encoding": "base64", "content": base64.StdEncoding.EncodeToString([]byte(content)), "sha": "blob-" + content[:3]}
+	}
+
+	t.Run("opens a pull request in every repository that needs the change", func(t *testing.T) {
+		server := ghmock.New(t)
+		for _, repo := range []string{"api", "web", "docs", "cli"} {
+			server.Respond("GET /repos/octo/"+repo, http.StatusOK, map[string]any{"default_branch": "main"})
+			server.Respond("GET /repos/octo/"+repo+"/git/ref/heads/main", http.StatusOK, map[string]any{"ref": "refs/heads/main", "object": map[string]any{"sha": "sha-" + repo}})
+			server.Respond("PUT /repos/octo/"+repo+"/contents/config.yml", http.StatusCreated, map[string]any{"content": map[string]any{"path": "config.yml"}})
+			server.Respond("POST /repos/octo/"+repo+"/pulls", http.StatusCreated, map[string]any{"number": 7, "html_url": "https://github.com/octo/" + repo + "/pull/7"})
+		}
+		server.Respond("GET /repos/octo/api/contents/config.yml", http.StatusOK, file("version: 1\n"))
+		server.Respond("GET /repos/octo/web/contents/config.yml", http.StatusOK, file("version: 2\n"))
+		// docs has no config.yml yet, and cli already has the branch.
+		server.Respond("GET /repos/octo/docs/contents/config.yml", http.StatusNotFound, map[string]any{"message": "Not Found"})
+		server.Respond("GET /repos/octo/cli/contents/config.yml", http.StatusNotFound, map[string]any{"message": "Not Found"})
+		server.Respond("POST /repos/octo/api/git/refs", http.StatusCreated, map[string]any{"ref": "refs/heads/bump-config"})
+		server.Respond("POST /repos/octo/docs/git/refs", http.StatusCreated, map[string]any{"ref": "refs/heads/bump-config"})
+		server.Respond("POST /repos/octo/cli/git/refs", http.StatusUnprocessableEntity, map[string]any{"message": "Reference already exists"})
+
+		_, handler := CreatePRAcrossRepos(server.GetClient(), translations.NullTranslationHelper)
+		result := ghmock.CallTool(t, handler, args("octo/api", "octo/web", "octo/docs", "octo/cli"))
+		require.False(t, result.IsError, ghmock.ResultText(t, result))
+
+		var resp struct {
+			Succeeded []FanOutPullRequest `json:"succeeded"`
+			Failed    []BulkFailure       `json:"failed"`
+			Skipped   []BulkSkip          `json:"skipped"`
+			Summary   BulkSummary         `json:"summary"`
+		}
+		require.NoError(t, json.Unmarshal([]byte(ghmock.ResultText(t, result)), &resp))
+		assert.Equal(t, BulkSummary{Total: 4, Succeeded: 2, Failed: 1, Skipped: 1}, resp.Summary)
+		assert.Equal(t, []FanOutPullRequest{
+			{Repository: "octo/api", Number: 7, URL: "https://github.com/octo/api/pull/7", Branch: "bump-config", Base: "main"},
+			{Repository: "octo/docs", Number: 7, URL: "https://github.com/octo/docs/pull/7", Branch: "bump-config", Base: "main"},
+		}, resp.Succeeded)
+		assert.Equal(t, []BulkSkip{{Item: "octo/web", Reason: "config.yml already has the content on main"}}, resp.Skipped)
+		require.Len(t, resp.Failed, 1)
+		assert.Equal(t, "octo/cli", resp.Failed[0].Item)
+		assert.Contains(t, resp.Failed[0].Message, "failed to create branch bump-config")
+		server.AssertNotRequested(http.MethodDelete)
+
+		var ref map[string]any
+		require.NoError(t, server.AssertRequested("POST /repos/octo/api/git/refs").DecodeBody(&ref))
+		assert.Equal(t, map[string]any{"ref": "refs/heads/bump-config", "sha": "sha-api"}, ref)
+		var commit map[string]any
+		require.NoError(t, server.AssertRequested("PUT /repos/octo/api/contents/config.yml").DecodeBody(&commit))
+		assert.Equal(t, "bump-config", commit["branch"])
+		assert.Equal(t, "blob-ver", commit["sha"])
+		var newFile map[string]any
+		require.NoError(t, server.AssertRequested("PUT /repos/octo/docs/contents/config.yml").DecodeBody(&newFile))
+		assert.NotContains(t, newFile, "sha")
+		var pr map[string]any
+		require.NoError(t, server.AssertRequested("POST /repos/octo/docs/pulls").DecodeBody(&pr))
+		assert.Equal(t, "bump-config", pr["head"])
+		assert.Equal(t, "main", pr["base"])
+		assert.Equal(t, "Bump config to version 2", pr["title"])
+	})
+
+	t.Run("deletes the branch when a later step fails", func(t *testing.T) {
+		server := ghmock.New(t)
+		for _, repo := range []string{"api", "web"} {
+			server.Respond("GET /repos/octo/"+repo+"/contents/config.yml", http.StatusOK, file("version: 1\n"))
+			server.Respond("GET /repos/octo/"+repo+"/git/ref/heads/main", http.StatusOK, map[string]any{"ref": "refs/heads/main", "object": map[string]any{"sha": "sha-" + repo}})
+			server.Respond("POST /repos/octo/"+repo+"/git/refs", http.StatusCreated, map[string]any{"ref": "refs/heads/bump-config"})
+			server.Respond("DELETE /repos/octo/"+repo+"/git/refs/heads/bump-config", http.StatusNoContent, nil)
+		}
+		server.Respond("PUT /repos/octo/api/contents/config.yml", http.StatusConflict, map[string]any{"message": "config.yml does not match blob-ver"})
+		server.Respond("PUT /repos/octo/web/contents/config.yml", http.StatusCreated, map[string]any{"content": map[string]any{"path": "config.yml"}})
+		server.Respond("POST /repos/octo/web/pulls", http.StatusUnprocessableEntity, map[string]any{"message": "Validation Failed"})
+
+		_, handler := CreatePRAcrossRepos(server.GetClient(), translations.NullTranslationHelper)
+		arguments := args("octo/api", "octo/web")
+		arguments["base"] = "main"
+		result := ghmock.CallTool(t, handler, arguments)
+		require.True(t, result.IsError, "every repository failed")
+
+		text := ghmock.ResultText(t, result)
+		assert.Contains(t, text, "failed to commit config.yml to branch bump-config")
+		assert.Contains(t, text, "failed to create pull request from branch bump-config")
+		assert.NotContains(t, text, "could not be deleted")
+		server.AssertRequested("DELETE /repos/octo/api/git/refs/heads/bump-config")
+		server.AssertRequested("DELETE /repos/octo/web/git/refs/heads/bump-config")
+	})
+
+	t.Run("every repository failing is an error", func(t *testing.T) {
+		server := ghmock.New(t)
+		server.Respond("GET /repos/octo/missing", http.StatusNotFound, map[string]any{"message": "Not Found"})
+		_, handler := CreatePRAcrossRepos(server.GetClient(), translations.NullTranslationHelper)
+		result := ghmock.CallTool(t, handler, args("octo/missing"))
+		require.True(t, result.IsError)
+		assert.Contains(t, ghmock.ResultText(t, result), "failed to get repository")
+	})
+
+	for name, tc := range map[string]struct {
+		repositories []any
+		message      string
+	}{
+		"no repositories":      {[]any{}, "repositories must contain between 1 and 30 repositories, got 0"},
+		"invalid repository":   {[]any{"octo"}, "repositories[0]: expected owner/repo, got \"octo\""},
+		"duplicate repository": {[]any{"octo/api", "Octo/API"}, "repositories[1]: Octo/API is listed twice"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			server := ghmock.New(t)
+			_, handler := CreatePRAcrossRepos(server.GetClient(), translations.NullTranslationHelper)
+			result := ghmock.CallTool(t, handler, args(tc.repositories...))
+			require.True(t, result.IsError)
+			assert.Equal(t, tc.message, ghmock.ResultText(t, result))
+			assert.Empty(t, server.Requests())
+		})
+	}
+}
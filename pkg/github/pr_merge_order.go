@@ -0,0 +1,298 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v79/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// MaxMergeOrderPullRequests is the maximum number of pull requests get_pr_merge_order orders in one call.
+const MaxMergeOrderPullRequests = 50
+
+var (
+	// "depends on #12", "blocked by #12 and #13" or "requires octo/app#12" in a pull request description.
+	dependencyMarkerPattern = regexp.MustCompile(`(?i)\b(?:depends\s+on|blocked\s+by|requires|merge\s+after)\s*:?\s+((?:[\w.-]+/[\w.-]+)?#\d+(?:\s*(?:,|and|&)\s*(?:[\w.-]+/[\w.-]+)?#\d+)*)`)
+	// A single "#12" or "octo/app#12" of a dependency marker.
+	dependencyReferencePattern = regexp.MustCompile(`(?:([\w.-]+/[\w.-]+))?#(\d+)`)
+)
+
+// PRMergeOrder is the order in which a set of pull requests can be merged safely, dependencies first.
+type PRMergeOrder struct {
+	Order   []PRMergeStep `json:"order"`
+	Ready   []int         `json:"ready"`
+	Blocked []int         `json:"blocked"`
+	Cycle   []int         `json:"cycle,omitempty"`
+}
+
+// PRMergeStep is a pull request in merge order, with what it depends on and why it cannot be merged yet, if so.
+type PRMergeStep struct {
+	Position  int            `json:"position"`
+	Number    int            `json:"number"`
+	Title     string         `json:"title"`
+	URL       string         `json:"url"`
+	Base      string         `json:"base"`
+	Head      string         `json:"head"`
+	Draft     bool           `json:"draft,omitempty"`
+	DependsOn []PRDependency `json:"depends_on"`
+	Related   []int          `json:"related,omitempty"`
+	BlockedBy []string       `json:"blocked_by,omitempty"`
+}
+
+// PRDependency is a pull request or issue that must be merged or closed before a pull request.
+type PRDependency struct {
+	Number int    `json:"number"`
+	Reason string `json:"reason"`
+	State  string `json:"state"`
+}
+
+// findDependencyMarkers returns the numbers of the issues and pull requests of owner/repo that a description says
+// must land first, in order of first reference.
+func findDependencyMarkers(owner, repo, body string) []int {
+	var numbers []int
+	for _, marker := range dependencyMarkerPattern.FindAllStringSubmatch(body, -1) {
+		for _, m := range dependencyReferencePattern.FindAllStringSubmatch(marker[1], -1) {
+			if m[1] != "" && !strings.EqualFold(m[1], owner+"/"+repo) {
+				continue
+			}
+			if n, err := strconv.Atoi(m[2]); err == nil && !slices.Contains(numbers, n) {
+				numbers = append(numbers, n)
+			}
+		}
+	}
+	return numbers
+}
+
+// dependencyState returns the state of an issue or pull request a pull request depends on: open, merged,
+// closed_unmerged for a pull request closed without merging, or closed for an issue that was closed.
+func dependencyState(issue *github.Issue) string {
+	switch {
+	case issue.GetState() != "closed":
+		return "open"
+	case !issue.IsPullRequest():
+		return "closed"
+	case issue.GetPullRequestLinks().GetMergedAt().IsZero():
+		return "closed_unmerged"
+	default:
+		return "merged"
+	}
+}
+
+// pullRequestState returns the state of a pull request of the set: open, merged or closed_unmerged.
+func pullRequestState(pr *github.PullRequest) string {
+	switch {
+	case pr.GetMerged() || !pr.GetMergedAt().IsZero():
+		return "merged"
+	case pr.GetState() == "closed":
+		return "closed_unmerged"
+	default:
+		return "open"
+	}
+}
+
+// GetPRMergeOrder creates a tool that orders a set of pull requests by their dependencies.
+func GetPRMergeOrder(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_pr_merge_order",
+			mcp.WithDescription(t("TOOL_GET_PR_MERGE_ORDER_DESCRIPTION", "Get a safe order to merge a set of open pull requests of a repository, such as stacked or related changes, and which of them are blocked now. A pull request depends on the pull request whose head branch it is based on, and on the issues and pull requests its description says it \"depends on\", is \"blocked by\", \"requires\" or should \"merge after\". Other references between the pull requests are listed as related without ordering them. Pull requests are blocked while a dependency is open or was closed without merging, or while they are drafts.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_GET_PR_MERGE_ORDER_USER_TITLE", "Get pull request merge order"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryOwner),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryName),
+			),
+			mcp.WithArray("pull_numbers",
+				mcp.Description(fmt.Sprintf("Pull requests to order (e.g. [\"12\", \"15\"]). Defaults to the %d oldest open pull requests of the repository.", MaxMergeOrderPullRequests)),
+				mcp.WithStringItems(),
+			),
+		), func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pullNumbers, err := OptionalBigIntArrayParam(request, "pull_numbers")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if len(pullNumbers) > MaxMergeOrderPullRequests {
+				return mcp.NewToolResultError(fmt.Sprintf("pull_numbers can contain at most %d pull requests, got %d", MaxMergeOrderPullRequests, len(pullNumbers))), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			var pulls []*github.PullRequest
+			if len(pullNumbers) == 0 {
+				list, resp, err := client.PullRequests.List(ctx, owner, repo, &github.PullRequestListOptions{
+					State:       "open",
+					Sort:        "created",
+					Direction:   "asc",
+					ListOptions: github.ListOptions{PerPage: MaxMergeOrderPullRequests},
+				})
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to list pull requests", resp, err), nil
+				}
+				_ = resp.Body.Close()
+				pulls = list
+			}
+			for _, number := range pullNumbers {
+				if slices.ContainsFunc(pulls, func(pr *github.PullRequest) bool { return int64(pr.GetNumber()) == number }) {
+					continue
+				}
+				pr, resp, err := client.PullRequests.Get(ctx, owner, repo, int(number))
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("failed to get pull request %d", number), resp, err), nil
+				}
+				_ = resp.Body.Close()
+				pulls = append(pulls, pr)
+			}
+
+			byNumber := map[int]*github.PullRequest{}
+			for _, pr := range pulls {
+				byNumber[pr.GetNumber()] = pr
+			}
+			// States of the dependencies that are not in the set, looked up once each.
+			external := map[int]string{}
+			steps := map[int]*PRMergeStep{}
+			var open []int
+			for _, pr := range pulls {
+				if pullRequestState(pr) != "open" {
+					continue
+				}
+				step := &PRMergeStep{
+					Number:    pr.GetNumber(),
+					Title:     pr.GetTitle(),
+					URL:       pr.GetHTMLURL(),
+					Base:      pr.GetBase().GetRef(),
+					Head:      pr.GetHead().GetRef(),
+					Draft:     pr.GetDraft(),
+					DependsOn: []PRDependency{},
+				}
+				addDependency := func(number int, reason string) {
+					if number == step.Number || slices.ContainsFunc(step.DependsOn, func(d PRDependency) bool { return d.Number == number }) {
+						return
+					}
+					step.DependsOn = append(step.DependsOn, PRDependency{Number: number, Reason: reason})
+				}
+
+				// A pull request based on the head branch of another of the same repository is stacked on it.
+				for _, other := range pulls {
+					if other.GetHead().GetRef() == step.Base && strings.EqualFold(other.GetHead().GetRepo().GetFullName(), owner+"/"+repo) {
+						addDependency(other.GetNumber(), fmt.Sprintf("based on its branch %s", step.Base))
+					}
+				}
+				markers := findDependencyMarkers(owner, repo, pr.GetBody())
+				for _, number := range markers {
+					addDependency(number, "marked as a dependency in the description")
+				}
+				for _, ref := range findIssueReferences(owner, repo, "", []string{pr.GetBody()}) {
+					if _, ok := byNumber[ref.number]; ok && ref.number != step.Number && !slices.Contains(markers, ref.number) {
+						step.Related = append(step.Related, ref.number)
+					}
+				}
+
+				for i := range step.DependsOn {
+					dependency := &step.DependsOn[i]
+					if other, ok := byNumber[dependency.Number]; ok {
+						dependency.State = pullRequestState(other)
+						continue
+					}
+					state, ok := external[dependency.Number]
+					if !ok {
+						issue, resp, err := client.Issues.Get(ctx, owner, repo, dependency.Number)
+						switch {
+						case err == nil:
+							_ = resp.Body.Close()
+							state = dependencyState(issue)
+						case resp != nil && resp.StatusCode == http.StatusNotFound:
+							state = "not_found"
+						default:
+							return ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("failed to get #%d, a dependency of pull request %d", dependency.Number, step.Number), resp, err), nil
+						}
+						external[dependency.Number] = state
+					}
+					dependency.State = state
+				}
+				steps[step.Number] = step
+				open = append(open, step.Number)
+			}
+
+			// Pull requests are ordered dependencies first and otherwise by number, considering only the open
+			// dependencies of the set; the others are merged already or block the pull request until they are.
+			order := PRMergeOrder{Order: []PRMergeStep{}, Ready: []int{}, Blocked: []int{}}
+			slices.Sort(open)
+			placed := map[int]bool{}
+			for len(placed) < len(open) {
+				next := slices.IndexFunc(open, func(number int) bool {
+					return !placed[number] && !slices.ContainsFunc(steps[number].DependsOn, func(d PRDependency) bool {
+						_, inSet := steps[d.Number]
+						return inSet && !placed[d.Number]
+					})
+				})
+				if next == -1 {
+					break
+				}
+				placed[open[next]] = true
+				step := steps[open[next]]
+				step.Position = len(order.Order) + 1
+				order.Order = append(order.Order, *step)
+			}
+			// What is left depends on itself through other pull requests, and cannot be ordered.
+			for _, number := range open {
+				if !placed[number] {
+					order.Cycle = append(order.Cycle, number)
+				}
+			}
+			for _, number := range order.Cycle {
+				step := steps[number]
+				step.Position = len(order.Order) + 1
+				order.Order = append(order.Order, *step)
+			}
+
+			for i := range order.Order {
+				step := &order.Order[i]
+				if slices.Contains(order.Cycle, step.Number) {
+					step.BlockedBy = append(step.BlockedBy, "in a dependency cycle")
+				}
+				for _, dependency := range step.DependsOn {
+					switch dependency.State {
+					case "open":
+						step.BlockedBy = append(step.BlockedBy, fmt.Sprintf("#%d is open", dependency.Number))
+					case "closed_unmerged":
+						step.BlockedBy = append(step.BlockedBy, fmt.Sprintf("#%d was closed without merging", dependency.Number))
+					case "not_found":
+						step.BlockedBy = append(step.BlockedBy, fmt.Sprintf("#%d was not found", dependency.Number))
+					}
+				}
+				if step.Draft {
+					step.BlockedBy = append(step.BlockedBy, "draft")
+				}
+				if len(step.BlockedBy) > 0 {
+					order.Blocked = append(order.Blocked, step.Number)
+				} else {
+					order.Ready = append(order.Ready, step.Number)
+				}
+			}
+			return MarshalledTextResult(order), nil
+		}
+}
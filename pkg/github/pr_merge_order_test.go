@@ -0,0 +1,116 @@
+package github
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/ghmock"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_FindDependencyMarkers(t *testing.T) {
+	body := "Depends on #12 and #13.\nBlocked by: octo/app#14, other/app#15\nrequires #12\nSee #16, merge after #17"
+	assert.Equal(t, []int{12, 13, 14, 17}, findDependencyMarkers("octo", "app", body))
+	assert.Empty(t, findDependencyMarkers("octo", "app", "Fixes #12"))
+}
+
+func Test_GetPRMergeOrder(t *testing.T) {
+	tool, _ := GetPRMergeOrder(nil, translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+	assert.True(t, *tool.Annotations.ReadOnlyHint)
+	assert.ElementsMatch(t, []string{"owner", "repo"}, tool.InputSchema.Required)
+
+	pull := func(number int, base, head, body string) map[string]any {
+		return map[string]any{
+			"number": number, "title": "PR " + head, "state": "open", "body": body,
+			"html_url": "https://github.com/octo/app/pull/" + head,
+			"base":     map[string]any{"ref": base},
+			"head":     map[string]any{"ref": head, "repo": map[string]any{"full_name": "octo/app"}},
+		}
+	}
+
+	t.Run("orders open pull requests by their dependencies", func(t *testing.T) {
+		server := ghmock.New(t)
+		draft := pull(5, "main", "docs", "Related to #2")
+		draft["draft"] = true
+		server.Respond("GET /repos/octo/app/pulls", http.StatusOK, []any{
+			// 3 is stacked on 2, which is stacked on 1; 4 depends on 3 and on issue 9, which is closed.
+			pull(1, "main", "api", ""),
+			pull(2, "api", "client", ""),
+			pull(3, "client", "cli", "Part of #1"),
+			pull(4, "main", "release", "Depends on #3 and #9"),
+			draft,
+			// 6 and 7 depend on each other.
+			pull(6, "main", "a", "blocked by #7"),
+			pull(7, "main", "b", "requires #6"),
+		})
+		server.Respond("GET /repos/octo/app/issues/9", http.StatusOK, map[string]any{"number": 9, "state": "closed"})
+
+		_, handler := GetPRMergeOrder(server.GetClient(), translations.NullTranslationHelper)
+		result := ghmock.CallTool(t, handler, map[string]any{"owner": "octo", "repo": "app"})
+		require.False(t, result.IsError, ghmock.ResultText(t, result))
+
+		var order PRMergeOrder
+		require.NoError(t, json.Unmarshal([]byte(ghmock.ResultText(t, result)), &order))
+		var numbers []int
+		for i, step := range order.Order {
+			assert.Equal(t, i+1, step.Position)
+			numbers = append(numbers, step.Number)
+		}
+		assert.Equal(t, []int{1, 2, 3, 4, 5, 6, 7}, numbers)
+		assert.Equal(t, []int{1}, order.Ready)
+		assert.Equal(t, []int{2, 3, 4, 5, 6, 7}, order.Blocked)
+		assert.Equal(t, []int{6, 7}, order.Cycle)
+
+		assert.Equal(t, []PRDependency{{Number: 1, Reason: "based on its branch api", State: "open"}}, order.Order[1].DependsOn)
+		assert.Equal(t, []int{1}, order.Order[2].Related)
+		assert.Equal(t, []PRDependency{
+			{Number: 3, Reason: "marked as a dependency in the description", State: "open"},
+			{Number: 9, Reason: "marked as a dependency in the description", State: "closed"},
+		}, order.Order[3].DependsOn)
+		assert.Equal(t, []string{"#3 is open"}, order.Order[3].BlockedBy)
+		assert.Equal(t, []string{"draft"}, order.Order[4].BlockedBy)
+		assert.Equal(t, []int{2}, order.Order[4].Related)
+		assert.Equal(t, []string{"in a dependency cycle", "#7 is open"}, order.Order[5].BlockedBy)
+	})
+
+	t.Run("given pull requests with merged and missing dependencies", func(t *testing.T) {
+		server := ghmock.New(t)
+		server.Respond("GET /repos/octo/app/pulls/21", http.StatusOK, pull(21, "main", "x", "Depends on #20"))
+		server.Respond("GET /repos/octo/app/pulls/22", http.StatusOK, pull(22, "main", "y", "Depends on #19, #404"))
+		server.Respond("GET /repos/octo/app/pulls/20", http.StatusOK, map[string]any{
+			"number": 20, "state": "closed", "merged": true, "base": map[string]any{"ref": "main"}, "head": map[string]any{"ref": "w"},
+		})
+		server.Respond("GET /repos/octo/app/issues/19", http.StatusOK, map[string]any{
+			"number": 19, "state": "closed", "pull_request": map[string]any{"url": "https://api.github.com/repos/octo/app/pulls/19"},
+		})
+		server.Respond("GET /repos/octo/app/issues/404", http.StatusNotFound, map[string]any{"message": "Not Found"})
+
+		_, handler := GetPRMergeOrder(server.GetClient(), translations.NullTranslationHelper)
+		result := ghmock.CallTool(t, handler, map[string]any{"owner": "octo", "repo": "app", "pull_numbers": []any{"22", "21", "20"}})
+		require.False(t, result.IsError, ghmock.ResultText(t, result))
+
+		var order PRMergeOrder
+		require.NoError(t, json.Unmarshal([]byte(ghmock.ResultText(t, result)), &order))
+		require.Len(t, order.Order, 2)
+		assert.Equal(t, 21, order.Order[0].Number)
+		assert.Equal(t, []PRDependency{{Number: 20, Reason: "marked as a dependency in the description", State: "merged"}}, order.Order[0].DependsOn)
+		assert.Equal(t, []int{21}, order.Ready)
+		assert.Equal(t, []string{"#19 was closed without merging", "#404 was not found"}, order.Order[1].BlockedBy)
+	})
+
+	t.Run("too many pull requests", func(t *testing.T) {
+		numbers := make([]any, MaxMergeOrderPullRequests+1)
+		for i := range numbers {
+			numbers[i] = "1"
+		}
+		_, handler := GetPRMergeOrder(nil, translations.NullTranslationHelper)
+		result := ghmock.CallTool(t, handler, map[string]any{"owner": "octo", "repo": "app", "pull_numbers": numbers})
+		require.True(t, result.IsError)
+		assert.Equal(t, "pull_numbers can contain at most 50 pull requests, got 51", ghmock.ResultText(t, result))
+	})
+}
@@ -0,0 +1,283 @@
+package github
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/shurcooL/githubv4"
+)
+
+// maxReviewSummaryText caps the length of each review and comment body in summarize_reviews.
+const maxReviewSummaryText = 500
+
+// ReviewSummary condenses the reviews of a pull request to what is left to address: the latest review of every
+// reviewer and the review threads grouped by file, unresolved threads first.
+type ReviewSummary struct {
+	Number         int                     `json:"number"`
+	Title          string                  `json:"title"`
+	URL            string                  `json:"url"`
+	State          string                  `json:"state"`
+	ReviewDecision string                  `json:"review_decision,omitempty"`
+	Reviewers      []ReviewSummaryReviewer `json:"reviewers"`
+	Threads        ReviewThreadCounts      `json:"threads"`
+	Files          []ReviewSummaryFile     `json:"files"`
+}
+
+// ReviewSummaryReviewer is the latest review of a reviewer.
+type ReviewSummaryReviewer struct {
+	Login       string `json:"login"`
+	State       string `json:"state"`
+	Body        string `json:"body,omitempty"`
+	SubmittedAt string `json:"submitted_at,omitempty"`
+	URL         string `json:"url"`
+}
+
+// ReviewThreadCounts counts the review threads of a pull request. Outdated threads are counted whether or not they
+// are resolved.
+type ReviewThreadCounts struct {
+	Total      int `json:"total"`
+	Unresolved int `json:"unresolved"`
+	Resolved   int `json:"resolved"`
+	Outdated   int `json:"outdated"`
+}
+
+// ReviewSummaryFile is a file with review threads. Resolved threads are only listed when asked for.
+type ReviewSummaryFile struct {
+	Path          string                `json:"path"`
+	Unresolved    []ReviewSummaryThread `json:"unresolved"`
+	ResolvedCount int                   `json:"resolved_count"`
+	Resolved      []ReviewSummaryThread `json:"resolved,omitempty"`
+}
+
+// ReviewSummaryThread is a review thread, condensed to its first comment and its last reply.
+type ReviewSummaryThread struct {
+	Line            int    `json:"line,omitempty"`
+	Outdated        bool   `json:"outdated,omitempty"`
+	Author          string `json:"author"`
+	Comment         string `json:"comment"`
+	Replies         int    `json:"replies"`
+	LastReplyAuthor string `json:"last_reply_author,omitempty"`
+	LastReply       string `json:"last_reply,omitempty"`
+	ResolvedBy      string `json:"resolved_by,omitempty"`
+	URL             string `json:"url"`
+}
+
+type reviewSummaryComment struct {
+	Author struct {
+		Login githubv4.String
+	}
+	Body githubv4.String
+	URL  githubv4.URI
+}
+
+type reviewSummaryThreadNode struct {
+	IsResolved githubv4.Boolean
+	IsOutdated githubv4.Boolean
+	Path       githubv4.String
+	Line       *githubv4.Int
+	ResolvedBy struct {
+		Login githubv4.String
+	}
+	Comments struct {
+		TotalCount githubv4.Int
+		Nodes      []reviewSummaryComment
+	} `graphql:"comments(first: 1)"`
+	LastComment struct {
+		Nodes []reviewSummaryComment
+	} `graphql:"lastComment: comments(last: 1)"`
+}
+
+// truncateReviewText trims a review or comment body and cuts it to maxReviewSummaryText characters.
+func truncateReviewText(text string) string {
+	text = strings.TrimSpace(text)
+	if runes := []rune(text); len(runes) > maxReviewSummaryText {
+		return string(runes[:maxReviewSummaryText]) + "…"
+	}
+	return text
+}
+
+// reviewSummaryThread condenses a review thread.
+func reviewSummaryThread(node reviewSummaryThreadNode) ReviewSummaryThread {
+	thread := ReviewSummaryThread{
+		Outdated:   bool(node.IsOutdated),
+		Replies:    max(int(node.Comments.TotalCount)-1, 0),
+		ResolvedBy: string(node.ResolvedBy.Login),
+	}
+	if node.Line != nil {
+		thread.Line = int(*node.Line)
+	}
+	if len(node.Comments.Nodes) > 0 {
+		first := node.Comments.Nodes[0]
+		thread.Author = string(first.Author.Login)
+		thread.Comment = truncateReviewText(string(first.Body))
+		thread.URL = first.URL.String()
+	}
+	if thread.Replies > 0 && len(node.LastComment.Nodes) > 0 {
+		last := node.LastComment.Nodes[0]
+		thread.LastReplyAuthor = string(last.Author.Login)
+		thread.LastReply = truncateReviewText(string(last.Body))
+	}
+	return thread
+}
+
+// SummarizeReviews creates a tool that condenses the reviews and review threads of a pull request.
+func SummarizeReviews(getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("summarize_reviews",
+			mcp.WithDescription(t("TOOL_SUMMARIZE_REVIEWS_DESCRIPTION", "Summarize the reviews of a pull request to answer \"what's left to address\": the latest review of every reviewer, and the review threads grouped by file and resolution state, each condensed to its first comment and last reply. Unresolved threads are listed, resolved threads are counted unless include_resolved is set. Long comments are shortened; use pull_request_read for the full review comments.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_SUMMARIZE_REVIEWS_USER_TITLE", "Summarize pull request reviews"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryOwner),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryName),
+			),
+			mcp.WithNumber("pullNumber",
+				mcp.Required(),
+				mcp.Description("Pull request number"),
+			),
+			mcp.WithBoolean("include_resolved",
+				mcp.Description("Also list the resolved threads of every file"),
+			),
+		), func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pullNumber, err := RequiredInt(request, "pullNumber")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			includeResolved, err := OptionalParam[bool](request, "include_resolved")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getGQLClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub GraphQL client: %w", err)
+			}
+
+			var query struct {
+				Repository struct {
+					PullRequest struct {
+						Title          githubv4.String
+						URL            githubv4.URI
+						State          githubv4.PullRequestState
+						ReviewDecision *githubv4.PullRequestReviewDecision
+						LatestReviews  struct {
+							Nodes []struct {
+								Author struct {
+									Login githubv4.String
+								}
+								State       githubv4.PullRequestReviewState
+								Body        githubv4.String
+								SubmittedAt *githubv4.DateTime
+								URL         githubv4.URI
+							}
+						} `graphql:"latestReviews(first: 100)"`
+						ReviewThreads struct {
+							Nodes    []reviewSummaryThreadNode
+							PageInfo struct {
+								HasNextPage githubv4.Boolean
+								EndCursor   githubv4.String
+							}
+						} `graphql:"reviewThreads(first: 100, after: $after)"`
+					} `graphql:"pullRequest(number: $number)"`
+				} `graphql:"repository(owner: $owner, name: $name)"`
+			}
+			vars := map[string]any{
+				"owner":  githubv4.String(owner),
+				"name":   githubv4.String(repo),
+				"number": githubv4.Int(pullNumber), // #nosec G115 - pull request numbers are always small positive integers
+				"after":  (*githubv4.String)(nil),
+			}
+			var threads []reviewSummaryThreadNode
+			for {
+				if err := client.Query(ctx, &query, vars); err != nil {
+					return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "failed to get pull request reviews", err), nil
+				}
+				threads = append(threads, query.Repository.PullRequest.ReviewThreads.Nodes...)
+				if !query.Repository.PullRequest.ReviewThreads.PageInfo.HasNextPage {
+					break
+				}
+				vars["after"] = githubv4.NewString(query.Repository.PullRequest.ReviewThreads.PageInfo.EndCursor)
+			}
+
+			pr := query.Repository.PullRequest
+			summary := ReviewSummary{
+				Number:    pullNumber,
+				Title:     string(pr.Title),
+				URL:       pr.URL.String(),
+				State:     strings.ToLower(string(pr.State)),
+				Reviewers: []ReviewSummaryReviewer{},
+				Files:     []ReviewSummaryFile{},
+			}
+			if pr.ReviewDecision != nil {
+				summary.ReviewDecision = strings.ToLower(string(*pr.ReviewDecision))
+			}
+			for _, review := range pr.LatestReviews.Nodes {
+				reviewer := ReviewSummaryReviewer{
+					Login: string(review.Author.Login),
+					State: strings.ToLower(string(review.State)),
+					Body:  truncateReviewText(string(review.Body)),
+					URL:   review.URL.String(),
+				}
+				if review.SubmittedAt != nil {
+					reviewer.SubmittedAt = FormatTimestamp(review.SubmittedAt.Time)
+				}
+				summary.Reviewers = append(summary.Reviewers, reviewer)
+			}
+
+			files := map[string]*ReviewSummaryFile{}
+			for _, node := range threads {
+				path := string(node.Path)
+				file, ok := files[path]
+				if !ok {
+					file = &ReviewSummaryFile{Path: path, Unresolved: []ReviewSummaryThread{}}
+					files[path] = file
+				}
+				summary.Threads.Total++
+				if node.IsOutdated {
+					summary.Threads.Outdated++
+				}
+				if node.IsResolved {
+					summary.Threads.Resolved++
+					file.ResolvedCount++
+					if includeResolved {
+						file.Resolved = append(file.Resolved, reviewSummaryThread(node))
+					}
+					continue
+				}
+				summary.Threads.Unresolved++
+				file.Unresolved = append(file.Unresolved, reviewSummaryThread(node))
+			}
+
+			// Files with the most unresolved threads come first; threads are in line order.
+			byLine := func(a, b ReviewSummaryThread) int { return cmp.Compare(a.Line, b.Line) }
+			for _, file := range files {
+				slices.SortStableFunc(file.Unresolved, byLine)
+				slices.SortStableFunc(file.Resolved, byLine)
+				summary.Files = append(summary.Files, *file)
+			}
+			slices.SortFunc(summary.Files, func(a, b ReviewSummaryFile) int {
+				return cmp.Or(cmp.Compare(len(b.Unresolved), len(a.Unresolved)), strings.Compare(a.Path, b.Path))
+			})
+			return MarshalledTextResult(summary), nil
+		}
+}
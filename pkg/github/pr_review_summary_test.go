@@ -0,0 +1,126 @@
+package github
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/ghmock"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_SummarizeReviews(t *testing.T) {
+	tool, _ := SummarizeReviews(nil, translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+	assert.True(t, *tool.Annotations.ReadOnlyHint)
+	assert.ElementsMatch(t, []string{"owner", "repo", "pullNumber"}, tool.InputSchema.Required)
+
+	comment := func(login, body string) map[string]any {
+		return map[string]any{"author": map[string]any{"login": login}, "body": body, "url": "https://github.com/octo/app/pull/7#discussion_" + login}
+	}
+	thread := func(path string, line any, resolved, outdated bool, comments ...map[string]any) map[string]any {
+		return map[string]any{
+			"isResolved": resolved, "isOutdated": outdated, "path": path, "line": line,
+			"resolvedBy":  map[string]any{"login": map[bool]string{true: "hubot"}[resolved]},
+			"comments":    map[string]any{"totalCount": len(comments), "nodes": comments[:1]},
+			"lastComment": map[string]any{"nodes": comments[len(comments)-1:]},
+		}
+	}
+	pages := map[string][]any{
+		"": {
+			thread("main.go", 40, false, false, comment("alice", "  Handle the error here.  "), comment("bob", "Done?"), comment("alice", "Not yet")),
+			thread("main.go", 12, false, true, comment("alice", strings.Repeat("é", maxReviewSummaryText+10))),
+			thread("README.md", 3, true, false, comment("carol", "Typo"), comment("bob", "Fixed")),
+		},
+		"c1": {
+			thread("api/server.go", nil, false, false, comment("carol", "Why not a mutex?")),
+			thread("main.go", 50, true, false, comment("carol", "Rename this")),
+		},
+	}
+	newServer := func(t *testing.T) *ghmock.Server {
+		server := ghmock.New(t)
+		server.HandleGraphQL("reviewThreads(", func(_ string, variables map[string]any) (any, []string) {
+			assert.Equal(t, float64(7), variables["number"])
+			after, _ := variables["after"].(string)
+			return map[string]any{"repository": map[string]any{"pullRequest": map[string]any{
+				"title": "Add server", "url": "https://github.com/octo/app/pull/7", "state": "OPEN", "reviewDecision": "CHANGES_REQUESTED",
+				"latestReviews": map[string]any{"nodes": []any{
+					map[string]any{"author": map[string]any{"login": "alice"}, "state": "CHANGES_REQUESTED", "body": "Needs error handling.", "submittedAt": "2025-03-01T10:00:00Z", "url": "https://github.com/octo/app/pull/7#review-1"},
+					map[string]any{"author": map[string]any{"login": "carol"}, "state": "APPROVED", "body": "", "submittedAt": "2025-03-02T10:00:00Z", "url": "https://github.com/octo/app/pull/7#review-2"},
+				}},
+				"reviewThreads": map[string]any{
+					"nodes":    pages[after],
+					"pageInfo": map[string]any{"hasNextPage": after == "", "endCursor": "c1"},
+				},
+			}}}, nil
+		})
+		return server
+	}
+	call := func(t *testing.T, server *ghmock.Server, args map[string]any) ReviewSummary {
+		_, handler := SummarizeReviews(server.GetGQLClient(), translations.NullTranslationHelper)
+		merged := map[string]any{"owner": "octo", "repo": "app", "pullNumber": float64(7)}
+		for k, v := range args {
+			merged[k] = v
+		}
+		result := ghmock.CallTool(t, handler, merged)
+		require.False(t, result.IsError, ghmock.ResultText(t, result))
+		var summary ReviewSummary
+		require.NoError(t, json.Unmarshal([]byte(ghmock.ResultText(t, result)), &summary))
+		return summary
+	}
+
+	t.Run("unresolved threads by file", func(t *testing.T) {
+		summary := call(t, newServer(t), nil)
+
+		assert.Equal(t, "open", summary.State)
+		assert.Equal(t, "changes_requested", summary.ReviewDecision)
+		assert.Equal(t, []ReviewSummaryReviewer{
+			{Login: "alice", State: "changes_requested", Body: "Needs error handling.", SubmittedAt: "2025-03-01T10:00:00Z", URL: "https://github.com/octo/app/pull/7#review-1"},
+			{Login: "carol", State: "approved", SubmittedAt: "2025-03-02T10:00:00Z", URL: "https://github.com/octo/app/pull/7#review-2"},
+		}, summary.Reviewers)
+		assert.Equal(t, ReviewThreadCounts{Total: 5, Unresolved: 3, Resolved: 2, Outdated: 1}, summary.Threads)
+
+		require.Len(t, summary.Files, 3)
+		main := summary.Files[0]
+		assert.Equal(t, "main.go", main.Path)
+		assert.Equal(t, 1, main.ResolvedCount)
+		assert.Empty(t, main.Resolved)
+		require.Len(t, main.Unresolved, 2)
+		outdated := main.Unresolved[0]
+		assert.Equal(t, 12, outdated.Line)
+		assert.True(t, outdated.Outdated)
+		assert.Equal(t, strings.Repeat("é", maxReviewSummaryText)+"…", outdated.Comment)
+		assert.Equal(t, ReviewSummaryThread{
+			Line: 40, Author: "alice", Comment: "Handle the error here.", Replies: 2,
+			LastReplyAuthor: "alice", LastReply: "Not yet", URL: "https://github.com/octo/app/pull/7#discussion_alice",
+		}, main.Unresolved[1])
+
+		assert.Equal(t, "api/server.go", summary.Files[1].Path)
+		assert.Equal(t, []ReviewSummaryThread{{Author: "carol", Comment: "Why not a mutex?", URL: "https://github.com/octo/app/pull/7#discussion_carol"}}, summary.Files[1].Unresolved)
+		assert.Equal(t, ReviewSummaryFile{Path: "README.md", Unresolved: []ReviewSummaryThread{}, ResolvedCount: 1}, summary.Files[2])
+	})
+
+	t.Run("with resolved threads", func(t *testing.T) {
+		summary := call(t, newServer(t), map[string]any{"include_resolved": true})
+
+		require.Len(t, summary.Files, 3)
+		assert.Equal(t, []ReviewSummaryThread{{
+			Line: 3, Author: "carol", Comment: "Typo", Replies: 1, LastReplyAuthor: "bob", LastReply: "Fixed",
+			ResolvedBy: "hubot", URL: "https://github.com/octo/app/pull/7#discussion_carol",
+		}}, summary.Files[2].Resolved)
+		require.Len(t, summary.Files[0].Resolved, 1)
+		assert.Equal(t, 50, summary.Files[0].Resolved[0].Line)
+	})
+
+	t.Run("pull request not found", func(t *testing.T) {
+		server := ghmock.New(t)
+		server.RespondGraphQLError("reviewThreads(", "Could not resolve to a PullRequest with the number of 7.")
+		_, handler := SummarizeReviews(server.GetGQLClient(), translations.NullTranslationHelper)
+		result := ghmock.CallTool(t, handler, map[string]any{"owner": "octo", "repo": "app", "pullNumber": float64(7)})
+		require.True(t, result.IsError)
+		assert.Contains(t, ghmock.ResultText(t, result), "failed to get pull request reviews")
+	})
+}
@@ -0,0 +1,45 @@
+package github
+
+import (
+	"fmt"
+	"time"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// WithExpectedUpdatedAt adds the optional expected_updated_at precondition to a tool that edits a resource, so that
+// an agent does not overwrite an edit a person made after the agent read the resource.
+func WithExpectedUpdatedAt(resource string) mcp.ToolOption {
+	return mcp.WithString("expected_updated_at",
+		mcp.Description(fmt.Sprintf("The updated_at of the %[1]s when it was last read, as an RFC 3339 timestamp. If the %[1]s has been updated since, nothing is changed and a CONFLICT error with the current %[1]s is returned.", resource)),
+	)
+}
+
+// expectedUpdatedAtParam returns the expected_updated_at precondition of an edit, or the zero time if none was given.
+func expectedUpdatedAtParam(r mcp.CallToolRequest) (time.Time, error) {
+	value, err := OptionalParam[string](r, "expected_updated_at")
+	if err != nil || value == "" {
+		return time.Time{}, err
+	}
+	expected, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid expected_updated_at %q: must be an RFC 3339 timestamp such as 2025-01-06T15:04:05Z", value)
+	}
+	return expected, nil
+}
+
+// updatedSinceResult returns a CONFLICT error result holding the current state of a resource if it was updated after
+// the expected time, and nil if the edit may go ahead or no precondition was given. GitHub has no conditional writes
+// for projects, so this narrows the window for lost updates to the time between the check and the write.
+func updatedSinceResult(resource string, expected, updatedAt time.Time, current any) *mcp.CallToolResult {
+	if expected.IsZero() || !updatedAt.After(expected) {
+		return nil
+	}
+	toolErr := ghErrors.NewToolError(ghErrors.CodeConflict,
+		fmt.Sprintf("the %s was updated at %s, after expected_updated_at %s", resource, updatedAt.UTC().Format(time.RFC3339), expected.UTC().Format(time.RFC3339)),
+		"expected_updated_at",
+	)
+	toolErr.Current = current
+	return toolErr.Result()
+}
@@ -0,0 +1,76 @@
+package github
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/pkg/ghmock"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v79/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_UpdateProjectItem_ExpectedUpdatedAt(t *testing.T) {
+	newServer := func(t *testing.T) *ghmock.Server {
+		server := ghmock.New(t)
+		server.Respond("GET /orgs/octo/projectsV2/1/items/801", http.StatusOK, map[string]any{
+			"id": 801, "content_type": "Issue", "updated_at": "2025-01-06T10:00:00Z",
+		})
+		server.Respond("GET /orgs/octo/projectsV2/1/fields/11", http.StatusOK, map[string]any{
+			"id": 11, "name": "Status", "data_type": "single_select", "options": []any{map[string]any{"id": "opt-done", "name": map[string]any{"raw": "Done"}}},
+		})
+		server.Respond("PATCH /orgs/octo/projectsV2/1/items/801", http.StatusOK, map[string]any{"id": 801})
+		return server
+	}
+	call := func(t *testing.T, server *ghmock.Server, expectedUpdatedAt string) (string, bool) {
+		_, handler := UpdateProjectItem(server.GetClient(), server.GetGQLClient(), translations.NullTranslationHelper)
+		result := ghmock.CallTool(t, handler, map[string]any{
+			"owner_type": "org", "owner": "octo", "project_number": float64(1), "item_id": float64(801),
+			"updated_field":       map[string]any{"id": float64(11), "value": "opt-done"},
+			"expected_updated_at": expectedUpdatedAt,
+		})
+		return ghmock.ResultText(t, result), result.IsError
+	}
+
+	t.Run("updated since it was read", func(t *testing.T) {
+		server := newServer(t)
+		text, isError := call(t, server, "2025-01-06T09:59:59Z")
+		require.True(t, isError)
+		var toolErr struct {
+			Code    string               `json:"code"`
+			Param   string               `json:"param"`
+			Current github.ProjectV2Item `json:"current"`
+		}
+		require.NoError(t, json.Unmarshal([]byte(text), &toolErr))
+		assert.Equal(t, "CONFLICT", toolErr.Code)
+		assert.Equal(t, "expected_updated_at", toolErr.Param)
+		assert.Equal(t, int64(801), toolErr.Current.GetID())
+		server.AssertNotRequested("PATCH")
+	})
+
+	t.Run("not updated since it was read", func(t *testing.T) {
+		server := newServer(t)
+		text, isError := call(t, server, "2025-01-06T10:00:00Z")
+		require.False(t, isError, text)
+		server.AssertRequested("PATCH /orgs/octo/projectsV2/1/items/801")
+	})
+
+	t.Run("no precondition", func(t *testing.T) {
+		server := newServer(t)
+		text, isError := call(t, server, "")
+		require.False(t, isError, text)
+		for _, r := range server.Requests() {
+			assert.False(t, r.Method == http.MethodGet && r.Path == "/orgs/octo/projectsV2/1/items/801", "the item should not be read")
+		}
+	})
+
+	t.Run("invalid timestamp", func(t *testing.T) {
+		server := ghmock.New(t)
+		text, isError := call(t, server, "2025-01-06")
+		require.True(t, isError)
+		assert.Contains(t, text, `invalid expected_updated_at "2025-01-06"`)
+		assert.Empty(t, server.Requests())
+	})
+}
@@ -0,0 +1,190 @@
+package github
+
+import (
+	"context"
+	"fmt"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v79/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/shurcooL/githubv4"
+)
+
+// projectCollaboratorRoles maps the roles of add_project_collaborator to the roles of the GraphQL API.
+var projectCollaboratorRoles = map[string]githubv4.ProjectV2Roles{
+	"read":  githubv4.ProjectV2RolesReader,
+	"write": githubv4.ProjectV2RolesWriter,
+	"admin": githubv4.ProjectV2RolesAdmin,
+}
+
+// ProjectCollaboratorsUpdate is the result of granting or removing access to a project.
+type ProjectCollaboratorsUpdate struct {
+	Role  string   `json:"role"`
+	Users []string `json:"users,omitempty"`
+	Teams []string `json:"teams,omitempty"`
+}
+
+// withProjectCollaboratorParams adds the parameters naming the project and the users and teams whose access changes.
+func withProjectCollaboratorParams() mcp.ToolOption {
+	return func(tool *mcp.Tool) {
+		for _, opt := range []mcp.ToolOption{
+			mcp.WithString("owner_type",
+				mcp.Required(),
+				mcp.Description("Owner type"),
+				mcp.Enum("user", "org"),
+			),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("If owner_type == user it is the handle for the GitHub user account. If owner_type == org it is the name of the organization. The name is not case sensitive."),
+			),
+			mcp.WithNumber("project_number",
+				mcp.Required(),
+				mcp.Description("The project's number."),
+			),
+			mcp.WithArray("users",
+				mcp.Description("Logins of the users, e.g. [\"octocat\"]"),
+				mcp.WithStringItems(),
+			),
+			mcp.WithArray("teams",
+				mcp.Description("Slugs of teams of the organization, e.g. [\"platform\"]. Only for projects of organizations."),
+				mcp.WithStringItems(),
+			),
+		} {
+			opt(tool)
+		}
+	}
+}
+
+// updateProjectCollaborators sets the role of users and teams on a project. The NONE role removes their access.
+func updateProjectCollaborators(ctx context.Context, req mcp.CallToolRequest, getClient GetClientFn, getGQLClient GetGQLClientFn, role githubv4.ProjectV2Roles) (*mcp.CallToolResult, []string, []string, error) {
+	ownerType, err := RequiredParam[string](req, "owner_type")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil, nil, nil
+	}
+	owner, err := RequiredParam[string](req, "owner")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil, nil, nil
+	}
+	projectNumber, err := ValidateProjectNumber(req)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil, nil, nil
+	}
+	users, err := OptionalStringArrayParam(req, "users")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil, nil, nil
+	}
+	teams, err := OptionalStringArrayParam(req, "teams")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil, nil, nil
+	}
+	if len(users) == 0 && len(teams) == 0 {
+		return mcp.NewToolResultError("at least one of users or teams is required"), nil, nil, nil
+	}
+	if len(teams) > 0 && ownerType != "org" {
+		return mcp.NewToolResultError("teams can only be given access to projects of organizations"), nil, nil, nil
+	}
+
+	client, err := getClient(ctx)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to get GitHub client: %w", err)
+	}
+	gqlClient, err := getGQLClient(ctx)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to get GitHub GraphQL client: %w", err)
+	}
+
+	project, resp, err := getProjectV2(ctx, client, ownerType, owner, projectNumber)
+	if err != nil {
+		return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get project", resp, err), nil, nil, nil
+	}
+	_ = resp.Body.Close()
+
+	var collaborators []githubv4.ProjectV2Collaborator
+	for _, login := range users {
+		var user *github.User
+		user, resp, err = client.Users.Get(ctx, login)
+		if err != nil {
+			return ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("failed to get user %s", login), resp, err), nil, nil, nil
+		}
+		_ = resp.Body.Close()
+		collaborators = append(collaborators, githubv4.ProjectV2Collaborator{Role: role, UserID: githubv4.NewID(user.GetNodeID())})
+	}
+	for _, slug := range teams {
+		var team *github.Team
+		team, resp, err = client.Teams.GetTeamBySlug(ctx, owner, slug)
+		if err != nil {
+			return ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("failed to get team %s", slug), resp, err), nil, nil, nil
+		}
+		_ = resp.Body.Close()
+		collaborators = append(collaborators, githubv4.ProjectV2Collaborator{Role: role, TeamID: githubv4.NewID(team.GetNodeID())})
+	}
+
+	var mutation struct {
+		UpdateProjectV2Collaborators struct {
+			ClientMutationID githubv4.String
+		} `graphql:"updateProjectV2Collaborators(input: $input)"`
+	}
+	input := githubv4.UpdateProjectV2CollaboratorsInput{
+		ProjectID:     githubv4.ID(project.GetNodeID()),
+		Collaborators: collaborators,
+	}
+	if err := gqlClient.Mutate(ctx, &mutation, input, nil); err != nil {
+		return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "failed to update project collaborators", err), nil, nil, nil
+	}
+	return nil, users, teams, nil
+}
+
+// AddProjectCollaborator creates a tool that gives users and teams access to a project.
+func AddProjectCollaborator(getClient GetClientFn, getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("add_project_collaborator",
+			mcp.WithDescription(t("TOOL_ADD_PROJECT_COLLABORATOR_DESCRIPTION", "Give users, or teams of the owning organization, access to a Project of a user or org with a role, or change the role of existing collaborators.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:           t("TOOL_ADD_PROJECT_COLLABORATOR_USER_TITLE", "Add project collaborator"),
+				ReadOnlyHint:    ToBoolPtr(false),
+				DestructiveHint: ToBoolPtr(false),
+				IdempotentHint:  ToBoolPtr(true),
+			}),
+			withProjectCollaboratorParams(),
+			mcp.WithString("role",
+				mcp.Required(),
+				mcp.Description("The role to grant: read to view the project, write to also edit it, admin to also manage its settings and access."),
+				mcp.Enum("read", "write", "admin"),
+			),
+		), func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			roleName, err := RequiredParam[string](req, "role")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			role, ok := projectCollaboratorRoles[roleName]
+			if !ok {
+				return mcp.NewToolResultError(fmt.Sprintf("invalid role %q: must be one of read, write, admin", roleName)), nil
+			}
+			errResult, users, teams, err := updateProjectCollaborators(ctx, req, getClient, getGQLClient, role)
+			if errResult != nil || err != nil {
+				return errResult, err
+			}
+			return MarshalledTextResult(ProjectCollaboratorsUpdate{Role: roleName, Users: users, Teams: teams}), nil
+		}
+}
+
+// RemoveProjectCollaborator creates a tool that removes the access of users and teams to a project.
+func RemoveProjectCollaborator(getClient GetClientFn, getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("remove_project_collaborator",
+			mcp.WithDescription(t("TOOL_REMOVE_PROJECT_COLLABORATOR_DESCRIPTION", "Remove the direct access of users, or teams of the owning organization, to a Project of a user or org. They keep any access they have through the organization or a team.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:           t("TOOL_REMOVE_PROJECT_COLLABORATOR_USER_TITLE", "Remove project collaborator"),
+				ReadOnlyHint:    ToBoolPtr(false),
+				DestructiveHint: ToBoolPtr(true),
+				IdempotentHint:  ToBoolPtr(true),
+			}),
+			withProjectCollaboratorParams(),
+		), func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			errResult, users, teams, err := updateProjectCollaborators(ctx, req, getClient, getGQLClient, githubv4.ProjectV2RolesNone)
+			if errResult != nil || err != nil {
+				return errResult, err
+			}
+			return MarshalledTextResult(ProjectCollaboratorsUpdate{Role: "none", Users: users, Teams: teams}), nil
+		}
+}
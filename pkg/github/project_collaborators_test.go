@@ -0,0 +1,78 @@
+package github
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/ghmock"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ProjectCollaborators(t *testing.T) {
+	addTool, _ := AddProjectCollaborator(nil, nil, translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(addTool.Name, addTool))
+	assert.False(t, *addTool.Annotations.DestructiveHint)
+	assert.ElementsMatch(t, []string{"owner_type", "owner", "project_number", "role"}, addTool.InputSchema.Required)
+	removeTool, _ := RemoveProjectCollaborator(nil, nil, translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(removeTool.Name, removeTool))
+	assert.True(t, *removeTool.Annotations.DestructiveHint)
+
+	newServer := func(t *testing.T) *ghmock.Server {
+		server := ghmock.New(t)
+		server.Respond("GET /orgs/octo/projectsV2/1", http.StatusOK, map[string]any{"id": 1, "node_id": "PVT_1"})
+		server.Respond("GET /users/octocat", http.StatusOK, map[string]any{"login": "octocat", "node_id": "U_1"})
+		server.Respond("GET /orgs/octo/teams/platform", http.StatusOK, map[string]any{"slug": "platform", "node_id": "T_1"})
+		server.RespondGraphQL("updateProjectV2Collaborators(", map[string]any{"updateProjectV2Collaborators": map[string]any{"clientMutationId": ""}})
+		return server
+	}
+	args := func(extra map[string]any) map[string]any {
+		a := map[string]any{"owner_type": "org", "owner": "octo", "project_number": float64(1), "users": []any{"octocat"}, "teams": []any{"platform"}}
+		for k, v := range extra {
+			a[k] = v
+		}
+		return a
+	}
+
+	t.Run("add", func(t *testing.T) {
+		server := newServer(t)
+		_, handler := AddProjectCollaborator(server.GetClient(), server.GetGQLClient(), translations.NullTranslationHelper)
+		result := ghmock.CallTool(t, handler, args(map[string]any{"role": "write"}))
+		require.False(t, result.IsError, ghmock.ResultText(t, result))
+		assert.JSONEq(t, `{"role":"write","users":["octocat"],"teams":["platform"]}`, ghmock.ResultText(t, result))
+		assert.Equal(t, map[string]any{"projectId": "PVT_1", "collaborators": []any{
+			map[string]any{"role": "WRITER", "userId": "U_1"},
+			map[string]any{"role": "WRITER", "teamId": "T_1"},
+		}}, server.AssertGraphQL("updateProjectV2Collaborators(").Variables["input"])
+	})
+
+	t.Run("remove", func(t *testing.T) {
+		server := newServer(t)
+		_, handler := RemoveProjectCollaborator(server.GetClient(), server.GetGQLClient(), translations.NullTranslationHelper)
+		result := ghmock.CallTool(t, handler, args(map[string]any{"teams": nil}))
+		require.False(t, result.IsError, ghmock.ResultText(t, result))
+		assert.Equal(t, map[string]any{"projectId": "PVT_1", "collaborators": []any{
+			map[string]any{"role": "NONE", "userId": "U_1"},
+		}}, server.AssertGraphQL("updateProjectV2Collaborators(").Variables["input"])
+	})
+
+	for name, tc := range map[string]struct {
+		args map[string]any
+		want string
+	}{
+		"invalid role":            {args(map[string]any{"role": "owner"}), `invalid role "owner": must be one of read, write, admin`},
+		"nobody":                  {args(map[string]any{"role": "read", "users": nil, "teams": nil}), "at least one of users or teams is required"},
+		"teams on a user project": {args(map[string]any{"role": "read", "owner_type": "user"}), "teams can only be given access to projects of organizations"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			server := ghmock.New(t)
+			_, handler := AddProjectCollaborator(server.GetClient(), server.GetGQLClient(), translations.NullTranslationHelper)
+			result := ghmock.CallTool(t, handler, tc.args)
+			require.True(t, result.IsError)
+			assert.Equal(t, tc.want, ghmock.ResultText(t, result))
+			assert.Empty(t, server.Requests())
+		})
+	}
+}
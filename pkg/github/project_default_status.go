@@ -0,0 +1,88 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/google/go-github/v79/github"
+)
+
+// projectStatusFieldName is the name of the single select field that holds the column of an item on a board.
+const projectStatusFieldName = "Status"
+
+// ProjectDefaultStatuses maps a project, written as owner/number such as octo/1, to the Status that add_project_item
+// sets on the items it adds, so new items land in a column such as Triage instead of having no status.
+type ProjectDefaultStatuses map[string]string
+
+// isProject reports whether a project written as owner/number, as in server options, is the given project. Owners are
+// not case sensitive.
+func isProject(project, owner string, number int) bool {
+	projectOwner, projectNumber, ok := strings.Cut(project, "/")
+	return ok && strings.EqualFold(projectOwner, owner) && projectNumber == strconv.Itoa(number)
+}
+
+// lookup returns the default status of a project.
+func (d ProjectDefaultStatuses) lookup(owner string, number int) (string, bool) {
+	for project, status := range d {
+		if isProject(project, owner, number) {
+			return status, true
+		}
+	}
+	return "", false
+}
+
+// setDefaultStatus sets the Status field of an item to a status, unless the item already has one, which is the case
+// when it was on the project before being added again.
+func setDefaultStatus(ctx context.Context, client *github.Client, ownerType, owner string, number int, itemID int64, status string) (*github.Response, error) {
+	fields, resp, err := listAllProjectFields(ctx, client, ownerType, owner, number)
+	if err != nil {
+		return resp, err
+	}
+	var statusField *github.ProjectV2Field
+	for _, field := range fields {
+		if strings.EqualFold(field.GetName(), projectStatusFieldName) && field.GetDataType() == "single_select" {
+			statusField = field
+			break
+		}
+	}
+	if statusField == nil {
+		return nil, fmt.Errorf("the project has no single select field named %q", projectStatusFieldName)
+	}
+	var optionID string
+	for _, option := range statusField.Options {
+		if strings.EqualFold(option.GetName().GetRaw(), status) {
+			optionID = option.GetID()
+			break
+		}
+	}
+	if optionID == "" {
+		return nil, fmt.Errorf("the %s field has no option %q", statusField.GetName(), status)
+	}
+
+	item, resp, err := getProjectItem(ctx, client, ownerType, owner, number, itemID, &github.GetProjectItemOptions{Fields: []int64{statusField.GetID()}})
+	if err != nil {
+		return resp, err
+	}
+	_ = resp.Body.Close()
+	for _, value := range item.Fields {
+		if value.GetID() == statusField.GetID() && value.Value != nil {
+			return resp, nil
+		}
+	}
+
+	update := &github.UpdateProjectItemOptions{
+		Fields: []*github.UpdateProjectV2Field{{ID: statusField.GetID(), Value: optionID}},
+	}
+	if ownerType == "org" {
+		_, resp, err = client.Projects.UpdateOrganizationProjectItem(ctx, owner, number, itemID, update)
+	} else {
+		_, resp, err = client.Projects.UpdateUserProjectItem(ctx, owner, number, itemID, update)
+	}
+	if err != nil {
+		return resp, err
+	}
+	_ = resp.Body.Close()
+	return resp, nil
+}
@@ -0,0 +1,87 @@
+package github
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/pkg/ghmock"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ProjectDefaultStatuses_lookup(t *testing.T) {
+	defaults := ProjectDefaultStatuses{"Octo/1": "Triage", "mona/12": "Inbox"}
+
+	status, ok := defaults.lookup("octo", 1)
+	assert.True(t, ok)
+	assert.Equal(t, "Triage", status)
+
+	_, ok = defaults.lookup("octo", 12)
+	assert.False(t, ok)
+	_, ok = ProjectDefaultStatuses(nil).lookup("octo", 1)
+	assert.False(t, ok)
+}
+
+func Test_AddProjectItem_DefaultStatus(t *testing.T) {
+	newServer := func(t *testing.T, status any) *ghmock.Server {
+		server := ghmock.New(t)
+		server.Respond("POST /orgs/octo/projectsV2/1/items", http.StatusCreated, map[string]any{"id": 9001, "content_type": "Issue"})
+		server.Respond("GET /orgs/octo/projectsV2/1/fields", http.StatusOK, []map[string]any{
+			{"id": 9, "name": "Title", "data_type": "title"},
+			{
+				"id": 11, "name": "Status", "data_type": "single_select",
+				"options": []map[string]any{
+					{"id": "opt-triage", "name": map[string]any{"raw": "Triage"}},
+					{"id": "opt-done", "name": map[string]any{"raw": "Done"}},
+				},
+			},
+		})
+		server.Respond("GET /orgs/octo/projectsV2/1/items/9001", http.StatusOK, map[string]any{
+			"id": 9001, "fields": []map[string]any{{"id": 11, "name": "Status", "data_type": "single_select", "value": status}},
+		})
+		server.Respond("PATCH /orgs/octo/projectsV2/1/items/9001", http.StatusOK, map[string]any{"id": 9001})
+		return server
+	}
+	call := func(t *testing.T, server *ghmock.Server, defaults ProjectDefaultStatuses) (string, bool) {
+		_, handler := AddProjectItem(server.GetClient(), translations.NullTranslationHelper, defaults)
+		result := ghmock.CallTool(t, handler, map[string]any{
+			"owner_type": "org", "owner": "octo", "project_number": float64(1), "item_type": "issue", "item_id": float64(1234),
+		})
+		return ghmock.ResultText(t, result), result.IsError
+	}
+
+	t.Run("sets the status of a new item", func(t *testing.T) {
+		server := newServer(t, nil)
+		text, isError := call(t, server, ProjectDefaultStatuses{"octo/1": "triage"})
+		require.False(t, isError, text)
+		assert.Equal(t, "11", server.AssertRequested("GET /orgs/octo/projectsV2/1/items/9001").Query.Get("fields"))
+		var update map[string]any
+		require.NoError(t, server.AssertRequested("PATCH /orgs/octo/projectsV2/1/items/9001").DecodeBody(&update))
+		assert.Equal(t, map[string]any{"fields": []any{map[string]any{"id": float64(11), "value": "opt-triage"}}}, update)
+	})
+
+	t.Run("keeps the status of an item already on the board", func(t *testing.T) {
+		server := newServer(t, map[string]any{"id": "opt-done", "name": map[string]any{"raw": "Done"}})
+		text, isError := call(t, server, ProjectDefaultStatuses{"octo/1": "Triage"})
+		require.False(t, isError, text)
+		server.AssertNotRequested("PATCH")
+	})
+
+	t.Run("other projects", func(t *testing.T) {
+		server := newServer(t, nil)
+		text, isError := call(t, server, ProjectDefaultStatuses{"octo/2": "Triage"})
+		require.False(t, isError, text)
+		server.AssertNotRequested("GET")
+		server.AssertNotRequested("PATCH")
+	})
+
+	t.Run("unknown status", func(t *testing.T) {
+		server := newServer(t, nil)
+		text, isError := call(t, server, ProjectDefaultStatuses{"octo/1": "Backlog"})
+		require.True(t, isError)
+		assert.Contains(t, text, `item 9001 was added but its default status "Backlog" could not be set`)
+		assert.Contains(t, text, `the Status field has no option "Backlog"`)
+		server.AssertNotRequested("PATCH")
+	})
+}
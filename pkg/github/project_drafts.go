@@ -0,0 +1,287 @@
+package github
+
+import (
+	"context"
+	"fmt"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v79/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/shurcooL/githubv4"
+)
+
+// ProjectDraftIssue is a draft issue added to a project.
+type ProjectDraftIssue struct {
+	ItemID     int64  `json:"item_id"`
+	ItemNodeID string `json:"item_node_id"`
+	Title      string `json:"title"`
+}
+
+// ConvertedDraftIssue is the issue a draft issue of a project was converted to, and the project item that now holds
+// it.
+type ConvertedDraftIssue struct {
+	ItemID      int64  `json:"item_id"`
+	ItemNodeID  string `json:"item_node_id"`
+	IssueID     int64  `json:"issue_id"`
+	IssueNodeID string `json:"issue_node_id"`
+	IssueNumber int    `json:"issue_number"`
+	IssueURL    string `json:"issue_url"`
+}
+
+// projectDraftItem is the project item of a draft issue added through GraphQL.
+type projectDraftItem struct {
+	ID         githubv4.ID
+	DatabaseID githubv4.Int `graphql:"databaseId"`
+}
+
+// addProjectDraftIssue adds a draft issue to a project. Draft issues can only be created through GraphQL.
+func addProjectDraftIssue(ctx context.Context, gqlClient *githubv4.Client, input githubv4.AddProjectV2DraftIssueInput) (projectDraftItem, error) {
+	var mutation struct {
+		AddProjectV2DraftIssue struct {
+			ProjectItem projectDraftItem
+		} `graphql:"addProjectV2DraftIssue(input: $input)"`
+	}
+	if err := gqlClient.Mutate(ctx, &mutation, input, nil); err != nil {
+		return projectDraftItem{}, err
+	}
+	return mutation.AddProjectV2DraftIssue.ProjectItem, nil
+}
+
+// getProjectItem gets an item of a project of a user or an organization.
+func getProjectItem(ctx context.Context, client *github.Client, ownerType, owner string, number int, itemID int64, opts *github.GetProjectItemOptions) (*github.ProjectV2Item, *github.Response, error) {
+	if ownerType == "org" {
+		return client.Projects.GetOrganizationProjectItem(ctx, owner, number, itemID, opts)
+	}
+	return client.Projects.GetUserProjectItem(ctx, owner, number, itemID, opts)
+}
+
+// CreateDraftIssue creates a tool that adds a draft issue to a project.
+func CreateDraftIssue(getClient GetClientFn, getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("create_draft_issue",
+			mcp.WithDescription(t("TOOL_CREATE_DRAFT_ISSUE_DESCRIPTION", "Add a draft issue to a Project of a user or org. Draft issues only exist in the project until they are converted to an issue of a repository with convert_draft_issue_to_issue.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:           t("TOOL_CREATE_DRAFT_ISSUE_USER_TITLE", "Create draft issue"),
+				ReadOnlyHint:    ToBoolPtr(false),
+				DestructiveHint: ToBoolPtr(false),
+				IdempotentHint:  ToBoolPtr(false),
+			}),
+			mcp.WithString("owner_type",
+				mcp.Required(),
+				mcp.Description("Owner type"),
+				mcp.Enum("user", "org"),
+			),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("If owner_type == user it is the handle for the GitHub user account. If owner_type == org it is the name of the organization. The name is not case sensitive."),
+			),
+			mcp.WithNumber("project_number",
+				mcp.Required(),
+				mcp.Description("The project's number."),
+			),
+			mcp.WithString("title",
+				mcp.Required(),
+				mcp.Description("Title of the draft issue"),
+			),
+			mcp.WithString("body",
+				mcp.Description("Body of the draft issue, in Markdown"),
+			),
+			mcp.WithArray("assignees",
+				mcp.Description("Logins of the users to assign to the draft issue"),
+				mcp.WithStringItems(),
+			),
+		), func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			ownerType, err := RequiredParam[string](req, "owner_type")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			owner, err := RequiredParam[string](req, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			projectNumber, err := ValidateProjectNumber(req)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			title, err := RequiredParam[string](req, "title")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			body, err := OptionalParam[string](req, "body")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			assignees, err := OptionalStringArrayParam(req, "assignees")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			gqlClient, err := getGQLClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub GraphQL client: %w", err)
+			}
+
+			project, resp, err := getProjectV2(ctx, client, ownerType, owner, projectNumber)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get project", resp, err), nil
+			}
+			_ = resp.Body.Close()
+
+			input := githubv4.AddProjectV2DraftIssueInput{
+				ProjectID: githubv4.ID(project.GetNodeID()),
+				Title:     githubv4.String(title),
+			}
+			if body != "" {
+				input.Body = githubv4.NewString(githubv4.String(body))
+			}
+			if len(assignees) > 0 {
+				assigneeIDs := make([]githubv4.ID, 0, len(assignees))
+				for _, login := range assignees {
+					user, resp, err := client.Users.Get(ctx, login)
+					if err != nil {
+						return ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("failed to get assignee '%s'", login), resp, err), nil
+					}
+					_ = resp.Body.Close()
+					assigneeIDs = append(assigneeIDs, githubv4.ID(user.GetNodeID()))
+				}
+				input.AssigneeIDs = &assigneeIDs
+			}
+
+			item, err := addProjectDraftIssue(ctx, gqlClient, input)
+			if err != nil {
+				return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "failed to add draft issue", err), nil
+			}
+
+			return MarshalledTextResult(ProjectDraftIssue{
+				ItemID:     int64(item.DatabaseID),
+				ItemNodeID: fmt.Sprint(item.ID),
+				Title:      title,
+			}), nil
+		}
+}
+
+// ConvertDraftIssueToIssue creates a tool that converts a draft issue of a project to an issue of a repository.
+func ConvertDraftIssueToIssue(getClient GetClientFn, getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("convert_draft_issue_to_issue",
+			mcp.WithDescription(t("TOOL_CONVERT_DRAFT_ISSUE_TO_ISSUE_DESCRIPTION", "Convert a draft issue of a Project of a user or org to an issue of a repository. The title, body and assignees of the draft are kept and the item stays in the project with its field values.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:           t("TOOL_CONVERT_DRAFT_ISSUE_TO_ISSUE_USER_TITLE", "Convert draft issue to issue"),
+				ReadOnlyHint:    ToBoolPtr(false),
+				DestructiveHint: ToBoolPtr(false),
+				IdempotentHint:  ToBoolPtr(false),
+			}),
+			mcp.WithString("owner_type",
+				mcp.Required(),
+				mcp.Description("Owner type"),
+				mcp.Enum("user", "org"),
+			),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("If owner_type == user it is the handle for the GitHub user account. If owner_type == org it is the name of the organization. The name is not case sensitive."),
+			),
+			mcp.WithNumber("project_number",
+				mcp.Required(),
+				mcp.Description("The project's number."),
+			),
+			mcp.WithNumber("item_id",
+				mcp.Required(),
+				mcp.Description("The ID of the project item of the draft issue."),
+			),
+			mcp.WithString("repo_owner",
+				mcp.Required(),
+				mcp.Description("Owner of the repository to create the issue in"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Name of the repository to create the issue in"),
+			),
+		), func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			ownerType, err := RequiredParam[string](req, "owner_type")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			owner, err := RequiredParam[string](req, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			projectNumber, err := ValidateProjectNumber(req)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			itemID, err := ValidateProjectItemID(req)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repoOwner, err := RequiredParam[string](req, "repo_owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repoName, err := RequiredParam[string](req, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			gqlClient, err := getGQLClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub GraphQL client: %w", err)
+			}
+
+			item, resp, err := getProjectItem(ctx, client, ownerType, owner, projectNumber, itemID, nil)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get project item", resp, err), nil
+			}
+			_ = resp.Body.Close()
+			if contentType := item.GetContentType(); contentType != "DraftIssue" {
+				return mcp.NewToolResultError(fmt.Sprintf("item %d is not a draft issue: its content type is %s", itemID, contentType)), nil
+			}
+
+			repository, resp, err := client.Repositories.Get(ctx, repoOwner, repoName)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get repository", resp, err), nil
+			}
+			_ = resp.Body.Close()
+
+			var mutation struct {
+				ConvertProjectV2DraftIssueItemToIssue struct {
+					Item struct {
+						ID         githubv4.ID
+						DatabaseID githubv4.Int `graphql:"databaseId"`
+						Content    struct {
+							Issue struct {
+								ID         githubv4.ID
+								DatabaseID githubv4.Int `graphql:"databaseId"`
+								Number     githubv4.Int
+								URL        githubv4.String
+							} `graphql:"... on Issue"`
+						}
+					}
+				} `graphql:"convertProjectV2DraftIssueItemToIssue(input: $input)"`
+			}
+			input := githubv4.ConvertProjectV2DraftIssueItemToIssueInput{
+				ItemID:       githubv4.ID(item.GetNodeID()),
+				RepositoryID: githubv4.ID(repository.GetNodeID()),
+			}
+			if err := gqlClient.Mutate(ctx, &mutation, input, nil); err != nil {
+				return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "failed to convert draft issue", err), nil
+			}
+
+			converted := mutation.ConvertProjectV2DraftIssueItemToIssue.Item
+			return MarshalledTextResult(ConvertedDraftIssue{
+				ItemID:      int64(converted.DatabaseID),
+				ItemNodeID:  fmt.Sprint(converted.ID),
+				IssueID:     int64(converted.Content.Issue.DatabaseID),
+				IssueNodeID: fmt.Sprint(converted.Content.Issue.ID),
+				IssueNumber: int(converted.Content.Issue.Number),
+				IssueURL:    string(converted.Content.Issue.URL),
+			}), nil
+		}
+}
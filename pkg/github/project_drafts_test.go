@@ -0,0 +1,91 @@
+package github
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/ghmock"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_CreateDraftIssue(t *testing.T) {
+	tool, _ := CreateDraftIssue(nil, nil, translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+	assert.False(t, *tool.Annotations.ReadOnlyHint)
+	assert.ElementsMatch(t, []string{"owner_type", "owner", "project_number", "title"}, tool.InputSchema.Required)
+
+	server := ghmock.New(t)
+	server.Respond("GET /users/mona/projectsV2/3", http.StatusOK, map[string]any{"id": 3, "node_id": "PVT_3"})
+	server.Respond("GET /users/hubot", http.StatusOK, map[string]any{"login": "hubot", "node_id": "U_hubot"})
+	var input map[string]any
+	server.HandleGraphQL("addProjectV2DraftIssue(", func(_ string, vars map[string]any) (any, []string) {
+		input = vars["input"].(map[string]any)
+		return map[string]any{"addProjectV2DraftIssue": map[string]any{"projectItem": map[string]any{"id": "PVTI_7", "databaseId": 7}}}, nil
+	})
+	_, handler := CreateDraftIssue(server.GetClient(), server.GetGQLClient(), translations.NullTranslationHelper)
+
+	result := ghmock.CallTool(t, handler, map[string]any{
+		"owner_type": "user", "owner": "mona", "project_number": float64(3),
+		"title": "Write release notes", "body": "For v2.0", "assignees": []any{"hubot"},
+	})
+	require.False(t, result.IsError, ghmock.ResultText(t, result))
+	assert.Equal(t, map[string]any{
+		"projectId": "PVT_3", "title": "Write release notes", "body": "For v2.0", "assigneeIds": []any{"U_hubot"},
+	}, input)
+
+	var draft ProjectDraftIssue
+	require.NoError(t, json.Unmarshal([]byte(ghmock.ResultText(t, result)), &draft))
+	assert.Equal(t, ProjectDraftIssue{ItemID: 7, ItemNodeID: "PVTI_7", Title: "Write release notes"}, draft)
+}
+
+func Test_ConvertDraftIssueToIssue(t *testing.T) {
+	tool, _ := ConvertDraftIssueToIssue(nil, nil, translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+	assert.False(t, *tool.Annotations.ReadOnlyHint)
+	assert.ElementsMatch(t, []string{"owner_type", "owner", "project_number", "item_id", "repo_owner", "repo"}, tool.InputSchema.Required)
+
+	call := func(t *testing.T, server *ghmock.Server) (string, bool) {
+		_, handler := ConvertDraftIssueToIssue(server.GetClient(), server.GetGQLClient(), translations.NullTranslationHelper)
+		result := ghmock.CallTool(t, handler, map[string]any{
+			"owner_type": "org", "owner": "octo", "project_number": float64(1), "item_id": float64(7),
+			"repo_owner": "octo", "repo": "app",
+		})
+		return ghmock.ResultText(t, result), result.IsError
+	}
+
+	t.Run("converts the draft", func(t *testing.T) {
+		server := ghmock.New(t)
+		server.Respond("GET /orgs/octo/projectsV2/1/items/7", http.StatusOK, map[string]any{"id": 7, "node_id": "PVTI_7", "content_type": "DraftIssue"})
+		server.Respond("GET /repos/octo/app", http.StatusOK, map[string]any{"id": 1, "node_id": "R_app"})
+		var input map[string]any
+		server.HandleGraphQL("convertProjectV2DraftIssueItemToIssue(", func(_ string, vars map[string]any) (any, []string) {
+			input = vars["input"].(map[string]any)
+			return map[string]any{"convertProjectV2DraftIssueItemToIssue": map[string]any{"item": map[string]any{
+				"id": "PVTI_7", "databaseId": 7,
+				"content": map[string]any{"id": "I_42", "databaseId": 555, "number": 42, "url": "https://github.com/octo/app/issues/42"},
+			}}}, nil
+		})
+
+		text, isError := call(t, server)
+		require.False(t, isError, text)
+		assert.Equal(t, map[string]any{"itemId": "PVTI_7", "repositoryId": "R_app"}, input)
+		var converted ConvertedDraftIssue
+		require.NoError(t, json.Unmarshal([]byte(text), &converted))
+		assert.Equal(t, ConvertedDraftIssue{
+			ItemID: 7, ItemNodeID: "PVTI_7", IssueID: 555, IssueNodeID: "I_42", IssueNumber: 42, IssueURL: "https://github.com/octo/app/issues/42",
+		}, converted)
+	})
+
+	t.Run("not a draft", func(t *testing.T) {
+		server := ghmock.New(t)
+		server.Respond("GET /orgs/octo/projectsV2/1/items/7", http.StatusOK, map[string]any{"id": 7, "node_id": "PVTI_7", "content_type": "Issue"})
+		text, isError := call(t, server)
+		require.True(t, isError)
+		assert.Contains(t, text, "item 7 is not a draft issue: its content type is Issue")
+		assert.Empty(t, server.Mutations())
+	})
+}
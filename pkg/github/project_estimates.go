@@ -0,0 +1,312 @@
+package github
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v79/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// projectBoard is the items of a project with the values of the fields that summaries group them by: the Status
+// field, the assignees, the labels, the first iteration field, the date fields and optionally a number field holding
+// estimates.
+type projectBoard struct {
+	status    *github.ProjectV2Field
+	iteration *github.ProjectV2Field
+	estimate  *github.ProjectV2Field
+	dates     []*github.ProjectV2Field
+	items     []projectBoardItem
+}
+
+// projectBoardItem is an item of a project board. Values the item does not have are empty. Dates are keyed by field
+// name.
+type projectBoardItem struct {
+	item      *projectItemWithContent
+	status    string
+	assignees []string
+	labels    []string
+	iteration string
+	estimate  *float64
+	dates     map[string]string
+}
+
+// loadProjectBoard lists the items of a project for a summary, given the fields of the project and the number field to
+// read estimates from, if any.
+func loadProjectBoard(ctx context.Context, client *github.Client, ownerType, owner string, number int, fields []*github.ProjectV2Field, estimate *github.ProjectV2Field) (*projectBoard, *github.Response, error) {
+	board := &projectBoard{estimate: estimate}
+	var assignees, labels *github.ProjectV2Field
+	for _, field := range fields {
+		switch {
+		case board.status == nil && strings.EqualFold(field.GetName(), projectStatusFieldName) && field.GetDataType() == "single_select":
+			board.status = field
+		case board.iteration == nil && field.GetDataType() == "iteration":
+			board.iteration = field
+		case assignees == nil && field.GetDataType() == "assignees":
+			assignees = field
+		case labels == nil && field.GetDataType() == "labels":
+			labels = field
+		case field.GetDataType() == "date":
+			board.dates = append(board.dates, field)
+		}
+	}
+
+	var fieldIDs []int64
+	for _, field := range append([]*github.ProjectV2Field{board.status, board.iteration, board.estimate, assignees, labels}, board.dates...) {
+		if field != nil {
+			fieldIDs = append(fieldIDs, field.GetID())
+		}
+	}
+	items, resp, err := listAllProjectItems(ctx, client, ownerType, owner, number, fieldIDs)
+	if err != nil {
+		return nil, resp, err
+	}
+	for _, item := range items {
+		boardItem := projectBoardItem{item: item, dates: map[string]string{}}
+		for _, value := range item.Fields {
+			switch {
+			case board.status != nil && value.GetID() == board.status.GetID():
+				boardItem.status, _ = snapshotFieldValue("single_select", value.Value).(string)
+			case board.iteration != nil && value.GetID() == board.iteration.GetID():
+				boardItem.iteration, _ = snapshotFieldValue("iteration", value.Value).(string)
+			case board.estimate != nil && value.GetID() == board.estimate.GetID():
+				if estimate, ok := value.Value.(float64); ok {
+					boardItem.estimate = &estimate
+				}
+			case assignees != nil && value.GetID() == assignees.GetID():
+				boardItem.assignees = namesOf(value.Value, "login")
+			case labels != nil && value.GetID() == labels.GetID():
+				boardItem.labels = namesOf(value.Value, "name")
+			default:
+				for _, field := range board.dates {
+					if date, ok := value.Value.(string); ok && value.GetID() == field.GetID() && len(date) >= len(time.DateOnly) {
+						boardItem.dates[field.GetName()] = date[:len(time.DateOnly)]
+					}
+				}
+			}
+		}
+		board.items = append(board.items, boardItem)
+	}
+	return board, resp, nil
+}
+
+// namesOf returns the given key of each user or label of an assignees or labels field value.
+func namesOf(value any, key string) []string {
+	var names []string
+	values, _ := value.([]any)
+	for _, v := range values {
+		if m, ok := v.(map[string]any); ok {
+			if name, ok := m[key].(string); ok {
+				names = append(names, name)
+			}
+		}
+	}
+	return names
+}
+
+// assigneeGroups returns the assignees of an item, or the group of unassigned items if it has none.
+func (i projectBoardItem) assigneeGroups() []string {
+	if len(i.assignees) == 0 {
+		return []string{noValue("Assignees")}
+	}
+	return i.assignees
+}
+
+// statusOrder returns the names of the options of the Status field in board order.
+func (b *projectBoard) statusOrder() []string {
+	if b.status == nil {
+		return nil
+	}
+	var names []string
+	for _, option := range b.status.Options {
+		names = append(names, option.GetName().GetRaw())
+	}
+	return names
+}
+
+// iterationOrder returns the titles of the iterations of the iteration field, oldest first.
+func (b *projectBoard) iterationOrder() []string {
+	if b.iteration == nil || b.iteration.Configuration == nil {
+		return nil
+	}
+	var titles []string
+	for _, iteration := range b.iteration.Configuration.Iterations {
+		titles = append(titles, iteration.GetTitle().GetRaw())
+	}
+	return titles
+}
+
+// findProjectNumberField finds a number field among the fields of a project.
+func findProjectNumberField(fields []*github.ProjectV2Field, fieldID int64) (*github.ProjectV2Field, error) {
+	i := slices.IndexFunc(fields, func(field *github.ProjectV2Field) bool { return field.GetID() == fieldID })
+	if i == -1 {
+		return nil, fmt.Errorf("the project has no field with ID %d", fieldID)
+	}
+	if dataType := fields[i].GetDataType(); dataType != "number" {
+		return nil, fmt.Errorf("field %s is of type %s, not number", fields[i].GetName(), dataType)
+	}
+	return fields[i], nil
+}
+
+// noValue names the group of the items that have no value for a field, the way the project groups them.
+func noValue(field string) string {
+	return "No " + field
+}
+
+// compareAssignees orders assignees by login, with the group of unassigned items last.
+func compareAssignees(a, b string) int {
+	if (a == noValue("Assignees")) != (b == noValue("Assignees")) {
+		if a == noValue("Assignees") {
+			return 1
+		}
+		return -1
+	}
+	return strings.Compare(strings.ToLower(a), strings.ToLower(b))
+}
+
+// ProjectEstimateSummary sums the estimates of the items of a project per status, assignee and iteration.
+type ProjectEstimateSummary struct {
+	FieldID     int64                  `json:"field_id"`
+	FieldName   string                 `json:"field_name"`
+	Total       float64                `json:"total"`
+	Estimated   int                    `json:"estimated_items"`
+	Unestimated int                    `json:"unestimated_items"`
+	ByStatus    []ProjectEstimateGroup `json:"by_status,omitempty"`
+	ByAssignee  []ProjectEstimateGroup `json:"by_assignee"`
+	ByIteration []ProjectEstimateGroup `json:"by_iteration,omitempty"`
+}
+
+// ProjectEstimateGroup is the sum of the estimates of a group of items, such as a column of a board.
+type ProjectEstimateGroup struct {
+	Name        string  `json:"name"`
+	Total       float64 `json:"total"`
+	Estimated   int     `json:"estimated_items"`
+	Unestimated int     `json:"unestimated_items"`
+}
+
+// estimateGroups sums estimates into groups, kept in the order given by order, then in order of first appearance.
+type estimateGroups struct {
+	groups []ProjectEstimateGroup
+}
+
+func newEstimateGroups(order []string) *estimateGroups {
+	g := &estimateGroups{}
+	for _, name := range order {
+		g.groups = append(g.groups, ProjectEstimateGroup{Name: name})
+	}
+	return g
+}
+
+func (g *estimateGroups) add(name string, estimate *float64) {
+	i := slices.IndexFunc(g.groups, func(group ProjectEstimateGroup) bool { return group.Name == name })
+	if i == -1 {
+		g.groups = append(g.groups, ProjectEstimateGroup{Name: name})
+		i = len(g.groups) - 1
+	}
+	if estimate == nil {
+		g.groups[i].Unestimated++
+		return
+	}
+	g.groups[i].Total += *estimate
+	g.groups[i].Estimated++
+}
+
+// SummarizeProjectEstimates creates a tool that sums a number field of the items of a project per status, assignee and
+// iteration.
+func SummarizeProjectEstimates(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("summarize_project_estimates",
+			mcp.WithDescription(t("TOOL_SUMMARIZE_PROJECT_ESTIMATES_DESCRIPTION", "Sum a number field of the items of a Project of a user or org, such as Points or Estimate, per Status column, per assignee and per iteration, for capacity and load views in sprint planning. Items with several assignees count in full for each of them.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_SUMMARIZE_PROJECT_ESTIMATES_USER_TITLE", "Summarize project estimates"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner_type",
+				mcp.Required(),
+				mcp.Description("Owner type"),
+				mcp.Enum("user", "org"),
+			),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("If owner_type == user it is the handle for the GitHub user account. If owner_type == org it is the name of the organization. The name is not case sensitive."),
+			),
+			mcp.WithNumber("project_number",
+				mcp.Required(),
+				mcp.Description("The project's number."),
+			),
+			mcp.WithNumber("field_id",
+				mcp.Required(),
+				mcp.Description("The id of the number field holding the estimates."),
+			),
+		), func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			ownerType, err := RequiredParam[string](req, "owner_type")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			owner, err := RequiredParam[string](req, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			projectNumber, err := ValidateProjectNumber(req)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			fieldID, err := ValidateProjectFieldID(req)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			fields, resp, err := listAllProjectFields(ctx, client, ownerType, owner, projectNumber)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to list project fields", resp, err), nil
+			}
+			estimate, err := findProjectNumberField(fields, fieldID)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			board, resp, err := loadProjectBoard(ctx, client, ownerType, owner, projectNumber, fields, estimate)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to list project items", resp, err), nil
+			}
+
+			summary := ProjectEstimateSummary{FieldID: fieldID, FieldName: board.estimate.GetName()}
+			byStatus := newEstimateGroups(board.statusOrder())
+			byAssignee := newEstimateGroups(nil)
+			byIteration := newEstimateGroups(board.iterationOrder())
+			for _, item := range board.items {
+				if item.estimate == nil {
+					summary.Unestimated++
+				} else {
+					summary.Total += *item.estimate
+					summary.Estimated++
+				}
+				if board.status != nil {
+					byStatus.add(cmp.Or(item.status, noValue(board.status.GetName())), item.estimate)
+				}
+				if board.iteration != nil {
+					byIteration.add(cmp.Or(item.iteration, noValue(board.iteration.GetName())), item.estimate)
+				}
+				for _, assignee := range item.assigneeGroups() {
+					byAssignee.add(assignee, item.estimate)
+				}
+			}
+			summary.ByStatus = byStatus.groups
+			summary.ByIteration = byIteration.groups
+			summary.ByAssignee = append([]ProjectEstimateGroup{}, byAssignee.groups...)
+			slices.SortStableFunc(summary.ByAssignee, func(a, b ProjectEstimateGroup) int {
+				return compareAssignees(a.Name, b.Name)
+			})
+			return MarshalledTextResult(summary), nil
+		}
+}
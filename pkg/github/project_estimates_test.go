@@ -0,0 +1,116 @@
+package github
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/ghmock"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// respondProjectBoard serves the Planning project of octo with a Status, Sprint, Points and Assignees field, and three
+// items: a Todo item for mona, a Done item for mona and hubot, and an unassigned item In Progress without points.
+func respondProjectBoard(server *ghmock.Server) {
+	option := func(id, name string) map[string]any {
+		return map[string]any{"id": id, "name": map[string]any{"raw": name}}
+	}
+	server.Respond("GET /orgs/octo/projectsV2/1/fields", http.StatusOK, []map[string]any{
+		{"id": 9, "name": "Title", "data_type": "title"},
+		{"id": 11, "name": "Status", "data_type": "single_select", "options": []any{option("o1", "Todo"), option("o2", "In Progress"), option("o3", "Done")}},
+		{"id": 12, "name": "Sprint", "data_type": "iteration", "configuration": map[string]any{"iterations": []any{
+			map[string]any{"id": "it-1", "title": map[string]any{"raw": "Sprint 1"}},
+			map[string]any{"id": "it-2", "title": map[string]any{"raw": "Sprint 2"}},
+		}}},
+		{"id": 13, "name": "Points", "data_type": "number"},
+		{"id": 14, "name": "Assignees", "data_type": "assignees"},
+	})
+	status := func(name string) map[string]any {
+		return map[string]any{"id": 11, "value": map[string]any{"name": map[string]any{"raw": name}}}
+	}
+	sprint1 := map[string]any{"id": 12, "value": map[string]any{"id": "it-1", "title": map[string]any{"raw": "Sprint 1"}}}
+	assignees := func(logins ...string) map[string]any {
+		var users []any
+		for _, login := range logins {
+			users = append(users, map[string]any{"login": login})
+		}
+		return map[string]any{"id": 14, "value": users}
+	}
+	server.Respond("GET /orgs/octo/projectsV2/1/items", http.StatusOK, []map[string]any{
+		{
+			"id": 801, "node_id": "PVTI_801", "content_type": "Issue",
+			"content": map[string]any{"title": "Fix login", "html_url": "https://github.com/octo/app/issues/1", "state": "open"},
+			"fields":  []any{status("Todo"), sprint1, map[string]any{"id": 13, "value": 3}, assignees("mona")},
+		},
+		{
+			"id": 802, "node_id": "PVTI_802", "content_type": "Issue",
+			"content": map[string]any{"title": "Add SSO", "html_url": "https://github.com/octo/app/issues/2", "state": "closed"},
+			"fields":  []any{status("Done"), sprint1, map[string]any{"id": 13, "value": 5}, assignees("mona", "hubot")},
+		},
+		{
+			"id": 803, "content_type": "DraftIssue", "content": map[string]any{"title": "Write docs"},
+			"fields": []any{status("In Progress"), map[string]any{"id": 12, "value": nil}, map[string]any{"id": 13, "value": nil}, assignees()},
+		},
+	})
+}
+
+func Test_SummarizeProjectEstimates(t *testing.T) {
+	tool, _ := SummarizeProjectEstimates(nil, translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+	assert.True(t, *tool.Annotations.ReadOnlyHint)
+
+	call := func(t *testing.T, server *ghmock.Server, fieldID float64) (string, bool) {
+		_, handler := SummarizeProjectEstimates(server.GetClient(), translations.NullTranslationHelper)
+		result := ghmock.CallTool(t, handler, map[string]any{"owner_type": "org", "owner": "octo", "project_number": float64(1), "field_id": fieldID})
+		return ghmock.ResultText(t, result), result.IsError
+	}
+
+	t.Run("sums per status, assignee and iteration", func(t *testing.T) {
+		server := ghmock.New(t)
+		respondProjectBoard(server)
+		text, isError := call(t, server, 13)
+		require.False(t, isError, text)
+		assert.Equal(t, "11,12,13,14", server.AssertRequested("GET /orgs/octo/projectsV2/1/items").Query.Get("fields"))
+
+		var summary ProjectEstimateSummary
+		require.NoError(t, json.Unmarshal([]byte(text), &summary))
+		assert.Equal(t, ProjectEstimateSummary{
+			FieldID: 13, FieldName: "Points", Total: 8, Estimated: 2, Unestimated: 1,
+			ByStatus: []ProjectEstimateGroup{
+				{Name: "Todo", Total: 3, Estimated: 1},
+				{Name: "In Progress", Unestimated: 1},
+				{Name: "Done", Total: 5, Estimated: 1},
+			},
+			ByAssignee: []ProjectEstimateGroup{
+				{Name: "hubot", Total: 5, Estimated: 1},
+				{Name: "mona", Total: 8, Estimated: 2},
+				{Name: "No Assignees", Unestimated: 1},
+			},
+			ByIteration: []ProjectEstimateGroup{
+				{Name: "Sprint 1", Total: 8, Estimated: 2},
+				{Name: "Sprint 2"},
+				{Name: "No Sprint", Unestimated: 1},
+			},
+		}, summary)
+	})
+
+	t.Run("not a number field", func(t *testing.T) {
+		server := ghmock.New(t)
+		respondProjectBoard(server)
+		text, isError := call(t, server, 11)
+		require.True(t, isError)
+		assert.Contains(t, text, "field Status is of type single_select, not number")
+		assert.Len(t, server.Requests(), 1)
+	})
+
+	t.Run("unknown field", func(t *testing.T) {
+		server := ghmock.New(t)
+		respondProjectBoard(server)
+		text, isError := call(t, server, 99)
+		require.True(t, isError)
+		assert.Contains(t, text, "the project has no field with ID 99")
+	})
+}
@@ -0,0 +1,201 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/shurcooL/githubv4"
+)
+
+// ProjectFieldHistory is the history of the value of one field of a project item, oldest change first.
+type ProjectFieldHistory struct {
+	ItemID    int64                `json:"item_id"`
+	FieldID   int64                `json:"field_id"`
+	FieldName string               `json:"field_name"`
+	Changes   []ProjectFieldChange `json:"changes"`
+}
+
+// ProjectFieldChange is a change of the value of a field of a project item.
+type ProjectFieldChange struct {
+	From      string    `json:"from"`
+	To        string    `json:"to"`
+	Actor     string    `json:"actor,omitempty"`
+	Automated bool      `json:"automated"`
+	ChangedAt time.Time `json:"changed_at"`
+}
+
+// projectStatusTimeline is a page of the Status changes in the timeline of an issue or pull request, on any project.
+type projectStatusTimeline struct {
+	Nodes []struct {
+		ProjectV2ItemStatusChangedEvent struct {
+			CreatedAt      githubv4.DateTime
+			PreviousStatus githubv4.String
+			Status         githubv4.String
+			WasAutomated   githubv4.Boolean
+			Actor          struct {
+				Login githubv4.String
+			}
+			Project struct {
+				ID githubv4.ID
+			}
+		} `graphql:"... on ProjectV2ItemStatusChangedEvent"`
+	}
+	PageInfo struct {
+		HasNextPage githubv4.Boolean
+		EndCursor   githubv4.String
+	}
+}
+
+// listProjectStatusChanges lists the changes of the Status of a project item, read from the timeline of its issue or
+// pull request.
+func listProjectStatusChanges(ctx context.Context, client *githubv4.Client, itemNodeID string) ([]ProjectFieldChange, error) {
+	changes := []ProjectFieldChange{}
+	vars := map[string]any{
+		"id":    githubv4.ID(itemNodeID),
+		"after": (*githubv4.String)(nil),
+	}
+	for {
+		// Issues and pull requests have timelines of different types, so the two are told apart by an alias.
+		var query struct {
+			Node struct {
+				ProjectV2Item struct {
+					Project struct {
+						ID githubv4.ID
+					}
+					Content struct {
+						Issue struct {
+							TimelineItems projectStatusTimeline `graphql:"issueTimeline: timelineItems(first: 100, after: $after, itemTypes: [PROJECT_V2_ITEM_STATUS_CHANGED_EVENT])"`
+						} `graphql:"... on Issue"`
+						PullRequest struct {
+							TimelineItems projectStatusTimeline `graphql:"pullRequestTimeline: timelineItems(first: 100, after: $after, itemTypes: [PROJECT_V2_ITEM_STATUS_CHANGED_EVENT])"`
+						} `graphql:"... on PullRequest"`
+					}
+				} `graphql:"... on ProjectV2Item"`
+			} `graphql:"node(id: $id)"`
+		}
+		if err := client.Query(ctx, &query, vars); err != nil {
+			return nil, err
+		}
+
+		item := query.Node.ProjectV2Item
+		timeline := item.Content.Issue.TimelineItems
+		if len(timeline.Nodes) == 0 {
+			timeline = item.Content.PullRequest.TimelineItems
+		}
+		for _, node := range timeline.Nodes {
+			event := node.ProjectV2ItemStatusChangedEvent
+			// The timeline holds the changes on every project the issue or pull request is on.
+			if event.Project.ID != item.Project.ID {
+				continue
+			}
+			changes = append(changes, ProjectFieldChange{
+				From:      string(event.PreviousStatus),
+				To:        string(event.Status),
+				Actor:     string(event.Actor.Login),
+				Automated: bool(event.WasAutomated),
+				ChangedAt: event.CreatedAt.Time,
+			})
+		}
+		if !timeline.PageInfo.HasNextPage {
+			return changes, nil
+		}
+		vars["after"] = githubv4.NewString(timeline.PageInfo.EndCursor)
+	}
+}
+
+// GetProjectFieldHistory creates a tool that gets the history of the value of a field of a project item.
+func GetProjectFieldHistory(getClient GetClientFn, getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_project_field_history",
+			mcp.WithDescription(t("TOOL_GET_PROJECT_FIELD_HISTORY_DESCRIPTION", "Get the changes of the value of a field of a Project item, with when and by whom each was made, oldest first. Useful for cycle time analysis. GitHub records the history of the Status field of issues and pull requests only.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_GET_PROJECT_FIELD_HISTORY_USER_TITLE", "Get project field history"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner_type",
+				mcp.Required(),
+				mcp.Description("Owner type"),
+				mcp.Enum("user", "org"),
+			),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("If owner_type == user it is the handle for the GitHub user account. If owner_type == org it is the name of the organization. The name is not case sensitive."),
+			),
+			mcp.WithNumber("project_number",
+				mcp.Required(),
+				mcp.Description("The project's number."),
+			),
+			mcp.WithNumber("item_id",
+				mcp.Required(),
+				mcp.Description("The unique identifier of the project item. This is not the issue or pull request ID."),
+			),
+			mcp.WithNumber("field_id",
+				mcp.Required(),
+				mcp.Description("The field's id."),
+			),
+		), func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			ownerType, err := RequiredParam[string](req, "owner_type")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			owner, err := RequiredParam[string](req, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			projectNumber, err := ValidateProjectNumber(req)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			itemID, err := ValidateProjectItemID(req)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			fieldID, err := ValidateProjectFieldID(req)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			gqlClient, err := getGQLClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub GraphQL client: %w", err)
+			}
+
+			field, resp, err := getProjectField(ctx, client, ownerType, owner, projectNumber, fieldID)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get project field", resp, err), nil
+			}
+			_ = resp.Body.Close()
+			if !strings.EqualFold(field.GetName(), projectStatusFieldName) || field.GetDataType() != "single_select" {
+				return mcp.NewToolResultError(fmt.Sprintf("GitHub records the history of the %s field only, so the history of %s is not available", projectStatusFieldName, field.GetName())), nil
+			}
+
+			item, resp, err := getProjectItem(ctx, client, ownerType, owner, projectNumber, itemID, nil)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get project item", resp, err), nil
+			}
+			_ = resp.Body.Close()
+			if contentType := item.GetContentType(); contentType != "Issue" && contentType != "PullRequest" {
+				return mcp.NewToolResultError(fmt.Sprintf("item %d has no history: GitHub records it for issues and pull requests only, and its content type is %s", itemID, contentType)), nil
+			}
+
+			changes, err := listProjectStatusChanges(ctx, gqlClient, item.GetNodeID())
+			if err != nil {
+				return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "failed to get project field history", err), nil
+			}
+			return MarshalledTextResult(ProjectFieldHistory{
+				ItemID:    itemID,
+				FieldID:   fieldID,
+				FieldName: field.GetName(),
+				Changes:   changes,
+			}), nil
+		}
+}
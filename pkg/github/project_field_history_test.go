@@ -0,0 +1,93 @@
+package github
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/ghmock"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// statusChangedEvent is a Status change in the timeline of an issue as returned by GraphQL.
+func statusChangedEvent(project, from, to, at string) map[string]any {
+	return map[string]any{
+		"createdAt": at, "previousStatus": from, "status": to, "wasAutomated": false,
+		"actor": map[string]any{"login": "mona"}, "project": map[string]any{"id": project},
+	}
+}
+
+func Test_GetProjectFieldHistory(t *testing.T) {
+	tool, _ := GetProjectFieldHistory(nil, nil, translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+	assert.True(t, *tool.Annotations.ReadOnlyHint)
+	assert.ElementsMatch(t, []string{"owner_type", "owner", "project_number", "item_id", "field_id"}, tool.InputSchema.Required)
+
+	newServer := func(t *testing.T, contentType string) *ghmock.Server {
+		server := ghmock.New(t)
+		respondProjectFields(server)
+		server.Respond("GET /orgs/octo/projectsV2/1/items/801", http.StatusOK, map[string]any{"id": 801, "node_id": "PVTI_801", "content_type": contentType})
+		server.HandleGraphQL("node(id: $id)", func(_ string, vars map[string]any) (any, []string) {
+			if vars["id"] != "PVTI_801" {
+				return nil, []string{"Could not resolve to a node with the global id of '" + vars["id"].(string) + "'"}
+			}
+			pages := map[any]map[string]any{
+				nil: {
+					"nodes": []any{
+						statusChangedEvent("PVT_1", "", "Todo", "2025-01-06T10:00:00Z"),
+						statusChangedEvent("PVT_2", "Todo", "Done", "2025-01-07T10:00:00Z"),
+					},
+					"pageInfo": map[string]any{"hasNextPage": true, "endCursor": "c1"},
+				},
+				"c1": {
+					"nodes":    []any{statusChangedEvent("PVT_1", "Todo", "In Progress", "2025-01-08T10:00:00Z")},
+					"pageInfo": map[string]any{"hasNextPage": false, "endCursor": "c2"},
+				},
+			}
+			content := map[string]any{"issueTimeline": pages[vars["after"]]}
+			return map[string]any{"node": map[string]any{"project": map[string]any{"id": "PVT_1"}, "content": content}}, nil
+		})
+		return server
+	}
+	call := func(t *testing.T, server *ghmock.Server, fieldID float64) (string, bool) {
+		_, handler := GetProjectFieldHistory(server.GetClient(), server.GetGQLClient(), translations.NullTranslationHelper)
+		result := ghmock.CallTool(t, handler, map[string]any{
+			"owner_type": "org", "owner": "octo", "project_number": float64(1), "item_id": float64(801), "field_id": fieldID,
+		})
+		return ghmock.ResultText(t, result), result.IsError
+	}
+
+	t.Run("status changes on the project", func(t *testing.T) {
+		server := newServer(t, "Issue")
+		text, isError := call(t, server, 11)
+		require.False(t, isError, text)
+		var history ProjectFieldHistory
+		require.NoError(t, json.Unmarshal([]byte(text), &history))
+		assert.Equal(t, ProjectFieldHistory{
+			ItemID: 801, FieldID: 11, FieldName: "Status",
+			Changes: []ProjectFieldChange{
+				{From: "", To: "Todo", Actor: "mona", ChangedAt: time.Date(2025, 1, 6, 10, 0, 0, 0, time.UTC)},
+				{From: "Todo", To: "In Progress", Actor: "mona", ChangedAt: time.Date(2025, 1, 8, 10, 0, 0, 0, time.UTC)},
+			},
+		}, history)
+	})
+
+	t.Run("other fields", func(t *testing.T) {
+		server := newServer(t, "Issue")
+		text, isError := call(t, server, 12)
+		require.True(t, isError)
+		assert.Contains(t, text, "GitHub records the history of the Status field only, so the history of Sprint is not available")
+		server.AssertNotRequested("POST")
+	})
+
+	t.Run("draft issues", func(t *testing.T) {
+		server := newServer(t, "DraftIssue")
+		text, isError := call(t, server, 11)
+		require.True(t, isError)
+		assert.Contains(t, text, "item 801 has no history")
+	})
+}
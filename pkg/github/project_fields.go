@@ -0,0 +1,555 @@
+package github
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v79/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/shurcooL/githubv4"
+)
+
+// DefaultIterationDuration is the number of days of the iterations of a new iteration field, unless the caller gives
+// another.
+const DefaultIterationDuration = 14
+
+// projectFieldDataTypes maps the data types create_project_field accepts to their GraphQL names.
+var projectFieldDataTypes = map[string]githubv4.ProjectV2CustomFieldType{
+	"text":          githubv4.ProjectV2CustomFieldTypeText,
+	"number":        githubv4.ProjectV2CustomFieldTypeNumber,
+	"date":          githubv4.ProjectV2CustomFieldTypeDate,
+	"single_select": githubv4.ProjectV2CustomFieldTypeSingleSelect,
+	"iteration":     "ITERATION",
+}
+
+// projectFieldOptionColors are the colors of single select options.
+var projectFieldOptionColors = []string{"gray", "blue", "green", "yellow", "orange", "red", "pink", "purple"}
+
+// CreateProjectV2FieldInput represents the input for creating a project field via the GraphQL API.
+// Used to extend the functionality of the githubv4 library to support iteration fields.
+type CreateProjectV2FieldInput struct {
+	ProjectID              githubv4.ID                                       `json:"projectId"`
+	DataType               githubv4.ProjectV2CustomFieldType                 `json:"dataType"`
+	Name                   githubv4.String                                   `json:"name"`
+	SingleSelectOptions    *[]githubv4.ProjectV2SingleSelectFieldOptionInput `json:"singleSelectOptions,omitempty"`
+	IterationConfiguration *ProjectV2IterationFieldConfigurationInput        `json:"iterationConfiguration,omitempty"`
+}
+
+// UpdateProjectV2FieldInput represents the input for updating a project field via the GraphQL API, which the githubv4
+// library does not support.
+type UpdateProjectV2FieldInput struct {
+	FieldID                githubv4.ID                                       `json:"fieldId"`
+	Name                   *githubv4.String                                  `json:"name,omitempty"`
+	SingleSelectOptions    *[]githubv4.ProjectV2SingleSelectFieldOptionInput `json:"singleSelectOptions,omitempty"`
+	IterationConfiguration *ProjectV2IterationFieldConfigurationInput        `json:"iterationConfiguration,omitempty"`
+}
+
+// ProjectV2IterationFieldConfigurationInput represents the cadence of an iteration field.
+type ProjectV2IterationFieldConfigurationInput struct {
+	Duration   githubv4.Int         `json:"duration"`
+	StartDate  githubv4.String      `json:"startDate"`
+	Iterations []ProjectV2Iteration `json:"iterations"`
+}
+
+// ProjectV2Iteration represents an iteration of an iteration field.
+type ProjectV2Iteration struct {
+	Title     githubv4.String `json:"title"`
+	StartDate githubv4.String `json:"startDate"`
+	Duration  githubv4.Int    `json:"duration"`
+}
+
+// projectFieldOption is an option of a single select field as given to create_project_field and update_project_field.
+type projectFieldOption struct {
+	Name        string `json:"name"`
+	Color       string `json:"color"`
+	Description string `json:"description"`
+}
+
+// projectV2FieldConfiguration is the union of the kinds of project fields, as returned by the field mutations and
+// the views of a project.
+type projectV2FieldConfiguration struct {
+	ProjectV2Field struct {
+		DatabaseID githubv4.Int `graphql:"databaseId"`
+		Name       githubv4.String
+	} `graphql:"... on ProjectV2Field"`
+	ProjectV2SingleSelectField struct {
+		DatabaseID githubv4.Int `graphql:"databaseId"`
+		Name       githubv4.String
+	} `graphql:"... on ProjectV2SingleSelectField"`
+	ProjectV2IterationField struct {
+		DatabaseID githubv4.Int `graphql:"databaseId"`
+		Name       githubv4.String
+	} `graphql:"... on ProjectV2IterationField"`
+}
+
+func (f projectV2FieldConfiguration) databaseID() int64 {
+	return int64(max(f.ProjectV2Field.DatabaseID, f.ProjectV2SingleSelectField.DatabaseID, f.ProjectV2IterationField.DatabaseID))
+}
+
+// name returns the name of the field. The GraphQL client fills every fragment from the same JSON object, so each
+// holds the name already.
+func (f projectV2FieldConfiguration) name() string {
+	return string(cmp.Or(f.ProjectV2Field.Name, f.ProjectV2SingleSelectField.Name, f.ProjectV2IterationField.Name))
+}
+
+// projectFieldOptionsParam reads the single select options of a field, or nil if none are given.
+func projectFieldOptionsParam(request mcp.CallToolRequest) (*[]githubv4.ProjectV2SingleSelectFieldOptionInput, error) {
+	raw, ok := request.GetArguments()["options"]
+	if !ok || raw == nil {
+		return nil, nil
+	}
+	data, err := json.Marshal(raw)
+	if err != nil {
+		return nil, fmt.Errorf("invalid options: %w", err)
+	}
+	var options []projectFieldOption
+	if err := json.Unmarshal(data, &options); err != nil {
+		return nil, fmt.Errorf("invalid options: %w", err)
+	}
+	if len(options) == 0 {
+		return nil, fmt.Errorf("options must contain at least one option")
+	}
+	inputs := make([]githubv4.ProjectV2SingleSelectFieldOptionInput, len(options))
+	for i, option := range options {
+		if strings.TrimSpace(option.Name) == "" {
+			return nil, fmt.Errorf("options[%d]: missing required field: name", i)
+		}
+		color := strings.ToLower(option.Color)
+		if color == "" {
+			color = "gray"
+		}
+		if !slices.Contains(projectFieldOptionColors, color) {
+			return nil, fmt.Errorf("options[%d]: invalid color %q: must be one of %s", i, option.Color, strings.Join(projectFieldOptionColors, ", "))
+		}
+		inputs[i] = githubv4.ProjectV2SingleSelectFieldOptionInput{
+			Name:        githubv4.String(option.Name),
+			Color:       githubv4.ProjectV2SingleSelectFieldOptionColor(strings.ToUpper(color)),
+			Description: githubv4.String(option.Description),
+		}
+	}
+	return &inputs, nil
+}
+
+// projectDateParam reads an optional date parameter in YYYY-MM-DD format.
+func projectDateParam(request mcp.CallToolRequest, p string) (string, error) {
+	date, err := OptionalParam[string](request, p)
+	if err != nil || date == "" {
+		return "", err
+	}
+	if _, err := time.Parse(time.DateOnly, date); err != nil {
+		return "", fmt.Errorf("invalid %s %q: expected YYYY-MM-DD", p, date)
+	}
+	return date, nil
+}
+
+// getProjectField gets a field of a project of a user or an organization.
+func getProjectField(ctx context.Context, client *github.Client, ownerType, owner string, number int, fieldID int64) (*github.ProjectV2Field, *github.Response, error) {
+	if ownerType == "org" {
+		return client.Projects.GetOrganizationProjectField(ctx, owner, number, fieldID)
+	}
+	return client.Projects.GetUserProjectField(ctx, owner, number, fieldID)
+}
+
+// projectFieldResult returns a field the way get_project_field does, after a mutation created or changed it.
+func projectFieldResult(ctx context.Context, client *github.Client, ownerType, owner string, number int, fieldID int64) *mcp.CallToolResult {
+	field, resp, err := getProjectField(ctx, client, ownerType, owner, number, fieldID)
+	if err != nil {
+		return ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("field %d was saved but could not be read back", fieldID), resp, err)
+	}
+	_ = resp.Body.Close()
+	return MarshalledTextResult(field)
+}
+
+// createProjectV2Field creates a custom field of a project and returns its ID.
+func createProjectV2Field(ctx context.Context, gqlClient *githubv4.Client, input CreateProjectV2FieldInput) (int64, error) {
+	var mutation struct {
+		CreateProjectV2Field struct {
+			ProjectV2Field projectV2FieldConfiguration
+		} `graphql:"createProjectV2Field(input: $input)"`
+	}
+	if err := gqlClient.Mutate(ctx, &mutation, input, nil); err != nil {
+		return 0, err
+	}
+	return mutation.CreateProjectV2Field.ProjectV2Field.databaseID(), nil
+}
+
+// updateProjectV2Field renames a custom field of a project or replaces its options or cadence.
+func updateProjectV2Field(ctx context.Context, gqlClient *githubv4.Client, input UpdateProjectV2FieldInput) error {
+	var mutation struct {
+		UpdateProjectV2Field struct {
+			ProjectV2Field projectV2FieldConfiguration
+		} `graphql:"updateProjectV2Field(input: $input)"`
+	}
+	return gqlClient.Mutate(ctx, &mutation, input, nil)
+}
+
+// projectFieldOptionsSchema is the schema of the options parameter of the field tools.
+func projectFieldOptionsSchema(description string) mcp.ToolOption {
+	return mcp.WithArray("options",
+		mcp.Description(description),
+		mcp.Items(map[string]any{
+			"type":     "object",
+			"required": []string{"name"},
+			"properties": map[string]any{
+				"name":        map[string]any{"type": "string", "description": "Name of the option"},
+				"color":       map[string]any{"type": "string", "enum": projectFieldOptionColors, "description": "Color of the option. Defaults to gray."},
+				"description": map[string]any{"type": "string", "description": "Description of the option"},
+			},
+		}),
+	)
+}
+
+// CreateProjectField creates a tool that adds a custom field to a project.
+func CreateProjectField(getClient GetClientFn, getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("create_project_field",
+			mcp.WithDescription(t("TOOL_CREATE_PROJECT_FIELD_DESCRIPTION", "Add a custom field to a Project of a user or org. Single select fields need their options; iteration fields start iterations of iteration_duration days on iteration_start_date.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:           t("TOOL_CREATE_PROJECT_FIELD_USER_TITLE", "Create project field"),
+				ReadOnlyHint:    ToBoolPtr(false),
+				DestructiveHint: ToBoolPtr(false),
+				IdempotentHint:  ToBoolPtr(false),
+			}),
+			mcp.WithString("owner_type",
+				mcp.Required(),
+				mcp.Description("Owner type"),
+				mcp.Enum("user", "org"),
+			),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("If owner_type == user it is the handle for the GitHub user account. If owner_type == org it is the name of the organization. The name is not case sensitive."),
+			),
+			mcp.WithNumber("project_number",
+				mcp.Required(),
+				mcp.Description("The project's number."),
+			),
+			mcp.WithString("name",
+				mcp.Required(),
+				mcp.Description("Name of the field"),
+			),
+			mcp.WithString("data_type",
+				mcp.Required(),
+				mcp.Description("Type of the field"),
+				mcp.Enum("text", "number", "date", "single_select", "iteration"),
+			),
+			projectFieldOptionsSchema("Options of a single_select field, in display order"),
+			mcp.WithNumber("iteration_duration",
+				mcp.Description("Length of each iteration of an iteration field, in days"),
+				mcp.Min(1),
+				mcp.DefaultNumber(DefaultIterationDuration),
+			),
+			mcp.WithString("iteration_start_date",
+				mcp.Description("Start date of the first iteration of an iteration field, as YYYY-MM-DD. Defaults to today."),
+			),
+		),
+		func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			ownerType, err := RequiredParam[string](req, "owner_type")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			owner, err := RequiredParam[string](req, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			projectNumber, err := ValidateProjectNumber(req)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			name, err := RequiredParam[string](req, "name")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			dataType, err := RequiredParam[string](req, "data_type")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			graphQLType, ok := projectFieldDataTypes[dataType]
+			if !ok {
+				return mcp.NewToolResultError(fmt.Sprintf("invalid data_type %q", dataType)), nil
+			}
+			options, err := projectFieldOptionsParam(req)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			duration, err := OptionalIntParamWithDefault(req, "iteration_duration", DefaultIterationDuration)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			startDate, err := projectDateParam(req, "iteration_start_date")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			input := CreateProjectV2FieldInput{
+				DataType: graphQLType,
+				Name:     githubv4.String(name),
+			}
+			switch {
+			case dataType == "single_select" && options == nil:
+				return mcp.NewToolResultError("a single_select field needs at least one option"), nil
+			case dataType != "single_select" && options != nil:
+				return mcp.NewToolResultError("options can only be given for a single_select field"), nil
+			case dataType == "single_select":
+				input.SingleSelectOptions = options
+			case dataType == "iteration":
+				if duration < 1 {
+					return mcp.NewToolResultError("iteration_duration must be at least 1 day"), nil
+				}
+				if startDate == "" {
+					startDate = time.Now().UTC().Format("2006-01-02")
+				}
+				input.IterationConfiguration = &ProjectV2IterationFieldConfigurationInput{
+					Duration:   githubv4.Int(duration),
+					StartDate:  githubv4.String(startDate),
+					Iterations: []ProjectV2Iteration{},
+				}
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			gqlClient, err := getGQLClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub GraphQL client: %w", err)
+			}
+
+			project, resp, err := getProjectV2(ctx, client, ownerType, owner, projectNumber)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get project", resp, err), nil
+			}
+			_ = resp.Body.Close()
+			input.ProjectID = githubv4.ID(project.GetNodeID())
+
+			fieldID, err := createProjectV2Field(ctx, gqlClient, input)
+			if err != nil {
+				return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "failed to create project field", err), nil
+			}
+
+			return projectFieldResult(ctx, client, ownerType, owner, projectNumber, fieldID), nil
+		}
+}
+
+// UpdateProjectField creates a tool that renames a custom field of a project or changes its options or cadence.
+func UpdateProjectField(getClient GetClientFn, getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("update_project_field",
+			mcp.WithDescription(t("TOOL_UPDATE_PROJECT_FIELD_DESCRIPTION", "Rename a custom field of a Project of a user or org, replace the options of a single select field, or change the cadence of an iteration field. Items whose option is left out of the new options lose their value for the field; existing iterations are kept.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:           t("TOOL_UPDATE_PROJECT_FIELD_USER_TITLE", "Update project field"),
+				ReadOnlyHint:    ToBoolPtr(false),
+				DestructiveHint: ToBoolPtr(true),
+				IdempotentHint:  ToBoolPtr(true),
+			}),
+			mcp.WithString("owner_type",
+				mcp.Required(),
+				mcp.Description("Owner type"),
+				mcp.Enum("user", "org"),
+			),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("If owner_type == user it is the handle for the GitHub user account. If owner_type == org it is the name of the organization. The name is not case sensitive."),
+			),
+			mcp.WithNumber("project_number",
+				mcp.Required(),
+				mcp.Description("The project's number."),
+			),
+			mcp.WithNumber("field_id",
+				mcp.Required(),
+				mcp.Description("The field's id."),
+			),
+			mcp.WithString("name",
+				mcp.Description("New name of the field"),
+			),
+			projectFieldOptionsSchema("New options of a single_select field, in display order. They replace all current options."),
+			mcp.WithNumber("iteration_duration",
+				mcp.Description("New length of the iterations of an iteration field, in days. Requires iteration_start_date."),
+				mcp.Min(1),
+			),
+			mcp.WithString("iteration_start_date",
+				mcp.Description("New start date of the iterations of an iteration field, as YYYY-MM-DD. Requires iteration_duration."),
+			),
+			WithExpectedUpdatedAt("field"),
+		),
+		func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			ownerType, err := RequiredParam[string](req, "owner_type")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			owner, err := RequiredParam[string](req, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			projectNumber, err := ValidateProjectNumber(req)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			fieldID, err := ValidateProjectFieldID(req)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			name, err := OptionalParam[string](req, "name")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			options, err := projectFieldOptionsParam(req)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			duration, err := OptionalIntParam(req, "iteration_duration")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			startDate, err := projectDateParam(req, "iteration_start_date")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if (duration != 0) != (startDate != "") {
+				return mcp.NewToolResultError("iteration_duration and iteration_start_date must be given together"), nil
+			}
+			if name == "" && options == nil && duration == 0 {
+				return mcp.NewToolResultError("at least one of name, options or iteration_duration and iteration_start_date must be given"), nil
+			}
+			expectedUpdatedAt, err := expectedUpdatedAtParam(req)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			gqlClient, err := getGQLClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub GraphQL client: %w", err)
+			}
+
+			field, resp, err := getProjectField(ctx, client, ownerType, owner, projectNumber, fieldID)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get project field", resp, err), nil
+			}
+			_ = resp.Body.Close()
+			if result := updatedSinceResult("field", expectedUpdatedAt, field.GetUpdatedAt().Time, field); result != nil {
+				return result, nil
+			}
+
+			input := UpdateProjectV2FieldInput{FieldID: githubv4.ID(field.GetNodeID())}
+			if name != "" {
+				input.Name = githubv4.NewString(githubv4.String(name))
+			}
+			if options != nil {
+				if field.GetDataType() != "single_select" {
+					return mcp.NewToolResultError(fmt.Sprintf("options can only be given for a single_select field, but %s is of type %s", field.GetName(), field.GetDataType())), nil
+				}
+				input.SingleSelectOptions = options
+			}
+			if duration != 0 {
+				if field.GetDataType() != "iteration" {
+					return mcp.NewToolResultError(fmt.Sprintf("the cadence can only be set for an iteration field, but %s is of type %s", field.GetName(), field.GetDataType())), nil
+				}
+				// The iterations are replaced by the given ones, so the current ones are passed back to keep them.
+				iterations := []ProjectV2Iteration{}
+				if field.Configuration != nil {
+					for _, iteration := range field.Configuration.Iterations {
+						iterations = append(iterations, ProjectV2Iteration{
+							Title:     githubv4.String(iteration.GetTitle().GetRaw()),
+							StartDate: githubv4.String(iteration.GetStartDate()),
+							Duration:  githubv4.Int(iteration.GetDuration()),
+						})
+					}
+				}
+				input.IterationConfiguration = &ProjectV2IterationFieldConfigurationInput{
+					Duration:   githubv4.Int(duration),
+					StartDate:  githubv4.String(startDate),
+					Iterations: iterations,
+				}
+			}
+
+			if err := updateProjectV2Field(ctx, gqlClient, input); err != nil {
+				return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "failed to update project field", err), nil
+			}
+
+			return projectFieldResult(ctx, client, ownerType, owner, projectNumber, fieldID), nil
+		}
+}
+
+// DeleteProjectField creates a tool that deletes a custom field of a project.
+func DeleteProjectField(getClient GetClientFn, getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("delete_project_field",
+			mcp.WithDescription(t("TOOL_DELETE_PROJECT_FIELD_DESCRIPTION", "Delete a custom field of a Project of a user or org, with the values of every item for it. Built-in fields such as Title or Assignees cannot be deleted.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:           t("TOOL_DELETE_PROJECT_FIELD_USER_TITLE", "Delete project field"),
+				ReadOnlyHint:    ToBoolPtr(false),
+				DestructiveHint: ToBoolPtr(true),
+				IdempotentHint:  ToBoolPtr(true),
+			}),
+			mcp.WithString("owner_type",
+				mcp.Required(),
+				mcp.Description("Owner type"),
+				mcp.Enum("user", "org"),
+			),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("If owner_type == user it is the handle for the GitHub user account. If owner_type == org it is the name of the organization. The name is not case sensitive."),
+			),
+			mcp.WithNumber("project_number",
+				mcp.Required(),
+				mcp.Description("The project's number."),
+			),
+			mcp.WithNumber("field_id",
+				mcp.Required(),
+				mcp.Description("The field's id."),
+			),
+		),
+		func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			ownerType, err := RequiredParam[string](req, "owner_type")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			owner, err := RequiredParam[string](req, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			projectNumber, err := ValidateProjectNumber(req)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			fieldID, err := ValidateProjectFieldID(req)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			gqlClient, err := getGQLClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub GraphQL client: %w", err)
+			}
+
+			field, resp, err := getProjectField(ctx, client, ownerType, owner, projectNumber, fieldID)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get project field", resp, err), nil
+			}
+			_ = resp.Body.Close()
+
+			var mutation struct {
+				DeleteProjectV2Field struct {
+					ClientMutationID githubv4.String
+				} `graphql:"deleteProjectV2Field(input: $input)"`
+			}
+			input := githubv4.DeleteProjectV2FieldInput{FieldID: githubv4.ID(field.GetNodeID())}
+			if err := gqlClient.Mutate(ctx, &mutation, input, nil); err != nil {
+				return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "failed to delete project field", err), nil
+			}
+
+			return mcp.NewToolResultText(fmt.Sprintf("field %d (%s) deleted", fieldID, field.GetName())), nil
+		}
+}
@@ -0,0 +1,226 @@
+package github
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/ghmock"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v79/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// respondProjectFields serves the Planning project of octo with a Status single select field and a Sprint iteration
+// field that has one iteration.
+func respondProjectFields(server *ghmock.Server) {
+	server.Respond("GET /orgs/octo/projectsV2/1", http.StatusOK, map[string]any{"id": 1, "node_id": "PVT_1", "title": "Planning"})
+	server.Respond("GET /orgs/octo/projectsV2/1/fields/11", http.StatusOK, map[string]any{
+		"id": 11, "node_id": "PVTSSF_11", "name": "Status", "data_type": "single_select", "updated_at": "2025-01-06T10:00:00Z",
+		"options": []map[string]any{{"id": "opt-todo", "name": map[string]any{"raw": "Todo"}, "color": "GRAY"}},
+	})
+	server.Respond("GET /orgs/octo/projectsV2/1/fields/12", http.StatusOK, map[string]any{
+		"id": 12, "node_id": "PVTIF_12", "name": "Sprint", "data_type": "iteration",
+		"configuration": map[string]any{
+			"duration": 14, "start_day": 1,
+			"iterations": []map[string]any{{"id": "it-1", "title": map[string]any{"raw": "Sprint 1"}, "start_date": "2025-01-06", "duration": 14}},
+		},
+	})
+}
+
+// handleProjectFieldMutation records the input of a field mutation and answers with a field of the given kind.
+func handleProjectFieldMutation(server *ghmock.Server, mutation, kind string, databaseID int, input *map[string]any) {
+	server.HandleGraphQL(mutation+"(", func(query string, vars map[string]any) (any, []string) {
+		*input = vars["input"].(map[string]any)
+		if !strings.Contains(query, "... on "+kind) {
+			return nil, []string{"missing fragment for " + kind}
+		}
+		return map[string]any{mutation: map[string]any{"projectV2Field": map[string]any{"databaseId": databaseID}}}, nil
+	})
+}
+
+func Test_CreateProjectField(t *testing.T) {
+	tool, _ := CreateProjectField(nil, nil, translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+	assert.False(t, *tool.Annotations.ReadOnlyHint)
+	assert.ElementsMatch(t, []string{"owner_type", "owner", "project_number", "name", "data_type"}, tool.InputSchema.Required)
+
+	call := func(t *testing.T, server *ghmock.Server, args map[string]any) (string, bool) {
+		_, handler := CreateProjectField(server.GetClient(), server.GetGQLClient(), translations.NullTranslationHelper)
+		args["owner_type"], args["owner"], args["project_number"] = "org", "octo", float64(1)
+		result := ghmock.CallTool(t, handler, args)
+		return ghmock.ResultText(t, result), result.IsError
+	}
+
+	t.Run("single select", func(t *testing.T) {
+		server := ghmock.New(t)
+		respondProjectFields(server)
+		var input map[string]any
+		handleProjectFieldMutation(server, "createProjectV2Field", "ProjectV2SingleSelectField", 11, &input)
+
+		text, isError := call(t, server, map[string]any{
+			"name": "Status", "data_type": "single_select",
+			"options": []any{map[string]any{"name": "Todo"}, map[string]any{"name": "Done", "color": "green", "description": "Shipped"}},
+		})
+		require.False(t, isError, text)
+		assert.Equal(t, map[string]any{
+			"projectId": "PVT_1", "dataType": "SINGLE_SELECT", "name": "Status",
+			"singleSelectOptions": []any{
+				map[string]any{"name": "Todo", "color": "GRAY", "description": ""},
+				map[string]any{"name": "Done", "color": "GREEN", "description": "Shipped"},
+			},
+		}, input)
+		var field github.ProjectV2Field
+		require.NoError(t, json.Unmarshal([]byte(text), &field))
+		assert.Equal(t, int64(11), field.GetID())
+		assert.Equal(t, "single_select", field.GetDataType())
+	})
+
+	t.Run("iteration", func(t *testing.T) {
+		server := ghmock.New(t)
+		respondProjectFields(server)
+		var input map[string]any
+		handleProjectFieldMutation(server, "createProjectV2Field", "ProjectV2IterationField", 12, &input)
+
+		text, isError := call(t, server, map[string]any{
+			"name": "Sprint", "data_type": "iteration", "iteration_duration": float64(7), "iteration_start_date": "2025-01-06",
+		})
+		require.False(t, isError, text)
+		assert.Equal(t, map[string]any{
+			"projectId": "PVT_1", "dataType": "ITERATION", "name": "Sprint",
+			"iterationConfiguration": map[string]any{"duration": float64(7), "startDate": "2025-01-06", "iterations": []any{}},
+		}, input)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		for name, tc := range map[string]struct {
+			args map[string]any
+			want string
+		}{
+			"single select without options": {map[string]any{"name": "Status", "data_type": "single_select"}, "a single_select field needs at least one option"},
+			"options of a text field":       {map[string]any{"name": "Notes", "data_type": "text", "options": []any{map[string]any{"name": "A"}}}, "options can only be given for a single_select field"},
+			"unknown color":                 {map[string]any{"name": "Status", "data_type": "single_select", "options": []any{map[string]any{"name": "A", "color": "teal"}}}, `options[0]: invalid color "teal"`},
+			"bad start date":                {map[string]any{"name": "Sprint", "data_type": "iteration", "iteration_start_date": "06/01/2025"}, `invalid iteration_start_date "06/01/2025"`},
+		} {
+			t.Run(name, func(t *testing.T) {
+				server := ghmock.New(t)
+				text, isError := call(t, server, tc.args)
+				require.True(t, isError)
+				assert.Contains(t, text, tc.want)
+				assert.Empty(t, server.Requests())
+			})
+		}
+	})
+}
+
+func Test_UpdateProjectField(t *testing.T) {
+	tool, _ := UpdateProjectField(nil, nil, translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+	assert.True(t, *tool.Annotations.DestructiveHint)
+	assert.ElementsMatch(t, []string{"owner_type", "owner", "project_number", "field_id"}, tool.InputSchema.Required)
+
+	call := func(t *testing.T, server *ghmock.Server, args map[string]any) (string, bool) {
+		_, handler := UpdateProjectField(server.GetClient(), server.GetGQLClient(), translations.NullTranslationHelper)
+		args["owner_type"], args["owner"], args["project_number"] = "org", "octo", float64(1)
+		result := ghmock.CallTool(t, handler, args)
+		return ghmock.ResultText(t, result), result.IsError
+	}
+
+	t.Run("renames and replaces options", func(t *testing.T) {
+		server := ghmock.New(t)
+		respondProjectFields(server)
+		var input map[string]any
+		handleProjectFieldMutation(server, "updateProjectV2Field", "ProjectV2SingleSelectField", 11, &input)
+
+		text, isError := call(t, server, map[string]any{"field_id": float64(11), "name": "Stage", "options": []any{map[string]any{"name": "Todo", "color": "BLUE"}}})
+		require.False(t, isError, text)
+		assert.Equal(t, map[string]any{
+			"fieldId": "PVTSSF_11", "name": "Stage",
+			"singleSelectOptions": []any{map[string]any{"name": "Todo", "color": "BLUE", "description": ""}},
+		}, input)
+	})
+
+	t.Run("changes the cadence and keeps the iterations", func(t *testing.T) {
+		server := ghmock.New(t)
+		respondProjectFields(server)
+		var input map[string]any
+		handleProjectFieldMutation(server, "updateProjectV2Field", "ProjectV2IterationField", 12, &input)
+
+		text, isError := call(t, server, map[string]any{"field_id": float64(12), "iteration_duration": float64(7), "iteration_start_date": "2025-02-03"})
+		require.False(t, isError, text)
+		assert.Equal(t, map[string]any{
+			"fieldId": "PVTIF_12",
+			"iterationConfiguration": map[string]any{
+				"duration": float64(7), "startDate": "2025-02-03",
+				"iterations": []any{map[string]any{"title": "Sprint 1", "startDate": "2025-01-06", "duration": float64(14)}},
+			},
+		}, input)
+	})
+
+	t.Run("options of an iteration field", func(t *testing.T) {
+		server := ghmock.New(t)
+		respondProjectFields(server)
+		text, isError := call(t, server, map[string]any{"field_id": float64(12), "options": []any{map[string]any{"name": "A"}}})
+		require.True(t, isError)
+		assert.Contains(t, text, "options can only be given for a single_select field, but Sprint is of type iteration")
+		assert.Empty(t, server.Mutations())
+	})
+
+	t.Run("updated since it was read", func(t *testing.T) {
+		server := ghmock.New(t)
+		respondProjectFields(server)
+		text, isError := call(t, server, map[string]any{"field_id": float64(11), "name": "Stage", "expected_updated_at": "2025-01-06T09:00:00Z"})
+		require.True(t, isError)
+		var toolErr struct {
+			Code    string                `json:"code"`
+			Message string                `json:"message"`
+			Current github.ProjectV2Field `json:"current"`
+		}
+		require.NoError(t, json.Unmarshal([]byte(text), &toolErr))
+		assert.Equal(t, "CONFLICT", toolErr.Code)
+		assert.Equal(t, "the field was updated at 2025-01-06T10:00:00Z, after expected_updated_at 2025-01-06T09:00:00Z", toolErr.Message)
+		assert.Equal(t, "Status", toolErr.Current.GetName())
+		assert.Empty(t, server.Mutations())
+	})
+
+	t.Run("not updated since it was read", func(t *testing.T) {
+		server := ghmock.New(t)
+		respondProjectFields(server)
+		var input map[string]any
+		handleProjectFieldMutation(server, "updateProjectV2Field", "ProjectV2SingleSelectField", 11, &input)
+
+		text, isError := call(t, server, map[string]any{"field_id": float64(11), "name": "Stage", "expected_updated_at": "2025-01-06T12:00:00+02:00"})
+		require.False(t, isError, text)
+		assert.Equal(t, "Stage", input["name"])
+	})
+
+	t.Run("nothing to change", func(t *testing.T) {
+		server := ghmock.New(t)
+		text, isError := call(t, server, map[string]any{"field_id": float64(11)})
+		require.True(t, isError)
+		assert.Contains(t, text, "at least one of name, options or iteration_duration and iteration_start_date must be given")
+	})
+}
+
+func Test_DeleteProjectField(t *testing.T) {
+	tool, _ := DeleteProjectField(nil, nil, translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+	assert.True(t, *tool.Annotations.DestructiveHint)
+
+	server := ghmock.New(t)
+	respondProjectFields(server)
+	var input map[string]any
+	server.HandleGraphQL("deleteProjectV2Field(", func(_ string, vars map[string]any) (any, []string) {
+		input = vars["input"].(map[string]any)
+		return map[string]any{"deleteProjectV2Field": map[string]any{"clientMutationId": ""}}, nil
+	})
+	_, handler := DeleteProjectField(server.GetClient(), server.GetGQLClient(), translations.NullTranslationHelper)
+
+	result := ghmock.CallTool(t, handler, map[string]any{"owner_type": "org", "owner": "octo", "project_number": float64(1), "field_id": float64(11)})
+	require.False(t, result.IsError, ghmock.ResultText(t, result))
+	assert.Equal(t, map[string]any{"fieldId": "PVTSSF_11"}, input)
+	assert.Equal(t, "field 11 (Status) deleted", ghmock.ResultText(t, result))
+}
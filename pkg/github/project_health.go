@@ -0,0 +1,282 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v79/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// DefaultStaleProjectItemDays is the number of days without updates after which get_project_health reports an item
+// as stale, unless the caller gives another.
+const DefaultStaleProjectItemDays = 14
+
+// ProjectHealth is the result of the health checks of a project. Each check lists the items that fail it; checks
+// that cannot run on the project are listed in skipped_checks with the reason.
+type ProjectHealth struct {
+	Summary              ProjectHealthSummary        `json:"summary"`
+	UnassignedInProgress []ProjectHealthItem         `json:"unassigned_in_progress"`
+	Unestimated          []ProjectHealthItem         `json:"unestimated"`
+	Overdue              []ProjectHealthItem         `json:"overdue"`
+	Blocked              []ProjectHealthItem         `json:"blocked"`
+	WIPLimitViolations   []ProjectWIPLimitViolation  `json:"wip_limit_violations"`
+	Stale                []ProjectHealthItem         `json:"stale"`
+	SkippedChecks        []ProjectHealthSkippedCheck `json:"skipped_checks,omitempty"`
+}
+
+// ProjectHealthSummary counts the problems found by each check.
+type ProjectHealthSummary struct {
+	Items                int `json:"items"`
+	UnassignedInProgress int `json:"unassigned_in_progress"`
+	Unestimated          int `json:"unestimated"`
+	Overdue              int `json:"overdue"`
+	Blocked              int `json:"blocked"`
+	WIPLimitViolations   int `json:"wip_limit_violations"`
+	Stale                int `json:"stale"`
+}
+
+// ProjectHealthItem is an item of a project that fails a health check, with what is wrong with it.
+type ProjectHealthItem struct {
+	ID          int64    `json:"id"`
+	ContentType string   `json:"content_type"`
+	Title       string   `json:"title"`
+	URL         string   `json:"url,omitempty"`
+	Status      string   `json:"status,omitempty"`
+	Assignees   []string `json:"assignees,omitempty"`
+	Detail      string   `json:"detail,omitempty"`
+}
+
+// ProjectWIPLimitViolation is a Status column holding more items than its work in progress limit.
+type ProjectWIPLimitViolation struct {
+	Status string `json:"status"`
+	Limit  int    `json:"limit"`
+	Count  int    `json:"count"`
+}
+
+// ProjectHealthSkippedCheck is a health check that could not run on a project.
+type ProjectHealthSkippedCheck struct {
+	Check  string `json:"check"`
+	Reason string `json:"reason"`
+}
+
+// wipLimitsParam returns the wip_limits parameter, a map from Status option names to the maximum number of items in
+// the column.
+func wipLimitsParam(req mcp.CallToolRequest) (map[string]int, error) {
+	raw, err := OptionalParam[map[string]any](req, "wip_limits")
+	if err != nil {
+		return nil, err
+	}
+	limits := map[string]int{}
+	for status, value := range raw {
+		limit, ok := value.(float64)
+		if !ok || limit < 0 || limit != float64(int(limit)) {
+			return nil, fmt.Errorf("invalid wip_limits value for %s: expected a number of items such as 3, got %v", status, value)
+		}
+		limits[status] = int(limit)
+	}
+	return limits, nil
+}
+
+// containsFold reports whether names contains name, ignoring case.
+func containsFold(names []string, name string) bool {
+	return slices.ContainsFunc(names, func(s string) bool { return strings.EqualFold(s, name) })
+}
+
+// GetProjectHealth creates a tool that runs the usual health checks of a project board in one call.
+func GetProjectHealth(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_project_health",
+			mcp.WithDescription(t("TOOL_GET_PROJECT_HEALTH_DESCRIPTION", "Check the health of a Project of a user or org in one call: in progress items without assignees, items without an estimate, items with a date field in the past, blocked items (a Status or label containing \"blocked\"), Status columns over their work in progress limits, and items not updated for stale_days days. Items in a done status are not checked.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_GET_PROJECT_HEALTH_USER_TITLE", "Get project health"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner_type",
+				mcp.Required(),
+				mcp.Description("Owner type"),
+				mcp.Enum("user", "org"),
+			),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("If owner_type == user it is the handle for the GitHub user account. If owner_type == org it is the name of the organization. The name is not case sensitive."),
+			),
+			mcp.WithNumber("project_number",
+				mcp.Required(),
+				mcp.Description("The project's number."),
+			),
+			mcp.WithNumber("estimate_field_id",
+				mcp.Description("The id of a number field holding estimates, such as Points. Without it, items are not checked for estimates."),
+			),
+			mcp.WithArray("in_progress_statuses",
+				mcp.Description("The Status columns of work in progress, which should have assignees. Defaults to [\"In Progress\"]."),
+				mcp.WithStringItems(),
+			),
+			mcp.WithArray("done_statuses",
+				mcp.Description("The Status columns of finished work, which are not checked. Defaults to [\"Done\"]."),
+				mcp.WithStringItems(),
+			),
+			mcp.WithObject("wip_limits",
+				mcp.Description("The maximum number of items of Status columns, e.g. {\"In Progress\": 5, \"In Review\": 3}."),
+			),
+			mcp.WithNumber("stale_days",
+				mcp.Description("Report items not updated for this many days as stale."),
+				mcp.Min(1),
+				mcp.DefaultNumber(DefaultStaleProjectItemDays),
+			),
+		), func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			ownerType, err := RequiredParam[string](req, "owner_type")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			owner, err := RequiredParam[string](req, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			projectNumber, err := ValidateProjectNumber(req)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			estimateFieldID, err := OptionalIntParam(req, "estimate_field_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			inProgress, err := OptionalStringArrayParam(req, "in_progress_statuses")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if len(inProgress) == 0 {
+				inProgress = []string{"In Progress"}
+			}
+			done, err := OptionalStringArrayParam(req, "done_statuses")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if len(done) == 0 {
+				done = []string{"Done"}
+			}
+			wipLimits, err := wipLimitsParam(req)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			staleDays, err := OptionalIntParamWithDefault(req, "stale_days", DefaultStaleProjectItemDays)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			fields, resp, err := listAllProjectFields(ctx, client, ownerType, owner, projectNumber)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to list project fields", resp, err), nil
+			}
+			var estimate *github.ProjectV2Field
+			if estimateFieldID != 0 {
+				if estimate, err = findProjectNumberField(fields, int64(estimateFieldID)); err != nil {
+					return mcp.NewToolResultError(err.Error()), nil
+				}
+			}
+			board, resp, err := loadProjectBoard(ctx, client, ownerType, owner, projectNumber, fields, estimate)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to list project items", resp, err), nil
+			}
+			for status := range wipLimits {
+				if !containsFold(board.statusOrder(), status) {
+					return mcp.NewToolResultError(fmt.Sprintf("invalid wip_limits: the %s field has no option %s", projectStatusFieldName, status)), nil
+				}
+			}
+
+			health := ProjectHealth{
+				UnassignedInProgress: []ProjectHealthItem{},
+				Unestimated:          []ProjectHealthItem{},
+				Overdue:              []ProjectHealthItem{},
+				Blocked:              []ProjectHealthItem{},
+				WIPLimitViolations:   []ProjectWIPLimitViolation{},
+				Stale:                []ProjectHealthItem{},
+			}
+			skip := func(check, reason string) {
+				health.SkippedChecks = append(health.SkippedChecks, ProjectHealthSkippedCheck{Check: check, Reason: reason})
+			}
+			if board.status == nil {
+				skip("unassigned_in_progress", fmt.Sprintf("the project has no %s field", projectStatusFieldName))
+			}
+			if estimate == nil {
+				skip("unestimated", "no estimate_field_id was given")
+			}
+			if len(board.dates) == 0 {
+				skip("overdue", "the project has no date fields")
+			}
+			if len(wipLimits) == 0 {
+				skip("wip_limit_violations", "no wip_limits were given")
+			}
+
+			now := time.Now().UTC()
+			today := now.Format(time.DateOnly)
+			staleBefore := now.AddDate(0, 0, -staleDays)
+			counts := map[string]int{}
+			for _, boardItem := range board.items {
+				health.Summary.Items++
+				counts[strings.ToLower(boardItem.status)]++
+				if containsFold(done, boardItem.status) {
+					continue
+				}
+
+				report := func(list *[]ProjectHealthItem, detail string) {
+					item := ProjectHealthItem{
+						ID:          boardItem.item.GetID(),
+						ContentType: boardItem.item.GetContentType(),
+						Status:      boardItem.status,
+						Assignees:   boardItem.assignees,
+						Detail:      detail,
+					}
+					if boardItem.item.Content != nil {
+						item.Title = boardItem.item.Content.Title
+						item.URL = boardItem.item.Content.HTMLURL
+					}
+					*list = append(*list, item)
+				}
+				if board.status != nil && containsFold(inProgress, boardItem.status) && len(boardItem.assignees) == 0 {
+					report(&health.UnassignedInProgress, "")
+				}
+				if estimate != nil && boardItem.estimate == nil {
+					report(&health.Unestimated, "")
+				}
+				for _, field := range board.dates {
+					if date, ok := boardItem.dates[field.GetName()]; ok && date < today {
+						report(&health.Overdue, fmt.Sprintf("%s was %s", field.GetName(), date))
+					}
+				}
+				if strings.Contains(strings.ToLower(boardItem.status), "blocked") {
+					report(&health.Blocked, fmt.Sprintf("%s is %s", projectStatusFieldName, boardItem.status))
+				} else if i := slices.IndexFunc(boardItem.labels, func(label string) bool { return strings.Contains(strings.ToLower(label), "blocked") }); i != -1 {
+					report(&health.Blocked, fmt.Sprintf("labeled %s", boardItem.labels[i]))
+				}
+				if updatedAt := boardItem.item.GetUpdatedAt().Time; !updatedAt.IsZero() && updatedAt.Before(staleBefore) {
+					report(&health.Stale, fmt.Sprintf("not updated since %s", updatedAt.Format(time.DateOnly)))
+				}
+			}
+			for _, status := range board.statusOrder() {
+				for limitStatus, limit := range wipLimits {
+					if strings.EqualFold(status, limitStatus) && counts[strings.ToLower(status)] > limit {
+						health.WIPLimitViolations = append(health.WIPLimitViolations, ProjectWIPLimitViolation{Status: status, Limit: limit, Count: counts[strings.ToLower(status)]})
+					}
+				}
+			}
+
+			health.Summary.UnassignedInProgress = len(health.UnassignedInProgress)
+			health.Summary.Unestimated = len(health.Unestimated)
+			health.Summary.Overdue = len(health.Overdue)
+			health.Summary.Blocked = len(health.Blocked)
+			health.Summary.WIPLimitViolations = len(health.WIPLimitViolations)
+			health.Summary.Stale = len(health.Stale)
+			return MarshalledTextResult(health), nil
+		}
+}
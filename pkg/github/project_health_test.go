@@ -0,0 +1,137 @@
+package github
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/ghmock"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_GetProjectHealth(t *testing.T) {
+	tool, _ := GetProjectHealth(nil, translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+	assert.True(t, *tool.Annotations.ReadOnlyHint)
+
+	recently := time.Now().UTC().Format(time.RFC3339)
+	newServer := func(t *testing.T) *ghmock.Server {
+		option := func(id, name string) map[string]any {
+			return map[string]any{"id": id, "name": map[string]any{"raw": name}}
+		}
+		status := func(name string) map[string]any {
+			return map[string]any{"id": 11, "value": map[string]any{"name": map[string]any{"raw": name}}}
+		}
+		assignees := func(logins ...string) map[string]any {
+			users := []any{}
+			for _, login := range logins {
+				users = append(users, map[string]any{"login": login})
+			}
+			return map[string]any{"id": 12, "value": users}
+		}
+		server := ghmock.New(t)
+		server.Respond("GET /orgs/octo/projectsV2/1/fields", http.StatusOK, []map[string]any{
+			{"id": 11, "name": "Status", "data_type": "single_select", "options": []any{
+				option("o1", "Todo"), option("o2", "In Progress"), option("o3", "Blocked"), option("o4", "Done"),
+			}},
+			{"id": 12, "name": "Assignees", "data_type": "assignees"},
+			{"id": 13, "name": "Points", "data_type": "number"},
+			{"id": 14, "name": "Labels", "data_type": "labels"},
+			{"id": 15, "name": "Due", "data_type": "date"},
+		})
+		server.Respond("GET /orgs/octo/projectsV2/1/items", http.StatusOK, []map[string]any{
+			{
+				"id": 901, "content_type": "Issue", "updated_at": "2020-01-01T00:00:00Z",
+				"content": map[string]any{"title": "Fix login", "html_url": "https://github.com/octo/app/issues/1"},
+				"fields":  []any{status("In Progress"), assignees(), map[string]any{"id": 15, "value": "2020-01-06"}},
+			},
+			{
+				"id": 902, "content_type": "Issue", "updated_at": recently,
+				"content": map[string]any{"title": "Add SSO", "html_url": "https://github.com/octo/app/issues/2"},
+				"fields": []any{
+					status("In Progress"), assignees("mona"), map[string]any{"id": 13, "value": 2},
+					map[string]any{"id": 14, "value": []any{map[string]any{"name": "blocked-by-infra"}}},
+					map[string]any{"id": 15, "value": "2999-01-06"},
+				},
+			},
+			{
+				"id": 903, "content_type": "DraftIssue", "updated_at": recently,
+				"content": map[string]any{"title": "Write docs"},
+				"fields":  []any{status("Blocked"), assignees("hubot"), map[string]any{"id": 13, "value": 1}},
+			},
+			{
+				"id": 904, "content_type": "Issue", "updated_at": "2020-01-01T00:00:00Z",
+				"content": map[string]any{"title": "Old bug", "html_url": "https://github.com/octo/app/issues/4"},
+				"fields":  []any{status("Done"), assignees(), map[string]any{"id": 15, "value": "2020-01-06"}},
+			},
+		})
+		return server
+	}
+	call := func(t *testing.T, server *ghmock.Server, args map[string]any) (string, bool) {
+		_, handler := GetProjectHealth(server.GetClient(), translations.NullTranslationHelper)
+		result := ghmock.CallTool(t, handler, args)
+		return ghmock.ResultText(t, result), result.IsError
+	}
+
+	t.Run("runs every check", func(t *testing.T) {
+		server := newServer(t)
+		text, isError := call(t, server, map[string]any{
+			"owner_type": "org", "owner": "octo", "project_number": float64(1),
+			"estimate_field_id": float64(13), "wip_limits": map[string]any{"in progress": float64(1)},
+		})
+		require.False(t, isError, text)
+		assert.Equal(t, "11,13,12,14,15", server.AssertRequested("GET /orgs/octo/projectsV2/1/items").Query.Get("fields"))
+
+		var health ProjectHealth
+		require.NoError(t, json.Unmarshal([]byte(text), &health))
+		fixLogin := ProjectHealthItem{ID: 901, ContentType: "Issue", Title: "Fix login", URL: "https://github.com/octo/app/issues/1", Status: "In Progress"}
+		withDetail := func(item ProjectHealthItem, detail string) ProjectHealthItem {
+			item.Detail = detail
+			return item
+		}
+		assert.Equal(t, ProjectHealth{
+			Summary:              ProjectHealthSummary{Items: 4, UnassignedInProgress: 1, Unestimated: 1, Overdue: 1, Blocked: 2, WIPLimitViolations: 1, Stale: 1},
+			UnassignedInProgress: []ProjectHealthItem{fixLogin},
+			Unestimated:          []ProjectHealthItem{fixLogin},
+			Overdue:              []ProjectHealthItem{withDetail(fixLogin, "Due was 2020-01-06")},
+			Blocked: []ProjectHealthItem{
+				{ID: 902, ContentType: "Issue", Title: "Add SSO", URL: "https://github.com/octo/app/issues/2", Status: "In Progress", Assignees: []string{"mona"}, Detail: "labeled blocked-by-infra"},
+				{ID: 903, ContentType: "DraftIssue", Title: "Write docs", Status: "Blocked", Assignees: []string{"hubot"}, Detail: "Status is Blocked"},
+			},
+			WIPLimitViolations: []ProjectWIPLimitViolation{{Status: "In Progress", Limit: 1, Count: 2}},
+			Stale:              []ProjectHealthItem{withDetail(fixLogin, "not updated since 2020-01-01")},
+		}, health)
+	})
+
+	t.Run("skips checks without their inputs", func(t *testing.T) {
+		text, isError := call(t, newServer(t), map[string]any{"owner_type": "org", "owner": "octo", "project_number": float64(1)})
+		require.False(t, isError, text)
+		var health ProjectHealth
+		require.NoError(t, json.Unmarshal([]byte(text), &health))
+		assert.Equal(t, []ProjectHealthSkippedCheck{
+			{Check: "unestimated", Reason: "no estimate_field_id was given"},
+			{Check: "wip_limit_violations", Reason: "no wip_limits were given"},
+		}, health.SkippedChecks)
+		assert.Empty(t, health.Unestimated)
+	})
+
+	t.Run("unknown status in wip_limits", func(t *testing.T) {
+		text, isError := call(t, newServer(t), map[string]any{
+			"owner_type": "org", "owner": "octo", "project_number": float64(1), "wip_limits": map[string]any{"Review": float64(2)},
+		})
+		assert.True(t, isError)
+		assert.Equal(t, "invalid wip_limits: the Status field has no option Review", text)
+	})
+
+	t.Run("invalid wip limit", func(t *testing.T) {
+		text, isError := call(t, ghmock.New(t), map[string]any{
+			"owner_type": "org", "owner": "octo", "project_number": float64(1), "wip_limits": map[string]any{"Todo": "many"},
+		})
+		assert.True(t, isError)
+		assert.Equal(t, "invalid wip_limits value for Todo: expected a number of items such as 3, got many", text)
+	})
+}
@@ -0,0 +1,158 @@
+package github
+
+import (
+	"context"
+	"time"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/shurcooL/githubv4"
+)
+
+// DefaultAbandonedProjectMonths is the number of months without updates after which list_all_org_projects flags a
+// project as abandoned, unless the caller gives another.
+const DefaultAbandonedProjectMonths = 6
+
+// OrgProjectSummary is a project in the inventory of an organization's projects.
+type OrgProjectSummary struct {
+	Number     int    `json:"number"`
+	Title      string `json:"title"`
+	URL        string `json:"url"`
+	Visibility string `json:"visibility"`
+	Closed     bool   `json:"closed"`
+	ItemCount  int    `json:"item_count"`
+	UpdatedAt  string `json:"updated_at"`
+	Abandoned  bool   `json:"abandoned"`
+}
+
+// OrgProjectInventorySummary counts the projects of an inventory.
+type OrgProjectInventorySummary struct {
+	Total     int `json:"total"`
+	Open      int `json:"open"`
+	Closed    int `json:"closed"`
+	Public    int `json:"public"`
+	Private   int `json:"private"`
+	Abandoned int `json:"abandoned"`
+}
+
+type orgProjectsQuery struct {
+	Organization struct {
+		ProjectsV2 struct {
+			Nodes []struct {
+				Number    githubv4.Int
+				Title     githubv4.String
+				URL       githubv4.URI
+				Public    githubv4.Boolean
+				Closed    githubv4.Boolean
+				UpdatedAt githubv4.DateTime
+				Items     struct {
+					TotalCount githubv4.Int
+				}
+			}
+			PageInfo struct {
+				HasNextPage githubv4.Boolean
+				EndCursor   githubv4.String
+			}
+		} `graphql:"projectsV2(first: 100, after: $after, orderBy: {field: UPDATED_AT, direction: DESC})"`
+	} `graphql:"organization(login: $org)"`
+}
+
+// ListAllOrgProjects creates a tool that lists every project of an organization for governance reviews, flagging
+// projects that have not been updated for a number of months as abandoned.
+func ListAllOrgProjects(getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_all_org_projects",
+			mcp.WithDescription(t("TOOL_LIST_ALL_ORG_PROJECTS_DESCRIPTION", "List every Project of an organization, across all pages, most recently updated first, with its item count, last update time and visibility, and a summary. Projects not updated for abandoned_after_months months are flagged as abandoned. Use it for governance reviews of an organization's boards; use list_projects to browse projects page by page.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_LIST_ALL_ORG_PROJECTS_USER_TITLE", "List all organization projects"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("org",
+				mcp.Required(),
+				mcp.Description("The organization name. The name is not case sensitive."),
+			),
+			mcp.WithNumber("abandoned_after_months",
+				mcp.Description("Flag projects with no updates for this many months as abandoned."),
+				mcp.Min(1),
+				mcp.DefaultNumber(DefaultAbandonedProjectMonths),
+			),
+			mcp.WithBoolean("include_closed",
+				mcp.Description("Include closed projects."),
+				mcp.DefaultBool(true),
+			),
+		), func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			org, err := RequiredParam[string](request, "org")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			months, err := OptionalIntParamWithDefault(request, "abandoned_after_months", DefaultAbandonedProjectMonths)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			includeClosed, err := OptionalBoolParamWithDefault(request, "include_closed", true)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getGQLClient(ctx)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			abandonedBefore := time.Now().AddDate(0, -months, 0)
+			projects := []OrgProjectSummary{}
+			var summary OrgProjectInventorySummary
+			vars := map[string]any{
+				"org":   githubv4.String(org),
+				"after": (*githubv4.String)(nil),
+			}
+			for {
+				var query orgProjectsQuery
+				if err := client.Query(ctx, &query, vars); err != nil {
+					return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "failed to list organization projects", err), nil
+				}
+				for _, node := range query.Organization.ProjectsV2.Nodes {
+					if bool(node.Closed) && !includeClosed {
+						continue
+					}
+					project := OrgProjectSummary{
+						Number:     int(node.Number),
+						Title:      string(node.Title),
+						URL:        node.URL.String(),
+						Visibility: "private",
+						Closed:     bool(node.Closed),
+						ItemCount:  int(node.Items.TotalCount),
+						UpdatedAt:  FormatTimestamp(node.UpdatedAt.Time),
+						Abandoned:  node.UpdatedAt.Before(abandonedBefore),
+					}
+					if node.Public {
+						project.Visibility = "public"
+						summary.Public++
+					} else {
+						summary.Private++
+					}
+					if project.Closed {
+						summary.Closed++
+					} else {
+						summary.Open++
+					}
+					if project.Abandoned {
+						summary.Abandoned++
+					}
+					projects = append(projects, project)
+				}
+				if !query.Organization.ProjectsV2.PageInfo.HasNextPage {
+					break
+				}
+				vars["after"] = githubv4.NewString(query.Organization.ProjectsV2.PageInfo.EndCursor)
+			}
+			summary.Total = len(projects)
+
+			// Every page has been fetched, so there is no next page to point to.
+			response := NewListResponse("projects", projects, PageInfo{}, &summary.Total)
+			response["abandoned_after_months"] = months
+			response["summary"] = summary
+			return MarshalledTextResult(response), nil
+		}
+}
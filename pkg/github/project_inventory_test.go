@@ -0,0 +1,98 @@
+package github
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/ghmock"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ListAllOrgProjects(t *testing.T) {
+	tool, _ := ListAllOrgProjects(nil, translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+	assert.True(t, *tool.Annotations.ReadOnlyHint)
+	assert.Equal(t, []string{"org"}, tool.InputSchema.Required)
+
+	now := time.Now().UTC()
+	project := func(number int, public, closed bool, updatedAt time.Time, items int) map[string]any {
+		return map[string]any{
+			"number": number, "title": "Board", "url": "https://github.com/orgs/octo-org/projects/1",
+			"public": public, "closed": closed, "updatedAt": updatedAt.Format(time.RFC3339),
+			"items": map[string]any{"totalCount": items},
+		}
+	}
+	newServer := func(t *testing.T) *ghmock.Server {
+		server := ghmock.New(t)
+		server.HandleGraphQL("organization(login: $org)", func(_ string, variables map[string]any) (any, []string) {
+			assert.Equal(t, "octo-org", variables["org"])
+			if variables["after"] == nil {
+				return map[string]any{"organization": map[string]any{"projectsV2": map[string]any{
+					"nodes":    []any{project(1, true, false, now.AddDate(0, 0, -2), 12)},
+					"pageInfo": map[string]any{"hasNextPage": true, "endCursor": "cursor-1"},
+				}}}, nil
+			}
+			assert.Equal(t, "cursor-1", variables["after"])
+			return map[string]any{"organization": map[string]any{"projectsV2": map[string]any{
+				"nodes": []any{
+					project(2, false, false, now.AddDate(0, -4, 0), 3),
+					project(3, false, true, now.AddDate(-1, 0, 0), 0),
+				},
+				"pageInfo": map[string]any{"hasNextPage": false, "endCursor": "cursor-2"},
+			}}}, nil
+		})
+		return server
+	}
+
+	type response struct {
+		AbandonedAfterMonths int                        `json:"abandoned_after_months"`
+		Projects             []OrgProjectSummary        `json:"projects"`
+		PageInfo             PageInfo                   `json:"page_info"`
+		TotalCount           int                        `json:"total_count"`
+		Summary              OrgProjectInventorySummary `json:"summary"`
+	}
+	call := func(t *testing.T, args map[string]any) response {
+		_, handler := ListAllOrgProjects(newServer(t).GetGQLClient(), translations.NullTranslationHelper)
+		result := ghmock.CallTool(t, handler, args)
+		require.False(t, result.IsError, ghmock.ResultText(t, result))
+		var resp response
+		require.NoError(t, json.Unmarshal([]byte(ghmock.ResultText(t, result)), &resp))
+		return resp
+	}
+
+	t.Run("all pages with the default threshold", func(t *testing.T) {
+		resp := call(t, map[string]any{"org": "octo-org"})
+		assert.Equal(t, DefaultAbandonedProjectMonths, resp.AbandonedAfterMonths)
+		require.Len(t, resp.Projects, 3)
+		assert.Equal(t, 3, resp.TotalCount)
+		assert.False(t, resp.PageInfo.HasNextPage)
+		assert.Equal(t, OrgProjectSummary{
+			Number: 1, Title: "Board", URL: "https://github.com/orgs/octo-org/projects/1", Visibility: "public",
+			ItemCount: 12, UpdatedAt: now.AddDate(0, 0, -2).Format(time.RFC3339),
+		}, resp.Projects[0])
+		assert.Equal(t, "private", resp.Projects[1].Visibility)
+		assert.False(t, resp.Projects[1].Abandoned)
+		assert.True(t, resp.Projects[2].Abandoned)
+		assert.Equal(t, OrgProjectInventorySummary{Total: 3, Open: 2, Closed: 1, Public: 1, Private: 2, Abandoned: 1}, resp.Summary)
+	})
+
+	t.Run("custom threshold without closed projects", func(t *testing.T) {
+		resp := call(t, map[string]any{"org": "octo-org", "abandoned_after_months": float64(3), "include_closed": false})
+		require.Len(t, resp.Projects, 2)
+		assert.True(t, resp.Projects[1].Abandoned)
+		assert.Equal(t, OrgProjectInventorySummary{Total: 2, Open: 2, Public: 1, Private: 1, Abandoned: 1}, resp.Summary)
+	})
+
+	t.Run("GraphQL error", func(t *testing.T) {
+		server := ghmock.New(t)
+		server.RespondGraphQLError("organization(login: $org)", "Could not resolve to an Organization with the login of 'nope'.")
+		_, handler := ListAllOrgProjects(server.GetGQLClient(), translations.NullTranslationHelper)
+		result := ghmock.CallTool(t, handler, map[string]any{"org": "nope"})
+		require.True(t, result.IsError)
+		assert.Contains(t, ghmock.ResultText(t, result), "failed to list organization projects")
+	})
+}
@@ -0,0 +1,114 @@
+package github
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/google/go-github/v79/github"
+)
+
+// ProjectItemGroups is the items of a project grouped by the value of a field, as the swimlanes of a board view.
+type ProjectItemGroups struct {
+	GroupBy    string             `json:"group_by"`
+	TotalCount int                `json:"total_count"`
+	Groups     []ProjectItemGroup `json:"groups"`
+}
+
+// ProjectItemGroup is the items of a project that have one value of the field they are grouped by.
+type ProjectItemGroup struct {
+	Name  string                    `json:"name"`
+	Count int                       `json:"count"`
+	Items []*projectItemWithContent `json:"items"`
+}
+
+// findProjectGroupField finds the field to group items by: the assignees, the labels or the first iteration field for
+// "assignee", "label" and "iteration", or the single select field of the given name otherwise.
+func findProjectGroupField(fields []*github.ProjectV2Field, groupBy string) (*github.ProjectV2Field, error) {
+	dataType := map[string]string{"assignee": "assignees", "label": "labels", "iteration": "iteration"}[strings.ToLower(groupBy)]
+	for _, field := range fields {
+		if dataType != "" && field.GetDataType() == dataType {
+			return field, nil
+		}
+		if dataType == "" && strings.EqualFold(field.GetName(), groupBy) {
+			if field.GetDataType() != "single_select" {
+				return nil, fmt.Errorf("cannot group by field %s: it is a %s field, and only single select fields, assignee, label and iteration can be grouped by", field.GetName(), field.GetDataType())
+			}
+			return field, nil
+		}
+	}
+	if dataType != "" {
+		return nil, fmt.Errorf("cannot group by %s: the project has no %s field", groupBy, dataType)
+	}
+	return nil, fmt.Errorf("cannot group by %s: the project has no field of this name; group_by must be assignee, label, iteration or the name of a single select field", groupBy)
+}
+
+// projectGroupNames returns the names of the groups an item belongs to: one per assignee or label, or the value of a
+// single select or iteration field. Items without a value belong to the group named after the missing value.
+func projectGroupNames(item *projectItemWithContent, field *github.ProjectV2Field) []string {
+	var names []string
+	for _, value := range item.Fields {
+		if value.GetID() != field.GetID() {
+			continue
+		}
+		switch field.GetDataType() {
+		case "assignees", "labels":
+			names = namesOf(value.Value, map[string]string{"assignees": "login", "labels": "name"}[field.GetDataType()])
+		default:
+			if name, ok := snapshotFieldValue(field.GetDataType(), value.Value).(string); ok && name != "" {
+				names = append(names, name)
+			}
+		}
+	}
+	if len(names) == 0 {
+		return []string{noValue(field.GetName())}
+	}
+	return names
+}
+
+// groupProjectItems groups items by the value of a field. Groups of single select options and iterations come in board
+// order and are listed even when empty; assignees and labels are sorted by name. The group of items without a value
+// comes last.
+func groupProjectItems(items []*projectItemWithContent, field *github.ProjectV2Field) []ProjectItemGroup {
+	var groups []ProjectItemGroup
+	switch field.GetDataType() {
+	case "single_select":
+		for _, option := range field.Options {
+			groups = append(groups, ProjectItemGroup{Name: option.GetName().GetRaw(), Items: []*projectItemWithContent{}})
+		}
+	case "iteration":
+		if field.Configuration != nil {
+			for _, iteration := range field.Configuration.Iterations {
+				groups = append(groups, ProjectItemGroup{Name: iteration.GetTitle().GetRaw(), Items: []*projectItemWithContent{}})
+			}
+		}
+	}
+	ordered := len(groups)
+
+	for _, item := range items {
+		for _, name := range projectGroupNames(item, field) {
+			i := slices.IndexFunc(groups, func(group ProjectItemGroup) bool { return group.Name == name })
+			if i == -1 {
+				groups = append(groups, ProjectItemGroup{Name: name})
+				i = len(groups) - 1
+			}
+			groups[i].Count++
+			groups[i].Items = append(groups[i].Items, item)
+		}
+	}
+
+	none := noValue(field.GetName())
+	slices.SortStableFunc(groups[ordered:], func(a, b ProjectItemGroup) int {
+		if (a.Name == none) != (b.Name == none) {
+			if a.Name == none {
+				return 1
+			}
+			return -1
+		}
+		if ordered > 0 {
+			return 0
+		}
+		return strings.Compare(strings.ToLower(a.Name), strings.ToLower(b.Name))
+	})
+	return groups
+}
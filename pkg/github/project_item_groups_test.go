@@ -0,0 +1,132 @@
+package github
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/pkg/ghmock"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ListProjectItems_GroupBy(t *testing.T) {
+	newServer := func(t *testing.T) *ghmock.Server {
+		server := ghmock.New(t)
+		server.Respond("GET /orgs/{org}/projectsV2/{project}/fields", http.StatusOK, []map[string]any{
+			{"id": 11, "name": "Status", "data_type": "single_select", "options": []map[string]any{
+				{"id": "a", "name": map[string]any{"raw": "Todo"}},
+				{"id": "b", "name": map[string]any{"raw": "In Progress"}},
+				{"id": "c", "name": map[string]any{"raw": "Done"}},
+			}},
+			{"id": 12, "name": "Assignees", "data_type": "assignees"},
+			{"id": 13, "name": "Points", "data_type": "number"},
+		})
+		server.Respond("GET /orgs/{org}/projectsV2/{project}/items", http.StatusOK, []map[string]any{
+			{"id": 801, "content_type": "Issue", "fields": []map[string]any{
+				{"id": 11, "name": "Status", "value": map[string]any{"id": "c", "name": map[string]any{"raw": "Done"}}},
+				{"id": 12, "name": "Assignees", "value": []any{map[string]any{"login": "mona"}, map[string]any{"login": "hubot"}}},
+			}},
+			{"id": 802, "content_type": "Issue", "fields": []map[string]any{
+				{"id": 11, "name": "Status", "value": map[string]any{"id": "a", "name": map[string]any{"raw": "Todo"}}},
+				{"id": 12, "name": "Assignees", "value": []any{map[string]any{"login": "mona"}}},
+			}},
+			{"id": 803, "content_type": "DraftIssue", "fields": []map[string]any{}},
+		})
+		return server
+	}
+	type response struct {
+		GroupBy    string `json:"group_by"`
+		TotalCount int    `json:"total_count"`
+		Groups     []struct {
+			Name  string `json:"name"`
+			Count int    `json:"count"`
+			Items []struct {
+				ID int64 `json:"id"`
+			} `json:"items"`
+		} `json:"groups"`
+	}
+	call := func(t *testing.T, server *ghmock.Server, args map[string]any) response {
+		_, handler := ListProjectItems(server.GetClient(), translations.NullTranslationHelper)
+		result := ghmock.CallTool(t, handler, args)
+		require.False(t, result.IsError, ghmock.ResultText(t, result))
+		var resp response
+		require.NoError(t, json.Unmarshal([]byte(ghmock.ResultText(t, result)), &resp))
+		return resp
+	}
+	groups := func(resp response) map[string][]int64 {
+		got := map[string][]int64{}
+		for _, group := range resp.Groups {
+			assert.Len(t, group.Items, group.Count)
+			got[group.Name] = []int64{}
+			for _, item := range group.Items {
+				got[group.Name] = append(got[group.Name], item.ID)
+			}
+		}
+		return got
+	}
+	names := func(resp response) []string {
+		var names []string
+		for _, group := range resp.Groups {
+			names = append(names, group.Name)
+		}
+		return names
+	}
+
+	t.Run("single select field", func(t *testing.T) {
+		server := newServer(t)
+		resp := call(t, server, map[string]any{
+			"owner_type": "org", "owner": "octo-org", "project_number": float64(1),
+			"group_by": "status", "query": "is:issue", "fields": []any{"13"},
+		})
+		assert.Equal(t, "Status", resp.GroupBy)
+		assert.Equal(t, 3, resp.TotalCount)
+		assert.Equal(t, []string{"Todo", "In Progress", "Done", "No Status"}, names(resp))
+		assert.Equal(t, map[string][]int64{"Todo": {802}, "In Progress": {}, "Done": {801}, "No Status": {803}}, groups(resp))
+
+		query := server.AssertRequested("GET /orgs/octo-org/projectsV2/1/items").Query
+		assert.Equal(t, "is:issue", query.Get("q"))
+		assert.Equal(t, "13,11", query.Get("fields"))
+	})
+
+	t.Run("assignee", func(t *testing.T) {
+		resp := call(t, newServer(t), map[string]any{
+			"owner_type": "org", "owner": "octo-org", "project_number": float64(1), "group_by": "assignee",
+		})
+		assert.Equal(t, "Assignees", resp.GroupBy)
+		assert.Equal(t, []string{"hubot", "mona", "No Assignees"}, names(resp))
+		assert.Equal(t, map[string][]int64{"hubot": {801}, "mona": {801, 802}, "No Assignees": {803}}, groups(resp))
+	})
+
+	t.Run("field that cannot be grouped by", func(t *testing.T) {
+		server := newServer(t)
+		_, handler := ListProjectItems(server.GetClient(), translations.NullTranslationHelper)
+		result := ghmock.CallTool(t, handler, map[string]any{
+			"owner_type": "org", "owner": "octo-org", "project_number": float64(1), "group_by": "Points",
+		})
+		require.True(t, result.IsError)
+		assert.Contains(t, ghmock.ResultText(t, result), "cannot group by field Points: it is a number field")
+	})
+
+	t.Run("missing iteration field", func(t *testing.T) {
+		server := newServer(t)
+		_, handler := ListProjectItems(server.GetClient(), translations.NullTranslationHelper)
+		result := ghmock.CallTool(t, handler, map[string]any{
+			"owner_type": "org", "owner": "octo-org", "project_number": float64(1), "group_by": "iteration",
+		})
+		require.True(t, result.IsError)
+		assert.Equal(t, "cannot group by iteration: the project has no iteration field", ghmock.ResultText(t, result))
+	})
+
+	t.Run("with pagination cursor", func(t *testing.T) {
+		server := ghmock.New(t)
+		_, handler := ListProjectItems(server.GetClient(), translations.NullTranslationHelper)
+		result := ghmock.CallTool(t, handler, map[string]any{
+			"owner_type": "org", "owner": "octo-org", "project_number": float64(1), "group_by": "Status", "after": "abc",
+		})
+		require.True(t, result.IsError)
+		assert.Contains(t, ghmock.ResultText(t, result), "cannot be used with group_by")
+		assert.Empty(t, server.Requests())
+	})
+}
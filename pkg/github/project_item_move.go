@@ -0,0 +1,250 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v79/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// ProjectItemMove is the result of copying or moving an item from one project to another.
+type ProjectItemMove struct {
+	SourceItemID      int64                 `json:"source_item_id"`
+	ItemID            int64                 `json:"item_id"`
+	ContentURL        string                `json:"content_url,omitempty"`
+	CopiedFields      []string              `json:"copied_fields"`
+	SkippedFields     []ProjectSkippedField `json:"skipped_fields,omitempty"`
+	RemovedFromSource bool                  `json:"removed_from_source"`
+}
+
+// ProjectSkippedField is a field value of the source item that could not be copied to the target project.
+type ProjectSkippedField struct {
+	Field  string `json:"field"`
+	Value  any    `json:"value"`
+	Reason string `json:"reason"`
+}
+
+// getProjectItemWithContent gets an item of a project with the values of the given fields and the issue or pull
+// request it refers to.
+func getProjectItemWithContent(ctx context.Context, client *github.Client, ownerType, owner string, number int, itemID int64, fieldIDs []int64) (*projectItemWithContent, *github.Response, error) {
+	ids := make([]string, len(fieldIDs))
+	for i, id := range fieldIDs {
+		ids[i] = strconv.FormatInt(id, 10)
+	}
+	u := fmt.Sprintf("%s/projectsV2/%d/items/%d", projectOwnerPath(ownerType, owner), number, itemID)
+	if len(ids) > 0 {
+		u += "?" + url.Values{"fields": {strings.Join(ids, ",")}}.Encode()
+	}
+	req, err := client.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	var item projectItemWithContent
+	resp, err := client.Do(ctx, req, &item)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &item, resp, nil
+}
+
+// MoveProjectItem creates a tool that adds the issue or pull request of a project item to another project with its
+// field values, optionally removing it from the first project.
+func MoveProjectItem(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("move_project_item",
+			mcp.WithDescription(t("TOOL_MOVE_PROJECT_ITEM_DESCRIPTION", "Copy or move an item of a Project of a user or org to another Project: adds its issue or pull request to the target project, copies the values of the text, number, date, single select and iteration fields that the target project has a field of the same name and type for, and optionally removes the item from the source project. Single select options and iterations are matched by name. Values that cannot be copied are reported. Draft issues cannot be moved.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:           t("TOOL_MOVE_PROJECT_ITEM_USER_TITLE", "Move project item"),
+				ReadOnlyHint:    ToBoolPtr(false),
+				DestructiveHint: ToBoolPtr(true),
+				IdempotentHint:  ToBoolPtr(false),
+			}),
+			mcp.WithString("owner_type",
+				mcp.Required(),
+				mcp.Description("Owner type of the source project"),
+				mcp.Enum("user", "org"),
+			),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("If owner_type == user it is the handle for the GitHub user account. If owner_type == org it is the name of the organization. The name is not case sensitive."),
+			),
+			mcp.WithNumber("project_number",
+				mcp.Required(),
+				mcp.Description("The number of the source project."),
+			),
+			mcp.WithNumber("item_id",
+				mcp.Required(),
+				mcp.Description("The unique identifier of the item in the source project. This is not the issue or pull request ID."),
+			),
+			mcp.WithString("target_owner_type",
+				mcp.Required(),
+				mcp.Description("Owner type of the target project"),
+				mcp.Enum("user", "org"),
+			),
+			mcp.WithString("target_owner",
+				mcp.Required(),
+				mcp.Description("The user or organization owning the target project."),
+			),
+			mcp.WithNumber("target_project_number",
+				mcp.Required(),
+				mcp.Description("The number of the target project."),
+			),
+			mcp.WithBoolean("remove_from_source",
+				mcp.Description("Remove the item from the source project once it is in the target project. Defaults to false, which copies the item."),
+			),
+		), func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			ownerType, err := RequiredParam[string](req, "owner_type")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			owner, err := RequiredParam[string](req, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			projectNumber, err := ValidateProjectNumber(req)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			itemID, err := ValidateProjectItemID(req)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			targetOwnerType, err := RequiredParam[string](req, "target_owner_type")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			targetOwner, err := RequiredParam[string](req, "target_owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			targetNumber, err := requiredProjectIDParam(req, "target_project_number", projectNumberFormat)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			targetProjectNumber := int(targetNumber)
+			removeFromSource, err := OptionalParam[bool](req, "remove_from_source")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if ownerType == targetOwnerType && strings.EqualFold(owner, targetOwner) && projectNumber == targetProjectNumber {
+				return mcp.NewToolResultError("the source and target projects are the same project"), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			sourceFields, resp, err := listAllProjectFields(ctx, client, ownerType, owner, projectNumber)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to list source project fields", resp, err), nil
+			}
+			fieldIDs := make([]int64, 0, len(sourceFields))
+			fieldTypes := map[string]string{}
+			for _, field := range sourceFields {
+				fieldIDs = append(fieldIDs, field.GetID())
+				fieldTypes[field.GetName()] = field.GetDataType()
+			}
+			item, resp, err := getProjectItemWithContent(ctx, client, ownerType, owner, projectNumber, itemID, fieldIDs)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get project item", resp, err), nil
+			}
+			_ = resp.Body.Close()
+			source := toSnapshotItem(item, fieldTypes)
+			if (source.ContentType != "Issue" && source.ContentType != "PullRequest") || source.ContentID == 0 {
+				return mcp.NewToolResultError(fmt.Sprintf("item %d is a %s: only issues and pull requests can be added to another project", itemID, source.ContentType)), nil
+			}
+
+			targetFields, resp, err := listAllProjectFields(ctx, client, targetOwnerType, targetOwner, targetProjectNumber)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to list target project fields", resp, err), nil
+			}
+			targetsByName := map[string]*github.ProjectV2Field{}
+			for _, field := range targetFields {
+				targetsByName[field.GetName()] = field
+			}
+
+			result := ProjectItemMove{SourceItemID: itemID, ContentURL: source.ContentURL, CopiedFields: []string{}}
+			update := &github.UpdateProjectItemOptions{}
+			for _, field := range sourceFields {
+				name := field.GetName()
+				value, ok := source.FieldValues[name]
+				if !ok || value == nil {
+					continue
+				}
+				if _, updatable := projectFieldValueFormats[field.GetDataType()]; !updatable {
+					continue
+				}
+				target, ok := targetsByName[name]
+				switch {
+				case !ok:
+					result.SkippedFields = append(result.SkippedFields, ProjectSkippedField{Field: name, Value: value, Reason: "the target project has no field of this name"})
+					continue
+				case target.GetDataType() != field.GetDataType():
+					result.SkippedFields = append(result.SkippedFields, ProjectSkippedField{Field: name, Value: value, Reason: fmt.Sprintf("the field is a %s field in the target project, not a %s field", target.GetDataType(), field.GetDataType())})
+					continue
+				}
+				targetValue, err := restoreFieldValue(target, value)
+				if err != nil {
+					result.SkippedFields = append(result.SkippedFields, ProjectSkippedField{Field: name, Value: value, Reason: err.Error() + " in the target project"})
+					continue
+				}
+				update.Fields = append(update.Fields, &github.UpdateProjectV2Field{ID: target.GetID(), Value: targetValue})
+				result.CopiedFields = append(result.CopiedFields, name)
+			}
+
+			opts := &github.AddProjectItemOptions{ID: source.ContentID, Type: source.ContentType}
+			var added *github.ProjectV2Item
+			if targetOwnerType == "org" {
+				added, resp, err = client.Projects.AddOrganizationProjectItem(ctx, targetOwner, targetProjectNumber, opts)
+			} else {
+				added, resp, err = client.Projects.AddUserProjectItem(ctx, targetOwner, targetProjectNumber, opts)
+			}
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, ProjectAddFailedError, resp, err), nil
+			}
+			_ = resp.Body.Close()
+			result.ItemID = added.GetID()
+
+			if len(update.Fields) > 0 {
+				if targetOwnerType == "org" {
+					_, resp, err = client.Projects.UpdateOrganizationProjectItem(ctx, targetOwner, targetProjectNumber, result.ItemID, update)
+				} else {
+					_, resp, err = client.Projects.UpdateUserProjectItem(ctx, targetOwner, targetProjectNumber, result.ItemID, update)
+				}
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx,
+						fmt.Sprintf("item was added to the target project as item %d but its field values could not be copied, so it was not removed from the source project", result.ItemID),
+						resp,
+						err,
+					), nil
+				}
+				_ = resp.Body.Close()
+			}
+
+			if removeFromSource {
+				if ownerType == "org" {
+					resp, err = client.Projects.DeleteOrganizationProjectItem(ctx, owner, projectNumber, itemID)
+				} else {
+					resp, err = client.Projects.DeleteUserProjectItem(ctx, owner, projectNumber, itemID)
+				}
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx,
+						fmt.Sprintf("item was copied to the target project as item %d but could not be removed from the source project", result.ItemID),
+						resp,
+						err,
+					), nil
+				}
+				_ = resp.Body.Close()
+				result.RemovedFromSource = true
+			}
+			return MarshalledTextResult(result), nil
+		}
+}
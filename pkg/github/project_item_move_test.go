@@ -0,0 +1,112 @@
+package github
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/ghmock"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_MoveProjectItem(t *testing.T) {
+	tool, _ := MoveProjectItem(nil, translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+	assert.False(t, *tool.Annotations.ReadOnlyHint)
+	assert.True(t, *tool.Annotations.DestructiveHint)
+	assert.ElementsMatch(t, []string{"owner_type", "owner", "project_number", "item_id", "target_owner_type", "target_owner", "target_project_number"}, tool.InputSchema.Required)
+
+	// The source project has a Notes field the target project lacks.
+	newServer := func(t *testing.T, contentType string) *ghmock.Server {
+		server := ghmock.New(t)
+		server.Respond("GET /orgs/{org}/projectsV2/{project}/fields", http.StatusOK,
+			append(projectFields(100), map[string]any{"id": 150, "name": "Notes", "data_type": "text"}))
+		server.Respond("GET /orgs/{org}/projectsV2/{project}/items/{item}", http.StatusOK, map[string]any{
+			"id": 11, "content_type": contentType,
+			"content": map[string]any{"id": 501, "html_url": "https://github.com/octo-org/app/issues/1"},
+			"fields": []map[string]any{
+				{"id": 100, "name": "Title", "data_type": "title", "value": map[string]any{"raw": "Fix login"}},
+				{"id": 101, "name": "Status", "data_type": "single_select", "value": map[string]any{"id": "done-100", "name": map[string]any{"raw": "Done"}}},
+				{"id": 102, "name": "Points", "data_type": "number", "value": 5},
+				{"id": 150, "name": "Notes", "data_type": "text", "value": "flaky"},
+			},
+		})
+		server.Respond("DELETE /orgs/{org}/projectsV2/{project}/items/{item}", http.StatusNoContent, nil)
+		server.Respond("GET /users/{user}/projectsV2/{project}/fields", http.StatusOK, projectFields(200))
+		server.Respond("POST /users/{user}/projectsV2/{project}/items", http.StatusCreated, map[string]any{"id": 21, "content_type": "Issue"})
+		server.Respond("PATCH /users/{user}/projectsV2/{project}/items/{item}", http.StatusOK, map[string]any{"id": 21})
+		return server
+	}
+	args := func(removeFromSource bool) map[string]any {
+		return map[string]any{
+			"owner_type": "org", "owner": "octo-org", "project_number": float64(1), "item_id": float64(11),
+			"target_owner_type": "user", "target_owner": "octocat", "target_project_number": float64(3),
+			"remove_from_source": removeFromSource,
+		}
+	}
+
+	t.Run("copy", func(t *testing.T) {
+		server := newServer(t, "Issue")
+		_, handler := MoveProjectItem(server.GetClient(), translations.NullTranslationHelper)
+		result := ghmock.CallTool(t, handler, args(false))
+		require.False(t, result.IsError, ghmock.ResultText(t, result))
+
+		var moved ProjectItemMove
+		require.NoError(t, json.Unmarshal([]byte(ghmock.ResultText(t, result)), &moved))
+		assert.Equal(t, ProjectItemMove{
+			SourceItemID:  11,
+			ItemID:        21,
+			ContentURL:    "https://github.com/octo-org/app/issues/1",
+			CopiedFields:  []string{"Status", "Points"},
+			SkippedFields: []ProjectSkippedField{{Field: "Notes", Value: "flaky", Reason: "the target project has no field of this name"}},
+		}, moved)
+		assert.Equal(t, "100,101,102,150", server.AssertRequested("GET /orgs/octo-org/projectsV2/1/items/11").Query.Get("fields"))
+
+		var added map[string]any
+		require.NoError(t, server.AssertRequested("POST /users/octocat/projectsV2/3/items").DecodeBody(&added))
+		assert.Equal(t, map[string]any{"type": "Issue", "id": float64(501)}, added)
+		var updated map[string]any
+		require.NoError(t, server.AssertRequested("PATCH /users/octocat/projectsV2/3/items/21").DecodeBody(&updated))
+		assert.Equal(t, map[string]any{"fields": []any{
+			map[string]any{"id": float64(201), "value": "done-200"},
+			map[string]any{"id": float64(202), "value": float64(5)},
+		}}, updated)
+		server.AssertNotRequested(http.MethodDelete)
+	})
+
+	t.Run("move", func(t *testing.T) {
+		server := newServer(t, "Issue")
+		_, handler := MoveProjectItem(server.GetClient(), translations.NullTranslationHelper)
+		result := ghmock.CallTool(t, handler, args(true))
+		require.False(t, result.IsError, ghmock.ResultText(t, result))
+
+		var moved ProjectItemMove
+		require.NoError(t, json.Unmarshal([]byte(ghmock.ResultText(t, result)), &moved))
+		assert.True(t, moved.RemovedFromSource)
+		server.AssertRequested("DELETE /orgs/octo-org/projectsV2/1/items/11")
+	})
+
+	t.Run("draft issue", func(t *testing.T) {
+		server := newServer(t, "DraftIssue")
+		_, handler := MoveProjectItem(server.GetClient(), translations.NullTranslationHelper)
+		result := ghmock.CallTool(t, handler, args(true))
+		require.True(t, result.IsError)
+		assert.Contains(t, ghmock.ResultText(t, result), "item 11 is a DraftIssue")
+		server.AssertNotRequested(http.MethodPost)
+		server.AssertNotRequested(http.MethodDelete)
+	})
+
+	t.Run("same project", func(t *testing.T) {
+		server := ghmock.New(t)
+		_, handler := MoveProjectItem(server.GetClient(), translations.NullTranslationHelper)
+		a := args(false)
+		a["target_owner_type"], a["target_owner"], a["target_project_number"] = "org", "Octo-Org", float64(1)
+		result := ghmock.CallTool(t, handler, a)
+		require.True(t, result.IsError)
+		assert.Equal(t, "the source and target projects are the same project", ghmock.ResultText(t, result))
+		assert.Empty(t, server.Requests())
+	})
+}
@@ -0,0 +1,209 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/shurcooL/githubv4"
+)
+
+// ProjectItemPosition is the position of an item in the ordering of a project after it was moved, counting from 1
+// at the top.
+type ProjectItemPosition struct {
+	ItemID      int64  `json:"item_id"`
+	Position    int    `json:"position"`
+	Total       int    `json:"total"`
+	AfterItemID *int64 `json:"after_item_id,omitempty"`
+}
+
+type projectItemOrderNode struct {
+	ID         githubv4.ID
+	DatabaseID githubv4.Int `graphql:"databaseId"`
+}
+
+type projectItemOrderConnection struct {
+	Nodes    []projectItemOrderNode
+	PageInfo struct {
+		HasNextPage githubv4.Boolean
+		EndCursor   githubv4.String
+	}
+}
+
+// listProjectItemOrder queries the node ID of a project of a user or an organization and its items in the order of
+// the project, top first.
+func listProjectItemOrder(ctx context.Context, client *githubv4.Client, ownerType, owner string, number int) (githubv4.ID, []projectItemOrderNode, error) {
+	var projectID githubv4.ID
+	var items []projectItemOrderNode
+	vars := map[string]any{
+		"owner":  githubv4.String(owner),
+		"number": githubv4.Int(number),
+		"after":  (*githubv4.String)(nil),
+	}
+	for {
+		var page projectItemOrderConnection
+		if ownerType == "org" {
+			var query struct {
+				Organization struct {
+					ProjectV2 struct {
+						ID    githubv4.ID
+						Items projectItemOrderConnection `graphql:"items(first: 100, after: $after)"`
+					} `graphql:"projectV2(number: $number)"`
+				} `graphql:"organization(login: $owner)"`
+			}
+			if err := client.Query(ctx, &query, vars); err != nil {
+				return nil, nil, err
+			}
+			projectID, page = query.Organization.ProjectV2.ID, query.Organization.ProjectV2.Items
+		} else {
+			var query struct {
+				User struct {
+					ProjectV2 struct {
+						ID    githubv4.ID
+						Items projectItemOrderConnection `graphql:"items(first: 100, after: $after)"`
+					} `graphql:"projectV2(number: $number)"`
+				} `graphql:"user(login: $owner)"`
+			}
+			if err := client.Query(ctx, &query, vars); err != nil {
+				return nil, nil, err
+			}
+			projectID, page = query.User.ProjectV2.ID, query.User.ProjectV2.Items
+		}
+		items = append(items, page.Nodes...)
+		if !page.PageInfo.HasNextPage {
+			return projectID, items, nil
+		}
+		vars["after"] = githubv4.NewString(page.PageInfo.EndCursor)
+	}
+}
+
+// SetProjectItemPosition creates a tool that moves an item of a project to the top, the bottom or after another item
+// in the ordering of the project.
+func SetProjectItemPosition(getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("set_project_item_position",
+			mcp.WithDescription(t("TOOL_SET_PROJECT_ITEM_POSITION_DESCRIPTION", "Move an item of a Project of a user or org to the top, to the bottom or after another item in the ordering of the project, which board and table views show unless they are sorted by a field. Returns the resulting position of the item, counting from 1 at the top.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:           t("TOOL_SET_PROJECT_ITEM_POSITION_USER_TITLE", "Set project item position"),
+				ReadOnlyHint:    ToBoolPtr(false),
+				DestructiveHint: ToBoolPtr(false),
+				IdempotentHint:  ToBoolPtr(true),
+			}),
+			mcp.WithString("owner_type",
+				mcp.Required(),
+				mcp.Description("Owner type"),
+				mcp.Enum("user", "org"),
+			),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("If owner_type == user it is the handle for the GitHub user account. If owner_type == org it is the name of the organization. The name is not case sensitive."),
+			),
+			mcp.WithNumber("project_number",
+				mcp.Required(),
+				mcp.Description("The project's number."),
+			),
+			mcp.WithNumber("item_id",
+				mcp.Required(),
+				mcp.Description("The unique identifier of the project item to move. This is not the issue or pull request ID."),
+			),
+			mcp.WithString("position",
+				mcp.Required(),
+				mcp.Description("Where to move the item"),
+				mcp.Enum("top", "bottom", "after"),
+			),
+			mcp.WithNumber("after_item_id",
+				mcp.Description("The project item to move the item after. Required when position is after."),
+			),
+		), func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			ownerType, err := RequiredParam[string](req, "owner_type")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			owner, err := RequiredParam[string](req, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			projectNumber, err := ValidateProjectNumber(req)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			itemID, err := ValidateProjectItemID(req)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			position, err := RequiredParam[string](req, "position")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			var afterItemID int64
+			switch position {
+			case "after":
+				if afterItemID, err = requiredProjectIDParam(req, "after_item_id", projectItemIDFormat); err != nil {
+					return mcp.NewToolResultError(err.Error()), nil
+				}
+				if afterItemID == itemID {
+					return mcp.NewToolResultError("after_item_id must be another item than item_id"), nil
+				}
+			case "top", "bottom":
+				if _, ok := req.GetArguments()["after_item_id"]; ok {
+					return mcp.NewToolResultError("after_item_id can only be given when position is after"), nil
+				}
+			default:
+				return mcp.NewToolResultError(fmt.Sprintf("invalid position %q: expected top, bottom or after", position)), nil
+			}
+
+			client, err := getGQLClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub GraphQL client: %w", err)
+			}
+
+			projectID, items, err := listProjectItemOrder(ctx, client, ownerType, owner, projectNumber)
+			if err != nil {
+				return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "failed to list project items", err), nil
+			}
+			indexOf := func(id int64) int {
+				return slices.IndexFunc(items, func(item projectItemOrderNode) bool { return int64(item.DatabaseID) == id })
+			}
+			from := indexOf(itemID)
+			if from == -1 {
+				return mcp.NewToolResultError(fmt.Sprintf("the project has no item with ID %d", itemID)), nil
+			}
+			item := items[from]
+			items = slices.Delete(items, from, from+1)
+
+			// The item is inserted at index to, after the item before it, if any.
+			var to int
+			switch position {
+			case "top":
+				to = 0
+			case "bottom":
+				to = len(items)
+			case "after":
+				i := indexOf(afterItemID)
+				if i == -1 {
+					return mcp.NewToolResultError(fmt.Sprintf("the project has no item with ID %d", afterItemID)), nil
+				}
+				to = i + 1
+			}
+			input := githubv4.UpdateProjectV2ItemPositionInput{ProjectID: projectID, ItemID: item.ID}
+			result := ProjectItemPosition{ItemID: itemID, Position: to + 1, Total: len(items) + 1}
+			if to > 0 {
+				input.AfterID = &items[to-1].ID
+				after := int64(items[to-1].DatabaseID)
+				result.AfterItemID = &after
+			}
+
+			var mutation struct {
+				UpdateProjectV2ItemPosition struct {
+					ClientMutationID githubv4.String
+				} `graphql:"updateProjectV2ItemPosition(input: $input)"`
+			}
+			if err := client.Mutate(ctx, &mutation, input, nil); err != nil {
+				return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "failed to move project item", err), nil
+			}
+			return MarshalledTextResult(result), nil
+		}
+}
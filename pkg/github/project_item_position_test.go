@@ -0,0 +1,117 @@
+package github
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/ghmock"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_SetProjectItemPosition(t *testing.T) {
+	tool, _ := SetProjectItemPosition(nil, translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+	assert.False(t, *tool.Annotations.DestructiveHint)
+	assert.ElementsMatch(t, []string{"owner_type", "owner", "project_number", "item_id", "position"}, tool.InputSchema.Required)
+
+	// newServer serves an organization project whose items 11, 12, 13 and 14 are in that order, over two pages.
+	newServer := func(t *testing.T) *ghmock.Server {
+		server := ghmock.New(t)
+		server.HandleGraphQL("items(first: 100, after: $after)", func(_ string, vars map[string]any) (any, []string) {
+			nodes := []any{map[string]any{"id": "PVTI_11", "databaseId": 11}, map[string]any{"id": "PVTI_12", "databaseId": 12}}
+			pageInfo := map[string]any{"hasNextPage": true, "endCursor": "c1"}
+			if vars["after"] == "c1" {
+				nodes = []any{map[string]any{"id": "PVTI_13", "databaseId": 13}, map[string]any{"id": "PVTI_14", "databaseId": 14}}
+				pageInfo = map[string]any{"hasNextPage": false}
+			}
+			return map[string]any{"organization": map[string]any{"projectV2": map[string]any{
+				"id": "PVT_1", "items": map[string]any{"nodes": nodes, "pageInfo": pageInfo},
+			}}}, nil
+		})
+		server.RespondGraphQL("updateProjectV2ItemPosition(", map[string]any{"updateProjectV2ItemPosition": map[string]any{"clientMutationId": ""}})
+		return server
+	}
+	call := func(t *testing.T, server *ghmock.Server, args map[string]any) (string, bool) {
+		_, handler := SetProjectItemPosition(server.GetGQLClient(), translations.NullTranslationHelper)
+		merged := map[string]any{"owner_type": "org", "owner": "octo", "project_number": float64(1)}
+		for k, v := range args {
+			merged[k] = v
+		}
+		result := ghmock.CallTool(t, handler, merged)
+		return ghmock.ResultText(t, result), result.IsError
+	}
+	ptr := func(id int64) *int64 { return &id }
+
+	tests := []struct {
+		name     string
+		args     map[string]any
+		input    map[string]any
+		expected ProjectItemPosition
+	}{
+		{
+			name:     "top",
+			args:     map[string]any{"item_id": float64(13), "position": "top"},
+			input:    map[string]any{"projectId": "PVT_1", "itemId": "PVTI_13"},
+			expected: ProjectItemPosition{ItemID: 13, Position: 1, Total: 4},
+		},
+		{
+			name:     "bottom",
+			args:     map[string]any{"item_id": float64(11), "position": "bottom"},
+			input:    map[string]any{"projectId": "PVT_1", "itemId": "PVTI_11", "afterId": "PVTI_14"},
+			expected: ProjectItemPosition{ItemID: 11, Position: 4, Total: 4, AfterItemID: ptr(14)},
+		},
+		{
+			name:     "bottom when already last",
+			args:     map[string]any{"item_id": float64(14), "position": "bottom"},
+			input:    map[string]any{"projectId": "PVT_1", "itemId": "PVTI_14", "afterId": "PVTI_13"},
+			expected: ProjectItemPosition{ItemID: 14, Position: 4, Total: 4, AfterItemID: ptr(13)},
+		},
+		{
+			name:     "after another item",
+			args:     map[string]any{"item_id": float64(14), "position": "after", "after_item_id": float64(11)},
+			input:    map[string]any{"projectId": "PVT_1", "itemId": "PVTI_14", "afterId": "PVTI_11"},
+			expected: ProjectItemPosition{ItemID: 14, Position: 2, Total: 4, AfterItemID: ptr(11)},
+		},
+		{
+			name:     "after an item below",
+			args:     map[string]any{"item_id": float64(11), "position": "after", "after_item_id": float64(13)},
+			input:    map[string]any{"projectId": "PVT_1", "itemId": "PVTI_11", "afterId": "PVTI_13"},
+			expected: ProjectItemPosition{ItemID: 11, Position: 3, Total: 4, AfterItemID: ptr(13)},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			server := newServer(t)
+			text, isError := call(t, server, tc.args)
+			require.False(t, isError, text)
+			assert.Equal(t, tc.input, server.AssertGraphQL("updateProjectV2ItemPosition(").Variables["input"])
+			var position ProjectItemPosition
+			require.NoError(t, json.Unmarshal([]byte(text), &position))
+			assert.Equal(t, tc.expected, position)
+		})
+	}
+
+	errorTests := []struct {
+		name    string
+		args    map[string]any
+		message string
+	}{
+		{"missing after_item_id", map[string]any{"item_id": float64(11), "position": "after"}, "missing required parameter: after_item_id"},
+		{"after itself", map[string]any{"item_id": float64(11), "position": "after", "after_item_id": float64(11)}, "after_item_id must be another item than item_id"},
+		{"after_item_id with top", map[string]any{"item_id": float64(11), "position": "top", "after_item_id": float64(12)}, "after_item_id can only be given when position is after"},
+		{"unknown item", map[string]any{"item_id": float64(99), "position": "top"}, "the project has no item with ID 99"},
+		{"unknown after item", map[string]any{"item_id": float64(11), "position": "after", "after_item_id": float64(99)}, "the project has no item with ID 99"},
+	}
+	for _, tc := range errorTests {
+		t.Run(tc.name, func(t *testing.T) {
+			server := newServer(t)
+			text, isError := call(t, server, tc.args)
+			require.True(t, isError)
+			assert.Contains(t, text, tc.message)
+			assert.Empty(t, server.Mutations())
+		})
+	}
+}
@@ -0,0 +1,381 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"sync"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v79/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/shurcooL/githubv4"
+)
+
+const (
+	// MaxBulkProjectItems is the maximum number of items bulk_update_project_items and bulk_archive_project_items
+	// change in one call.
+	MaxBulkProjectItems = 100
+	// DefaultBulkProjectItemConcurrency is the number of items the bulk project item tools change at a time unless the
+	// caller gives another limit.
+	DefaultBulkProjectItemConcurrency = 4
+	// MaxBulkProjectItemConcurrency caps the concurrency of the bulk project item tools, to stay clear of secondary
+	// rate limits.
+	MaxBulkProjectItemConcurrency = 8
+)
+
+// BulkUpdatedProjectItem is an item of a project updated by bulk_update_project_items.
+type BulkUpdatedProjectItem struct {
+	ItemID        int64   `json:"item_id"`
+	UpdatedFields []int64 `json:"updated_fields,omitempty"`
+	ClearedFields []int64 `json:"cleared_fields,omitempty"`
+}
+
+// BulkArchivedProjectItem is an item of a project archived or unarchived by bulk_archive_project_items.
+type BulkArchivedProjectItem struct {
+	ItemID   int64 `json:"item_id"`
+	Archived bool  `json:"archived"`
+}
+
+// bulkProjectItemOutcome is the outcome of updating one item. GraphQL errors come from clearing fields.
+type bulkProjectItemOutcome struct {
+	updated *BulkUpdatedProjectItem
+	resp    *github.Response
+	err     error
+	graphQL bool
+}
+
+// updateProjectItem updates an item of a project of a user or an organization.
+func updateProjectItem(ctx context.Context, client *github.Client, ownerType, owner string, number int, itemID int64, opts *github.UpdateProjectItemOptions) (*github.ProjectV2Item, *github.Response, error) {
+	if ownerType == "org" {
+		return client.Projects.UpdateOrganizationProjectItem(ctx, owner, number, itemID, opts)
+	}
+	return client.Projects.UpdateUserProjectItem(ctx, owner, number, itemID, opts)
+}
+
+// bulkProjectItemIDsParam decodes and checks the item_ids parameter.
+func bulkProjectItemIDsParam(request mcp.CallToolRequest) ([]int64, error) {
+	raw, ok := request.GetArguments()["item_ids"].([]any)
+	if !ok {
+		return nil, fmt.Errorf("missing required parameter: item_ids")
+	}
+	if len(raw) == 0 || len(raw) > MaxBulkProjectItems {
+		return nil, fmt.Errorf("item_ids must contain between 1 and %d items, got %d", MaxBulkProjectItems, len(raw))
+	}
+	ids := make([]int64, 0, len(raw))
+	for i, value := range raw {
+		id, err := validateProjectIDValue(fmt.Sprintf("item_ids[%d]", i), value, projectItemIDFormat)
+		if err != nil {
+			return nil, err
+		}
+		if slices.Contains(ids, id) {
+			return nil, fmt.Errorf("item_ids[%d]: item %d is listed twice", i, id)
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+// bulkProjectFieldsParam decodes and checks the updated_fields parameter.
+func bulkProjectFieldsParam(request mcp.CallToolRequest) ([]*github.UpdateProjectV2Field, error) {
+	raw, ok := request.GetArguments()["updated_fields"].([]any)
+	if !ok || len(raw) == 0 {
+		return nil, fmt.Errorf("missing required parameter: updated_fields")
+	}
+	fields := make([]*github.UpdateProjectV2Field, 0, len(raw))
+	for i, value := range raw {
+		p := fmt.Sprintf("updated_fields[%d]", i)
+		input, ok := value.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("%s must be an object", p)
+		}
+		field, err := projectFieldUpdate(p, input)
+		if err != nil {
+			return nil, err
+		}
+		if slices.ContainsFunc(fields, func(f *github.UpdateProjectV2Field) bool { return f.ID == field.ID }) {
+			return nil, fmt.Errorf("%s: field %d is listed twice", p, field.ID)
+		}
+		fields = append(fields, field)
+	}
+	return fields, nil
+}
+
+// BulkUpdateProjectItems creates a tool that sets the same field values on many items of a project.
+func BulkUpdateProjectItems(getClient GetClientFn, getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("bulk_update_project_items",
+			mcp.WithDescription(t("TOOL_BULK_UPDATE_PROJECT_ITEMS_DESCRIPTION", fmt.Sprintf("Set the same field values on up to %d items of a Project of a user or org in one call, for example to move 40 cards to the current Sprint with Priority P1. Field values are given as for update_project_item. Reports the result of every item, with a summary.", MaxBulkProjectItems))),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:           t("TOOL_BULK_UPDATE_PROJECT_ITEMS_USER_TITLE", "Update project items in bulk"),
+				ReadOnlyHint:    ToBoolPtr(false),
+				DestructiveHint: ToBoolPtr(true),
+				IdempotentHint:  ToBoolPtr(true),
+			}),
+			mcp.WithString("owner_type",
+				mcp.Required(),
+				mcp.Description("Owner type"),
+				mcp.Enum("user", "org"),
+			),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("If owner_type == user it is the handle for the GitHub user account. If owner_type == org it is the name of the organization. The name is not case sensitive."),
+			),
+			mcp.WithNumber("project_number",
+				mcp.Required(),
+				mcp.Description("The project's number."),
+			),
+			mcp.WithArray("item_ids",
+				mcp.Required(),
+				mcp.Description("The unique identifiers of the project items to update (e.g. [\"135086\", \"135087\"]). These are not the issue or pull request IDs."),
+				mcp.WithStringItems(),
+			),
+			mcp.WithArray("updated_fields",
+				mcp.Required(),
+				mcp.Description("Fields to set on every item. Single select options and iterations can be given by name or title, the iteration in progress as @current, and dates as YYYY-MM-DD. To clear a field, set value to null or \"\", or set clear to true instead of a value."),
+				mcp.Items(map[string]any{
+					"type":     "object",
+					"required": []string{"id"},
+					"properties": map[string]any{
+						"id":    map[string]any{"type": "number", "description": "ID of the project field"},
+						"value": map[string]any{"description": "New value of the field"},
+						"clear": map[string]any{"type": "boolean", "description": "Clear the field instead of setting a value"},
+					},
+				}),
+			),
+			mcp.WithNumber("concurrency",
+				mcp.Description("Number of items to update at a time"),
+				mcp.Min(1),
+				mcp.Max(MaxBulkProjectItemConcurrency),
+				mcp.DefaultNumber(DefaultBulkProjectItemConcurrency),
+			),
+		), func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			ownerType, err := RequiredParam[string](req, "owner_type")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			owner, err := RequiredParam[string](req, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			projectNumber, err := ValidateProjectNumber(req)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			itemIDs, err := bulkProjectItemIDsParam(req)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			fields, err := bulkProjectFieldsParam(req)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			concurrency, err := OptionalIntParamWithDefault(req, "concurrency", DefaultBulkProjectItemConcurrency)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			concurrency = min(max(concurrency, 1), MaxBulkProjectItemConcurrency)
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			// Values are translated once for all items, so that a value the field does not accept fails the call
+			// before any item is changed. Fields to clear are cleared with GraphQL, by node ID.
+			var set []*github.UpdateProjectV2Field
+			var cleared []int64
+			clearFieldNodeIDs := map[int64]string{}
+			for _, field := range fields {
+				value := field.Value
+				if text, isText := value.(string); isText || value == nil {
+					projectField, resp, err := getProjectField(ctx, client, ownerType, owner, projectNumber, field.ID)
+					if err != nil {
+						return ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("failed to get project field %d", field.ID), resp, err), nil
+					}
+					_ = resp.Body.Close()
+					if value == nil {
+						cleared = append(cleared, field.ID)
+						clearFieldNodeIDs[field.ID] = projectField.GetNodeID()
+						continue
+					}
+					if value, err = projectFieldValue(projectField, text); err != nil {
+						return mcp.NewToolResultError(err.Error()), nil
+					}
+				}
+				set = append(set, &github.UpdateProjectV2Field{ID: field.ID, Value: value})
+			}
+
+			var gqlClient *githubv4.Client
+			var projectNodeID string
+			if len(cleared) > 0 {
+				if gqlClient, err = getGQLClient(ctx); err != nil {
+					return nil, fmt.Errorf("failed to get GitHub GraphQL client: %w", err)
+				}
+				project, resp, err := getProjectV2(ctx, client, ownerType, owner, projectNumber)
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get project", resp, err), nil
+				}
+				_ = resp.Body.Close()
+				projectNodeID = project.GetNodeID()
+			}
+
+			updateItem := func(itemID int64) bulkProjectItemOutcome {
+				updated := &BulkUpdatedProjectItem{ItemID: itemID}
+				if len(set) > 0 {
+					_, resp, err := updateProjectItem(ctx, client, ownerType, owner, projectNumber, itemID, &github.UpdateProjectItemOptions{Fields: set})
+					if err != nil {
+						return bulkProjectItemOutcome{resp: resp, err: err}
+					}
+					_ = resp.Body.Close()
+					for _, field := range set {
+						updated.UpdatedFields = append(updated.UpdatedFields, field.ID)
+					}
+				}
+				if len(cleared) > 0 {
+					item, resp, err := getProjectItem(ctx, client, ownerType, owner, projectNumber, itemID, nil)
+					if err != nil {
+						return bulkProjectItemOutcome{resp: resp, err: fmt.Errorf("failed to get project item: %w", err)}
+					}
+					_ = resp.Body.Close()
+					for _, fieldID := range cleared {
+						if err := clearProjectV2ItemFieldValue(ctx, gqlClient, projectNodeID, item.GetNodeID(), clearFieldNodeIDs[fieldID]); err != nil {
+							return bulkProjectItemOutcome{err: fmt.Errorf("failed to clear field %d: %w", fieldID, err), graphQL: true}
+						}
+						updated.ClearedFields = append(updated.ClearedFields, fieldID)
+					}
+				}
+				return bulkProjectItemOutcome{updated: updated}
+			}
+
+			outcomes := make([]bulkProjectItemOutcome, len(itemIDs))
+			sem := make(chan struct{}, concurrency)
+			var wg sync.WaitGroup
+			for i, itemID := range itemIDs {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					sem <- struct{}{}
+					defer func() { <-sem }()
+					outcomes[i] = updateItem(itemID)
+				}()
+			}
+			wg.Wait()
+
+			result := NewBulkResult[BulkUpdatedProjectItem]()
+			for i, outcome := range outcomes {
+				item := strconv.FormatInt(itemIDs[i], 10)
+				switch {
+				case outcome.err != nil && outcome.graphQL:
+					result.AddGraphQLFailure(item, outcome.err)
+				case outcome.err != nil:
+					result.AddAPIFailure(item, outcome.resp, outcome.err)
+				default:
+					result.AddSuccess(*outcome.updated)
+				}
+			}
+			return result.ToolResult()
+		}
+}
+
+// BulkArchiveProjectItems creates a tool that archives or unarchives many items of a project.
+func BulkArchiveProjectItems(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("bulk_archive_project_items",
+			mcp.WithDescription(t("TOOL_BULK_ARCHIVE_PROJECT_ITEMS_DESCRIPTION", fmt.Sprintf("Archive up to %d items of a Project of a user or org in one call, for example to clean up the cards of a finished sprint, or unarchive them to bring them back. Archived items keep their field values and are hidden from the project's views. Reports the result of every item, with a summary.", MaxBulkProjectItems))),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:           t("TOOL_BULK_ARCHIVE_PROJECT_ITEMS_USER_TITLE", "Archive project items in bulk"),
+				ReadOnlyHint:    ToBoolPtr(false),
+				DestructiveHint: ToBoolPtr(false),
+				IdempotentHint:  ToBoolPtr(true),
+			}),
+			mcp.WithString("owner_type",
+				mcp.Required(),
+				mcp.Description("Owner type"),
+				mcp.Enum("user", "org"),
+			),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("If owner_type == user it is the handle for the GitHub user account. If owner_type == org it is the name of the organization. The name is not case sensitive."),
+			),
+			mcp.WithNumber("project_number",
+				mcp.Required(),
+				mcp.Description("The project's number."),
+			),
+			mcp.WithArray("item_ids",
+				mcp.Required(),
+				mcp.Description("The unique identifiers of the project items to archive (e.g. [\"135086\", \"135087\"]). These are not the issue or pull request IDs."),
+				mcp.WithStringItems(),
+			),
+			mcp.WithBoolean("unarchive",
+				mcp.Description("Unarchive the items instead of archiving them"),
+			),
+			mcp.WithNumber("concurrency",
+				mcp.Description("Number of items to change at a time"),
+				mcp.Min(1),
+				mcp.Max(MaxBulkProjectItemConcurrency),
+				mcp.DefaultNumber(DefaultBulkProjectItemConcurrency),
+			),
+		), func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			ownerType, err := RequiredParam[string](req, "owner_type")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			owner, err := RequiredParam[string](req, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			projectNumber, err := ValidateProjectNumber(req)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			itemIDs, err := bulkProjectItemIDsParam(req)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			unarchive, err := OptionalParam[bool](req, "unarchive")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			concurrency, err := OptionalIntParamWithDefault(req, "concurrency", DefaultBulkProjectItemConcurrency)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			concurrency = min(max(concurrency, 1), MaxBulkProjectItemConcurrency)
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			outcomes := make([]bulkProjectItemOutcome, len(itemIDs))
+			sem := make(chan struct{}, concurrency)
+			var wg sync.WaitGroup
+			for i, itemID := range itemIDs {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					sem <- struct{}{}
+					defer func() { <-sem }()
+					_, resp, err := updateProjectItem(ctx, client, ownerType, owner, projectNumber, itemID, &github.UpdateProjectItemOptions{Archived: github.Ptr(!unarchive)})
+					if err != nil {
+						outcomes[i] = bulkProjectItemOutcome{resp: resp, err: err}
+						return
+					}
+					_ = resp.Body.Close()
+				}()
+			}
+			wg.Wait()
+
+			result := NewBulkResult[BulkArchivedProjectItem]()
+			for i, outcome := range outcomes {
+				if outcome.err != nil {
+					result.AddAPIFailure(strconv.FormatInt(itemIDs[i], 10), outcome.resp, outcome.err)
+					continue
+				}
+				result.AddSuccess(BulkArchivedProjectItem{ItemID: itemIDs[i], Archived: !unarchive})
+			}
+			return result.ToolResult()
+		}
+}
@@ -0,0 +1,244 @@
+package github
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/ghmock"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type bulkUpdateProjectItemsResponse struct {
+	Succeeded []BulkUpdatedProjectItem `json:"succeeded"`
+	Failed    []BulkFailure            `json:"failed"`
+	Summary   BulkSummary              `json:"summary"`
+}
+
+func Test_BulkUpdateProjectItems(t *testing.T) {
+	tool, _ := BulkUpdateProjectItems(nil, nil, translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+	assert.True(t, *tool.Annotations.DestructiveHint)
+	assert.ElementsMatch(t, []string{"owner_type", "owner", "project_number", "item_ids", "updated_fields"}, tool.InputSchema.Required)
+
+	call := func(t *testing.T, server *ghmock.Server, args map[string]any) (bulkUpdateProjectItemsResponse, string, bool) {
+		_, handler := BulkUpdateProjectItems(server.GetClient(), server.GetGQLClient(), translations.NullTranslationHelper)
+		merged := map[string]any{"owner_type": "org", "owner": "octo", "project_number": float64(1)}
+		for k, v := range args {
+			merged[k] = v
+		}
+		result := ghmock.CallTool(t, handler, merged)
+		var resp bulkUpdateProjectItemsResponse
+		if !result.IsError {
+			require.NoError(t, json.Unmarshal([]byte(ghmock.ResultText(t, result)), &resp))
+		}
+		return resp, ghmock.ResultText(t, result), result.IsError
+	}
+
+	t.Run("sets the fields of every item", func(t *testing.T) {
+		server := ghmock.New(t)
+		server.Respond("GET /orgs/octo/projectsV2/1/fields/10", http.StatusOK, map[string]any{
+			"id": 10, "name": "Sprint", "data_type": "iteration",
+			"configuration": map[string]any{"iterations": []any{
+				map[string]any{"id": "it-2", "title": map[string]any{"raw": "Sprint 2"}, "start_date": time.Now().UTC().AddDate(0, 0, -3).Format(time.DateOnly), "duration": 14},
+			}},
+		})
+		server.Respond("GET /orgs/octo/projectsV2/1/fields/11", http.StatusOK, map[string]any{
+			"id": 11, "name": "Priority", "data_type": "single_select",
+			"options": []any{map[string]any{"id": "opt-p1", "name": map[string]any{"raw": "P1"}}},
+		})
+		var bodies []map[string]any
+		server.HandleFunc("PATCH /orgs/octo/projectsV2/1/items/{id}", func(w http.ResponseWriter, r *http.Request) {
+			if r.PathValue("id") == "803" {
+				w.WriteHeader(http.StatusNotFound)
+				_, _ = w.Write([]byte(`{"message": "Not Found"}`))
+				return
+			}
+			var body map[string]any
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			bodies = append(bodies, body)
+			_, _ = w.Write([]byte(`{"id": ` + r.PathValue("id") + `}`))
+		})
+
+		resp, text, isError := call(t, server, map[string]any{
+			"item_ids": []any{"801", float64(802), "803"},
+			"updated_fields": []any{
+				map[string]any{"id": float64(10), "value": "@current"},
+				map[string]any{"id": float64(11), "value": "p1"},
+				map[string]any{"id": float64(12), "value": float64(3)},
+			},
+			"concurrency": float64(1),
+		})
+		require.False(t, isError, text)
+
+		assert.Equal(t, BulkSummary{Total: 3, Succeeded: 2, Failed: 1}, resp.Summary)
+		assert.Equal(t, []BulkUpdatedProjectItem{
+			{ItemID: 801, UpdatedFields: []int64{10, 11, 12}},
+			{ItemID: 802, UpdatedFields: []int64{10, 11, 12}},
+		}, resp.Succeeded)
+		require.Len(t, resp.Failed, 1)
+		assert.Equal(t, "803", resp.Failed[0].Item)
+		require.Len(t, bodies, 2)
+		assert.Equal(t, map[string]any{"fields": []any{
+			map[string]any{"id": float64(10), "value": "it-2"},
+			map[string]any{"id": float64(11), "value": "opt-p1"},
+			map[string]any{"id": float64(12), "value": float64(3)},
+		}}, bodies[0])
+	})
+
+	t.Run("clears fields", func(t *testing.T) {
+		server := ghmock.New(t)
+		server.Respond("GET /users/octocat/projectsV2/1", http.StatusOK, map[string]any{"id": 1, "node_id": "PVT_1"})
+		server.Respond("GET /users/octocat/projectsV2/1/fields/12", http.StatusOK, map[string]any{"id": 12, "node_id": "PVTF_12", "name": "Due", "data_type": "date"})
+		server.Respond("GET /users/octocat/projectsV2/1/items/801", http.StatusOK, map[string]any{"id": 801, "node_id": "PVTI_801"})
+		server.Respond("GET /users/octocat/projectsV2/1/items/802", http.StatusOK, map[string]any{"id": 802, "node_id": "PVTI_802"})
+		server.HandleGraphQL("clearProjectV2ItemFieldValue(", func(_ string, variables map[string]any) (any, []string) {
+			if variables["input"].(map[string]any)["itemId"] == "PVTI_802" {
+				return nil, []string{"Field cannot be cleared"}
+			}
+			return map[string]any{"clearProjectV2ItemFieldValue": map[string]any{"projectV2Item": map[string]any{"id": "PVTI_801"}}}, nil
+		})
+
+		resp, text, isError := call(t, server, map[string]any{
+			"owner_type": "user", "owner": "octocat",
+			"item_ids":       []any{float64(801), float64(802)},
+			"updated_fields": []any{map[string]any{"id": float64(12), "clear": true}},
+		})
+		require.False(t, isError, text)
+
+		assert.Equal(t, []BulkUpdatedProjectItem{{ItemID: 801, ClearedFields: []int64{12}}}, resp.Succeeded)
+		require.Len(t, resp.Failed, 1)
+		assert.Equal(t, "802", resp.Failed[0].Item)
+		assert.Contains(t, resp.Failed[0].Message, "failed to clear field 12")
+		assert.Len(t, server.Mutations(), 2)
+		server.AssertNotRequested(http.MethodPatch)
+	})
+
+	t.Run("value the field does not accept", func(t *testing.T) {
+		server := ghmock.New(t)
+		server.Respond("GET /orgs/octo/projectsV2/1/fields/11", http.StatusOK, map[string]any{
+			"id": 11, "name": "Priority", "data_type": "single_select",
+			"options": []any{map[string]any{"id": "opt-p1", "name": map[string]any{"raw": "P1"}}},
+		})
+		_, text, isError := call(t, server, map[string]any{
+			"item_ids":       []any{float64(801)},
+			"updated_fields": []any{map[string]any{"id": float64(11), "value": "P0"}},
+		})
+		require.True(t, isError)
+		assert.Equal(t, `"P0" is not an option of field Priority; options are: P1`, text)
+		server.AssertNotRequested("")
+	})
+
+	for name, tc := range map[string]struct {
+		args    map[string]any
+		message string
+	}{
+		"no items": {
+			map[string]any{"item_ids": []any{}, "updated_fields": []any{map[string]any{"id": float64(11), "value": "P1"}}},
+			"item_ids must contain between 1 and 100 items, got 0",
+		},
+		"repeated item": {
+			map[string]any{"item_ids": []any{float64(801), "801"}, "updated_fields": []any{map[string]any{"id": float64(11), "value": "P1"}}},
+			"item_ids[1]: item 801 is listed twice",
+		},
+		"node ID": {
+			map[string]any{"item_ids": []any{"PVTI_801"}, "updated_fields": []any{map[string]any{"id": float64(11), "value": "P1"}}},
+			`invalid item_ids[0] "PVTI_801": this is a GraphQL node ID`,
+		},
+		"no fields": {
+			map[string]any{"item_ids": []any{float64(801)}, "updated_fields": []any{}},
+			"missing required parameter: updated_fields",
+		},
+		"missing value": {
+			map[string]any{"item_ids": []any{float64(801)}, "updated_fields": []any{map[string]any{"id": float64(11)}}},
+			"updated_fields[0].value is required",
+		},
+		"repeated field": {
+			map[string]any{"item_ids": []any{float64(801)}, "updated_fields": []any{
+				map[string]any{"id": float64(11), "value": "P1"},
+				map[string]any{"id": "11", "clear": true},
+			}},
+			"updated_fields[1]: field 11 is listed twice",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			server := ghmock.New(t)
+			_, text, isError := call(t, server, tc.args)
+			require.True(t, isError)
+			assert.Contains(t, text, tc.message)
+			assert.Empty(t, server.Requests())
+		})
+	}
+}
+
+func Test_BulkArchiveProjectItems(t *testing.T) {
+	tool, _ := BulkArchiveProjectItems(nil, translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+	assert.False(t, *tool.Annotations.DestructiveHint)
+	assert.ElementsMatch(t, []string{"owner_type", "owner", "project_number", "item_ids"}, tool.InputSchema.Required)
+
+	type response struct {
+		Succeeded []BulkArchivedProjectItem `json:"succeeded"`
+		Failed    []BulkFailure             `json:"failed"`
+		Summary   BulkSummary               `json:"summary"`
+	}
+	for name, unarchive := range map[string]bool{"archive": false, "unarchive": true} {
+		t.Run(name, func(t *testing.T) {
+			server := ghmock.New(t)
+			var bodies []map[string]any
+			server.HandleFunc("PATCH /orgs/octo/projectsV2/1/items/{id}", func(w http.ResponseWriter, r *http.Request) {
+				if r.PathValue("id") == "803" {
+					w.WriteHeader(http.StatusNotFound)
+					_, _ = w.Write([]byte(`{"message": "Not Found"}`))
+					return
+				}
+				var body map[string]any
+				require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+				bodies = append(bodies, body)
+				_, _ = w.Write([]byte(`{"id": ` + r.PathValue("id") + `}`))
+			})
+
+			_, handler := BulkArchiveProjectItems(server.GetClient(), translations.NullTranslationHelper)
+			result := ghmock.CallTool(t, handler, map[string]any{
+				"owner_type": "org", "owner": "octo", "project_number": float64(1),
+				"item_ids": []any{float64(801), "802", float64(803)}, "unarchive": unarchive, "concurrency": float64(1),
+			})
+			require.False(t, result.IsError, ghmock.ResultText(t, result))
+
+			var resp response
+			require.NoError(t, json.Unmarshal([]byte(ghmock.ResultText(t, result)), &resp))
+			assert.Equal(t, BulkSummary{Total: 3, Succeeded: 2, Failed: 1}, resp.Summary)
+			assert.Equal(t, []BulkArchivedProjectItem{{ItemID: 801, Archived: !unarchive}, {ItemID: 802, Archived: !unarchive}}, resp.Succeeded)
+			require.Len(t, resp.Failed, 1)
+			assert.Equal(t, "803", resp.Failed[0].Item)
+			assert.Equal(t, []map[string]any{{"archived": !unarchive}, {"archived": !unarchive}}, bodies)
+		})
+	}
+
+	t.Run("every item failed", func(t *testing.T) {
+		server := ghmock.New(t)
+		server.Respond("PATCH /users/octocat/projectsV2/1/items/{id}", http.StatusForbidden, map[string]any{"message": "Resource not accessible by integration"})
+		_, handler := BulkArchiveProjectItems(server.GetClient(), translations.NullTranslationHelper)
+		result := ghmock.CallTool(t, handler, map[string]any{
+			"owner_type": "user", "owner": "octocat", "project_number": float64(1), "item_ids": []any{float64(801)},
+		})
+		require.True(t, result.IsError)
+	})
+
+	t.Run("too many items", func(t *testing.T) {
+		server := ghmock.New(t)
+		ids := make([]any, MaxBulkProjectItems+1)
+		for i := range ids {
+			ids[i] = float64(i + 1)
+		}
+		_, handler := BulkArchiveProjectItems(server.GetClient(), translations.NullTranslationHelper)
+		result := ghmock.CallTool(t, handler, map[string]any{"owner_type": "org", "owner": "octo", "project_number": float64(1), "item_ids": ids})
+		require.True(t, result.IsError)
+		assert.Equal(t, "item_ids must contain between 1 and 100 items, got 101", ghmock.ResultText(t, result))
+		assert.Empty(t, server.Requests())
+	})
+}
@@ -0,0 +1,463 @@
+package github
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v79/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/shurcooL/githubv4"
+)
+
+// Enum values for ManageProjectIterations action
+const (
+	ProjectIterationActionAdd      = "add"
+	ProjectIterationActionUpdate   = "update"
+	ProjectIterationActionComplete = "complete"
+)
+
+// ProjectIterations are the iterations of an iteration field, oldest first.
+type ProjectIterations struct {
+	FieldID    int64              `json:"field_id"`
+	FieldName  string             `json:"field_name"`
+	Duration   int                `json:"duration"`
+	Iterations []ProjectIteration `json:"iterations"`
+	// UnassignedItems is the number of items in no iteration, when the items of the iterations were listed.
+	UnassignedItems *int `json:"unassigned_items,omitempty"`
+}
+
+// listResponse returns the iterations in the list envelope, with the field they belong to as extra fields. All the
+// iterations of a field come in one response, so there is no next page to point to.
+func (p ProjectIterations) listResponse() map[string]any {
+	response := NewListResponse("iterations", p.Iterations, PageInfo{}, nil)
+	response["field_id"] = p.FieldID
+	response["field_name"] = p.FieldName
+	response["duration"] = p.Duration
+	if p.UnassignedItems != nil {
+		response["unassigned_items"] = *p.UnassignedItems
+	}
+	return response
+}
+
+// ProjectIteration is an iteration of an iteration field. EndDate is its last day.
+type ProjectIteration struct {
+	ID        string                 `json:"id"`
+	Title     string                 `json:"title"`
+	StartDate string                 `json:"start_date"`
+	Duration  int                    `json:"duration"`
+	EndDate   string                 `json:"end_date"`
+	Completed bool                   `json:"completed"`
+	Items     []ProjectIterationItem `json:"items,omitempty"`
+}
+
+// ProjectIterationItem is an item of a project in an iteration.
+type ProjectIterationItem struct {
+	ID          int64  `json:"id"`
+	ContentType string `json:"content_type"`
+	Title       string `json:"title"`
+	URL         string `json:"url,omitempty"`
+}
+
+// projectIterationNode is an iteration as returned by GraphQL. Start dates are YYYY-MM-DD.
+type projectIterationNode struct {
+	ID        githubv4.String
+	Title     githubv4.String
+	StartDate githubv4.String
+	Duration  githubv4.Int
+}
+
+// projectIterationConfiguration is the configuration of an iteration field. Setting the iterations replaces all of
+// them, so the completed iterations are passed back along with the current ones to keep them.
+type projectIterationConfiguration struct {
+	Duration            githubv4.Int
+	Iterations          []projectIterationNode
+	CompletedIterations []projectIterationNode
+}
+
+// iterations returns the completed and current iterations, oldest first.
+func (c projectIterationConfiguration) iterations() []ProjectIteration {
+	var iterations []ProjectIteration
+	for _, completed := range []bool{true, false} {
+		nodes := c.Iterations
+		if completed {
+			nodes = c.CompletedIterations
+		}
+		for _, node := range nodes {
+			iteration := ProjectIteration{
+				ID:        string(node.ID),
+				Title:     string(node.Title),
+				StartDate: string(node.StartDate),
+				Duration:  int(node.Duration),
+				Completed: completed,
+			}
+			if start, err := time.Parse(time.DateOnly, iteration.StartDate); err == nil {
+				iteration.EndDate = start.AddDate(0, 0, iteration.Duration-1).Format(time.DateOnly)
+			}
+			iterations = append(iterations, iteration)
+		}
+	}
+	slices.SortStableFunc(iterations, func(a, b ProjectIteration) int {
+		return strings.Compare(a.StartDate, b.StartDate)
+	})
+	return iterations
+}
+
+// getProjectIterationConfiguration queries the configuration of an iteration field by its node ID.
+func getProjectIterationConfiguration(ctx context.Context, client *githubv4.Client, fieldNodeID string) (projectIterationConfiguration, error) {
+	var query struct {
+		Node struct {
+			ProjectV2IterationField struct {
+				Configuration projectIterationConfiguration
+			} `graphql:"... on ProjectV2IterationField"`
+		} `graphql:"node(id: $id)"`
+	}
+	if err := client.Query(ctx, &query, map[string]any{"id": githubv4.ID(fieldNodeID)}); err != nil {
+		return projectIterationConfiguration{}, err
+	}
+	return query.Node.ProjectV2IterationField.Configuration, nil
+}
+
+// setProjectIterations replaces the iterations of an iteration field. The first iteration sets the start of the
+// cadence.
+func setProjectIterations(ctx context.Context, client *githubv4.Client, fieldNodeID string, duration int, iterations []ProjectIteration) error {
+	inputs := make([]ProjectV2Iteration, len(iterations))
+	for i, iteration := range iterations {
+		inputs[i] = ProjectV2Iteration{
+			Title:     githubv4.String(iteration.Title),
+			StartDate: githubv4.String(iteration.StartDate),
+			Duration:  githubv4.Int(iteration.Duration),
+		}
+	}
+	slices.SortStableFunc(inputs, func(a, b ProjectV2Iteration) int {
+		return strings.Compare(string(a.StartDate), string(b.StartDate))
+	})
+	startDate := time.Now().UTC().Format(time.DateOnly)
+	if len(inputs) > 0 {
+		startDate = string(inputs[0].StartDate)
+	}
+
+	var mutation struct {
+		UpdateProjectV2Field struct {
+			ProjectV2Field projectV2FieldConfiguration
+		} `graphql:"updateProjectV2Field(input: $input)"`
+	}
+	input := UpdateProjectV2FieldInput{
+		FieldID: githubv4.ID(fieldNodeID),
+		IterationConfiguration: &ProjectV2IterationFieldConfigurationInput{
+			Duration:   githubv4.Int(duration),
+			StartDate:  githubv4.String(startDate),
+			Iterations: inputs,
+		},
+	}
+	return client.Mutate(ctx, &mutation, input, nil)
+}
+
+// getIterationField gets an iteration field and its configuration, or returns the error result of the tool.
+func getIterationField(ctx context.Context, client *github.Client, gqlClient *githubv4.Client, ownerType, owner string, number int, fieldID int64) (*github.ProjectV2Field, projectIterationConfiguration, *mcp.CallToolResult) {
+	field, resp, err := getProjectField(ctx, client, ownerType, owner, number, fieldID)
+	if err != nil {
+		return nil, projectIterationConfiguration{}, ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get project field", resp, err)
+	}
+	_ = resp.Body.Close()
+	if field.GetDataType() != "iteration" {
+		return nil, projectIterationConfiguration{}, mcp.NewToolResultError(fmt.Sprintf("field %s is of type %s, not iteration", field.GetName(), field.GetDataType()))
+	}
+	config, err := getProjectIterationConfiguration(ctx, gqlClient, field.GetNodeID())
+	if err != nil {
+		return nil, projectIterationConfiguration{}, ghErrors.NewGitHubGraphQLErrorResponse(ctx, "failed to get project iterations", err)
+	}
+	return field, config, nil
+}
+
+// ListProjectIterations creates a tool that lists the iterations of an iteration field, and optionally their items.
+func ListProjectIterations(getClient GetClientFn, getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_project_iterations",
+			mcp.WithDescription(t("TOOL_LIST_PROJECT_ITERATIONS_DESCRIPTION", "List the iterations of an iteration field of a Project of a user or org, completed ones included, oldest first. Set include_items to also list the items in each iteration, e.g. to plan or review a sprint.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_LIST_PROJECT_ITERATIONS_USER_TITLE", "List project iterations"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner_type",
+				mcp.Required(),
+				mcp.Description("Owner type"),
+				mcp.Enum("user", "org"),
+			),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("If owner_type == user it is the handle for the GitHub user account. If owner_type == org it is the name of the organization. The name is not case sensitive."),
+			),
+			mcp.WithNumber("project_number",
+				mcp.Required(),
+				mcp.Description("The project's number."),
+			),
+			mcp.WithNumber("field_id",
+				mcp.Required(),
+				mcp.Description("The id of the iteration field."),
+			),
+			mcp.WithBoolean("include_items",
+				mcp.Description("Also list the items in each iteration"),
+				mcp.DefaultBool(false),
+			),
+		), func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			ownerType, err := RequiredParam[string](req, "owner_type")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			owner, err := RequiredParam[string](req, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			projectNumber, err := ValidateProjectNumber(req)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			fieldID, err := ValidateProjectFieldID(req)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			includeItems, err := OptionalBoolParamWithDefault(req, "include_items", false)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			gqlClient, err := getGQLClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub GraphQL client: %w", err)
+			}
+
+			field, config, errResult := getIterationField(ctx, client, gqlClient, ownerType, owner, projectNumber, fieldID)
+			if errResult != nil {
+				return errResult, nil
+			}
+			result := ProjectIterations{
+				FieldID:    fieldID,
+				FieldName:  field.GetName(),
+				Duration:   int(config.Duration),
+				Iterations: config.iterations(),
+			}
+			if !includeItems {
+				return MarshalledTextResult(result.listResponse()), nil
+			}
+
+			items, resp, err := listAllProjectItems(ctx, client, ownerType, owner, projectNumber, []int64{fieldID})
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to list project items", resp, err), nil
+			}
+			byIteration := map[string]int{}
+			for i, iteration := range result.Iterations {
+				byIteration[iteration.ID] = i
+			}
+			unassigned := 0
+			for _, item := range items {
+				iterationItem := ProjectIterationItem{ID: item.GetID(), ContentType: item.GetContentType()}
+				if item.Content != nil {
+					iterationItem.Title = item.Content.Title
+					iterationItem.URL = item.Content.HTMLURL
+				}
+				i, ok := -1, false
+				for _, value := range item.Fields {
+					if value.GetID() != fieldID {
+						continue
+					}
+					if m, isMap := value.Value.(map[string]any); isMap {
+						id, _ := m["id"].(string)
+						i, ok = byIteration[id]
+					}
+				}
+				if !ok {
+					unassigned++
+					continue
+				}
+				result.Iterations[i].Items = append(result.Iterations[i].Items, iterationItem)
+			}
+			result.UnassignedItems = &unassigned
+			return MarshalledTextResult(result.listResponse()), nil
+		}
+}
+
+// ManageProjectIterations creates a tool that adds, changes and completes the iterations of an iteration field.
+func ManageProjectIterations(getClient GetClientFn, getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("manage_project_iterations",
+			mcp.WithDescription(t("TOOL_MANAGE_PROJECT_ITERATIONS_DESCRIPTION", "Manage the iterations of an iteration field of a Project of a user or org: add an iteration, update the title, start date or duration of one, or complete one by ending it yesterday. GitHub treats an iteration as completed once its last day has passed. To change the cadence of the field, use update_project_field.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:           t("TOOL_MANAGE_PROJECT_ITERATIONS_USER_TITLE", "Manage project iterations"),
+				ReadOnlyHint:    ToBoolPtr(false),
+				DestructiveHint: ToBoolPtr(true),
+				IdempotentHint:  ToBoolPtr(false),
+			}),
+			mcp.WithString("owner_type",
+				mcp.Required(),
+				mcp.Description("Owner type"),
+				mcp.Enum("user", "org"),
+			),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("If owner_type == user it is the handle for the GitHub user account. If owner_type == org it is the name of the organization. The name is not case sensitive."),
+			),
+			mcp.WithNumber("project_number",
+				mcp.Required(),
+				mcp.Description("The project's number."),
+			),
+			mcp.WithNumber("field_id",
+				mcp.Required(),
+				mcp.Description("The id of the iteration field."),
+			),
+			mcp.WithString("action",
+				mcp.Required(),
+				mcp.Description("Action to perform: add an iteration, update one, or complete one."),
+				mcp.Enum(ProjectIterationActionAdd, ProjectIterationActionUpdate, ProjectIterationActionComplete),
+			),
+			mcp.WithString("iteration_id",
+				mcp.Description("ID of the iteration to update or complete, as returned by list_project_iterations"),
+			),
+			mcp.WithString("title",
+				mcp.Description("Title of the iteration. Defaults to Iteration N for a new iteration."),
+			),
+			mcp.WithString("start_date",
+				mcp.Description("Start date of the iteration, as YYYY-MM-DD. Defaults to the day after the last iteration for a new iteration."),
+			),
+			mcp.WithNumber("duration",
+				mcp.Description("Length of the iteration, in days. Defaults to the duration of the field for a new iteration."),
+				mcp.Min(1),
+			),
+		), func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			ownerType, err := RequiredParam[string](req, "owner_type")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			owner, err := RequiredParam[string](req, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			projectNumber, err := ValidateProjectNumber(req)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			fieldID, err := ValidateProjectFieldID(req)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			action, err := RequiredParam[string](req, "action")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			iterationID, err := OptionalParam[string](req, "iteration_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			title, err := OptionalParam[string](req, "title")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			startDate, err := projectDateParam(req, "start_date")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			duration, err := OptionalIntParam(req, "duration")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			switch action {
+			case ProjectIterationActionAdd:
+			case ProjectIterationActionUpdate:
+				if iterationID == "" {
+					return mcp.NewToolResultError("missing required parameter: iteration_id"), nil
+				}
+				if title == "" && startDate == "" && duration == 0 {
+					return mcp.NewToolResultError("at least one of title, start_date or duration must be given"), nil
+				}
+			case ProjectIterationActionComplete:
+				if iterationID == "" {
+					return mcp.NewToolResultError("missing required parameter: iteration_id"), nil
+				}
+			default:
+				return mcp.NewToolResultError("Invalid action. Must be one of: add, update, complete."), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			gqlClient, err := getGQLClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub GraphQL client: %w", err)
+			}
+
+			field, config, errResult := getIterationField(ctx, client, gqlClient, ownerType, owner, projectNumber, fieldID)
+			if errResult != nil {
+				return errResult, nil
+			}
+			iterations := config.iterations()
+
+			if action == ProjectIterationActionAdd {
+				iteration := ProjectIteration{
+					Title:     cmp.Or(title, fmt.Sprintf("Iteration %d", len(iterations)+1)),
+					StartDate: startDate,
+					Duration:  cmp.Or(duration, int(config.Duration)),
+				}
+				if iteration.StartDate == "" {
+					iteration.StartDate = time.Now().UTC().Format(time.DateOnly)
+					if len(iterations) > 0 {
+						last := iterations[len(iterations)-1]
+						if end, err := time.Parse(time.DateOnly, last.EndDate); err == nil {
+							iteration.StartDate = end.AddDate(0, 0, 1).Format(time.DateOnly)
+						}
+					}
+				}
+				iterations = append(iterations, iteration)
+			} else {
+				i := slices.IndexFunc(iterations, func(iteration ProjectIteration) bool { return iteration.ID == iterationID })
+				if i == -1 {
+					return mcp.NewToolResultError(fmt.Sprintf("field %s has no iteration with ID %q", field.GetName(), iterationID)), nil
+				}
+				iteration := &iterations[i]
+				if action == ProjectIterationActionUpdate {
+					iteration.Title = cmp.Or(title, iteration.Title)
+					iteration.StartDate = cmp.Or(startDate, iteration.StartDate)
+					iteration.Duration = cmp.Or(duration, iteration.Duration)
+				} else {
+					if iteration.Completed {
+						return mcp.NewToolResultError(fmt.Sprintf("iteration %s is already completed", iteration.Title)), nil
+					}
+					start, err := time.Parse(time.DateOnly, iteration.StartDate)
+					if err != nil {
+						return nil, fmt.Errorf("failed to parse the start date of iteration %s: %w", iteration.Title, err)
+					}
+					// Ending the iteration yesterday completes it, since GitHub completes iterations whose last day has passed.
+					today := time.Now().UTC().Truncate(24 * time.Hour)
+					days := int(today.Sub(start).Hours() / 24)
+					if days < 1 {
+						return mcp.NewToolResultError(fmt.Sprintf("iteration %s starts on %s and can only be completed after its first day", iteration.Title, iteration.StartDate)), nil
+					}
+					iteration.Duration = days
+				}
+			}
+
+			if err := setProjectIterations(ctx, gqlClient, field.GetNodeID(), int(config.Duration), iterations); err != nil {
+				return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "failed to update project iterations", err), nil
+			}
+
+			config, err = getProjectIterationConfiguration(ctx, gqlClient, field.GetNodeID())
+			if err != nil {
+				return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "iterations were saved but could not be read back", err), nil
+			}
+			return MarshalledTextResult(ProjectIterations{
+				FieldID:    fieldID,
+				FieldName:  field.GetName(),
+				Duration:   int(config.Duration),
+				Iterations: config.iterations(),
+			}), nil
+		}
+}
@@ -0,0 +1,183 @@
+package github
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/ghmock"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// respondIterations serves the Sprint field of the Planning project with a completed Sprint 1 and the given current
+// iterations.
+func respondIterations(server *ghmock.Server, current ...map[string]any) {
+	respondProjectFields(server)
+	server.HandleGraphQL("node(id: $id)", func(_ string, vars map[string]any) (any, []string) {
+		if vars["id"] != "PVTIF_12" {
+			return nil, []string{"unexpected node"}
+		}
+		return map[string]any{"node": map[string]any{"configuration": map[string]any{
+			"duration":            14,
+			"iterations":          current,
+			"completedIterations": []any{map[string]any{"id": "it-1", "title": "Sprint 1", "startDate": "2025-01-06", "duration": 14}},
+		}}}, nil
+	})
+}
+
+var sprint2 = map[string]any{"id": "it-2", "title": "Sprint 2", "startDate": "2025-01-20", "duration": 14}
+
+func Test_ListProjectIterations(t *testing.T) {
+	tool, _ := ListProjectIterations(nil, nil, translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+	assert.True(t, *tool.Annotations.ReadOnlyHint)
+
+	call := func(t *testing.T, server *ghmock.Server, args map[string]any) (string, bool) {
+		_, handler := ListProjectIterations(server.GetClient(), server.GetGQLClient(), translations.NullTranslationHelper)
+		args["owner_type"], args["owner"], args["project_number"] = "org", "octo", float64(1)
+		result := ghmock.CallTool(t, handler, args)
+		return ghmock.ResultText(t, result), result.IsError
+	}
+
+	t.Run("iterations", func(t *testing.T) {
+		server := ghmock.New(t)
+		respondIterations(server, sprint2)
+		text, isError := call(t, server, map[string]any{"field_id": float64(12)})
+		require.False(t, isError, text)
+		var iterations ProjectIterations
+		require.NoError(t, json.Unmarshal([]byte(text), &iterations))
+		assert.Equal(t, ProjectIterations{
+			FieldID: 12, FieldName: "Sprint", Duration: 14,
+			Iterations: []ProjectIteration{
+				{ID: "it-1", Title: "Sprint 1", StartDate: "2025-01-06", Duration: 14, EndDate: "2025-01-19", Completed: true},
+				{ID: "it-2", Title: "Sprint 2", StartDate: "2025-01-20", Duration: 14, EndDate: "2025-02-02"},
+			},
+		}, iterations)
+		assert.Contains(t, text, `"page_info":{"has_next_page":false,"has_previous_page":false}`)
+		for _, r := range server.Requests() {
+			assert.NotEqual(t, "/orgs/octo/projectsV2/1/items", r.Path)
+		}
+	})
+
+	t.Run("items of each iteration", func(t *testing.T) {
+		server := ghmock.New(t)
+		respondIterations(server, sprint2)
+		server.Respond("GET /orgs/octo/projectsV2/1/items", http.StatusOK, []map[string]any{
+			{
+				"id": 801, "content_type": "Issue", "content": map[string]any{"title": "Fix login", "html_url": "https://github.com/octo/app/issues/1"},
+				"fields": []map[string]any{{"id": 12, "name": "Sprint", "value": map[string]any{"id": "it-2", "title": map[string]any{"raw": "Sprint 2"}}}},
+			},
+			{"id": 802, "content_type": "DraftIssue", "fields": []map[string]any{{"id": 12, "name": "Sprint", "value": nil}}},
+		})
+		text, isError := call(t, server, map[string]any{"field_id": float64(12), "include_items": true})
+		require.False(t, isError, text)
+		assert.Equal(t, "12", server.AssertRequested("GET /orgs/octo/projectsV2/1/items").Query.Get("fields"))
+		var iterations ProjectIterations
+		require.NoError(t, json.Unmarshal([]byte(text), &iterations))
+		assert.Empty(t, iterations.Iterations[0].Items)
+		assert.Equal(t, []ProjectIterationItem{{ID: 801, ContentType: "Issue", Title: "Fix login", URL: "https://github.com/octo/app/issues/1"}}, iterations.Iterations[1].Items)
+		require.NotNil(t, iterations.UnassignedItems)
+		assert.Equal(t, 1, *iterations.UnassignedItems)
+	})
+
+	t.Run("not an iteration field", func(t *testing.T) {
+		server := ghmock.New(t)
+		respondIterations(server)
+		text, isError := call(t, server, map[string]any{"field_id": float64(11)})
+		require.True(t, isError)
+		assert.Contains(t, text, "field Status is of type single_select, not iteration")
+	})
+}
+
+func Test_ManageProjectIterations(t *testing.T) {
+	tool, _ := ManageProjectIterations(nil, nil, translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+	assert.True(t, *tool.Annotations.DestructiveHint)
+	assert.ElementsMatch(t, []string{"owner_type", "owner", "project_number", "field_id", "action"}, tool.InputSchema.Required)
+
+	call := func(t *testing.T, server *ghmock.Server, args map[string]any) (string, bool) {
+		_, handler := ManageProjectIterations(server.GetClient(), server.GetGQLClient(), translations.NullTranslationHelper)
+		args["owner_type"], args["owner"], args["project_number"], args["field_id"] = "org", "octo", float64(1), float64(12)
+		result := ghmock.CallTool(t, handler, args)
+		return ghmock.ResultText(t, result), result.IsError
+	}
+	// recordIterations records the iterations given to the field mutation.
+	recordIterations := func(server *ghmock.Server, iterations *any) {
+		server.HandleGraphQL("updateProjectV2Field(", func(_ string, vars map[string]any) (any, []string) {
+			input := vars["input"].(map[string]any)
+			if input["fieldId"] != "PVTIF_12" {
+				return nil, []string{"unexpected field"}
+			}
+			*iterations = input["iterationConfiguration"]
+			return map[string]any{"updateProjectV2Field": map[string]any{"projectV2Field": map[string]any{"databaseId": 12}}}, nil
+		})
+	}
+	sprint1 := map[string]any{"title": "Sprint 1", "startDate": "2025-01-06", "duration": float64(14)}
+
+	t.Run("add after the last iteration", func(t *testing.T) {
+		server := ghmock.New(t)
+		respondIterations(server, sprint2)
+		var config any
+		recordIterations(server, &config)
+
+		text, isError := call(t, server, map[string]any{"action": "add"})
+		require.False(t, isError, text)
+		assert.Equal(t, map[string]any{
+			"duration": float64(14), "startDate": "2025-01-06",
+			"iterations": []any{
+				sprint1,
+				map[string]any{"title": "Sprint 2", "startDate": "2025-01-20", "duration": float64(14)},
+				map[string]any{"title": "Iteration 3", "startDate": "2025-02-03", "duration": float64(14)},
+			},
+		}, config)
+	})
+
+	t.Run("update an iteration", func(t *testing.T) {
+		server := ghmock.New(t)
+		respondIterations(server, sprint2)
+		var config any
+		recordIterations(server, &config)
+
+		text, isError := call(t, server, map[string]any{"action": "update", "iteration_id": "it-2", "title": "Hardening", "duration": float64(7)})
+		require.False(t, isError, text)
+		assert.Equal(t, []any{sprint1, map[string]any{"title": "Hardening", "startDate": "2025-01-20", "duration": float64(7)}}, config.(map[string]any)["iterations"])
+	})
+
+	t.Run("complete an iteration", func(t *testing.T) {
+		server := ghmock.New(t)
+		start := time.Now().UTC().AddDate(0, 0, -3).Format(time.DateOnly)
+		respondIterations(server, map[string]any{"id": "it-2", "title": "Sprint 2", "startDate": start, "duration": 14})
+		var config any
+		recordIterations(server, &config)
+
+		text, isError := call(t, server, map[string]any{"action": "complete", "iteration_id": "it-2"})
+		require.False(t, isError, text)
+		assert.Equal(t, []any{sprint1, map[string]any{"title": "Sprint 2", "startDate": start, "duration": float64(3)}}, config.(map[string]any)["iterations"])
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		for name, tc := range map[string]struct {
+			args map[string]any
+			want string
+		}{
+			"update without iteration":     {map[string]any{"action": "update", "title": "Hardening"}, "missing required parameter: iteration_id"},
+			"update without changes":       {map[string]any{"action": "update", "iteration_id": "it-2"}, "at least one of title, start_date or duration must be given"},
+			"unknown iteration":            {map[string]any{"action": "complete", "iteration_id": "it-9"}, `field Sprint has no iteration with ID "it-9"`},
+			"completed iteration":          {map[string]any{"action": "complete", "iteration_id": "it-1"}, "iteration Sprint 1 is already completed"},
+			"iteration starting in future": {map[string]any{"action": "complete", "iteration_id": "it-3"}, "can only be completed after its first day"},
+		} {
+			t.Run(name, func(t *testing.T) {
+				server := ghmock.New(t)
+				respondIterations(server, map[string]any{"id": "it-3", "title": "Sprint 3", "startDate": time.Now().UTC().AddDate(0, 0, 7).Format(time.DateOnly), "duration": 14})
+				text, isError := call(t, server, tc.args)
+				require.True(t, isError)
+				assert.Contains(t, text, tc.want)
+				assert.Empty(t, server.Mutations())
+			})
+		}
+	})
+}
@@ -0,0 +1,288 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/shurcooL/githubv4"
+)
+
+// ProjectReadme is the short description and README of a project, where teams keep the working agreements of a board.
+type ProjectReadme struct {
+	Number           int       `json:"number"`
+	Title            string    `json:"title"`
+	URL              string    `json:"url"`
+	ShortDescription string    `json:"short_description"`
+	Readme           string    `json:"readme"`
+	UpdatedAt        time.Time `json:"updated_at"`
+}
+
+// projectReadmeFields are the fields of a ProjectV2 queried for its README. The REST API does not return the README.
+type projectReadmeFields struct {
+	ID               githubv4.ID
+	Number           githubv4.Int
+	Title            githubv4.String
+	URL              githubv4.URI
+	ShortDescription githubv4.String
+	Readme           githubv4.String
+	UpdatedAt        githubv4.DateTime
+}
+
+func (p projectReadmeFields) toProjectReadme() ProjectReadme {
+	return ProjectReadme{
+		Number:           int(p.Number),
+		Title:            string(p.Title),
+		URL:              p.URL.String(),
+		ShortDescription: string(p.ShortDescription),
+		Readme:           string(p.Readme),
+		UpdatedAt:        p.UpdatedAt.Time,
+	}
+}
+
+// markdown renders the README as a document headed by the title and short description of the project.
+func (r ProjectReadme) markdown() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "# %s\n\n", r.Title)
+	if r.ShortDescription != "" {
+		fmt.Fprintf(&b, "%s\n\n", r.ShortDescription)
+	}
+	if r.Readme != "" {
+		fmt.Fprintf(&b, "%s\n", strings.TrimRight(r.Readme, "\n"))
+	}
+	return b.String()
+}
+
+// getProjectReadme queries the README of a project of a user or an organization.
+func getProjectReadme(ctx context.Context, client *githubv4.Client, ownerType, owner string, number int) (projectReadmeFields, error) {
+	vars := map[string]any{
+		"owner":  githubv4.String(owner),
+		"number": githubv4.Int(number),
+	}
+	if ownerType == "org" {
+		var query struct {
+			Organization struct {
+				ProjectV2 projectReadmeFields `graphql:"projectV2(number: $number)"`
+			} `graphql:"organization(login: $owner)"`
+		}
+		if err := client.Query(ctx, &query, vars); err != nil {
+			return projectReadmeFields{}, err
+		}
+		return query.Organization.ProjectV2, nil
+	}
+	var query struct {
+		User struct {
+			ProjectV2 projectReadmeFields `graphql:"projectV2(number: $number)"`
+		} `graphql:"user(login: $owner)"`
+	}
+	if err := client.Query(ctx, &query, vars); err != nil {
+		return projectReadmeFields{}, err
+	}
+	return query.User.ProjectV2, nil
+}
+
+// GetProjectReadme creates a tool that gets the short description and README of a project.
+func GetProjectReadme(getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_project_readme",
+			mcp.WithDescription(t("TOOL_GET_PROJECT_README_DESCRIPTION", "Get the short description and README of a Project of a user or org. Teams often keep the working agreements of a board, such as what each status means, in its README.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_GET_PROJECT_README_USER_TITLE", "Get project README"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner_type",
+				mcp.Required(),
+				mcp.Description("Owner type"),
+				mcp.Enum("user", "org"),
+			),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("If owner_type == user it is the handle for the GitHub user account. If owner_type == org it is the name of the organization. The name is not case sensitive."),
+			),
+			mcp.WithNumber("project_number",
+				mcp.Required(),
+				mcp.Description("The project's number."),
+			),
+		), func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			ownerType, err := RequiredParam[string](req, "owner_type")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			owner, err := RequiredParam[string](req, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			projectNumber, err := ValidateProjectNumber(req)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getGQLClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub GraphQL client: %w", err)
+			}
+
+			project, err := getProjectReadme(ctx, client, ownerType, owner, projectNumber)
+			if err != nil {
+				return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "failed to get project README", err), nil
+			}
+			return MarshalledTextResult(project.toProjectReadme()), nil
+		}
+}
+
+// UpdateProjectReadme creates a tool that sets the short description and README of a project.
+func UpdateProjectReadme(getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("update_project_readme",
+			mcp.WithDescription(t("TOOL_UPDATE_PROJECT_README_DESCRIPTION", "Set the short description and/or README of a Project of a user or org. Each given value replaces the current one, so read the README with get_project_readme first to edit part of it. An empty string clears the value.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:           t("TOOL_UPDATE_PROJECT_README_USER_TITLE", "Update project README"),
+				ReadOnlyHint:    ToBoolPtr(false),
+				DestructiveHint: ToBoolPtr(false),
+				IdempotentHint:  ToBoolPtr(true),
+			}),
+			mcp.WithString("owner_type",
+				mcp.Required(),
+				mcp.Description("Owner type"),
+				mcp.Enum("user", "org"),
+			),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("If owner_type == user it is the handle for the GitHub user account. If owner_type == org it is the name of the organization. The name is not case sensitive."),
+			),
+			mcp.WithNumber("project_number",
+				mcp.Required(),
+				mcp.Description("The project's number."),
+			),
+			mcp.WithString("short_description",
+				mcp.Description("New short description of the project"),
+			),
+			mcp.WithString("readme",
+				mcp.Description("New README of the project, in Markdown"),
+			),
+			WithExpectedUpdatedAt("project"),
+		), func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			ownerType, err := RequiredParam[string](req, "owner_type")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			owner, err := RequiredParam[string](req, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			projectNumber, err := ValidateProjectNumber(req)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			shortDescription, err := OptionalParam[string](req, "short_description")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			readme, err := OptionalParam[string](req, "readme")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			// An empty value clears the field, so whether a parameter was given is told by its presence.
+			_, hasShortDescription := req.GetArguments()["short_description"]
+			_, hasReadme := req.GetArguments()["readme"]
+			if !hasShortDescription && !hasReadme {
+				return mcp.NewToolResultError("at least one of short_description or readme must be given"), nil
+			}
+			expectedUpdatedAt, err := expectedUpdatedAtParam(req)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getGQLClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub GraphQL client: %w", err)
+			}
+
+			project, err := getProjectReadme(ctx, client, ownerType, owner, projectNumber)
+			if err != nil {
+				return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "failed to get project", err), nil
+			}
+			if result := updatedSinceResult("project", expectedUpdatedAt, project.UpdatedAt.Time, project.toProjectReadme()); result != nil {
+				return result, nil
+			}
+
+			var mutation struct {
+				UpdateProjectV2 struct {
+					ProjectV2 projectReadmeFields
+				} `graphql:"updateProjectV2(input: $input)"`
+			}
+			input := githubv4.UpdateProjectV2Input{ProjectID: project.ID}
+			if hasShortDescription {
+				input.ShortDescription = githubv4.NewString(githubv4.String(shortDescription))
+			}
+			if hasReadme {
+				input.Readme = githubv4.NewString(githubv4.String(readme))
+			}
+			if err := client.Mutate(ctx, &mutation, input, nil); err != nil {
+				return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "failed to update project README", err), nil
+			}
+			return MarshalledTextResult(mutation.UpdateProjectV2.ProjectV2.toProjectReadme()), nil
+		}
+}
+
+// GetProjectReadmeResource defines the resource template and handler for getting the README of a project.
+func GetProjectReadmeResource(getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (mcp.ResourceTemplate, server.ResourceTemplateHandlerFunc) {
+	return mcp.NewResourceTemplate(
+			"project://{owner_type}/{owner}/{project_number}/readme", // Resource template
+			t("RESOURCE_PROJECT_README_DESCRIPTION", "Project README"),
+			mcp.WithTemplateMIMEType("text/markdown"),
+		),
+		ProjectReadmeResourceHandler(getGQLClient)
+}
+
+// ProjectReadmeResourceHandler returns a handler function for project README requests.
+func ProjectReadmeResourceHandler(getGQLClient GetGQLClientFn) func(ctx context.Context, request mcp.ReadResourceRequest) ([]mcp.ResourceContents, error) {
+	return func(ctx context.Context, request mcp.ReadResourceRequest) ([]mcp.ResourceContents, error) {
+		// the matcher will give []string with one element
+		ot, ok := request.Params.Arguments["owner_type"].([]string)
+		if !ok || len(ot) == 0 {
+			return nil, errors.New("owner_type is required")
+		}
+		ownerType := ot[0]
+		if ownerType != "org" && ownerType != "user" {
+			return nil, fmt.Errorf("owner_type must be org or user, got %q", ownerType)
+		}
+
+		o, ok := request.Params.Arguments["owner"].([]string)
+		if !ok || len(o) == 0 {
+			return nil, errors.New("owner is required")
+		}
+		owner := o[0]
+
+		n, ok := request.Params.Arguments["project_number"].([]string)
+		if !ok || len(n) == 0 {
+			return nil, errors.New("project_number is required")
+		}
+		projectNumber, err := strconv.Atoi(n[0])
+		if err != nil || projectNumber <= 0 {
+			return nil, fmt.Errorf("invalid project number: %q", n[0])
+		}
+
+		client, err := getGQLClient(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get GitHub GraphQL client: %w", err)
+		}
+		project, err := getProjectReadme(ctx, client, ownerType, owner, projectNumber)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get project README: %w", err)
+		}
+
+		return []mcp.ResourceContents{
+			mcp.TextResourceContents{
+				URI:      request.Params.URI,
+				MIMEType: "text/markdown",
+				Text:     project.toProjectReadme().markdown(),
+			},
+		}, nil
+	}
+}
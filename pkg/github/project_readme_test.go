@@ -0,0 +1,149 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/ghmock"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// projectReadmeJSON is the Security project of octo as returned by GraphQL.
+func projectReadmeJSON(shortDescription, readme string) map[string]any {
+	return map[string]any{
+		"id": "PVT_1", "number": 1, "title": "Security", "url": "https://github.com/orgs/octo/projects/1",
+		"shortDescription": shortDescription, "readme": readme, "updatedAt": "2025-01-06T10:00:00Z",
+	}
+}
+
+func respondProjectReadme(server *ghmock.Server) {
+	server.HandleGraphQL("organization(login: $owner)", func(_ string, vars map[string]any) (any, []string) {
+		if vars["owner"] != "octo" || vars["number"] != float64(1) {
+			return nil, []string{"Could not resolve to a ProjectV2 with the number 1."}
+		}
+		return map[string]any{"organization": map[string]any{"projectV2": projectReadmeJSON("Open alerts", "Triage within 2 days.\n")}}, nil
+	})
+}
+
+func Test_GetProjectReadme(t *testing.T) {
+	tool, _ := GetProjectReadme(nil, translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+	assert.True(t, *tool.Annotations.ReadOnlyHint)
+
+	server := ghmock.New(t)
+	respondProjectReadme(server)
+	_, handler := GetProjectReadme(server.GetGQLClient(), translations.NullTranslationHelper)
+
+	result := ghmock.CallTool(t, handler, map[string]any{"owner_type": "org", "owner": "octo", "project_number": float64(1)})
+	require.False(t, result.IsError, ghmock.ResultText(t, result))
+	var readme ProjectReadme
+	require.NoError(t, json.Unmarshal([]byte(ghmock.ResultText(t, result)), &readme))
+	assert.Equal(t, ProjectReadme{
+		Number: 1, Title: "Security", URL: "https://github.com/orgs/octo/projects/1",
+		ShortDescription: "Open alerts", Readme: "Triage within 2 days.\n",
+		UpdatedAt: time.Date(2025, 1, 6, 10, 0, 0, 0, time.UTC),
+	}, readme)
+}
+
+func Test_UpdateProjectReadme(t *testing.T) {
+	tool, _ := UpdateProjectReadme(nil, translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+	assert.True(t, *tool.Annotations.IdempotentHint)
+	assert.ElementsMatch(t, []string{"owner_type", "owner", "project_number"}, tool.InputSchema.Required)
+
+	call := func(t *testing.T, server *ghmock.Server, args map[string]any) (string, bool) {
+		_, handler := UpdateProjectReadme(server.GetGQLClient(), translations.NullTranslationHelper)
+		args["owner_type"], args["owner"], args["project_number"] = "org", "octo", float64(1)
+		result := ghmock.CallTool(t, handler, args)
+		return ghmock.ResultText(t, result), result.IsError
+	}
+
+	t.Run("sets the given values", func(t *testing.T) {
+		server := ghmock.New(t)
+		respondProjectReadme(server)
+		var input map[string]any
+		server.HandleGraphQL("updateProjectV2(", func(_ string, vars map[string]any) (any, []string) {
+			input = vars["input"].(map[string]any)
+			return map[string]any{"updateProjectV2": map[string]any{"projectV2": projectReadmeJSON("", "Triage within 1 day.")}}, nil
+		})
+
+		text, isError := call(t, server, map[string]any{"short_description": "", "readme": "Triage within 1 day."})
+		require.False(t, isError, text)
+		assert.Equal(t, map[string]any{"projectId": "PVT_1", "shortDescription": "", "readme": "Triage within 1 day."}, input)
+		var readme ProjectReadme
+		require.NoError(t, json.Unmarshal([]byte(text), &readme))
+		assert.Equal(t, "Triage within 1 day.", readme.Readme)
+		assert.Empty(t, readme.ShortDescription)
+	})
+
+	t.Run("leaves out values not given", func(t *testing.T) {
+		server := ghmock.New(t)
+		respondProjectReadme(server)
+		var input map[string]any
+		server.HandleGraphQL("updateProjectV2(", func(_ string, vars map[string]any) (any, []string) {
+			input = vars["input"].(map[string]any)
+			return map[string]any{"updateProjectV2": map[string]any{"projectV2": projectReadmeJSON("Open alerts only", "Triage within 2 days.\n")}}, nil
+		})
+
+		text, isError := call(t, server, map[string]any{"short_description": "Open alerts only"})
+		require.False(t, isError, text)
+		assert.Equal(t, map[string]any{"projectId": "PVT_1", "shortDescription": "Open alerts only"}, input)
+	})
+
+	t.Run("updated since it was read", func(t *testing.T) {
+		server := ghmock.New(t)
+		respondProjectReadme(server)
+
+		text, isError := call(t, server, map[string]any{"readme": "Triage within 1 day.", "expected_updated_at": "2025-01-06T09:00:00Z"})
+		require.True(t, isError)
+		var toolErr struct {
+			Code    string        `json:"code"`
+			Current ProjectReadme `json:"current"`
+		}
+		require.NoError(t, json.Unmarshal([]byte(text), &toolErr))
+		assert.Equal(t, "CONFLICT", toolErr.Code)
+		assert.Equal(t, "Triage within 2 days.\n", toolErr.Current.Readme)
+		assert.Empty(t, server.Mutations())
+	})
+
+	t.Run("nothing to set", func(t *testing.T) {
+		server := ghmock.New(t)
+		text, isError := call(t, server, map[string]any{})
+		require.True(t, isError)
+		assert.Contains(t, text, "at least one of short_description or readme must be given")
+		assert.Empty(t, server.Mutations())
+	})
+}
+
+func Test_ProjectReadmeResourceHandler(t *testing.T) {
+	server := ghmock.New(t)
+	respondProjectReadme(server)
+	handler := ProjectReadmeResourceHandler(server.GetGQLClient())
+
+	read := func(args map[string]any) ([]mcp.ResourceContents, error) {
+		request := mcp.ReadResourceRequest{}
+		request.Params.URI = "project://org/octo/1/readme"
+		request.Params.Arguments = args
+		return handler(context.Background(), request)
+	}
+
+	contents, err := read(map[string]any{"owner_type": []string{"org"}, "owner": []string{"octo"}, "project_number": []string{"1"}})
+	require.NoError(t, err)
+	assert.Equal(t, []mcp.ResourceContents{mcp.TextResourceContents{
+		URI:      "project://org/octo/1/readme",
+		MIMEType: "text/markdown",
+		Text:     "# Security\n\nOpen alerts\n\nTriage within 2 days.\n",
+	}}, contents)
+
+	_, err = read(map[string]any{"owner_type": []string{"team"}, "owner": []string{"octo"}, "project_number": []string{"1"}})
+	assert.EqualError(t, err, `owner_type must be org or user, got "team"`)
+
+	_, err = read(map[string]any{"owner_type": []string{"org"}, "owner": []string{"octo"}, "project_number": []string{"one"}})
+	assert.EqualError(t, err, `invalid project number: "one"`)
+}
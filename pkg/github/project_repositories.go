@@ -0,0 +1,282 @@
+package github
+
+import (
+	"context"
+	"fmt"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/shurcooL/githubv4"
+)
+
+// ProjectRepository is a repository linked to a project. The auto-add workflows of a project can only add the issues
+// and pull requests of its linked repositories.
+type ProjectRepository struct {
+	FullName string `json:"full_name"`
+	URL      string `json:"url"`
+	Private  bool   `json:"private"`
+	Archived bool   `json:"archived"`
+}
+
+// ProjectRepositoryLink is the result of linking or unlinking a repository and a project.
+type ProjectRepositoryLink struct {
+	Repository string `json:"repository"`
+	Linked     bool   `json:"linked"`
+}
+
+type projectRepositoryNode struct {
+	NameWithOwner githubv4.String
+	URL           githubv4.URI
+	IsPrivate     githubv4.Boolean
+	IsArchived    githubv4.Boolean
+}
+
+type projectRepositoryConnection struct {
+	Nodes    []projectRepositoryNode
+	PageInfo struct {
+		HasNextPage githubv4.Boolean
+		EndCursor   githubv4.String
+	}
+}
+
+// listProjectRepositories queries every repository linked to a project of a user or an organization.
+func listProjectRepositories(ctx context.Context, client *githubv4.Client, ownerType, owner string, number int) ([]ProjectRepository, error) {
+	repositories := []ProjectRepository{}
+	vars := map[string]any{
+		"owner":  githubv4.String(owner),
+		"number": githubv4.Int(number),
+		"after":  (*githubv4.String)(nil),
+	}
+	for {
+		var page projectRepositoryConnection
+		if ownerType == "org" {
+			var query struct {
+				Organization struct {
+					ProjectV2 struct {
+						Repositories projectRepositoryConnection `graphql:"repositories(first: 100, after: $after)"`
+					} `graphql:"projectV2(number: $number)"`
+				} `graphql:"organization(login: $owner)"`
+			}
+			if err := client.Query(ctx, &query, vars); err != nil {
+				return nil, err
+			}
+			page = query.Organization.ProjectV2.Repositories
+		} else {
+			var query struct {
+				User struct {
+					ProjectV2 struct {
+						Repositories projectRepositoryConnection `graphql:"repositories(first: 100, after: $after)"`
+					} `graphql:"projectV2(number: $number)"`
+				} `graphql:"user(login: $owner)"`
+			}
+			if err := client.Query(ctx, &query, vars); err != nil {
+				return nil, err
+			}
+			page = query.User.ProjectV2.Repositories
+		}
+		for _, node := range page.Nodes {
+			repositories = append(repositories, ProjectRepository{
+				FullName: string(node.NameWithOwner),
+				URL:      node.URL.String(),
+				Private:  bool(node.IsPrivate),
+				Archived: bool(node.IsArchived),
+			})
+		}
+		if !page.PageInfo.HasNextPage {
+			return repositories, nil
+		}
+		vars["after"] = githubv4.NewString(page.PageInfo.EndCursor)
+	}
+}
+
+// withProjectRepositoryParams adds the parameters naming the project and the repository to link or unlink.
+func withProjectRepositoryParams() mcp.ToolOption {
+	return func(tool *mcp.Tool) {
+		for _, opt := range []mcp.ToolOption{
+			mcp.WithString("owner_type",
+				mcp.Required(),
+				mcp.Description("Owner type"),
+				mcp.Enum("user", "org"),
+			),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("If owner_type == user it is the handle for the GitHub user account. If owner_type == org it is the name of the organization. The name is not case sensitive."),
+			),
+			mcp.WithNumber("project_number",
+				mcp.Required(),
+				mcp.Description("The project's number."),
+			),
+			mcp.WithString("repo_owner",
+				mcp.Required(),
+				mcp.Description("Owner of the repository"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Name of the repository"),
+			),
+		} {
+			opt(tool)
+		}
+	}
+}
+
+// linkProjectRepository links a repository to a project, or unlinks it.
+func linkProjectRepository(ctx context.Context, req mcp.CallToolRequest, getClient GetClientFn, getGQLClient GetGQLClientFn, link bool) (*mcp.CallToolResult, error) {
+	ownerType, err := RequiredParam[string](req, "owner_type")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	owner, err := RequiredParam[string](req, "owner")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	projectNumber, err := ValidateProjectNumber(req)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	repoOwner, err := RequiredParam[string](req, "repo_owner")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	repoName, err := RequiredParam[string](req, "repo")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	client, err := getClient(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+	}
+	gqlClient, err := getGQLClient(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get GitHub GraphQL client: %w", err)
+	}
+
+	project, resp, err := getProjectV2(ctx, client, ownerType, owner, projectNumber)
+	if err != nil {
+		return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get project", resp, err), nil
+	}
+	_ = resp.Body.Close()
+	repo, resp, err := client.Repositories.Get(ctx, repoOwner, repoName)
+	if err != nil {
+		return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get repository", resp, err), nil
+	}
+	_ = resp.Body.Close()
+
+	if link {
+		var mutation struct {
+			LinkProjectV2ToRepository struct {
+				Repository struct {
+					NameWithOwner githubv4.String
+				}
+			} `graphql:"linkProjectV2ToRepository(input: $input)"`
+		}
+		input := githubv4.LinkProjectV2ToRepositoryInput{
+			ProjectID:    githubv4.ID(project.GetNodeID()),
+			RepositoryID: githubv4.ID(repo.GetNodeID()),
+		}
+		if err := gqlClient.Mutate(ctx, &mutation, input, nil); err != nil {
+			return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "failed to link repository to project", err), nil
+		}
+	} else {
+		var mutation struct {
+			UnlinkProjectV2FromRepository struct {
+				Repository struct {
+					NameWithOwner githubv4.String
+				}
+			} `graphql:"unlinkProjectV2FromRepository(input: $input)"`
+		}
+		input := githubv4.UnlinkProjectV2FromRepositoryInput{
+			ProjectID:    githubv4.ID(project.GetNodeID()),
+			RepositoryID: githubv4.ID(repo.GetNodeID()),
+		}
+		if err := gqlClient.Mutate(ctx, &mutation, input, nil); err != nil {
+			return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "failed to unlink repository from project", err), nil
+		}
+	}
+	return MarshalledTextResult(ProjectRepositoryLink{Repository: repo.GetFullName(), Linked: link}), nil
+}
+
+// ListProjectRepositories creates a tool that lists the repositories linked to a project.
+func ListProjectRepositories(getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_project_repositories",
+			mcp.WithDescription(t("TOOL_LIST_PROJECT_REPOSITORIES_DESCRIPTION", "List the repositories linked to a Project of a user or org. The auto-add workflows of a project only add issues and pull requests of its linked repositories.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_LIST_PROJECT_REPOSITORIES_USER_TITLE", "List project repositories"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner_type",
+				mcp.Required(),
+				mcp.Description("Owner type"),
+				mcp.Enum("user", "org"),
+			),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("If owner_type == user it is the handle for the GitHub user account. If owner_type == org it is the name of the organization. The name is not case sensitive."),
+			),
+			mcp.WithNumber("project_number",
+				mcp.Required(),
+				mcp.Description("The project's number."),
+			),
+		), func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			ownerType, err := RequiredParam[string](req, "owner_type")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			owner, err := RequiredParam[string](req, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			projectNumber, err := ValidateProjectNumber(req)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getGQLClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub GraphQL client: %w", err)
+			}
+
+			repositories, err := listProjectRepositories(ctx, client, ownerType, owner, projectNumber)
+			if err != nil {
+				return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "failed to list project repositories", err), nil
+			}
+			// Every page has been fetched, so there is no next page to point to.
+			totalCount := len(repositories)
+			return MarshalledTextResult(NewListResponse("repositories", repositories, PageInfo{}, &totalCount)), nil
+		}
+}
+
+// LinkProjectToRepository creates a tool that links a repository to a project.
+func LinkProjectToRepository(getClient GetClientFn, getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("link_project_to_repository",
+			mcp.WithDescription(t("TOOL_LINK_PROJECT_TO_REPOSITORY_DESCRIPTION", "Link a repository to a Project of a user or org, so that the project is listed in the repository and its auto-add workflows can add the repository's issues and pull requests.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:           t("TOOL_LINK_PROJECT_TO_REPOSITORY_USER_TITLE", "Link project to repository"),
+				ReadOnlyHint:    ToBoolPtr(false),
+				DestructiveHint: ToBoolPtr(false),
+				IdempotentHint:  ToBoolPtr(true),
+			}),
+			withProjectRepositoryParams(),
+		), func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return linkProjectRepository(ctx, req, getClient, getGQLClient, true)
+		}
+}
+
+// UnlinkProjectFromRepository creates a tool that unlinks a repository from a project.
+func UnlinkProjectFromRepository(getClient GetClientFn, getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("unlink_project_from_repository",
+			mcp.WithDescription(t("TOOL_UNLINK_PROJECT_FROM_REPOSITORY_DESCRIPTION", "Unlink a repository from a Project of a user or org. Items of the repository already in the project stay in it, but auto-add workflows stop adding new ones.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:           t("TOOL_UNLINK_PROJECT_FROM_REPOSITORY_USER_TITLE", "Unlink project from repository"),
+				ReadOnlyHint:    ToBoolPtr(false),
+				DestructiveHint: ToBoolPtr(true),
+				IdempotentHint:  ToBoolPtr(true),
+			}),
+			withProjectRepositoryParams(),
+		), func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return linkProjectRepository(ctx, req, getClient, getGQLClient, false)
+		}
+}
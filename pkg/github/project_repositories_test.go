@@ -0,0 +1,101 @@
+package github
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/ghmock"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ListProjectRepositories(t *testing.T) {
+	tool, _ := ListProjectRepositories(nil, translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+	assert.True(t, *tool.Annotations.ReadOnlyHint)
+
+	server := ghmock.New(t)
+	server.HandleGraphQL("repositories(first: 100, after: $after)", func(_ string, vars map[string]any) (any, []string) {
+		if vars["owner"] != "octocat" || vars["number"] != float64(2) {
+			return nil, []string{"Could not resolve to a ProjectV2 with the number 2."}
+		}
+		node := map[string]any{"nameWithOwner": "octocat/hello", "url": "https://github.com/octocat/hello", "isPrivate": false, "isArchived": false}
+		pageInfo := map[string]any{"hasNextPage": true, "endCursor": "c1"}
+		if vars["after"] == "c1" {
+			node = map[string]any{"nameWithOwner": "octo/app", "url": "https://github.com/octo/app", "isPrivate": true, "isArchived": true}
+			pageInfo = map[string]any{"hasNextPage": false}
+		}
+		return map[string]any{"user": map[string]any{"projectV2": map[string]any{"repositories": map[string]any{
+			"nodes": []any{node}, "pageInfo": pageInfo,
+		}}}}, nil
+	})
+	_, handler := ListProjectRepositories(server.GetGQLClient(), translations.NullTranslationHelper)
+
+	result := ghmock.CallTool(t, handler, map[string]any{"owner_type": "user", "owner": "octocat", "project_number": float64(2)})
+	require.False(t, result.IsError, ghmock.ResultText(t, result))
+	var response struct {
+		Repositories []ProjectRepository `json:"repositories"`
+		PageInfo     PageInfo            `json:"page_info"`
+		TotalCount   int                 `json:"total_count"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(ghmock.ResultText(t, result)), &response))
+	assert.Equal(t, []ProjectRepository{
+		{FullName: "octocat/hello", URL: "https://github.com/octocat/hello"},
+		{FullName: "octo/app", URL: "https://github.com/octo/app", Private: true, Archived: true},
+	}, response.Repositories)
+	assert.Equal(t, 2, response.TotalCount)
+	assert.False(t, response.PageInfo.HasNextPage)
+}
+
+func Test_LinkProjectToRepository(t *testing.T) {
+	linkTool, _ := LinkProjectToRepository(nil, nil, translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(linkTool.Name, linkTool))
+	assert.False(t, *linkTool.Annotations.DestructiveHint)
+	assert.ElementsMatch(t, []string{"owner_type", "owner", "project_number", "repo_owner", "repo"}, linkTool.InputSchema.Required)
+	unlinkTool, _ := UnlinkProjectFromRepository(nil, nil, translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(unlinkTool.Name, unlinkTool))
+	assert.True(t, *unlinkTool.Annotations.DestructiveHint)
+
+	newServer := func(t *testing.T) *ghmock.Server {
+		server := ghmock.New(t)
+		server.Respond("GET /orgs/octo/projectsV2/1", http.StatusOK, map[string]any{"id": 1, "node_id": "PVT_1"})
+		server.Respond("GET /repos/octo/app", http.StatusOK, map[string]any{"full_name": "octo/app", "node_id": "R_1"})
+		repository := map[string]any{"repository": map[string]any{"nameWithOwner": "octo/app"}}
+		server.RespondGraphQL("linkProjectV2ToRepository(", map[string]any{"linkProjectV2ToRepository": repository})
+		server.RespondGraphQL("unlinkProjectV2FromRepository(", map[string]any{"unlinkProjectV2FromRepository": repository})
+		return server
+	}
+	args := map[string]any{"owner_type": "org", "owner": "octo", "project_number": float64(1), "repo_owner": "octo", "repo": "app"}
+
+	t.Run("link", func(t *testing.T) {
+		server := newServer(t)
+		_, handler := LinkProjectToRepository(server.GetClient(), server.GetGQLClient(), translations.NullTranslationHelper)
+		result := ghmock.CallTool(t, handler, args)
+		require.False(t, result.IsError, ghmock.ResultText(t, result))
+		assert.JSONEq(t, `{"repository":"octo/app","linked":true}`, ghmock.ResultText(t, result))
+		assert.Equal(t, map[string]any{"projectId": "PVT_1", "repositoryId": "R_1"}, server.AssertGraphQL("linkProjectV2ToRepository(").Variables["input"])
+	})
+
+	t.Run("unlink", func(t *testing.T) {
+		server := newServer(t)
+		_, handler := UnlinkProjectFromRepository(server.GetClient(), server.GetGQLClient(), translations.NullTranslationHelper)
+		result := ghmock.CallTool(t, handler, args)
+		require.False(t, result.IsError, ghmock.ResultText(t, result))
+		assert.JSONEq(t, `{"repository":"octo/app","linked":false}`, ghmock.ResultText(t, result))
+		assert.Equal(t, map[string]any{"projectId": "PVT_1", "repositoryId": "R_1"}, server.AssertGraphQL("unlinkProjectV2FromRepository(").Variables["input"])
+	})
+
+	t.Run("repository not found", func(t *testing.T) {
+		server := ghmock.New(t)
+		server.Respond("GET /orgs/octo/projectsV2/1", http.StatusOK, map[string]any{"id": 1, "node_id": "PVT_1"})
+		server.Respond("GET /repos/octo/app", http.StatusNotFound, map[string]any{"message": "Not Found"})
+		_, handler := LinkProjectToRepository(server.GetClient(), server.GetGQLClient(), translations.NullTranslationHelper)
+		result := ghmock.CallTool(t, handler, args)
+		require.True(t, result.IsError)
+		assert.Contains(t, ghmock.ResultText(t, result), "failed to get repository")
+		assert.Empty(t, server.Mutations())
+	})
+}
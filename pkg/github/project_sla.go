@@ -0,0 +1,235 @@
+package github
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// ProjectSLAPolicy is the maximum number of days the items of a project in a Status column, with a label, or both,
+// should stay so, such as bugs in Triage for 3 days.
+type ProjectSLAPolicy struct {
+	Name    string  `json:"name,omitempty"`
+	Status  string  `json:"status,omitempty"`
+	Label   string  `json:"label,omitempty"`
+	MaxDays float64 `json:"max_days"`
+}
+
+// Validate checks that a policy matches items and allows them some time.
+func (p ProjectSLAPolicy) Validate() error {
+	if p.Status == "" && p.Label == "" {
+		return errors.New("at least one of status or label is required")
+	}
+	if p.MaxDays <= 0 {
+		return fmt.Errorf("max_days must be a positive number of days, got %v", p.MaxDays)
+	}
+	return nil
+}
+
+// title returns the name of a policy, or describes it when it has none.
+func (p ProjectSLAPolicy) title() string {
+	if p.Name != "" {
+		return p.Name
+	}
+	var matches []string
+	if p.Label != "" {
+		matches = append(matches, "labeled "+p.Label)
+	}
+	if p.Status != "" {
+		matches = append(matches, "in "+p.Status)
+	}
+	days := strconv.FormatFloat(p.MaxDays, 'f', -1, 64) + " days"
+	if p.MaxDays == 1 {
+		days = "1 day"
+	}
+	return fmt.Sprintf("%s for over %s", strings.Join(matches, " and "), days)
+}
+
+// ProjectSLAPolicies maps a project, written as owner/number such as octo/1, to the SLA policies that
+// check_project_sla_breaches checks its items against.
+type ProjectSLAPolicies map[string][]ProjectSLAPolicy
+
+// lookup returns the SLA policies of a project.
+func (p ProjectSLAPolicies) lookup(owner string, number int) []ProjectSLAPolicy {
+	for project, policies := range p {
+		if isProject(project, owner, number) {
+			return policies
+		}
+	}
+	return nil
+}
+
+// ProjectSLACheck is the result of checking the items of a project against SLA policies.
+type ProjectSLACheck struct {
+	Policies []ProjectSLAPolicy `json:"policies"`
+	Breaches []ProjectSLABreach `json:"breaches"`
+}
+
+// projectSLAPoliciesParam returns the policies parameter, the SLA policies to check instead of the configured ones.
+func projectSLAPoliciesParam(req mcp.CallToolRequest) ([]ProjectSLAPolicy, error) {
+	raw, err := OptionalParam[[]any](req, "policies")
+	if err != nil || len(raw) == 0 {
+		return nil, err
+	}
+	data, err := json.Marshal(raw)
+	if err != nil {
+		return nil, fmt.Errorf("invalid policies: %w", err)
+	}
+	var policies []ProjectSLAPolicy
+	if err := json.Unmarshal(data, &policies); err != nil {
+		return nil, fmt.Errorf("invalid policies: %w", err)
+	}
+	for i, policy := range policies {
+		if err := policy.Validate(); err != nil {
+			return nil, fmt.Errorf("policies[%d]: %w", i, err)
+		}
+	}
+	return policies, nil
+}
+
+// CheckProjectSLABreaches creates a tool that checks the items of a project against SLA policies, by default the ones
+// configured for the project.
+func CheckProjectSLABreaches(getClient GetClientFn, getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc, configured ProjectSLAPolicies) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("check_project_sla_breaches",
+			mcp.WithDescription(t("TOOL_CHECK_PROJECT_SLA_BREACHES_DESCRIPTION", "Check the open items of a Project of a user or org against SLA policies, such as bugs in Triage for over 3 days, and list the items that breach them with their age. The policies are the ones configured for the project on the server unless others are given. Age in a Status column is read from the history of the item's Status, or counted from when the item was added to the project when there is none; age with only a label is counted from when the item was added to the project.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_CHECK_PROJECT_SLA_BREACHES_USER_TITLE", "Check project SLA breaches"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner_type",
+				mcp.Required(),
+				mcp.Description("Owner type"),
+				mcp.Enum("user", "org"),
+			),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("If owner_type == user it is the handle for the GitHub user account. If owner_type == org it is the name of the organization. The name is not case sensitive."),
+			),
+			mcp.WithNumber("project_number",
+				mcp.Required(),
+				mcp.Description("The project's number."),
+			),
+			mcp.WithArray("policies",
+				mcp.Description("SLA policies to check instead of the ones configured for the project"),
+				mcp.Items(map[string]any{
+					"type":     "object",
+					"required": []string{"max_days"},
+					"properties": map[string]any{
+						"name":     map[string]any{"type": "string", "description": "Name of the policy, e.g. \"Bugs in triage\""},
+						"status":   map[string]any{"type": "string", "description": "Status column the policy applies to"},
+						"label":    map[string]any{"type": "string", "description": "Label the policy applies to"},
+						"max_days": map[string]any{"type": "number", "description": "Maximum number of days items should match the policy"},
+					},
+				}),
+			),
+		), func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			ownerType, err := RequiredParam[string](req, "owner_type")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			owner, err := RequiredParam[string](req, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			projectNumber, err := ValidateProjectNumber(req)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			policies, err := projectSLAPoliciesParam(req)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if len(policies) == 0 {
+				policies = configured.lookup(owner, projectNumber)
+			}
+			if len(policies) == 0 {
+				return mcp.NewToolResultError(fmt.Sprintf("no SLA policies are configured for project %s/%d; give policies or configure project-sla-policies", owner, projectNumber)), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			gqlClient, err := getGQLClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub GraphQL client: %w", err)
+			}
+
+			fields, resp, err := listAllProjectFields(ctx, client, ownerType, owner, projectNumber)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to list project fields", resp, err), nil
+			}
+			board, resp, err := loadProjectBoard(ctx, client, ownerType, owner, projectNumber, fields, nil)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to list project items", resp, err), nil
+			}
+			for _, policy := range policies {
+				if policy.Status != "" && !containsFold(board.statusOrder(), policy.Status) {
+					return mcp.NewToolResultError(fmt.Sprintf("invalid policy %s: the %s field has no option %s", policy.title(), projectStatusFieldName, policy.Status)), nil
+				}
+			}
+
+			check := ProjectSLACheck{Policies: policies, Breaches: []ProjectSLABreach{}}
+			now := time.Now()
+			for _, boardItem := range board.items {
+				item := boardItem.item
+				if item.Content != nil && item.Content.State == "closed" {
+					continue
+				}
+				addedAt := item.GetCreatedAt().Time
+				// The time the item entered its current column, read from its Status history at most once.
+				var enteredStatus *time.Time
+				for _, policy := range policies {
+					if policy.Status != "" && !strings.EqualFold(policy.Status, boardItem.status) {
+						continue
+					}
+					if policy.Label != "" && !containsFold(boardItem.labels, policy.Label) {
+						continue
+					}
+					since := addedAt
+					if policy.Status != "" {
+						if enteredStatus == nil {
+							enteredStatus = &addedAt
+							if contentType := item.GetContentType(); contentType == "Issue" || contentType == "PullRequest" {
+								changes, err := listProjectStatusChanges(ctx, gqlClient, item.GetNodeID())
+								if err != nil {
+									return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "failed to get project status history", err), nil
+								}
+								if _, last, lastAt := timeInStatus(changes, now); last == boardItem.status {
+									enteredStatus = &lastAt
+								}
+							}
+						}
+						since = *enteredStatus
+					}
+					if since.IsZero() {
+						continue
+					}
+					if days := roundDays(now.Sub(since)); days > policy.MaxDays {
+						breach := ProjectSLABreach{Policy: policy.title(), ID: item.GetID(), Status: boardItem.status, Days: days, SLADays: policy.MaxDays}
+						if item.Content != nil {
+							breach.Title = item.Content.Title
+							breach.URL = item.Content.HTMLURL
+						}
+						check.Breaches = append(check.Breaches, breach)
+					}
+				}
+			}
+			// The worst breaches, relative to their SLA, come first.
+			slices.SortStableFunc(check.Breaches, func(a, b ProjectSLABreach) int {
+				return cmp.Compare(b.Days/b.SLADays, a.Days/a.SLADays)
+			})
+			return MarshalledTextResult(check), nil
+		}
+}
@@ -0,0 +1,155 @@
+package github
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/ghmock"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_CheckProjectSLABreaches(t *testing.T) {
+	tool, _ := CheckProjectSLABreaches(nil, nil, translations.NullTranslationHelper, nil)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+	assert.True(t, *tool.Annotations.ReadOnlyHint)
+
+	daysAgo := func(days int) string {
+		return time.Now().UTC().AddDate(0, 0, -days).Format(time.RFC3339)
+	}
+	// The board has an open bug in Triage for 5 days, a draft issue added to In Review 4 days ago, a closed bug in
+	// Triage and an open issue In Review for a day.
+	newServer := func(t *testing.T) *ghmock.Server {
+		server := ghmock.New(t)
+		option := func(id, name string) map[string]any {
+			return map[string]any{"id": id, "name": map[string]any{"raw": name}}
+		}
+		server.Respond("GET /orgs/octo/projectsV2/1/fields", http.StatusOK, []map[string]any{
+			{"id": 11, "name": "Status", "data_type": "single_select", "options": []any{option("o1", "Triage"), option("o2", "In Review"), option("o3", "Done")}},
+			{"id": 15, "name": "Labels", "data_type": "labels"},
+		})
+		status := func(name string) map[string]any {
+			return map[string]any{"id": 11, "value": map[string]any{"name": map[string]any{"raw": name}}}
+		}
+		labels := func(names ...string) map[string]any {
+			var values []any
+			for _, name := range names {
+				values = append(values, map[string]any{"name": name})
+			}
+			return map[string]any{"id": 15, "value": values}
+		}
+		server.Respond("GET /orgs/octo/projectsV2/1/items", http.StatusOK, []map[string]any{
+			{
+				"id": 901, "node_id": "PVTI_901", "content_type": "Issue", "created_at": daysAgo(10),
+				"content": map[string]any{"title": "Crash on save", "html_url": "https://github.com/octo/app/issues/9", "state": "open"},
+				"fields":  []any{status("Triage"), labels("bug")},
+			},
+			{
+				"id": 902, "node_id": "PVTI_902", "content_type": "DraftIssue", "created_at": daysAgo(4),
+				"content": map[string]any{"title": "Review copy"},
+				"fields":  []any{status("In Review"), labels()},
+			},
+			{
+				"id": 903, "node_id": "PVTI_903", "content_type": "Issue", "created_at": daysAgo(20),
+				"content": map[string]any{"title": "Old crash", "html_url": "https://github.com/octo/app/issues/3", "state": "closed"},
+				"fields":  []any{status("Triage"), labels("bug")},
+			},
+			{
+				"id": 904, "node_id": "PVTI_904", "content_type": "Issue", "created_at": daysAgo(30),
+				"content": map[string]any{"title": "Add dark mode", "html_url": "https://github.com/octo/app/issues/4", "state": "open"},
+				"fields":  []any{status("In Review"), labels("feature")},
+			},
+		})
+		server.HandleGraphQL("node(id: $id)", func(_ string, vars map[string]any) (any, []string) {
+			events := map[any][]any{
+				"PVTI_901": {
+					statusChangedEvent("PVT_1", "", "Done", daysAgo(9)),
+					statusChangedEvent("PVT_1", "Done", "Triage", daysAgo(5)),
+				},
+				"PVTI_904": {
+					statusChangedEvent("PVT_1", "", "In Review", daysAgo(1)),
+				},
+			}[vars["id"]]
+			if events == nil {
+				return nil, []string{"unexpected node"}
+			}
+			timeline := map[string]any{"nodes": events, "pageInfo": map[string]any{"hasNextPage": false}}
+			return map[string]any{"node": map[string]any{"project": map[string]any{"id": "PVT_1"}, "content": map[string]any{"issueTimeline": timeline}}}, nil
+		})
+		return server
+	}
+	configured := ProjectSLAPolicies{"Octo/1": {
+		{Name: "Bugs in triage", Status: "Triage", Label: "bug", MaxDays: 3},
+		{Status: "In Review", MaxDays: 2},
+	}}
+	call := func(t *testing.T, server *ghmock.Server, args map[string]any) ProjectSLACheck {
+		_, handler := CheckProjectSLABreaches(server.GetClient(), server.GetGQLClient(), translations.NullTranslationHelper, configured)
+		result := ghmock.CallTool(t, handler, args)
+		require.False(t, result.IsError, ghmock.ResultText(t, result))
+		var check ProjectSLACheck
+		require.NoError(t, json.Unmarshal([]byte(ghmock.ResultText(t, result)), &check))
+		return check
+	}
+
+	t.Run("configured policies", func(t *testing.T) {
+		server := newServer(t)
+		check := call(t, server, map[string]any{"owner_type": "org", "owner": "octo", "project_number": float64(1)})
+		assert.Equal(t, configured["Octo/1"], check.Policies)
+		assert.Equal(t, []ProjectSLABreach{
+			{Policy: "in In Review for over 2 days", ID: 902, Title: "Review copy", Status: "In Review", Days: 4, SLADays: 2},
+			{Policy: "Bugs in triage", ID: 901, Title: "Crash on save", URL: "https://github.com/octo/app/issues/9", Status: "Triage", Days: 5, SLADays: 3},
+		}, check.Breaches)
+	})
+
+	t.Run("given policies", func(t *testing.T) {
+		server := newServer(t)
+		check := call(t, server, map[string]any{
+			"owner_type":     "org",
+			"owner":          "octo",
+			"project_number": float64(1),
+			"policies":       []any{map[string]any{"label": "feature", "max_days": float64(14)}},
+		})
+		assert.Equal(t, []ProjectSLABreach{
+			{Policy: "labeled feature for over 14 days", ID: 904, Title: "Add dark mode", URL: "https://github.com/octo/app/issues/4", Status: "In Review", Days: 30, SLADays: 14},
+		}, check.Breaches)
+		assert.Empty(t, server.Mutations())
+	})
+
+	for name, tc := range map[string]struct {
+		args map[string]any
+		want string
+	}{
+		"no policies": {
+			map[string]any{"owner": "mona"},
+			"no SLA policies are configured for project mona/1; give policies or configure project-sla-policies",
+		},
+		"policy without status or label": {
+			map[string]any{"policies": []any{map[string]any{"max_days": float64(3)}}},
+			"policies[0]: at least one of status or label is required",
+		},
+		"policy without max_days": {
+			map[string]any{"policies": []any{map[string]any{"status": "Triage"}}},
+			"policies[0]: max_days must be a positive number of days, got 0",
+		},
+		"unknown status": {
+			map[string]any{"policies": []any{map[string]any{"status": "Blocked", "max_days": float64(1)}}},
+			"invalid policy in Blocked for over 1 day: the Status field has no option Blocked",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			server := newServer(t)
+			args := map[string]any{"owner_type": "org", "owner": "octo", "project_number": float64(1)}
+			for k, v := range tc.args {
+				args[k] = v
+			}
+			_, handler := CheckProjectSLABreaches(server.GetClient(), server.GetGQLClient(), translations.NullTranslationHelper, configured)
+			result := ghmock.CallTool(t, handler, args)
+			require.True(t, result.IsError)
+			assert.Equal(t, tc.want, ghmock.ResultText(t, result))
+		})
+	}
+}
@@ -0,0 +1,899 @@
+package github
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v79/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/shurcooL/githubv4"
+)
+
+// ProjectSnapshotVersion is the version of the ProjectSnapshot format written by snapshot_project. restore_project
+// rejects snapshots of other versions.
+const ProjectSnapshotVersion = 1
+
+// ProjectSnapshot is the full state of a project: its fields, with their options and iterations, and its items, with
+// their field values. Field values are keyed by field name, and single select and iteration values are stored by
+// option name and iteration title, so that a snapshot can be restored into a copy of the project whose IDs differ.
+type ProjectSnapshot struct {
+	Version int                   `json:"version"`
+	TakenAt string                `json:"taken_at"`
+	Project ProjectSnapshotInfo   `json:"project"`
+	Fields  []ProjectFieldSchema  `json:"fields"`
+	Items   []ProjectSnapshotItem `json:"items"`
+}
+
+// ProjectSnapshotInfo identifies the project a snapshot was taken of.
+type ProjectSnapshotInfo struct {
+	OwnerType        string `json:"owner_type"`
+	Owner            string `json:"owner"`
+	Number           int    `json:"number"`
+	Title            string `json:"title"`
+	ShortDescription string `json:"short_description,omitempty"`
+	Public           bool   `json:"public"`
+	Closed           bool   `json:"closed"`
+	URL              string `json:"url,omitempty"`
+}
+
+// ProjectSnapshotItem is an item of a project and its field values.
+type ProjectSnapshotItem struct {
+	ID          int64          `json:"id"`
+	ContentType string         `json:"content_type"`
+	ContentID   int64          `json:"content_id,omitempty"`
+	ContentURL  string         `json:"content_url,omitempty"`
+	Title       string         `json:"title,omitempty"`
+	Archived    bool           `json:"archived,omitempty"`
+	FieldValues map[string]any `json:"field_values"`
+}
+
+// ProjectRestoreChange is a change restore_project made, or would make in a dry run.
+type ProjectRestoreChange struct {
+	Item   string `json:"item"`
+	Action string `json:"action"`
+	Field  string `json:"field,omitempty"`
+	From   any    `json:"from,omitempty"`
+	To     any    `json:"to,omitempty"`
+}
+
+// projectItemWithContent is a project item as returned by the REST API, including the issue or pull request it
+// refers to, which github.ProjectV2Item does not decode.
+type projectItemWithContent struct {
+	github.ProjectV2Item
+	Content *struct {
+		ID      int64  `json:"id"`
+		Title   string `json:"title"`
+		HTMLURL string `json:"html_url"`
+		State   string `json:"state,omitempty"`
+	} `json:"content,omitempty"`
+}
+
+// projectOwnerPath returns the REST API path of the owner of a project.
+func projectOwnerPath(ownerType, owner string) string {
+	if ownerType == "org" {
+		return "orgs/" + url.PathEscape(owner)
+	}
+	return "users/" + url.PathEscape(owner)
+}
+
+// getProjectV2 gets a project of a user or organization.
+func getProjectV2(ctx context.Context, client *github.Client, ownerType, owner string, number int) (*github.ProjectV2, *github.Response, error) {
+	if ownerType == "org" {
+		return client.Projects.GetOrganizationProject(ctx, owner, number)
+	}
+	return client.Projects.GetUserProject(ctx, owner, number)
+}
+
+// listAllProjectFields lists every field of a project.
+func listAllProjectFields(ctx context.Context, client *github.Client, ownerType, owner string, number int) ([]*github.ProjectV2Field, *github.Response, error) {
+	perPage := MaxProjectsPerPage
+	opts := &github.ListProjectsOptions{
+		ListProjectsPaginationOptions: github.ListProjectsPaginationOptions{PerPage: &perPage},
+	}
+	var all []*github.ProjectV2Field
+	for {
+		var fields []*github.ProjectV2Field
+		var resp *github.Response
+		var err error
+		if ownerType == "org" {
+			fields, resp, err = client.Projects.ListOrganizationProjectFields(ctx, owner, number, opts)
+		} else {
+			fields, resp, err = client.Projects.ListUserProjectFields(ctx, owner, number, opts)
+		}
+		if err != nil {
+			return nil, resp, err
+		}
+		_ = resp.Body.Close()
+		all = append(all, fields...)
+		if resp.After == "" {
+			return all, resp, nil
+		}
+		after := resp.After
+		opts.After = &after
+	}
+}
+
+// listAllProjectItems lists every item of a project with the values of the given fields, and the issue or pull
+// request each item refers to.
+func listAllProjectItems(ctx context.Context, client *github.Client, ownerType, owner string, number int, fieldIDs []int64) ([]*projectItemWithContent, *github.Response, error) {
+	return listAllProjectItemsMatching(ctx, client, ownerType, owner, number, "", fieldIDs)
+}
+
+// listAllProjectItemsMatching lists every item of a project matching a query in the project filtering syntax, or
+// every item if the query is empty, like listAllProjectItems.
+func listAllProjectItemsMatching(ctx context.Context, client *github.Client, ownerType, owner string, number int, q string, fieldIDs []int64) ([]*projectItemWithContent, *github.Response, error) {
+	ids := make([]string, len(fieldIDs))
+	for i, id := range fieldIDs {
+		ids[i] = strconv.FormatInt(id, 10)
+	}
+	var all []*projectItemWithContent
+	after := ""
+	for {
+		query := url.Values{"per_page": {strconv.Itoa(MaxProjectsPerPage)}}
+		if len(ids) > 0 {
+			query.Set("fields", strings.Join(ids, ","))
+		}
+		if q != "" {
+			query.Set("q", q)
+		}
+		if after != "" {
+			query.Set("after", after)
+		}
+		u := fmt.Sprintf("%s/projectsV2/%d/items?%s", projectOwnerPath(ownerType, owner), number, query.Encode())
+		req, err := client.NewRequest("GET", u, nil)
+		if err != nil {
+			return nil, nil, err
+		}
+		var items []*projectItemWithContent
+		resp, err := client.Do(ctx, req, &items)
+		if err != nil {
+			return nil, resp, err
+		}
+		_ = resp.Body.Close()
+		all = append(all, items...)
+		if resp.After == "" {
+			return all, resp, nil
+		}
+		after = resp.After
+	}
+}
+
+// textOf returns the text of a title-like value, which the REST API returns either as a string or as an object
+// with its raw text.
+func textOf(value any) (string, bool) {
+	switch v := value.(type) {
+	case string:
+		return v, true
+	case map[string]any:
+		for _, key := range []string{"raw", "text", "name"} {
+			if s, ok := v[key].(string); ok {
+				return s, true
+			}
+		}
+	}
+	return "", false
+}
+
+// snapshotFieldValue converts the value of a field as returned by the REST API to its snapshot form: single select
+// options by name, iterations by title and titles as text. Other values are kept as returned.
+func snapshotFieldValue(dataType string, value any) any {
+	if value == nil {
+		return nil
+	}
+	switch dataType {
+	case "single_select":
+		if m, ok := value.(map[string]any); ok {
+			if name, ok := textOf(m["name"]); ok {
+				return name
+			}
+		}
+	case "iteration":
+		if m, ok := value.(map[string]any); ok {
+			if title, ok := textOf(m["title"]); ok {
+				return title
+			}
+		}
+	case "title":
+		if text, ok := textOf(value); ok {
+			return text
+		}
+	}
+	return value
+}
+
+// snapshotItemKey identifies the issue, pull request or draft issue of an item across copies of a project. Draft
+// issues without a content ID are identified by title.
+func snapshotItemKey(item ProjectSnapshotItem) string {
+	if item.ContentID == 0 {
+		return fmt.Sprintf("%s %q", item.ContentType, item.Title)
+	}
+	return fmt.Sprintf("%s %d", item.ContentType, item.ContentID)
+}
+
+// snapshotItemLabel names an item in the changes restore_project reports.
+func snapshotItemLabel(item ProjectSnapshotItem) string {
+	if item.ContentURL != "" {
+		return item.ContentURL
+	}
+	return snapshotItemKey(item)
+}
+
+func toSnapshotItem(item *projectItemWithContent, fieldTypes map[string]string) ProjectSnapshotItem {
+	snapshotItem := ProjectSnapshotItem{
+		ID:          item.GetID(),
+		ContentType: item.GetContentType(),
+		Archived:    item.ArchivedAt != nil,
+		FieldValues: map[string]any{},
+	}
+	if item.Content != nil {
+		snapshotItem.ContentID = item.Content.ID
+		snapshotItem.ContentURL = item.Content.HTMLURL
+		snapshotItem.Title = item.Content.Title
+	}
+	for _, value := range item.Fields {
+		dataType := value.DataType
+		if dataType == "" {
+			dataType = fieldTypes[value.Name]
+		}
+		converted := snapshotFieldValue(dataType, value.Value)
+		if dataType == "title" {
+			if title, ok := converted.(string); ok && snapshotItem.Title == "" {
+				snapshotItem.Title = title
+			}
+			continue
+		}
+		snapshotItem.FieldValues[value.Name] = converted
+	}
+	return snapshotItem
+}
+
+// takeProjectSnapshot reads the full state of a project.
+func takeProjectSnapshot(ctx context.Context, client *github.Client, ownerType, owner string, number int, now time.Time) (*ProjectSnapshot, *mcp.CallToolResult) {
+	project, resp, err := getProjectV2(ctx, client, ownerType, owner, number)
+	if err != nil {
+		return nil, ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get project", resp, err)
+	}
+	_ = resp.Body.Close()
+
+	fields, resp, err := listAllProjectFields(ctx, client, ownerType, owner, number)
+	if err != nil {
+		return nil, ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to list project fields", resp, err)
+	}
+
+	snapshot := &ProjectSnapshot{
+		Version: ProjectSnapshotVersion,
+		TakenAt: FormatTimestamp(now),
+		Project: ProjectSnapshotInfo{
+			OwnerType:        ownerType,
+			Owner:            owner,
+			Number:           number,
+			Title:            project.GetTitle(),
+			ShortDescription: project.GetShortDescription(),
+			Public:           project.GetPublic(),
+			Closed:           project.ClosedAt != nil,
+			URL:              project.GetHTMLURL(),
+		},
+		Fields: []ProjectFieldSchema{},
+		Items:  []ProjectSnapshotItem{},
+	}
+	fieldIDs := make([]int64, 0, len(fields))
+	fieldTypes := map[string]string{}
+	for _, field := range fields {
+		snapshot.Fields = append(snapshot.Fields, convertToProjectFieldSchema(field))
+		fieldIDs = append(fieldIDs, field.GetID())
+		fieldTypes[field.GetName()] = field.GetDataType()
+	}
+
+	items, resp, err := listAllProjectItems(ctx, client, ownerType, owner, number, fieldIDs)
+	if err != nil {
+		return nil, ghErrors.NewGitHubAPIErrorResponse(ctx, ProjectListFailedError, resp, err)
+	}
+	for _, item := range items {
+		snapshot.Items = append(snapshot.Items, toSnapshotItem(item, fieldTypes))
+	}
+	return snapshot, nil
+}
+
+// SnapshotProject creates a tool that serializes the full state of a project to a versioned JSON document.
+func SnapshotProject(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("snapshot_project",
+			mcp.WithDescription(t("TOOL_SNAPSHOT_PROJECT_DESCRIPTION", "Take a snapshot of the full state of a Project for a user or org: its fields with their options and iterations, and every item with its field values, as a versioned JSON document. Take one before a risky bulk change; restore_project can restore the project from it.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_SNAPSHOT_PROJECT_USER_TITLE", "Snapshot project"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner_type",
+				mcp.Required(),
+				mcp.Description("Owner type"),
+				mcp.Enum("user", "org"),
+			),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("If owner_type == user it is the handle for the GitHub user account. If owner_type == org it is the name of the organization. The name is not case sensitive."),
+			),
+			mcp.WithNumber("project_number",
+				mcp.Required(),
+				mcp.Description("The project's number."),
+			),
+		), func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](req, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			ownerType, err := RequiredParam[string](req, "owner_type")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			projectNumber, err := ValidateProjectNumber(req)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			snapshot, errResult := takeProjectSnapshot(ctx, client, ownerType, owner, projectNumber, time.Now())
+			if errResult != nil {
+				return errResult, nil
+			}
+			return MarshalledTextResult(snapshot), nil
+		}
+}
+
+// snapshotParam decodes the snapshot parameter, which clients may send as an object or as a JSON string.
+func snapshotParam(req mcp.CallToolRequest) (*ProjectSnapshot, error) {
+	raw, ok := req.GetArguments()["snapshot"]
+	if !ok || raw == nil {
+		return nil, fmt.Errorf("missing required parameter: snapshot")
+	}
+	var data []byte
+	if s, ok := raw.(string); ok {
+		data = []byte(s)
+	} else {
+		var err error
+		if data, err = json.Marshal(raw); err != nil {
+			return nil, fmt.Errorf("invalid snapshot: %w", err)
+		}
+	}
+	var snapshot ProjectSnapshot
+	if err := json.Unmarshal(data, &snapshot); err != nil {
+		return nil, fmt.Errorf("invalid snapshot: %w", err)
+	}
+	if snapshot.Version != ProjectSnapshotVersion {
+		return nil, fmt.Errorf("unsupported snapshot version %d: expected %d", snapshot.Version, ProjectSnapshotVersion)
+	}
+	return &snapshot, nil
+}
+
+// restoreFieldValue returns the value to send to update_project_item to set a field of the target project to the
+// snapshot value, mapping option names and iteration titles to the target's IDs. Empty values are cleared instead.
+func restoreFieldValue(field *github.ProjectV2Field, value any) (any, error) {
+	switch field.GetDataType() {
+	case "single_select":
+		for _, option := range field.Options {
+			if option.GetName().GetRaw() == value {
+				return option.GetID(), nil
+			}
+		}
+		return nil, fmt.Errorf("option %v does not exist", value)
+	case "iteration":
+		if field.Configuration != nil {
+			for _, iteration := range field.Configuration.Iterations {
+				if iteration.GetTitle().GetRaw() == value {
+					return iteration.GetID(), nil
+				}
+			}
+		}
+		return nil, fmt.Errorf("iteration %v does not exist", value)
+	}
+	return value, nil
+}
+
+// RestoreProject creates a tool that restores a project from a snapshot taken by snapshot_project.
+func RestoreProject(getClient GetClientFn, getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("restore_project",
+			mcp.WithDescription(t("TOOL_RESTORE_PROJECT_DESCRIPTION", "Restore a Project for a user or org from a snapshot taken by snapshot_project, either the project the snapshot was taken of or another project with fields of the same names, such as a copy of it. Creates the fields and single select options that are missing, adds the snapshot's issues and pull requests and re-creates its draft issues that are missing, sets every text, number, date, single select and iteration field back to its snapshot value, restores archived states, and optionally removes items that are not in the snapshot. Use dry_run to see the changes first.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:           t("TOOL_RESTORE_PROJECT_USER_TITLE", "Restore project"),
+				ReadOnlyHint:    ToBoolPtr(false),
+				DestructiveHint: ToBoolPtr(true),
+				IdempotentHint:  ToBoolPtr(true),
+			}),
+			mcp.WithString("owner_type",
+				mcp.Required(),
+				mcp.Description("Owner type of the project to restore"),
+				mcp.Enum("user", "org"),
+			),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("If owner_type == user it is the handle for the GitHub user account. If owner_type == org it is the name of the organization. The name is not case sensitive."),
+			),
+			mcp.WithNumber("project_number",
+				mcp.Required(),
+				mcp.Description("The number of the project to restore."),
+			),
+			mcp.WithObject("snapshot",
+				mcp.Required(),
+				mcp.Description("The snapshot document returned by snapshot_project."),
+			),
+			mcp.WithBoolean("remove_extra_items",
+				mcp.Description("Remove items that are not in the snapshot from the project."),
+			),
+			mcp.WithBoolean("dry_run",
+				mcp.Description("Report the changes without making them."),
+			),
+		), func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](req, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			ownerType, err := RequiredParam[string](req, "owner_type")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			projectNumber, err := ValidateProjectNumber(req)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			snapshot, err := snapshotParam(req)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			removeExtra, err := OptionalParam[bool](req, "remove_extra_items")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			dryRun, err := OptionalParam[bool](req, "dry_run")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			r := &projectRestorer{
+				client:       client,
+				getGQLClient: getGQLClient,
+				ownerType:    ownerType,
+				owner:        owner,
+				number:       projectNumber,
+				dryRun:       dryRun,
+				result:       NewBulkResult[ProjectRestoreChange](),
+			}
+			if errResult := r.restore(ctx, snapshot, removeExtra); errResult != nil {
+				return errResult, nil
+			}
+
+			summary := r.result.Summary()
+			body, err := json.Marshal(struct {
+				DryRun bool `json:"dry_run"`
+				*BulkResult[ProjectRestoreChange]
+				Summary BulkSummary `json:"summary"`
+			}{dryRun, r.result, summary})
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+			if summary.Failed > 0 && summary.Succeeded == 0 {
+				return mcp.NewToolResultError(string(body)), nil
+			}
+			return mcp.NewToolResultText(string(body)), nil
+		}
+}
+
+// restorableField is a field of a snapshot and the field of the same name and type in the target project.
+type restorableField struct {
+	name   string
+	target *github.ProjectV2Field
+}
+
+// projectRestorer reconciles a project with a snapshot, recording every change in result.
+type projectRestorer struct {
+	client       *github.Client
+	getGQLClient GetGQLClientFn
+	ownerType    string
+	owner        string
+	number       int
+	dryRun       bool
+	result       *BulkResult[ProjectRestoreChange]
+	// itemNodeIDs maps the IDs of the target project's items to their node IDs, which clearing a field needs.
+	itemNodeIDs map[int64]string
+	// gqlClient and projectNodeID are set by the first change that needs GraphQL.
+	gqlClient     *githubv4.Client
+	projectNodeID string
+}
+
+func (r *projectRestorer) restore(ctx context.Context, snapshot *ProjectSnapshot, removeExtra bool) *mcp.CallToolResult {
+	fields, resp, err := listAllProjectFields(ctx, r.client, r.ownerType, r.owner, r.number)
+	if err != nil {
+		return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to list project fields", resp, err)
+	}
+	targetFields := map[string]*github.ProjectV2Field{}
+	fieldIDs := make([]int64, 0, len(fields))
+	fieldTypes := map[string]string{}
+	for _, field := range fields {
+		targetFields[field.GetName()] = field
+		fieldIDs = append(fieldIDs, field.GetID())
+		fieldTypes[field.GetName()] = field.GetDataType()
+	}
+
+	// Fields and options of the snapshot that the target project lacks are created before the items are restored.
+	// Fields that cannot be restored are reported once, rather than for every item.
+	var restorable []restorableField
+	for _, field := range snapshot.Fields {
+		if !field.Updatable {
+			continue
+		}
+		target, ok := targetFields[field.Name]
+		switch {
+		case !ok:
+			if target = r.createField(ctx, field); target == nil {
+				continue
+			}
+		case target.GetDataType() != field.DataType:
+			r.result.AddSkipped("field "+field.Name, fmt.Sprintf("the field is a %s field in the target project, not a %s field", target.GetDataType(), field.DataType))
+			continue
+		case field.DataType == "single_select":
+			target = r.addMissingOptions(ctx, field, target)
+		}
+		restorable = append(restorable, restorableField{name: field.Name, target: target})
+	}
+
+	items, resp, err := listAllProjectItems(ctx, r.client, r.ownerType, r.owner, r.number, fieldIDs)
+	if err != nil {
+		return ghErrors.NewGitHubAPIErrorResponse(ctx, ProjectListFailedError, resp, err)
+	}
+	currentItems := make([]ProjectSnapshotItem, 0, len(items))
+	current := map[string]ProjectSnapshotItem{}
+	r.itemNodeIDs = map[int64]string{}
+	for _, item := range items {
+		r.itemNodeIDs[item.GetID()] = item.GetNodeID()
+		snapshotItem := toSnapshotItem(item, fieldTypes)
+		currentItems = append(currentItems, snapshotItem)
+		current[snapshotItemKey(snapshotItem)] = snapshotItem
+	}
+
+	wanted := map[string]bool{}
+	for _, item := range snapshot.Items {
+		key := snapshotItemKey(item)
+		wanted[key] = true
+
+		existing, ok := current[key]
+		if !ok {
+			var added bool
+			if existing, added = r.addItem(ctx, item); !added {
+				continue
+			}
+		}
+		r.restoreItem(ctx, item, existing, restorable)
+	}
+
+	if removeExtra {
+		for _, item := range currentItems {
+			if !wanted[snapshotItemKey(item)] {
+				r.removeItem(ctx, item)
+			}
+		}
+	}
+	return nil
+}
+
+// createField creates a field of the snapshot in the target project, with the snapshot's options or iterations, and
+// returns it, or nil if it could not be created.
+func (r *projectRestorer) createField(ctx context.Context, field ProjectFieldSchema) *github.ProjectV2Field {
+	label := "field " + field.Name
+	graphQLType, ok := projectFieldDataTypes[field.DataType]
+	if !ok || (field.DataType == "single_select" && len(field.Options) == 0) {
+		r.result.AddSkipped(label, "the field does not exist in the target project and cannot be created")
+		return nil
+	}
+	input := CreateProjectV2FieldInput{DataType: graphQLType, Name: githubv4.String(field.Name)}
+	switch field.DataType {
+	case "single_select":
+		options := make([]githubv4.ProjectV2SingleSelectFieldOptionInput, 0, len(field.Options))
+		for _, option := range field.Options {
+			options = append(options, newProjectFieldOptionInput(option.Name))
+		}
+		input.SingleSelectOptions = &options
+	case "iteration":
+		configuration := &ProjectV2IterationFieldConfigurationInput{
+			Duration:   DefaultIterationDuration,
+			StartDate:  githubv4.String(time.Now().UTC().Format(time.DateOnly)),
+			Iterations: []ProjectV2Iteration{},
+		}
+		for i, iteration := range field.Iterations {
+			if i == 0 {
+				configuration.Duration = githubv4.Int(iteration.Duration) // #nosec G115 - iteration durations are a few days
+				configuration.StartDate = githubv4.String(iteration.StartDate)
+			}
+			configuration.Iterations = append(configuration.Iterations, ProjectV2Iteration{
+				Title:     githubv4.String(iteration.Title),
+				StartDate: githubv4.String(iteration.StartDate),
+				Duration:  githubv4.Int(iteration.Duration), // #nosec G115 - iteration durations are a few days
+			})
+		}
+		input.IterationConfiguration = configuration
+	}
+
+	change := ProjectRestoreChange{Item: label, Action: "create_field", To: field.DataType}
+	if r.dryRun {
+		// The field a dry run would create accepts the snapshot's options and iterations by name.
+		target := &github.ProjectV2Field{Name: github.Ptr(field.Name), DataType: github.Ptr(field.DataType)}
+		for _, option := range field.Options {
+			target.Options = append(target.Options, &github.ProjectV2FieldOption{ID: github.Ptr(option.Name), Name: &github.ProjectV2TextContent{Raw: github.Ptr(option.Name)}})
+		}
+		if len(field.Iterations) > 0 {
+			target.Configuration = &github.ProjectV2FieldConfiguration{}
+			for _, iteration := range field.Iterations {
+				target.Configuration.Iterations = append(target.Configuration.Iterations, &github.ProjectV2FieldIteration{ID: github.Ptr(iteration.Title), Title: &github.ProjectV2TextContent{Raw: github.Ptr(iteration.Title)}})
+			}
+		}
+		r.result.AddSuccess(change)
+		return target
+	}
+
+	if !r.useGraphQL(ctx, label) {
+		return nil
+	}
+	input.ProjectID = githubv4.ID(r.projectNodeID)
+	fieldID, err := createProjectV2Field(ctx, r.gqlClient, input)
+	if err != nil {
+		r.result.AddGraphQLFailure(label, fmt.Errorf("failed to create project field: %w", err))
+		return nil
+	}
+	target, resp, err := getProjectField(ctx, r.client, r.ownerType, r.owner, r.number, fieldID)
+	if err != nil {
+		r.result.AddAPIFailure(label, resp, err)
+		return nil
+	}
+	_ = resp.Body.Close()
+	r.result.AddSuccess(change)
+	return target
+}
+
+// addMissingOptions adds the options of a single select field of the snapshot that the field of the target project
+// lacks, and returns the field with them, or as it was if they could not be added.
+func (r *projectRestorer) addMissingOptions(ctx context.Context, field ProjectFieldSchema, target *github.ProjectV2Field) *github.ProjectV2Field {
+	label := "field " + field.Name
+	existing := map[string]bool{}
+	for _, option := range target.Options {
+		existing[option.GetName().GetRaw()] = true
+	}
+	var missing []string
+	for _, option := range field.Options {
+		if !existing[option.Name] {
+			missing = append(missing, option.Name)
+		}
+	}
+	if len(missing) == 0 {
+		return target
+	}
+
+	if r.dryRun {
+		updated := *target
+		updated.Options = slices.Clone(target.Options)
+		for _, name := range missing {
+			updated.Options = append(updated.Options, &github.ProjectV2FieldOption{ID: github.Ptr(name), Name: &github.ProjectV2TextContent{Raw: github.Ptr(name)}})
+			r.result.AddSuccess(ProjectRestoreChange{Item: label, Action: "add_option", To: name})
+		}
+		return &updated
+	}
+
+	if !r.useGraphQL(ctx, label) {
+		return target
+	}
+	// The options are replaced by the given ones, so the current ones are passed back to keep them.
+	options := make([]githubv4.ProjectV2SingleSelectFieldOptionInput, 0, len(target.Options)+len(missing))
+	for _, option := range target.Options {
+		options = append(options, githubv4.ProjectV2SingleSelectFieldOptionInput{
+			Name:        githubv4.String(option.GetName().GetRaw()),
+			Color:       githubv4.ProjectV2SingleSelectFieldOptionColor(strings.ToUpper(cmp.Or(option.GetColor(), "gray"))),
+			Description: githubv4.String(option.GetDescription().GetRaw()),
+		})
+	}
+	for _, name := range missing {
+		options = append(options, newProjectFieldOptionInput(name))
+	}
+	if err := updateProjectV2Field(ctx, r.gqlClient, UpdateProjectV2FieldInput{FieldID: githubv4.ID(target.GetNodeID()), SingleSelectOptions: &options}); err != nil {
+		r.result.AddGraphQLFailure(label, fmt.Errorf("failed to add options %s: %w", strings.Join(missing, ", "), err))
+		return target
+	}
+	updated, resp, err := getProjectField(ctx, r.client, r.ownerType, r.owner, r.number, target.GetID())
+	if err != nil {
+		r.result.AddAPIFailure(label, resp, err)
+		return target
+	}
+	_ = resp.Body.Close()
+	for _, name := range missing {
+		r.result.AddSuccess(ProjectRestoreChange{Item: label, Action: "add_option", To: name})
+	}
+	return updated
+}
+
+// newProjectFieldOptionInput returns a gray option without description, as the snapshot only records option names.
+func newProjectFieldOptionInput(name string) githubv4.ProjectV2SingleSelectFieldOptionInput {
+	return githubv4.ProjectV2SingleSelectFieldOptionInput{
+		Name:  githubv4.String(name),
+		Color: githubv4.ProjectV2SingleSelectFieldOptionColorGray,
+	}
+}
+
+func (r *projectRestorer) addItem(ctx context.Context, item ProjectSnapshotItem) (ProjectSnapshotItem, bool) {
+	label := snapshotItemLabel(item)
+	if item.ContentType == "DraftIssue" && item.Title != "" {
+		return r.addDraftIssue(ctx, item)
+	}
+	if (item.ContentType != "Issue" && item.ContentType != "PullRequest") || item.ContentID == 0 {
+		r.result.AddSkipped(label, fmt.Sprintf("%s items that are not in the target project cannot be re-created", item.ContentType))
+		return ProjectSnapshotItem{}, false
+	}
+	if r.dryRun {
+		r.result.AddSuccess(ProjectRestoreChange{Item: label, Action: "add"})
+		return ProjectSnapshotItem{ContentType: item.ContentType, ContentID: item.ContentID, FieldValues: map[string]any{}}, true
+	}
+
+	opts := &github.AddProjectItemOptions{ID: item.ContentID, Type: item.ContentType}
+	var added *github.ProjectV2Item
+	var resp *github.Response
+	var err error
+	if r.ownerType == "org" {
+		added, resp, err = r.client.Projects.AddOrganizationProjectItem(ctx, r.owner, r.number, opts)
+	} else {
+		added, resp, err = r.client.Projects.AddUserProjectItem(ctx, r.owner, r.number, opts)
+	}
+	if err != nil {
+		r.result.AddAPIFailure(label, resp, err)
+		return ProjectSnapshotItem{}, false
+	}
+	_ = resp.Body.Close()
+	r.itemNodeIDs[added.GetID()] = added.GetNodeID()
+	r.result.AddSuccess(ProjectRestoreChange{Item: label, Action: "add"})
+	return ProjectSnapshotItem{ID: added.GetID(), ContentType: item.ContentType, ContentID: item.ContentID, FieldValues: map[string]any{}}, true
+}
+
+func (r *projectRestorer) restoreItem(ctx context.Context, item, existing ProjectSnapshotItem, restorable []restorableField) {
+	label := snapshotItemLabel(item)
+	update := &github.UpdateProjectItemOptions{}
+	var changes []ProjectRestoreChange
+	// The REST API cannot clear date, iteration and single select fields, so empty values are cleared with GraphQL.
+	var cleared []restorableField
+	var clearChanges []ProjectRestoreChange
+	for _, field := range restorable {
+		name := field.name
+		want, from := item.FieldValues[name], existing.FieldValues[name]
+		if reflect.DeepEqual(want, from) {
+			continue
+		}
+		change := ProjectRestoreChange{Item: label, Action: "update", Field: name, From: from, To: want}
+		if want == nil {
+			cleared = append(cleared, field)
+			clearChanges = append(clearChanges, change)
+			continue
+		}
+		value, err := restoreFieldValue(field.target, want)
+		if err != nil {
+			r.result.AddSkipped(label, fmt.Sprintf("field %s: %s in the target project", name, err))
+			continue
+		}
+		update.Fields = append(update.Fields, &github.UpdateProjectV2Field{ID: field.target.GetID(), Value: value})
+		changes = append(changes, change)
+	}
+	if item.Archived != existing.Archived {
+		archived := item.Archived
+		update.Archived = &archived
+		action := "unarchive"
+		if archived {
+			action = "archive"
+		}
+		changes = append(changes, ProjectRestoreChange{Item: label, Action: action})
+	}
+
+	if len(changes) > 0 && !r.dryRun {
+		_, resp, err := updateProjectItem(ctx, r.client, r.ownerType, r.owner, r.number, existing.ID, update)
+		if err != nil {
+			r.result.AddAPIFailure(label, resp, err)
+			return
+		}
+		_ = resp.Body.Close()
+	}
+	for _, change := range changes {
+		r.result.AddSuccess(change)
+	}
+
+	for i, field := range cleared {
+		if !r.dryRun && !r.clearField(ctx, label, existing.ID, field) {
+			return
+		}
+		r.result.AddSuccess(clearChanges[i])
+	}
+}
+
+// addDraftIssue re-creates a draft issue of the snapshot, with its title, in the target project. Draft issues can
+// only be created through GraphQL.
+func (r *projectRestorer) addDraftIssue(ctx context.Context, item ProjectSnapshotItem) (ProjectSnapshotItem, bool) {
+	label := snapshotItemLabel(item)
+	added := ProjectSnapshotItem{ContentType: item.ContentType, Title: item.Title, FieldValues: map[string]any{}}
+	if !r.dryRun {
+		if !r.useGraphQL(ctx, label) {
+			return ProjectSnapshotItem{}, false
+		}
+		draft, err := addProjectDraftIssue(ctx, r.gqlClient, githubv4.AddProjectV2DraftIssueInput{
+			ProjectID: githubv4.ID(r.projectNodeID),
+			Title:     githubv4.String(item.Title),
+		})
+		if err != nil {
+			r.result.AddGraphQLFailure(label, fmt.Errorf("failed to add draft issue: %w", err))
+			return ProjectSnapshotItem{}, false
+		}
+		added.ID = int64(draft.DatabaseID)
+		r.itemNodeIDs[added.ID] = fmt.Sprint(draft.ID)
+	}
+	r.result.AddSuccess(ProjectRestoreChange{Item: label, Action: "add"})
+	return added, true
+}
+
+// useGraphQL gets the GraphQL client and the node ID of the target project the first time a change needs them,
+// recording the failure against label if it cannot.
+func (r *projectRestorer) useGraphQL(ctx context.Context, label string) bool {
+	if r.gqlClient != nil {
+		return true
+	}
+	gqlClient, err := r.getGQLClient(ctx)
+	if err != nil {
+		r.result.AddGraphQLFailure(label, fmt.Errorf("failed to get GitHub GraphQL client: %w", err))
+		return false
+	}
+	project, resp, err := getProjectV2(ctx, r.client, r.ownerType, r.owner, r.number)
+	if err != nil {
+		r.result.AddAPIFailure(label, resp, err)
+		return false
+	}
+	_ = resp.Body.Close()
+	r.gqlClient, r.projectNodeID = gqlClient, project.GetNodeID()
+	return true
+}
+
+// clearField clears a field of an item of the target project, recording the failure if it cannot.
+func (r *projectRestorer) clearField(ctx context.Context, label string, itemID int64, field restorableField) bool {
+	if !r.useGraphQL(ctx, label) {
+		return false
+	}
+	if err := clearProjectV2ItemFieldValue(ctx, r.gqlClient, r.projectNodeID, r.itemNodeIDs[itemID], field.target.GetNodeID()); err != nil {
+		r.result.AddGraphQLFailure(label, fmt.Errorf("failed to clear field %s: %w", field.name, err))
+		return false
+	}
+	return true
+}
+
+func (r *projectRestorer) removeItem(ctx context.Context, item ProjectSnapshotItem) {
+	label := snapshotItemLabel(item)
+	if !r.dryRun {
+		var resp *github.Response
+		var err error
+		if r.ownerType == "org" {
+			resp, err = r.client.Projects.DeleteOrganizationProjectItem(ctx, r.owner, r.number, item.ID)
+		} else {
+			resp, err = r.client.Projects.DeleteUserProjectItem(ctx, r.owner, r.number, item.ID)
+		}
+		if err != nil {
+			r.result.AddAPIFailure(label, resp, err)
+			return
+		}
+		_ = resp.Body.Close()
+	}
+	r.result.AddSuccess(ProjectRestoreChange{Item: label, Action: "remove"})
+}
@@ -0,0 +1,283 @@
+package github
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/ghmock"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// projectFields returns the fields of a project with a Status single select field and a Points number field, with
+// IDs starting at base so that copies of a project have different IDs.
+func projectFields(base int) []map[string]any {
+	return []map[string]any{
+		{"id": base, "name": "Title", "data_type": "title"},
+		{
+			"id": base + 1, "node_id": fmt.Sprintf("PVTSSF_%d", base+1), "name": "Status", "data_type": "single_select",
+			"options": []map[string]any{
+				{"id": fmt.Sprintf("todo-%d", base), "name": map[string]any{"raw": "Todo"}},
+				{"id": fmt.Sprintf("done-%d", base), "name": map[string]any{"raw": "Done"}},
+			},
+		},
+		{"id": base + 2, "name": "Points", "data_type": "number"},
+	}
+}
+
+func Test_SnapshotProject(t *testing.T) {
+	tool, _ := SnapshotProject(nil, translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+	assert.True(t, *tool.Annotations.ReadOnlyHint)
+	assert.ElementsMatch(t, []string{"owner_type", "owner", "project_number"}, tool.InputSchema.Required)
+
+	server := ghmock.New(t)
+	server.Respond("GET /orgs/{org}/projectsV2/{project}", http.StatusOK, map[string]any{
+		"id": 1, "number": 7, "title": "Roadmap", "public": true, "html_url": "https://github.com/orgs/octo-org/projects/7",
+	})
+	server.Respond("GET /orgs/{org}/projectsV2/{project}/fields", http.StatusOK, projectFields(100))
+	server.HandleFunc("GET /orgs/{org}/projectsV2/{project}/items", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "100,101,102", r.URL.Query().Get("fields"))
+		if r.URL.Query().Get("after") == "" {
+			w.Header().Set("Link", `<https://api.github.com/orgs/octo-org/projectsV2/7/items?after=cursor-2>; rel="next"`)
+			_, _ = w.Write(mock.MustMarshal([]map[string]any{{
+				"id": 1, "content_type": "Issue",
+				"content": map[string]any{"id": 501, "title": "Fix login", "html_url": "https://github.com/octo-org/app/issues/1"},
+				"fields": []map[string]any{
+					{"id": 100, "name": "Title", "data_type": "title", "value": map[string]any{"text": "Fix login"}},
+					{"id": 101, "name": "Status", "data_type": "single_select", "value": map[string]any{"id": "todo-100", "name": map[string]any{"raw": "Todo"}}},
+					{"id": 102, "name": "Points", "data_type": "number", "value": 3},
+				},
+			}}))
+			return
+		}
+		_, _ = w.Write(mock.MustMarshal([]map[string]any{{
+			"id": 2, "content_type": "DraftIssue", "archived_at": "2025-01-01T00:00:00Z",
+			"fields": []map[string]any{
+				{"id": 100, "name": "Title", "data_type": "title", "value": map[string]any{"text": "Idea"}},
+				{"id": 101, "name": "Status", "data_type": "single_select", "value": nil},
+			},
+		}}))
+	})
+
+	_, handler := SnapshotProject(server.GetClient(), translations.NullTranslationHelper)
+	result := ghmock.CallTool(t, handler, map[string]any{"owner": "octo-org", "owner_type": "org", "project_number": float64(7)})
+	require.False(t, result.IsError, ghmock.ResultText(t, result))
+
+	var snapshot ProjectSnapshot
+	require.NoError(t, json.Unmarshal([]byte(ghmock.ResultText(t, result)), &snapshot))
+	assert.Equal(t, ProjectSnapshotVersion, snapshot.Version)
+	_, err := time.Parse(time.RFC3339, snapshot.TakenAt)
+	assert.NoError(t, err)
+	assert.Equal(t, ProjectSnapshotInfo{
+		OwnerType: "org", Owner: "octo-org", Number: 7, Title: "Roadmap", Public: true, URL: "https://github.com/orgs/octo-org/projects/7",
+	}, snapshot.Project)
+	require.Len(t, snapshot.Fields, 3)
+	assert.Equal(t, "Status", snapshot.Fields[1].Name)
+	assert.Equal(t, []ProjectSnapshotItem{
+		{
+			ID: 1, ContentType: "Issue", ContentID: 501, ContentURL: "https://github.com/octo-org/app/issues/1", Title: "Fix login",
+			FieldValues: map[string]any{"Status": "Todo", "Points": float64(3)},
+		},
+		{ID: 2, ContentType: "DraftIssue", Title: "Idea", Archived: true, FieldValues: map[string]any{"Status": nil}},
+	}, snapshot.Items)
+}
+
+func Test_RestoreProject(t *testing.T) {
+	tool, _ := RestoreProject(nil, nil, translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+	assert.True(t, *tool.Annotations.DestructiveHint)
+	assert.ElementsMatch(t, []string{"owner_type", "owner", "project_number", "snapshot"}, tool.InputSchema.Required)
+
+	snapshot := map[string]any{
+		"version": 1,
+		"project": map[string]any{"owner_type": "org", "owner": "octo-org", "number": 7, "title": "Roadmap"},
+		"fields": []map[string]any{
+			{"id": 100, "name": "Title", "data_type": "title", "updatable": false},
+			{"id": 101, "name": "Status", "data_type": "single_select", "updatable": true, "options": []map[string]any{
+				{"id": "todo-100", "name": "Todo"}, {"id": "done-100", "name": "Done"}, {"id": "blocked-100", "name": "Blocked"},
+			}},
+			{"id": 102, "name": "Points", "data_type": "number", "updatable": true},
+			{"id": 103, "name": "Notes", "data_type": "text", "updatable": true},
+		},
+		"items": []map[string]any{
+			{"id": 1, "content_type": "Issue", "content_id": 501, "content_url": "https://github.com/octo-org/app/issues/1",
+				"field_values": map[string]any{"Status": "Done", "Points": 5}},
+			{"id": 2, "content_type": "Issue", "content_id": 502, "content_url": "https://github.com/octo-org/app/issues/2",
+				"field_values": map[string]any{"Status": "Todo", "Points": nil}},
+			{"id": 3, "content_type": "DraftIssue", "title": "Idea", "field_values": map[string]any{}},
+		},
+	}
+
+	// The target is a copy of the project with other IDs: the Notes field and the Blocked option were deleted, issue 1
+	// has other values, issue 2 and the draft issue were removed and issue 3 was added.
+	newServer := func(t *testing.T) *ghmock.Server {
+		server := ghmock.New(t)
+		server.Respond("GET /users/{user}/projectsV2/{project}", http.StatusOK, map[string]any{"id": 3, "node_id": "PVT_3"})
+		server.Respond("GET /users/{user}/projectsV2/{project}/fields", http.StatusOK, projectFields(200))
+		server.HandleFunc("GET /users/{user}/projectsV2/{project}/fields/{field}", func(w http.ResponseWriter, r *http.Request) {
+			field := map[string]any{"id": 203, "node_id": "PVTF_203", "name": "Notes", "data_type": "text"}
+			if r.PathValue("field") == "201" {
+				field = projectFields(200)[1]
+				field["options"] = append(field["options"].([]map[string]any), map[string]any{"id": "blocked-200", "name": map[string]any{"raw": "Blocked"}})
+			}
+			_, _ = w.Write(mock.MustMarshal(field))
+		})
+		server.RespondGraphQL("createProjectV2Field(", map[string]any{
+			"createProjectV2Field": map[string]any{"projectV2Field": map[string]any{"databaseId": 203, "name": "Notes"}},
+		})
+		server.RespondGraphQL("updateProjectV2Field(", map[string]any{
+			"updateProjectV2Field": map[string]any{"projectV2Field": map[string]any{"databaseId": 201, "name": "Status"}},
+		})
+		server.RespondGraphQL("addProjectV2DraftIssue(", map[string]any{
+			"addProjectV2DraftIssue": map[string]any{"projectItem": map[string]any{"id": "PVTI_14", "databaseId": 14}},
+		})
+		server.Respond("GET /users/{user}/projectsV2/{project}/items", http.StatusOK, []map[string]any{
+			{
+				"id": 11, "content_type": "Issue", "archived_at": "2025-01-01T00:00:00Z",
+				"content": map[string]any{"id": 501, "html_url": "https://github.com/octo-org/app/issues/1"},
+				"fields": []map[string]any{
+					{"id": 201, "name": "Status", "data_type": "single_select", "value": map[string]any{"id": "todo-200", "name": map[string]any{"raw": "Todo"}}},
+					{"id": 202, "name": "Points", "data_type": "number", "value": 5},
+				},
+			},
+			{
+				"id": 13, "content_type": "Issue",
+				"content": map[string]any{"id": 503, "html_url": "https://github.com/octo-org/app/issues/3"},
+				"fields":  []map[string]any{},
+			},
+		})
+		server.Respond("POST /users/{user}/projectsV2/{project}/items", http.StatusCreated, map[string]any{"id": 12, "content_type": "Issue"})
+		server.Respond("PATCH /users/{user}/projectsV2/{project}/items/{item}", http.StatusOK, map[string]any{"id": 11})
+		server.Respond("DELETE /users/{user}/projectsV2/{project}/items/{item}", http.StatusNoContent, nil)
+		return server
+	}
+	args := func(dryRun bool) map[string]any {
+		return map[string]any{
+			"owner": "octocat", "owner_type": "user", "project_number": float64(3),
+			"snapshot": snapshot, "remove_extra_items": true, "dry_run": dryRun,
+		}
+	}
+	expectedChanges := []ProjectRestoreChange{
+		{Item: "field Status", Action: "add_option", To: "Blocked"},
+		{Item: "field Notes", Action: "create_field", To: "text"},
+		{Item: "https://github.com/octo-org/app/issues/1", Action: "update", Field: "Status", From: "Todo", To: "Done"},
+		{Item: "https://github.com/octo-org/app/issues/1", Action: "unarchive"},
+		{Item: "https://github.com/octo-org/app/issues/2", Action: "add"},
+		{Item: "https://github.com/octo-org/app/issues/2", Action: "update", Field: "Status", To: "Todo"},
+		{Item: `DraftIssue "Idea"`, Action: "add"},
+		{Item: "https://github.com/octo-org/app/issues/3", Action: "remove"},
+	}
+
+	type response struct {
+		DryRun    bool                   `json:"dry_run"`
+		Succeeded []ProjectRestoreChange `json:"succeeded"`
+		Skipped   []BulkSkip             `json:"skipped"`
+		Summary   BulkSummary            `json:"summary"`
+	}
+	call := func(t *testing.T, server *ghmock.Server, dryRun bool) response {
+		_, handler := RestoreProject(server.GetClient(), server.GetGQLClient(), translations.NullTranslationHelper)
+		result := ghmock.CallTool(t, handler, args(dryRun))
+		require.False(t, result.IsError, ghmock.ResultText(t, result))
+		var resp response
+		require.NoError(t, json.Unmarshal([]byte(ghmock.ResultText(t, result)), &resp))
+		return resp
+	}
+
+	t.Run("dry run", func(t *testing.T) {
+		server := newServer(t)
+		resp := call(t, server, true)
+		assert.True(t, resp.DryRun)
+		assert.Equal(t, expectedChanges, resp.Succeeded)
+		assert.Empty(t, resp.Skipped)
+		for _, req := range server.Requests() {
+			assert.Equal(t, http.MethodGet, req.Method, "a dry run must not change the project")
+		}
+	})
+
+	t.Run("restore", func(t *testing.T) {
+		server := newServer(t)
+		resp := call(t, server, false)
+		assert.False(t, resp.DryRun)
+		assert.Equal(t, expectedChanges, resp.Succeeded)
+
+		var updated map[string]any
+		require.NoError(t, server.AssertRequested("PATCH /users/octocat/projectsV2/3/items/11").DecodeBody(&updated))
+		assert.Equal(t, map[string]any{"archived": false, "fields": []any{map[string]any{"id": float64(201), "value": "done-200"}}}, updated)
+
+		var added map[string]any
+		require.NoError(t, server.AssertRequested("POST /users/octocat/projectsV2/3/items").DecodeBody(&added))
+		assert.Equal(t, map[string]any{"type": "Issue", "id": float64(502)}, added)
+		updated = nil
+		require.NoError(t, server.AssertRequested("PATCH /users/octocat/projectsV2/3/items/12").DecodeBody(&updated))
+		assert.Equal(t, map[string]any{"fields": []any{map[string]any{"id": float64(201), "value": "todo-200"}}}, updated)
+
+		server.AssertRequested("DELETE /users/octocat/projectsV2/3/items/13")
+
+		assert.Equal(t, map[string]any{"projectId": "PVT_3", "dataType": "TEXT", "name": "Notes"},
+			server.AssertGraphQL("createProjectV2Field(").Variables["input"])
+		assert.Equal(t, map[string]any{"fieldId": "PVTSSF_201", "singleSelectOptions": []any{
+			map[string]any{"name": "Todo", "color": "GRAY", "description": ""},
+			map[string]any{"name": "Done", "color": "GRAY", "description": ""},
+			map[string]any{"name": "Blocked", "color": "GRAY", "description": ""},
+		}}, server.AssertGraphQL("updateProjectV2Field(").Variables["input"])
+		assert.Equal(t, map[string]any{"projectId": "PVT_3", "title": "Idea"},
+			server.AssertGraphQL("addProjectV2DraftIssue(").Variables["input"])
+	})
+
+	t.Run("clears a single select field", func(t *testing.T) {
+		server := ghmock.New(t)
+		server.Respond("GET /users/{user}/projectsV2/{project}", http.StatusOK, map[string]any{"id": 3, "node_id": "PVT_3"})
+		server.Respond("GET /users/{user}/projectsV2/{project}/fields", http.StatusOK, []map[string]any{
+			{"id": 201, "node_id": "PVTSSF_201", "name": "Status", "data_type": "single_select",
+				"options": []map[string]any{{"id": "todo-200", "name": map[string]any{"raw": "Todo"}}}},
+		})
+		server.Respond("GET /users/{user}/projectsV2/{project}/items", http.StatusOK, []map[string]any{{
+			"id": 11, "node_id": "PVTI_11", "content_type": "Issue",
+			"content": map[string]any{"id": 501, "html_url": "https://github.com/octo-org/app/issues/1"},
+			"fields": []map[string]any{
+				{"id": 201, "name": "Status", "data_type": "single_select", "value": map[string]any{"id": "todo-200", "name": map[string]any{"raw": "Todo"}}},
+			},
+		}})
+		server.RespondGraphQL("clearProjectV2ItemFieldValue(", map[string]any{
+			"clearProjectV2ItemFieldValue": map[string]any{"projectV2Item": map[string]any{"id": "PVTI_11"}},
+		})
+
+		_, handler := RestoreProject(server.GetClient(), server.GetGQLClient(), translations.NullTranslationHelper)
+		result := ghmock.CallTool(t, handler, map[string]any{
+			"owner": "octocat", "owner_type": "user", "project_number": float64(3),
+			"snapshot": map[string]any{
+				"version": 1,
+				"project": map[string]any{"owner_type": "org", "owner": "octo-org", "number": 7, "title": "Roadmap"},
+				"fields":  []map[string]any{{"id": 101, "name": "Status", "data_type": "single_select", "updatable": true}},
+				"items": []map[string]any{{"id": 1, "content_type": "Issue", "content_id": 501, "content_url": "https://github.com/octo-org/app/issues/1",
+					"field_values": map[string]any{"Status": nil}}},
+			},
+		})
+		require.False(t, result.IsError, ghmock.ResultText(t, result))
+		var resp response
+		require.NoError(t, json.Unmarshal([]byte(ghmock.ResultText(t, result)), &resp))
+		assert.Equal(t, []ProjectRestoreChange{
+			{Item: "https://github.com/octo-org/app/issues/1", Action: "update", Field: "Status", From: "Todo"},
+		}, resp.Succeeded)
+
+		assert.Equal(t, map[string]any{"projectId": "PVT_3", "itemId": "PVTI_11", "fieldId": "PVTSSF_201"},
+			server.AssertGraphQL("clearProjectV2ItemFieldValue(").Variables["input"])
+		server.AssertNotRequested(http.MethodPatch)
+	})
+
+	t.Run("unsupported version", func(t *testing.T) {
+		_, handler := RestoreProject(ghmock.New(t).GetClient(), nil, translations.NullTranslationHelper)
+		result := ghmock.CallTool(t, handler, map[string]any{
+			"owner": "octocat", "owner_type": "user", "project_number": float64(3), "snapshot": `{"version": 2}`,
+		})
+		require.True(t, result.IsError)
+		assert.Equal(t, "unsupported snapshot version 2: expected 1", ghmock.ResultText(t, result))
+	})
+}
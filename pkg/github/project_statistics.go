@@ -0,0 +1,243 @@
+package github
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"math"
+	"slices"
+	"time"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// ProjectStatistics is the statistics of the items of a project for status reports.
+type ProjectStatistics struct {
+	TotalItems  int                          `json:"total_items"`
+	Content     ProjectContentStatistics     `json:"content"`
+	ByStatus    []ProjectStatusStatistics    `json:"by_status,omitempty"`
+	ByAssignee  []ProjectCountGroup          `json:"by_assignee"`
+	ByIteration []ProjectIterationStatistics `json:"by_iteration,omitempty"`
+}
+
+// ProjectContentStatistics counts the items of a project by the state of their issue or pull request.
+type ProjectContentStatistics struct {
+	Open        int `json:"open"`
+	Closed      int `json:"closed"`
+	DraftIssues int `json:"draft_issues"`
+}
+
+// ProjectStatusStatistics is the items in a Status column. The average time items spend in the column is only set
+// when time in status was requested and items have left the column.
+type ProjectStatusStatistics struct {
+	Status              string   `json:"status"`
+	Count               int      `json:"count"`
+	AverageDaysInStatus *float64 `json:"average_days_in_status,omitempty"`
+}
+
+// ProjectCountGroup is the number of items in a group, such as the items of an assignee.
+type ProjectCountGroup struct {
+	Name  string `json:"name"`
+	Count int    `json:"count"`
+}
+
+// ProjectIterationStatistics is the completion of the items of an iteration.
+type ProjectIterationStatistics struct {
+	Iteration      string  `json:"iteration"`
+	Total          int     `json:"total"`
+	Completed      int     `json:"completed"`
+	CompletionRate float64 `json:"completion_rate"`
+}
+
+// statusDurations sums the time items spent in each Status, from the changes of their Status, oldest first. Only the
+// time between entering and leaving a status counts, so the current status of an item is not measured.
+type statusDurations struct {
+	total map[string]time.Duration
+	count map[string]int
+}
+
+func (d *statusDurations) add(changes []ProjectFieldChange) {
+	for i := 1; i < len(changes); i++ {
+		status := changes[i-1].To
+		if status == "" || changes[i].From != status {
+			continue
+		}
+		d.total[status] += changes[i].ChangedAt.Sub(changes[i-1].ChangedAt)
+		d.count[status]++
+	}
+}
+
+// averageDays returns the average number of days items spent in a status, rounded to a tenth of a day.
+func (d *statusDurations) averageDays(status string) *float64 {
+	if d.count[status] == 0 {
+		return nil
+	}
+	days := roundDays(d.total[status] / time.Duration(d.count[status]))
+	return &days
+}
+
+// roundDays converts a duration to days, rounded to a tenth of a day.
+func roundDays(d time.Duration) float64 {
+	return math.Round(d.Hours()/24*10) / 10
+}
+
+// GetProjectStatistics creates a tool that computes statistics of the items of a project for status reports.
+func GetProjectStatistics(getClient GetClientFn, getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_project_statistics",
+			mcp.WithDescription(t("TOOL_GET_PROJECT_STATISTICS_DESCRIPTION", "Get statistics of every item of a Project of a user or org for status reports: the number of items per Status column, open and closed issues and pull requests, the number of items per assignee, the completion rate of each iteration and, optionally, the average time items spend in each Status column. An item is completed when its Status is a done status or its issue or pull request is closed.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_GET_PROJECT_STATISTICS_USER_TITLE", "Get project statistics"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner_type",
+				mcp.Required(),
+				mcp.Description("Owner type"),
+				mcp.Enum("user", "org"),
+			),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("If owner_type == user it is the handle for the GitHub user account. If owner_type == org it is the name of the organization. The name is not case sensitive."),
+			),
+			mcp.WithNumber("project_number",
+				mcp.Required(),
+				mcp.Description("The project's number."),
+			),
+			mcp.WithArray("done_statuses",
+				mcp.Description("The Status columns of finished work. Defaults to [\"Done\"]."),
+				mcp.WithStringItems(),
+			),
+			mcp.WithBoolean("include_time_in_status",
+				mcp.Description("Compute the average number of days issues and pull requests spend in each Status column from the history of their Status. Makes one request per item, so it is slow on large projects."),
+			),
+		), func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			ownerType, err := RequiredParam[string](req, "owner_type")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			owner, err := RequiredParam[string](req, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			projectNumber, err := ValidateProjectNumber(req)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			done, err := OptionalStringArrayParam(req, "done_statuses")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if len(done) == 0 {
+				done = []string{"Done"}
+			}
+			includeTimeInStatus, err := OptionalParam[bool](req, "include_time_in_status")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			fields, resp, err := listAllProjectFields(ctx, client, ownerType, owner, projectNumber)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to list project fields", resp, err), nil
+			}
+			board, resp, err := loadProjectBoard(ctx, client, ownerType, owner, projectNumber, fields, nil)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to list project items", resp, err), nil
+			}
+
+			durations := &statusDurations{total: map[string]time.Duration{}, count: map[string]int{}}
+			if includeTimeInStatus && board.status != nil {
+				gqlClient, err := getGQLClient(ctx)
+				if err != nil {
+					return nil, fmt.Errorf("failed to get GitHub GraphQL client: %w", err)
+				}
+				for _, item := range board.items {
+					if contentType := item.item.GetContentType(); contentType != "Issue" && contentType != "PullRequest" {
+						continue
+					}
+					changes, err := listProjectStatusChanges(ctx, gqlClient, item.item.GetNodeID())
+					if err != nil {
+						return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "failed to get project status history", err), nil
+					}
+					durations.add(changes)
+				}
+			}
+
+			stats := ProjectStatistics{TotalItems: len(board.items), ByAssignee: []ProjectCountGroup{}}
+			statusName := projectStatusFieldName
+			if board.status != nil {
+				statusName = board.status.GetName()
+				for _, status := range board.statusOrder() {
+					stats.ByStatus = append(stats.ByStatus, ProjectStatusStatistics{Status: status})
+				}
+			}
+			for _, iteration := range board.iterationOrder() {
+				stats.ByIteration = append(stats.ByIteration, ProjectIterationStatistics{Iteration: iteration})
+			}
+			for _, item := range board.items {
+				state := ""
+				if item.item.Content != nil {
+					state = item.item.Content.State
+				}
+				switch {
+				case item.item.GetContentType() == "DraftIssue":
+					stats.Content.DraftIssues++
+				case state == "closed":
+					stats.Content.Closed++
+				default:
+					stats.Content.Open++
+				}
+
+				if board.status != nil {
+					status := cmp.Or(item.status, noValue(statusName))
+					i := slices.IndexFunc(stats.ByStatus, func(s ProjectStatusStatistics) bool { return s.Status == status })
+					if i == -1 {
+						stats.ByStatus = append(stats.ByStatus, ProjectStatusStatistics{Status: status})
+						i = len(stats.ByStatus) - 1
+					}
+					stats.ByStatus[i].Count++
+				}
+
+				for _, assignee := range item.assigneeGroups() {
+					i := slices.IndexFunc(stats.ByAssignee, func(g ProjectCountGroup) bool { return g.Name == assignee })
+					if i == -1 {
+						stats.ByAssignee = append(stats.ByAssignee, ProjectCountGroup{Name: assignee})
+						i = len(stats.ByAssignee) - 1
+					}
+					stats.ByAssignee[i].Count++
+				}
+
+				if board.iteration != nil {
+					iteration := cmp.Or(item.iteration, noValue(board.iteration.GetName()))
+					i := slices.IndexFunc(stats.ByIteration, func(s ProjectIterationStatistics) bool { return s.Iteration == iteration })
+					if i == -1 {
+						stats.ByIteration = append(stats.ByIteration, ProjectIterationStatistics{Iteration: iteration})
+						i = len(stats.ByIteration) - 1
+					}
+					stats.ByIteration[i].Total++
+					if containsFold(done, item.status) || state == "closed" {
+						stats.ByIteration[i].Completed++
+					}
+				}
+			}
+
+			for i := range stats.ByStatus {
+				stats.ByStatus[i].AverageDaysInStatus = durations.averageDays(stats.ByStatus[i].Status)
+			}
+			for i, iteration := range stats.ByIteration {
+				if iteration.Total > 0 {
+					stats.ByIteration[i].CompletionRate = math.Round(float64(iteration.Completed)/float64(iteration.Total)*100) / 100
+				}
+			}
+			slices.SortStableFunc(stats.ByAssignee, func(a, b ProjectCountGroup) int {
+				return compareAssignees(a.Name, b.Name)
+			})
+			return MarshalledTextResult(stats), nil
+		}
+}
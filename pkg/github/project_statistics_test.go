@@ -0,0 +1,75 @@
+package github
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/ghmock"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_GetProjectStatistics(t *testing.T) {
+	tool, _ := GetProjectStatistics(nil, nil, translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+	assert.True(t, *tool.Annotations.ReadOnlyHint)
+
+	call := func(t *testing.T, server *ghmock.Server, args map[string]any) ProjectStatistics {
+		_, handler := GetProjectStatistics(server.GetClient(), server.GetGQLClient(), translations.NullTranslationHelper)
+		result := ghmock.CallTool(t, handler, args)
+		require.False(t, result.IsError, ghmock.ResultText(t, result))
+		var stats ProjectStatistics
+		require.NoError(t, json.Unmarshal([]byte(ghmock.ResultText(t, result)), &stats))
+		return stats
+	}
+	days := func(d float64) *float64 { return &d }
+
+	t.Run("counts items", func(t *testing.T) {
+		server := ghmock.New(t)
+		respondProjectBoard(server)
+		stats := call(t, server, map[string]any{"owner_type": "org", "owner": "octo", "project_number": float64(1)})
+		assert.Equal(t, ProjectStatistics{
+			TotalItems: 3,
+			Content:    ProjectContentStatistics{Open: 1, Closed: 1, DraftIssues: 1},
+			ByStatus:   []ProjectStatusStatistics{{Status: "Todo", Count: 1}, {Status: "In Progress", Count: 1}, {Status: "Done", Count: 1}},
+			ByAssignee: []ProjectCountGroup{{Name: "hubot", Count: 1}, {Name: "mona", Count: 2}, {Name: "No Assignees", Count: 1}},
+			ByIteration: []ProjectIterationStatistics{
+				{Iteration: "Sprint 1", Total: 2, Completed: 1, CompletionRate: 0.5},
+				{Iteration: "Sprint 2"},
+				{Iteration: "No Sprint", Total: 1},
+			},
+		}, stats)
+		server.AssertNotRequested("POST")
+	})
+
+	t.Run("time in status", func(t *testing.T) {
+		server := ghmock.New(t)
+		respondProjectBoard(server)
+		server.HandleGraphQL("node(id: $id)", func(_ string, vars map[string]any) (any, []string) {
+			events := map[any][]any{
+				"PVTI_801": {
+					statusChangedEvent("PVT_1", "", "Todo", "2025-01-06T10:00:00Z"),
+					statusChangedEvent("PVT_1", "Todo", "In Progress", "2025-01-08T10:00:00Z"),
+					statusChangedEvent("PVT_1", "In Progress", "Todo", "2025-01-09T10:00:00Z"),
+				},
+				"PVTI_802": {
+					statusChangedEvent("PVT_1", "", "Todo", "2025-01-01T10:00:00Z"),
+					statusChangedEvent("PVT_1", "Todo", "Done", "2025-01-05T10:00:00Z"),
+				},
+			}[vars["id"]]
+			if events == nil {
+				return nil, []string{"unexpected node"}
+			}
+			timeline := map[string]any{"nodes": events, "pageInfo": map[string]any{"hasNextPage": false}}
+			return map[string]any{"node": map[string]any{"project": map[string]any{"id": "PVT_1"}, "content": map[string]any{"issueTimeline": timeline}}}, nil
+		})
+		stats := call(t, server, map[string]any{"owner_type": "org", "owner": "octo", "project_number": float64(1), "include_time_in_status": true})
+		assert.Equal(t, []ProjectStatusStatistics{
+			{Status: "Todo", Count: 1, AverageDaysInStatus: days(3)},
+			{Status: "In Progress", Count: 1, AverageDaysInStatus: days(1)},
+			{Status: "Done", Count: 1},
+		}, stats.ByStatus)
+	})
+}
@@ -0,0 +1,232 @@
+package github
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// ProjectTimeInStatus is how long the items of a project spent in each Status column, per column and per item, with
+// the items that have been in their current column for longer than its SLA.
+type ProjectTimeInStatus struct {
+	ByStatus    []ProjectStatusTime       `json:"by_status"`
+	Items       []ProjectItemTimeInStatus `json:"items"`
+	SLABreaches []ProjectSLABreach        `json:"sla_breaches"`
+}
+
+// ProjectStatusTime is the average time items spent in a Status column before leaving it.
+type ProjectStatusTime struct {
+	Status      string   `json:"status"`
+	Departures  int      `json:"departures"`
+	AverageDays *float64 `json:"average_days,omitempty"`
+	SLADays     *float64 `json:"sla_days,omitempty"`
+}
+
+// ProjectItemTimeInStatus is the total time an item spent in each Status column, including the time it has been in
+// its current column so far.
+type ProjectItemTimeInStatus struct {
+	ID                  int64              `json:"id"`
+	Title               string             `json:"title"`
+	URL                 string             `json:"url,omitempty"`
+	CurrentStatus       string             `json:"current_status,omitempty"`
+	DaysInCurrentStatus *float64           `json:"days_in_current_status,omitempty"`
+	DaysInStatus        map[string]float64 `json:"days_in_status"`
+}
+
+// ProjectSLABreach is an item that has been in its current Status column, or matched an SLA policy, for longer than
+// the SLA allows.
+type ProjectSLABreach struct {
+	Policy  string  `json:"policy,omitempty"`
+	ID      int64   `json:"id"`
+	Title   string  `json:"title"`
+	URL     string  `json:"url,omitempty"`
+	Status  string  `json:"status"`
+	Days    float64 `json:"days"`
+	SLADays float64 `json:"sla_days"`
+}
+
+// statusSLAsParam returns the slas parameter, a map from Status option names to the maximum number of days an item
+// should stay in the column.
+func statusSLAsParam(req mcp.CallToolRequest) (map[string]float64, error) {
+	raw, err := OptionalParam[map[string]any](req, "slas")
+	if err != nil {
+		return nil, err
+	}
+	slas := map[string]float64{}
+	for status, value := range raw {
+		days, ok := value.(float64)
+		if !ok || days <= 0 {
+			return nil, fmt.Errorf("invalid slas value for %s: expected a positive number of days such as 3, got %v", status, value)
+		}
+		slas[status] = days
+	}
+	return slas, nil
+}
+
+// timeInStatus sums the time spent in each status from the changes of the Status of an item, oldest first, counting
+// the time in the last status until now. It returns the last status and when the item entered it.
+func timeInStatus(changes []ProjectFieldChange, now time.Time) (map[string]time.Duration, string, time.Time) {
+	durations := map[string]time.Duration{}
+	for i, change := range changes {
+		if change.To == "" {
+			continue
+		}
+		end := now
+		if i+1 < len(changes) {
+			end = changes[i+1].ChangedAt
+		}
+		durations[change.To] += end.Sub(change.ChangedAt)
+	}
+	if len(changes) == 0 {
+		return durations, "", time.Time{}
+	}
+	last := changes[len(changes)-1]
+	return durations, last.To, last.ChangedAt
+}
+
+// GetProjectTimeInStatus creates a tool that reports how long the items of a project spent in each Status column.
+func GetProjectTimeInStatus(getClient GetClientFn, getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_project_time_in_status",
+			mcp.WithDescription(t("TOOL_GET_PROJECT_TIME_IN_STATUS_DESCRIPTION", "Get how long the issues and pull requests of a Project of a user or org spent in each Status column, from the history of their Status: the average time per column before items leave it, the total time per item and column, and the items that have been in their current column longer than its SLA. Makes one request per item, so give item_ids on large projects.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_GET_PROJECT_TIME_IN_STATUS_USER_TITLE", "Get project time in status"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner_type",
+				mcp.Required(),
+				mcp.Description("Owner type"),
+				mcp.Enum("user", "org"),
+			),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("If owner_type == user it is the handle for the GitHub user account. If owner_type == org it is the name of the organization. The name is not case sensitive."),
+			),
+			mcp.WithNumber("project_number",
+				mcp.Required(),
+				mcp.Description("The project's number."),
+			),
+			mcp.WithArray("item_ids",
+				mcp.Description("Only report these project items (e.g. [\"135086\"]). Defaults to every issue and pull request of the project."),
+				mcp.WithStringItems(),
+			),
+			mcp.WithObject("slas",
+				mcp.Description("The maximum number of days items should stay in Status columns, e.g. {\"In Review\": 2, \"Triage\": 3}."),
+			),
+		), func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			ownerType, err := RequiredParam[string](req, "owner_type")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			owner, err := RequiredParam[string](req, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			projectNumber, err := ValidateProjectNumber(req)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			itemIDs, err := OptionalBigIntArrayParam(req, "item_ids")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			slas, err := statusSLAsParam(req)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			gqlClient, err := getGQLClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub GraphQL client: %w", err)
+			}
+
+			fields, resp, err := listAllProjectFields(ctx, client, ownerType, owner, projectNumber)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to list project fields", resp, err), nil
+			}
+			board, resp, err := loadProjectBoard(ctx, client, ownerType, owner, projectNumber, fields, nil)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to list project items", resp, err), nil
+			}
+			if board.status == nil {
+				return mcp.NewToolResultError(fmt.Sprintf("the project has no %s field", projectStatusFieldName)), nil
+			}
+			// SLAs are matched to the columns of the board, so that the names of the report are those of the board.
+			statusSLAs := map[string]float64{}
+			for status, days := range slas {
+				i := slices.IndexFunc(board.statusOrder(), func(s string) bool { return strings.EqualFold(s, status) })
+				if i == -1 {
+					return mcp.NewToolResultError(fmt.Sprintf("invalid slas: the %s field has no option %s", projectStatusFieldName, status)), nil
+				}
+				statusSLAs[board.statusOrder()[i]] = days
+			}
+			for _, id := range itemIDs {
+				if !slices.ContainsFunc(board.items, func(item projectBoardItem) bool { return item.item.GetID() == id }) {
+					return mcp.NewToolResultError(fmt.Sprintf("the project has no item with ID %d", id)), nil
+				}
+			}
+
+			report := ProjectTimeInStatus{Items: []ProjectItemTimeInStatus{}, SLABreaches: []ProjectSLABreach{}}
+			durations := &statusDurations{total: map[string]time.Duration{}, count: map[string]int{}}
+			now := time.Now()
+			for _, boardItem := range board.items {
+				item := boardItem.item
+				if len(itemIDs) > 0 && !slices.Contains(itemIDs, item.GetID()) {
+					continue
+				}
+				if contentType := item.GetContentType(); contentType != "Issue" && contentType != "PullRequest" {
+					continue
+				}
+				changes, err := listProjectStatusChanges(ctx, gqlClient, item.GetNodeID())
+				if err != nil {
+					return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "failed to get project status history", err), nil
+				}
+				durations.add(changes)
+
+				itemTime := ProjectItemTimeInStatus{ID: item.GetID(), CurrentStatus: boardItem.status, DaysInStatus: map[string]float64{}}
+				if item.Content != nil {
+					itemTime.Title = item.Content.Title
+					itemTime.URL = item.Content.HTMLURL
+				}
+				perStatus, last, since := timeInStatus(changes, now)
+				for status, d := range perStatus {
+					itemTime.DaysInStatus[status] = roundDays(d)
+				}
+				// The time in the current column is only known when the last change moved the item into it.
+				if last != "" && last == boardItem.status {
+					days := roundDays(now.Sub(since))
+					itemTime.DaysInCurrentStatus = &days
+					if sla, ok := statusSLAs[last]; ok && days > sla {
+						report.SLABreaches = append(report.SLABreaches, ProjectSLABreach{
+							ID: itemTime.ID, Title: itemTime.Title, URL: itemTime.URL, Status: last, Days: days, SLADays: sla,
+						})
+					}
+				}
+				report.Items = append(report.Items, itemTime)
+			}
+
+			for _, status := range board.statusOrder() {
+				statusTime := ProjectStatusTime{Status: status, Departures: durations.count[status], AverageDays: durations.averageDays(status)}
+				if sla, ok := statusSLAs[status]; ok {
+					statusTime.SLADays = &sla
+				}
+				report.ByStatus = append(report.ByStatus, statusTime)
+			}
+			// The worst breaches, relative to their SLA, come first.
+			slices.SortStableFunc(report.SLABreaches, func(a, b ProjectSLABreach) int {
+				return cmp.Compare(b.Days/b.SLADays, a.Days/a.SLADays)
+			})
+			return MarshalledTextResult(report), nil
+		}
+}
@@ -0,0 +1,133 @@
+package github
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/ghmock"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_GetProjectTimeInStatus(t *testing.T) {
+	tool, _ := GetProjectTimeInStatus(nil, nil, translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+	assert.True(t, *tool.Annotations.ReadOnlyHint)
+
+	daysAgo := func(days int) string {
+		return time.Now().UTC().AddDate(0, 0, -days).Format(time.RFC3339)
+	}
+	respondStatusHistory := func(server *ghmock.Server) {
+		server.HandleGraphQL("node(id: $id)", func(_ string, vars map[string]any) (any, []string) {
+			events := map[any][]any{
+				"PVTI_801": {
+					statusChangedEvent("PVT_1", "", "In Progress", daysAgo(8)),
+					statusChangedEvent("PVT_1", "In Progress", "Todo", daysAgo(5)),
+				},
+				"PVTI_802": {
+					statusChangedEvent("PVT_1", "", "Todo", daysAgo(10)),
+					statusChangedEvent("PVT_1", "Todo", "Done", daysAgo(9)),
+				},
+			}[vars["id"]]
+			if events == nil {
+				return nil, []string{"unexpected node"}
+			}
+			timeline := map[string]any{"nodes": events, "pageInfo": map[string]any{"hasNextPage": false}}
+			return map[string]any{"node": map[string]any{"project": map[string]any{"id": "PVT_1"}, "content": map[string]any{"issueTimeline": timeline}}}, nil
+		})
+	}
+	days := func(d float64) *float64 { return &d }
+
+	t.Run("reports time in status and SLA breaches", func(t *testing.T) {
+		server := ghmock.New(t)
+		respondProjectBoard(server)
+		respondStatusHistory(server)
+		_, handler := GetProjectTimeInStatus(server.GetClient(), server.GetGQLClient(), translations.NullTranslationHelper)
+		result := ghmock.CallTool(t, handler, map[string]any{
+			"owner_type":     "org",
+			"owner":          "octo",
+			"project_number": float64(1),
+			"slas":           map[string]any{"todo": float64(2), "Done": float64(30)},
+		})
+		require.False(t, result.IsError, ghmock.ResultText(t, result))
+		var report ProjectTimeInStatus
+		require.NoError(t, json.Unmarshal([]byte(ghmock.ResultText(t, result)), &report))
+
+		assert.Equal(t, []ProjectStatusTime{
+			{Status: "Todo", Departures: 1, AverageDays: days(1), SLADays: days(2)},
+			{Status: "In Progress", Departures: 1, AverageDays: days(3)},
+			{Status: "Done", SLADays: days(30)},
+		}, report.ByStatus)
+		assert.Equal(t, []ProjectItemTimeInStatus{
+			{
+				ID: 801, Title: "Fix login", URL: "https://github.com/octo/app/issues/1", CurrentStatus: "Todo",
+				DaysInCurrentStatus: days(5), DaysInStatus: map[string]float64{"In Progress": 3, "Todo": 5},
+			},
+			{
+				ID: 802, Title: "Add SSO", URL: "https://github.com/octo/app/issues/2", CurrentStatus: "Done",
+				DaysInCurrentStatus: days(9), DaysInStatus: map[string]float64{"Todo": 1, "Done": 9},
+			},
+		}, report.Items)
+		assert.Equal(t, []ProjectSLABreach{
+			{ID: 801, Title: "Fix login", URL: "https://github.com/octo/app/issues/1", Status: "Todo", Days: 5, SLADays: 2},
+		}, report.SLABreaches)
+	})
+
+	t.Run("only reports the given items", func(t *testing.T) {
+		server := ghmock.New(t)
+		respondProjectBoard(server)
+		respondStatusHistory(server)
+		_, handler := GetProjectTimeInStatus(server.GetClient(), server.GetGQLClient(), translations.NullTranslationHelper)
+		result := ghmock.CallTool(t, handler, map[string]any{
+			"owner_type":     "org",
+			"owner":          "octo",
+			"project_number": float64(1),
+			"item_ids":       []any{"802"},
+		})
+		require.False(t, result.IsError, ghmock.ResultText(t, result))
+		var report ProjectTimeInStatus
+		require.NoError(t, json.Unmarshal([]byte(ghmock.ResultText(t, result)), &report))
+		require.Len(t, report.Items, 1)
+		assert.Equal(t, int64(802), report.Items[0].ID)
+		assert.Empty(t, report.SLABreaches)
+	})
+
+	for _, tc := range []struct {
+		name        string
+		args        map[string]any
+		expectedErr string
+	}{
+		{
+			name:        "invalid SLA",
+			args:        map[string]any{"slas": map[string]any{"Todo": "soon"}},
+			expectedErr: "invalid slas value for Todo",
+		},
+		{
+			name:        "unknown SLA status",
+			args:        map[string]any{"slas": map[string]any{"Blocked": float64(1)}},
+			expectedErr: "the Status field has no option Blocked",
+		},
+		{
+			name:        "unknown item",
+			args:        map[string]any{"item_ids": []any{"999"}},
+			expectedErr: "the project has no item with ID 999",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			server := ghmock.New(t)
+			respondProjectBoard(server)
+			_, handler := GetProjectTimeInStatus(server.GetClient(), server.GetGQLClient(), translations.NullTranslationHelper)
+			args := map[string]any{"owner_type": "org", "owner": "octo", "project_number": float64(1)}
+			for k, v := range tc.args {
+				args[k] = v
+			}
+			result := ghmock.CallTool(t, handler, args)
+			require.True(t, result.IsError)
+			assert.Contains(t, ghmock.ResultText(t, result), tc.expectedErr)
+			assert.Empty(t, server.Mutations())
+		})
+	}
+}
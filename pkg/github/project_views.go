@@ -0,0 +1,342 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/shurcooL/githubv4"
+)
+
+// ProjectView is a saved view of a project: how it lays out its items and which of them it shows.
+type ProjectView struct {
+	Number          int                `json:"number"`
+	Name            string             `json:"name"`
+	Layout          string             `json:"layout"`
+	Filter          string             `json:"filter,omitempty"`
+	VisibleFields   []ProjectViewField `json:"visible_fields,omitempty"`
+	SortBy          []ProjectViewSort  `json:"sort_by,omitempty"`
+	GroupBy         []ProjectViewField `json:"group_by,omitempty"`
+	VerticalGroupBy []ProjectViewField `json:"vertical_group_by,omitempty"`
+	UpdatedAt       time.Time          `json:"updated_at"`
+}
+
+// ProjectViewField is a field a view shows, sorts or groups by.
+type ProjectViewField struct {
+	ID   int64  `json:"id"`
+	Name string `json:"name"`
+}
+
+// ProjectViewSort is a field a view sorts by, and the direction, asc or desc.
+type ProjectViewSort struct {
+	Field     ProjectViewField `json:"field"`
+	Direction string           `json:"direction"`
+}
+
+// projectViewFields are the fields of a ProjectV2View. The REST API does not list views.
+type projectViewFields struct {
+	Number    githubv4.Int
+	Name      githubv4.String
+	Layout    githubv4.ProjectV2ViewLayout
+	Filter    githubv4.String
+	UpdatedAt githubv4.DateTime
+	Fields    struct {
+		Nodes []projectV2FieldConfiguration
+	} `graphql:"fields(first: 100)"`
+	SortByFields struct {
+		Nodes []struct {
+			Direction githubv4.OrderDirection
+			Field     projectV2FieldConfiguration
+		}
+	} `graphql:"sortByFields(first: 10)"`
+	GroupByFields struct {
+		Nodes []projectV2FieldConfiguration
+	} `graphql:"groupByFields(first: 10)"`
+	VerticalGroupByFields struct {
+		Nodes []projectV2FieldConfiguration
+	} `graphql:"verticalGroupByFields(first: 10)"`
+}
+
+func (v projectViewFields) toProjectView() ProjectView {
+	viewFields := func(nodes []projectV2FieldConfiguration) []ProjectViewField {
+		var fields []ProjectViewField
+		for _, node := range nodes {
+			fields = append(fields, ProjectViewField{ID: node.databaseID(), Name: node.name()})
+		}
+		return fields
+	}
+	view := ProjectView{
+		Number:          int(v.Number),
+		Name:            string(v.Name),
+		Layout:          strings.ToLower(strings.TrimSuffix(string(v.Layout), "_LAYOUT")),
+		Filter:          string(v.Filter),
+		VisibleFields:   viewFields(v.Fields.Nodes),
+		GroupBy:         viewFields(v.GroupByFields.Nodes),
+		VerticalGroupBy: viewFields(v.VerticalGroupByFields.Nodes),
+		UpdatedAt:       v.UpdatedAt.Time,
+	}
+	for _, node := range v.SortByFields.Nodes {
+		view.SortBy = append(view.SortBy, ProjectViewSort{
+			Field:     ProjectViewField{ID: node.Field.databaseID(), Name: node.Field.name()},
+			Direction: strings.ToLower(string(node.Direction)),
+		})
+	}
+	return view
+}
+
+// listProjectViews queries the views of a project of a user or an organization and their total count. Projects have
+// few views, so only the first 100 are listed rather than paginating.
+func listProjectViews(ctx context.Context, client *githubv4.Client, ownerType, owner string, number int) ([]projectViewFields, int, error) {
+	vars := map[string]any{
+		"owner":  githubv4.String(owner),
+		"number": githubv4.Int(number),
+	}
+	type views struct {
+		Nodes      []projectViewFields
+		TotalCount githubv4.Int
+	}
+	if ownerType == "org" {
+		var query struct {
+			Organization struct {
+				ProjectV2 struct {
+					Views views `graphql:"views(first: 100)"`
+				} `graphql:"projectV2(number: $number)"`
+			} `graphql:"organization(login: $owner)"`
+		}
+		if err := client.Query(ctx, &query, vars); err != nil {
+			return nil, 0, err
+		}
+		return query.Organization.ProjectV2.Views.Nodes, int(query.Organization.ProjectV2.Views.TotalCount), nil
+	}
+	var query struct {
+		User struct {
+			ProjectV2 struct {
+				Views views `graphql:"views(first: 100)"`
+			} `graphql:"projectV2(number: $number)"`
+		} `graphql:"user(login: $owner)"`
+	}
+	if err := client.Query(ctx, &query, vars); err != nil {
+		return nil, 0, err
+	}
+	return query.User.ProjectV2.Views.Nodes, int(query.User.ProjectV2.Views.TotalCount), nil
+}
+
+// getProjectView queries a view of a project of a user or an organization by its number.
+func getProjectView(ctx context.Context, client *githubv4.Client, ownerType, owner string, number, viewNumber int) (projectViewFields, error) {
+	vars := map[string]any{
+		"owner":      githubv4.String(owner),
+		"number":     githubv4.Int(number),
+		"viewNumber": githubv4.Int(viewNumber),
+	}
+	if ownerType == "org" {
+		var query struct {
+			Organization struct {
+				ProjectV2 struct {
+					View projectViewFields `graphql:"view(number: $viewNumber)"`
+				} `graphql:"projectV2(number: $number)"`
+			} `graphql:"organization(login: $owner)"`
+		}
+		if err := client.Query(ctx, &query, vars); err != nil {
+			return projectViewFields{}, err
+		}
+		return query.Organization.ProjectV2.View, nil
+	}
+	var query struct {
+		User struct {
+			ProjectV2 struct {
+				View projectViewFields `graphql:"view(number: $viewNumber)"`
+			} `graphql:"projectV2(number: $number)"`
+		} `graphql:"user(login: $owner)"`
+	}
+	if err := client.Query(ctx, &query, vars); err != nil {
+		return projectViewFields{}, err
+	}
+	return query.User.ProjectV2.View, nil
+}
+
+// createProjectViewRequest is the body of the REST request that creates a view, which go-github does not support.
+type createProjectViewRequest struct {
+	Name          string  `json:"name"`
+	Layout        string  `json:"layout"`
+	Filter        string  `json:"filter,omitempty"`
+	VisibleFields []int64 `json:"visible_fields,omitempty"`
+}
+
+// ListProjectViews creates a tool that lists the views of a project.
+func ListProjectViews(getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_project_views",
+			mcp.WithDescription(t("TOOL_LIST_PROJECT_VIEWS_DESCRIPTION", "List the views of a Project of a user or org, with their layout (board, table or roadmap), filter, visible fields, sort and grouping.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_LIST_PROJECT_VIEWS_USER_TITLE", "List project views"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner_type",
+				mcp.Required(),
+				mcp.Description("Owner type"),
+				mcp.Enum("user", "org"),
+			),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("If owner_type == user it is the handle for the GitHub user account. If owner_type == org it is the name of the organization. The name is not case sensitive."),
+			),
+			mcp.WithNumber("project_number",
+				mcp.Required(),
+				mcp.Description("The project's number."),
+			),
+		), func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			ownerType, err := RequiredParam[string](req, "owner_type")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			owner, err := RequiredParam[string](req, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			projectNumber, err := ValidateProjectNumber(req)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getGQLClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub GraphQL client: %w", err)
+			}
+
+			nodes, totalCount, err := listProjectViews(ctx, client, ownerType, owner, projectNumber)
+			if err != nil {
+				return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "failed to list project views", err), nil
+			}
+			views := make([]ProjectView, len(nodes))
+			for i, node := range nodes {
+				views[i] = node.toProjectView()
+			}
+			// Only the first 100 views are fetched, so there is no cursor to continue from.
+			return MarshalledTextResult(NewListResponse("views", views, NewGraphQLPageInfo(totalCount > len(views), false, "", ""), &totalCount)), nil
+		}
+}
+
+// CreateProjectView creates a tool that adds a view to a project.
+func CreateProjectView(getClient GetClientFn, getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("create_project_view",
+			mcp.WithDescription(t("TOOL_CREATE_PROJECT_VIEW_DESCRIPTION", "Add a view to a Project of a user or org. GitHub does not support setting the sort or grouping of a view, or changing a view once created, through its API; those are set in the project on github.com.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:           t("TOOL_CREATE_PROJECT_VIEW_USER_TITLE", "Create project view"),
+				ReadOnlyHint:    ToBoolPtr(false),
+				DestructiveHint: ToBoolPtr(false),
+				IdempotentHint:  ToBoolPtr(false),
+			}),
+			mcp.WithString("owner_type",
+				mcp.Required(),
+				mcp.Description("Owner type"),
+				mcp.Enum("user", "org"),
+			),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("If owner_type == user it is the handle for the GitHub user account. If owner_type == org it is the name of the organization. The name is not case sensitive."),
+			),
+			mcp.WithNumber("project_number",
+				mcp.Required(),
+				mcp.Description("The project's number."),
+			),
+			mcp.WithString("name",
+				mcp.Required(),
+				mcp.Description("Name of the view"),
+			),
+			mcp.WithString("layout",
+				mcp.Required(),
+				mcp.Description("Layout of the view"),
+				mcp.Enum("board", "table", "roadmap"),
+			),
+			mcp.WithString("filter",
+				mcp.Description("Filter of the items the view shows, in the project filter syntax, e.g. 'is:issue status:Todo'"),
+			),
+			mcp.WithArray("visible_fields",
+				mcp.Description("IDs of the fields the view shows, in display order (e.g. [\"102589\", \"985201\"]). Not supported by the roadmap layout."),
+				mcp.WithStringItems(),
+			),
+		), func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			ownerType, err := RequiredParam[string](req, "owner_type")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			owner, err := RequiredParam[string](req, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			projectNumber, err := ValidateProjectNumber(req)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			name, err := RequiredParam[string](req, "name")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			layout, err := RequiredParam[string](req, "layout")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			filter, err := OptionalParam[string](req, "filter")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			visibleFields, err := OptionalBigIntArrayParam(req, "visible_fields")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if layout == "roadmap" && len(visibleFields) > 0 {
+				return mcp.NewToolResultError("visible_fields cannot be given for a roadmap view"), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			gqlClient, err := getGQLClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub GraphQL client: %w", err)
+			}
+
+			// Views of user projects are created through the numeric ID of the user rather than the login.
+			ownerPath := projectOwnerPath(ownerType, owner)
+			if ownerType != "org" {
+				user, resp, err := client.Users.Get(ctx, owner)
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("failed to get user %s", owner), resp, err), nil
+				}
+				_ = resp.Body.Close()
+				ownerPath = "users/" + strconv.FormatInt(user.GetID(), 10)
+			}
+
+			u := fmt.Sprintf("%s/projectsV2/%d/views", ownerPath, projectNumber)
+			httpReq, err := client.NewRequest(http.MethodPost, u, createProjectViewRequest{
+				Name:          name,
+				Layout:        layout,
+				Filter:        filter,
+				VisibleFields: visibleFields,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to create request: %w", err)
+			}
+			var created struct {
+				Number int `json:"number"`
+			}
+			resp, err := client.Do(ctx, httpReq, &created)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to create project view", resp, err), nil
+			}
+			_ = resp.Body.Close()
+
+			view, err := getProjectView(ctx, gqlClient, ownerType, owner, projectNumber, created.Number)
+			if err != nil {
+				return ghErrors.NewGitHubGraphQLErrorResponse(ctx, fmt.Sprintf("view %d was created but could not be read back", created.Number), err), nil
+			}
+			return MarshalledTextResult(view.toProjectView()), nil
+		}
+}
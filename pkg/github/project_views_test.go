@@ -0,0 +1,125 @@
+package github
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/ghmock"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// projectViewJSON is the Board view of the Planning project as returned by GraphQL, grouped by Status and sorted by
+// Priority.
+func projectViewJSON(number int, name string) map[string]any {
+	status := map[string]any{"databaseId": 11, "name": "Status"}
+	return map[string]any{
+		"number": number, "name": name, "layout": "BOARD_LAYOUT", "filter": "is:issue", "updatedAt": "2025-01-06T10:00:00Z",
+		"fields":                map[string]any{"nodes": []any{map[string]any{"databaseId": 9, "name": "Title"}, status}},
+		"sortByFields":          map[string]any{"nodes": []any{map[string]any{"direction": "DESC", "field": map[string]any{"databaseId": 13, "name": "Priority"}}}},
+		"groupByFields":         map[string]any{"nodes": []any{}},
+		"verticalGroupByFields": map[string]any{"nodes": []any{status}},
+	}
+}
+
+func Test_ListProjectViews(t *testing.T) {
+	tool, _ := ListProjectViews(nil, translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+	assert.True(t, *tool.Annotations.ReadOnlyHint)
+
+	server := ghmock.New(t)
+	server.HandleGraphQL("views(first: 100)", func(_ string, vars map[string]any) (any, []string) {
+		if vars["owner"] != "mona" || vars["number"] != float64(3) {
+			return nil, []string{"Could not resolve to a ProjectV2 with the number 3."}
+		}
+		return map[string]any{"user": map[string]any{"projectV2": map[string]any{"views": map[string]any{"nodes": []any{projectViewJSON(1, "Board")}, "totalCount": 1}}}}, nil
+	})
+	_, handler := ListProjectViews(server.GetGQLClient(), translations.NullTranslationHelper)
+
+	result := ghmock.CallTool(t, handler, map[string]any{"owner_type": "user", "owner": "mona", "project_number": float64(3)})
+	require.False(t, result.IsError, ghmock.ResultText(t, result))
+	var response struct {
+		Views      []ProjectView `json:"views"`
+		PageInfo   PageInfo      `json:"page_info"`
+		TotalCount int           `json:"total_count"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(ghmock.ResultText(t, result)), &response))
+	assert.Equal(t, []ProjectView{{
+		Number: 1, Name: "Board", Layout: "board", Filter: "is:issue",
+		VisibleFields:   []ProjectViewField{{ID: 9, Name: "Title"}, {ID: 11, Name: "Status"}},
+		SortBy:          []ProjectViewSort{{Field: ProjectViewField{ID: 13, Name: "Priority"}, Direction: "desc"}},
+		VerticalGroupBy: []ProjectViewField{{ID: 11, Name: "Status"}},
+		UpdatedAt:       time.Date(2025, 1, 6, 10, 0, 0, 0, time.UTC),
+	}}, response.Views)
+	assert.Equal(t, 1, response.TotalCount)
+	assert.False(t, response.PageInfo.HasNextPage)
+}
+
+func Test_CreateProjectView(t *testing.T) {
+	tool, _ := CreateProjectView(nil, nil, translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+	assert.False(t, *tool.Annotations.IdempotentHint)
+	assert.ElementsMatch(t, []string{"owner_type", "owner", "project_number", "name", "layout"}, tool.InputSchema.Required)
+
+	newServer := func(t *testing.T) *ghmock.Server {
+		server := ghmock.New(t)
+		server.Respond("GET /users/mona", http.StatusOK, map[string]any{"id": 583231, "login": "mona"})
+		server.Respond("POST /orgs/octo/projectsV2/1/views", http.StatusCreated, map[string]any{"id": 7, "number": 2, "name": "Triage"})
+		server.Respond("POST /users/583231/projectsV2/3/views", http.StatusCreated, map[string]any{"id": 8, "number": 2, "name": "Triage"})
+		server.HandleGraphQL("view(number: $viewNumber)", func(query string, vars map[string]any) (any, []string) {
+			owner := "user"
+			if strings.Contains(query, "organization(") {
+				owner = "organization"
+			}
+			return map[string]any{owner: map[string]any{"projectV2": map[string]any{"view": projectViewJSON(int(vars["viewNumber"].(float64)), "Triage")}}}, nil
+		})
+		return server
+	}
+	call := func(t *testing.T, server *ghmock.Server, args map[string]any) (string, bool) {
+		_, handler := CreateProjectView(server.GetClient(), server.GetGQLClient(), translations.NullTranslationHelper)
+		result := ghmock.CallTool(t, handler, args)
+		return ghmock.ResultText(t, result), result.IsError
+	}
+
+	t.Run("organization project", func(t *testing.T) {
+		server := newServer(t)
+		text, isError := call(t, server, map[string]any{
+			"owner_type": "org", "owner": "octo", "project_number": float64(1),
+			"name": "Triage", "layout": "board", "filter": "is:issue", "visible_fields": []any{"9", "11"},
+		})
+		require.False(t, isError, text)
+		var body map[string]any
+		require.NoError(t, server.AssertRequested("POST /orgs/octo/projectsV2/1/views").DecodeBody(&body))
+		assert.Equal(t, map[string]any{"name": "Triage", "layout": "board", "filter": "is:issue", "visible_fields": []any{float64(9), float64(11)}}, body)
+		var view ProjectView
+		require.NoError(t, json.Unmarshal([]byte(text), &view))
+		assert.Equal(t, 2, view.Number)
+		assert.Equal(t, "board", view.Layout)
+	})
+
+	t.Run("user project", func(t *testing.T) {
+		server := newServer(t)
+		text, isError := call(t, server, map[string]any{
+			"owner_type": "user", "owner": "mona", "project_number": float64(3), "name": "Triage", "layout": "table",
+		})
+		require.False(t, isError, text)
+		var body map[string]any
+		require.NoError(t, server.AssertRequested("POST /users/583231/projectsV2/3/views").DecodeBody(&body))
+		assert.Equal(t, map[string]any{"name": "Triage", "layout": "table"}, body)
+	})
+
+	t.Run("visible fields of a roadmap", func(t *testing.T) {
+		server := ghmock.New(t)
+		text, isError := call(t, server, map[string]any{
+			"owner_type": "org", "owner": "octo", "project_number": float64(1), "name": "Timeline", "layout": "roadmap", "visible_fields": []any{"9"},
+		})
+		require.True(t, isError)
+		assert.Contains(t, text, "visible_fields cannot be given for a roadmap view")
+		assert.Empty(t, server.Requests())
+	})
+}
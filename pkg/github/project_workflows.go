@@ -0,0 +1,141 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/shurcooL/githubv4"
+)
+
+// projectWorkflowTriggers describes what starts each built-in workflow of a project. The API exposes the name and
+// state of a workflow but not its trigger or the values it sets, so the trigger is known from the name only.
+var projectWorkflowTriggers = map[string]string{
+	"Item added to project":          "An issue or pull request is added to the project",
+	"Item reopened":                  "An issue or pull request in the project is reopened",
+	"Item closed":                    "An issue or pull request in the project is closed",
+	"Code changes requested":         "A review requesting changes is submitted on a pull request in the project",
+	"Code review approved":           "A pull request in the project is approved",
+	"Pull request merged":            "A pull request in the project is merged",
+	"Pull request linked to issue":   "A pull request is linked to an issue in the project",
+	"Auto-add to project":            "An issue or pull request matching the workflow's filter is created or updated in the workflow's repository",
+	"Auto-add sub-issues to project": "A sub-issue is added to an issue in the project",
+	"Auto-archive items":             "An item in the project matches the workflow's filter, e.g. it was closed some time ago",
+	"Auto-close issue":               "The Status of an issue in the project is set to the workflow's status, which closes the issue",
+}
+
+// ProjectWorkflow is a built-in workflow of a project, which moves, adds or archives items automatically.
+type ProjectWorkflow struct {
+	Number    int       `json:"number"`
+	Name      string    `json:"name"`
+	Enabled   bool      `json:"enabled"`
+	Trigger   string    `json:"trigger,omitempty"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type projectWorkflowNode struct {
+	Number    githubv4.Int
+	Name      githubv4.String
+	Enabled   githubv4.Boolean
+	UpdatedAt githubv4.DateTime
+}
+
+// listProjectWorkflows queries the workflows of a project of a user or an organization and their total count.
+// Projects have a fixed set of built-in workflows, so only the first 100 are listed rather than paginating.
+func listProjectWorkflows(ctx context.Context, client *githubv4.Client, ownerType, owner string, number int) ([]projectWorkflowNode, int, error) {
+	vars := map[string]any{
+		"owner":  githubv4.String(owner),
+		"number": githubv4.Int(number),
+	}
+	type workflows struct {
+		Nodes      []projectWorkflowNode
+		TotalCount githubv4.Int
+	}
+	if ownerType == "org" {
+		var query struct {
+			Organization struct {
+				ProjectV2 struct {
+					Workflows workflows `graphql:"workflows(first: 100)"`
+				} `graphql:"projectV2(number: $number)"`
+			} `graphql:"organization(login: $owner)"`
+		}
+		if err := client.Query(ctx, &query, vars); err != nil {
+			return nil, 0, err
+		}
+		return query.Organization.ProjectV2.Workflows.Nodes, int(query.Organization.ProjectV2.Workflows.TotalCount), nil
+	}
+	var query struct {
+		User struct {
+			ProjectV2 struct {
+				Workflows workflows `graphql:"workflows(first: 100)"`
+			} `graphql:"projectV2(number: $number)"`
+		} `graphql:"user(login: $owner)"`
+	}
+	if err := client.Query(ctx, &query, vars); err != nil {
+		return nil, 0, err
+	}
+	return query.User.ProjectV2.Workflows.Nodes, int(query.User.ProjectV2.Workflows.TotalCount), nil
+}
+
+// ListProjectWorkflows creates a tool that lists the built-in workflows of a project.
+func ListProjectWorkflows(getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_project_workflows",
+			mcp.WithDescription(t("TOOL_LIST_PROJECT_WORKFLOWS_DESCRIPTION", "List the built-in workflows of a Project of a user or org, such as Item closed or Auto-archive items, with whether each is enabled and what triggers it. Use it to explain why items move, appear or get archived automatically. Workflows can only be enabled, disabled or configured in the project on github.com.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_LIST_PROJECT_WORKFLOWS_USER_TITLE", "List project workflows"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner_type",
+				mcp.Required(),
+				mcp.Description("Owner type"),
+				mcp.Enum("user", "org"),
+			),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("If owner_type == user it is the handle for the GitHub user account. If owner_type == org it is the name of the organization. The name is not case sensitive."),
+			),
+			mcp.WithNumber("project_number",
+				mcp.Required(),
+				mcp.Description("The project's number."),
+			),
+		), func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			ownerType, err := RequiredParam[string](req, "owner_type")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			owner, err := RequiredParam[string](req, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			projectNumber, err := ValidateProjectNumber(req)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getGQLClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub GraphQL client: %w", err)
+			}
+
+			nodes, totalCount, err := listProjectWorkflows(ctx, client, ownerType, owner, projectNumber)
+			if err != nil {
+				return ghErrors.NewGitHubGraphQLErrorResponse(ctx, "failed to list project workflows", err), nil
+			}
+			workflows := make([]ProjectWorkflow, len(nodes))
+			for i, node := range nodes {
+				workflows[i] = ProjectWorkflow{
+					Number:    int(node.Number),
+					Name:      string(node.Name),
+					Enabled:   bool(node.Enabled),
+					Trigger:   projectWorkflowTriggers[string(node.Name)],
+					UpdatedAt: node.UpdatedAt.Time,
+				}
+			}
+			// Only the first 100 workflows are fetched, so there is no cursor to continue from.
+			return MarshalledTextResult(NewListResponse("workflows", workflows, NewGraphQLPageInfo(totalCount > len(workflows), false, "", ""), &totalCount)), nil
+		}
+}
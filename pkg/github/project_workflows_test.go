@@ -0,0 +1,46 @@
+package github
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/ghmock"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ListProjectWorkflows(t *testing.T) {
+	tool, _ := ListProjectWorkflows(nil, translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+	assert.True(t, *tool.Annotations.ReadOnlyHint)
+
+	server := ghmock.New(t)
+	server.HandleGraphQL("workflows(first: 100)", func(_ string, vars map[string]any) (any, []string) {
+		if vars["owner"] != "octo" || vars["number"] != float64(1) {
+			return nil, []string{"Could not resolve to a ProjectV2 with the number 1."}
+		}
+		return map[string]any{"organization": map[string]any{"projectV2": map[string]any{"workflows": map[string]any{"nodes": []any{
+			map[string]any{"number": 1, "name": "Item closed", "enabled": true, "updatedAt": "2025-01-06T10:00:00Z"},
+			map[string]any{"number": 7, "name": "Custom cleanup", "enabled": false, "updatedAt": "2025-01-07T10:00:00Z"},
+		}, "totalCount": 2}}}}, nil
+	})
+	_, handler := ListProjectWorkflows(server.GetGQLClient(), translations.NullTranslationHelper)
+
+	result := ghmock.CallTool(t, handler, map[string]any{"owner_type": "org", "owner": "octo", "project_number": float64(1)})
+	require.False(t, result.IsError, ghmock.ResultText(t, result))
+	var response struct {
+		Workflows  []ProjectWorkflow `json:"workflows"`
+		PageInfo   PageInfo          `json:"page_info"`
+		TotalCount int               `json:"total_count"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(ghmock.ResultText(t, result)), &response))
+	assert.Equal(t, []ProjectWorkflow{
+		{Number: 1, Name: "Item closed", Enabled: true, Trigger: "An issue or pull request in the project is closed", UpdatedAt: time.Date(2025, 1, 6, 10, 0, 0, 0, time.UTC)},
+		{Number: 7, Name: "Custom cleanup", UpdatedAt: time.Date(2025, 1, 7, 10, 0, 0, 0, time.UTC)},
+	}, response.Workflows)
+	assert.Equal(t, 2, response.TotalCount)
+	assert.False(t, response.PageInfo.HasNextPage)
+}
@@ -0,0 +1,201 @@
+package github
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v79/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// ProjectWorkload is the work of each assignee of a project, most loaded first.
+type ProjectWorkload struct {
+	EstimateField string                    `json:"estimate_field,omitempty"`
+	Assignees     []ProjectAssigneeWorkload `json:"assignees"`
+}
+
+// ProjectAssigneeWorkload is the items of an assignee, grouped by Status. The estimate totals are only set when an
+// estimate field was given.
+type ProjectAssigneeWorkload struct {
+	Assignee      string                  `json:"assignee"`
+	ItemCount     int                     `json:"item_count"`
+	EstimateTotal *float64                `json:"estimate_total,omitempty"`
+	ByStatus      []ProjectWorkloadStatus `json:"by_status"`
+}
+
+// ProjectWorkloadStatus is the items of an assignee in one Status column.
+type ProjectWorkloadStatus struct {
+	Status        string                `json:"status"`
+	EstimateTotal *float64              `json:"estimate_total,omitempty"`
+	Items         []ProjectWorkloadItem `json:"items"`
+}
+
+// ProjectWorkloadItem is an item of a project in a workload.
+type ProjectWorkloadItem struct {
+	ID          int64    `json:"id"`
+	ContentType string   `json:"content_type"`
+	Title       string   `json:"title"`
+	URL         string   `json:"url,omitempty"`
+	Estimate    *float64 `json:"estimate,omitempty"`
+}
+
+// addEstimate adds an estimate to a total that is only tracked when an estimate field was given.
+func addEstimate(total **float64, estimate *float64, tracked bool) {
+	if !tracked {
+		return
+	}
+	if *total == nil {
+		*total = new(float64)
+	}
+	if estimate != nil {
+		**total += *estimate
+	}
+}
+
+// GetProjectAssigneeWorkload creates a tool that lists the items of each assignee of a project, grouped by Status.
+func GetProjectAssigneeWorkload(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_project_assignee_workload",
+			mcp.WithDescription(t("TOOL_GET_PROJECT_ASSIGNEE_WORKLOAD_DESCRIPTION", "Get the items of each assignee of a Project of a user or org, grouped by Status, with estimate totals when an estimate field is given. Assignees are listed most loaded first, to see who is overloaded in one call, e.g. for a standup.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_GET_PROJECT_ASSIGNEE_WORKLOAD_USER_TITLE", "Get project assignee workload"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner_type",
+				mcp.Required(),
+				mcp.Description("Owner type"),
+				mcp.Enum("user", "org"),
+			),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("If owner_type == user it is the handle for the GitHub user account. If owner_type == org it is the name of the organization. The name is not case sensitive."),
+			),
+			mcp.WithNumber("project_number",
+				mcp.Required(),
+				mcp.Description("The project's number."),
+			),
+			mcp.WithNumber("estimate_field_id",
+				mcp.Description("The id of a number field holding estimates, such as Points, to total per assignee and status"),
+			),
+			mcp.WithString("assignee",
+				mcp.Description("Only get the workload of this user"),
+			),
+			mcp.WithArray("statuses",
+				mcp.Description("Only include items in these Status columns, e.g. [\"Todo\", \"In Progress\"]"),
+				mcp.WithStringItems(),
+			),
+		), func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			ownerType, err := RequiredParam[string](req, "owner_type")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			owner, err := RequiredParam[string](req, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			projectNumber, err := ValidateProjectNumber(req)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			estimateFieldID, err := OptionalIntParam(req, "estimate_field_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			assignee, err := OptionalParam[string](req, "assignee")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			statuses, err := OptionalStringArrayParam(req, "statuses")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			fields, resp, err := listAllProjectFields(ctx, client, ownerType, owner, projectNumber)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to list project fields", resp, err), nil
+			}
+			var estimate *github.ProjectV2Field
+			if estimateFieldID != 0 {
+				if estimate, err = findProjectNumberField(fields, int64(estimateFieldID)); err != nil {
+					return mcp.NewToolResultError(err.Error()), nil
+				}
+			}
+			board, resp, err := loadProjectBoard(ctx, client, ownerType, owner, projectNumber, fields, estimate)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to list project items", resp, err), nil
+			}
+
+			statusName := projectStatusFieldName
+			if board.status != nil {
+				statusName = board.status.GetName()
+			}
+			statusOrder := append(board.statusOrder(), noValue(statusName))
+			tracked := estimate != nil
+			workloads := map[string]*ProjectAssigneeWorkload{}
+			for _, item := range board.items {
+				status := cmp.Or(item.status, noValue(statusName))
+				if len(statuses) > 0 && !slices.ContainsFunc(statuses, func(s string) bool { return strings.EqualFold(s, status) }) {
+					continue
+				}
+				workloadItem := ProjectWorkloadItem{ID: item.item.GetID(), ContentType: item.item.GetContentType(), Estimate: item.estimate}
+				if item.item.Content != nil {
+					workloadItem.Title = item.item.Content.Title
+					workloadItem.URL = item.item.Content.HTMLURL
+				}
+				for _, login := range item.assigneeGroups() {
+					if assignee != "" && !strings.EqualFold(login, assignee) {
+						continue
+					}
+					workload, ok := workloads[login]
+					if !ok {
+						workload = &ProjectAssigneeWorkload{Assignee: login}
+						workloads[login] = workload
+					}
+					i := slices.IndexFunc(workload.ByStatus, func(s ProjectWorkloadStatus) bool { return s.Status == status })
+					if i == -1 {
+						workload.ByStatus = append(workload.ByStatus, ProjectWorkloadStatus{Status: status})
+						i = len(workload.ByStatus) - 1
+					}
+					workload.ItemCount++
+					addEstimate(&workload.EstimateTotal, item.estimate, tracked)
+					addEstimate(&workload.ByStatus[i].EstimateTotal, item.estimate, tracked)
+					workload.ByStatus[i].Items = append(workload.ByStatus[i].Items, workloadItem)
+				}
+			}
+
+			result := ProjectWorkload{Assignees: []ProjectAssigneeWorkload{}}
+			if tracked {
+				result.EstimateField = estimate.GetName()
+			}
+			for _, workload := range workloads {
+				slices.SortStableFunc(workload.ByStatus, func(a, b ProjectWorkloadStatus) int {
+					return cmp.Compare(slices.Index(statusOrder, a.Status), slices.Index(statusOrder, b.Status))
+				})
+				result.Assignees = append(result.Assignees, *workload)
+			}
+			// The most loaded assignees come first, by estimate when there are estimates and by number of items otherwise.
+			slices.SortFunc(result.Assignees, func(a, b ProjectAssigneeWorkload) int {
+				if (a.Assignee == noValue("Assignees")) != (b.Assignee == noValue("Assignees")) {
+					return compareAssignees(a.Assignee, b.Assignee)
+				}
+				if a.EstimateTotal != nil && b.EstimateTotal != nil && *a.EstimateTotal != *b.EstimateTotal {
+					return cmp.Compare(*b.EstimateTotal, *a.EstimateTotal)
+				}
+				if a.ItemCount != b.ItemCount {
+					return cmp.Compare(b.ItemCount, a.ItemCount)
+				}
+				return compareAssignees(a.Assignee, b.Assignee)
+			})
+			return MarshalledTextResult(result), nil
+		}
+}
@@ -0,0 +1,74 @@
+package github
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/ghmock"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_GetProjectAssigneeWorkload(t *testing.T) {
+	tool, _ := GetProjectAssigneeWorkload(nil, translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+	assert.True(t, *tool.Annotations.ReadOnlyHint)
+	assert.ElementsMatch(t, []string{"owner_type", "owner", "project_number"}, tool.InputSchema.Required)
+
+	call := func(t *testing.T, args map[string]any) ProjectWorkload {
+		server := ghmock.New(t)
+		respondProjectBoard(server)
+		_, handler := GetProjectAssigneeWorkload(server.GetClient(), translations.NullTranslationHelper)
+		args["owner_type"], args["owner"], args["project_number"] = "org", "octo", float64(1)
+		result := ghmock.CallTool(t, handler, args)
+		require.False(t, result.IsError, ghmock.ResultText(t, result))
+		var workload ProjectWorkload
+		require.NoError(t, json.Unmarshal([]byte(ghmock.ResultText(t, result)), &workload))
+		return workload
+	}
+	points := func(v float64) *float64 { return &v }
+	fixLogin := ProjectWorkloadItem{ID: 801, ContentType: "Issue", Title: "Fix login", URL: "https://github.com/octo/app/issues/1", Estimate: points(3)}
+	addSSO := ProjectWorkloadItem{ID: 802, ContentType: "Issue", Title: "Add SSO", URL: "https://github.com/octo/app/issues/2", Estimate: points(5)}
+
+	t.Run("with estimates", func(t *testing.T) {
+		workload := call(t, map[string]any{"estimate_field_id": float64(13)})
+		assert.Equal(t, ProjectWorkload{
+			EstimateField: "Points",
+			Assignees: []ProjectAssigneeWorkload{
+				{
+					Assignee: "mona", ItemCount: 2, EstimateTotal: points(8),
+					ByStatus: []ProjectWorkloadStatus{
+						{Status: "Todo", EstimateTotal: points(3), Items: []ProjectWorkloadItem{fixLogin}},
+						{Status: "Done", EstimateTotal: points(5), Items: []ProjectWorkloadItem{addSSO}},
+					},
+				},
+				{
+					Assignee: "hubot", ItemCount: 1, EstimateTotal: points(5),
+					ByStatus: []ProjectWorkloadStatus{{Status: "Done", EstimateTotal: points(5), Items: []ProjectWorkloadItem{addSSO}}},
+				},
+				{
+					Assignee: "No Assignees", ItemCount: 1, EstimateTotal: points(0),
+					ByStatus: []ProjectWorkloadStatus{{Status: "In Progress", EstimateTotal: points(0), Items: []ProjectWorkloadItem{{ID: 803, ContentType: "DraftIssue", Title: "Write docs"}}}},
+				},
+			},
+		}, workload)
+	})
+
+	t.Run("without estimates", func(t *testing.T) {
+		workload := call(t, map[string]any{})
+		assert.Empty(t, workload.EstimateField)
+		require.Len(t, workload.Assignees, 3)
+		assert.Equal(t, "mona", workload.Assignees[0].Assignee)
+		assert.Nil(t, workload.Assignees[0].EstimateTotal)
+	})
+
+	t.Run("one assignee in some statuses", func(t *testing.T) {
+		workload := call(t, map[string]any{"assignee": "MONA", "statuses": []any{"todo", "In Progress"}})
+		require.Len(t, workload.Assignees, 1)
+		assert.Equal(t, "mona", workload.Assignees[0].Assignee)
+		assert.Equal(t, 1, workload.Assignees[0].ItemCount)
+		assert.Equal(t, "Todo", workload.Assignees[0].ByStatus[0].Status)
+	})
+}
@@ -6,13 +6,17 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"slices"
+	"strconv"
 	"strings"
+	"time"
 
 	ghErrors "github.com/github/github-mcp-server/pkg/errors"
 	"github.com/github/github-mcp-server/pkg/translations"
 	"github.com/google/go-github/v79/github"
 	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/mark3labs/mcp-go/server"
+	"github.com/shurcooL/githubv4"
 )
 
 const (
@@ -44,10 +48,10 @@ func ListProjects(getClient GetClientFn, t translations.TranslationHelperFunc) (
 				mcp.Description(fmt.Sprintf("Results per page (max %d)", MaxProjectsPerPage)),
 			),
 			mcp.WithString("after",
-				mcp.Description("Forward pagination cursor from previous pageInfo.nextCursor."),
+				mcp.Description("Forward pagination cursor from previous page_info.end_cursor."),
 			),
 			mcp.WithString("before",
-				mcp.Description("Backward pagination cursor from previous pageInfo.prevCursor (rare)."),
+				mcp.Description("Backward pagination cursor from previous page_info.start_cursor (rare)."),
 			),
 		), func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			owner, err := RequiredParam[string](req, "owner")
@@ -108,10 +112,7 @@ func ListProjects(getClient GetClientFn, t translations.TranslationHelperFunc) (
 				minimalProjects = append(minimalProjects, *convertToMinimalProject(project))
 			}
 
-			response := map[string]any{
-				"projects": minimalProjects,
-				"pageInfo": buildPageInfo(resp),
-			}
+			response := NewListResponse("projects", minimalProjects, NewRESTPageInfo(resp), nil)
 
 			r, err := json.Marshal(response)
 			if err != nil {
@@ -144,7 +145,7 @@ func GetProject(getClient GetClientFn, t translations.TranslationHelperFunc) (to
 			),
 		), func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 
-			projectNumber, err := RequiredInt(req, "project_number")
+			projectNumber, err := ValidateProjectNumber(req)
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
@@ -222,10 +223,10 @@ func ListProjectFields(getClient GetClientFn, t translations.TranslationHelperFu
 				mcp.Description(fmt.Sprintf("Results per page (max %d)", MaxProjectsPerPage)),
 			),
 			mcp.WithString("after",
-				mcp.Description("Forward pagination cursor from previous pageInfo.nextCursor."),
+				mcp.Description("Forward pagination cursor from previous page_info.end_cursor."),
 			),
 			mcp.WithString("before",
-				mcp.Description("Backward pagination cursor from previous pageInfo.prevCursor (rare)."),
+				mcp.Description("Backward pagination cursor from previous page_info.start_cursor (rare)."),
 			),
 		), func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			owner, err := RequiredParam[string](req, "owner")
@@ -238,7 +239,7 @@ func ListProjectFields(getClient GetClientFn, t translations.TranslationHelperFu
 				return mcp.NewToolResultError(err.Error()), nil
 			}
 
-			projectNumber, err := RequiredInt(req, "project_number")
+			projectNumber, err := ValidateProjectNumber(req)
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
@@ -275,10 +276,7 @@ func ListProjectFields(getClient GetClientFn, t translations.TranslationHelperFu
 			}
 			defer func() { _ = resp.Body.Close() }()
 
-			response := map[string]any{
-				"fields":   projectFields,
-				"pageInfo": buildPageInfo(resp),
-			}
+			response := NewListResponse("fields", projectFields, NewRESTPageInfo(resp), nil)
 
 			r, err := json.Marshal(response)
 			if err != nil {
@@ -319,11 +317,11 @@ func GetProjectField(getClient GetClientFn, t translations.TranslationHelperFunc
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
-			projectNumber, err := RequiredInt(req, "project_number")
+			projectNumber, err := ValidateProjectNumber(req)
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
-			fieldID, err := RequiredBigInt(req, "field_id")
+			fieldID, err := ValidateProjectFieldID(req)
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
@@ -366,6 +364,154 @@ func GetProjectField(getClient GetClientFn, t translations.TranslationHelperFunc
 		}
 }
 
+// ProjectFieldSchema describes a project field and the values update_project_item accepts for it.
+type ProjectFieldSchema struct {
+	ID          int64                   `json:"id"`
+	Name        string                  `json:"name"`
+	DataType    string                  `json:"data_type"`
+	Updatable   bool                    `json:"updatable"`
+	ValueFormat string                  `json:"value_format"`
+	Options     []ProjectFieldOption    `json:"options,omitempty"`
+	Iterations  []ProjectFieldIteration `json:"iterations,omitempty"`
+}
+
+// ProjectFieldOption is a choice of a single select field.
+type ProjectFieldOption struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// ProjectFieldIteration is an iteration of an iteration field.
+type ProjectFieldIteration struct {
+	ID        string `json:"id"`
+	Title     string `json:"title"`
+	StartDate string `json:"start_date,omitempty"`
+	Duration  int    `json:"duration,omitempty"`
+}
+
+// projectFieldValueFormats describes the value update_project_item expects for each updatable field data type.
+var projectFieldValueFormats = map[string]string{
+	"text":          "string",
+	"number":        "number",
+	"date":          "date string in YYYY-MM-DD format",
+	"single_select": "option id (string) from options",
+	"iteration":     "iteration id (string) from iterations",
+}
+
+// convertToProjectFieldSchema reduces a project field to its ID, type and the values it accepts.
+func convertToProjectFieldSchema(field *github.ProjectV2Field) ProjectFieldSchema {
+	schema := ProjectFieldSchema{
+		ID:       field.GetID(),
+		Name:     field.GetName(),
+		DataType: field.GetDataType(),
+	}
+	if format, ok := projectFieldValueFormats[schema.DataType]; ok {
+		schema.Updatable = true
+		schema.ValueFormat = format
+	} else {
+		schema.ValueFormat = "read-only, cannot be set with update_project_item"
+	}
+	for _, option := range field.Options {
+		schema.Options = append(schema.Options, ProjectFieldOption{
+			ID:   option.GetID(),
+			Name: option.GetName().GetRaw(),
+		})
+	}
+	if field.Configuration != nil {
+		for _, iteration := range field.Configuration.Iterations {
+			schema.Iterations = append(schema.Iterations, ProjectFieldIteration{
+				ID:        iteration.GetID(),
+				Title:     iteration.GetTitle().GetRaw(),
+				StartDate: iteration.GetStartDate(),
+				Duration:  iteration.GetDuration(),
+			})
+		}
+	}
+	return schema
+}
+
+func DescribeProjectSchema(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("describe_project_schema",
+			mcp.WithDescription(t("TOOL_DESCRIBE_PROJECT_SCHEMA_DESCRIPTION", "Describe every field of a Project for a user or org in a compact schema: field IDs, data types, whether the field can be updated, the expected value format, and the IDs of single select options and iterations. Use it to build valid update_project_item calls.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_DESCRIBE_PROJECT_SCHEMA_USER_TITLE", "Describe project schema"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner_type",
+				mcp.Required(),
+				mcp.Description("Owner type"),
+				mcp.Enum("user", "org")),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("If owner_type == user it is the handle for the GitHub user account. If owner_type == org it is the name of the organization. The name is not case sensitive."),
+			),
+			mcp.WithNumber("project_number",
+				mcp.Required(),
+				mcp.Description("The project's number."),
+			),
+		), func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](req, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			ownerType, err := RequiredParam[string](req, "owner_type")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			projectNumber, err := ValidateProjectNumber(req)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			perPage := MaxProjectsPerPage
+			opts := &github.ListProjectsOptions{
+				ListProjectsPaginationOptions: github.ListProjectsPaginationOptions{PerPage: &perPage},
+			}
+			fields := []ProjectFieldSchema{}
+			for {
+				var resp *github.Response
+				var projectFields []*github.ProjectV2Field
+				if ownerType == "org" {
+					projectFields, resp, err = client.Projects.ListOrganizationProjectFields(ctx, owner, projectNumber, opts)
+				} else {
+					projectFields, resp, err = client.Projects.ListUserProjectFields(ctx, owner, projectNumber, opts)
+				}
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx,
+						"failed to list project fields",
+						resp,
+						err,
+					), nil
+				}
+				_ = resp.Body.Close()
+
+				for _, field := range projectFields {
+					fields = append(fields, convertToProjectFieldSchema(field))
+				}
+				if resp.After == "" {
+					break
+				}
+				after := resp.After
+				opts.After = &after
+			}
+
+			r, err := json.Marshal(map[string]any{
+				"project_number": projectNumber,
+				"fields":         fields,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
 func ListProjectItems(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
 	return mcp.NewTool("list_project_items",
 			mcp.WithDescription(t("TOOL_LIST_PROJECT_ITEMS_DESCRIPTION", `Search project items with advanced filtering`)),
@@ -392,15 +538,18 @@ func ListProjectItems(getClient GetClientFn, t translations.TranslationHelperFun
 				mcp.Description(fmt.Sprintf("Results per page (max %d)", MaxProjectsPerPage)),
 			),
 			mcp.WithString("after",
-				mcp.Description("Forward pagination cursor from previous pageInfo.nextCursor."),
+				mcp.Description("Forward pagination cursor from previous page_info.end_cursor."),
 			),
 			mcp.WithString("before",
-				mcp.Description("Backward pagination cursor from previous pageInfo.prevCursor (rare)."),
+				mcp.Description("Backward pagination cursor from previous page_info.start_cursor (rare)."),
 			),
 			mcp.WithArray("fields",
 				mcp.Description("Field IDs to include (e.g. [\"102589\", \"985201\"]). CRITICAL: Always provide to get field values. Without this, only titles returned."),
 				mcp.WithStringItems(),
 			),
+			mcp.WithString("group_by",
+				mcp.Description("Group every matching item by assignee, label, iteration or the name of a single select field such as Status, returning groups with their counts instead of a page of items. Items with several assignees or labels are in the group of each. Cannot be used with after or before."),
+			),
 		), func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			owner, err := RequiredParam[string](req, "owner")
 			if err != nil {
@@ -412,7 +561,7 @@ func ListProjectItems(getClient GetClientFn, t translations.TranslationHelperFun
 				return mcp.NewToolResultError(err.Error()), nil
 			}
 
-			projectNumber, err := RequiredInt(req, "project_number")
+			projectNumber, err := ValidateProjectNumber(req)
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
@@ -432,11 +581,42 @@ func ListProjectItems(getClient GetClientFn, t translations.TranslationHelperFun
 				return mcp.NewToolResultError(err.Error()), nil
 			}
 
+			groupBy, err := OptionalParam[string](req, "group_by")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if groupBy != "" && (pagination.After != nil || pagination.Before != nil) {
+				return mcp.NewToolResultError("after and before cannot be used with group_by, which groups every matching item"), nil
+			}
+
 			client, err := getClient(ctx)
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
 
+			if groupBy != "" {
+				projectFields, resp, err := listAllProjectFields(ctx, client, ownerType, owner, projectNumber)
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to list project fields", resp, err), nil
+				}
+				groupField, err := findProjectGroupField(projectFields, groupBy)
+				if err != nil {
+					return mcp.NewToolResultError(err.Error()), nil
+				}
+				if !slices.Contains(fields, groupField.GetID()) {
+					fields = append(fields, groupField.GetID())
+				}
+				items, resp, err := listAllProjectItemsMatching(ctx, client, ownerType, owner, projectNumber, queryStr, fields)
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx, ProjectListFailedError, resp, err), nil
+				}
+				return MarshalledTextResult(ProjectItemGroups{
+					GroupBy:    groupField.GetName(),
+					TotalCount: len(items),
+					Groups:     groupProjectItems(items, groupField),
+				}), nil
+			}
+
 			var resp *github.Response
 			var projectItems []*github.ProjectV2Item
 			var queryPtr *string
@@ -468,10 +648,7 @@ func ListProjectItems(getClient GetClientFn, t translations.TranslationHelperFun
 			}
 			defer func() { _ = resp.Body.Close() }()
 
-			response := map[string]any{
-				"items":    projectItems,
-				"pageInfo": buildPageInfo(resp),
-			}
+			response := NewListResponse("items", projectItems, NewRESTPageInfo(resp), nil)
 
 			r, err := json.Marshal(response)
 			if err != nil {
@@ -521,11 +698,11 @@ func GetProjectItem(getClient GetClientFn, t translations.TranslationHelperFunc)
 				return mcp.NewToolResultError(err.Error()), nil
 			}
 
-			projectNumber, err := RequiredInt(req, "project_number")
+			projectNumber, err := ValidateProjectNumber(req)
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
-			itemID, err := RequiredBigInt(req, "item_id")
+			itemID, err := ValidateProjectItemID(req)
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
@@ -573,12 +750,14 @@ func GetProjectItem(getClient GetClientFn, t translations.TranslationHelperFunc)
 		}
 }
 
-func AddProjectItem(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+func AddProjectItem(getClient GetClientFn, t translations.TranslationHelperFunc, defaultStatuses ProjectDefaultStatuses) (tool mcp.Tool, handler server.ToolHandlerFunc) {
 	return mcp.NewTool("add_project_item",
-			mcp.WithDescription(t("TOOL_ADD_PROJECT_ITEM_DESCRIPTION", "Add a specific Project item for a user or org")),
+			mcp.WithDescription(t("TOOL_ADD_PROJECT_ITEM_DESCRIPTION", "Add a specific Project item for a user or org. If the server configures a default status for the project, new items get that status.")),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
-				Title:        t("TOOL_ADD_PROJECT_ITEM_USER_TITLE", "Add project item"),
-				ReadOnlyHint: ToBoolPtr(false),
+				Title:           t("TOOL_ADD_PROJECT_ITEM_USER_TITLE", "Add project item"),
+				ReadOnlyHint:    ToBoolPtr(false),
+				DestructiveHint: ToBoolPtr(false),
+				IdempotentHint:  ToBoolPtr(true),
 			}),
 			mcp.WithString("owner_type",
 				mcp.Required(),
@@ -610,11 +789,11 @@ func AddProjectItem(getClient GetClientFn, t translations.TranslationHelperFunc)
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
-			projectNumber, err := RequiredInt(req, "project_number")
+			projectNumber, err := ValidateProjectNumber(req)
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
-			itemID, err := RequiredBigInt(req, "item_id")
+			itemID, err := ValidateProjectContentID(req)
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
@@ -662,6 +841,17 @@ func AddProjectItem(getClient GetClientFn, t translations.TranslationHelperFunc)
 				}
 				return mcp.NewToolResultError(fmt.Sprintf("%s: %s", ProjectAddFailedError, string(body))), nil
 			}
+
+			if status, ok := defaultStatuses.lookup(owner, projectNumber); ok {
+				if resp, err := setDefaultStatus(ctx, client, ownerType, owner, projectNumber, addedItem.GetID(), status); err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx,
+						fmt.Sprintf("item %d was added but its default status %q could not be set", addedItem.GetID(), status),
+						resp,
+						err,
+					), nil
+				}
+			}
+
 			r, err := json.Marshal(addedItem)
 			if err != nil {
 				return nil, fmt.Errorf("failed to marshal response: %w", err)
@@ -671,12 +861,14 @@ func AddProjectItem(getClient GetClientFn, t translations.TranslationHelperFunc)
 		}
 }
 
-func UpdateProjectItem(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+func UpdateProjectItem(getClient GetClientFn, getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
 	return mcp.NewTool("update_project_item",
 			mcp.WithDescription(t("TOOL_UPDATE_PROJECT_ITEM_DESCRIPTION", "Update a specific Project item for a user or org")),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
-				Title:        t("TOOL_UPDATE_PROJECT_ITEM_USER_TITLE", "Update project item"),
-				ReadOnlyHint: ToBoolPtr(false),
+				Title:           t("TOOL_UPDATE_PROJECT_ITEM_USER_TITLE", "Update project item"),
+				ReadOnlyHint:    ToBoolPtr(false),
+				DestructiveHint: ToBoolPtr(true),
+				IdempotentHint:  ToBoolPtr(true),
 			}),
 			mcp.WithString("owner_type",
 				mcp.Required(), mcp.Description("Owner type"),
@@ -696,8 +888,9 @@ func UpdateProjectItem(getClient GetClientFn, t translations.TranslationHelperFu
 			),
 			mcp.WithObject("updated_field",
 				mcp.Required(),
-				mcp.Description("Object consisting of the ID of the project field to update and the new value for the field. To clear the field, set value to null. Example: {\"id\": 123456, \"value\": \"New Value\"}"),
+				mcp.Description("Object consisting of the ID of the project field to update and the new value for the field. Single select options and iterations can be given by name or title, the iteration in progress as @current, and dates as YYYY-MM-DD. To clear a date, iteration, single select or other field, set value to null or \"\", or set clear to true instead of a value. Example: {\"id\": 123456, \"value\": \"New Value\"} or {\"id\": 123456, \"clear\": true}"),
 			),
+			WithExpectedUpdatedAt("item"),
 		), func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			owner, err := RequiredParam[string](req, "owner")
 			if err != nil {
@@ -707,11 +900,11 @@ func UpdateProjectItem(getClient GetClientFn, t translations.TranslationHelperFu
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
-			projectNumber, err := RequiredInt(req, "project_number")
+			projectNumber, err := ValidateProjectNumber(req)
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
-			itemID, err := RequiredBigInt(req, "item_id")
+			itemID, err := ValidateProjectItemID(req)
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
@@ -730,12 +923,42 @@ func UpdateProjectItem(getClient GetClientFn, t translations.TranslationHelperFu
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
+			expectedUpdatedAt, err := expectedUpdatedAtParam(req)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
 
 			client, err := getClient(ctx)
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
 
+			if !expectedUpdatedAt.IsZero() {
+				item, resp, err := getProjectItem(ctx, client, ownerType, owner, projectNumber, itemID, nil)
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get project item", resp, err), nil
+				}
+				_ = resp.Body.Close()
+				if result := updatedSinceResult("item", expectedUpdatedAt, item.GetUpdatedAt().Time, item); result != nil {
+					return result, nil
+				}
+			}
+
+			if updatePayload.Fields[0].Value == nil {
+				return clearProjectItemField(ctx, client, getGQLClient, ownerType, owner, projectNumber, itemID, updatePayload.Fields[0].ID)
+			}
+			// Option names, iteration titles and dates are given as strings, which the API only takes for text fields.
+			if text, ok := updatePayload.Fields[0].Value.(string); ok {
+				field, resp, err := getProjectField(ctx, client, ownerType, owner, projectNumber, updatePayload.Fields[0].ID)
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get project field", resp, err), nil
+				}
+				_ = resp.Body.Close()
+				if updatePayload.Fields[0].Value, err = projectFieldValue(field, text); err != nil {
+					return mcp.NewToolResultError(err.Error()), nil
+				}
+			}
+
 			var resp *github.Response
 			var updatedItem *github.ProjectV2Item
 
@@ -770,12 +993,68 @@ func UpdateProjectItem(getClient GetClientFn, t translations.TranslationHelperFu
 		}
 }
 
+// clearProjectItemField clears the value of a field of a project item with the clearProjectV2ItemFieldValue mutation,
+// which unlike the REST API clears date, iteration and single select fields, and returns the item.
+func clearProjectItemField(ctx context.Context, client *github.Client, getGQLClient GetGQLClientFn, ownerType, owner string, projectNumber int, itemID, fieldID int64) (*mcp.CallToolResult, error) {
+	gqlClient, err := getGQLClient(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get GitHub GraphQL client: %w", err)
+	}
+
+	project, resp, err := getProjectV2(ctx, client, ownerType, owner, projectNumber)
+	if err != nil {
+		return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get project", resp, err), nil
+	}
+	_ = resp.Body.Close()
+	item, resp, err := getProjectItem(ctx, client, ownerType, owner, projectNumber, itemID, nil)
+	if err != nil {
+		return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get project item", resp, err), nil
+	}
+	_ = resp.Body.Close()
+	field, resp, err := getProjectField(ctx, client, ownerType, owner, projectNumber, fieldID)
+	if err != nil {
+		return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get project field", resp, err), nil
+	}
+	_ = resp.Body.Close()
+
+	if err := clearProjectV2ItemFieldValue(ctx, gqlClient, project.GetNodeID(), item.GetNodeID(), field.GetNodeID()); err != nil {
+		return ghErrors.NewGitHubGraphQLErrorResponse(ctx, ProjectUpdateFailedError, err), nil
+	}
+
+	item, resp, err = getProjectItem(ctx, client, ownerType, owner, projectNumber, itemID, nil)
+	if err != nil {
+		return ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("field %d of item %d was cleared but the item could not be read back", fieldID, itemID), resp, err), nil
+	}
+	_ = resp.Body.Close()
+	return MarshalledTextResult(item), nil
+}
+
+// clearProjectV2ItemFieldValue clears the value of a field of a project item, given the node IDs of the project, the
+// item and the field.
+func clearProjectV2ItemFieldValue(ctx context.Context, client *githubv4.Client, projectID, itemID, fieldID string) error {
+	var mutation struct {
+		ClearProjectV2ItemFieldValue struct {
+			ProjectV2Item struct {
+				ID githubv4.ID
+			}
+		} `graphql:"clearProjectV2ItemFieldValue(input: $input)"`
+	}
+	input := githubv4.ClearProjectV2ItemFieldValueInput{
+		ProjectID: githubv4.ID(projectID),
+		ItemID:    githubv4.ID(itemID),
+		FieldID:   githubv4.ID(fieldID),
+	}
+	return client.Mutate(ctx, &mutation, input, nil)
+}
+
 func DeleteProjectItem(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
 	return mcp.NewTool("delete_project_item",
-			mcp.WithDescription(t("TOOL_DELETE_PROJECT_ITEM_DESCRIPTION", "Delete a specific Project item for a user or org")),
+			mcp.WithDescription(t("TOOL_DELETE_PROJECT_ITEM_DESCRIPTION", "Delete a specific Project item for a user or org, or archive it to hide it from the project's views while keeping its field values. Archived items can be brought back with the unarchive action.")),
 			mcp.WithToolAnnotation(mcp.ToolAnnotation{
-				Title:        t("TOOL_DELETE_PROJECT_ITEM_USER_TITLE", "Delete project item"),
-				ReadOnlyHint: ToBoolPtr(false),
+				Title:           t("TOOL_DELETE_PROJECT_ITEM_USER_TITLE", "Delete project item"),
+				ReadOnlyHint:    ToBoolPtr(false),
+				DestructiveHint: ToBoolPtr(true),
+				IdempotentHint:  ToBoolPtr(true),
 			}),
 			mcp.WithString("owner_type",
 				mcp.Required(),
@@ -794,6 +1073,11 @@ func DeleteProjectItem(getClient GetClientFn, t translations.TranslationHelperFu
 				mcp.Required(),
 				mcp.Description("The internal project item ID to delete from the project (not the issue or pull request ID)."),
 			),
+			mcp.WithString("action",
+				mcp.Description("Whether to delete the item, archive it, or unarchive an archived item"),
+				mcp.Enum("delete", "archive", "unarchive"),
+				mcp.DefaultString("delete"),
+			),
 		), func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			owner, err := RequiredParam[string](req, "owner")
 			if err != nil {
@@ -803,19 +1087,35 @@ func DeleteProjectItem(getClient GetClientFn, t translations.TranslationHelperFu
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
-			projectNumber, err := RequiredInt(req, "project_number")
+			projectNumber, err := ValidateProjectNumber(req)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			itemID, err := ValidateProjectItemID(req)
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
-			itemID, err := RequiredBigInt(req, "item_id")
+			action, err := OptionalParam[string](req, "action")
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
+			if action != "" && action != "delete" && action != "archive" && action != "unarchive" {
+				return mcp.NewToolResultError(fmt.Sprintf("invalid action %q: expected delete, archive or unarchive", action)), nil
+			}
 			client, err := getClient(ctx)
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
 
+			if action == "archive" || action == "unarchive" {
+				_, resp, err := updateProjectItem(ctx, client, ownerType, owner, projectNumber, itemID, &github.UpdateProjectItemOptions{Archived: github.Ptr(action == "archive")})
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("failed to %s project item", action), resp, err), nil
+				}
+				_ = resp.Body.Close()
+				return mcp.NewToolResultText(fmt.Sprintf("project item successfully %sd", action)), nil
+			}
+
 			var resp *github.Response
 			if ownerType == "org" {
 				resp, err = client.Projects.DeleteOrganizationProjectItem(ctx, owner, projectNumber, itemID)
@@ -843,13 +1143,6 @@ func DeleteProjectItem(getClient GetClientFn, t translations.TranslationHelperFu
 		}
 }
 
-type pageInfo struct {
-	HasNextPage     bool   `json:"hasNextPage"`
-	HasPreviousPage bool   `json:"hasPreviousPage"`
-	NextCursor      string `json:"nextCursor,omitempty"`
-	PrevCursor      string `json:"prevCursor,omitempty"`
-}
-
 func toNewProjectType(projType string) string {
 	switch strings.ToLower(projType) {
 	case "issue":
@@ -861,62 +1154,203 @@ func toNewProjectType(projType string) string {
 	}
 }
 
-// validateAndConvertToInt64 ensures the value is a number and converts it to int64.
-func validateAndConvertToInt64(value any) (int64, error) {
+// projectIDFormat describes the format of one kind of numeric project identifier, so that malformed values can be
+// rejected with a message explaining where to find the right one.
+type projectIDFormat struct {
+	description  string
+	nodePrefixes []string
+	hint         string
+}
+
+var (
+	projectNumberFormat = projectIDFormat{
+		description:  "the project's number as shown in its URL, e.g. 5 for https://github.com/orgs/octo-org/projects/5",
+		nodePrefixes: []string{"PVT_"},
+		hint:         "use list_projects to find it",
+	}
+	projectFieldIDFormat = projectIDFormat{
+		description:  "the numeric ID of the project field, e.g. 229416",
+		nodePrefixes: []string{"PVTF_", "PVTSSF_", "PVTIF_"},
+		hint:         "use list_project_fields or describe_project_schema to find it",
+	}
+	projectItemIDFormat = projectIDFormat{
+		description:  "the numeric ID of the project item, e.g. 135086",
+		nodePrefixes: []string{"PVTI_"},
+		hint:         "use list_project_items to find it",
+	}
+	projectContentIDFormat = projectIDFormat{
+		description:  "the numeric ID of the issue or pull request, not its number, e.g. 1347",
+		nodePrefixes: []string{"I_", "PR_"},
+		hint:         "use the id returned by get_issue or get_pull_request",
+	}
+)
+
+// validateProjectIDValue checks that value is a positive integer in the given format. Numeric strings are accepted;
+// GraphQL node IDs and other strings are rejected with a message describing the expected format.
+func validateProjectIDValue(p string, value any, format projectIDFormat) (int64, error) {
+	invalid := func(got string) error {
+		return fmt.Errorf("invalid %s %s: expected %s; %s", p, got, format.description, format.hint)
+	}
 	switch v := value.(type) {
 	case float64:
-		// Validate that the float64 can be safely converted to int64
-		intVal := int64(v)
-		if float64(intVal) != v {
-			return 0, fmt.Errorf("value must be a valid integer (got %v)", v)
+		id := int64(v)
+		if float64(id) != v || id <= 0 {
+			return 0, invalid(fmt.Sprintf("%v", v))
 		}
-		return intVal, nil
-	case int64:
-		return v, nil
-	case int:
-		return int64(v), nil
+		return id, nil
+	case string:
+		for _, prefix := range format.nodePrefixes {
+			if strings.HasPrefix(v, prefix) {
+				return 0, fmt.Errorf("invalid %s %q: this is a GraphQL node ID, expected %s; %s", p, v, format.description, format.hint)
+			}
+		}
+		id, err := strconv.ParseInt(strings.TrimSpace(v), 10, 64)
+		if err != nil || id <= 0 {
+			return 0, invalid(fmt.Sprintf("%q", v))
+		}
+		return id, nil
 	default:
-		return 0, fmt.Errorf("value must be a number (got %T)", v)
+		return 0, invalid(fmt.Sprintf("of type %T", v))
+	}
+}
+
+func requiredProjectIDParam(r mcp.CallToolRequest, p string, format projectIDFormat) (int64, error) {
+	value, ok := r.GetArguments()[p]
+	if !ok || value == nil {
+		return 0, fmt.Errorf("missing required parameter: %s", p)
 	}
+	return validateProjectIDValue(p, value, format)
 }
 
-// buildUpdateProjectItem constructs UpdateProjectItemOptions from the input map.
+// ValidateProjectNumber returns the project_number parameter of a project tool, failing with a description of the
+// expected format when it is missing or malformed.
+func ValidateProjectNumber(r mcp.CallToolRequest) (int, error) {
+	number, err := requiredProjectIDParam(r, "project_number", projectNumberFormat)
+	if err != nil {
+		return 0, err
+	}
+	return int(number), nil
+}
+
+// ValidateProjectFieldID returns the field_id parameter of a project tool, failing with a description of the
+// expected format when it is missing or malformed.
+func ValidateProjectFieldID(r mcp.CallToolRequest) (int64, error) {
+	return requiredProjectIDParam(r, "field_id", projectFieldIDFormat)
+}
+
+// ValidateProjectItemID returns the item_id parameter of a project tool, failing with a description of the
+// expected format when it is missing or malformed.
+func ValidateProjectItemID(r mcp.CallToolRequest) (int64, error) {
+	return requiredProjectIDParam(r, "item_id", projectItemIDFormat)
+}
+
+// ValidateProjectContentID returns the item_id parameter of add_project_item, the ID of the issue or pull request to
+// add, failing with a description of the expected format when it is missing or malformed.
+func ValidateProjectContentID(r mcp.CallToolRequest) (int64, error) {
+	return requiredProjectIDParam(r, "item_id", projectContentIDFormat)
+}
+
+// buildUpdateProjectItem constructs UpdateProjectItemOptions from the input map. The value of the field is nil when the
+// field should be cleared.
 func buildUpdateProjectItem(input map[string]any) (*github.UpdateProjectItemOptions, error) {
 	if input == nil {
 		return nil, fmt.Errorf("updated_field must be an object")
 	}
 
+	field, err := projectFieldUpdate("updated_field", input)
+	if err != nil {
+		return nil, err
+	}
+
+	payload := &github.UpdateProjectItemOptions{
+		Fields: []*github.UpdateProjectV2Field{field},
+	}
+
+	return payload, nil
+}
+
+// projectFieldUpdate decodes an object of the field ID and the new value, or clear, given as parameter p. The value is
+// nil when the field should be cleared.
+func projectFieldUpdate(p string, input map[string]any) (*github.UpdateProjectV2Field, error) {
 	idField, ok := input["id"]
 	if !ok {
-		return nil, fmt.Errorf("updated_field.id is required")
+		return nil, fmt.Errorf("%s.id is required", p)
 	}
 
-	fieldID, err := validateAndConvertToInt64(idField)
+	fieldID, err := validateProjectIDValue(p+".id", idField, projectFieldIDFormat)
 	if err != nil {
-		return nil, fmt.Errorf("updated_field.id: %w", err)
+		return nil, err
 	}
 
-	valueField, ok := input["value"]
-	if !ok {
-		return nil, fmt.Errorf("updated_field.value is required")
+	clearField, ok := input["clear"].(bool)
+	if _, exists := input["clear"]; exists && !ok {
+		return nil, fmt.Errorf("%s.clear must be a boolean", p)
 	}
-
-	payload := &github.UpdateProjectItemOptions{
-		Fields: []*github.UpdateProjectV2Field{{
-			ID:    fieldID,
-			Value: valueField,
-		}},
+	valueField, hasValue := input["value"]
+	switch {
+	case clearField && valueField != nil && valueField != "":
+		return nil, fmt.Errorf("%s.value cannot be given with %s.clear", p, p)
+	case clearField, valueField == "":
+		// An empty value clears the field, like null.
+		valueField = nil
+	case !hasValue:
+		return nil, fmt.Errorf("%s.value is required", p)
 	}
 
-	return payload, nil
+	return &github.UpdateProjectV2Field{ID: fieldID, Value: valueField}, nil
 }
 
-func buildPageInfo(resp *github.Response) pageInfo {
-	return pageInfo{
-		HasNextPage:     resp.After != "",
-		HasPreviousPage: resp.Before != "",
-		NextCursor:      resp.After,
-		PrevCursor:      resp.Before,
+// projectFieldValue translates a string given as the value of a field of a project into the value the API expects for
+// the field's data type: the ID of a single select option or iteration given by ID, name or title, or of the iteration
+// in progress given as @current, a date as YYYY-MM-DD, or a number. Values of other fields are left as they are.
+func projectFieldValue(field *github.ProjectV2Field, text string) (any, error) {
+	switch field.GetDataType() {
+	case "single_select":
+		names := make([]string, 0, len(field.Options))
+		for _, option := range field.Options {
+			if option.GetID() == text || strings.EqualFold(option.GetName().GetRaw(), text) {
+				return option.GetID(), nil
+			}
+			names = append(names, option.GetName().GetRaw())
+		}
+		return nil, fmt.Errorf("%q is not an option of field %s; options are: %s", text, field.GetName(), strings.Join(names, ", "))
+	case "iteration":
+		var titles []string
+		if field.Configuration != nil {
+			if strings.EqualFold(text, "@current") {
+				today := time.Now().UTC().Format(time.DateOnly)
+				for _, iteration := range field.Configuration.Iterations {
+					start, err := time.Parse(time.DateOnly, iteration.GetStartDate())
+					if err == nil && iteration.GetStartDate() <= today && today < start.AddDate(0, 0, iteration.GetDuration()).Format(time.DateOnly) {
+						return iteration.GetID(), nil
+					}
+				}
+				return nil, fmt.Errorf("field %s has no iteration in progress today", field.GetName())
+			}
+			for _, iteration := range field.Configuration.Iterations {
+				if iteration.GetID() == text || strings.EqualFold(iteration.GetTitle().GetRaw(), text) {
+					return iteration.GetID(), nil
+				}
+				titles = append(titles, iteration.GetTitle().GetRaw())
+			}
+		}
+		return nil, fmt.Errorf("%q is not a current or upcoming iteration of field %s; iterations are: %s", text, field.GetName(), strings.Join(titles, ", "))
+	case "date":
+		if date, err := time.Parse(time.DateOnly, text); err == nil {
+			return date.Format(time.DateOnly), nil
+		}
+		if timestamp, err := time.Parse(time.RFC3339, text); err == nil {
+			return timestamp.Format(time.DateOnly), nil
+		}
+		return nil, fmt.Errorf("field %s is a date field: expected a date such as 2025-01-31, got %q", field.GetName(), text)
+	case "number":
+		number, err := strconv.ParseFloat(strings.TrimSpace(text), 64)
+		if err != nil {
+			return nil, fmt.Errorf("field %s is a number field: expected a number, got %q", field.GetName(), text)
+		}
+		return number, nil
+	default:
+		return text, nil
 	}
 }
 
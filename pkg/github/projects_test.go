@@ -6,11 +6,15 @@ import (
 	"io"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/ghmock"
 	"github.com/github/github-mcp-server/pkg/translations"
 	gh "github.com/google/go-github/v79/github"
+	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/shurcooL/githubv4"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -166,8 +170,8 @@ func Test_ListProjects(t *testing.T) {
 			projects, ok := response["projects"].([]interface{})
 			require.True(t, ok)
 			assert.Equal(t, tc.expectedLength, len(projects))
-			// pageInfo should exist
-			_, hasPageInfo := response["pageInfo"].(map[string]interface{})
+			// page_info should exist
+			_, hasPageInfo := response["page_info"].(map[string]interface{})
 			assert.True(t, hasPageInfo)
 		})
 	}
@@ -450,7 +454,7 @@ func Test_ListProjectFields(t *testing.T) {
 			fields, ok := response["fields"].([]interface{})
 			require.True(t, ok)
 			assert.Equal(t, tc.expectedLength, len(fields))
-			_, hasPageInfo := response["pageInfo"].(map[string]interface{})
+			_, hasPageInfo := response["page_info"].(map[string]interface{})
 			assert.True(t, hasPageInfo)
 		})
 	}
@@ -612,6 +616,128 @@ func Test_GetProjectField(t *testing.T) {
 	}
 }
 
+func Test_DescribeProjectSchema(t *testing.T) {
+	mockClient := gh.NewClient(nil)
+	tool, _ := DescribeProjectSchema(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "describe_project_schema", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner_type")
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "project_number")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner_type", "owner", "project_number"})
+
+	firstPage := []map[string]any{
+		{"id": 101, "name": "Title", "data_type": "title"},
+		{
+			"id": 102, "name": "Status", "data_type": "single_select",
+			"options": []map[string]any{
+				{"id": "f75ad846", "name": map[string]any{"raw": "Todo", "html": "Todo"}, "color": "GRAY"},
+				{"id": "47fc9ee4", "name": map[string]any{"raw": "Done", "html": "Done"}, "color": "GREEN"},
+			},
+		},
+	}
+	secondPage := []map[string]any{
+		{
+			"id": 103, "name": "Sprint", "data_type": "iteration",
+			"configuration": map[string]any{
+				"duration": 14,
+				"iterations": []map[string]any{
+					{"id": "c2b3", "title": map[string]any{"raw": "Sprint 1"}, "start_date": "2025-01-06", "duration": 14},
+				},
+			},
+		},
+		{"id": 104, "name": "Due", "data_type": "date"},
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedFields []ProjectFieldSchema
+		expectedErrMsg string
+	}{
+		{
+			name: "all pages of organization fields",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.EndpointPattern{Pattern: "/orgs/{org}/projectsV2/{project}/fields", Method: http.MethodGet},
+					http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+						if r.URL.Query().Get("after") == "cursor-2" {
+							w.WriteHeader(http.StatusOK)
+							_, _ = w.Write(mock.MustMarshal(secondPage))
+							return
+						}
+						w.Header().Set("Link", `<https://api.github.com/orgs/octo-org/projectsV2/1/fields?after=cursor-2>; rel="next"`)
+						w.WriteHeader(http.StatusOK)
+						_, _ = w.Write(mock.MustMarshal(firstPage))
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":          "octo-org",
+				"owner_type":     "org",
+				"project_number": float64(1),
+			},
+			expectedFields: []ProjectFieldSchema{
+				{ID: 101, Name: "Title", DataType: "title", ValueFormat: "read-only, cannot be set with update_project_item"},
+				{
+					ID: 102, Name: "Status", DataType: "single_select", Updatable: true, ValueFormat: "option id (string) from options",
+					Options: []ProjectFieldOption{{ID: "f75ad846", Name: "Todo"}, {ID: "47fc9ee4", Name: "Done"}},
+				},
+				{
+					ID: 103, Name: "Sprint", DataType: "iteration", Updatable: true, ValueFormat: "iteration id (string) from iterations",
+					Iterations: []ProjectFieldIteration{{ID: "c2b3", Title: "Sprint 1", StartDate: "2025-01-06", Duration: 14}},
+				},
+				{ID: 104, Name: "Due", DataType: "date", Updatable: true, ValueFormat: "date string in YYYY-MM-DD format"},
+			},
+		},
+		{
+			name: "api error",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.EndpointPattern{Pattern: "/users/{user}/projectsV2/{project}/fields", Method: http.MethodGet},
+					mockResponse(t, http.StatusNotFound, map[string]string{"message": "Not Found"}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":          "octocat",
+				"owner_type":     "user",
+				"project_number": float64(2),
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to list project fields",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := gh.NewClient(tc.mockedClient)
+			_, handler := DescribeProjectSchema(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+			require.NoError(t, err)
+
+			if tc.expectError {
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			textContent := getTextResult(t, result)
+			var response struct {
+				ProjectNumber int                  `json:"project_number"`
+				Fields        []ProjectFieldSchema `json:"fields"`
+			}
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &response))
+			assert.Equal(t, 1, response.ProjectNumber)
+			assert.Equal(t, tc.expectedFields, response.Fields)
+		})
+	}
+}
+
 func Test_ListProjectItems(t *testing.T) {
 	mockClient := gh.NewClient(nil)
 	tool, _ := ListProjectItems(stubGetClientFn(mockClient), translations.NullTranslationHelper)
@@ -806,7 +932,7 @@ func Test_ListProjectItems(t *testing.T) {
 			items, ok := response["items"].([]interface{})
 			require.True(t, ok)
 			assert.Equal(t, tc.expectedLength, len(items))
-			_, hasPageInfo := response["pageInfo"].(map[string]interface{})
+			_, hasPageInfo := response["page_info"].(map[string]interface{})
 			assert.True(t, hasPageInfo)
 		})
 	}
@@ -962,6 +1088,18 @@ func Test_GetProjectItem(t *testing.T) {
 			},
 			expectError: true,
 		},
+		{
+			name:         "item node ID instead of numeric ID",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]any{
+				"owner":          "octo-org",
+				"owner_type":     "org",
+				"project_number": float64(10),
+				"item_id":        "PVTI_lADOANN5s84ACbL0zgBueEI",
+			},
+			expectError:    true,
+			expectedErrMsg: `invalid item_id "PVTI_lADOANN5s84ACbL0zgBueEI": this is a GraphQL node ID`,
+		},
 	}
 
 	for _, tc := range tests {
@@ -1007,7 +1145,7 @@ func Test_GetProjectItem(t *testing.T) {
 
 func Test_AddProjectItem(t *testing.T) {
 	mockClient := gh.NewClient(nil)
-	tool, _ := AddProjectItem(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	tool, _ := AddProjectItem(stubGetClientFn(mockClient), translations.NullTranslationHelper, nil)
 	require.NoError(t, toolsnaps.Test(tool.Name, tool))
 
 	assert.Equal(t, "add_project_item", tool.Name)
@@ -1191,7 +1329,7 @@ func Test_AddProjectItem(t *testing.T) {
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
 			client := gh.NewClient(tc.mockedClient)
-			_, handler := AddProjectItem(stubGetClientFn(client), translations.NullTranslationHelper)
+			_, handler := AddProjectItem(stubGetClientFn(client), translations.NullTranslationHelper, nil)
 			request := createMCPRequest(tc.requestArgs)
 
 			result, err := handler(context.Background(), request)
@@ -1241,7 +1379,7 @@ func Test_AddProjectItem(t *testing.T) {
 
 func Test_UpdateProjectItem(t *testing.T) {
 	mockClient := gh.NewClient(nil)
-	tool, _ := UpdateProjectItem(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	tool, _ := UpdateProjectItem(stubGetClientFn(mockClient), stubGetGQLClientFn(githubv4.NewClient(nil)), translations.NullTranslationHelper)
 	require.NoError(t, toolsnaps.Test(tool.Name, tool))
 
 	assert.Equal(t, "update_project_item", tool.Name)
@@ -1273,6 +1411,10 @@ func Test_UpdateProjectItem(t *testing.T) {
 		{
 			name: "success organization update",
 			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.EndpointPattern{Pattern: "/orgs/{org}/projectsV2/{project}/fields/{field_id}", Method: http.MethodGet},
+					map[string]any{"id": 101, "name": "Notes", "data_type": "text"},
+				),
 				mock.WithRequestMatchHandler(
 					mock.EndpointPattern{Pattern: "/orgs/{org}/projectsV2/{project}/items/{item_id}", Method: http.MethodPatch},
 					http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -1343,6 +1485,10 @@ func Test_UpdateProjectItem(t *testing.T) {
 		{
 			name: "api error",
 			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(
+					mock.EndpointPattern{Pattern: "/orgs/{org}/projectsV2/{project}/fields/{field_id}", Method: http.MethodGet},
+					map[string]any{"id": 303, "name": "Notes", "data_type": "text"},
+				),
 				mock.WithRequestMatchHandler(
 					mock.EndpointPattern{Pattern: "/orgs/{org}/projectsV2/{project}/items/{item_id}", Method: http.MethodPatch},
 					mockResponse(t, http.StatusInternalServerError, map[string]string{"message": "boom"}),
@@ -1466,12 +1612,28 @@ func Test_UpdateProjectItem(t *testing.T) {
 			},
 			expectError: true,
 		},
+		{
+			name:         "updated_field value and clear",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]any{
+				"owner":          "octo-org",
+				"owner_type":     "org",
+				"project_number": float64(1),
+				"item_id":        float64(2),
+				"updated_field": map[string]any{
+					"id":    float64(9),
+					"value": "Done",
+					"clear": true,
+				},
+			},
+			expectError: true,
+		},
 	}
 
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
 			client := gh.NewClient(tc.mockedClient)
-			_, handler := UpdateProjectItem(stubGetClientFn(client), translations.NullTranslationHelper)
+			_, handler := UpdateProjectItem(stubGetClientFn(client), stubGetGQLClientFn(githubv4.NewClient(nil)), translations.NullTranslationHelper)
 			request := createMCPRequest(tc.requestArgs)
 			result, err := handler(context.Background(), request)
 
@@ -1499,6 +1661,8 @@ func Test_UpdateProjectItem(t *testing.T) {
 					assert.Contains(t, text, "updated_field.id is required")
 				case "updated_field missing value":
 					assert.Contains(t, text, "updated_field.value is required")
+				case "updated_field value and clear":
+					assert.Contains(t, text, "updated_field.value cannot be given with updated_field.clear")
 				}
 				return
 			}
@@ -1663,3 +1827,312 @@ func Test_DeleteProjectItem(t *testing.T) {
 		})
 	}
 }
+
+func Test_ProjectItemLifecycle(t *testing.T) {
+	server := ghmock.New(t)
+	server.Respond("POST /orgs/{org}/projectsV2/{project}/items", http.StatusCreated, map[string]any{"id": 9001, "content_type": "Issue"})
+	server.Respond("GET /orgs/{org}/projectsV2/{project}/fields/{field}", http.StatusOK, map[string]any{"id": 101, "name": "Notes", "data_type": "text"})
+	server.Respond("PATCH /orgs/{org}/projectsV2/{project}/items/{item}", http.StatusOK, map[string]any{"id": 9001})
+	server.Respond("DELETE /orgs/{org}/projectsV2/{project}/items/{item}", http.StatusNoContent, nil)
+
+	_, addItem := AddProjectItem(server.GetClient(), translations.NullTranslationHelper, nil)
+	_, updateItem := UpdateProjectItem(server.GetClient(), server.GetGQLClient(), translations.NullTranslationHelper)
+	_, deleteItem := DeleteProjectItem(server.GetClient(), translations.NullTranslationHelper)
+	project := map[string]any{"owner": "octo-org", "owner_type": "org", "project_number": float64(7)}
+	with := func(args map[string]any) map[string]any {
+		merged := map[string]any{}
+		for k, v := range project {
+			merged[k] = v
+		}
+		for k, v := range args {
+			merged[k] = v
+		}
+		return merged
+	}
+
+	result := ghmock.CallTool(t, addItem, with(map[string]any{"item_type": "issue", "item_id": float64(1234)}))
+	require.False(t, result.IsError, ghmock.ResultText(t, result))
+	var added map[string]any
+	require.NoError(t, server.AssertRequested("POST /orgs/octo-org/projectsV2/7/items").DecodeBody(&added))
+	assert.Equal(t, map[string]any{"type": "Issue", "id": float64(1234)}, added)
+
+	result = ghmock.CallTool(t, updateItem, with(map[string]any{
+		"item_id":       float64(9001),
+		"updated_field": map[string]any{"id": float64(101), "value": "In Progress"},
+	}))
+	require.False(t, result.IsError, ghmock.ResultText(t, result))
+	var updated map[string]any
+	require.NoError(t, server.AssertRequested("PATCH /orgs/octo-org/projectsV2/7/items/9001").DecodeBody(&updated))
+	assert.Equal(t, map[string]any{"fields": []any{map[string]any{"id": float64(101), "value": "In Progress"}}}, updated)
+
+	result = ghmock.CallTool(t, deleteItem, with(map[string]any{"item_id": float64(9001)}))
+	require.False(t, result.IsError, ghmock.ResultText(t, result))
+	server.AssertRequested("DELETE /orgs/octo-org/projectsV2/7/items/9001")
+	assert.Len(t, server.Requests(), 4)
+}
+
+func Test_DeleteProjectItem_Archive(t *testing.T) {
+	for action, archived := range map[string]bool{"archive": true, "unarchive": false} {
+		t.Run(action, func(t *testing.T) {
+			server := ghmock.New(t)
+			server.Respond("PATCH /users/octocat/projectsV2/1/items/801", http.StatusOK, map[string]any{"id": 801})
+			_, handler := DeleteProjectItem(server.GetClient(), translations.NullTranslationHelper)
+			result := ghmock.CallTool(t, handler, map[string]any{
+				"owner_type": "user", "owner": "octocat", "project_number": float64(1), "item_id": float64(801), "action": action,
+			})
+			require.False(t, result.IsError, ghmock.ResultText(t, result))
+			assert.Equal(t, "project item successfully "+action+"d", ghmock.ResultText(t, result))
+
+			var body map[string]any
+			require.NoError(t, server.AssertRequested("PATCH /users/octocat/projectsV2/1/items/801").DecodeBody(&body))
+			assert.Equal(t, map[string]any{"archived": archived}, body)
+			server.AssertNotRequested(http.MethodDelete)
+		})
+	}
+
+	t.Run("failure", func(t *testing.T) {
+		server := ghmock.New(t)
+		server.Respond("PATCH /orgs/octo/projectsV2/1/items/801", http.StatusNotFound, map[string]any{"message": "Not Found"})
+		_, handler := DeleteProjectItem(server.GetClient(), translations.NullTranslationHelper)
+		result := ghmock.CallTool(t, handler, map[string]any{
+			"owner_type": "org", "owner": "octo", "project_number": float64(1), "item_id": float64(801), "action": "unarchive",
+		})
+		require.True(t, result.IsError)
+		assert.Contains(t, ghmock.ResultText(t, result), "failed to unarchive project item")
+	})
+
+	t.Run("invalid action", func(t *testing.T) {
+		server := ghmock.New(t)
+		_, handler := DeleteProjectItem(server.GetClient(), translations.NullTranslationHelper)
+		result := ghmock.CallTool(t, handler, map[string]any{
+			"owner_type": "org", "owner": "octo", "project_number": float64(1), "item_id": float64(801), "action": "restore",
+		})
+		require.True(t, result.IsError)
+		assert.Equal(t, `invalid action "restore": expected delete, archive or unarchive`, ghmock.ResultText(t, result))
+		assert.Empty(t, server.Requests())
+	})
+}
+
+func Test_UpdateProjectItem_Clear(t *testing.T) {
+	for name, updatedField := range map[string]map[string]any{
+		"null value":  {"id": float64(12), "value": nil},
+		"empty value": {"id": float64(12), "value": ""},
+		"clear":       {"id": float64(12), "clear": true},
+	} {
+		t.Run(name, func(t *testing.T) {
+			server := ghmock.New(t)
+			server.Respond("GET /orgs/octo/projectsV2/1", http.StatusOK, map[string]any{"id": 1, "node_id": "PVT_1"})
+			server.Respond("GET /orgs/octo/projectsV2/1/items/801", http.StatusOK, map[string]any{"id": 801, "node_id": "PVTI_801", "content_type": "Issue"})
+			server.Respond("GET /orgs/octo/projectsV2/1/fields/12", http.StatusOK, map[string]any{"id": 12, "node_id": "PVTF_12", "name": "Due", "data_type": "date"})
+			server.RespondGraphQL("clearProjectV2ItemFieldValue(", map[string]any{
+				"clearProjectV2ItemFieldValue": map[string]any{"projectV2Item": map[string]any{"id": "PVTI_801"}},
+			})
+
+			_, handler := UpdateProjectItem(server.GetClient(), server.GetGQLClient(), translations.NullTranslationHelper)
+			result := ghmock.CallTool(t, handler, map[string]any{
+				"owner_type": "org", "owner": "octo", "project_number": float64(1), "item_id": float64(801),
+				"updated_field": updatedField,
+			})
+			require.False(t, result.IsError, ghmock.ResultText(t, result))
+
+			assert.Equal(t, map[string]any{"projectId": "PVT_1", "itemId": "PVTI_801", "fieldId": "PVTF_12"},
+				server.AssertGraphQL("clearProjectV2ItemFieldValue(").Variables["input"])
+			server.AssertNotRequested("PATCH")
+			var item gh.ProjectV2Item
+			require.NoError(t, json.Unmarshal([]byte(ghmock.ResultText(t, result)), &item))
+			assert.Equal(t, int64(801), item.GetID())
+		})
+	}
+
+	t.Run("mutation error", func(t *testing.T) {
+		server := ghmock.New(t)
+		server.Respond("GET /users/octocat/projectsV2/1", http.StatusOK, map[string]any{"id": 1, "node_id": "PVT_1"})
+		server.Respond("GET /users/octocat/projectsV2/1/items/801", http.StatusOK, map[string]any{"id": 801, "node_id": "PVTI_801"})
+		server.Respond("GET /users/octocat/projectsV2/1/fields/12", http.StatusOK, map[string]any{"id": 12, "node_id": "PVTF_12"})
+		server.RespondGraphQLError("clearProjectV2ItemFieldValue(", "Field cannot be cleared")
+
+		_, handler := UpdateProjectItem(server.GetClient(), server.GetGQLClient(), translations.NullTranslationHelper)
+		result := ghmock.CallTool(t, handler, map[string]any{
+			"owner_type": "user", "owner": "octocat", "project_number": float64(1), "item_id": float64(801),
+			"updated_field": map[string]any{"id": float64(12), "clear": true},
+		})
+		require.True(t, result.IsError)
+		assert.Contains(t, ghmock.ResultText(t, result), "failed to update a project item")
+	})
+}
+
+func Test_ProjectFieldValue(t *testing.T) {
+	priority := &gh.ProjectV2Field{
+		Name:     gh.Ptr("Priority"),
+		DataType: gh.Ptr("single_select"),
+		Options: []*gh.ProjectV2FieldOption{
+			{ID: gh.Ptr("opt-high"), Name: &gh.ProjectV2TextContent{Raw: gh.Ptr("High")}},
+			{ID: gh.Ptr("opt-low"), Name: &gh.ProjectV2TextContent{Raw: gh.Ptr("Low")}},
+		},
+	}
+	sprint := &gh.ProjectV2Field{
+		Name:     gh.Ptr("Sprint"),
+		DataType: gh.Ptr("iteration"),
+		Configuration: &gh.ProjectV2FieldConfiguration{Iterations: []*gh.ProjectV2FieldIteration{
+			{ID: gh.Ptr("it-1"), Title: &gh.ProjectV2TextContent{Raw: gh.Ptr("Sprint 1")}},
+		}},
+	}
+	today := time.Now().UTC()
+	sprints := &gh.ProjectV2Field{
+		Name:     gh.Ptr("Sprint"),
+		DataType: gh.Ptr("iteration"),
+		Configuration: &gh.ProjectV2FieldConfiguration{Iterations: []*gh.ProjectV2FieldIteration{
+			{ID: gh.Ptr("it-2"), StartDate: gh.Ptr(today.AddDate(0, 0, -13).Format(time.DateOnly)), Duration: gh.Ptr(14)},
+			{ID: gh.Ptr("it-3"), StartDate: gh.Ptr(today.AddDate(0, 0, 1).Format(time.DateOnly)), Duration: gh.Ptr(14)},
+		}},
+	}
+	due := &gh.ProjectV2Field{Name: gh.Ptr("Due"), DataType: gh.Ptr("date")}
+	points := &gh.ProjectV2Field{Name: gh.Ptr("Points"), DataType: gh.Ptr("number")}
+	notes := &gh.ProjectV2Field{Name: gh.Ptr("Notes"), DataType: gh.Ptr("text")}
+
+	tests := []struct {
+		name     string
+		field    *gh.ProjectV2Field
+		value    string
+		expected any
+		err      string
+	}{
+		{name: "option by name", field: priority, value: "high", expected: "opt-high"},
+		{name: "option by ID", field: priority, value: "opt-low", expected: "opt-low"},
+		{name: "unknown option", field: priority, value: "Urgent", err: `"Urgent" is not an option of field Priority; options are: High, Low`},
+		{name: "iteration by title", field: sprint, value: "sprint 1", expected: "it-1"},
+		{name: "iteration by ID", field: sprint, value: "it-1", expected: "it-1"},
+		{name: "unknown iteration", field: sprint, value: "Sprint 9", err: `"Sprint 9" is not a current or upcoming iteration of field Sprint; iterations are: Sprint 1`},
+		{name: "iteration in progress", field: sprints, value: "@current", expected: "it-2"},
+		{name: "no iteration in progress", field: sprint, value: "@current", err: "field Sprint has no iteration in progress today"},
+		{name: "date", field: due, value: "2025-01-31", expected: "2025-01-31"},
+		{name: "timestamp", field: due, value: "2025-01-31T10:00:00Z", expected: "2025-01-31"},
+		{name: "invalid date", field: due, value: "next week", err: `field Due is a date field: expected a date such as 2025-01-31, got "next week"`},
+		{name: "number as string", field: points, value: "3.5", expected: 3.5},
+		{name: "invalid number", field: points, value: "three", err: `field Points is a number field: expected a number, got "three"`},
+		{name: "text", field: notes, value: "High", expected: "High"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			value, err := projectFieldValue(tc.field, tc.value)
+			if tc.err != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, value)
+		})
+	}
+}
+
+func Test_UpdateProjectItem_SingleSelectByName(t *testing.T) {
+	server := ghmock.New(t)
+	server.Respond("GET /users/octocat/projectsV2/1/fields/12", http.StatusOK, map[string]any{
+		"id": 12, "name": "Priority", "data_type": "single_select",
+		"options": []any{map[string]any{"id": "opt-high", "name": map[string]any{"raw": "High"}}},
+	})
+	server.Respond("PATCH /users/octocat/projectsV2/1/items/801", http.StatusOK, map[string]any{"id": 801})
+	_, handler := UpdateProjectItem(server.GetClient(), server.GetGQLClient(), translations.NullTranslationHelper)
+	call := func(value string) *mcp.CallToolResult {
+		return ghmock.CallTool(t, handler, map[string]any{
+			"owner_type": "user", "owner": "octocat", "project_number": float64(1), "item_id": float64(801),
+			"updated_field": map[string]any{"id": float64(12), "value": value},
+		})
+	}
+
+	result := call("High")
+	require.False(t, result.IsError, ghmock.ResultText(t, result))
+	var updated map[string]any
+	require.NoError(t, server.AssertRequested("PATCH /users/octocat/projectsV2/1/items/801").DecodeBody(&updated))
+	assert.Equal(t, map[string]any{"fields": []any{map[string]any{"id": float64(12), "value": "opt-high"}}}, updated)
+
+	result = call("Urgent")
+	require.True(t, result.IsError)
+	assert.Equal(t, `"Urgent" is not an option of field Priority; options are: High`, ghmock.ResultText(t, result))
+	patches := 0
+	for _, r := range server.Requests() {
+		if r.Method == http.MethodPatch {
+			patches++
+		}
+	}
+	assert.Equal(t, 1, patches, "an unknown option should not update the item")
+}
+
+func Test_ValidateProjectIDs(t *testing.T) {
+	tests := []struct {
+		name           string
+		args           map[string]any
+		validate       func(mcp.CallToolRequest) (int64, error)
+		expected       int64
+		expectedErrMsg string
+	}{
+		{
+			name:     "project number",
+			args:     map[string]any{"project_number": float64(5)},
+			validate: func(r mcp.CallToolRequest) (int64, error) { n, err := ValidateProjectNumber(r); return int64(n), err },
+			expected: 5,
+		},
+		{
+			name:     "numeric string item ID",
+			args:     map[string]any{"item_id": "135086"},
+			validate: ValidateProjectItemID,
+			expected: 135086,
+		},
+		{
+			name:           "project node ID",
+			args:           map[string]any{"project_number": "PVT_kwDOANN5s84ACbL0"},
+			validate:       func(r mcp.CallToolRequest) (int64, error) { n, err := ValidateProjectNumber(r); return int64(n), err },
+			expectedErrMsg: "this is a GraphQL node ID, expected the project's number as shown in its URL",
+		},
+		{
+			name:           "single select field node ID",
+			args:           map[string]any{"field_id": "PVTSSF_lADOANN5s84ACbL0zgBZrZY"},
+			validate:       ValidateProjectFieldID,
+			expectedErrMsg: "use list_project_fields or describe_project_schema to find it",
+		},
+		{
+			name:           "fractional item ID",
+			args:           map[string]any{"item_id": float64(1.5)},
+			validate:       ValidateProjectItemID,
+			expectedErrMsg: "invalid item_id 1.5: expected the numeric ID of the project item",
+		},
+		{
+			name:           "negative field ID",
+			args:           map[string]any{"field_id": float64(-3)},
+			validate:       ValidateProjectFieldID,
+			expectedErrMsg: "invalid field_id -3",
+		},
+		{
+			name:           "issue node ID",
+			args:           map[string]any{"item_id": "I_kwDOA0xdyM50BPaO"},
+			validate:       ValidateProjectContentID,
+			expectedErrMsg: "this is a GraphQL node ID, expected the numeric ID of the issue or pull request, not its number, e.g. 1347; use the id returned by get_issue or get_pull_request",
+		},
+		{
+			name:     "issue ID",
+			args:     map[string]any{"item_id": float64(1347)},
+			validate: ValidateProjectContentID,
+			expected: 1347,
+		},
+		{
+			name:           "missing item ID",
+			args:           map[string]any{},
+			validate:       ValidateProjectItemID,
+			expectedErrMsg: "missing required parameter: item_id",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			id, err := tc.validate(createMCPRequest(tc.args))
+			if tc.expectedErrMsg != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErrMsg)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, id)
+		})
+	}
+}
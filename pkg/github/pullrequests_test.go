@@ -4,11 +4,14 @@ import (
 	"context"
 	"encoding/json"
 	"net/http"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/github/github-mcp-server/internal/githubv4mock"
 	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/ghmock"
+	"github.com/github/github-mcp-server/pkg/redact"
 	"github.com/github/github-mcp-server/pkg/translations"
 	"github.com/google/go-github/v79/github"
 	"github.com/shurcooL/githubv4"
@@ -137,6 +140,29 @@ func Test_GetPullRequest(t *testing.T) {
 	}
 }
 
+func Test_GetPullRequestDiff_NotRedacted(t *testing.T) {
+	// A diff full of secret-looking names must come back as it is, or an agent applying it would commit the
+	// redaction. Only strings shaped like tokens are scrubbed.
+	token := "ghp_" + strings.Repeat("a1", 18)
+	diff := strings.Join([]string{
+		`+apiKey := os.Getenv("API_KEY")`,
+		`+req.Header.Set("Authorization", "Bearer " + accessTokenFromEnvironment2)`,
+		`+password = config["password"]`,
+		`+cloneURL := "https://" + user + ":" + pass + "@github.com"`,
+		`+token := "` + token + `"`,
+	}, "\n")
+
+	server := ghmock.New(t)
+	server.HandleFunc("GET /repos/octo/app/pulls/7", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(diff))
+	})
+	_, handler := PullRequestRead(server.GetClient(), stubRepoAccessCache(githubv4.NewClient(nil), 5*time.Minute), translations.NullTranslationHelper, stubFeatureFlags(map[string]bool{"lockdown-mode": false}))
+	handler = redact.New("server-token").ToolMiddleware()(handler)
+
+	result := ghmock.CallTool(t, handler, map[string]any{"method": "get_diff", "owner": "octo", "repo": "app", "pullNumber": float64(7)})
+	require.False(t, result.IsError, ghmock.ResultText(t, result))
+	assert.Equal(t, strings.Replace(diff, token, redact.Placeholder, 1), ghmock.ResultText(t, result))
+}
 func Test_UpdatePullRequest(t *testing.T) {
 	// Verify tool definition once
 	mockClient := github.NewClient(nil)
@@ -695,8 +721,11 @@ func Test_ListPullRequests(t *testing.T) {
 			textContent := getTextResult(t, result)
 
 			// Unmarshal and verify the result
-			var returnedPRs []*github.PullRequest
-			err = json.Unmarshal([]byte(textContent.Text), &returnedPRs)
+			var response struct {
+				PullRequests []*github.PullRequest `json:"pull_requests"`
+			}
+			err = json.Unmarshal([]byte(textContent.Text), &response)
+			returnedPRs := response.PullRequests
 			require.NoError(t, err)
 			assert.Len(t, returnedPRs, 2)
 			assert.Equal(t, *tc.expectedPRs[0].Number, *returnedPRs[0].Number)
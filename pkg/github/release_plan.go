@@ -0,0 +1,393 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/shurcooL/githubv4"
+)
+
+// SemverImpactNone is the bump of a release plan without merged pull requests.
+const SemverImpactNone = "none"
+
+// ReleasePlan is the next release of a repository as planned from a project: the pull requests merged since the last
+// release for the items of the project that are ready, the version bump their labels call for, and the ready items
+// that have nothing merged yet.
+type ReleasePlan struct {
+	Repository      string                   `json:"repository"`
+	TargetDate      string                   `json:"target_date,omitempty"`
+	PreviousRelease *ReleasePlanRelease      `json:"previous_release,omitempty"`
+	Bump            string                   `json:"bump"`
+	NextVersion     string                   `json:"next_version,omitempty"`
+	PullRequests    []ReleasePlanPullRequest `json:"pull_requests"`
+	Pending         []ReleasePlanItem        `json:"pending"`
+}
+
+// ReleasePlanRelease is the release a plan starts from.
+type ReleasePlanRelease struct {
+	Tag         string    `json:"tag"`
+	PublishedAt time.Time `json:"published_at"`
+}
+
+// ReleasePlanPullRequest is a merged pull request going into a release, with the project items it ships.
+type ReleasePlanPullRequest struct {
+	Number   int       `json:"number"`
+	Title    string    `json:"title"`
+	URL      string    `json:"url"`
+	MergedAt time.Time `json:"merged_at"`
+	Labels   []string  `json:"labels,omitempty"`
+	Bump     string    `json:"bump"`
+	Items    []int64   `json:"items"`
+}
+
+// ReleasePlanItem is a ready project item that is not going into a release, and why.
+type ReleasePlanItem struct {
+	ID     int64  `json:"id"`
+	Title  string `json:"title"`
+	URL    string `json:"url,omitempty"`
+	Status string `json:"status"`
+	Reason string `json:"reason"`
+}
+
+// releasePullRequest is a pull request found for a project item.
+type releasePullRequest struct {
+	number   int
+	title    string
+	url      string
+	merged   bool
+	mergedAt time.Time
+	labels   []string
+}
+
+// issueURLParts parses the URL of an issue or pull request, such as https://github.com/octo/app/issues/1, into its
+// repository, kind (issues or pull) and number.
+func issueURLParts(htmlURL string) (owner, repo, kind string, number int, ok bool) {
+	u, err := url.Parse(htmlURL)
+	if err != nil {
+		return "", "", "", 0, false
+	}
+	segments := strings.Split(strings.Trim(u.Path, "/"), "/")
+	if len(segments) < 4 {
+		return "", "", "", 0, false
+	}
+	segments = segments[len(segments)-4:]
+	number, err = strconv.Atoi(segments[3])
+	if err != nil || (segments[2] != "issues" && segments[2] != "pull") {
+		return "", "", "", 0, false
+	}
+	return segments[0], segments[1], segments[2], number, true
+}
+
+// releaseBump returns the version bump called for by labels: major for a major label, minor for a minor label, and
+// patch otherwise.
+func releaseBump(labels, majorLabels, minorLabels []string) string {
+	switch {
+	case slices.ContainsFunc(labels, func(label string) bool { return containsFold(majorLabels, label) }):
+		return SemverImpactMajor
+	case slices.ContainsFunc(labels, func(label string) bool { return containsFold(minorLabels, label) }):
+		return SemverImpactMinor
+	default:
+		return SemverImpactPatch
+	}
+}
+
+// nextReleaseVersion bumps a version such as v1.2.3, keeping its v prefix. Below 1.0.0 breaking changes only bump the
+// minor version, as semver allows. It returns false for versions that are not semver.
+func nextReleaseVersion(version, bump string) (string, bool) {
+	parts, ok := parseVersionParts(version)
+	if !ok {
+		return "", false
+	}
+	switch {
+	case bump == SemverImpactMajor && parts[0] > 0:
+		parts = [3]int{parts[0] + 1, 0, 0}
+	case bump == SemverImpactMajor || bump == SemverImpactMinor:
+		parts = [3]int{parts[0], parts[1] + 1, 0}
+	case bump == SemverImpactPatch:
+		parts[2]++
+	}
+	prefix := ""
+	if strings.HasPrefix(version, "v") {
+		prefix = "v"
+	}
+	return fmt.Sprintf("%s%d.%d.%d", prefix, parts[0], parts[1], parts[2]), true
+}
+
+// listClosingPullRequests lists the pull requests linked to close an issue.
+func listClosingPullRequests(ctx context.Context, client *githubv4.Client, owner, repo string, number int) ([]releasePullRequest, error) {
+	var query struct {
+		Repository struct {
+			Issue struct {
+				ClosedByPullRequestsReferences struct {
+					Nodes []struct {
+						Number   githubv4.Int
+						Title    githubv4.String
+						URL      githubv4.URI
+						Merged   githubv4.Boolean
+						MergedAt *githubv4.DateTime
+						Labels   struct {
+							Nodes []struct {
+								Name githubv4.String
+							}
+						} `graphql:"labels(first: 20)"`
+					}
+				} `graphql:"closedByPullRequestsReferences(first: $first, includeClosedPrs: false)"`
+			} `graphql:"issue(number: $number)"`
+		} `graphql:"repository(owner: $owner, name: $repo)"`
+	}
+	vars := map[string]any{
+		"owner":  githubv4.String(owner),
+		"repo":   githubv4.String(repo),
+		"number": githubv4.Int(number), // #nosec G115 - issue numbers are always small positive integers
+		"first":  githubv4.Int(maxLinkedPullRequests),
+	}
+	if err := client.Query(ctx, &query, vars); err != nil {
+		return nil, err
+	}
+	var prs []releasePullRequest
+	for _, node := range query.Repository.Issue.ClosedByPullRequestsReferences.Nodes {
+		pr := releasePullRequest{
+			number: int(node.Number),
+			title:  string(node.Title),
+			url:    node.URL.String(),
+			merged: bool(node.Merged),
+		}
+		if node.MergedAt != nil {
+			pr.mergedAt = node.MergedAt.Time
+		}
+		for _, label := range node.Labels.Nodes {
+			pr.labels = append(pr.labels, string(label.Name))
+		}
+		prs = append(prs, pr)
+	}
+	return prs, nil
+}
+
+// PlanRelease creates a tool that plans the next release of a repository from the ready items of a project.
+func PlanRelease(getClient GetClientFn, getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("plan_release",
+			mcp.WithDescription(t("TOOL_PLAN_RELEASE_DESCRIPTION", "Plan the next release of a repository from a Project of a user or org: take the project's issues and pull requests of the repository in the ready statuses, find the pull requests merged for them since the latest release, and propose the next semver version from their labels. Ready items with nothing merged yet are listed as pending. Issues are mapped to the pull requests linked to close them.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_PLAN_RELEASE_USER_TITLE", "Plan release"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner_type",
+				mcp.Required(),
+				mcp.Description("Owner type"),
+				mcp.Enum("user", "org"),
+			),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("If owner_type == user it is the handle for the GitHub user account. If owner_type == org it is the name of the organization. The name is not case sensitive."),
+			),
+			mcp.WithNumber("project_number",
+				mcp.Required(),
+				mcp.Description("The project's number."),
+			),
+			mcp.WithString("repo_owner",
+				mcp.Required(),
+				mcp.Description("Owner of the repository to release"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Name of the repository to release"),
+			),
+			mcp.WithString("target_date",
+				mcp.Description("Date of the release, YYYY-MM-DD. Pull requests merged after it are left for the release after."),
+			),
+			mcp.WithArray("statuses",
+				mcp.Description("The Status columns of items ready to ship. Defaults to [\"Ready\", \"Done\"]."),
+				mcp.WithStringItems(),
+			),
+			mcp.WithArray("major_labels",
+				mcp.Description("Labels of breaking changes. Defaults to [\"breaking-change\", \"breaking\", \"major\"]."),
+				mcp.WithStringItems(),
+			),
+			mcp.WithArray("minor_labels",
+				mcp.Description("Labels of new features. Defaults to [\"enhancement\", \"feature\", \"minor\"]."),
+				mcp.WithStringItems(),
+			),
+		), func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			ownerType, err := RequiredParam[string](req, "owner_type")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			owner, err := RequiredParam[string](req, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			projectNumber, err := ValidateProjectNumber(req)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repoOwner, err := RequiredParam[string](req, "repo_owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repoName, err := RequiredParam[string](req, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			targetDate, err := OptionalParam[string](req, "target_date")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			var mergedBefore time.Time
+			if targetDate != "" {
+				date, err := time.Parse(time.DateOnly, targetDate)
+				if err != nil {
+					return mcp.NewToolResultError(fmt.Sprintf("invalid target_date %q: expected YYYY-MM-DD", targetDate)), nil
+				}
+				mergedBefore = date.AddDate(0, 0, 1)
+			}
+			statuses, err := OptionalStringArrayParam(req, "statuses")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if len(statuses) == 0 {
+				statuses = []string{"Ready", "Done"}
+			}
+			majorLabels, err := OptionalStringArrayParam(req, "major_labels")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if len(majorLabels) == 0 {
+				majorLabels = []string{"breaking-change", "breaking", "major"}
+			}
+			minorLabels, err := OptionalStringArrayParam(req, "minor_labels")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if len(minorLabels) == 0 {
+				minorLabels = []string{"enhancement", "feature", "minor"}
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+			gqlClient, err := getGQLClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub GraphQL client: %w", err)
+			}
+
+			plan := ReleasePlan{
+				Repository:   repoOwner + "/" + repoName,
+				TargetDate:   targetDate,
+				Bump:         SemverImpactNone,
+				PullRequests: []ReleasePlanPullRequest{},
+				Pending:      []ReleasePlanItem{},
+			}
+			release, resp, err := client.Repositories.GetLatestRelease(ctx, repoOwner, repoName)
+			switch {
+			case err == nil:
+				_ = resp.Body.Close()
+				plan.PreviousRelease = &ReleasePlanRelease{Tag: release.GetTagName(), PublishedAt: release.GetPublishedAt().Time}
+			case resp == nil || resp.StatusCode != http.StatusNotFound:
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get latest release", resp, err), nil
+			}
+
+			fields, resp, err := listAllProjectFields(ctx, client, ownerType, owner, projectNumber)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to list project fields", resp, err), nil
+			}
+			board, resp, err := loadProjectBoard(ctx, client, ownerType, owner, projectNumber, fields, nil)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to list project items", resp, err), nil
+			}
+
+			for _, boardItem := range board.items {
+				item := boardItem.item
+				if !containsFold(statuses, boardItem.status) || item.Content == nil {
+					continue
+				}
+				itemOwner, itemRepo, kind, number, ok := issueURLParts(item.Content.HTMLURL)
+				if !ok || !strings.EqualFold(itemOwner, repoOwner) || !strings.EqualFold(itemRepo, repoName) {
+					continue
+				}
+
+				var prs []releasePullRequest
+				if kind == "pull" {
+					pr, resp, err := client.PullRequests.Get(ctx, repoOwner, repoName, number)
+					if err != nil {
+						return ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("failed to get pull request #%d", number), resp, err), nil
+					}
+					_ = resp.Body.Close()
+					found := releasePullRequest{number: number, title: pr.GetTitle(), url: pr.GetHTMLURL(), merged: pr.GetMerged(), mergedAt: pr.GetMergedAt().Time}
+					for _, label := range pr.Labels {
+						found.labels = append(found.labels, label.GetName())
+					}
+					prs = append(prs, found)
+				} else {
+					prs, err = listClosingPullRequests(ctx, gqlClient, repoOwner, repoName, number)
+					if err != nil {
+						return ghErrors.NewGitHubGraphQLErrorResponse(ctx, fmt.Sprintf("failed to get pull requests linked to issue #%d", number), err), nil
+					}
+				}
+
+				reason := "no pull request linked to close it is merged"
+				if kind == "pull" {
+					reason = "not merged"
+				}
+				shipped, released := false, false
+				for _, pr := range prs {
+					switch {
+					case !pr.merged:
+						continue
+					case plan.PreviousRelease != nil && !pr.mergedAt.After(plan.PreviousRelease.PublishedAt):
+						released = true
+						continue
+					case !mergedBefore.IsZero() && !pr.mergedAt.Before(mergedBefore):
+						reason = "merged after target_date"
+						continue
+					}
+					shipped = true
+					i := slices.IndexFunc(plan.PullRequests, func(p ReleasePlanPullRequest) bool { return p.Number == pr.number })
+					if i == -1 {
+						plan.PullRequests = append(plan.PullRequests, ReleasePlanPullRequest{
+							Number: pr.number, Title: pr.title, URL: pr.url, MergedAt: pr.mergedAt, Labels: pr.labels, Bump: SemverImpactPatch, Items: []int64{},
+						})
+						i = len(plan.PullRequests) - 1
+					}
+					plan.PullRequests[i].Items = append(plan.PullRequests[i].Items, item.GetID())
+					// The labels of the item count too, since bugs and features are often labeled on the issue only.
+					if bump := releaseBump(append(slices.Clone(pr.labels), boardItem.labels...), majorLabels, minorLabels); semverImpactRank[bump] > semverImpactRank[plan.PullRequests[i].Bump] {
+						plan.PullRequests[i].Bump = bump
+					}
+				}
+				// Items shipped in the latest release are done with, so only items with nothing released are pending.
+				if !shipped && !released {
+					plan.Pending = append(plan.Pending, ReleasePlanItem{
+						ID: item.GetID(), Title: item.Content.Title, URL: item.Content.HTMLURL, Status: boardItem.status, Reason: reason,
+					})
+				}
+			}
+
+			for _, pr := range plan.PullRequests {
+				if plan.Bump == SemverImpactNone || semverImpactRank[pr.Bump] > semverImpactRank[plan.Bump] {
+					plan.Bump = pr.Bump
+				}
+			}
+			slices.SortStableFunc(plan.PullRequests, func(a, b ReleasePlanPullRequest) int {
+				return a.MergedAt.Compare(b.MergedAt)
+			})
+			switch {
+			case plan.Bump == SemverImpactNone:
+			case plan.PreviousRelease == nil:
+				plan.NextVersion = "v0.1.0"
+			default:
+				plan.NextVersion, _ = nextReleaseVersion(plan.PreviousRelease.Tag, plan.Bump)
+			}
+			return MarshalledTextResult(plan), nil
+		}
+}
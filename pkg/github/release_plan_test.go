@@ -0,0 +1,154 @@
+package github
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/ghmock"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_nextReleaseVersion(t *testing.T) {
+	for _, tc := range []struct {
+		version, bump, want string
+	}{
+		{"v1.4.2", SemverImpactMajor, "v2.0.0"},
+		{"v1.4.2", SemverImpactMinor, "v1.5.0"},
+		{"1.4.2", SemverImpactPatch, "1.4.3"},
+		{"v0.3.1", SemverImpactMajor, "v0.4.0"},
+		{"v2.0.0-rc.1", SemverImpactPatch, "v2.0.1"},
+	} {
+		got, ok := nextReleaseVersion(tc.version, tc.bump)
+		assert.True(t, ok)
+		assert.Equal(t, tc.want, got, "%s with a %s bump", tc.version, tc.bump)
+	}
+	_, ok := nextReleaseVersion("nightly", SemverImpactPatch)
+	assert.False(t, ok)
+}
+
+func Test_PlanRelease(t *testing.T) {
+	tool, _ := PlanRelease(nil, nil, translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+	assert.True(t, *tool.Annotations.ReadOnlyHint)
+	assert.ElementsMatch(t, []string{"owner_type", "owner", "project_number", "repo_owner", "repo"}, tool.InputSchema.Required)
+
+	// The board has a Done feature issue closed by #20 and a Ready pull request #21 labeled breaking-change, both merged
+	// since v1.4.2, a Ready issue whose pull request is open, a Done issue released in v1.4.2, a pull request merged
+	// after the target date, an issue of another repository and an issue in the Backlog.
+	respondBoard := func(server *ghmock.Server) {
+		option := func(id, name string) map[string]any {
+			return map[string]any{"id": id, "name": map[string]any{"raw": name}}
+		}
+		server.Respond("GET /orgs/octo/projectsV2/1/fields", http.StatusOK, []map[string]any{
+			{"id": 11, "name": "Status", "data_type": "single_select", "options": []any{option("o1", "Backlog"), option("o2", "Ready"), option("o3", "Done")}},
+			{"id": 15, "name": "Labels", "data_type": "labels"},
+		})
+		item := func(id int, contentType, url, status string, labels ...string) map[string]any {
+			var values []any
+			for _, name := range labels {
+				values = append(values, map[string]any{"name": name})
+			}
+			return map[string]any{
+				"id": id, "content_type": contentType,
+				"content": map[string]any{"title": url, "html_url": url},
+				"fields":  []any{map[string]any{"id": 11, "value": map[string]any{"name": map[string]any{"raw": status}}}, map[string]any{"id": 15, "value": values}},
+			}
+		}
+		server.Respond("GET /orgs/octo/projectsV2/1/items", http.StatusOK, []map[string]any{
+			item(1001, "Issue", "https://github.com/octo/app/issues/10", "Done", "enhancement"),
+			item(1002, "PullRequest", "https://github.com/octo/app/pull/21", "Ready"),
+			item(1003, "Issue", "https://github.com/octo/app/issues/11", "Ready"),
+			item(1004, "Issue", "https://github.com/octo/app/issues/12", "Done"),
+			item(1005, "Issue", "https://github.com/octo/other/issues/1", "Done"),
+			item(1006, "Issue", "https://github.com/octo/app/issues/13", "Backlog"),
+			item(1007, "PullRequest", "https://github.com/octo/app/pull/23", "Ready"),
+		})
+		server.Respond("GET /repos/octo/app/pulls/21", http.StatusOK, map[string]any{
+			"number": 21, "title": "Drop v1 API", "html_url": "https://github.com/octo/app/pull/21",
+			"merged": true, "merged_at": "2025-03-10T10:00:00Z", "labels": []any{map[string]any{"name": "breaking-change"}},
+		})
+		server.Respond("GET /repos/octo/app/pulls/23", http.StatusOK, map[string]any{
+			"number": 23, "title": "Late fix", "html_url": "https://github.com/octo/app/pull/23", "merged": true, "merged_at": "2025-03-25T10:00:00Z",
+		})
+		server.HandleGraphQL("closedByPullRequestsReferences(", func(_ string, vars map[string]any) (any, []string) {
+			pr := func(number int, mergedAt string) map[string]any {
+				node := map[string]any{
+					"number": number, "title": "PR", "url": fmt.Sprintf("https://github.com/octo/app/pull/%d", number),
+					"merged": mergedAt != "", "mergedAt": nil, "labels": map[string]any{"nodes": []any{}},
+				}
+				if mergedAt != "" {
+					node["mergedAt"] = mergedAt
+				}
+				return node
+			}
+			nodes := map[any][]any{
+				float64(10): {pr(20, "2025-03-05T10:00:00Z")},
+				float64(11): {pr(22, "")},
+				float64(12): {pr(19, "2025-02-20T10:00:00Z")},
+			}[vars["number"]]
+			if nodes == nil {
+				return nil, []string{"unexpected issue"}
+			}
+			return map[string]any{"repository": map[string]any{"issue": map[string]any{"closedByPullRequestsReferences": map[string]any{"nodes": nodes}}}}, nil
+		})
+	}
+	args := map[string]any{"owner_type": "org", "owner": "octo", "project_number": float64(1), "repo_owner": "octo", "repo": "app", "target_date": "2025-03-20"}
+	call := func(t *testing.T, server *ghmock.Server) ReleasePlan {
+		_, handler := PlanRelease(server.GetClient(), server.GetGQLClient(), translations.NullTranslationHelper)
+		result := ghmock.CallTool(t, handler, args)
+		require.False(t, result.IsError, ghmock.ResultText(t, result))
+		var plan ReleasePlan
+		require.NoError(t, json.Unmarshal([]byte(ghmock.ResultText(t, result)), &plan))
+		return plan
+	}
+
+	t.Run("plans the release since the latest one", func(t *testing.T) {
+		server := ghmock.New(t)
+		respondBoard(server)
+		server.Respond("GET /repos/octo/app/releases/latest", http.StatusOK, map[string]any{"tag_name": "v1.4.2", "published_at": "2025-03-01T10:00:00Z"})
+		plan := call(t, server)
+
+		assert.Equal(t, &ReleasePlanRelease{Tag: "v1.4.2", PublishedAt: time.Date(2025, 3, 1, 10, 0, 0, 0, time.UTC)}, plan.PreviousRelease)
+		assert.Equal(t, SemverImpactMajor, plan.Bump)
+		assert.Equal(t, "v2.0.0", plan.NextVersion)
+		require.Len(t, plan.PullRequests, 2)
+		assert.Equal(t, 20, plan.PullRequests[0].Number)
+		assert.Equal(t, SemverImpactMinor, plan.PullRequests[0].Bump)
+		assert.Equal(t, []int64{1001}, plan.PullRequests[0].Items)
+		assert.Equal(t, ReleasePlanPullRequest{
+			Number: 21, Title: "Drop v1 API", URL: "https://github.com/octo/app/pull/21", MergedAt: time.Date(2025, 3, 10, 10, 0, 0, 0, time.UTC),
+			Labels: []string{"breaking-change"}, Bump: SemverImpactMajor, Items: []int64{1002},
+		}, plan.PullRequests[1])
+		assert.Equal(t, []ReleasePlanItem{
+			{ID: 1003, Title: "https://github.com/octo/app/issues/11", URL: "https://github.com/octo/app/issues/11", Status: "Ready", Reason: "no pull request linked to close it is merged"},
+			{ID: 1007, Title: "https://github.com/octo/app/pull/23", URL: "https://github.com/octo/app/pull/23", Status: "Ready", Reason: "merged after target_date"},
+		}, plan.Pending)
+	})
+
+	t.Run("first release", func(t *testing.T) {
+		server := ghmock.New(t)
+		respondBoard(server)
+		server.Respond("GET /repos/octo/app/releases/latest", http.StatusNotFound, map[string]any{"message": "Not Found"})
+		plan := call(t, server)
+		assert.Nil(t, plan.PreviousRelease)
+		assert.Equal(t, "v0.1.0", plan.NextVersion)
+		assert.Len(t, plan.PullRequests, 3)
+	})
+
+	t.Run("invalid target date", func(t *testing.T) {
+		server := ghmock.New(t)
+		_, handler := PlanRelease(server.GetClient(), server.GetGQLClient(), translations.NullTranslationHelper)
+		result := ghmock.CallTool(t, handler, map[string]any{
+			"owner_type": "org", "owner": "octo", "project_number": float64(1), "repo_owner": "octo", "repo": "app", "target_date": "March 20",
+		})
+		require.True(t, result.IsError)
+		assert.Equal(t, `invalid target_date "March 20": expected YYYY-MM-DD`, ghmock.ResultText(t, result))
+		assert.Empty(t, server.Requests())
+	})
+}
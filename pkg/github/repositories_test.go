@@ -961,8 +961,11 @@ func Test_ListCommits(t *testing.T) {
 			textContent := getTextResult(t, result)
 
 			// Unmarshal and verify the result
-			var returnedCommits []MinimalCommit
-			err = json.Unmarshal([]byte(textContent.Text), &returnedCommits)
+			var response struct {
+				Commits []MinimalCommit `json:"commits"`
+			}
+			err = json.Unmarshal([]byte(textContent.Text), &response)
+			returnedCommits := response.Commits
 			require.NoError(t, err)
 			assert.Len(t, returnedCommits, len(tc.expectedCommits))
 			for i, commit := range returnedCommits {
@@ -1766,8 +1769,11 @@ func Test_ListBranches(t *testing.T) {
 			require.NotEmpty(t, textContent.Text)
 
 			// Verify response
-			var branches []*github.Branch
-			err = json.Unmarshal([]byte(textContent.Text), &branches)
+			var response struct {
+				Branches []*github.Branch `json:"branches"`
+			}
+			err = json.Unmarshal([]byte(textContent.Text), &response)
+			branches := response.Branches
 			require.NoError(t, err)
 			assert.Len(t, branches, 2)
 			assert.Equal(t, "main", *branches[0].Name)
@@ -2064,8 +2070,11 @@ func Test_ListTags(t *testing.T) {
 			textContent := getTextResult(t, result)
 
 			// Parse and verify the result
-			var returnedTags []*github.RepositoryTag
-			err = json.Unmarshal([]byte(textContent.Text), &returnedTags)
+			var response struct {
+				Tags []*github.RepositoryTag `json:"tags"`
+			}
+			err = json.Unmarshal([]byte(textContent.Text), &response)
+			returnedTags := response.Tags
 			require.NoError(t, err)
 
 			// Verify each tag
@@ -2312,8 +2321,11 @@ func Test_ListReleases(t *testing.T) {
 
 			require.NoError(t, err)
 			textContent := getTextResult(t, result)
-			var returnedReleases []*github.RepositoryRelease
-			err = json.Unmarshal([]byte(textContent.Text), &returnedReleases)
+			var response struct {
+				Releases []*github.RepositoryRelease `json:"releases"`
+			}
+			err = json.Unmarshal([]byte(textContent.Text), &response)
+			returnedReleases := response.Releases
 			require.NoError(t, err)
 			assert.Len(t, returnedReleases, len(tc.expectedResult))
 			for i, rel := range returnedReleases {
@@ -3054,8 +3066,11 @@ func Test_ListStarredRepositories(t *testing.T) {
 				textContent := getTextResult(t, result)
 
 				// Unmarshal and verify the result
-				var returnedRepos []MinimalRepository
-				err = json.Unmarshal([]byte(textContent.Text), &returnedRepos)
+				var response struct {
+					Repositories []MinimalRepository `json:"repositories"`
+				}
+				err = json.Unmarshal([]byte(textContent.Text), &response)
+				returnedRepos := response.Repositories
 				require.NoError(t, err)
 
 				assert.Len(t, returnedRepos, tc.expectedCount)
@@ -0,0 +1,225 @@
+package github
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v79/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// withConfirm adds the confirm parameter of tools that make hard to reverse changes. Without confirm the tools only
+// describe the change they would make.
+func withConfirm() mcp.ToolOption {
+	return mcp.WithBoolean("confirm",
+		mcp.Description("Set to true to make the change. Without it the tool only describes the change, so that it can be shown to the user first."),
+	)
+}
+
+// confirmationRequired is the result of a tool called without confirm.
+func confirmationRequired(action string) *mcp.CallToolResult {
+	return MarshalledTextResult(map[string]any{
+		"confirmed": false,
+		"action":    action,
+		"message":   "No changes were made. Confirm the action with the user, then call the tool again with confirm set to true.",
+	})
+}
+
+// ArchiveRepository creates a tool that archives a repository.
+func ArchiveRepository(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("archive_repository",
+			mcp.WithDescription(t("TOOL_ARCHIVE_REPOSITORY_DESCRIPTION", "Archive a repository, making it read-only for everyone. Requires confirm set to true; without it, describes the change only.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:           t("TOOL_ARCHIVE_REPOSITORY_USER_TITLE", "Archive repository"),
+				ReadOnlyHint:    ToBoolPtr(false),
+				DestructiveHint: ToBoolPtr(true),
+				IdempotentHint:  ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryOwner),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryName),
+			),
+			withConfirm(),
+		),
+		setRepositoryArchivedHandler(getClient, true)
+}
+
+// UnarchiveRepository creates a tool that unarchives a repository.
+func UnarchiveRepository(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("unarchive_repository",
+			mcp.WithDescription(t("TOOL_UNARCHIVE_REPOSITORY_DESCRIPTION", "Unarchive a repository, making it writable again. Requires confirm set to true; without it, describes the change only.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:           t("TOOL_UNARCHIVE_REPOSITORY_USER_TITLE", "Unarchive repository"),
+				ReadOnlyHint:    ToBoolPtr(false),
+				DestructiveHint: ToBoolPtr(false),
+				IdempotentHint:  ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryOwner),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryName),
+			),
+			withConfirm(),
+		),
+		setRepositoryArchivedHandler(getClient, false)
+}
+
+func setRepositoryArchivedHandler(getClient GetClientFn, archive bool) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		owner, err := RequiredParam[string](request, "owner")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		repo, err := RequiredParam[string](request, "repo")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		confirm, err := OptionalParam[bool](request, "confirm")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		client, err := getClient(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+		}
+
+		verb := "unarchive"
+		if archive {
+			verb = "archive"
+		}
+		current, resp, err := client.Repositories.Get(ctx, owner, repo)
+		if err != nil {
+			return ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("failed to get repository %s/%s", owner, repo), resp, err), nil
+		}
+		_ = resp.Body.Close()
+		if current.GetArchived() == archive {
+			return MarshalledTextResult(map[string]any{
+				"repository": current.GetFullName(),
+				"archived":   archive,
+				"changed":    false,
+			}), nil
+		}
+		if !confirm {
+			return confirmationRequired(fmt.Sprintf("%s %s", verb, current.GetFullName())), nil
+		}
+
+		updated, resp, err := client.Repositories.Edit(ctx, owner, repo, &github.Repository{Archived: github.Ptr(archive)})
+		if err != nil {
+			return ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("failed to %s repository %s/%s", verb, owner, repo), resp, err), nil
+		}
+		_ = resp.Body.Close()
+
+		return MarshalledTextResult(map[string]any{
+			"repository": updated.GetFullName(),
+			"archived":   updated.GetArchived(),
+			"changed":    true,
+		}), nil
+	}
+}
+
+// TransferRepository creates a tool that transfers a repository to another user or organization.
+func TransferRepository(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("transfer_repository",
+			mcp.WithDescription(t("TOOL_TRANSFER_REPOSITORY_DESCRIPTION", "Transfer a repository to another user or organization. Transfers to a user must be accepted by that user; transfers to an organization happen right away if you can create repositories there. Requires confirm set to true; without it, describes the change only.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:           t("TOOL_TRANSFER_REPOSITORY_USER_TITLE", "Transfer repository"),
+				ReadOnlyHint:    ToBoolPtr(false),
+				DestructiveHint: ToBoolPtr(true),
+				IdempotentHint:  ToBoolPtr(false),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryOwner),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryName),
+			),
+			mcp.WithString("new_owner",
+				mcp.Required(),
+				mcp.Description("User or organization to transfer the repository to"),
+			),
+			mcp.WithString("new_name",
+				mcp.Description("New name of the repository. Defaults to its current name."),
+			),
+			mcp.WithArray("team_ids",
+				mcp.Description("IDs of teams of the new owner organization to give access to the repository (e.g. [\"12\", \"345\"])"),
+				mcp.WithStringItems(),
+			),
+			withConfirm(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			newOwner, err := RequiredParam[string](request, "new_owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			newName, err := OptionalParam[string](request, "new_name")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			teamIDs, err := OptionalBigIntArrayParam(request, "team_ids")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			confirm, err := OptionalParam[bool](request, "confirm")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			newName = cmp.Or(newName, repo)
+			if !confirm {
+				return confirmationRequired(fmt.Sprintf("transfer %s/%s to %s/%s", owner, repo, newOwner, newName)), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			transfer := github.TransferRequest{NewOwner: newOwner, TeamID: teamIDs}
+			if newName != repo {
+				transfer.NewName = github.Ptr(newName)
+			}
+			transferred, resp, err := client.Repositories.Transfer(ctx, owner, repo, transfer)
+			if err != nil {
+				// GitHub accepts the transfer with a 202 and completes it in the background.
+				var accepted *github.AcceptedError
+				if resp == nil || resp.StatusCode != http.StatusAccepted || !errors.As(err, &accepted) {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("failed to transfer repository %s/%s", owner, repo), resp, err), nil
+				}
+				_ = json.Unmarshal(accepted.Raw, &transferred)
+			}
+			_ = resp.Body.Close()
+
+			return MarshalledTextResult(map[string]any{
+				"repository": fmt.Sprintf("%s/%s", owner, repo),
+				"new_owner":  newOwner,
+				"new_name":   newName,
+				"url":        transferred.GetHTMLURL(),
+				"message":    "The transfer has started. Transfers to a user complete once the user accepts them.",
+			}), nil
+		}
+}
@@ -0,0 +1,128 @@
+package github
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/ghmock"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ArchiveRepository(t *testing.T) {
+	tool, _ := ArchiveRepository(nil, translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+	assert.True(t, *tool.Annotations.DestructiveHint)
+	unarchiveTool, _ := UnarchiveRepository(nil, translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(unarchiveTool.Name, unarchiveTool))
+	assert.False(t, *unarchiveTool.Annotations.DestructiveHint)
+
+	newServer := func(t *testing.T, archived bool) *ghmock.Server {
+		server := ghmock.New(t)
+		server.Respond("GET /repos/octo/app", http.StatusOK, map[string]any{"full_name": "octo/app", "archived": archived})
+		server.Respond("PATCH /repos/octo/app", http.StatusOK, map[string]any{"full_name": "octo/app", "archived": !archived})
+		return server
+	}
+	args := func(confirm bool) map[string]any {
+		return map[string]any{"owner": "octo", "repo": "app", "confirm": confirm}
+	}
+
+	t.Run("without confirm describes the change", func(t *testing.T) {
+		server := newServer(t, false)
+		_, handler := ArchiveRepository(server.GetClient(), translations.NullTranslationHelper)
+		result := ghmock.CallTool(t, handler, map[string]any{"owner": "octo", "repo": "app"})
+		require.False(t, result.IsError, ghmock.ResultText(t, result))
+		var resp map[string]any
+		require.NoError(t, json.Unmarshal([]byte(ghmock.ResultText(t, result)), &resp))
+		assert.Equal(t, false, resp["confirmed"])
+		assert.Equal(t, "archive octo/app", resp["action"])
+		server.AssertNotRequested(http.MethodPatch)
+	})
+
+	t.Run("archives with confirm", func(t *testing.T) {
+		server := newServer(t, false)
+		_, handler := ArchiveRepository(server.GetClient(), translations.NullTranslationHelper)
+		result := ghmock.CallTool(t, handler, args(true))
+		require.False(t, result.IsError, ghmock.ResultText(t, result))
+		assert.JSONEq(t, `{"repository": "octo/app", "archived": true, "changed": true}`, ghmock.ResultText(t, result))
+		var body map[string]any
+		require.NoError(t, server.AssertRequested("PATCH /repos/octo/app").DecodeBody(&body))
+		assert.Equal(t, map[string]any{"archived": true}, body)
+	})
+
+	t.Run("already archived is unchanged", func(t *testing.T) {
+		server := newServer(t, true)
+		_, handler := ArchiveRepository(server.GetClient(), translations.NullTranslationHelper)
+		result := ghmock.CallTool(t, handler, args(true))
+		require.False(t, result.IsError, ghmock.ResultText(t, result))
+		assert.JSONEq(t, `{"repository": "octo/app", "archived": true, "changed": false}`, ghmock.ResultText(t, result))
+		server.AssertNotRequested(http.MethodPatch)
+	})
+
+	t.Run("unarchives with confirm", func(t *testing.T) {
+		server := newServer(t, true)
+		_, handler := UnarchiveRepository(server.GetClient(), translations.NullTranslationHelper)
+		result := ghmock.CallTool(t, handler, args(true))
+		require.False(t, result.IsError, ghmock.ResultText(t, result))
+		assert.JSONEq(t, `{"repository": "octo/app", "archived": false, "changed": true}`, ghmock.ResultText(t, result))
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		server := ghmock.New(t)
+		server.Respond("GET /repos/octo/app", http.StatusNotFound, map[string]any{"message": "Not Found"})
+		_, handler := ArchiveRepository(server.GetClient(), translations.NullTranslationHelper)
+		result := ghmock.CallTool(t, handler, args(true))
+		require.True(t, result.IsError)
+		assert.Contains(t, ghmock.ResultText(t, result), "failed to get repository octo/app")
+	})
+}
+
+func Test_TransferRepository(t *testing.T) {
+	tool, _ := TransferRepository(nil, translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+	assert.True(t, *tool.Annotations.DestructiveHint)
+	assert.ElementsMatch(t, []string{"owner", "repo", "new_owner"}, tool.InputSchema.Required)
+
+	t.Run("without confirm describes the change", func(t *testing.T) {
+		server := ghmock.New(t)
+		_, handler := TransferRepository(server.GetClient(), translations.NullTranslationHelper)
+		result := ghmock.CallTool(t, handler, map[string]any{"owner": "octo", "repo": "app", "new_owner": "platform"})
+		require.False(t, result.IsError, ghmock.ResultText(t, result))
+		assert.Contains(t, ghmock.ResultText(t, result), `"action":"transfer octo/app to platform/app"`)
+		assert.Empty(t, server.Requests())
+	})
+
+	t.Run("transfers with confirm", func(t *testing.T) {
+		server := ghmock.New(t)
+		server.Respond("POST /repos/octo/app/transfer", http.StatusAccepted, map[string]any{
+			"full_name": "platform/service", "html_url": "https://github.com/platform/service",
+		})
+		_, handler := TransferRepository(server.GetClient(), translations.NullTranslationHelper)
+		result := ghmock.CallTool(t, handler, map[string]any{
+			"owner": "octo", "repo": "app", "new_owner": "platform", "new_name": "service",
+			"team_ids": []any{"12"}, "confirm": true,
+		})
+		require.False(t, result.IsError, ghmock.ResultText(t, result))
+		var resp map[string]any
+		require.NoError(t, json.Unmarshal([]byte(ghmock.ResultText(t, result)), &resp))
+		assert.Equal(t, "platform", resp["new_owner"])
+		assert.Equal(t, "service", resp["new_name"])
+		assert.Equal(t, "https://github.com/platform/service", resp["url"])
+
+		var body map[string]any
+		require.NoError(t, server.AssertRequested("POST /repos/octo/app/transfer").DecodeBody(&body))
+		assert.Equal(t, map[string]any{"new_owner": "platform", "new_name": "service", "team_ids": []any{float64(12)}}, body)
+	})
+
+	t.Run("transfer rejected", func(t *testing.T) {
+		server := ghmock.New(t)
+		server.Respond("POST /repos/octo/app/transfer", http.StatusUnprocessableEntity, map[string]any{"message": "Repository cannot be transferred"})
+		_, handler := TransferRepository(server.GetClient(), translations.NullTranslationHelper)
+		result := ghmock.CallTool(t, handler, map[string]any{"owner": "octo", "repo": "app", "new_owner": "platform", "confirm": true})
+		require.True(t, result.IsError)
+		assert.Contains(t, ghmock.ResultText(t, result), "failed to transfer repository octo/app")
+	})
+}
@@ -0,0 +1,250 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"time"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v79/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// statsComputingMessage is returned while GitHub is still computing repository statistics in the background.
+const statsComputingMessage = "GitHub is computing the statistics for this repository. Try again in a few seconds."
+
+// ContributorActivity summarizes the commit activity of one contributor.
+type ContributorActivity struct {
+	Login       string `json:"login"`
+	Commits     int    `json:"commits"`
+	Additions   int    `json:"additions"`
+	Deletions   int    `json:"deletions"`
+	ActiveWeeks int    `json:"active_weeks"`
+	FirstWeek   string `json:"first_week,omitempty"`
+	LastWeek    string `json:"last_week,omitempty"`
+}
+
+// CodeFrequencyWeek is the number of lines added and deleted in a week.
+type CodeFrequencyWeek struct {
+	Week      string `json:"week"`
+	Additions int    `json:"additions"`
+	Deletions int    `json:"deletions"`
+}
+
+// parseStatsSince parses the optional since parameter of the statistics tools.
+func parseStatsSince(since string) (time.Time, error) {
+	if since == "" {
+		return time.Time{}, nil
+	}
+	sinceTime, err := parseISOTimestamp(since)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid since timestamp: %w", err)
+	}
+	return sinceTime, nil
+}
+
+// summarizeContributorStats totals the weekly statistics of each contributor from since onwards and orders the
+// contributors by commit count.
+func summarizeContributorStats(stats []*github.ContributorStats, since time.Time) []ContributorActivity {
+	contributors := make([]ContributorActivity, 0, len(stats))
+	for _, s := range stats {
+		activity := ContributorActivity{Login: s.GetAuthor().GetLogin()}
+		for _, week := range s.Weeks {
+			if week.Week == nil || week.Week.Before(since) || week.GetCommits() == 0 {
+				continue
+			}
+			activity.Commits += week.GetCommits()
+			activity.Additions += week.GetAdditions()
+			activity.Deletions += week.GetDeletions()
+			activity.ActiveWeeks++
+			weekStart := week.Week.Format("2006-01-02")
+			if activity.FirstWeek == "" {
+				activity.FirstWeek = weekStart
+			}
+			activity.LastWeek = weekStart
+		}
+		if activity.Commits > 0 {
+			contributors = append(contributors, activity)
+		}
+	}
+	sort.SliceStable(contributors, func(i, j int) bool {
+		if contributors[i].Commits != contributors[j].Commits {
+			return contributors[i].Commits > contributors[j].Commits
+		}
+		return contributors[i].Login < contributors[j].Login
+	})
+	return contributors
+}
+
+// GetContributorStats creates a tool to get the commit activity of each contributor to a repository.
+func GetContributorStats(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_contributor_stats",
+			mcp.WithDescription(t("TOOL_GET_CONTRIBUTOR_STATS_DESCRIPTION", "Get commit activity per contributor to a repository: commits, lines added and deleted, and the number of weeks with commits, ordered by commit count. GitHub only tracks the top 100 contributors and may need a few seconds to compute the statistics the first time they are requested.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_GET_CONTRIBUTOR_STATS_USER_TITLE", "Get contributor statistics"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryOwner),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryName),
+			),
+			mcp.WithString("since",
+				mcp.Description("Only count activity in weeks starting at or after this date (ISO 8601, e.g. 2025-01-01)"),
+			),
+			mcp.WithNumber("limit",
+				mcp.Description("Maximum number of contributors to return (default 25)"),
+				mcp.Min(1),
+				mcp.Max(100),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			since, err := OptionalParam[string](request, "since")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			sinceTime, err := parseStatsSince(since)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			limit, err := OptionalIntParamWithDefault(request, "limit", 25)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			stats, resp, err := client.Repositories.ListContributorsStats(ctx, owner, repo)
+			if err != nil {
+				if resp != nil && resp.StatusCode == http.StatusAccepted && isAcceptedError(err) {
+					return mcp.NewToolResultText(statsComputingMessage), nil
+				}
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to get contributor statistics for %s/%s", owner, repo),
+					resp,
+					err,
+				), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			contributors := summarizeContributorStats(stats, sinceTime)
+			total := len(contributors)
+			if len(contributors) > limit {
+				contributors = contributors[:limit]
+			}
+
+			r, err := json.Marshal(map[string]any{
+				"total_contributors": total,
+				"contributors":       contributors,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// GetCodeFrequency creates a tool to get the weekly additions and deletions of a repository.
+func GetCodeFrequency(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_code_frequency",
+			mcp.WithDescription(t("TOOL_GET_CODE_FREQUENCY_DESCRIPTION", "Get the number of lines added and deleted in a repository per week, with totals. Deletions are reported as positive numbers. Only available for repositories with fewer than 10,000 commits.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_GET_CODE_FREQUENCY_USER_TITLE", "Get code frequency"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryOwner),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryName),
+			),
+			mcp.WithString("since",
+				mcp.Description("Only include weeks starting at or after this date (ISO 8601, e.g. 2025-01-01)"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			since, err := OptionalParam[string](request, "since")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			sinceTime, err := parseStatsSince(since)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			stats, resp, err := client.Repositories.ListCodeFrequency(ctx, owner, repo)
+			if err != nil {
+				if resp != nil && resp.StatusCode == http.StatusAccepted && isAcceptedError(err) {
+					return mcp.NewToolResultText(statsComputingMessage), nil
+				}
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to get code frequency for %s/%s", owner, repo),
+					resp,
+					err,
+				), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			weeks := make([]CodeFrequencyWeek, 0, len(stats))
+			var additions, deletions int
+			for _, week := range stats {
+				if week.Week == nil || week.Week.Before(sinceTime) {
+					continue
+				}
+				w := CodeFrequencyWeek{
+					Week:      week.Week.Format("2006-01-02"),
+					Additions: week.GetAdditions(),
+					Deletions: -week.GetDeletions(),
+				}
+				additions += w.Additions
+				deletions += w.Deletions
+				weeks = append(weeks, w)
+			}
+
+			r, err := json.Marshal(map[string]any{
+				"weeks":           weeks,
+				"total_additions": additions,
+				"total_deletions": deletions,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
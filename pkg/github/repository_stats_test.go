@@ -0,0 +1,252 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v79/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_GetContributorStats(t *testing.T) {
+	mockClient := github.NewClient(nil)
+	tool, _ := GetContributorStats(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "get_contributor_stats", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "since")
+	assert.Contains(t, tool.InputSchema.Properties, "limit")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+
+	week := func(date string, commits, additions, deletions int) *github.WeeklyStats {
+		w, _ := time.Parse("2006-01-02", date)
+		return &github.WeeklyStats{
+			Week:      &github.Timestamp{Time: w},
+			Commits:   github.Ptr(commits),
+			Additions: github.Ptr(additions),
+			Deletions: github.Ptr(deletions),
+		}
+	}
+	stats := []*github.ContributorStats{
+		{
+			Author: &github.Contributor{Login: github.Ptr("alice")},
+			Total:  github.Ptr(3),
+			Weeks:  []*github.WeeklyStats{week("2025-01-05", 2, 100, 10), week("2025-01-12", 0, 0, 0), week("2025-02-02", 1, 5, 1)},
+		},
+		{
+			Author: &github.Contributor{Login: github.Ptr("bob")},
+			Total:  github.Ptr(5),
+			Weeks:  []*github.WeeklyStats{week("2024-12-01", 4, 40, 40), week("2025-01-19", 1, 1, 0)},
+		},
+		{
+			Author: &github.Contributor{Login: github.Ptr("carol")},
+			Total:  github.Ptr(1),
+			Weeks:  []*github.WeeklyStats{week("2024-11-03", 1, 3, 3)},
+		},
+	}
+
+	tests := []struct {
+		name                 string
+		mockedClient         *http.Client
+		requestArgs          map[string]interface{}
+		expectError          bool
+		expectedErrMsg       string
+		expectedText         string
+		expectedTotal        int
+		expectedContributors []ContributorActivity
+	}{
+		{
+			name:         "all time",
+			mockedClient: mock.NewMockedHTTPClient(mock.WithRequestMatch(mock.GetReposStatsContributorsByOwnerByRepo, stats)),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+			},
+			expectedTotal: 3,
+			expectedContributors: []ContributorActivity{
+				{Login: "bob", Commits: 5, Additions: 41, Deletions: 40, ActiveWeeks: 2, FirstWeek: "2024-12-01", LastWeek: "2025-01-19"},
+				{Login: "alice", Commits: 3, Additions: 105, Deletions: 11, ActiveWeeks: 2, FirstWeek: "2025-01-05", LastWeek: "2025-02-02"},
+				{Login: "carol", Commits: 1, Additions: 3, Deletions: 3, ActiveWeeks: 1, FirstWeek: "2024-11-03", LastWeek: "2024-11-03"},
+			},
+		},
+		{
+			name:         "since date with limit",
+			mockedClient: mock.NewMockedHTTPClient(mock.WithRequestMatch(mock.GetReposStatsContributorsByOwnerByRepo, stats)),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"since": "2025-01-01",
+				"limit": float64(1),
+			},
+			expectedTotal: 2,
+			expectedContributors: []ContributorActivity{
+				{Login: "alice", Commits: 3, Additions: 105, Deletions: 11, ActiveWeeks: 2, FirstWeek: "2025-01-05", LastWeek: "2025-02-02"},
+			},
+		},
+		{
+			name: "statistics still computing",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposStatsContributorsByOwnerByRepo,
+					mockResponse(t, http.StatusAccepted, map[string]string{}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+			},
+			expectedText: statsComputingMessage,
+		},
+		{
+			name:         "invalid since",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"since": "last week",
+			},
+			expectError:    true,
+			expectedErrMsg: "invalid since timestamp",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := GetContributorStats(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+			require.NoError(t, err)
+
+			if tc.expectError {
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			textContent := getTextResult(t, result)
+			if tc.expectedText != "" {
+				assert.Equal(t, tc.expectedText, textContent.Text)
+				return
+			}
+
+			var response struct {
+				TotalContributors int                   `json:"total_contributors"`
+				Contributors      []ContributorActivity `json:"contributors"`
+			}
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &response))
+			assert.Equal(t, tc.expectedTotal, response.TotalContributors)
+			assert.Equal(t, tc.expectedContributors, response.Contributors)
+		})
+	}
+}
+
+func Test_GetCodeFrequency(t *testing.T) {
+	mockClient := github.NewClient(nil)
+	tool, _ := GetCodeFrequency(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "get_code_frequency", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "since")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+
+	// 2024-12-29, 2025-01-05 and 2025-01-12 as unix timestamps.
+	frequency := [][]int{
+		{1735430400, 120, -30},
+		{1736035200, 10, -2},
+		{1736640000, 0, 0},
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedErrMsg string
+		expectedWeeks  []CodeFrequencyWeek
+		expectedAdds   int
+		expectedDels   int
+	}{
+		{
+			name:         "all weeks",
+			mockedClient: mock.NewMockedHTTPClient(mock.WithRequestMatch(mock.GetReposStatsCodeFrequencyByOwnerByRepo, frequency)),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+			},
+			expectedWeeks: []CodeFrequencyWeek{
+				{Week: "2024-12-29", Additions: 120, Deletions: 30},
+				{Week: "2025-01-05", Additions: 10, Deletions: 2},
+				{Week: "2025-01-12", Additions: 0, Deletions: 0},
+			},
+			expectedAdds: 130,
+			expectedDels: 32,
+		},
+		{
+			name:         "since date",
+			mockedClient: mock.NewMockedHTTPClient(mock.WithRequestMatch(mock.GetReposStatsCodeFrequencyByOwnerByRepo, frequency)),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"since": "2025-01-01",
+			},
+			expectedWeeks: []CodeFrequencyWeek{
+				{Week: "2025-01-05", Additions: 10, Deletions: 2},
+				{Week: "2025-01-12", Additions: 0, Deletions: 0},
+			},
+			expectedAdds: 10,
+			expectedDels: 2,
+		},
+		{
+			name: "too many commits",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposStatsCodeFrequencyByOwnerByRepo,
+					mockResponse(t, http.StatusUnprocessableEntity, map[string]string{"message": "The repository has more than 10000 commits"}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to get code frequency for owner/repo",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := GetCodeFrequency(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+			require.NoError(t, err)
+
+			if tc.expectError {
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			textContent := getTextResult(t, result)
+			var response struct {
+				Weeks          []CodeFrequencyWeek `json:"weeks"`
+				TotalAdditions int                 `json:"total_additions"`
+				TotalDeletions int                 `json:"total_deletions"`
+			}
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &response))
+			assert.Equal(t, tc.expectedWeeks, response.Weeks)
+			assert.Equal(t, tc.expectedAdds, response.TotalAdditions)
+			assert.Equal(t, tc.expectedDels, response.TotalDeletions)
+		})
+	}
+}
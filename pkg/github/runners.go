@@ -0,0 +1,483 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v79/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// MinimalRunner is the trimmed output type for a self-hosted runner.
+type MinimalRunner struct {
+	ID           int64    `json:"id"`
+	Name         string   `json:"name"`
+	OS           string   `json:"os,omitempty"`
+	Status       string   `json:"status"`
+	Busy         bool     `json:"busy"`
+	Labels       []string `json:"labels"`
+	CustomLabels []string `json:"custom_labels"`
+}
+
+// MinimalRunnerGroup is the trimmed output type for a self-hosted runner group.
+type MinimalRunnerGroup struct {
+	ID                       int64    `json:"id"`
+	Name                     string   `json:"name"`
+	Visibility               string   `json:"visibility"`
+	Default                  bool     `json:"default"`
+	Inherited                bool     `json:"inherited"`
+	AllowsPublicRepositories bool     `json:"allows_public_repositories"`
+	RestrictedToWorkflows    bool     `json:"restricted_to_workflows"`
+	SelectedWorkflows        []string `json:"selected_workflows,omitempty"`
+}
+
+// runnerLabelsResponse is the payload returned by the runner label endpoints, which go-github does not wrap.
+type runnerLabelsResponse struct {
+	TotalCount int                    `json:"total_count"`
+	Labels     []*github.RunnerLabels `json:"labels"`
+}
+
+// ListRunners creates a tool to list the self-hosted runners of an organization or repository.
+func ListRunners(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_runners",
+			mcp.WithDescription(t("TOOL_LIST_RUNNERS_DESCRIPTION", "List self-hosted GitHub Actions runners with their status, busy state and labels. Lists organization runners when only owner is given, or repository runners when repo is also given.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_LIST_RUNNERS_USER_TITLE", "List self-hosted runners"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Organization name, or repository owner when repo is provided"),
+			),
+			mcp.WithString("repo",
+				mcp.Description("Repository name. Omit to list organization runners."),
+			),
+			mcp.WithString("name",
+				mcp.Description("Only return runners with this name"),
+			),
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := OptionalParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			name, err := OptionalParam[string](request, "name")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			opts := &github.ListRunnersOptions{
+				ListOptions: github.ListOptions{
+					Page:    pagination.Page,
+					PerPage: pagination.PerPage,
+				},
+			}
+			if name != "" {
+				opts.Name = github.Ptr(name)
+			}
+
+			var runners *github.Runners
+			var resp *github.Response
+			if repo != "" {
+				runners, resp, err = client.Actions.ListRunners(ctx, owner, repo, opts)
+			} else {
+				runners, resp, err = client.Actions.ListOrganizationRunners(ctx, owner, opts)
+			}
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to list runners for %s", runnerScope(owner, repo)),
+					resp,
+					err,
+				), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to list runners: %s", string(body))), nil
+			}
+
+			minimalRunners := make([]MinimalRunner, 0, len(runners.Runners))
+			for _, runner := range runners.Runners {
+				minimalRunners = append(minimalRunners, convertToMinimalRunner(runner))
+			}
+
+			r, err := json.Marshal(NewListResponse("runners", minimalRunners, NewRESTPageInfo(resp), github.Ptr(runners.TotalCount)))
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// ListRunnerGroups creates a tool to list the self-hosted runner groups of an organization.
+func ListRunnerGroups(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_runner_groups",
+			mcp.WithDescription(t("TOOL_LIST_RUNNER_GROUPS_DESCRIPTION", "List the self-hosted runner groups of an organization, including their visibility and workflow restrictions.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_LIST_RUNNER_GROUPS_USER_TITLE", "List runner groups"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("org",
+				mcp.Required(),
+				mcp.Description("Organization name"),
+			),
+			mcp.WithString("visible_to_repository",
+				mcp.Description("Only return runner groups that the named repository is allowed to use"),
+			),
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			org, err := RequiredParam[string](request, "org")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			visibleToRepository, err := OptionalParam[string](request, "visible_to_repository")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			groups, resp, err := client.Actions.ListOrganizationRunnerGroups(ctx, org, &github.ListOrgRunnerGroupOptions{
+				VisibleToRepository: visibleToRepository,
+				ListOptions: github.ListOptions{
+					Page:    pagination.Page,
+					PerPage: pagination.PerPage,
+				},
+			})
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to list runner groups for '%s'", org),
+					resp,
+					err,
+				), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to list runner groups: %s", string(body))), nil
+			}
+
+			minimalGroups := make([]MinimalRunnerGroup, 0, len(groups.RunnerGroups))
+			for _, group := range groups.RunnerGroups {
+				minimalGroups = append(minimalGroups, MinimalRunnerGroup{
+					ID:                       group.GetID(),
+					Name:                     group.GetName(),
+					Visibility:               group.GetVisibility(),
+					Default:                  group.GetDefault(),
+					Inherited:                group.GetInherited(),
+					AllowsPublicRepositories: group.GetAllowsPublicRepositories(),
+					RestrictedToWorkflows:    group.GetRestrictedToWorkflows(),
+					SelectedWorkflows:        group.SelectedWorkflows,
+				})
+			}
+
+			r, err := json.Marshal(NewListResponse("runner_groups", minimalGroups, NewRESTPageInfo(resp), github.Ptr(groups.TotalCount)))
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// CreateRunnerToken creates a tool to generate a registration or removal token for self-hosted runners.
+func CreateRunnerToken(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("create_runner_token",
+			mcp.WithDescription(t("TOOL_CREATE_RUNNER_TOKEN_DESCRIPTION", "Generate a short-lived token for configuring self-hosted runners. A registration token is passed to config.sh to add a runner, a remove token to remove one. Tokens expire after one hour.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:           t("TOOL_CREATE_RUNNER_TOKEN_USER_TITLE", "Create runner token"),
+				ReadOnlyHint:    ToBoolPtr(false),
+				DestructiveHint: ToBoolPtr(false),
+				IdempotentHint:  ToBoolPtr(false),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Organization name, or repository owner when repo is provided"),
+			),
+			mcp.WithString("repo",
+				mcp.Description("Repository name. Omit to create a token for organization runners."),
+			),
+			mcp.WithString("token_type",
+				mcp.Required(),
+				mcp.Description("The kind of token to create"),
+				mcp.Enum("registration", "remove"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := OptionalParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			tokenType, err := RequiredParam[string](request, "token_type")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			var token string
+			var expiresAt *github.Timestamp
+			var resp *github.Response
+			switch tokenType {
+			case "registration":
+				var registrationToken *github.RegistrationToken
+				if repo != "" {
+					registrationToken, resp, err = client.Actions.CreateRegistrationToken(ctx, owner, repo)
+				} else {
+					registrationToken, resp, err = client.Actions.CreateOrganizationRegistrationToken(ctx, owner)
+				}
+				if err == nil {
+					token, expiresAt = registrationToken.GetToken(), registrationToken.ExpiresAt
+				}
+			case "remove":
+				var removeToken *github.RemoveToken
+				if repo != "" {
+					removeToken, resp, err = client.Actions.CreateRemoveToken(ctx, owner, repo)
+				} else {
+					removeToken, resp, err = client.Actions.CreateOrganizationRemoveToken(ctx, owner)
+				}
+				if err == nil {
+					token, expiresAt = removeToken.GetToken(), removeToken.ExpiresAt
+				}
+			default:
+				return mcp.NewToolResultError(fmt.Sprintf("unsupported token_type: %s", tokenType)), nil
+			}
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to create %s token for %s", tokenType, runnerScope(owner, repo)),
+					resp,
+					err,
+				), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusCreated {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to create runner token: %s", string(body))), nil
+			}
+
+			result := map[string]any{
+				"token_type": tokenType,
+				"token":      token,
+			}
+			if expiresAt != nil {
+				result["expires_at"] = FormatTimestamp(expiresAt.Time)
+			}
+
+			r, err := json.Marshal(result)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// UpdateRunnerLabels creates a tool to add and remove custom labels on a self-hosted runner.
+func UpdateRunnerLabels(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("update_runner_labels",
+			mcp.WithDescription(t("TOOL_UPDATE_RUNNER_LABELS_DESCRIPTION", "Add or remove custom labels on a self-hosted runner, for example to drain a runner from a label-targeted pool. Default labels such as self-hosted and the OS/architecture labels cannot be changed.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:           t("TOOL_UPDATE_RUNNER_LABELS_USER_TITLE", "Update runner labels"),
+				ReadOnlyHint:    ToBoolPtr(false),
+				DestructiveHint: ToBoolPtr(true),
+				IdempotentHint:  ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Organization name, or repository owner when repo is provided"),
+			),
+			mcp.WithString("repo",
+				mcp.Description("Repository name. Omit for organization runners."),
+			),
+			mcp.WithNumber("runner_id",
+				mcp.Required(),
+				mcp.Description("The unique identifier of the runner"),
+			),
+			mcp.WithArray("add_labels",
+				mcp.Description("Custom labels to add to the runner"),
+				mcp.Items(
+					map[string]any{
+						"type": "string",
+					},
+				),
+			),
+			mcp.WithArray("remove_labels",
+				mcp.Description("Custom labels to remove from the runner"),
+				mcp.Items(
+					map[string]any{
+						"type": "string",
+					},
+				),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := OptionalParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			runnerIDInt, err := RequiredInt(request, "runner_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			runnerID := int64(runnerIDInt)
+			addLabels, err := OptionalStringArrayParam(request, "add_labels")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			removeLabels, err := OptionalStringArrayParam(request, "remove_labels")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if len(addLabels) == 0 && len(removeLabels) == 0 {
+				return mcp.NewToolResultError("at least one of add_labels or remove_labels must be provided"), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			var runner *github.Runner
+			var resp *github.Response
+			if repo != "" {
+				runner, resp, err = client.Actions.GetRunner(ctx, owner, repo, runnerID)
+			} else {
+				runner, resp, err = client.Actions.GetOrganizationRunner(ctx, owner, runnerID)
+			}
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to get runner %d", runnerID),
+					resp,
+					err,
+				), nil
+			}
+			_ = resp.Body.Close()
+
+			// The labels endpoint replaces the full set of custom labels, so apply the
+			// requested changes on top of the runner's current custom labels.
+			labels := convertToMinimalRunner(runner).CustomLabels
+			for _, label := range addLabels {
+				if !slices.Contains(labels, label) {
+					labels = append(labels, label)
+				}
+			}
+			labels = slices.DeleteFunc(labels, func(label string) bool {
+				return slices.Contains(removeLabels, label)
+			})
+
+			u := fmt.Sprintf("orgs/%s/actions/runners/%d/labels", owner, runnerID)
+			if repo != "" {
+				u = fmt.Sprintf("repos/%s/%s/actions/runners/%d/labels", owner, repo, runnerID)
+			}
+			req, err := client.NewRequest(http.MethodPut, u, map[string][]string{"labels": labels})
+			if err != nil {
+				return nil, fmt.Errorf("failed to create request: %w", err)
+			}
+
+			updated := &runnerLabelsResponse{}
+			resp, err = client.Do(ctx, req, updated)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to update labels for runner %d", runnerID),
+					resp,
+					err,
+				), nil
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != http.StatusOK {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read response body: %w", err)
+				}
+				return mcp.NewToolResultError(fmt.Sprintf("failed to update runner labels: %s", string(body))), nil
+			}
+
+			runner.Labels = updated.Labels
+			r, err := json.Marshal(convertToMinimalRunner(runner))
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+func convertToMinimalRunner(runner *github.Runner) MinimalRunner {
+	minimal := MinimalRunner{
+		ID:           runner.GetID(),
+		Name:         runner.GetName(),
+		OS:           runner.GetOS(),
+		Status:       runner.GetStatus(),
+		Busy:         runner.GetBusy(),
+		Labels:       []string{},
+		CustomLabels: []string{},
+	}
+	for _, label := range runner.Labels {
+		minimal.Labels = append(minimal.Labels, label.GetName())
+		if label.GetType() == "custom" {
+			minimal.CustomLabels = append(minimal.CustomLabels, label.GetName())
+		}
+	}
+	return minimal
+}
+
+func runnerScope(owner, repo string) string {
+	if repo != "" {
+		return fmt.Sprintf("%s/%s", owner, repo)
+	}
+	return fmt.Sprintf("organization '%s'", owner)
+}
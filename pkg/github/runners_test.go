@@ -0,0 +1,377 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v79/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var mockRunner = &github.Runner{
+	ID:     github.Ptr(int64(42)),
+	Name:   github.Ptr("build-01"),
+	OS:     github.Ptr("linux"),
+	Status: github.Ptr("online"),
+	Busy:   github.Ptr(true),
+	Labels: []*github.RunnerLabels{
+		{Name: github.Ptr("self-hosted"), Type: github.Ptr("read-only")},
+		{Name: github.Ptr("linux"), Type: github.Ptr("read-only")},
+		{Name: github.Ptr("gpu"), Type: github.Ptr("custom")},
+	},
+}
+
+func Test_ListRunners(t *testing.T) {
+	mockClient := github.NewClient(nil)
+	tool, _ := ListRunners(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "list_runners", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "owner")
+	assert.Contains(t, tool.InputSchema.Properties, "repo")
+	assert.Contains(t, tool.InputSchema.Properties, "name")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner"})
+
+	runners := &github.Runners{TotalCount: 1, Runners: []*github.Runner{mockRunner}}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedErrMsg string
+	}{
+		{
+			name: "organization runners filtered by name",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetOrgsActionsRunnersByOrg,
+					expectQueryParams(t, map[string]string{
+						"name":     "build-01",
+						"page":     "1",
+						"per_page": "30",
+					}).andThen(
+						mockResponse(t, http.StatusOK, runners),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "octo-org",
+				"name":  "build-01",
+			},
+		},
+		{
+			name: "repository runners",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(mock.GetReposActionsRunnersByOwnerByRepo, runners),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "octo-org",
+				"repo":  "app",
+			},
+		},
+		{
+			name: "forbidden",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetOrgsActionsRunnersByOrg,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusForbidden)
+						_, _ = w.Write([]byte(`{"message": "Must have admin rights"}`))
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "octo-org",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to list runners for organization 'octo-org'",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := ListRunners(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+			require.NoError(t, err)
+
+			if tc.expectError {
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			textContent := getTextResult(t, result)
+			var response struct {
+				TotalCount int             `json:"total_count"`
+				Runners    []MinimalRunner `json:"runners"`
+			}
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &response))
+			assert.Equal(t, 1, response.TotalCount)
+			require.Len(t, response.Runners, 1)
+			assert.Equal(t, MinimalRunner{
+				ID:           42,
+				Name:         "build-01",
+				OS:           "linux",
+				Status:       "online",
+				Busy:         true,
+				Labels:       []string{"self-hosted", "linux", "gpu"},
+				CustomLabels: []string{"gpu"},
+			}, response.Runners[0])
+		})
+	}
+}
+
+func Test_ListRunnerGroups(t *testing.T) {
+	mockClient := github.NewClient(nil)
+	tool, _ := ListRunnerGroups(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "list_runner_groups", tool.Name)
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"org"})
+
+	client := github.NewClient(mock.NewMockedHTTPClient(
+		mock.WithRequestMatchHandler(
+			mock.GetOrgsActionsRunnerGroupsByOrg,
+			expectQueryParams(t, map[string]string{
+				"visible_to_repository": "app",
+				"page":                  "1",
+				"per_page":              "30",
+			}).andThen(
+				mockResponse(t, http.StatusOK, &github.RunnerGroups{
+					TotalCount: 1,
+					RunnerGroups: []*github.RunnerGroup{
+						{
+							ID:                    github.Ptr(int64(1)),
+							Name:                  github.Ptr("Default"),
+							Visibility:            github.Ptr("all"),
+							Default:               github.Ptr(true),
+							RestrictedToWorkflows: github.Ptr(false),
+						},
+					},
+				}),
+			),
+		),
+	))
+	_, handler := ListRunnerGroups(stubGetClientFn(client), translations.NullTranslationHelper)
+
+	result, err := handler(context.Background(), createMCPRequest(map[string]interface{}{
+		"org":                   "octo-org",
+		"visible_to_repository": "app",
+	}))
+	require.NoError(t, err)
+
+	textContent := getTextResult(t, result)
+	var response struct {
+		TotalCount   int                  `json:"total_count"`
+		RunnerGroups []MinimalRunnerGroup `json:"runner_groups"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(textContent.Text), &response))
+	require.Len(t, response.RunnerGroups, 1)
+	assert.Equal(t, "Default", response.RunnerGroups[0].Name)
+	assert.True(t, response.RunnerGroups[0].Default)
+}
+
+func Test_CreateRunnerToken(t *testing.T) {
+	mockClient := github.NewClient(nil)
+	tool, _ := CreateRunnerToken(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "create_runner_token", tool.Name)
+	assert.Contains(t, tool.InputSchema.Properties, "token_type")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "token_type"})
+
+	expiresAt := &github.Timestamp{Time: time.Date(2025, 3, 1, 13, 0, 0, 0, time.UTC)}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedToken  string
+		expectedErrMsg string
+	}{
+		{
+			name: "organization registration token",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostOrgsActionsRunnersRegistrationTokenByOrg,
+					mockResponse(t, http.StatusCreated, &github.RegistrationToken{Token: github.Ptr("REG123"), ExpiresAt: expiresAt}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":      "octo-org",
+				"token_type": "registration",
+			},
+			expectedToken: "REG123",
+		},
+		{
+			name: "repository remove token",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostReposActionsRunnersRemoveTokenByOwnerByRepo,
+					expectPath(t, "/repos/octo-org/app/actions/runners/remove-token").andThen(
+						mockResponse(t, http.StatusCreated, &github.RemoveToken{Token: github.Ptr("RM456"), ExpiresAt: expiresAt}),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":      "octo-org",
+				"repo":       "app",
+				"token_type": "remove",
+			},
+			expectedToken: "RM456",
+		},
+		{
+			name: "not an admin",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.PostOrgsActionsRunnersRegistrationTokenByOrg,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusForbidden)
+						_, _ = w.Write([]byte(`{"message": "Resource not accessible by integration"}`))
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":      "octo-org",
+				"token_type": "registration",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to create registration token for organization 'octo-org'",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := CreateRunnerToken(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+			require.NoError(t, err)
+
+			if tc.expectError {
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			textContent := getTextResult(t, result)
+			var response map[string]string
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &response))
+			assert.Equal(t, tc.expectedToken, response["token"])
+			assert.Equal(t, "2025-03-01T13:00:00Z", response["expires_at"])
+		})
+	}
+}
+
+func Test_UpdateRunnerLabels(t *testing.T) {
+	mockClient := github.NewClient(nil)
+	tool, _ := UpdateRunnerLabels(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "update_runner_labels", tool.Name)
+	assert.Contains(t, tool.InputSchema.Properties, "add_labels")
+	assert.Contains(t, tool.InputSchema.Properties, "remove_labels")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "runner_id"})
+
+	updatedLabels := runnerLabelsResponse{
+		TotalCount: 3,
+		Labels: []*github.RunnerLabels{
+			{Name: github.Ptr("self-hosted"), Type: github.Ptr("read-only")},
+			{Name: github.Ptr("linux"), Type: github.Ptr("read-only")},
+			{Name: github.Ptr("arm64"), Type: github.Ptr("custom")},
+		},
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedErrMsg string
+	}{
+		{
+			name: "swap custom labels on an organization runner",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(mock.GetOrgsActionsRunnersByOrgByRunnerId, mockRunner),
+				mock.WithRequestMatchHandler(
+					mock.PutOrgsActionsRunnersLabelsByOrgByRunnerId,
+					expectRequestBody(t, map[string]any{
+						"labels": []any{"arm64"},
+					}).andThen(
+						mockResponse(t, http.StatusOK, updatedLabels),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":         "octo-org",
+				"runner_id":     float64(42),
+				"add_labels":    []any{"arm64"},
+				"remove_labels": []any{"gpu"},
+			},
+		},
+		{
+			name:         "no label changes",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner":     "octo-org",
+				"runner_id": float64(42),
+			},
+			expectError:    true,
+			expectedErrMsg: "at least one of add_labels or remove_labels must be provided",
+		},
+		{
+			name: "runner not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposActionsRunnersByOwnerByRepoByRunnerId,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusNotFound)
+						_, _ = w.Write([]byte(`{"message": "Not Found"}`))
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":      "octo-org",
+				"repo":       "app",
+				"runner_id":  float64(7),
+				"add_labels": []any{"arm64"},
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to get runner 7",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := UpdateRunnerLabels(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+			require.NoError(t, err)
+
+			if tc.expectError {
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			textContent := getTextResult(t, result)
+			var runner MinimalRunner
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &runner))
+			assert.Equal(t, []string{"self-hosted", "linux", "arm64"}, runner.Labels)
+			assert.Equal(t, []string{"arm64"}, runner.CustomLabels)
+		})
+	}
+}
@@ -114,10 +114,10 @@ func SearchRepositories(getClient GetClientFn, t translations.TranslationHelperF
 					}
 
 					if repo.UpdatedAt != nil {
-						minimalRepo.UpdatedAt = repo.UpdatedAt.Format("2006-01-02T15:04:05Z")
+						minimalRepo.UpdatedAt = FormatTimestamp(repo.UpdatedAt.Time)
 					}
 					if repo.CreatedAt != nil {
-						minimalRepo.CreatedAt = repo.CreatedAt.Format("2006-01-02T15:04:05Z")
+						minimalRepo.CreatedAt = FormatTimestamp(repo.CreatedAt.Time)
 					}
 					if repo.Topics != nil {
 						minimalRepo.Topics = repo.Topics
@@ -166,6 +166,10 @@ func SearchCode(getClient GetClientFn, t translations.TranslationHelperFunc) (to
 				mcp.Description("Sort order for results"),
 				mcp.Enum("asc", "desc"),
 			),
+			mcp.WithString("mode",
+				mcp.Description("Search mode. 'text' (default) returns all matches. 'symbol' treats the query as a single function, class or type name plus optional qualifiers (e.g. 'ParseConfig language:go org:github') and returns only the matches that look like its definition."),
+				mcp.Enum("text", "symbol"),
+			),
 			WithPagination(),
 		),
 		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -173,6 +177,17 @@ func SearchCode(getClient GetClientFn, t translations.TranslationHelperFunc) (to
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
+			mode, err := OptionalParam[string](request, "mode")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			var symbol string
+			if mode == "symbol" {
+				symbol, err = parseSymbolQuery(query)
+				if err != nil {
+					return mcp.NewToolResultError(err.Error()), nil
+				}
+			}
 			sort, err := OptionalParam[string](request, "sort")
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
@@ -193,6 +208,8 @@ func SearchCode(getClient GetClientFn, t translations.TranslationHelperFunc) (to
 					PerPage: pagination.PerPage,
 					Page:    pagination.Page,
 				},
+				// Symbol mode filters on the matched fragments, so ask for them.
+				TextMatch: symbol != "",
 			}
 
 			client, err := getClient(ctx)
@@ -218,6 +235,19 @@ func SearchCode(getClient GetClientFn, t translations.TranslationHelperFunc) (to
 				return mcp.NewToolResultError(fmt.Sprintf("failed to search code: %s", string(body))), nil
 			}
 
+			if symbol != "" {
+				r, err := json.Marshal(map[string]any{
+					"symbol":             symbol,
+					"total_count":        result.GetTotal(),
+					"incomplete_results": result.GetIncompleteResults(),
+					"candidates":         filterSymbolDefinitions(result.CodeResults, symbol),
+				})
+				if err != nil {
+					return nil, fmt.Errorf("failed to marshal response: %w", err)
+				}
+				return mcp.NewToolResultText(string(r)), nil
+			}
+
 			r, err := json.Marshal(result)
 			if err != nil {
 				return nil, fmt.Errorf("failed to marshal response: %w", err)
@@ -239,6 +239,7 @@ func Test_SearchCode(t *testing.T) {
 	assert.Contains(t, tool.InputSchema.Properties, "query")
 	assert.Contains(t, tool.InputSchema.Properties, "sort")
 	assert.Contains(t, tool.InputSchema.Properties, "order")
+	assert.Contains(t, tool.InputSchema.Properties, "mode")
 	assert.Contains(t, tool.InputSchema.Properties, "perPage")
 	assert.Contains(t, tool.InputSchema.Properties, "page")
 	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"query"})
@@ -741,3 +742,109 @@ func Test_SearchOrgs(t *testing.T) {
 		})
 	}
 }
+
+func Test_SearchCode_SymbolMode(t *testing.T) {
+	mockSearchResult := &github.CodeSearchResult{
+		Total:             github.Ptr(3),
+		IncompleteResults: github.Ptr(false),
+		CodeResults: []*github.CodeResult{
+			{
+				Path:       github.Ptr("cmd/main.go"),
+				HTMLURL:    github.Ptr("https://github.com/owner/repo/blob/main/cmd/main.go"),
+				Repository: &github.Repository{FullName: github.Ptr("owner/repo")},
+				TextMatches: []*github.TextMatch{
+					{Fragment: github.Ptr("\tcfg, err := config.ParseConfig(path)\n\tif err != nil {")},
+				},
+			},
+			{
+				Path:       github.Ptr("config/config.go"),
+				HTMLURL:    github.Ptr("https://github.com/owner/repo/blob/main/config/config.go"),
+				Repository: &github.Repository{FullName: github.Ptr("owner/repo")},
+				TextMatches: []*github.TextMatch{
+					{Fragment: github.Ptr("// ParseConfig reads a config file.\nfunc ParseConfig(path string) (*Config, error) {")},
+				},
+			},
+			{
+				Path:       github.Ptr("docs/config.md"),
+				Repository: &github.Repository{FullName: github.Ptr("owner/repo")},
+				TextMatches: []*github.TextMatch{
+					{Fragment: github.Ptr("func ParseConfig(path string)")},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name               string
+		mockedClient       *http.Client
+		requestArgs        map[string]interface{}
+		expectError        bool
+		expectedErrMsg     string
+		expectedCandidates []SymbolCandidate
+	}{
+		{
+			name: "definitions are kept",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetSearchCode,
+					http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+						assert.Equal(t, "ParseConfig language:go", r.URL.Query().Get("q"))
+						assert.Contains(t, r.Header.Get("Accept"), "text-match")
+						mockResponse(t, http.StatusOK, mockSearchResult)(w, r)
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"query": "ParseConfig language:go",
+				"mode":  "symbol",
+			},
+			expectedCandidates: []SymbolCandidate{
+				{
+					Repository: "owner/repo",
+					Path:       "config/config.go",
+					HTMLURL:    "https://github.com/owner/repo/blob/main/config/config.go",
+					Language:   "go",
+					Kind:       "function",
+					Line:       "func ParseConfig(path string) (*Config, error) {",
+				},
+			},
+		},
+		{
+			name:         "more than one symbol",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"query": "ParseConfig LoadConfig",
+				"mode":  "symbol",
+			},
+			expectError:    true,
+			expectedErrMsg: "symbol mode expects a single symbol name",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := SearchCode(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+			require.NoError(t, err)
+
+			if tc.expectError {
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			textContent := getTextResult(t, result)
+			var response struct {
+				Symbol     string            `json:"symbol"`
+				TotalCount int               `json:"total_count"`
+				Candidates []SymbolCandidate `json:"candidates"`
+			}
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &response))
+			assert.Equal(t, "ParseConfig", response.Symbol)
+			assert.Equal(t, 3, response.TotalCount)
+			assert.Equal(t, tc.expectedCandidates, response.Candidates)
+		})
+	}
+}
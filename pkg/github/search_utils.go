@@ -38,6 +38,7 @@ func hasTypeFilter(query string) bool {
 func searchHandler(
 	ctx context.Context,
 	getClient GetClientFn,
+	getGQLClient GetGQLClientFn,
 	request mcp.CallToolRequest,
 	searchType string,
 	errorPrefix string,
@@ -73,6 +74,10 @@ func searchHandler(
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
+	pathPrefix, err := OptionalParam[string](request, "path_prefix")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
 	pagination, err := OptionalPaginationParams(request)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
@@ -106,6 +111,13 @@ func searchHandler(
 		return mcp.NewToolResultError(fmt.Sprintf("%s: %s", errorPrefix, string(body))), nil
 	}
 
+	if pathPrefix != "" {
+		var errResult *mcp.CallToolResult
+		if result.Issues, errResult = filterSearchResultsByPath(ctx, client, getGQLClient, result.Issues, normalizePathPrefix(pathPrefix)); errResult != nil {
+			return errResult, nil
+		}
+	}
+
 	r, err := json.Marshal(result)
 	if err != nil {
 		return nil, fmt.Errorf("%s: failed to marshal response: %w", errorPrefix, err)
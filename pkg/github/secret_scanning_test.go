@@ -159,7 +159,9 @@ func Test_ListSecretScanningAlerts(t *testing.T) {
 				mock.WithRequestMatchHandler(
 					mock.GetReposSecretScanningAlertsByOwnerByRepo,
 					expectQueryParams(t, map[string]string{
-						"state": "resolved",
+						"state":    "resolved",
+						"page":     "1",
+						"per_page": "30",
 					}).andThen(
 						mockResponse(t, http.StatusOK, []*github.SecretScanningAlert{&resolvedAlert}),
 					),
@@ -178,7 +180,10 @@ func Test_ListSecretScanningAlerts(t *testing.T) {
 			mockedClient: mock.NewMockedHTTPClient(
 				mock.WithRequestMatchHandler(
 					mock.GetReposSecretScanningAlertsByOwnerByRepo,
-					expectQueryParams(t, map[string]string{}).andThen(
+					expectQueryParams(t, map[string]string{
+						"page":     "1",
+						"per_page": "30",
+					}).andThen(
 						mockResponse(t, http.StatusOK, []*github.SecretScanningAlert{&resolvedAlert, &openAlert}),
 					),
 				),
@@ -233,8 +238,11 @@ func Test_ListSecretScanningAlerts(t *testing.T) {
 			textContent := getTextResult(t, result)
 
 			// Unmarshal and verify the result
-			var returnedAlerts []*github.SecretScanningAlert
-			err = json.Unmarshal([]byte(textContent.Text), &returnedAlerts)
+			var response struct {
+				Alerts []*github.SecretScanningAlert `json:"alerts"`
+			}
+			err = json.Unmarshal([]byte(textContent.Text), &response)
+			returnedAlerts := response.Alerts
 			assert.NoError(t, err)
 			assert.Len(t, returnedAlerts, len(tc.expectedAlerts))
 			for i, alert := range returnedAlerts {
@@ -173,7 +173,7 @@ func ListGlobalSecurityAdvisories(getClient GetClientFn, t translations.Translat
 				return mcp.NewToolResultError(fmt.Sprintf("failed to list advisories: %s", string(body))), nil
 			}
 
-			r, err := json.Marshal(advisories)
+			r, err := json.Marshal(NewListResponse("advisories", advisories, NewRESTPageInfo(resp), nil))
 			if err != nil {
 				return nil, fmt.Errorf("failed to marshal advisories: %w", err)
 			}
@@ -262,7 +262,7 @@ func ListRepositorySecurityAdvisories(getClient GetClientFn, t translations.Tran
 				return mcp.NewToolResultError(fmt.Sprintf("failed to list repository advisories: %s", string(body))), nil
 			}
 
-			r, err := json.Marshal(advisories)
+			r, err := json.Marshal(NewListResponse("advisories", advisories, NewRESTPageInfo(resp), nil))
 			if err != nil {
 				return nil, fmt.Errorf("failed to marshal advisories: %w", err)
 			}
@@ -387,7 +387,7 @@ func ListOrgRepositorySecurityAdvisories(getClient GetClientFn, t translations.T
 				return mcp.NewToolResultError(fmt.Sprintf("failed to list organization repository advisories: %s", string(body))), nil
 			}
 
-			r, err := json.Marshal(advisories)
+			r, err := json.Marshal(NewListResponse("advisories", advisories, NewRESTPageInfo(resp), nil))
 			if err != nil {
 				return nil, fmt.Errorf("failed to marshal advisories: %w", err)
 			}
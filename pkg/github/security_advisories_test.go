@@ -118,8 +118,11 @@ func Test_ListGlobalSecurityAdvisories(t *testing.T) {
 			textContent := getTextResult(t, result)
 
 			// Unmarshal and verify the result
-			var returnedAdvisories []*github.GlobalSecurityAdvisory
-			err = json.Unmarshal([]byte(textContent.Text), &returnedAdvisories)
+			var response struct {
+				Advisories []*github.GlobalSecurityAdvisory `json:"advisories"`
+			}
+			err = json.Unmarshal([]byte(textContent.Text), &response)
+			returnedAdvisories := response.Advisories
 			assert.NoError(t, err)
 			assert.Len(t, returnedAdvisories, len(tc.expectedAdvisories))
 			for i, advisory := range returnedAdvisories {
@@ -372,8 +375,11 @@ func Test_ListRepositorySecurityAdvisories(t *testing.T) {
 
 			textContent := getTextResult(t, result)
 
-			var returnedAdvisories []*github.SecurityAdvisory
-			err = json.Unmarshal([]byte(textContent.Text), &returnedAdvisories)
+			var response struct {
+				Advisories []*github.SecurityAdvisory `json:"advisories"`
+			}
+			err = json.Unmarshal([]byte(textContent.Text), &response)
+			returnedAdvisories := response.Advisories
 			assert.NoError(t, err)
 			assert.Len(t, returnedAdvisories, len(tc.expectedAdvisories))
 			for i, advisory := range returnedAdvisories {
@@ -511,8 +517,11 @@ func Test_ListOrgRepositorySecurityAdvisories(t *testing.T) {
 
 			textContent := getTextResult(t, result)
 
-			var returnedAdvisories []*github.SecurityAdvisory
-			err = json.Unmarshal([]byte(textContent.Text), &returnedAdvisories)
+			var response struct {
+				Advisories []*github.SecurityAdvisory `json:"advisories"`
+			}
+			err = json.Unmarshal([]byte(textContent.Text), &response)
+			returnedAdvisories := response.Advisories
 			assert.NoError(t, err)
 			assert.Len(t, returnedAdvisories, len(tc.expectedAdvisories))
 			for i, advisory := range returnedAdvisories {
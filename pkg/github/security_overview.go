@@ -0,0 +1,297 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v79/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// AlertCounts counts the open alerts of one kind by severity. Secret scanning alerts have no severity and are
+// counted by the validity of the leaked secret instead.
+type AlertCounts struct {
+	Total       int            `json:"total"`
+	BySeverity  map[string]int `json:"by_severity,omitempty"`
+	ByValidity  map[string]int `json:"by_validity,omitempty"`
+	Unavailable string         `json:"unavailable,omitempty"`
+}
+
+// RepositorySecurityOverview counts the open security alerts of a repository.
+type RepositorySecurityOverview struct {
+	Repository     string      `json:"repository"`
+	Total          int         `json:"total"`
+	Dependabot     AlertCounts `json:"dependabot"`
+	CodeScanning   AlertCounts `json:"code_scanning"`
+	SecretScanning AlertCounts `json:"secret_scanning"`
+}
+
+// SecurityOverview counts the open security alerts of a repository or of all repositories of an organization.
+type SecurityOverview struct {
+	RepositorySecurityOverview
+	Repositories []RepositorySecurityOverview `json:"repositories,omitempty"`
+}
+
+// Kinds of security alerts.
+const (
+	alertKindDependabot     = "dependabot"
+	alertKindCodeScanning   = "code_scanning"
+	alertKindSecretScanning = "secret_scanning"
+)
+
+// securityAlert is the part of an alert the security overview counts and sync_alerts_to_project tracks.
+type securityAlert struct {
+	kind       string
+	repository string
+	number     int
+	title      string
+	htmlURL    string
+	severity   string
+	validity   string
+}
+
+// id identifies an alert across kinds and repositories, e.g. "dependabot:octo/app#12".
+func (a securityAlert) id() string {
+	return fmt.Sprintf("%s:%s#%d", a.kind, a.repository, a.number)
+}
+
+// alertRepository returns the full name of the repository of an alert. Repository alert listings do not include
+// the repository.
+func alertRepository(repository *github.Repository, owner, repo string) string {
+	if repo != "" {
+		return owner + "/" + repo
+	}
+	return repository.GetFullName()
+}
+
+// nextAlertsPage moves alert list options to the next page of a response, following a cursor when the endpoint
+// paginates by cursor. It reports whether there is a next page.
+func nextAlertsPage(resp *github.Response, listOpts *github.ListOptions, cursorOpts *github.ListCursorOptions) bool {
+	switch {
+	case resp.After != "":
+		cursorOpts.After = resp.After
+	case resp.NextPage != 0:
+		listOpts.Page = resp.NextPage
+	default:
+		return false
+	}
+	return true
+}
+
+// listOpenDependabotAlerts lists the open Dependabot alerts of a repository, or of an organization when repo is
+// empty.
+func listOpenDependabotAlerts(ctx context.Context, client *github.Client, owner, repo string) ([]securityAlert, *github.Response, error) {
+	opts := &github.ListAlertsOptions{State: ToStringPtr("open"), ListOptions: github.ListOptions{PerPage: 100}}
+	var result []securityAlert
+	for {
+		var alerts []*github.DependabotAlert
+		var resp *github.Response
+		var err error
+		if repo != "" {
+			alerts, resp, err = client.Dependabot.ListRepoAlerts(ctx, owner, repo, opts)
+		} else {
+			alerts, resp, err = client.Dependabot.ListOrgAlerts(ctx, owner, opts)
+		}
+		if err != nil {
+			return nil, resp, err
+		}
+		_ = resp.Body.Close()
+		for _, alert := range alerts {
+			title := alert.GetSecurityAdvisory().GetSummary()
+			if pkg := alert.GetDependency().GetPackage().GetName(); pkg != "" {
+				title = fmt.Sprintf("%s (%s)", title, pkg)
+			}
+			result = append(result, securityAlert{
+				kind:       alertKindDependabot,
+				repository: alertRepository(alert.GetRepository(), owner, repo),
+				number:     alert.GetNumber(),
+				title:      title,
+				htmlURL:    alert.GetHTMLURL(),
+				severity:   alert.GetSecurityAdvisory().GetSeverity(),
+			})
+		}
+		if !nextAlertsPage(resp, &opts.ListOptions, &opts.ListCursorOptions) {
+			return result, resp, nil
+		}
+	}
+}
+
+// listOpenCodeScanningAlerts lists the open code scanning alerts of a repository, or of an organization when repo
+// is empty. Alerts of security rules are counted by their security severity, other alerts by the rule severity.
+func listOpenCodeScanningAlerts(ctx context.Context, client *github.Client, owner, repo string) ([]securityAlert, *github.Response, error) {
+	opts := &github.AlertListOptions{State: "open", ListOptions: github.ListOptions{PerPage: 100}}
+	var result []securityAlert
+	for {
+		var alerts []*github.Alert
+		var resp *github.Response
+		var err error
+		if repo != "" {
+			alerts, resp, err = client.CodeScanning.ListAlertsForRepo(ctx, owner, repo, opts)
+		} else {
+			alerts, resp, err = client.CodeScanning.ListAlertsForOrg(ctx, owner, opts)
+		}
+		if err != nil {
+			return nil, resp, err
+		}
+		_ = resp.Body.Close()
+		for _, alert := range alerts {
+			severity := alert.GetRule().GetSecuritySeverityLevel()
+			if severity == "" {
+				severity = alert.GetRule().GetSeverity()
+			}
+			result = append(result, securityAlert{
+				kind:       alertKindCodeScanning,
+				repository: alertRepository(alert.GetRepository(), owner, repo),
+				number:     alert.GetNumber(),
+				title:      alert.GetRule().GetDescription(),
+				htmlURL:    alert.GetHTMLURL(),
+				severity:   severity,
+			})
+		}
+		if !nextAlertsPage(resp, &opts.ListOptions, &opts.ListCursorOptions) {
+			return result, resp, nil
+		}
+	}
+}
+
+// listOpenSecretScanningAlerts lists the open secret scanning alerts of a repository, or of an organization when
+// repo is empty.
+func listOpenSecretScanningAlerts(ctx context.Context, client *github.Client, owner, repo string) ([]securityAlert, *github.Response, error) {
+	opts := &github.SecretScanningAlertListOptions{State: "open", ListOptions: github.ListOptions{PerPage: 100}}
+	var result []securityAlert
+	for {
+		var alerts []*github.SecretScanningAlert
+		var resp *github.Response
+		var err error
+		if repo != "" {
+			alerts, resp, err = client.SecretScanning.ListAlertsForRepo(ctx, owner, repo, opts)
+		} else {
+			alerts, resp, err = client.SecretScanning.ListAlertsForOrg(ctx, owner, opts)
+		}
+		if err != nil {
+			return nil, resp, err
+		}
+		_ = resp.Body.Close()
+		for _, alert := range alerts {
+			result = append(result, securityAlert{
+				kind:       alertKindSecretScanning,
+				repository: alertRepository(alert.GetRepository(), owner, repo),
+				number:     alert.GetNumber(),
+				title:      alert.GetSecretTypeDisplayName(),
+				htmlURL:    alert.GetHTMLURL(),
+				validity:   alert.GetValidity(),
+			})
+		}
+		if !nextAlertsPage(resp, &opts.ListOptions, &opts.ListCursorOptions) {
+			return result, resp, nil
+		}
+	}
+}
+
+// add counts an alert.
+func (c *AlertCounts) add(alert securityAlert) {
+	c.Total++
+	if alert.severity != "" {
+		if c.BySeverity == nil {
+			c.BySeverity = map[string]int{}
+		}
+		c.BySeverity[alert.severity]++
+	}
+	if alert.validity != "" {
+		if c.ByValidity == nil {
+			c.ByValidity = map[string]int{}
+		}
+		c.ByValidity[alert.validity]++
+	}
+}
+
+// GetSecurityOverview creates a tool that counts the open Dependabot, code scanning and secret scanning alerts of a
+// repository or an organization by severity.
+func GetSecurityOverview(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_security_overview",
+			mcp.WithDescription(t("TOOL_GET_SECURITY_OVERVIEW_DESCRIPTION", "Count the open Dependabot, code scanning and secret scanning alerts of a repository, or of all repositories of an organization, by severity in one response. Secret scanning alerts have no severity and are counted by the validity of the secret. An alert kind that is not enabled or not accessible is reported as unavailable.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_GET_SECURITY_OVERVIEW_USER_TITLE", "Get security alert overview"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Owner of the repository, or the organization to summarize"),
+			),
+			mcp.WithString("repo",
+				mcp.Description("Repository name. Omit to summarize all repositories of the organization."),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := OptionalParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			overview := SecurityOverview{RepositorySecurityOverview: RepositorySecurityOverview{Repository: owner}}
+			if repo != "" {
+				overview.Repository = owner + "/" + repo
+			}
+			repositories := map[string]*RepositorySecurityOverview{}
+			kinds := []struct {
+				name   string
+				list   func(context.Context, *github.Client, string, string) ([]securityAlert, *github.Response, error)
+				counts func(*RepositorySecurityOverview) *AlertCounts
+			}{
+				{"Dependabot", listOpenDependabotAlerts, func(o *RepositorySecurityOverview) *AlertCounts { return &o.Dependabot }},
+				{"code scanning", listOpenCodeScanningAlerts, func(o *RepositorySecurityOverview) *AlertCounts { return &o.CodeScanning }},
+				{"secret scanning", listOpenSecretScanningAlerts, func(o *RepositorySecurityOverview) *AlertCounts { return &o.SecretScanning }},
+			}
+			for _, kind := range kinds {
+				alerts, resp, err := kind.list(ctx, client, owner, repo)
+				if err != nil {
+					// Alert kinds that are disabled, or that the token may not read, should not hide the others.
+					if resp != nil && (resp.StatusCode == http.StatusForbidden || resp.StatusCode == http.StatusNotFound) {
+						kind.counts(&overview.RepositorySecurityOverview).Unavailable = fmt.Sprintf("%s alerts are not enabled or not accessible: %v", kind.name, err)
+						continue
+					}
+					return ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("failed to list %s alerts of %s", kind.name, overview.Repository), resp, err), nil
+				}
+				for _, alert := range alerts {
+					kind.counts(&overview.RepositorySecurityOverview).add(alert)
+					overview.Total++
+					if repo != "" {
+						continue
+					}
+					repository, ok := repositories[alert.repository]
+					if !ok {
+						repository = &RepositorySecurityOverview{Repository: alert.repository}
+						repositories[alert.repository] = repository
+					}
+					kind.counts(repository).add(alert)
+					repository.Total++
+				}
+			}
+
+			for _, repository := range repositories {
+				overview.Repositories = append(overview.Repositories, *repository)
+			}
+			sort.Slice(overview.Repositories, func(i, j int) bool {
+				if overview.Repositories[i].Total != overview.Repositories[j].Total {
+					return overview.Repositories[i].Total > overview.Repositories[j].Total
+				}
+				return overview.Repositories[i].Repository < overview.Repositories[j].Repository
+			})
+
+			return MarshalledTextResult(overview), nil
+		}
+}
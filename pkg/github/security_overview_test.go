@@ -0,0 +1,91 @@
+package github
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/ghmock"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_GetSecurityOverview(t *testing.T) {
+	tool, _ := GetSecurityOverview(nil, translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+	assert.True(t, *tool.Annotations.ReadOnlyHint)
+	assert.ElementsMatch(t, []string{"owner"}, tool.InputSchema.Required)
+
+	call := func(t *testing.T, server *ghmock.Server, args map[string]any) SecurityOverview {
+		_, handler := GetSecurityOverview(server.GetClient(), translations.NullTranslationHelper)
+		result := ghmock.CallTool(t, handler, args)
+		require.False(t, result.IsError, ghmock.ResultText(t, result))
+		var overview SecurityOverview
+		require.NoError(t, json.Unmarshal([]byte(ghmock.ResultText(t, result)), &overview))
+		return overview
+	}
+
+	t.Run("repository", func(t *testing.T) {
+		server := ghmock.New(t)
+		server.Respond("GET /repos/octo/app/dependabot/alerts", http.StatusOK, []map[string]any{
+			{"number": 1, "security_advisory": map[string]any{"severity": "critical"}},
+			{"number": 2, "security_advisory": map[string]any{"severity": "high"}},
+			{"number": 3, "security_advisory": map[string]any{"severity": "high"}},
+		})
+		server.Respond("GET /repos/octo/app/code-scanning/alerts", http.StatusNotFound, map[string]any{"message": "no analysis found"})
+		server.Respond("GET /repos/octo/app/secret-scanning/alerts", http.StatusOK, []map[string]any{
+			{"number": 1, "validity": "active"},
+		})
+
+		overview := call(t, server, map[string]any{"owner": "octo", "repo": "app"})
+		assert.Equal(t, "octo/app", overview.Repository)
+		assert.Equal(t, 4, overview.Total)
+		assert.Equal(t, AlertCounts{Total: 3, BySeverity: map[string]int{"critical": 1, "high": 2}}, overview.Dependabot)
+		assert.Contains(t, overview.CodeScanning.Unavailable, "code scanning alerts are not enabled or not accessible")
+		assert.Equal(t, AlertCounts{Total: 1, ByValidity: map[string]int{"active": 1}}, overview.SecretScanning)
+		assert.Empty(t, overview.Repositories)
+		assert.Equal(t, "open", server.AssertRequested("GET /repos/octo/app/dependabot/alerts").Query.Get("state"))
+	})
+
+	t.Run("organization", func(t *testing.T) {
+		server := ghmock.New(t)
+		server.HandleFunc("GET /orgs/octo/dependabot/alerts", func(w http.ResponseWriter, r *http.Request) {
+			repository := map[string]any{"full_name": "octo/app"}
+			if r.URL.Query().Get("after") == "" {
+				w.Header().Set("Link", `<`+server.URL()+`/orgs/octo/dependabot/alerts?after=next>; rel="next"`)
+			} else {
+				repository = map[string]any{"full_name": "octo/lib"}
+			}
+			_ = json.NewEncoder(w).Encode([]map[string]any{
+				{"number": 1, "security_advisory": map[string]any{"severity": "medium"}, "repository": repository},
+			})
+		})
+		server.Respond("GET /orgs/octo/code-scanning/alerts", http.StatusOK, []map[string]any{
+			{"number": 1, "rule": map[string]any{"severity": "error", "security_severity_level": "high"}, "repository": map[string]any{"full_name": "octo/lib"}},
+			{"number": 2, "rule": map[string]any{"severity": "warning"}, "repository": map[string]any{"full_name": "octo/lib"}},
+		})
+		server.Respond("GET /orgs/octo/secret-scanning/alerts", http.StatusOK, []map[string]any{})
+
+		overview := call(t, server, map[string]any{"owner": "octo"})
+		assert.Equal(t, "octo", overview.Repository)
+		assert.Equal(t, 4, overview.Total)
+		assert.Equal(t, AlertCounts{Total: 2, BySeverity: map[string]int{"medium": 2}}, overview.Dependabot)
+		assert.Equal(t, AlertCounts{Total: 2, BySeverity: map[string]int{"high": 1, "warning": 1}}, overview.CodeScanning)
+		require.Len(t, overview.Repositories, 2)
+		assert.Equal(t, "octo/lib", overview.Repositories[0].Repository)
+		assert.Equal(t, 3, overview.Repositories[0].Total)
+		assert.Equal(t, "octo/app", overview.Repositories[1].Repository)
+		assert.Equal(t, 1, overview.Repositories[1].Dependabot.Total)
+	})
+
+	t.Run("API failure", func(t *testing.T) {
+		server := ghmock.New(t)
+		server.Respond("GET /repos/octo/app/dependabot/alerts", http.StatusInternalServerError, map[string]any{"message": "boom"})
+		_, handler := GetSecurityOverview(server.GetClient(), translations.NullTranslationHelper)
+		result := ghmock.CallTool(t, handler, map[string]any{"owner": "octo", "repo": "app"})
+		require.True(t, result.IsError)
+		assert.Contains(t, ghmock.ResultText(t, result), "failed to list Dependabot alerts of octo/app")
+	})
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"strconv"
 
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
 	"github.com/google/go-github/v79/github"
 	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/mark3labs/mcp-go/server"
@@ -19,6 +20,8 @@ func NewServer(version string, opts ...server.ServerOption) *server.MCPServer {
 		server.WithToolCapabilities(true),
 		server.WithResourceCapabilities(true, true),
 		server.WithLogging(),
+		// Report tool errors as JSON with a machine-readable code.
+		server.WithToolHandlerMiddleware(ghErrors.StructuredToolErrors),
 	}
 	opts = append(defaultOpts, opts...)
 
@@ -297,7 +300,7 @@ func WithUnifiedPagination() mcp.ToolOption {
 		)(tool)
 
 		mcp.WithString("after",
-			mcp.Description("Cursor for pagination. Use the endCursor from the previous page's PageInfo for GraphQL APIs."),
+			mcp.Description("Cursor for pagination. Use the end_cursor from the previous response's page_info."),
 		)(tool)
 	}
 }
@@ -312,7 +315,7 @@ func WithCursorPagination() mcp.ToolOption {
 		)(tool)
 
 		mcp.WithString("after",
-			mcp.Description("Cursor for pagination. Use the endCursor from the previous page's PageInfo for GraphQL APIs."),
+			mcp.Description("Cursor for pagination. Use the end_cursor from the previous response's page_info."),
 		)(tool)
 	}
 }
@@ -408,6 +411,56 @@ func (p PaginationParams) ToGraphQLParams() (*GraphQLPaginationParams, error) {
 	return cursor.ToGraphQLParams()
 }
 
+// PageInfo is the pagination state every list tool returns under "page_info". Tools paginated by page number set
+// NextPage, cursor based tools (GraphQL and the Projects API) set the cursors.
+type PageInfo struct {
+	HasNextPage     bool   `json:"has_next_page"`
+	HasPreviousPage bool   `json:"has_previous_page"`
+	StartCursor     string `json:"start_cursor,omitempty"`
+	EndCursor       string `json:"end_cursor,omitempty"`
+	NextPage        int    `json:"next_page,omitempty"`
+}
+
+// NewRESTPageInfo builds the page info of a REST API response from the pagination links GitHub returned.
+func NewRESTPageInfo(resp *github.Response) PageInfo {
+	if resp == nil {
+		return PageInfo{}
+	}
+	return PageInfo{
+		HasNextPage:     resp.NextPage != 0 || resp.After != "",
+		HasPreviousPage: resp.PrevPage != 0 || resp.Before != "",
+		StartCursor:     resp.Before,
+		EndCursor:       resp.After,
+		NextPage:        resp.NextPage,
+	}
+}
+
+// NewGraphQLPageInfo builds the page info of a GraphQL connection.
+func NewGraphQLPageInfo(hasNextPage, hasPreviousPage bool, startCursor, endCursor string) PageInfo {
+	return PageInfo{
+		HasNextPage:     hasNextPage,
+		HasPreviousPage: hasPreviousPage,
+		StartCursor:     startCursor,
+		EndCursor:       endCursor,
+	}
+}
+
+// NewListResponse builds the response of a list tool: the items under key, the page info and, when the API
+// reports one, the total count.
+func NewListResponse[T any](key string, items []T, pageInfo PageInfo, totalCount *int) map[string]any {
+	if items == nil {
+		items = []T{}
+	}
+	response := map[string]any{
+		key:         items,
+		"page_info": pageInfo,
+	}
+	if totalCount != nil {
+		response["total_count"] = *totalCount
+	}
+	return response
+}
+
 func MarshalledTextResult(v any) *mcp.CallToolResult {
 	data, err := json.Marshal(v)
 	if err != nil {
@@ -573,3 +573,57 @@ func TestOptionalPaginationParams(t *testing.T) {
 		})
 	}
 }
+
+func Test_NewRESTPageInfo(t *testing.T) {
+	tests := []struct {
+		name     string
+		resp     *github.Response
+		expected PageInfo
+	}{
+		{
+			name:     "nil response",
+			resp:     nil,
+			expected: PageInfo{},
+		},
+		{
+			name:     "last page",
+			resp:     &github.Response{PrevPage: 2},
+			expected: PageInfo{HasPreviousPage: true},
+		},
+		{
+			name:     "page number pagination",
+			resp:     &github.Response{NextPage: 3, PrevPage: 1},
+			expected: PageInfo{HasNextPage: true, HasPreviousPage: true, NextPage: 3},
+		},
+		{
+			name:     "cursor pagination",
+			resp:     &github.Response{After: "next", Before: "prev"},
+			expected: PageInfo{HasNextPage: true, HasPreviousPage: true, StartCursor: "prev", EndCursor: "next"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, NewRESTPageInfo(tc.resp))
+		})
+	}
+}
+
+func Test_NewListResponse(t *testing.T) {
+	pageInfo := NewGraphQLPageInfo(true, false, "start", "end")
+
+	response := NewListResponse("items", []string{"a", "b"}, pageInfo, github.Ptr(5))
+	r, err := json.Marshal(response)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{
+		"items": ["a", "b"],
+		"page_info": {"has_next_page": true, "has_previous_page": false, "start_cursor": "start", "end_cursor": "end"},
+		"total_count": 5
+	}`, string(r))
+
+	// A nil slice is returned as an empty list and the total count is left out when unknown.
+	response = NewListResponse[string]("items", nil, PageInfo{}, nil)
+	r, err = json.Marshal(response)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"items": [], "page_info": {"has_next_page": false, "has_previous_page": false}}`, string(r))
+}
@@ -0,0 +1,306 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v79/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// MaxStackedPullRequests is the maximum number of pull requests create_stacked_pull_requests creates in one call.
+const MaxStackedPullRequests = 10
+
+// StackedPullRequest is a pull request of a stack, created or restacked.
+type StackedPullRequest struct {
+	Number       int    `json:"number"`
+	Title        string `json:"title"`
+	URL          string `json:"url"`
+	Base         string `json:"base"`
+	Head         string `json:"head"`
+	PreviousBase string `json:"previous_base,omitempty"`
+	BranchUpdate string `json:"branch_update,omitempty"`
+}
+
+// stackedPullRequestSpec is a pull request to create with create_stacked_pull_requests.
+type stackedPullRequestSpec struct {
+	Head  string `json:"head"`
+	Title string `json:"title"`
+	Body  string `json:"body"`
+	Draft bool   `json:"draft"`
+}
+
+// stackedPullRequestSpecsParam decodes and checks the pull_requests parameter.
+func stackedPullRequestSpecsParam(request mcp.CallToolRequest) ([]stackedPullRequestSpec, error) {
+	raw, ok := request.GetArguments()["pull_requests"].([]any)
+	if !ok {
+		return nil, fmt.Errorf("missing required parameter: pull_requests")
+	}
+	if len(raw) == 0 || len(raw) > MaxStackedPullRequests {
+		return nil, fmt.Errorf("pull_requests must contain between 1 and %d pull requests, got %d", MaxStackedPullRequests, len(raw))
+	}
+	data, err := json.Marshal(raw)
+	if err != nil {
+		return nil, fmt.Errorf("invalid pull_requests: %w", err)
+	}
+	var specs []stackedPullRequestSpec
+	if err := json.Unmarshal(data, &specs); err != nil {
+		return nil, fmt.Errorf("invalid pull_requests: %w", err)
+	}
+	heads := map[string]bool{}
+	for i, spec := range specs {
+		switch {
+		case strings.TrimSpace(spec.Head) == "":
+			return nil, fmt.Errorf("pull_requests[%d]: missing required field: head", i)
+		case strings.TrimSpace(spec.Title) == "":
+			return nil, fmt.Errorf("pull_requests[%d]: missing required field: title", i)
+		case heads[spec.Head]:
+			return nil, fmt.Errorf("pull_requests[%d]: branch %s is already in the stack", i, spec.Head)
+		}
+		heads[spec.Head] = true
+	}
+	return specs, nil
+}
+
+// stackedPullRequest returns a pull request as a pull request of a stack.
+func stackedPullRequest(pr *github.PullRequest) StackedPullRequest {
+	return StackedPullRequest{
+		Number: pr.GetNumber(),
+		Title:  pr.GetTitle(),
+		URL:    pr.GetHTMLURL(),
+		Base:   pr.GetBase().GetRef(),
+		Head:   pr.GetHead().GetRef(),
+	}
+}
+
+// CreateStackedPullRequests creates a tool that opens a stack of pull requests, each targeting the branch of the
+// previous one.
+func CreateStackedPullRequests(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("create_stacked_pull_requests",
+			mcp.WithDescription(t("TOOL_CREATE_STACKED_PULL_REQUESTS_DESCRIPTION", fmt.Sprintf("Create a stack of up to %d pull requests from existing branches, in order: the first targets the base branch and each of the others targets the branch of the one before it, so that each shows only its own changes. Reports the result of every pull request. Use restack_pull_requests when a pull request of the stack merges.", MaxStackedPullRequests))),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:           t("TOOL_CREATE_STACKED_PULL_REQUESTS_USER_TITLE", "Create stacked pull requests"),
+				ReadOnlyHint:    ToBoolPtr(false),
+				DestructiveHint: ToBoolPtr(false),
+				IdempotentHint:  ToBoolPtr(false),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryOwner),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryName),
+			),
+			mcp.WithString("base",
+				mcp.Description("Branch the first pull request targets. Defaults to the default branch of the repository."),
+			),
+			mcp.WithArray("pull_requests",
+				mcp.Required(),
+				mcp.Description("Pull requests of the stack, bottom first"),
+				mcp.Items(map[string]any{
+					"type":     "object",
+					"required": []string{"head", "title"},
+					"properties": map[string]any{
+						"head":  map[string]any{"type": "string", "description": "Branch containing the changes of the pull request"},
+						"title": map[string]any{"type": "string", "description": "PR title"},
+						"body":  map[string]any{"type": "string", "description": "PR description"},
+						"draft": map[string]any{"type": "boolean", "description": "Create as draft PR"},
+					},
+				}),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			base, err := OptionalParam[string](request, "base")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			specs, err := stackedPullRequestSpecsParam(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			if base == "" {
+				repository, resp, err := client.Repositories.Get(ctx, owner, repo)
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get repository", resp, err), nil
+				}
+				_ = resp.Body.Close()
+				base = repository.GetDefaultBranch()
+			}
+			for i, spec := range specs {
+				if spec.Head == base {
+					return mcp.NewToolResultError(fmt.Sprintf("pull_requests[%d]: branch %s is the base branch", i, spec.Head)), nil
+				}
+			}
+
+			// Pull requests are created in order, so that they are numbered bottom first. A pull request that cannot
+			// be created does not stop the others, whose base branches exist either way.
+			result := NewBulkResult[StackedPullRequest]()
+			for i, spec := range specs {
+				target := base
+				if i > 0 {
+					target = specs[i-1].Head
+				}
+				pr, resp, err := client.PullRequests.Create(ctx, owner, repo, &github.NewPullRequest{
+					Title: github.Ptr(spec.Title),
+					Body:  github.Ptr(spec.Body),
+					Head:  github.Ptr(spec.Head),
+					Base:  github.Ptr(target),
+					Draft: github.Ptr(spec.Draft),
+				})
+				if err != nil {
+					result.AddAPIFailure(fmt.Sprintf("pull_requests[%d]: %s into %s", i, spec.Head, target), resp, err)
+					continue
+				}
+				_ = resp.Body.Close()
+				result.AddSuccess(stackedPullRequest(pr))
+			}
+			return result.ToolResult()
+		}
+}
+
+// listStackedPullRequests lists the open pull requests of a repository whose base branch is the given branch.
+func listStackedPullRequests(ctx context.Context, client *github.Client, owner, repo, branch string) ([]*github.PullRequest, *github.Response, error) {
+	var pulls []*github.PullRequest
+	opts := &github.PullRequestListOptions{State: "open", Base: branch, ListOptions: github.ListOptions{PerPage: 100}}
+	for {
+		page, resp, err := client.PullRequests.List(ctx, owner, repo, opts)
+		if err != nil {
+			return nil, resp, err
+		}
+		_ = resp.Body.Close()
+		pulls = append(pulls, page...)
+		if resp.NextPage == 0 {
+			return pulls, resp, nil
+		}
+		opts.Page = resp.NextPage
+	}
+}
+
+// RestackPullRequests creates a tool that retargets and updates the pull requests stacked on a pull request.
+func RestackPullRequests(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("restack_pull_requests",
+			mcp.WithDescription(t("TOOL_RESTACK_PULL_REQUESTS_DESCRIPTION", "Restack the open pull requests stacked on a pull request, those whose base branch is its head branch. Once the pull request has merged, they are retargeted to the branch it merged into. Unless update_branches is false, each of them is then brought up to date with its base branch by merging the base branch into it, which GitHub does asynchronously. Pull requests stacked further up are restacked by calling the tool on each pull request in turn, once its branch is updated.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:           t("TOOL_RESTACK_PULL_REQUESTS_USER_TITLE", "Restack pull requests"),
+				ReadOnlyHint:    ToBoolPtr(false),
+				DestructiveHint: ToBoolPtr(false),
+				IdempotentHint:  ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryOwner),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryName),
+			),
+			mcp.WithNumber("pullNumber",
+				mcp.Required(),
+				mcp.Description("Pull request the others are stacked on"),
+			),
+			mcp.WithBoolean("update_branches",
+				mcp.Description("Merge the base branch into each stacked pull request"),
+				mcp.DefaultBool(true),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pullNumber, err := RequiredInt(request, "pullNumber")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			updateBranches, err := OptionalBoolParamWithDefault(request, "update_branches", true)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			parent, resp, err := client.PullRequests.Get(ctx, owner, repo, pullNumber)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to get pull request", resp, err), nil
+			}
+			_ = resp.Body.Close()
+			// Once the parent has merged, its children target the branch it merged into.
+			var retarget string
+			switch pullRequestState(parent) {
+			case "merged":
+				retarget = parent.GetBase().GetRef()
+			case "closed_unmerged":
+				return mcp.NewToolResultError(fmt.Sprintf("pull request %d was closed without merging: retarget the pull requests stacked on it with update_pull_request", pullNumber)), nil
+			}
+
+			children, resp, err := listStackedPullRequests(ctx, client, owner, repo, parent.GetHead().GetRef())
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to list stacked pull requests", resp, err), nil
+			}
+
+			result := NewBulkResult[StackedPullRequest]()
+			for _, child := range children {
+				item := fmt.Sprintf("#%d", child.GetNumber())
+				stacked := stackedPullRequest(child)
+				if retarget != "" {
+					edited, resp, err := client.PullRequests.Edit(ctx, owner, repo, child.GetNumber(), &github.PullRequest{
+						Base: &github.PullRequestBranch{Ref: github.Ptr(retarget)},
+					})
+					if err != nil {
+						result.AddAPIFailure(item, resp, fmt.Errorf("failed to retarget to %s: %w", retarget, err))
+						continue
+					}
+					_ = resp.Body.Close()
+					stacked = stackedPullRequest(edited)
+					stacked.PreviousBase = child.GetBase().GetRef()
+				}
+				if updateBranches {
+					_, resp, err := client.PullRequests.UpdateBranch(ctx, owner, repo, child.GetNumber(), nil)
+					if resp != nil {
+						_ = resp.Body.Close()
+					}
+					// GitHub accepts the update and merges the base branch in the background.
+					if err == nil || (resp != nil && resp.StatusCode == http.StatusAccepted && isAcceptedError(err)) {
+						stacked.BranchUpdate = "in_progress"
+					} else {
+						message := "failed to update branch"
+						if stacked.PreviousBase != "" {
+							message = fmt.Sprintf("retargeted to %s but failed to update branch", retarget)
+						}
+						result.AddAPIFailure(item, resp, fmt.Errorf("%s: %w", message, err))
+						continue
+					}
+				}
+				result.AddSuccess(stacked)
+			}
+			return result.ToolResult()
+		}
+}
@@ -0,0 +1,182 @@
+package github
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/ghmock"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type stackedPullRequestsResponse struct {
+	Succeeded []StackedPullRequest `json:"succeeded"`
+	Failed    []BulkFailure        `json:"failed"`
+	Summary   BulkSummary          `json:"summary"`
+}
+
+func Test_CreateStackedPullRequests(t *testing.T) {
+	tool, _ := CreateStackedPullRequests(nil, translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+	assert.False(t, *tool.Annotations.DestructiveHint)
+	assert.ElementsMatch(t, []string{"owner", "repo", "pull_requests"}, tool.InputSchema.Required)
+
+	t.Run("each pull request targets the branch of the previous one", func(t *testing.T) {
+		server := ghmock.New(t)
+		server.Respond("GET /repos/octo/app", http.StatusOK, map[string]any{"default_branch": "main"})
+		var bases []string
+		server.HandleFunc("POST /repos/octo/app/pulls", func(w http.ResponseWriter, r *http.Request) {
+			var body map[string]any
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			head, base := body["head"].(string), body["base"].(string)
+			bases = append(bases, base)
+			if head == "step-2" {
+				w.WriteHeader(http.StatusUnprocessableEntity)
+				_, _ = w.Write([]byte(`{"message": "Validation Failed"}`))
+				return
+			}
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write(mock.MustMarshal(map[string]any{
+				"number": len(bases), "title": body["title"], "html_url": "https://github.com/octo/app/pull/" + head,
+				"base": map[string]any{"ref": base}, "head": map[string]any{"ref": head},
+			}))
+		})
+
+		_, handler := CreateStackedPullRequests(server.GetClient(), translations.NullTranslationHelper)
+		result := ghmock.CallTool(t, handler, map[string]any{
+			"owner": "octo", "repo": "app",
+			"pull_requests": []any{
+				map[string]any{"head": "step-1", "title": "Step 1"},
+				map[string]any{"head": "step-2", "title": "Step 2"},
+				map[string]any{"head": "step-3", "title": "Step 3", "draft": true},
+			},
+		})
+		require.False(t, result.IsError, ghmock.ResultText(t, result))
+
+		assert.Equal(t, []string{"main", "step-1", "step-2"}, bases)
+		var resp stackedPullRequestsResponse
+		require.NoError(t, json.Unmarshal([]byte(ghmock.ResultText(t, result)), &resp))
+		assert.Equal(t, BulkSummary{Total: 3, Succeeded: 2, Failed: 1}, resp.Summary)
+		assert.Equal(t, []StackedPullRequest{
+			{Number: 1, Title: "Step 1", URL: "https://github.com/octo/app/pull/step-1", Base: "main", Head: "step-1"},
+			{Number: 3, Title: "Step 3", URL: "https://github.com/octo/app/pull/step-3", Base: "step-2", Head: "step-3"},
+		}, resp.Succeeded)
+		require.Len(t, resp.Failed, 1)
+		assert.Equal(t, "pull_requests[1]: step-2 into step-1", resp.Failed[0].Item)
+	})
+
+	for name, tc := range map[string]struct {
+		pullRequests []any
+		message      string
+	}{
+		"no pull requests": {[]any{}, "pull_requests must contain between 1 and 10 pull requests, got 0"},
+		"missing title":    {[]any{map[string]any{"head": "a"}}, "pull_requests[0]: missing required field: title"},
+		"repeated branch": {[]any{
+			map[string]any{"head": "a", "title": "A"},
+			map[string]any{"head": "a", "title": "B"},
+		}, "pull_requests[1]: branch a is already in the stack"},
+		"base branch": {[]any{map[string]any{"head": "main", "title": "A"}}, "pull_requests[0]: branch main is the base branch"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			server := ghmock.New(t)
+			_, handler := CreateStackedPullRequests(server.GetClient(), translations.NullTranslationHelper)
+			result := ghmock.CallTool(t, handler, map[string]any{"owner": "octo", "repo": "app", "base": "main", "pull_requests": tc.pullRequests})
+			require.True(t, result.IsError)
+			assert.Equal(t, tc.message, ghmock.ResultText(t, result))
+			server.AssertNotRequested("")
+		})
+	}
+}
+
+func Test_RestackPullRequests(t *testing.T) {
+	tool, _ := RestackPullRequests(nil, translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+	assert.ElementsMatch(t, []string{"owner", "repo", "pullNumber"}, tool.InputSchema.Required)
+
+	pull := func(number int, state, base, head string) map[string]any {
+		return map[string]any{
+			"number": number, "title": head, "state": state, "html_url": "https://github.com/octo/app/pull/" + head,
+			"base": map[string]any{"ref": base}, "head": map[string]any{"ref": head},
+		}
+	}
+	newServer := func(t *testing.T, parent map[string]any) *ghmock.Server {
+		server := ghmock.New(t)
+		server.Respond("GET /repos/octo/app/pulls/10", http.StatusOK, parent)
+		server.HandleFunc("GET /repos/octo/app/pulls", func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "feature-a", r.URL.Query().Get("base"))
+			assert.Equal(t, "open", r.URL.Query().Get("state"))
+			_, _ = w.Write(mock.MustMarshal([]any{pull(11, "open", "feature-a", "feature-b"), pull(12, "open", "feature-a", "feature-c")}))
+		})
+		return server
+	}
+	call := func(t *testing.T, server *ghmock.Server, args map[string]any) (stackedPullRequestsResponse, string, bool) {
+		_, handler := RestackPullRequests(server.GetClient(), translations.NullTranslationHelper)
+		merged := map[string]any{"owner": "octo", "repo": "app", "pullNumber": float64(10)}
+		for k, v := range args {
+			merged[k] = v
+		}
+		result := ghmock.CallTool(t, handler, merged)
+		var resp stackedPullRequestsResponse
+		if !result.IsError {
+			require.NoError(t, json.Unmarshal([]byte(ghmock.ResultText(t, result)), &resp))
+		}
+		return resp, ghmock.ResultText(t, result), result.IsError
+	}
+
+	t.Run("retargets and updates the children of a merged pull request", func(t *testing.T) {
+		parent := pull(10, "closed", "main", "feature-a")
+		parent["merged"] = true
+		server := newServer(t, parent)
+		server.Respond("PATCH /repos/octo/app/pulls/11", http.StatusOK, pull(11, "open", "main", "feature-b"))
+		server.Respond("PATCH /repos/octo/app/pulls/12", http.StatusOK, pull(12, "open", "main", "feature-c"))
+		server.Respond("PUT /repos/octo/app/pulls/11/update-branch", http.StatusAccepted, map[string]any{"message": "Updating pull request branch."})
+		server.Respond("PUT /repos/octo/app/pulls/12/update-branch", http.StatusUnprocessableEntity, map[string]any{"message": "merge conflict between base and head"})
+
+		resp, text, isError := call(t, server, nil)
+		require.False(t, isError, text)
+		assert.Equal(t, BulkSummary{Total: 2, Succeeded: 1, Failed: 1}, resp.Summary)
+		assert.Equal(t, []StackedPullRequest{{
+			Number: 11, Title: "feature-b", URL: "https://github.com/octo/app/pull/feature-b",
+			Base: "main", Head: "feature-b", PreviousBase: "feature-a", BranchUpdate: "in_progress",
+		}}, resp.Succeeded)
+		require.Len(t, resp.Failed, 1)
+		assert.Equal(t, "#12", resp.Failed[0].Item)
+		assert.Contains(t, resp.Failed[0].Message, "retargeted to main but failed to update branch")
+
+		var edit map[string]any
+		require.NoError(t, server.AssertRequested("PATCH /repos/octo/app/pulls/11").DecodeBody(&edit))
+		assert.Equal(t, map[string]any{"base": "main"}, edit)
+	})
+
+	t.Run("updates the children of an open pull request without retargeting them", func(t *testing.T) {
+		server := newServer(t, pull(10, "open", "main", "feature-a"))
+		server.Respond("PUT /repos/octo/app/pulls/{number}/update-branch", http.StatusAccepted, map[string]any{"message": "Updating pull request branch."})
+
+		resp, text, isError := call(t, server, nil)
+		require.False(t, isError, text)
+		assert.Equal(t, BulkSummary{Total: 2, Succeeded: 2}, resp.Summary)
+		assert.Equal(t, "feature-a", resp.Succeeded[0].Base)
+		assert.Empty(t, resp.Succeeded[0].PreviousBase)
+		server.AssertNotRequested(http.MethodPatch)
+	})
+
+	t.Run("without updating branches", func(t *testing.T) {
+		server := newServer(t, pull(10, "open", "main", "feature-a"))
+		resp, text, isError := call(t, server, map[string]any{"update_branches": false})
+		require.False(t, isError, text)
+		assert.Equal(t, []int{11, 12}, []int{resp.Succeeded[0].Number, resp.Succeeded[1].Number})
+		server.AssertNotRequested("")
+	})
+
+	t.Run("closed without merging", func(t *testing.T) {
+		server := ghmock.New(t)
+		server.Respond("GET /repos/octo/app/pulls/10", http.StatusOK, pull(10, "closed", "main", "feature-a"))
+		_, text, isError := call(t, server, nil)
+		require.True(t, isError)
+		assert.Contains(t, text, "pull request 10 was closed without merging")
+	})
+}
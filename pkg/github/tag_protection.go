@@ -0,0 +1,534 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v79/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// releaseTagClockSkew is how much later than its release a tag or its commit may be dated before check_release_tag
+// reports it, to allow for clocks that are slightly off.
+const releaseTagClockSkew = 5 * time.Minute
+
+// TagProtectionRule is a ruleset that targets tags.
+type TagProtectionRule struct {
+	ID          int64    `json:"id"`
+	Name        string   `json:"name"`
+	SourceType  string   `json:"source_type,omitempty"`
+	Source      string   `json:"source,omitempty"`
+	Enforcement string   `json:"enforcement"`
+	Include     []string `json:"include"`
+	Exclude     []string `json:"exclude,omitempty"`
+	Rules       []string `json:"rules"`
+}
+
+// ReleaseTagCheck is the result of checking whether the tag of a release still points where it did when the release
+// was published.
+type ReleaseTagCheck struct {
+	Tag         string   `json:"tag"`
+	ReleaseID   int64    `json:"release_id"`
+	PublishedAt string   `json:"published_at,omitempty"`
+	Immutable   bool     `json:"immutable"`
+	TagSHA      string   `json:"tag_sha,omitempty"`
+	CommitSHA   string   `json:"commit_sha"`
+	TaggedAt    string   `json:"tagged_at,omitempty"`
+	CommittedAt string   `json:"committed_at,omitempty"`
+	ExpectedSHA string   `json:"expected_sha,omitempty"`
+	Moved       bool     `json:"moved"`
+	ProtectedBy []string `json:"protected_by"`
+	Findings    []string `json:"findings"`
+}
+
+// convertToTagProtectionRule flattens a tag ruleset into its ref patterns and rule types.
+func convertToTagProtectionRule(ruleset *github.RepositoryRuleset) TagProtectionRule {
+	rule := TagProtectionRule{
+		ID:          ruleset.GetID(),
+		Name:        ruleset.Name,
+		Source:      ruleset.Source,
+		Enforcement: string(ruleset.Enforcement),
+		Include:     []string{},
+		Rules:       []string{},
+	}
+	if ruleset.SourceType != nil {
+		rule.SourceType = string(*ruleset.SourceType)
+	}
+	if refName := ruleset.GetConditions().GetRefName(); refName != nil {
+		rule.Include = append(rule.Include, refName.Include...)
+		rule.Exclude = refName.Exclude
+	}
+	if rules := ruleset.GetRules(); rules != nil {
+		for _, r := range []struct {
+			name string
+			set  bool
+		}{
+			{"creation", rules.Creation != nil},
+			{"update", rules.Update != nil},
+			{"deletion", rules.Deletion != nil},
+			{"non_fast_forward", rules.NonFastForward != nil},
+			{"required_signatures", rules.RequiredSignatures != nil},
+			{"tag_name_pattern", rules.TagNamePattern != nil},
+		} {
+			if r.set {
+				rule.Rules = append(rule.Rules, r.name)
+			}
+		}
+	}
+	return rule
+}
+
+// refPatternMatches reports whether a ruleset ref pattern such as 'refs/tags/v*' or '~ALL' matches a full ref name.
+// As in rulesets, '*' does not match '/' and '**' matches anything.
+func refPatternMatches(pattern, ref string) bool {
+	if pattern == "~ALL" {
+		return true
+	}
+	var expr strings.Builder
+	expr.WriteString("^")
+	for i := 0; i < len(pattern); i++ {
+		switch {
+		case strings.HasPrefix(pattern[i:], "**"):
+			expr.WriteString(".*")
+			i++
+		case pattern[i] == '*':
+			expr.WriteString("[^/]*")
+		case pattern[i] == '?':
+			expr.WriteString("[^/]")
+		default:
+			expr.WriteString(regexp.QuoteMeta(pattern[i : i+1]))
+		}
+	}
+	expr.WriteString("$")
+	matched, _ := regexp.MatchString(expr.String(), ref)
+	return matched
+}
+
+// protects reports whether the rule is enforced on a tag and stops it from being moved or deleted.
+func (r TagProtectionRule) protects(tag string) bool {
+	if r.Enforcement != string(github.RulesetEnforcementActive) {
+		return false
+	}
+	ref := "refs/tags/" + tag
+	matches := func(patterns []string) bool {
+		for _, pattern := range patterns {
+			if refPatternMatches(pattern, ref) {
+				return true
+			}
+		}
+		return false
+	}
+	if !matches(r.Include) || matches(r.Exclude) {
+		return false
+	}
+	var update, deletion bool
+	for _, rule := range r.Rules {
+		update = update || rule == "update"
+		deletion = deletion || rule == "deletion"
+	}
+	return update && deletion
+}
+
+// listTagProtectionRules gets the repository, organization and enterprise rulesets that target the tags of a
+// repository. A non-nil result is an error response that should be returned to the caller as is.
+func listTagProtectionRules(ctx context.Context, client *github.Client, owner, repo string) ([]TagProtectionRule, *mcp.CallToolResult) {
+	opts := &github.RepositoryListRulesetsOptions{
+		IncludesParents: github.Ptr(true),
+		ListOptions:     github.ListOptions{PerPage: 100},
+	}
+	rules := []TagProtectionRule{}
+	for {
+		rulesets, resp, err := client.Repositories.GetAllRulesets(ctx, owner, repo, opts)
+		if err != nil {
+			return nil, ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("failed to list rulesets for %s/%s", owner, repo), resp, err)
+		}
+		_ = resp.Body.Close()
+
+		for _, summary := range rulesets {
+			if summary.Target == nil || *summary.Target != github.RulesetTargetTag {
+				continue
+			}
+			// The list only has the name and enforcement of each ruleset, not its conditions and rules.
+			ruleset, resp, err := client.Repositories.GetRuleset(ctx, owner, repo, summary.GetID(), true)
+			if err != nil {
+				return nil, ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("failed to get ruleset %d", summary.GetID()), resp, err)
+			}
+			_ = resp.Body.Close()
+			rules = append(rules, convertToTagProtectionRule(ruleset))
+		}
+
+		if resp.NextPage == 0 {
+			return rules, nil
+		}
+		opts.Page = resp.NextPage
+	}
+}
+
+// ListTagProtectionRules creates a tool that lists the rulesets protecting the tags of a repository.
+func ListTagProtectionRules(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_tag_protection_rules",
+			mcp.WithDescription(t("TOOL_LIST_TAG_PROTECTION_RULES_DESCRIPTION", "List the rulesets that target the tags of a repository, including those inherited from its organization or enterprise, with the tag patterns they apply to and what they restrict (creation, update, deletion, force pushes).")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_LIST_TAG_PROTECTION_RULES_USER_TITLE", "List tag protection rules"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryOwner),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryName),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			rules, errResult := listTagProtectionRules(ctx, client, owner, repo)
+			if errResult != nil {
+				return errResult, nil
+			}
+
+			// Every page has been fetched, so there is no next page to point to.
+			return MarshalledTextResult(NewListResponse("rules", rules, PageInfo{}, github.Ptr(len(rules)))), nil
+		}
+}
+
+// CreateTagProtectionRule creates a tool that adds a ruleset protecting tags of a repository from being moved or
+// deleted.
+func CreateTagProtectionRule(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("create_tag_protection_rule",
+			mcp.WithDescription(t("TOOL_CREATE_TAG_PROTECTION_RULE_DESCRIPTION", "Protect the tags of a repository matching patterns from being moved, force-pushed or deleted, and optionally from being created, by adding a tag ruleset. Use it to keep release tags pointing at the commits they were published from.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:           t("TOOL_CREATE_TAG_PROTECTION_RULE_USER_TITLE", "Create tag protection rule"),
+				ReadOnlyHint:    ToBoolPtr(false),
+				DestructiveHint: ToBoolPtr(false),
+				IdempotentHint:  ToBoolPtr(false),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryOwner),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryName),
+			),
+			mcp.WithString("name",
+				mcp.Required(),
+				mcp.Description("Name of the ruleset"),
+			),
+			mcp.WithArray("patterns",
+				mcp.Required(),
+				mcp.Description("Tag name patterns to protect, e.g. 'v*' or 'release/**'. '*' does not match '/', '**' does."),
+				mcp.WithStringItems(),
+			),
+			mcp.WithArray("exclude",
+				mcp.Description("Tag name patterns to leave unprotected"),
+				mcp.WithStringItems(),
+			),
+			mcp.WithBoolean("restrict_creation",
+				mcp.Description("Also stop matching tags from being created by anyone who cannot bypass the ruleset"),
+			),
+			mcp.WithString("enforcement",
+				mcp.Description("'evaluate' only reports what the ruleset would block, which needs GitHub Enterprise"),
+				mcp.Enum(string(github.RulesetEnforcementActive), string(github.RulesetEnforcementEvaluate), string(github.RulesetEnforcementDisabled)),
+				mcp.DefaultString(string(github.RulesetEnforcementActive)),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			name, err := RequiredParam[string](request, "name")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			patterns, err := OptionalStringArrayParam(request, "patterns")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if len(patterns) == 0 {
+				return mcp.NewToolResultError("missing required parameter: patterns"), nil
+			}
+			exclude, err := OptionalStringArrayParam(request, "exclude")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			restrictCreation, err := OptionalParam[bool](request, "restrict_creation")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			enforcement, err := OptionalParam[string](request, "enforcement")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if enforcement == "" {
+				enforcement = string(github.RulesetEnforcementActive)
+			}
+
+			refs := func(patterns []string) []string {
+				refs := make([]string, 0, len(patterns))
+				for _, pattern := range patterns {
+					refs = append(refs, "refs/tags/"+strings.TrimPrefix(pattern, "refs/tags/"))
+				}
+				return refs
+			}
+			rules := &github.RepositoryRulesetRules{
+				Update:         &github.UpdateRuleParameters{},
+				Deletion:       &github.EmptyRuleParameters{},
+				NonFastForward: &github.EmptyRuleParameters{},
+			}
+			if restrictCreation {
+				rules.Creation = &github.EmptyRuleParameters{}
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			created, resp, err := client.Repositories.CreateRuleset(ctx, owner, repo, github.RepositoryRuleset{
+				Name:        name,
+				Target:      github.Ptr(github.RulesetTargetTag),
+				Enforcement: github.RulesetEnforcement(enforcement),
+				Conditions: &github.RepositoryRulesetConditions{
+					RefName: &github.RepositoryRulesetRefConditionParameters{
+						Include: refs(patterns),
+						Exclude: refs(exclude),
+					},
+				},
+				Rules: rules,
+			})
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("failed to create tag ruleset in %s/%s", owner, repo), resp, err), nil
+			}
+			_ = resp.Body.Close()
+
+			return MarshalledTextResult(convertToTagProtectionRule(created)), nil
+		}
+}
+
+// DeleteTagProtectionRule creates a tool that deletes a tag ruleset of a repository.
+func DeleteTagProtectionRule(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("delete_tag_protection_rule",
+			mcp.WithDescription(t("TOOL_DELETE_TAG_PROTECTION_RULE_DESCRIPTION", "Delete a tag ruleset of a repository, so that the tags it protected can be moved or deleted again. Only rulesets defined on the repository itself can be deleted here.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:           t("TOOL_DELETE_TAG_PROTECTION_RULE_USER_TITLE", "Delete tag protection rule"),
+				ReadOnlyHint:    ToBoolPtr(false),
+				DestructiveHint: ToBoolPtr(true),
+				IdempotentHint:  ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryOwner),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryName),
+			),
+			mcp.WithNumber("ruleset_id",
+				mcp.Required(),
+				mcp.Description("ID of the ruleset, as returned by list_tag_protection_rules"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			rulesetID, err := RequiredBigInt(request, "ruleset_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			// Make sure a branch or push ruleset is not deleted by mistake.
+			ruleset, resp, err := client.Repositories.GetRuleset(ctx, owner, repo, rulesetID, false)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("failed to get ruleset %d", rulesetID), resp, err), nil
+			}
+			_ = resp.Body.Close()
+			if ruleset.Target == nil || *ruleset.Target != github.RulesetTargetTag {
+				return mcp.NewToolResultError(fmt.Sprintf("ruleset %d does not target tags; only tag rulesets can be deleted here", rulesetID)), nil
+			}
+
+			resp, err = client.Repositories.DeleteRuleset(ctx, owner, repo, rulesetID)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("failed to delete ruleset %d", rulesetID), resp, err), nil
+			}
+			_ = resp.Body.Close()
+
+			return mcp.NewToolResultText(fmt.Sprintf("Deleted tag ruleset '%s' (%d) of %s/%s", ruleset.Name, rulesetID, owner, repo)), nil
+		}
+}
+
+// CheckReleaseTag creates a tool that checks whether the tag of a release has moved since the release was published.
+func CheckReleaseTag(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("check_release_tag",
+			mcp.WithDescription(t("TOOL_CHECK_RELEASE_TAG_DESCRIPTION", "Check whether the tag of a published release still points at the commit it was released from, and whether the release is immutable or the tag protected by a ruleset. Give expected_sha, the commit recorded when the release was published, for a definite answer; without it the tag and commit dates are compared with the publication date, which catches a re-created tag but can be fooled by forged dates.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_CHECK_RELEASE_TAG_USER_TITLE", "Check release tag integrity"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryOwner),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryName),
+			),
+			mcp.WithString("tag",
+				mcp.Required(),
+				mcp.Description("Tag of the release, e.g. 'v1.2.0'"),
+			),
+			mcp.WithString("expected_sha",
+				mcp.Description("Commit SHA the tag pointed at when the release was published"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			tag, err := RequiredParam[string](request, "tag")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			expectedSHA, err := OptionalParam[string](request, "expected_sha")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			release, resp, err := client.Repositories.GetReleaseByTag(ctx, owner, repo, tag)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("failed to get release for tag %s", tag), resp, err), nil
+			}
+			_ = resp.Body.Close()
+
+			check := ReleaseTagCheck{
+				Tag:         tag,
+				ReleaseID:   release.GetID(),
+				Immutable:   release.GetImmutable(),
+				ExpectedSHA: expectedSHA,
+				ProtectedBy: []string{},
+				Findings:    []string{},
+			}
+			var publishedAt time.Time
+			if release.PublishedAt != nil {
+				publishedAt = release.PublishedAt.Time
+				check.PublishedAt = FormatTimestamp(publishedAt)
+			}
+
+			ref, resp, err := client.Git.GetRef(ctx, owner, repo, "refs/tags/"+tag)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("failed to get tag %s", tag), resp, err), nil
+			}
+			_ = resp.Body.Close()
+
+			// Without an expected SHA, dates later than the publication are the only sign of a moved tag.
+			var dateFindings []string
+
+			// Peel annotated tags down to the commit they point at.
+			object := ref.GetObject()
+			for object.GetType() == "tag" {
+				tagObject, resp, err := client.Git.GetTag(ctx, owner, repo, object.GetSHA())
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("failed to get tag object %s", object.GetSHA()), resp, err), nil
+				}
+				_ = resp.Body.Close()
+				if check.TagSHA == "" {
+					check.TagSHA = tagObject.GetSHA()
+					if date := tagObject.GetTagger().Date; date != nil {
+						check.TaggedAt = FormatTimestamp(date.Time)
+						if !publishedAt.IsZero() && date.After(publishedAt.Add(releaseTagClockSkew)) {
+							dateFindings = append(dateFindings, "the tag was created after the release was published, so it was deleted and re-created")
+						}
+					}
+				}
+				object = tagObject.GetObject()
+			}
+			check.CommitSHA = object.GetSHA()
+
+			commit, resp, err := client.Git.GetCommit(ctx, owner, repo, check.CommitSHA)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("failed to get commit %s", check.CommitSHA), resp, err), nil
+			}
+			_ = resp.Body.Close()
+			if date := commit.GetCommitter().Date; date != nil {
+				check.CommittedAt = FormatTimestamp(date.Time)
+				if !publishedAt.IsZero() && date.After(publishedAt.Add(releaseTagClockSkew)) {
+					dateFindings = append(dateFindings, "the tag points at a commit made after the release was published")
+				}
+			}
+
+			if expectedSHA != "" {
+				check.Moved = !strings.HasPrefix(check.CommitSHA, strings.ToLower(expectedSHA))
+				if check.Moved {
+					check.Findings = append(check.Findings, fmt.Sprintf("the tag points at %s, not the expected %s", check.CommitSHA, expectedSHA))
+				}
+			} else {
+				check.Moved = len(dateFindings) > 0
+				check.Findings = append(check.Findings, dateFindings...)
+			}
+
+			rules, errResult := listTagProtectionRules(ctx, client, owner, repo)
+			if errResult != nil {
+				return errResult, nil
+			}
+			for _, rule := range rules {
+				if rule.protects(tag) {
+					check.ProtectedBy = append(check.ProtectedBy, rule.Name)
+				}
+			}
+
+			switch {
+			case check.Immutable:
+				check.Findings = append(check.Findings, "the release is immutable, so its tag cannot be moved or deleted")
+			case len(check.ProtectedBy) == 0:
+				check.Findings = append(check.Findings, "the release is not immutable and no active ruleset stops its tag from being moved or deleted")
+			}
+
+			return MarshalledTextResult(check), nil
+		}
+}
@@ -0,0 +1,188 @@
+package github
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/ghmock"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_refPatternMatches(t *testing.T) {
+	assert.True(t, refPatternMatches("refs/tags/v*", "refs/tags/v1.2.0"))
+	assert.False(t, refPatternMatches("refs/tags/v*", "refs/tags/v1/rc"))
+	assert.True(t, refPatternMatches("refs/tags/release/**", "refs/tags/release/2025/01"))
+	assert.True(t, refPatternMatches("~ALL", "refs/tags/anything"))
+	assert.False(t, refPatternMatches("refs/tags/v1.?", "refs/tags/v1.10"))
+}
+
+// respondTagRulesets serves one repository tag ruleset protecting v* tags and one branch ruleset.
+func respondTagRulesets(server *ghmock.Server) {
+	server.Respond("GET /repos/octo/app/rulesets", http.StatusOK, []map[string]any{
+		{"id": 1, "name": "Release tags", "target": "tag", "source_type": "Repository", "source": "octo/app", "enforcement": "active"},
+		{"id": 2, "name": "Main", "target": "branch", "source_type": "Repository", "source": "octo/app", "enforcement": "active"},
+	})
+	server.Respond("GET /repos/octo/app/rulesets/1", http.StatusOK, map[string]any{
+		"id": 1, "name": "Release tags", "target": "tag", "source_type": "Repository", "source": "octo/app", "enforcement": "active",
+		"conditions": map[string]any{"ref_name": map[string]any{"include": []string{"refs/tags/v*"}, "exclude": []string{}}},
+		"rules":      []map[string]any{{"type": "update"}, {"type": "deletion"}, {"type": "non_fast_forward"}},
+	})
+}
+
+func Test_ListTagProtectionRules(t *testing.T) {
+	tool, _ := ListTagProtectionRules(nil, translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+	assert.True(t, *tool.Annotations.ReadOnlyHint)
+
+	server := ghmock.New(t)
+	respondTagRulesets(server)
+	_, handler := ListTagProtectionRules(server.GetClient(), translations.NullTranslationHelper)
+
+	result := ghmock.CallTool(t, handler, map[string]any{"owner": "octo", "repo": "app"})
+	require.False(t, result.IsError, ghmock.ResultText(t, result))
+	var response struct {
+		Rules      []TagProtectionRule `json:"rules"`
+		PageInfo   PageInfo            `json:"page_info"`
+		TotalCount int                 `json:"total_count"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(ghmock.ResultText(t, result)), &response))
+	assert.Equal(t, []TagProtectionRule{{
+		ID: 1, Name: "Release tags", SourceType: "Repository", Source: "octo/app", Enforcement: "active",
+		Include: []string{"refs/tags/v*"}, Rules: []string{"update", "deletion", "non_fast_forward"},
+	}}, response.Rules)
+	assert.Equal(t, 1, response.TotalCount)
+	assert.False(t, response.PageInfo.HasNextPage)
+	assert.Equal(t, "true", server.AssertRequested("GET /repos/octo/app/rulesets").Query.Get("includes_parents"))
+}
+
+func Test_CreateTagProtectionRule(t *testing.T) {
+	tool, _ := CreateTagProtectionRule(nil, translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+	assert.ElementsMatch(t, []string{"owner", "repo", "name", "patterns"}, tool.InputSchema.Required)
+
+	server := ghmock.New(t)
+	server.Respond("POST /repos/octo/app/rulesets", http.StatusCreated, map[string]any{
+		"id": 7, "name": "Release tags", "target": "tag", "enforcement": "active",
+		"conditions": map[string]any{"ref_name": map[string]any{"include": []string{"refs/tags/v*"}}},
+		"rules":      []map[string]any{{"type": "creation"}, {"type": "update"}, {"type": "deletion"}, {"type": "non_fast_forward"}},
+	})
+	_, handler := CreateTagProtectionRule(server.GetClient(), translations.NullTranslationHelper)
+
+	result := ghmock.CallTool(t, handler, map[string]any{
+		"owner": "octo", "repo": "app", "name": "Release tags",
+		"patterns": []any{"v*"}, "exclude": []any{"refs/tags/v*-rc*"}, "restrict_creation": true,
+	})
+	require.False(t, result.IsError, ghmock.ResultText(t, result))
+	var body struct {
+		Name        string `json:"name"`
+		Target      string `json:"target"`
+		Enforcement string `json:"enforcement"`
+		Conditions  struct {
+			RefName struct {
+				Include []string `json:"include"`
+				Exclude []string `json:"exclude"`
+			} `json:"ref_name"`
+		} `json:"conditions"`
+		Rules []struct {
+			Type string `json:"type"`
+		} `json:"rules"`
+	}
+	require.NoError(t, server.AssertRequested("POST /repos/octo/app/rulesets").DecodeBody(&body))
+	assert.Equal(t, "tag", body.Target)
+	assert.Equal(t, "active", body.Enforcement)
+	assert.Equal(t, []string{"refs/tags/v*"}, body.Conditions.RefName.Include)
+	assert.Equal(t, []string{"refs/tags/v*-rc*"}, body.Conditions.RefName.Exclude)
+	var ruleTypes []string
+	for _, rule := range body.Rules {
+		ruleTypes = append(ruleTypes, rule.Type)
+	}
+	assert.ElementsMatch(t, []string{"creation", "update", "deletion", "non_fast_forward"}, ruleTypes)
+
+	var created TagProtectionRule
+	require.NoError(t, json.Unmarshal([]byte(ghmock.ResultText(t, result)), &created))
+	assert.Equal(t, int64(7), created.ID)
+}
+
+func Test_DeleteTagProtectionRule(t *testing.T) {
+	tool, _ := DeleteTagProtectionRule(nil, translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+	assert.True(t, *tool.Annotations.DestructiveHint)
+
+	server := ghmock.New(t)
+	respondTagRulesets(server)
+	server.Respond("GET /repos/octo/app/rulesets/2", http.StatusOK, map[string]any{"id": 2, "name": "Main", "target": "branch", "enforcement": "active"})
+	server.Respond("DELETE /repos/octo/app/rulesets/1", http.StatusNoContent, nil)
+	_, handler := DeleteTagProtectionRule(server.GetClient(), translations.NullTranslationHelper)
+
+	result := ghmock.CallTool(t, handler, map[string]any{"owner": "octo", "repo": "app", "ruleset_id": float64(1)})
+	require.False(t, result.IsError, ghmock.ResultText(t, result))
+	assert.Equal(t, "Deleted tag ruleset 'Release tags' (1) of octo/app", ghmock.ResultText(t, result))
+
+	result = ghmock.CallTool(t, handler, map[string]any{"owner": "octo", "repo": "app", "ruleset_id": float64(2)})
+	require.True(t, result.IsError)
+	assert.Contains(t, ghmock.ResultText(t, result), "ruleset 2 does not target tags")
+}
+
+func Test_CheckReleaseTag(t *testing.T) {
+	tool, _ := CheckReleaseTag(nil, translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+	assert.True(t, *tool.Annotations.ReadOnlyHint)
+	assert.ElementsMatch(t, []string{"owner", "repo", "tag"}, tool.InputSchema.Required)
+
+	newServer := func(t *testing.T, taggedAt, committedAt string, immutable bool) *ghmock.Server {
+		server := ghmock.New(t)
+		server.Respond("GET /repos/octo/app/releases/tags/v1.0.0", http.StatusOK, map[string]any{
+			"id": 42, "tag_name": "v1.0.0", "published_at": "2025-03-01T12:00:00Z", "immutable": immutable,
+		})
+		server.Respond("GET /repos/octo/app/git/ref/tags/v1.0.0", http.StatusOK, map[string]any{
+			"ref": "refs/tags/v1.0.0", "object": map[string]any{"type": "tag", "sha": "tagsha"},
+		})
+		server.Respond("GET /repos/octo/app/git/tags/tagsha", http.StatusOK, map[string]any{
+			"sha": "tagsha", "tagger": map[string]any{"date": taggedAt},
+			"object": map[string]any{"type": "commit", "sha": "abc123def456"},
+		})
+		server.Respond("GET /repos/octo/app/git/commits/abc123def456", http.StatusOK, map[string]any{
+			"sha": "abc123def456", "committer": map[string]any{"date": committedAt},
+		})
+		respondTagRulesets(server)
+		return server
+	}
+	call := func(t *testing.T, server *ghmock.Server, args map[string]any) ReleaseTagCheck {
+		_, handler := CheckReleaseTag(server.GetClient(), translations.NullTranslationHelper)
+		args["owner"], args["repo"], args["tag"] = "octo", "app", "v1.0.0"
+		result := ghmock.CallTool(t, handler, args)
+		require.False(t, result.IsError, ghmock.ResultText(t, result))
+		var check ReleaseTagCheck
+		require.NoError(t, json.Unmarshal([]byte(ghmock.ResultText(t, result)), &check))
+		return check
+	}
+
+	t.Run("untouched and protected", func(t *testing.T) {
+		check := call(t, newServer(t, "2025-03-01T11:58:00Z", "2025-02-28T09:00:00Z", false), map[string]any{})
+		assert.False(t, check.Moved)
+		assert.Equal(t, "abc123def456", check.CommitSHA)
+		assert.Equal(t, "tagsha", check.TagSHA)
+		assert.Equal(t, []string{"Release tags"}, check.ProtectedBy)
+		assert.Empty(t, check.Findings)
+	})
+
+	t.Run("re-created after publication", func(t *testing.T) {
+		check := call(t, newServer(t, "2025-04-01T00:00:00Z", "2025-03-31T00:00:00Z", false), map[string]any{})
+		assert.True(t, check.Moved)
+		assert.Len(t, check.Findings, 2)
+	})
+
+	t.Run("expected SHA decides", func(t *testing.T) {
+		check := call(t, newServer(t, "2025-04-01T00:00:00Z", "2025-02-28T09:00:00Z", true), map[string]any{"expected_sha": "ABC123D"})
+		assert.False(t, check.Moved)
+		assert.Equal(t, []string{"the release is immutable, so its tag cannot be moved or deleted"}, check.Findings)
+
+		check = call(t, newServer(t, "2025-03-01T11:58:00Z", "2025-02-28T09:00:00Z", false), map[string]any{"expected_sha": "fff000"})
+		assert.True(t, check.Moved)
+		assert.Contains(t, check.Findings, "the tag points at abc123def456, not the expected fff000")
+	})
+}
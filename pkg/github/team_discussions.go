@@ -0,0 +1,187 @@
+package github
+
+import (
+	"context"
+	"fmt"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v79/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// MinimalTeamDiscussion is a post on the discussion page of a team.
+type MinimalTeamDiscussion struct {
+	Number        int    `json:"number"`
+	Title         string `json:"title"`
+	Body          string `json:"body,omitempty"`
+	Author        string `json:"author,omitempty"`
+	Pinned        bool   `json:"pinned"`
+	Private       bool   `json:"private"`
+	CommentsCount int    `json:"comments_count"`
+	CreatedAt     string `json:"created_at,omitempty"`
+	HTMLURL       string `json:"html_url,omitempty"`
+}
+
+func toMinimalTeamDiscussion(discussion *github.TeamDiscussion) MinimalTeamDiscussion {
+	result := MinimalTeamDiscussion{
+		Number:        discussion.GetNumber(),
+		Title:         discussion.GetTitle(),
+		Body:          discussion.GetBody(),
+		Author:        discussion.GetAuthor().GetLogin(),
+		Pinned:        discussion.GetPinned(),
+		Private:       discussion.GetPrivate(),
+		CommentsCount: discussion.GetCommentsCount(),
+		HTMLURL:       discussion.GetHTMLURL(),
+	}
+	if discussion.CreatedAt != nil {
+		result.CreatedAt = FormatTimestamp(discussion.CreatedAt.Time)
+	}
+	return result
+}
+
+// ListTeamDiscussions creates a tool that lists the posts on the discussion page of a team.
+func ListTeamDiscussions(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_team_discussions",
+			mcp.WithDescription(t("TOOL_LIST_TEAM_DISCUSSIONS_DESCRIPTION", "List the discussions posted on the page of a team of an organization, newest first. Pinned discussions are the announcements of the team.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_LIST_TEAM_DISCUSSIONS_USER_TITLE", "List team discussions"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("org",
+				mcp.Required(),
+				mcp.Description("Organization name"),
+			),
+			mcp.WithString("team_slug",
+				mcp.Required(),
+				mcp.Description("Slug of the team"),
+			),
+			mcp.WithBoolean("pinned_only",
+				mcp.Description("Only return pinned discussions of the page"),
+			),
+			WithPagination(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			org, err := RequiredParam[string](request, "org")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			teamSlug, err := RequiredParam[string](request, "team_slug")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pinnedOnly, err := OptionalParam[bool](request, "pinned_only")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			pagination, err := OptionalPaginationParams(request)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			discussions, resp, err := client.Teams.ListDiscussionsBySlug(ctx, org, teamSlug, &github.DiscussionListOptions{
+				ListOptions: github.ListOptions{
+					Page:    pagination.Page,
+					PerPage: pagination.PerPage,
+				},
+			})
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to list discussions of team '%s/%s'", org, teamSlug),
+					resp,
+					err,
+				), nil
+			}
+			_ = resp.Body.Close()
+
+			result := make([]MinimalTeamDiscussion, 0, len(discussions))
+			for _, discussion := range discussions {
+				if pinnedOnly && !discussion.GetPinned() {
+					continue
+				}
+				result = append(result, toMinimalTeamDiscussion(discussion))
+			}
+			return MarshalledTextResult(NewListResponse("discussions", result, NewRESTPageInfo(resp), nil)), nil
+		}
+}
+
+// CreateTeamDiscussion creates a tool that posts a discussion on the page of a team.
+func CreateTeamDiscussion(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("create_team_discussion",
+			mcp.WithDescription(t("TOOL_CREATE_TEAM_DISCUSSION_DESCRIPTION", "Post a discussion, such as sprint notes or an announcement, on the page of a team of an organization. Members of the team are notified. Private discussions are only visible to the team, others to the whole organization.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:           t("TOOL_CREATE_TEAM_DISCUSSION_USER_TITLE", "Create team discussion"),
+				ReadOnlyHint:    ToBoolPtr(false),
+				DestructiveHint: ToBoolPtr(false),
+				IdempotentHint:  ToBoolPtr(false),
+			}),
+			mcp.WithString("org",
+				mcp.Required(),
+				mcp.Description("Organization name"),
+			),
+			mcp.WithString("team_slug",
+				mcp.Required(),
+				mcp.Description("Slug of the team"),
+			),
+			mcp.WithString("title",
+				mcp.Required(),
+				mcp.Description("Title of the discussion"),
+			),
+			mcp.WithString("body",
+				mcp.Required(),
+				mcp.Description("Body of the discussion, in Markdown"),
+			),
+			mcp.WithBoolean("private",
+				mcp.Description("Only show the discussion to members of the team"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			org, err := RequiredParam[string](request, "org")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			teamSlug, err := RequiredParam[string](request, "team_slug")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			title, err := RequiredParam[string](request, "title")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			body, err := RequiredParam[string](request, "body")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			private, err := OptionalParam[bool](request, "private")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			discussion, resp, err := client.Teams.CreateDiscussionBySlug(ctx, org, teamSlug, github.TeamDiscussion{
+				Title:   github.Ptr(title),
+				Body:    github.Ptr(body),
+				Private: github.Ptr(private),
+			})
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to create discussion for team '%s/%s'", org, teamSlug),
+					resp,
+					err,
+				), nil
+			}
+			_ = resp.Body.Close()
+
+			return MarshalledTextResult(toMinimalTeamDiscussion(discussion)), nil
+		}
+}
@@ -0,0 +1,71 @@
+package github
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/ghmock"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ListTeamDiscussions(t *testing.T) {
+	tool, _ := ListTeamDiscussions(nil, translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+	assert.True(t, *tool.Annotations.ReadOnlyHint)
+	assert.ElementsMatch(t, []string{"org", "team_slug"}, tool.InputSchema.Required)
+
+	server := ghmock.New(t)
+	server.Respond("GET /orgs/octo/teams/platform/discussions", http.StatusOK, []map[string]any{
+		{"number": 2, "title": "Sprint 12 notes", "body": "Done: 8 items", "author": map[string]any{"login": "mona"}, "comments_count": 3, "created_at": "2025-03-01T09:00:00Z"},
+		{"number": 1, "title": "On-call rotation", "pinned": true, "private": true},
+	})
+	_, handler := ListTeamDiscussions(server.GetClient(), translations.NullTranslationHelper)
+
+	result := ghmock.CallTool(t, handler, map[string]any{"org": "octo", "team_slug": "platform"})
+	require.False(t, result.IsError, ghmock.ResultText(t, result))
+	var resp struct {
+		Discussions []MinimalTeamDiscussion `json:"discussions"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(ghmock.ResultText(t, result)), &resp))
+	assert.Equal(t, []MinimalTeamDiscussion{
+		{Number: 2, Title: "Sprint 12 notes", Body: "Done: 8 items", Author: "mona", CommentsCount: 3, CreatedAt: "2025-03-01T09:00:00Z"},
+		{Number: 1, Title: "On-call rotation", Pinned: true, Private: true},
+	}, resp.Discussions)
+
+	result = ghmock.CallTool(t, handler, map[string]any{"org": "octo", "team_slug": "platform", "pinned_only": true})
+	require.False(t, result.IsError, ghmock.ResultText(t, result))
+	require.NoError(t, json.Unmarshal([]byte(ghmock.ResultText(t, result)), &resp))
+	require.Len(t, resp.Discussions, 1)
+	assert.Equal(t, 1, resp.Discussions[0].Number)
+}
+
+func Test_CreateTeamDiscussion(t *testing.T) {
+	tool, _ := CreateTeamDiscussion(nil, translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+	assert.False(t, *tool.Annotations.ReadOnlyHint)
+	assert.ElementsMatch(t, []string{"org", "team_slug", "title", "body"}, tool.InputSchema.Required)
+
+	server := ghmock.New(t)
+	server.Respond("POST /orgs/octo/teams/platform/discussions", http.StatusCreated, map[string]any{
+		"number": 3, "title": "Sprint 13 notes", "body": "Planned: 10 items", "private": true,
+		"html_url": "https://github.com/orgs/octo/teams/platform/discussions/3",
+	})
+	_, handler := CreateTeamDiscussion(server.GetClient(), translations.NullTranslationHelper)
+
+	result := ghmock.CallTool(t, handler, map[string]any{
+		"org": "octo", "team_slug": "platform", "title": "Sprint 13 notes", "body": "Planned: 10 items", "private": true,
+	})
+	require.False(t, result.IsError, ghmock.ResultText(t, result))
+	var body map[string]any
+	require.NoError(t, server.AssertRequested("POST /orgs/octo/teams/platform/discussions").DecodeBody(&body))
+	assert.Equal(t, map[string]any{"title": "Sprint 13 notes", "body": "Planned: 10 items", "private": true}, body)
+
+	var discussion MinimalTeamDiscussion
+	require.NoError(t, json.Unmarshal([]byte(ghmock.ResultText(t, result)), &discussion))
+	assert.Equal(t, 3, discussion.Number)
+	assert.Equal(t, "https://github.com/orgs/octo/teams/platform/discussions/3", discussion.HTMLURL)
+}
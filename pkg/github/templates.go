@@ -0,0 +1,186 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// LicenseSummary is an entry of the list of licenses GitHub offers as templates.
+type LicenseSummary struct {
+	Key    string `json:"key"`
+	Name   string `json:"name"`
+	SPDXID string `json:"spdx_id,omitempty"`
+}
+
+// LicenseTemplate is a license GitHub offers as a template, with the text to put in a LICENSE file.
+type LicenseTemplate struct {
+	Key            string   `json:"key"`
+	Name           string   `json:"name"`
+	SPDXID         string   `json:"spdx_id,omitempty"`
+	Description    string   `json:"description,omitempty"`
+	Implementation string   `json:"implementation,omitempty"`
+	Permissions    []string `json:"permissions,omitempty"`
+	Conditions     []string `json:"conditions,omitempty"`
+	Limitations    []string `json:"limitations,omitempty"`
+	Body           string   `json:"body"`
+}
+
+// ListGitignoreTemplates creates a tool that lists the names of the .gitignore templates GitHub offers.
+func ListGitignoreTemplates(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_gitignore_templates",
+			mcp.WithDescription(t("TOOL_LIST_GITIGNORE_TEMPLATES_DESCRIPTION", "List the names of the .gitignore templates GitHub offers, e.g. 'Go' or 'Node'. Use get_gitignore_template to get one.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_LIST_GITIGNORE_TEMPLATES_USER_TITLE", "List .gitignore templates"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+		),
+		func(ctx context.Context, _ mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			names, resp, err := client.Gitignores.List(ctx)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to list .gitignore templates", resp, err), nil
+			}
+			_ = resp.Body.Close()
+
+			return MarshalledTextResult(NewListResponse("templates", names, NewRESTPageInfo(resp), nil)), nil
+		}
+}
+
+// GetGitignoreTemplate creates a tool that gets the content of a .gitignore template.
+func GetGitignoreTemplate(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_gitignore_template",
+			mcp.WithDescription(t("TOOL_GET_GITIGNORE_TEMPLATE_DESCRIPTION", "Get the content of one of the .gitignore templates GitHub offers, ready to be written to a .gitignore file.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_GET_GITIGNORE_TEMPLATE_USER_TITLE", "Get .gitignore template"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("name",
+				mcp.Required(),
+				mcp.Description("Name of the template as returned by list_gitignore_templates, e.g. 'Go'. Names are case-sensitive."),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			name, err := RequiredParam[string](request, "name")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			template, resp, err := client.Gitignores.Get(ctx, name)
+			if err != nil {
+				if resp != nil && resp.StatusCode == http.StatusNotFound {
+					return mcp.NewToolResultError(fmt.Sprintf("there is no .gitignore template named '%s'; use list_gitignore_templates to see the available names", name)), nil
+				}
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("failed to get .gitignore template '%s'", name), resp, err), nil
+			}
+			_ = resp.Body.Close()
+
+			return MarshalledTextResult(map[string]any{
+				"name":   template.GetName(),
+				"source": template.GetSource(),
+			}), nil
+		}
+}
+
+// ListLicenses creates a tool that lists the licenses GitHub offers as templates.
+func ListLicenses(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_licenses",
+			mcp.WithDescription(t("TOOL_LIST_LICENSES_DESCRIPTION", "List the commonly used licenses GitHub offers as templates, with their keys and SPDX identifiers. Use get_license_template to get the text of one.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_LIST_LICENSES_USER_TITLE", "List license templates"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+		),
+		func(ctx context.Context, _ mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			licenses, resp, err := client.Licenses.List(ctx)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to list licenses", resp, err), nil
+			}
+			_ = resp.Body.Close()
+
+			result := make([]LicenseSummary, 0, len(licenses))
+			for _, license := range licenses {
+				result = append(result, LicenseSummary{
+					Key:    license.GetKey(),
+					Name:   license.GetName(),
+					SPDXID: license.GetSPDXID(),
+				})
+			}
+
+			return MarshalledTextResult(NewListResponse("licenses", result, NewRESTPageInfo(resp), nil)), nil
+		}
+}
+
+// GetLicenseTemplate creates a tool that gets the text and terms of a license GitHub offers as a template.
+func GetLicenseTemplate(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_license_template",
+			mcp.WithDescription(t("TOOL_GET_LICENSE_TEMPLATE_DESCRIPTION", "Get the full text of a license GitHub offers as a template, with its permissions, conditions and limitations. The text contains placeholders such as [year] and [fullname] to fill in before writing it to a LICENSE file; 'implementation' explains how.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_GET_LICENSE_TEMPLATE_USER_TITLE", "Get license template"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("license",
+				mcp.Required(),
+				mcp.Description("Key of the license as returned by list_licenses, e.g. 'mit' or 'apache-2.0'"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			key, err := RequiredParam[string](request, "license")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			license, resp, err := client.Licenses.Get(ctx, key)
+			if err != nil {
+				if resp != nil && resp.StatusCode == http.StatusNotFound {
+					return mcp.NewToolResultError(fmt.Sprintf("there is no license with key '%s'; use list_licenses to see the available keys", key)), nil
+				}
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, fmt.Sprintf("failed to get license %s", key), resp, err), nil
+			}
+			_ = resp.Body.Close()
+
+			result := LicenseTemplate{
+				Key:            license.GetKey(),
+				Name:           license.GetName(),
+				SPDXID:         license.GetSPDXID(),
+				Description:    license.GetDescription(),
+				Implementation: license.GetImplementation(),
+				Body:           license.GetBody(),
+			}
+			if license.Permissions != nil {
+				result.Permissions = *license.Permissions
+			}
+			if license.Conditions != nil {
+				result.Conditions = *license.Conditions
+			}
+			if license.Limitations != nil {
+				result.Limitations = *license.Limitations
+			}
+
+			return MarshalledTextResult(result), nil
+		}
+}
@@ -0,0 +1,102 @@
+package github
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/ghmock"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ListGitignoreTemplates(t *testing.T) {
+	tool, _ := ListGitignoreTemplates(nil, translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+	assert.True(t, *tool.Annotations.ReadOnlyHint)
+
+	server := ghmock.New(t)
+	server.Respond("GET /gitignore/templates", http.StatusOK, []string{"Go", "Node"})
+	_, handler := ListGitignoreTemplates(server.GetClient(), translations.NullTranslationHelper)
+
+	result := ghmock.CallTool(t, handler, map[string]any{})
+	require.False(t, result.IsError, ghmock.ResultText(t, result))
+	assert.JSONEq(t, `{"templates": ["Go", "Node"], "page_info": {"has_next_page": false, "has_previous_page": false}}`, ghmock.ResultText(t, result))
+}
+
+func Test_GetGitignoreTemplate(t *testing.T) {
+	tool, _ := GetGitignoreTemplate(nil, translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+	assert.ElementsMatch(t, []string{"name"}, tool.InputSchema.Required)
+
+	server := ghmock.New(t)
+	server.Respond("GET /gitignore/templates/Go", http.StatusOK, map[string]any{"name": "Go", "source": "*.exe\n*.test\n"})
+	server.Respond("GET /gitignore/templates/go", http.StatusNotFound, map[string]any{"message": "Not Found"})
+	_, handler := GetGitignoreTemplate(server.GetClient(), translations.NullTranslationHelper)
+
+	result := ghmock.CallTool(t, handler, map[string]any{"name": "Go"})
+	require.False(t, result.IsError, ghmock.ResultText(t, result))
+	assert.JSONEq(t, `{"name": "Go", "source": "*.exe\n*.test\n"}`, ghmock.ResultText(t, result))
+
+	result = ghmock.CallTool(t, handler, map[string]any{"name": "go"})
+	require.True(t, result.IsError)
+	assert.Contains(t, ghmock.ResultText(t, result), "there is no .gitignore template named 'go'")
+}
+
+func Test_ListLicenses(t *testing.T) {
+	tool, _ := ListLicenses(nil, translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+	assert.True(t, *tool.Annotations.ReadOnlyHint)
+
+	server := ghmock.New(t)
+	server.Respond("GET /licenses", http.StatusOK, []map[string]any{
+		{"key": "mit", "name": "MIT License", "spdx_id": "MIT", "url": "https://api.github.com/licenses/mit"},
+		{"key": "apache-2.0", "name": "Apache License 2.0", "spdx_id": "Apache-2.0"},
+	})
+	_, handler := ListLicenses(server.GetClient(), translations.NullTranslationHelper)
+
+	result := ghmock.CallTool(t, handler, map[string]any{})
+	require.False(t, result.IsError, ghmock.ResultText(t, result))
+	var response struct {
+		Licenses []LicenseSummary `json:"licenses"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(ghmock.ResultText(t, result)), &response))
+	assert.Equal(t, []LicenseSummary{
+		{Key: "mit", Name: "MIT License", SPDXID: "MIT"},
+		{Key: "apache-2.0", Name: "Apache License 2.0", SPDXID: "Apache-2.0"},
+	}, response.Licenses)
+}
+
+func Test_GetLicenseTemplate(t *testing.T) {
+	tool, _ := GetLicenseTemplate(nil, translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+	assert.ElementsMatch(t, []string{"license"}, tool.InputSchema.Required)
+
+	server := ghmock.New(t)
+	server.Respond("GET /licenses/mit", http.StatusOK, map[string]any{
+		"key": "mit", "name": "MIT License", "spdx_id": "MIT",
+		"description":    "A short and simple permissive license.",
+		"implementation": "Create a text file (typically named LICENSE or LICENSE.txt) in the root of your source code and copy the text of the license into the file. Replace [year] with the current year and [fullname] with the name (or names) of the copyright holders.",
+		"permissions":    []string{"commercial-use", "modifications"},
+		"conditions":     []string{"include-copyright"},
+		"limitations":    []string{"liability", "warranty"},
+		"body":           "MIT License\n\nCopyright (c) [year] [fullname]\n",
+	})
+	server.Respond("GET /licenses/nope", http.StatusNotFound, map[string]any{"message": "Not Found"})
+	_, handler := GetLicenseTemplate(server.GetClient(), translations.NullTranslationHelper)
+
+	result := ghmock.CallTool(t, handler, map[string]any{"license": "mit"})
+	require.False(t, result.IsError, ghmock.ResultText(t, result))
+	var license LicenseTemplate
+	require.NoError(t, json.Unmarshal([]byte(ghmock.ResultText(t, result)), &license))
+	assert.Equal(t, "MIT", license.SPDXID)
+	assert.Equal(t, "MIT License\n\nCopyright (c) [year] [fullname]\n", license.Body)
+	assert.Equal(t, []string{"include-copyright"}, license.Conditions)
+	assert.Contains(t, license.Implementation, "[fullname]")
+
+	result = ghmock.CallTool(t, handler, map[string]any{"license": "nope"})
+	require.True(t, result.IsError)
+	assert.Contains(t, ghmock.ResultText(t, result), "there is no license with key 'nope'")
+}
@@ -0,0 +1,135 @@
+package github
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// relativeSuffix is appended to the name of a timestamp field to name the field holding its relative time.
+const relativeSuffix = "_relative"
+
+// FormatTimestamp formats a timestamp for tool output as RFC 3339, keeping the time zone GitHub reported it in.
+func FormatTimestamp(t time.Time) string {
+	return t.Format(time.RFC3339)
+}
+
+// RelativeTime describes t relative to now the way a person would, e.g. "3 days ago" or "in 2 hours".
+func RelativeTime(t, now time.Time) string {
+	d := now.Sub(t)
+	future := d < 0
+	if future {
+		d = -d
+	}
+
+	var n int
+	var unit string
+	switch {
+	case d < time.Minute:
+		return "just now"
+	case d < time.Hour:
+		n, unit = int(d/time.Minute), "minute"
+	case d < 24*time.Hour:
+		n, unit = int(d/time.Hour), "hour"
+	case d < 30*24*time.Hour:
+		n, unit = int(d/(24*time.Hour)), "day"
+	case d < 365*24*time.Hour:
+		n, unit = int(d/(30*24*time.Hour)), "month"
+	default:
+		n, unit = int(d/(365*24*time.Hour)), "year"
+	}
+	if n != 1 {
+		unit += "s"
+	}
+	if future {
+		return fmt.Sprintf("in %d %s", n, unit)
+	}
+	return fmt.Sprintf("%d %s ago", n, unit)
+}
+
+// RelativeTimestamps is a tool handler middleware that adds a human-relative time next to every RFC 3339 timestamp
+// in JSON tool results, e.g. "updated_at_relative": "3 days ago" next to "updated_at", for chat clients to show
+// directly. now returns the current time.
+func RelativeTimestamps(now func() time.Time) server.ToolHandlerMiddleware {
+	return func(next server.ToolHandlerFunc) server.ToolHandlerFunc {
+		return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			result, err := next(ctx, request)
+			if err != nil || result == nil || result.IsError {
+				return result, err
+			}
+			for i, content := range result.Content {
+				if text, ok := content.(mcp.TextContent); ok {
+					if relative, ok := addRelativeTimes(text.Text, now()); ok {
+						text.Text = relative
+						result.Content[i] = text
+					}
+				}
+			}
+			return result, nil
+		}
+	}
+}
+
+// addRelativeTimes returns the JSON document text with relative times added, and whether it added any.
+func addRelativeTimes(text string, now time.Time) (string, bool) {
+	trimmed := strings.TrimSpace(text)
+	if !strings.HasPrefix(trimmed, "{") && !strings.HasPrefix(trimmed, "[") {
+		return "", false
+	}
+	decoder := json.NewDecoder(strings.NewReader(trimmed))
+	// Keep large IDs exact.
+	decoder.UseNumber()
+	var doc any
+	if decoder.Decode(&doc) != nil {
+		return "", false
+	}
+	if !addRelativeTimesTo(doc, now) {
+		return "", false
+	}
+
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	if encoder.Encode(doc) != nil {
+		return "", false
+	}
+	return strings.TrimSuffix(buf.String(), "\n"), true
+}
+
+func addRelativeTimesTo(value any, now time.Time) bool {
+	added := false
+	switch v := value.(type) {
+	case map[string]any:
+		relative := map[string]string{}
+		for key, field := range v {
+			if s, ok := field.(string); ok {
+				if t, err := time.Parse(time.RFC3339, s); err == nil && !strings.HasSuffix(key, relativeSuffix) {
+					relative[key+relativeSuffix] = RelativeTime(t, now)
+				}
+				continue
+			}
+			if addRelativeTimesTo(field, now) {
+				added = true
+			}
+		}
+		for key, rel := range relative {
+			if _, exists := v[key]; !exists {
+				v[key] = rel
+				added = true
+			}
+		}
+	case []any:
+		for _, item := range v {
+			if addRelativeTimesTo(item, now) {
+				added = true
+			}
+		}
+	}
+	return added
+}
@@ -0,0 +1,70 @@
+package github
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_FormatTimestamp(t *testing.T) {
+	pst := time.FixedZone("PST", -8*60*60)
+	assert.Equal(t, "2025-03-01T09:30:00-08:00", FormatTimestamp(time.Date(2025, 3, 1, 9, 30, 0, 0, pst)))
+	assert.Equal(t, "2025-03-01T17:30:00Z", FormatTimestamp(time.Date(2025, 3, 1, 17, 30, 0, 0, time.UTC)))
+}
+
+func Test_RelativeTime(t *testing.T) {
+	now := time.Date(2025, 6, 15, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		offset   time.Duration
+		expected string
+	}{
+		{-30 * time.Second, "just now"},
+		{-1 * time.Minute, "1 minute ago"},
+		{-45 * time.Minute, "45 minutes ago"},
+		{-5 * time.Hour, "5 hours ago"},
+		{-3 * 24 * time.Hour, "3 days ago"},
+		{-65 * 24 * time.Hour, "2 months ago"},
+		{-800 * 24 * time.Hour, "2 years ago"},
+		{2 * time.Hour, "in 2 hours"},
+	}
+	for _, tc := range tests {
+		assert.Equal(t, tc.expected, RelativeTime(now.Add(tc.offset), now), tc.offset.String())
+	}
+}
+
+func Test_RelativeTimestamps(t *testing.T) {
+	now := time.Date(2025, 6, 15, 12, 0, 0, 0, time.UTC)
+	call := func(result *mcp.CallToolResult) *mcp.CallToolResult {
+		handler := RelativeTimestamps(func() time.Time { return now })(func(_ context.Context, _ mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return result, nil
+		})
+		got, err := handler(context.Background(), mcp.CallToolRequest{})
+		require.NoError(t, err)
+		return got
+	}
+
+	t.Run("adds relative times next to timestamps", func(t *testing.T) {
+		result := call(mcp.NewToolResultText(`{"id":9007199254740993,"title":"<b>","updated_at":"2025-06-12T12:00:00Z","items":[{"created_at":"2025-06-15T04:00:00-08:00","date":"2025-06-15"}]}`))
+		assert.JSONEq(t, `{
+			"id": 9007199254740993,
+			"title": "<b>",
+			"updated_at": "2025-06-12T12:00:00Z",
+			"updated_at_relative": "3 days ago",
+			"items": [{"created_at": "2025-06-15T04:00:00-08:00", "created_at_relative": "just now", "date": "2025-06-15"}]
+		}`, getTextResult(t, result).Text)
+		assert.Contains(t, getTextResult(t, result).Text, "9007199254740993", "large IDs must stay exact")
+		assert.Contains(t, getTextResult(t, result).Text, "<b>", "HTML must not be escaped")
+	})
+
+	t.Run("leaves other results unchanged", func(t *testing.T) {
+		for _, text := range []string{`{"name":"no timestamps"}`, "plain text from 2025-06-12T12:00:00Z", `{"broken":`} {
+			assert.Equal(t, text, getTextResult(t, call(mcp.NewToolResultText(text))).Text)
+		}
+		errResult := mcp.NewToolResultError(`{"updated_at":"2025-06-12T12:00:00Z"}`)
+		assert.Equal(t, `{"updated_at":"2025-06-12T12:00:00Z"}`, getTextResult(t, call(errResult)).Text)
+	})
+}
@@ -0,0 +1,346 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/toolsets"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// tokenRequirements describes what a token needs to use the tools of one toolset. Classic scopes apply to classic
+// personal access tokens and OAuth apps; fine-grained permissions apply to fine-grained personal access tokens and
+// GitHub Apps, keyed by the permission's category ("repository", "organization" or "account") and name.
+type tokenRequirements struct {
+	readScopes  []string
+	writeScopes []string
+	readPerms   []finePermission
+	writePerms  []finePermission
+	note        string
+}
+
+type finePermission struct {
+	category string
+	name     string
+	access   string
+}
+
+func repoPerm(name, access string) finePermission {
+	return finePermission{category: "repository", name: name, access: access}
+}
+
+func orgPerm(name, access string) finePermission {
+	return finePermission{category: "organization", name: name, access: access}
+}
+
+func accountPerm(name, access string) finePermission {
+	return finePermission{category: "account", name: name, access: access}
+}
+
+// toolsetTokenRequirements maps toolset IDs to the token scopes and permissions their tools need. Toolsets that are
+// missing, such as users, work with any token.
+var toolsetTokenRequirements = map[string]tokenRequirements{
+	ToolsetMetadataContext.ID: {
+		readScopes: []string{"read:org"},
+		readPerms:  []finePermission{orgPerm("Members", "read")},
+	},
+	ToolsetMetadataRepos.ID: {
+		readScopes:  []string{"repo"},
+		writeScopes: []string{"repo"},
+		readPerms:   []finePermission{repoPerm("Contents", "read"), repoPerm("Metadata", "read")},
+		writePerms:  []finePermission{repoPerm("Contents", "write"), repoPerm("Administration", "write")},
+	},
+	ToolsetMetadataGit.ID: {
+		readScopes: []string{"repo"},
+		readPerms:  []finePermission{repoPerm("Contents", "read"), repoPerm("Metadata", "read")},
+	},
+	ToolsetMetadataIssues.ID: {
+		readScopes:  []string{"repo"},
+		writeScopes: []string{"repo"},
+		readPerms:   []finePermission{repoPerm("Issues", "read"), repoPerm("Metadata", "read")},
+		writePerms:  []finePermission{repoPerm("Issues", "write")},
+	},
+	ToolsetMetadataPullRequests.ID: {
+		readScopes:  []string{"repo"},
+		writeScopes: []string{"repo"},
+		readPerms:   []finePermission{repoPerm("Pull requests", "read"), repoPerm("Contents", "read"), repoPerm("Metadata", "read")},
+		writePerms:  []finePermission{repoPerm("Pull requests", "write"), repoPerm("Contents", "write")},
+	},
+	ToolsetMetadataOrgs.ID: {
+		readScopes: []string{"read:org"},
+		readPerms:  []finePermission{orgPerm("Members", "read")},
+	},
+	ToolsetMetadataActions.ID: {
+		readScopes:  []string{"repo"},
+		writeScopes: []string{"repo", "workflow"},
+		readPerms:   []finePermission{repoPerm("Actions", "read"), repoPerm("Metadata", "read")},
+		writePerms:  []finePermission{repoPerm("Actions", "write")},
+	},
+	ToolsetMetadataCodeSecurity.ID: {
+		readScopes: []string{"security_events"},
+		readPerms:  []finePermission{repoPerm("Code scanning alerts", "read"), repoPerm("Metadata", "read")},
+	},
+	ToolsetMetadataSecretProtection.ID: {
+		readScopes: []string{"security_events"},
+		readPerms:  []finePermission{repoPerm("Secret scanning alerts", "read"), repoPerm("Metadata", "read")},
+	},
+	ToolsetMetadataDependabot.ID: {
+		readScopes: []string{"security_events"},
+		readPerms:  []finePermission{repoPerm("Dependabot alerts", "read"), repoPerm("Metadata", "read")},
+	},
+	ToolsetMetadataNotifications.ID: {
+		readScopes: []string{"notifications"},
+		note:       "Notifications are not available to fine-grained personal access tokens; use a classic token with the notifications scope.",
+	},
+	ToolsetMetadataDiscussions.ID: {
+		readScopes: []string{"repo"},
+		readPerms:  []finePermission{repoPerm("Discussions", "read"), repoPerm("Metadata", "read")},
+	},
+	ToolsetMetadataGists.ID: {
+		writeScopes: []string{"gist"},
+		writePerms:  []finePermission{accountPerm("Gists", "write")},
+	},
+	ToolsetMetadataSecurityAdvisories.ID: {
+		readScopes: []string{"repo"},
+		readPerms:  []finePermission{repoPerm("Repository security advisories", "read"), repoPerm("Metadata", "read")},
+	},
+	ToolsetMetadataProjects.ID: {
+		readScopes:  []string{"read:project"},
+		writeScopes: []string{"project"},
+		readPerms:   []finePermission{orgPerm("Projects", "read")},
+		writePerms:  []finePermission{orgPerm("Projects", "write")},
+		note:        "Fine-grained personal access tokens can only access organization projects, not projects owned by a user.",
+	},
+	ToolsetMetadataStargazers.ID: {
+		writeScopes: []string{"public_repo"},
+		writePerms:  []finePermission{accountPerm("Starring", "write")},
+	},
+	ToolsetLabels.ID: {
+		readScopes:  []string{"repo"},
+		writeScopes: []string{"repo"},
+		readPerms:   []finePermission{repoPerm("Issues", "read"), repoPerm("Metadata", "read")},
+		writePerms:  []finePermission{repoPerm("Issues", "write")},
+	},
+	ToolsetMetadataPackages.ID: {
+		readScopes: []string{"read:packages"},
+		note:       "Packages are not available to fine-grained personal access tokens; use a classic token with the read:packages scope.",
+	},
+	ToolsetMetadataCodespaces.ID: {
+		readScopes:  []string{"codespace"},
+		writeScopes: []string{"codespace"},
+		readPerms:   []finePermission{accountPerm("Codespaces", "read")},
+		writePerms:  []finePermission{accountPerm("Codespaces", "write"), repoPerm("Codespaces", "write")},
+	},
+}
+
+// impliedScopes lists the classic scopes that each scope also grants, so that a recommendation does not ask for both
+// repo and public_repo, and a token with admin:org is not reported as missing read:org.
+var impliedScopes = map[string][]string{
+	"repo":           {"public_repo", "repo:status", "repo_deployment", "repo:invite", "security_events"},
+	"admin:org":      {"write:org", "read:org"},
+	"write:org":      {"read:org"},
+	"project":        {"read:project"},
+	"write:packages": {"read:packages"},
+	"user":           {"read:user", "user:email", "user:follow"},
+}
+
+// scopeGrants reports whether the granted classic scope includes scope.
+func scopeGrants(granted, scope string) bool {
+	return granted == scope || slices.Contains(impliedScopes[granted], scope)
+}
+
+// httpHeaderOAuthScopes is the canonical name of the header in which GitHub reports the scopes of a classic token.
+const httpHeaderOAuthScopes = "X-Oauth-Scopes"
+
+// TokenScopeRecommendation is the output of recommend_token_scopes.
+type TokenScopeRecommendation struct {
+	Toolsets               []string                     `json:"toolsets"`
+	ReadOnly               bool                         `json:"read_only"`
+	ClassicScopes          []string                     `json:"classic_scopes"`
+	FineGrainedPermissions map[string]map[string]string `json:"fine_grained_permissions"`
+	Notes                  []string                     `json:"notes,omitempty"`
+	TokenCheck             *TokenScopeCheck             `json:"token_check,omitempty"`
+}
+
+// TokenScopeCheck compares the scopes of the server's token with a recommendation.
+type TokenScopeCheck struct {
+	// TokenType is "classic" when GitHub reported the token's scopes, and "fine-grained" otherwise.
+	TokenType     string   `json:"token_type"`
+	GrantedScopes []string `json:"granted_scopes,omitempty"`
+	MissingScopes []string `json:"missing_scopes,omitempty"`
+	ExtraScopes   []string `json:"extra_scopes,omitempty"`
+	Sufficient    *bool    `json:"sufficient,omitempty"`
+	Note          string   `json:"note,omitempty"`
+}
+
+// recommendTokenScopes computes the minimal classic scopes and fine-grained permissions for the given toolsets.
+func recommendTokenScopes(toolsetIDs []string, readOnly bool) TokenScopeRecommendation {
+	var scopes []string
+	perms := map[string]map[string]string{}
+	var notes []string
+
+	addPerm := func(p finePermission) {
+		if perms[p.category] == nil {
+			perms[p.category] = map[string]string{}
+		}
+		if perms[p.category][p.name] != "write" {
+			perms[p.category][p.name] = p.access
+		}
+	}
+
+	for _, id := range toolsetIDs {
+		req, ok := toolsetTokenRequirements[id]
+		if !ok {
+			continue
+		}
+		scopes = append(scopes, req.readScopes...)
+		for _, p := range req.readPerms {
+			addPerm(p)
+		}
+		if !readOnly {
+			scopes = append(scopes, req.writeScopes...)
+			for _, p := range req.writePerms {
+				addPerm(p)
+			}
+		}
+		if req.note != "" {
+			notes = append(notes, req.note)
+		}
+	}
+
+	// Drop scopes that another recommended scope already grants.
+	minimal := []string{}
+	for _, scope := range scopes {
+		if slices.Contains(minimal, scope) {
+			continue
+		}
+		implied := slices.ContainsFunc(scopes, func(other string) bool {
+			return other != scope && scopeGrants(other, scope)
+		})
+		if !implied {
+			minimal = append(minimal, scope)
+		}
+	}
+	slices.Sort(minimal)
+
+	if len(perms["repository"]) > 0 {
+		notes = append(notes, "Fine-grained tokens must also be granted access to the repositories the tools operate on.")
+	}
+
+	return TokenScopeRecommendation{
+		Toolsets:               toolsetIDs,
+		ReadOnly:               readOnly,
+		ClassicScopes:          minimal,
+		FineGrainedPermissions: perms,
+		Notes:                  notes,
+	}
+}
+
+// checkTokenScopes compares the scopes GitHub reported for the token in the X-OAuth-Scopes header with the
+// recommended scopes. GitHub only reports scopes for classic tokens.
+func checkTokenScopes(header string, present bool, recommended []string) *TokenScopeCheck {
+	if !present {
+		return &TokenScopeCheck{
+			TokenType: "fine-grained",
+			Note:      "GitHub does not report the permissions of fine-grained tokens or GitHub App tokens; compare them with fine_grained_permissions in the token settings.",
+		}
+	}
+
+	granted := []string{}
+	for _, scope := range strings.Split(header, ",") {
+		if scope = strings.TrimSpace(scope); scope != "" {
+			granted = append(granted, scope)
+		}
+	}
+	slices.Sort(granted)
+
+	check := &TokenScopeCheck{TokenType: "classic", GrantedScopes: granted}
+	for _, scope := range recommended {
+		if !slices.ContainsFunc(granted, func(g string) bool { return scopeGrants(g, scope) }) {
+			check.MissingScopes = append(check.MissingScopes, scope)
+		}
+	}
+	for _, scope := range granted {
+		if !slices.Contains(recommended, scope) {
+			check.ExtraScopes = append(check.ExtraScopes, scope)
+		}
+	}
+	sufficient := len(check.MissingScopes) == 0
+	check.Sufficient = &sufficient
+	return check
+}
+
+// RecommendTokenScopes creates a tool that recommends the minimal token scopes and permissions needed by the enabled
+// toolsets, and optionally checks the server's token against them.
+func RecommendTokenScopes(getClient GetClientFn, tsg *toolsets.ToolsetGroup, readOnly bool, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	return mcp.NewTool("recommend_token_scopes",
+			mcp.WithDescription(t("TOOL_RECOMMEND_TOKEN_SCOPES_DESCRIPTION", "Recommend the minimal classic personal access token scopes and fine-grained token permissions needed by the enabled toolsets, or by the given toolsets. Optionally checks the scopes of the current token against the recommendation, to find missing or unnecessary scopes.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_RECOMMEND_TOKEN_SCOPES_USER_TITLE", "Recommend token scopes"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithArray("toolsets",
+				mcp.Description("Toolsets to recommend scopes for. Defaults to the enabled toolsets"),
+				mcp.WithStringItems(),
+			),
+			mcp.WithBoolean("read_only",
+				mcp.Description("Only consider read-only tools. Defaults to the server's read-only mode"),
+			),
+			mcp.WithBoolean("check_token",
+				mcp.Description("Check the scopes of the current token against the recommendation"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			toolsetIDs, err := OptionalStringArrayParam(request, "toolsets")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			readOnlyArg, err := OptionalBoolParamWithDefault(request, "read_only", readOnly)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			checkToken, err := OptionalParam[bool](request, "check_token")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			if len(toolsetIDs) == 0 {
+				for id := range tsg.Toolsets {
+					if tsg.IsEnabled(id) {
+						toolsetIDs = append(toolsetIDs, id)
+					}
+				}
+			} else {
+				for _, id := range toolsetIDs {
+					if _, ok := tsg.Toolsets[id]; !ok {
+						return mcp.NewToolResultError(fmt.Sprintf("toolset %s does not exist", id)), nil
+					}
+				}
+			}
+			slices.Sort(toolsetIDs)
+			toolsetIDs = slices.Compact(toolsetIDs)
+
+			// The server's read-only mode cannot be lifted by the caller.
+			recommendation := recommendTokenScopes(toolsetIDs, readOnlyArg || readOnly)
+
+			if checkToken {
+				client, err := getClient(ctx)
+				if err != nil {
+					return mcp.NewToolResultErrorFromErr("failed to get GitHub client", err), nil
+				}
+				_, resp, err := client.Users.Get(ctx, "")
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to check token scopes", resp, err), nil
+				}
+				_, present := resp.Header[httpHeaderOAuthScopes]
+				recommendation.TokenCheck = checkTokenScopes(resp.Header.Get(httpHeaderOAuthScopes), present, recommendation.ClassicScopes)
+			}
+
+			return MarshalledTextResult(recommendation), nil
+		}
+}
@@ -0,0 +1,114 @@
+package github
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/ghmock"
+	"github.com/github/github-mcp-server/pkg/toolsets"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_RecommendTokenScopes(t *testing.T) {
+	t.Parallel()
+
+	newGroup := func(getClient GetClientFn, readOnly bool, enabled ...string) *toolsets.ToolsetGroup {
+		tsg := DefaultToolsetGroup(readOnly, getClient, nil, nil, translations.NullTranslationHelper, 5000, FeatureFlags{}, nil, nil, nil)
+		require.NoError(t, tsg.EnableToolsets(enabled, nil))
+		return tsg
+	}
+
+	tool, _ := RecommendTokenScopes(nil, newGroup(nil, false), false, translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+	assert.Equal(t, "recommend_token_scopes", tool.Name)
+	assert.True(t, *tool.Annotations.ReadOnlyHint)
+
+	recommend := func(t *testing.T, getClient GetClientFn, tsg *toolsets.ToolsetGroup, readOnly bool, args map[string]any) TokenScopeRecommendation {
+		t.Helper()
+		_, handler := RecommendTokenScopes(getClient, tsg, readOnly, translations.NullTranslationHelper)
+		result := ghmock.CallTool(t, handler, args)
+		require.False(t, result.IsError, ghmock.ResultText(t, result))
+		var recommendation TokenScopeRecommendation
+		require.NoError(t, json.Unmarshal([]byte(ghmock.ResultText(t, result)), &recommendation))
+		return recommendation
+	}
+
+	t.Run("enabled toolsets", func(t *testing.T) {
+		tsg := newGroup(nil, false, ToolsetMetadataIssues.ID, ToolsetMetadataActions.ID, ToolsetMetadataStargazers.ID)
+		got := recommend(t, nil, tsg, false, map[string]any{})
+
+		assert.Equal(t, []string{"actions", "issues", "stargazers"}, got.Toolsets)
+		// public_repo is granted by repo
+		assert.Equal(t, []string{"repo", "workflow"}, got.ClassicScopes)
+		assert.Equal(t, map[string]string{"Issues": "write", "Actions": "write", "Metadata": "read"}, got.FineGrainedPermissions["repository"])
+		assert.Equal(t, map[string]string{"Starring": "write"}, got.FineGrainedPermissions["account"])
+		assert.Nil(t, got.TokenCheck)
+	})
+
+	t.Run("read-only", func(t *testing.T) {
+		tsg := newGroup(nil, false)
+		got := recommend(t, nil, tsg, false, map[string]any{
+			"toolsets":  []any{"projects", "gists", "context"},
+			"read_only": true,
+		})
+
+		assert.True(t, got.ReadOnly)
+		assert.Equal(t, []string{"read:org", "read:project"}, got.ClassicScopes)
+		assert.Equal(t, map[string]string{"Members": "read", "Projects": "read"}, got.FineGrainedPermissions["organization"])
+		assert.NotContains(t, got.FineGrainedPermissions, "account")
+		assert.Len(t, got.Notes, 1)
+	})
+
+	t.Run("read-only server ignores read_only false", func(t *testing.T) {
+		tsg := newGroup(nil, true)
+		got := recommend(t, nil, tsg, true, map[string]any{"toolsets": []any{"gists"}, "read_only": false})
+		assert.True(t, got.ReadOnly)
+		assert.Empty(t, got.ClassicScopes)
+	})
+
+	t.Run("unknown toolset", func(t *testing.T) {
+		_, handler := RecommendTokenScopes(nil, newGroup(nil, false), false, translations.NullTranslationHelper)
+		result := ghmock.CallTool(t, handler, map[string]any{"toolsets": []any{"nope"}})
+		require.True(t, result.IsError)
+		assert.Equal(t, "toolset nope does not exist", ghmock.ResultText(t, result))
+	})
+
+	t.Run("checks a classic token", func(t *testing.T) {
+		mock := ghmock.New(t)
+		mock.HandleFunc("GET /user", func(w http.ResponseWriter, _ *http.Request) {
+			w.Header().Set("X-OAuth-Scopes", "repo, admin:org, gist")
+			_, _ = w.Write([]byte(`{"login":"octocat"}`))
+		})
+		tsg := newGroup(mock.GetClient(), false)
+		got := recommend(t, mock.GetClient(), tsg, false, map[string]any{
+			"toolsets":    []any{"orgs", "actions"},
+			"check_token": true,
+		})
+
+		require.NotNil(t, got.TokenCheck)
+		assert.Equal(t, "classic", got.TokenCheck.TokenType)
+		assert.Equal(t, []string{"admin:org", "gist", "repo"}, got.TokenCheck.GrantedScopes)
+		assert.Equal(t, []string{"workflow"}, got.TokenCheck.MissingScopes)
+		assert.Equal(t, []string{"admin:org", "gist"}, got.TokenCheck.ExtraScopes)
+		assert.False(t, *got.TokenCheck.Sufficient)
+	})
+
+	t.Run("checks a fine-grained token", func(t *testing.T) {
+		mock := ghmock.New(t)
+		mock.Respond("GET /user", http.StatusOK, map[string]any{"login": "octocat"})
+		tsg := newGroup(mock.GetClient(), false)
+		got := recommend(t, mock.GetClient(), tsg, false, map[string]any{
+			"toolsets":    []any{"issues"},
+			"check_token": true,
+		})
+
+		require.NotNil(t, got.TokenCheck)
+		assert.Equal(t, "fine-grained", got.TokenCheck.TokenType)
+		assert.Nil(t, got.TokenCheck.Sufficient)
+		assert.NotEmpty(t, got.TokenCheck.Note)
+	})
+}
@@ -112,6 +112,14 @@ var (
 		ID:          "labels",
 		Description: "GitHub Labels related tools",
 	}
+	ToolsetMetadataPackages = ToolsetMetadata{
+		ID:          "packages",
+		Description: "GitHub Packages related tools, such as Container Registry images",
+	}
+	ToolsetMetadataCodespaces = ToolsetMetadata{
+		ID:          "codespaces",
+		Description: "GitHub Codespaces related tools",
+	}
 )
 
 func AvailableTools() []ToolsetMetadata {
@@ -135,6 +143,8 @@ func AvailableTools() []ToolsetMetadata {
 		ToolsetMetadataStargazers,
 		ToolsetMetadataDynamic,
 		ToolsetLabels,
+		ToolsetMetadataPackages,
+		ToolsetMetadataCodespaces,
 	}
 }
 
@@ -160,7 +170,7 @@ func GetDefaultToolsetIDs() []string {
 	}
 }
 
-func DefaultToolsetGroup(readOnly bool, getClient GetClientFn, getGQLClient GetGQLClientFn, getRawClient raw.GetRawClientFn, t translations.TranslationHelperFunc, contentWindowSize int, flags FeatureFlags, cache *lockdown.RepoAccessCache) *toolsets.ToolsetGroup {
+func DefaultToolsetGroup(readOnly bool, getClient GetClientFn, getGQLClient GetGQLClientFn, getRawClient raw.GetRawClientFn, t translations.TranslationHelperFunc, contentWindowSize int, flags FeatureFlags, cache *lockdown.RepoAccessCache, defaultStatuses ProjectDefaultStatuses, slaPolicies ProjectSLAPolicies) *toolsets.ToolsetGroup {
 	tsg := toolsets.NewToolsetGroup(readOnly)
 
 	// Define all available features with their default state (disabled)
@@ -168,24 +178,52 @@ func DefaultToolsetGroup(readOnly bool, getClient GetClientFn, getGQLClient GetG
 	repos := toolsets.NewToolset(ToolsetMetadataRepos.ID, ToolsetMetadataRepos.Description).
 		AddReadTools(
 			toolsets.NewServerTool(SearchRepositories(getClient, t)),
+			toolsets.NewServerTool(ListOrgRepositories(getClient, t)),
 			toolsets.NewServerTool(GetFileContents(getClient, getRawClient, t)),
 			toolsets.NewServerTool(ListCommits(getClient, t)),
 			toolsets.NewServerTool(SearchCode(getClient, t)),
 			toolsets.NewServerTool(GetCommit(getClient, t)),
 			toolsets.NewServerTool(ListBranches(getClient, t)),
+			toolsets.NewServerTool(CompareRefs(getClient, t)),
+			toolsets.NewServerTool(EvaluateRulesets(getClient, t)),
+			toolsets.NewServerTool(GetRequiredChecks(getClient, t)),
 			toolsets.NewServerTool(ListTags(getClient, t)),
 			toolsets.NewServerTool(GetTag(getClient, t)),
 			toolsets.NewServerTool(ListReleases(getClient, t)),
 			toolsets.NewServerTool(GetLatestRelease(getClient, t)),
 			toolsets.NewServerTool(GetReleaseByTag(getClient, t)),
+			toolsets.NewServerTool(CheckReleaseTag(getClient, t)),
+			toolsets.NewServerTool(ListTagProtectionRules(getClient, t)),
+			toolsets.NewServerTool(ListRepositoryCollaborators(getClient, t)),
+			toolsets.NewServerTool(ListRepositoryTeams(getClient, t)),
+			toolsets.NewServerTool(ListRepositoryInvitations(getClient, t)),
+			toolsets.NewServerTool(ListDeployKeys(getClient, t)),
+			toolsets.NewServerTool(ListGitignoreTemplates(getClient, t)),
+			toolsets.NewServerTool(GetGitignoreTemplate(getClient, t)),
+			toolsets.NewServerTool(ListLicenses(getClient, t)),
+			toolsets.NewServerTool(GetLicenseTemplate(getClient, t)),
 		).
 		AddWriteTools(
 			toolsets.NewServerTool(CreateOrUpdateFile(getClient, t)),
 			toolsets.NewServerTool(CreateRepository(getClient, t)),
 			toolsets.NewServerTool(ForkRepository(getClient, t)),
+			toolsets.NewServerTool(SyncFork(getClient, t)),
 			toolsets.NewServerTool(CreateBranch(getClient, t)),
 			toolsets.NewServerTool(PushFiles(getClient, t)),
 			toolsets.NewServerTool(DeleteFile(getClient, t)),
+			toolsets.NewServerTool(ArchiveRepository(getClient, t)),
+			toolsets.NewServerTool(UnarchiveRepository(getClient, t)),
+			toolsets.NewServerTool(TransferRepository(getClient, t)),
+			toolsets.NewServerTool(AddRepositoryCollaborator(getClient, t)),
+			toolsets.NewServerTool(RemoveRepositoryCollaborator(getClient, t)),
+			toolsets.NewServerTool(SetTeamRepositoryPermission(getClient, t)),
+			toolsets.NewServerTool(RemoveTeamRepositoryAccess(getClient, t)),
+			toolsets.NewServerTool(CancelRepositoryInvitation(getClient, t)),
+			toolsets.NewServerTool(ResendRepositoryInvitation(getClient, t)),
+			toolsets.NewServerTool(AddDeployKey(getClient, t)),
+			toolsets.NewServerTool(DeleteDeployKey(getClient, t)),
+			toolsets.NewServerTool(CreateTagProtectionRule(getClient, t)),
+			toolsets.NewServerTool(DeleteTagProtectionRule(getClient, t)),
 		).
 		AddResourceTemplates(
 			toolsets.NewServerResourceTemplate(GetRepositoryResourceContent(getClient, getRawClient, t)),
@@ -197,20 +235,30 @@ func DefaultToolsetGroup(readOnly bool, getClient GetClientFn, getGQLClient GetG
 	git := toolsets.NewToolset(ToolsetMetadataGit.ID, ToolsetMetadataGit.Description).
 		AddReadTools(
 			toolsets.NewServerTool(GetRepositoryTree(getClient, t)),
+			toolsets.NewServerTool(GetCommunityProfile(getClient, t)),
+			toolsets.NewServerTool(GetLicense(getClient, t)),
+			toolsets.NewServerTool(GetContributorStats(getClient, t)),
+			toolsets.NewServerTool(GetCodeFrequency(getClient, t)),
 		)
 	issues := toolsets.NewToolset(ToolsetMetadataIssues.ID, ToolsetMetadataIssues.Description).
 		AddReadTools(
 			toolsets.NewServerTool(IssueRead(getClient, getGQLClient, cache, t, flags)),
-			toolsets.NewServerTool(SearchIssues(getClient, t)),
-			toolsets.NewServerTool(ListIssues(getGQLClient, t)),
+			toolsets.NewServerTool(SearchIssues(getClient, getGQLClient, t)),
+			toolsets.NewServerTool(ListIssues(getClient, getGQLClient, t)),
 			toolsets.NewServerTool(ListIssueTypes(getClient, t)),
+			toolsets.NewServerTool(ListIssueTemplates(getClient, t)),
+			toolsets.NewServerTool(GetLinkedIssues(getGQLClient, t)),
 			toolsets.NewServerTool(GetLabel(getGQLClient, t)),
+			toolsets.NewServerTool(ParseMarkdownTasks(getClient, t)),
 		).
 		AddWriteTools(
 			toolsets.NewServerTool(IssueWrite(getClient, getGQLClient, t)),
+			toolsets.NewServerTool(CreateIssuesBulk(getClient, t)),
+			toolsets.NewServerTool(LinkIssues(getClient, getGQLClient, t)),
 			toolsets.NewServerTool(AddIssueComment(getClient, t)),
 			toolsets.NewServerTool(AssignCopilotToIssue(getGQLClient, t)),
 			toolsets.NewServerTool(SubIssueWrite(getClient, t)),
+			toolsets.NewServerTool(UpdateMarkdownTask(getClient, t)),
 		).AddPrompts(
 		toolsets.NewServerPrompt(AssignCodingAgentPrompt(t)),
 		toolsets.NewServerPrompt(IssueToFixWorkflowPrompt(t)),
@@ -218,21 +266,45 @@ func DefaultToolsetGroup(readOnly bool, getClient GetClientFn, getGQLClient GetG
 	users := toolsets.NewToolset(ToolsetMetadataUsers.ID, ToolsetMetadataUsers.Description).
 		AddReadTools(
 			toolsets.NewServerTool(SearchUsers(getClient, t)),
+			toolsets.NewServerTool(ListReceivedInvitations(getClient, t)),
+			toolsets.NewServerTool(ListSSHKeys(getClient, t)),
+			toolsets.NewServerTool(ListGPGKeys(getClient, t)),
+		).
+		AddWriteTools(
+			toolsets.NewServerTool(AcceptInvitation(getClient, t)),
+			toolsets.NewServerTool(AddSSHKey(getClient, t)),
+			toolsets.NewServerTool(AddGPGKey(getClient, t)),
 		)
 	orgs := toolsets.NewToolset(ToolsetMetadataOrgs.ID, ToolsetMetadataOrgs.Description).
 		AddReadTools(
 			toolsets.NewServerTool(SearchOrgs(getClient, t)),
+			toolsets.NewServerTool(GetCopilotMetrics(getClient, t)),
+			toolsets.NewServerTool(GetActionsBillingSummary(getClient, t)),
+			toolsets.NewServerTool(ListOrgInvitations(getClient, t)),
+			toolsets.NewServerTool(ListTeamDiscussions(getClient, t)),
+		).
+		AddWriteTools(
+			toolsets.NewServerTool(CancelOrgInvitation(getClient, t)),
+			toolsets.NewServerTool(ResendOrgInvitation(getClient, t)),
+			toolsets.NewServerTool(CreateTeamDiscussion(getClient, t)),
 		)
 	pullRequests := toolsets.NewToolset(ToolsetMetadataPullRequests.ID, ToolsetMetadataPullRequests.Description).
 		AddReadTools(
 			toolsets.NewServerTool(PullRequestRead(getClient, cache, t, flags)),
 			toolsets.NewServerTool(ListPullRequests(getClient, t)),
 			toolsets.NewServerTool(SearchPullRequests(getClient, t)),
+			toolsets.NewServerTool(SummarizeDependencyPRs(getClient, t)),
+			toolsets.NewServerTool(GetPRContext(getClient, t)),
+			toolsets.NewServerTool(GetPRMergeOrder(getClient, t)),
+			toolsets.NewServerTool(SummarizeReviews(getGQLClient, t)),
 		).
 		AddWriteTools(
 			toolsets.NewServerTool(MergePullRequest(getClient, t)),
 			toolsets.NewServerTool(UpdatePullRequestBranch(getClient, t)),
 			toolsets.NewServerTool(CreatePullRequest(getClient, t)),
+			toolsets.NewServerTool(CreatePRAcrossRepos(getClient, t)),
+			toolsets.NewServerTool(CreateStackedPullRequests(getClient, t)),
+			toolsets.NewServerTool(RestackPullRequests(getClient, t)),
 			toolsets.NewServerTool(UpdatePullRequest(getClient, getGQLClient, t)),
 			toolsets.NewServerTool(RequestCopilotReview(getClient, t)),
 
@@ -244,6 +316,7 @@ func DefaultToolsetGroup(readOnly bool, getClient GetClientFn, getGQLClient GetG
 		AddReadTools(
 			toolsets.NewServerTool(GetCodeScanningAlert(getClient, t)),
 			toolsets.NewServerTool(ListCodeScanningAlerts(getClient, t)),
+			toolsets.NewServerTool(GetSecurityOverview(getClient, t)),
 		)
 	secretProtection := toolsets.NewToolset(ToolsetMetadataSecretProtection.ID, ToolsetMetadataSecretProtection.Description).
 		AddReadTools(
@@ -254,6 +327,11 @@ func DefaultToolsetGroup(readOnly bool, getClient GetClientFn, getGQLClient GetG
 		AddReadTools(
 			toolsets.NewServerTool(GetDependabotAlert(getClient, t)),
 			toolsets.NewServerTool(ListDependabotAlerts(getClient, t)),
+			toolsets.NewServerTool(GetDependabotConfig(getClient, t)),
+			toolsets.NewServerTool(ListReposMissingDependabotConfig(getClient, t)),
+		).
+		AddWriteTools(
+			toolsets.NewServerTool(UpdateDependabotConfig(getClient, t)),
 		)
 
 	notifications := toolsets.NewToolset(ToolsetMetadataNotifications.ID, ToolsetMetadataNotifications.Description).
@@ -287,6 +365,13 @@ func DefaultToolsetGroup(readOnly bool, getClient GetClientFn, getGQLClient GetG
 			toolsets.NewServerTool(ListWorkflowRunArtifacts(getClient, t)),
 			toolsets.NewServerTool(DownloadWorkflowRunArtifact(getClient, t)),
 			toolsets.NewServerTool(GetWorkflowRunUsage(getClient, t)),
+			toolsets.NewServerTool(GetWorkflowUsage(getClient, t)),
+			toolsets.NewServerTool(FindFlakyJobs(getClient, t)),
+			toolsets.NewServerTool(FindWorkflowDependents(getClient, t)),
+			toolsets.NewServerTool(ListRunners(getClient, t)),
+			toolsets.NewServerTool(ListRunnerGroups(getClient, t)),
+			toolsets.NewServerTool(ListRepositorySecrets(getClient, t)),
+			toolsets.NewServerTool(VerifyAttestation(getClient, t)),
 		).
 		AddWriteTools(
 			toolsets.NewServerTool(RunWorkflow(getClient, t)),
@@ -294,6 +379,8 @@ func DefaultToolsetGroup(readOnly bool, getClient GetClientFn, getGQLClient GetG
 			toolsets.NewServerTool(RerunFailedJobs(getClient, t)),
 			toolsets.NewServerTool(CancelWorkflowRun(getClient, t)),
 			toolsets.NewServerTool(DeleteWorkflowRunLogs(getClient, t)),
+			toolsets.NewServerTool(CreateRunnerToken(getClient, t)),
+			toolsets.NewServerTool(UpdateRunnerLabels(getClient, t)),
 		)
 
 	securityAdvisories := toolsets.NewToolset(ToolsetMetadataSecurityAdvisories.ID, ToolsetMetadataSecurityAdvisories.Description).
@@ -312,6 +399,7 @@ func DefaultToolsetGroup(readOnly bool, getClient GetClientFn, getGQLClient GetG
 			toolsets.NewServerTool(GetMe(getClient, t)),
 			toolsets.NewServerTool(GetTeams(getClient, getGQLClient, t)),
 			toolsets.NewServerTool(GetTeamMembers(getGQLClient, t)),
+			toolsets.NewServerTool(RecommendTokenScopes(getClient, tsg, readOnly, t)),
 		)
 
 	gists := toolsets.NewToolset(ToolsetMetadataGists.ID, ToolsetMetadataGists.Description).
@@ -330,13 +418,50 @@ func DefaultToolsetGroup(readOnly bool, getClient GetClientFn, getGQLClient GetG
 			toolsets.NewServerTool(GetProject(getClient, t)),
 			toolsets.NewServerTool(ListProjectFields(getClient, t)),
 			toolsets.NewServerTool(GetProjectField(getClient, t)),
+			toolsets.NewServerTool(DescribeProjectSchema(getClient, t)),
 			toolsets.NewServerTool(ListProjectItems(getClient, t)),
 			toolsets.NewServerTool(GetProjectItem(getClient, t)),
+			toolsets.NewServerTool(SnapshotProject(getClient, t)),
+			toolsets.NewServerTool(ListAllOrgProjects(getGQLClient, t)),
+			toolsets.NewServerTool(GetProjectReadme(getGQLClient, t)),
+			toolsets.NewServerTool(ListProjectViews(getGQLClient, t)),
+			toolsets.NewServerTool(GetProjectFieldHistory(getClient, getGQLClient, t)),
+			toolsets.NewServerTool(ListProjectIterations(getClient, getGQLClient, t)),
+			toolsets.NewServerTool(SummarizeProjectEstimates(getClient, t)),
+			toolsets.NewServerTool(GetProjectAssigneeWorkload(getClient, t)),
+			toolsets.NewServerTool(ListProjectWorkflows(getGQLClient, t)),
+			toolsets.NewServerTool(ListProjectRepositories(getGQLClient, t)),
+			toolsets.NewServerTool(GetProjectHealth(getClient, t)),
+			toolsets.NewServerTool(GetProjectStatistics(getClient, getGQLClient, t)),
+			toolsets.NewServerTool(GetProjectTimeInStatus(getClient, getGQLClient, t)),
+			toolsets.NewServerTool(CheckProjectSLABreaches(getClient, getGQLClient, t, slaPolicies)),
+			toolsets.NewServerTool(PlanRelease(getClient, getGQLClient, t)),
 		).
 		AddWriteTools(
-			toolsets.NewServerTool(AddProjectItem(getClient, t)),
+			toolsets.NewServerTool(AddProjectItem(getClient, t, defaultStatuses)),
 			toolsets.NewServerTool(DeleteProjectItem(getClient, t)),
-			toolsets.NewServerTool(UpdateProjectItem(getClient, t)),
+			toolsets.NewServerTool(UpdateProjectItem(getClient, getGQLClient, t)),
+			toolsets.NewServerTool(BulkUpdateProjectItems(getClient, getGQLClient, t)),
+			toolsets.NewServerTool(BulkArchiveProjectItems(getClient, t)),
+			toolsets.NewServerTool(RestoreProject(getClient, getGQLClient, t)),
+			toolsets.NewServerTool(SyncAlertsToProject(getClient, getGQLClient, t)),
+			toolsets.NewServerTool(CreateDraftIssue(getClient, getGQLClient, t)),
+			toolsets.NewServerTool(ConvertDraftIssueToIssue(getClient, getGQLClient, t)),
+			toolsets.NewServerTool(UpdateProjectReadme(getGQLClient, t)),
+			toolsets.NewServerTool(CreateProjectField(getClient, getGQLClient, t)),
+			toolsets.NewServerTool(UpdateProjectField(getClient, getGQLClient, t)),
+			toolsets.NewServerTool(DeleteProjectField(getClient, getGQLClient, t)),
+			toolsets.NewServerTool(CreateProjectView(getClient, getGQLClient, t)),
+			toolsets.NewServerTool(MoveProjectItem(getClient, t)),
+			toolsets.NewServerTool(SetProjectItemPosition(getGQLClient, t)),
+			toolsets.NewServerTool(AddProjectCollaborator(getClient, getGQLClient, t)),
+			toolsets.NewServerTool(RemoveProjectCollaborator(getClient, getGQLClient, t)),
+			toolsets.NewServerTool(LinkProjectToRepository(getClient, getGQLClient, t)),
+			toolsets.NewServerTool(UnlinkProjectFromRepository(getClient, getGQLClient, t)),
+			toolsets.NewServerTool(ManageProjectIterations(getClient, getGQLClient, t)),
+		).
+		AddResourceTemplates(
+			toolsets.NewServerResourceTemplate(GetProjectReadmeResource(getGQLClient, t)),
 		)
 	stargazers := toolsets.NewToolset(ToolsetMetadataStargazers.ID, ToolsetMetadataStargazers.Description).
 		AddReadTools(
@@ -352,11 +477,25 @@ func DefaultToolsetGroup(readOnly bool, getClient GetClientFn, getGQLClient GetG
 			toolsets.NewServerTool(GetLabel(getGQLClient, t)),
 			// list labels on repo or issue
 			toolsets.NewServerTool(ListLabels(getGQLClient, t)),
+			toolsets.NewServerTool(SuggestLabels(getClient, t)),
 		).
 		AddWriteTools(
 			// create or update
 			toolsets.NewServerTool(LabelWrite(getGQLClient, t)),
 		)
+	packages := toolsets.NewToolset(ToolsetMetadataPackages.ID, ToolsetMetadataPackages.Description).
+		AddReadTools(
+			toolsets.NewServerTool(ListContainerTags(getClient, t)),
+		)
+	codespaces := toolsets.NewToolset(ToolsetMetadataCodespaces.ID, ToolsetMetadataCodespaces.Description).
+		AddReadTools(
+			toolsets.NewServerTool(ListCodespaces(getClient, t)),
+		).
+		AddWriteTools(
+			toolsets.NewServerTool(CreateCodespace(getClient, t)),
+			toolsets.NewServerTool(StopCodespace(getClient, t)),
+			toolsets.NewServerTool(DeleteCodespace(getClient, t)),
+		)
 	// Add toolsets to the group
 	tsg.AddToolset(contextTools)
 	tsg.AddToolset(repos)
@@ -377,6 +516,8 @@ func DefaultToolsetGroup(readOnly bool, getClient GetClientFn, getGQLClient GetG
 	tsg.AddToolset(projects)
 	tsg.AddToolset(stargazers)
 	tsg.AddToolset(labels)
+	tsg.AddToolset(packages)
+	tsg.AddToolset(codespaces)
 
 	return tsg
 }
@@ -3,6 +3,7 @@ package github
 import (
 	"testing"
 
+	"github.com/github/github-mcp-server/pkg/translations"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -280,3 +281,17 @@ func TestContainsToolset(t *testing.T) {
 		})
 	}
 }
+
+func TestWriteToolsDeclareHints(t *testing.T) {
+	tsg := DefaultToolsetGroup(false, nil, nil, nil, translations.NullTranslationHelper, 5000, FeatureFlags{}, nil, nil, nil)
+	for _, toolset := range tsg.Toolsets {
+		for _, tool := range toolset.GetAvailableTools() {
+			annotations := tool.Tool.Annotations
+			if annotations.ReadOnlyHint != nil && *annotations.ReadOnlyHint {
+				continue
+			}
+			assert.NotNil(t, annotations.DestructiveHint, "%s must declare DestructiveHint", tool.Tool.Name)
+			assert.NotNil(t, annotations.IdempotentHint, "%s must declare IdempotentHint", tool.Tool.Name)
+		}
+	}
+}
@@ -0,0 +1,254 @@
+package github
+
+import (
+	"context"
+	"fmt"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v79/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// What an SSH key of a user is used for. GitHub keeps the two kinds of keys apart; the same key can be added as both.
+const (
+	SSHKeyUsageAuthentication = "authentication"
+	SSHKeyUsageSigning        = "signing"
+)
+
+// MinimalSSHKey is an SSH key of the authenticated user.
+type MinimalSSHKey struct {
+	ID        int64  `json:"id"`
+	Title     string `json:"title"`
+	Key       string `json:"key"`
+	Usage     string `json:"usage"`
+	CreatedAt string `json:"created_at,omitempty"`
+}
+
+// MinimalGPGKey is a GPG key of the authenticated user.
+type MinimalGPGKey struct {
+	ID        int64    `json:"id"`
+	KeyID     string   `json:"key_id"`
+	Emails    []string `json:"emails,omitempty"`
+	CanSign   bool     `json:"can_sign"`
+	CreatedAt string   `json:"created_at,omitempty"`
+	ExpiresAt string   `json:"expires_at,omitempty"`
+}
+
+func newMinimalSSHKey(usage string, id int64, title, key string, createdAt *github.Timestamp) MinimalSSHKey {
+	result := MinimalSSHKey{ID: id, Title: title, Key: key, Usage: usage}
+	if createdAt != nil {
+		result.CreatedAt = FormatTimestamp(createdAt.Time)
+	}
+	return result
+}
+
+func toMinimalGPGKey(key *github.GPGKey) MinimalGPGKey {
+	result := MinimalGPGKey{
+		ID:      key.GetID(),
+		KeyID:   key.GetKeyID(),
+		CanSign: key.GetCanSign(),
+	}
+	for _, email := range key.Emails {
+		result.Emails = append(result.Emails, email.GetEmail())
+	}
+	if key.CreatedAt != nil {
+		result.CreatedAt = FormatTimestamp(key.CreatedAt.Time)
+	}
+	if key.ExpiresAt != nil {
+		result.ExpiresAt = FormatTimestamp(key.ExpiresAt.Time)
+	}
+	return result
+}
+
+// ListSSHKeys creates a tool that lists the SSH keys of the authenticated user.
+func ListSSHKeys(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_ssh_keys",
+			mcp.WithDescription(t("TOOL_LIST_SSH_KEYS_DESCRIPTION", "List the SSH keys of the authenticated user, both those used to authenticate Git operations and those used to sign commits. Use it to check whether a machine's key is already registered.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_LIST_SSH_KEYS_USER_TITLE", "List SSH keys"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+		),
+		func(ctx context.Context, _ mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			keys := []MinimalSSHKey{}
+			opts := &github.ListOptions{PerPage: 100}
+			for {
+				page, resp, err := client.Users.ListKeys(ctx, "", opts)
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to list SSH keys", resp, err), nil
+				}
+				_ = resp.Body.Close()
+				for _, key := range page {
+					keys = append(keys, newMinimalSSHKey(SSHKeyUsageAuthentication, key.GetID(), key.GetTitle(), key.GetKey(), key.CreatedAt))
+				}
+				if resp.NextPage == 0 {
+					break
+				}
+				opts.Page = resp.NextPage
+			}
+
+			opts = &github.ListOptions{PerPage: 100}
+			for {
+				page, resp, err := client.Users.ListSSHSigningKeys(ctx, "", opts)
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to list SSH signing keys", resp, err), nil
+				}
+				_ = resp.Body.Close()
+				for _, key := range page {
+					keys = append(keys, newMinimalSSHKey(SSHKeyUsageSigning, key.GetID(), key.GetTitle(), key.GetKey(), key.CreatedAt))
+				}
+				if resp.NextPage == 0 {
+					break
+				}
+				opts.Page = resp.NextPage
+			}
+
+			// Every page has been fetched, so there is no next page to point to.
+			return MarshalledTextResult(NewListResponse("keys", keys, PageInfo{}, github.Ptr(len(keys)))), nil
+		}
+}
+
+// AddSSHKey creates a tool that adds an SSH key to the authenticated user.
+func AddSSHKey(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("add_ssh_key",
+			mcp.WithDescription(t("TOOL_ADD_SSH_KEY_DESCRIPTION", "Add an SSH public key to the authenticated user, to authenticate Git operations or to sign commits. To use a key for both, add it twice.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:           t("TOOL_ADD_SSH_KEY_USER_TITLE", "Add SSH key"),
+				ReadOnlyHint:    ToBoolPtr(false),
+				DestructiveHint: ToBoolPtr(false),
+				IdempotentHint:  ToBoolPtr(false),
+			}),
+			mcp.WithString("title",
+				mcp.Required(),
+				mcp.Description("Name of the key, e.g. the machine it belongs to"),
+			),
+			mcp.WithString("key",
+				mcp.Required(),
+				mcp.Description("Public SSH key, e.g. 'ssh-ed25519 AAAA... user@host'"),
+			),
+			mcp.WithString("usage",
+				mcp.Description("What the key is used for"),
+				mcp.Enum(SSHKeyUsageAuthentication, SSHKeyUsageSigning),
+				mcp.DefaultString(SSHKeyUsageAuthentication),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			title, err := RequiredParam[string](request, "title")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			key, err := RequiredParam[string](request, "key")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			usage, err := OptionalParam[string](request, "usage")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			input := &github.Key{Title: github.Ptr(title), Key: github.Ptr(key)}
+			var result MinimalSSHKey
+			if usage == SSHKeyUsageSigning {
+				created, resp, err := client.Users.CreateSSHSigningKey(ctx, input)
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to add SSH signing key", resp, err), nil
+				}
+				_ = resp.Body.Close()
+				result = newMinimalSSHKey(SSHKeyUsageSigning, created.GetID(), created.GetTitle(), created.GetKey(), created.CreatedAt)
+			} else {
+				created, resp, err := client.Users.CreateKey(ctx, input)
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to add SSH key", resp, err), nil
+				}
+				_ = resp.Body.Close()
+				result = newMinimalSSHKey(SSHKeyUsageAuthentication, created.GetID(), created.GetTitle(), created.GetKey(), created.CreatedAt)
+			}
+
+			return MarshalledTextResult(result), nil
+		}
+}
+
+// ListGPGKeys creates a tool that lists the GPG keys of the authenticated user.
+func ListGPGKeys(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("list_gpg_keys",
+			mcp.WithDescription(t("TOOL_LIST_GPG_KEYS_DESCRIPTION", "List the GPG keys the authenticated user signs commits with, with their key IDs, email addresses and expiry.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_LIST_GPG_KEYS_USER_TITLE", "List GPG keys"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+		),
+		func(ctx context.Context, _ mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			keys := []MinimalGPGKey{}
+			opts := &github.ListOptions{PerPage: 100}
+			for {
+				page, resp, err := client.Users.ListGPGKeys(ctx, "", opts)
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to list GPG keys", resp, err), nil
+				}
+				_ = resp.Body.Close()
+				for _, key := range page {
+					keys = append(keys, toMinimalGPGKey(key))
+				}
+				if resp.NextPage == 0 {
+					break
+				}
+				opts.Page = resp.NextPage
+			}
+
+			// Every page has been fetched, so there is no next page to point to.
+			return MarshalledTextResult(NewListResponse("keys", keys, PageInfo{}, github.Ptr(len(keys)))), nil
+		}
+}
+
+// AddGPGKey creates a tool that adds a GPG key to the authenticated user.
+func AddGPGKey(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("add_gpg_key",
+			mcp.WithDescription(t("TOOL_ADD_GPG_KEY_DESCRIPTION", "Add a GPG public key to the authenticated user so that commits signed with it show as verified.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:           t("TOOL_ADD_GPG_KEY_USER_TITLE", "Add GPG key"),
+				ReadOnlyHint:    ToBoolPtr(false),
+				DestructiveHint: ToBoolPtr(false),
+				IdempotentHint:  ToBoolPtr(false),
+			}),
+			mcp.WithString("armored_public_key",
+				mcp.Required(),
+				mcp.Description("ASCII-armored public key, as printed by 'gpg --armor --export KEY_ID'"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			armoredPublicKey, err := RequiredParam[string](request, "armored_public_key")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			created, resp, err := client.Users.CreateGPGKey(ctx, armoredPublicKey)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx, "failed to add GPG key", resp, err), nil
+			}
+			_ = resp.Body.Close()
+
+			return MarshalledTextResult(toMinimalGPGKey(created)), nil
+		}
+}
@@ -0,0 +1,129 @@
+package github
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/ghmock"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ListSSHKeys(t *testing.T) {
+	tool, _ := ListSSHKeys(nil, translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+	assert.True(t, *tool.Annotations.ReadOnlyHint)
+
+	server := ghmock.New(t)
+	server.Respond("GET /user/keys", http.StatusOK, []map[string]any{
+		{"id": 1, "title": "laptop", "key": "ssh-ed25519 AAAA1", "created_at": "2024-01-01T00:00:00Z"},
+	})
+	server.Respond("GET /user/ssh_signing_keys", http.StatusOK, []map[string]any{
+		{"id": 2, "title": "laptop", "key": "ssh-ed25519 AAAA1"},
+	})
+	_, handler := ListSSHKeys(server.GetClient(), translations.NullTranslationHelper)
+
+	result := ghmock.CallTool(t, handler, map[string]any{})
+	require.False(t, result.IsError, ghmock.ResultText(t, result))
+	var response struct {
+		Keys       []MinimalSSHKey `json:"keys"`
+		PageInfo   PageInfo        `json:"page_info"`
+		TotalCount int             `json:"total_count"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(ghmock.ResultText(t, result)), &response))
+	assert.False(t, response.PageInfo.HasNextPage)
+	assert.Equal(t, 2, response.TotalCount)
+	assert.Equal(t, []MinimalSSHKey{
+		{ID: 1, Title: "laptop", Key: "ssh-ed25519 AAAA1", Usage: SSHKeyUsageAuthentication, CreatedAt: "2024-01-01T00:00:00Z"},
+		{ID: 2, Title: "laptop", Key: "ssh-ed25519 AAAA1", Usage: SSHKeyUsageSigning},
+	}, response.Keys)
+}
+
+func Test_AddSSHKey(t *testing.T) {
+	tool, _ := AddSSHKey(nil, translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+	assert.ElementsMatch(t, []string{"title", "key"}, tool.InputSchema.Required)
+
+	tests := []struct {
+		name  string
+		usage string
+		path  string
+	}{
+		{"authentication key by default", "", "POST /user/keys"},
+		{"signing key", SSHKeyUsageSigning, "POST /user/ssh_signing_keys"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			server := ghmock.New(t)
+			server.Respond(tc.path, http.StatusCreated, map[string]any{"id": 3, "title": "ci", "key": "ssh-ed25519 AAAA"})
+			_, handler := AddSSHKey(server.GetClient(), translations.NullTranslationHelper)
+
+			args := map[string]any{"title": "ci", "key": "ssh-ed25519 AAAA"}
+			if tc.usage != "" {
+				args["usage"] = tc.usage
+			}
+			result := ghmock.CallTool(t, handler, args)
+			require.False(t, result.IsError, ghmock.ResultText(t, result))
+			var body map[string]any
+			require.NoError(t, server.AssertRequested(tc.path).DecodeBody(&body))
+			assert.Equal(t, map[string]any{"title": "ci", "key": "ssh-ed25519 AAAA"}, body)
+		})
+	}
+
+	t.Run("key already in use", func(t *testing.T) {
+		server := ghmock.New(t)
+		server.Respond("POST /user/keys", http.StatusUnprocessableEntity, map[string]any{"message": "Validation Failed", "errors": []map[string]any{{"message": "key is already in use"}}})
+		_, handler := AddSSHKey(server.GetClient(), translations.NullTranslationHelper)
+
+		result := ghmock.CallTool(t, handler, map[string]any{"title": "ci", "key": "ssh-ed25519 AAAA"})
+		require.True(t, result.IsError)
+		assert.Contains(t, ghmock.ResultText(t, result), "failed to add SSH key")
+	})
+}
+
+func Test_ListGPGKeys(t *testing.T) {
+	tool, _ := ListGPGKeys(nil, translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	server := ghmock.New(t)
+	server.Respond("GET /user/gpg_keys", http.StatusOK, []map[string]any{{
+		"id": 4, "key_id": "3262EFF25BA0D270", "can_sign": true,
+		"emails":     []map[string]any{{"email": "mona@example.com", "verified": true}},
+		"expires_at": "2030-01-01T00:00:00Z",
+	}})
+	_, handler := ListGPGKeys(server.GetClient(), translations.NullTranslationHelper)
+
+	result := ghmock.CallTool(t, handler, map[string]any{})
+	require.False(t, result.IsError, ghmock.ResultText(t, result))
+	var response struct {
+		Keys       []MinimalGPGKey `json:"keys"`
+		PageInfo   PageInfo        `json:"page_info"`
+		TotalCount int             `json:"total_count"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(ghmock.ResultText(t, result)), &response))
+	assert.False(t, response.PageInfo.HasNextPage)
+	assert.Equal(t, 1, response.TotalCount)
+	assert.Equal(t, []MinimalGPGKey{
+		{ID: 4, KeyID: "3262EFF25BA0D270", Emails: []string{"mona@example.com"}, CanSign: true, ExpiresAt: "2030-01-01T00:00:00Z"},
+	}, response.Keys)
+}
+
+func Test_AddGPGKey(t *testing.T) {
+	tool, _ := AddGPGKey(nil, translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	server := ghmock.New(t)
+	server.Respond("POST /user/gpg_keys", http.StatusCreated, map[string]any{"id": 5, "key_id": "ABCD", "can_sign": true})
+	_, handler := AddGPGKey(server.GetClient(), translations.NullTranslationHelper)
+
+	armored := "-----BEGIN PGP PUBLIC KEY BLOCK-----\n...\n-----END PGP PUBLIC KEY BLOCK-----"
+	result := ghmock.CallTool(t, handler, map[string]any{"armored_public_key": armored})
+	require.False(t, result.IsError, ghmock.ResultText(t, result))
+	assert.JSONEq(t, `{"id": 5, "key_id": "ABCD", "can_sign": true}`, ghmock.ResultText(t, result))
+	var body map[string]any
+	require.NoError(t, server.AssertRequested("POST /user/gpg_keys").DecodeBody(&body))
+	assert.Equal(t, map[string]any{"armored_public_key": armored}, body)
+}
@@ -0,0 +1,125 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// exclusiveParams lists, by tool, groups of parameters of which a call may give at most one. JSON Schema cannot
+// express this in the flat input schemas tools declare, so they are listed here.
+var exclusiveParams = map[string][][]string{
+	"sub_issue_write": {{"after_id", "before_id"}},
+}
+
+// ValidateToolArguments checks the arguments of a call against the enums, minimums and maximums declared in the
+// input schema of the tool, and against its mutually exclusive parameters. It returns an INVALID_INPUT ToolError
+// naming the first offending parameter, or nil if the arguments are valid.
+func ValidateToolArguments(tool mcp.Tool, args map[string]any) *ghErrors.ToolError {
+	names := make([]string, 0, len(args))
+	for name := range args {
+		names = append(names, name)
+	}
+	// Report the same parameter on every call with the same arguments.
+	slices.Sort(names)
+
+	for _, name := range names {
+		value := args[name]
+		schema, ok := tool.InputSchema.Properties[name].(map[string]any)
+		if !ok || value == nil {
+			continue
+		}
+		if err := validateValue(name, schema, value); err != nil {
+			return err
+		}
+	}
+
+	for _, group := range exclusiveParams[tool.Name] {
+		var given []string
+		for _, name := range group {
+			if value, ok := args[name]; ok && value != nil && value != "" && value != float64(0) {
+				given = append(given, name)
+			}
+		}
+		if len(given) > 1 {
+			return ghErrors.NewToolError(ghErrors.CodeInvalidInput,
+				fmt.Sprintf("parameters %s are mutually exclusive; provide only one of them", strings.Join(given, " and ")), given[1])
+		}
+	}
+	return nil
+}
+
+func validateValue(name string, schema map[string]any, value any) *ghErrors.ToolError {
+	if enum := enumValues(schema["enum"]); len(enum) > 0 {
+		if s, ok := value.(string); ok && !slices.Contains(enum, s) {
+			return ghErrors.NewToolError(ghErrors.CodeInvalidInput,
+				fmt.Sprintf("invalid value %q for parameter %s: must be one of %s", s, name, strings.Join(enum, ", ")), name)
+		}
+	}
+
+	if number, ok := value.(float64); ok {
+		if minimum, ok := schema["minimum"].(float64); ok && number < minimum {
+			return ghErrors.NewToolError(ghErrors.CodeInvalidInput,
+				fmt.Sprintf("parameter %s must be at least %v, got %v", name, minimum, number), name)
+		}
+		if maximum, ok := schema["maximum"].(float64); ok && number > maximum {
+			return ghErrors.NewToolError(ghErrors.CodeInvalidInput,
+				fmt.Sprintf("parameter %s must be at most %v, got %v", name, maximum, number), name)
+		}
+	}
+
+	if items, ok := value.([]any); ok {
+		itemSchema, ok := schema["items"].(map[string]any)
+		if !ok {
+			return nil
+		}
+		for _, item := range items {
+			if err := validateValue(name, itemSchema, item); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// enumValues returns the allowed values of a string enum, which tools declare as a []string with mcp.Enum, or as a
+// []any when the schema was decoded from JSON.
+func enumValues(enum any) []string {
+	switch values := enum.(type) {
+	case []string:
+		return values
+	case []any:
+		strs := make([]string, 0, len(values))
+		for _, value := range values {
+			s, ok := value.(string)
+			if !ok {
+				return nil
+			}
+			strs = append(strs, s)
+		}
+		return strs
+	}
+	return nil
+}
+
+// ArgumentValidation is a tool handler middleware that rejects calls with invalid arguments, as reported by
+// ValidateToolArguments, before the tool runs, so that no GitHub API call is made with values the tool would
+// otherwise silently ignore or pass on. lookup returns the definition of a tool by name; calls to unknown tools are
+// passed through.
+func ArgumentValidation(lookup func(name string) (mcp.Tool, bool)) server.ToolHandlerMiddleware {
+	return func(next server.ToolHandlerFunc) server.ToolHandlerFunc {
+		return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			if tool, ok := lookup(request.Params.Name); ok {
+				if err := ValidateToolArguments(tool, request.GetArguments()); err != nil {
+					return err.Result(), nil
+				}
+			}
+			return next(ctx, request)
+		}
+	}
+}
@@ -0,0 +1,135 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ValidateToolArguments(t *testing.T) {
+	tool := mcp.NewTool("list_things",
+		mcp.WithString("state", mcp.Enum("open", "closed")),
+		mcp.WithNumber("perPage", mcp.Min(1), mcp.Max(100)),
+		mcp.WithArray("kinds", mcp.Items(map[string]any{"type": "string", "enum": []string{"issue", "pull_request"}})),
+		mcp.WithString("query"),
+	)
+
+	tests := []struct {
+		name          string
+		args          map[string]any
+		expectedParam string
+		expectedMsg   string
+	}{
+		{
+			name: "valid arguments",
+			args: map[string]any{"state": "open", "perPage": float64(100), "kinds": []any{"issue"}, "query": "anything"},
+		},
+		{
+			name: "null values are not validated",
+			args: map[string]any{"state": nil, "perPage": nil},
+		},
+		{
+			name:          "unknown enum value",
+			args:          map[string]any{"state": "draft"},
+			expectedParam: "state",
+			expectedMsg:   `invalid value "draft" for parameter state: must be one of open, closed`,
+		},
+		{
+			name:          "enum is case-sensitive",
+			args:          map[string]any{"state": "OPEN"},
+			expectedParam: "state",
+		},
+		{
+			name:          "below minimum",
+			args:          map[string]any{"perPage": float64(0)},
+			expectedParam: "perPage",
+			expectedMsg:   "parameter perPage must be at least 1, got 0",
+		},
+		{
+			name:          "above maximum",
+			args:          map[string]any{"perPage": float64(500)},
+			expectedParam: "perPage",
+			expectedMsg:   "parameter perPage must be at most 100, got 500",
+		},
+		{
+			name:          "unknown enum value in array",
+			args:          map[string]any{"kinds": []any{"issue", "draft"}},
+			expectedParam: "kinds",
+		},
+		{
+			name:          "first offending parameter in name order",
+			args:          map[string]any{"state": "draft", "perPage": float64(500)},
+			expectedParam: "perPage",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateToolArguments(tool, tc.args)
+			if tc.expectedParam == "" {
+				assert.Nil(t, err)
+				return
+			}
+			require.NotNil(t, err)
+			assert.Equal(t, ghErrors.CodeInvalidInput, err.Code)
+			assert.Equal(t, tc.expectedParam, err.Param)
+			if tc.expectedMsg != "" {
+				assert.Equal(t, tc.expectedMsg, err.Message)
+			}
+		})
+	}
+
+	t.Run("mutually exclusive parameters", func(t *testing.T) {
+		subIssueWrite, _ := SubIssueWrite(nil, translations.NullTranslationHelper)
+
+		err := ValidateToolArguments(subIssueWrite, map[string]any{"method": "reprioritize", "after_id": float64(1), "before_id": float64(2)})
+		require.NotNil(t, err)
+		assert.Equal(t, "parameters after_id and before_id are mutually exclusive; provide only one of them", err.Message)
+		assert.Equal(t, "before_id", err.Param)
+
+		assert.Nil(t, ValidateToolArguments(subIssueWrite, map[string]any{"method": "reprioritize", "after_id": float64(1), "before_id": float64(0)}))
+	})
+}
+
+func Test_ArgumentValidation(t *testing.T) {
+	tool := mcp.NewTool("list_things", mcp.WithString("state", mcp.Enum("open", "closed")))
+	called := false
+	handler := ArgumentValidation(func(name string) (mcp.Tool, bool) {
+		return tool, name == tool.Name
+	})(func(_ context.Context, _ mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		called = true
+		return mcp.NewToolResultText("ok"), nil
+	})
+
+	call := func(name string, args map[string]any) *mcp.CallToolResult {
+		request := mcp.CallToolRequest{}
+		request.Params.Name = name
+		request.Params.Arguments = args
+		result, err := handler(context.Background(), request)
+		require.NoError(t, err)
+		return result
+	}
+
+	result := call("list_things", map[string]any{"state": "merged"})
+	assert.True(t, result.IsError)
+	assert.False(t, called, "the tool must not run with invalid arguments")
+	var toolErr ghErrors.ToolError
+	require.NoError(t, json.Unmarshal([]byte(getTextResult(t, result).Text), &toolErr))
+	assert.Equal(t, ghErrors.CodeInvalidInput, toolErr.Code)
+	assert.Equal(t, "state", toolErr.Param)
+
+	result = call("list_things", map[string]any{"state": "open"})
+	assert.False(t, result.IsError)
+	assert.True(t, called)
+
+	called = false
+	result = call("other_tool", map[string]any{"state": "merged"})
+	assert.False(t, result.IsError)
+	assert.True(t, called, "calls to unknown tools are passed through")
+}
@@ -0,0 +1,478 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v79/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+const (
+	// DefaultWorkflowAnalyticsWindowDays is the look-back window used when no since date is given.
+	DefaultWorkflowAnalyticsWindowDays = 30
+	// DefaultWorkflowAnalyticsMaxRuns caps how many runs are fetched per analytics call by default.
+	DefaultWorkflowAnalyticsMaxRuns = 500
+	// MaxWorkflowAnalyticsRuns is the hard cap on runs fetched per analytics call.
+	MaxWorkflowAnalyticsRuns = 2000
+)
+
+// WorkflowUsageStats summarizes the runs of a single workflow over a time window.
+type WorkflowUsageStats struct {
+	WorkflowID             int64   `json:"workflow_id"`
+	Name                   string  `json:"name"`
+	TotalRuns              int     `json:"total_runs"`
+	CompletedRuns          int     `json:"completed_runs"`
+	SuccessfulRuns         int     `json:"successful_runs"`
+	FailedRuns             int     `json:"failed_runs"`
+	CancelledRuns          int     `json:"cancelled_runs"`
+	SuccessRate            float64 `json:"success_rate"`
+	AverageDurationSeconds float64 `json:"average_duration_seconds"`
+	P50DurationSeconds     float64 `json:"p50_duration_seconds"`
+	P90DurationSeconds     float64 `json:"p90_duration_seconds"`
+	P95DurationSeconds     float64 `json:"p95_duration_seconds"`
+	MaxDurationSeconds     float64 `json:"max_duration_seconds"`
+
+	durations []float64
+}
+
+// GetWorkflowUsage creates a tool to compute run counts, success rates and duration percentiles per workflow.
+func GetWorkflowUsage(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("get_workflow_usage",
+			mcp.WithDescription(t("TOOL_GET_WORKFLOW_USAGE_DESCRIPTION", "Analyze workflow runs in a repository over a time window. Returns per-workflow run counts, success rate, and average, p50, p90, p95 and max durations of completed runs, sorted by number of runs. Use this to spot slow or unreliable pipelines.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_GET_WORKFLOW_USAGE_USER_TITLE", "Get workflow usage analytics"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryOwner),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryName),
+			),
+			mcp.WithString("workflow_id",
+				mcp.Description("Only analyze this workflow (ID or file name). Omit to analyze all workflows."),
+			),
+			mcp.WithString("branch",
+				mcp.Description("Only analyze runs on this branch"),
+			),
+			mcp.WithString("since",
+				mcp.Description(fmt.Sprintf("Only analyze runs created on or after this date (ISO 8601). Defaults to %d days ago.", DefaultWorkflowAnalyticsWindowDays)),
+			),
+			mcp.WithNumber("max_runs",
+				mcp.Description(fmt.Sprintf("Maximum number of runs to analyze, newest first. Defaults to %d.", DefaultWorkflowAnalyticsMaxRuns)),
+				mcp.Min(1),
+				mcp.Max(MaxWorkflowAnalyticsRuns),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			workflowID, err := OptionalParam[string](request, "workflow_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			branch, err := OptionalParam[string](request, "branch")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			since, err := OptionalParam[string](request, "since")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			maxRuns, err := OptionalIntParamWithDefault(request, "max_runs", DefaultWorkflowAnalyticsMaxRuns)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			sinceTime, err := workflowAnalyticsSince(since)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			runs, resp, err := listWorkflowRunsSince(ctx, client, owner, repo, workflowID, &github.ListWorkflowRunsOptions{
+				Branch: branch,
+			}, sinceTime, maxRuns)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to list workflow runs for %s/%s", owner, repo),
+					resp,
+					err,
+				), nil
+			}
+
+			stats := summarizeWorkflowRuns(runs)
+
+			r, err := json.Marshal(map[string]any{
+				"since":         sinceTime.Format("2006-01-02"),
+				"runs_analyzed": len(runs),
+				"truncated":     len(runs) >= maxRuns,
+				"workflows":     stats,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+// workflowAnalyticsSince parses the since parameter, defaulting to the standard look-back window.
+func workflowAnalyticsSince(since string) (time.Time, error) {
+	if since == "" {
+		return time.Now().UTC().AddDate(0, 0, -DefaultWorkflowAnalyticsWindowDays), nil
+	}
+	sinceTime, err := parseISOTimestamp(since)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid since timestamp: %w", err)
+	}
+	return sinceTime, nil
+}
+
+// listWorkflowRunsSince pages through the workflow runs created since the given time, newest first,
+// stopping once maxRuns runs have been collected.
+func listWorkflowRunsSince(ctx context.Context, client *github.Client, owner, repo, workflowID string, opts *github.ListWorkflowRunsOptions, since time.Time, maxRuns int) ([]*github.WorkflowRun, *github.Response, error) {
+	opts.Created = ">=" + since.Format("2006-01-02")
+	opts.PerPage = min(maxRuns, 100)
+	opts.Page = 1
+
+	var runs []*github.WorkflowRun
+	for {
+		var page *github.WorkflowRuns
+		var resp *github.Response
+		var err error
+		if workflowID != "" {
+			page, resp, err = client.Actions.ListWorkflowRunsByFileName(ctx, owner, repo, workflowID, opts)
+		} else {
+			page, resp, err = client.Actions.ListRepositoryWorkflowRuns(ctx, owner, repo, opts)
+		}
+		if err != nil {
+			return nil, resp, err
+		}
+		_ = resp.Body.Close()
+
+		runs = append(runs, page.WorkflowRuns...)
+		if len(runs) >= maxRuns {
+			return runs[:maxRuns], resp, nil
+		}
+		if resp.NextPage == 0 {
+			return runs, resp, nil
+		}
+		opts.Page = resp.NextPage
+	}
+}
+
+// summarizeWorkflowRuns groups runs by workflow and computes their counts and duration statistics.
+func summarizeWorkflowRuns(runs []*github.WorkflowRun) []*WorkflowUsageStats {
+	byWorkflow := make(map[int64]*WorkflowUsageStats)
+	for _, run := range runs {
+		stats, ok := byWorkflow[run.GetWorkflowID()]
+		if !ok {
+			stats = &WorkflowUsageStats{WorkflowID: run.GetWorkflowID(), Name: run.GetName()}
+			byWorkflow[run.GetWorkflowID()] = stats
+		}
+
+		stats.TotalRuns++
+		if run.GetStatus() != "completed" {
+			continue
+		}
+		stats.CompletedRuns++
+		switch run.GetConclusion() {
+		case "success":
+			stats.SuccessfulRuns++
+		case "failure", "timed_out", "startup_failure":
+			stats.FailedRuns++
+		case "cancelled":
+			stats.CancelledRuns++
+		}
+		if duration, ok := workflowRunDuration(run); ok {
+			stats.durations = append(stats.durations, duration.Seconds())
+		}
+	}
+
+	result := make([]*WorkflowUsageStats, 0, len(byWorkflow))
+	for _, stats := range byWorkflow {
+		if decided := stats.SuccessfulRuns + stats.FailedRuns; decided > 0 {
+			stats.SuccessRate = float64(stats.SuccessfulRuns) / float64(decided)
+		}
+		if len(stats.durations) > 0 {
+			sort.Float64s(stats.durations)
+			var total float64
+			for _, d := range stats.durations {
+				total += d
+			}
+			stats.AverageDurationSeconds = math.Round(total / float64(len(stats.durations)))
+			stats.P50DurationSeconds = percentile(stats.durations, 50)
+			stats.P90DurationSeconds = percentile(stats.durations, 90)
+			stats.P95DurationSeconds = percentile(stats.durations, 95)
+			stats.MaxDurationSeconds = stats.durations[len(stats.durations)-1]
+		}
+		result = append(result, stats)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].TotalRuns != result[j].TotalRuns {
+			return result[i].TotalRuns > result[j].TotalRuns
+		}
+		return result[i].Name < result[j].Name
+	})
+	return result
+}
+
+// workflowRunDuration returns the wall-clock time of the latest attempt of a completed run.
+func workflowRunDuration(run *github.WorkflowRun) (time.Duration, bool) {
+	if run.RunStartedAt == nil || run.UpdatedAt == nil {
+		return 0, false
+	}
+	duration := run.UpdatedAt.Sub(run.RunStartedAt.Time)
+	if duration < 0 {
+		return 0, false
+	}
+	return duration, true
+}
+
+// percentile returns the nearest-rank percentile p of an ascending slice.
+func percentile(sorted []float64, p float64) float64 {
+	if len(sorted) == 0 {
+		return 0
+	}
+	rank := int(math.Ceil(p / 100 * float64(len(sorted))))
+	if rank < 1 {
+		rank = 1
+	}
+	return sorted[rank-1]
+}
+
+// MaxFlakyJobRunInspections caps how many runs have their jobs fetched by find_flaky_jobs.
+const MaxFlakyJobRunInspections = 100
+
+// FlakyJob describes a job that both failed and succeeded on the same commit.
+type FlakyJob struct {
+	Workflow        string            `json:"workflow"`
+	Job             string            `json:"job"`
+	FlakyCommits    int               `json:"flaky_commits"`
+	CommitsAnalyzed int               `json:"commits_analyzed"`
+	FlakeRate       float64           `json:"flake_rate"`
+	Examples        []FlakyJobExample `json:"examples"`
+	commits         map[string]bool
+}
+
+// FlakyJobExample points at a run in which a job flaked.
+type FlakyJobExample struct {
+	HeadSHA string `json:"head_sha"`
+	RunID   int64  `json:"run_id"`
+	HTMLURL string `json:"html_url,omitempty"`
+}
+
+// FindFlakyJobs creates a tool to find jobs that both failed and succeeded on the same commit.
+func FindFlakyJobs(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("find_flaky_jobs",
+			mcp.WithDescription(t("TOOL_FIND_FLAKY_JOBS_DESCRIPTION", "Find flaky Actions jobs in a repository: jobs that both failed and succeeded on the same commit, typically after a rerun. Results are ranked by flake rate, the share of analyzed commits of the workflow on which the job flaked, and include example runs to triage.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_FIND_FLAKY_JOBS_USER_TITLE", "Find flaky jobs"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryOwner),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description(DescriptionRepositoryName),
+			),
+			mcp.WithString("workflow_id",
+				mcp.Description("Only analyze this workflow (ID or file name). Omit to analyze all workflows."),
+			),
+			mcp.WithString("branch",
+				mcp.Description("Only analyze runs on this branch"),
+			),
+			mcp.WithString("since",
+				mcp.Description(fmt.Sprintf("Only analyze runs created on or after this date (ISO 8601). Defaults to %d days ago.", DefaultWorkflowAnalyticsWindowDays)),
+			),
+			mcp.WithNumber("max_runs",
+				mcp.Description(fmt.Sprintf("Maximum number of runs to analyze, newest first. Defaults to %d.", DefaultWorkflowAnalyticsMaxRuns)),
+				mcp.Min(1),
+				mcp.Max(MaxWorkflowAnalyticsRuns),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			owner, err := RequiredParam[string](request, "owner")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			repo, err := RequiredParam[string](request, "repo")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			workflowID, err := OptionalParam[string](request, "workflow_id")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			branch, err := OptionalParam[string](request, "branch")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			since, err := OptionalParam[string](request, "since")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			maxRuns, err := OptionalIntParamWithDefault(request, "max_runs", DefaultWorkflowAnalyticsMaxRuns)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			sinceTime, err := workflowAnalyticsSince(since)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			runs, resp, err := listWorkflowRunsSince(ctx, client, owner, repo, workflowID, &github.ListWorkflowRunsOptions{
+				Branch: branch,
+				Status: "completed",
+			}, sinceTime, maxRuns)
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to list workflow runs for %s/%s", owner, repo),
+					resp,
+					err,
+				), nil
+			}
+
+			// Count the commits each workflow ran on, and pick the runs that may hide a
+			// flake: reruns, and commits on which the same workflow ran more than once.
+			commitsPerWorkflow := make(map[string]map[string]bool)
+			runsPerCommit := make(map[string][]*github.WorkflowRun)
+			for _, run := range runs {
+				if commitsPerWorkflow[run.GetName()] == nil {
+					commitsPerWorkflow[run.GetName()] = make(map[string]bool)
+				}
+				commitsPerWorkflow[run.GetName()][run.GetHeadSHA()] = true
+				key := run.GetName() + "@" + run.GetHeadSHA()
+				runsPerCommit[key] = append(runsPerCommit[key], run)
+			}
+			var candidates []*github.WorkflowRun
+			for _, run := range runs {
+				key := run.GetName() + "@" + run.GetHeadSHA()
+				if run.GetRunAttempt() > 1 || len(runsPerCommit[key]) > 1 {
+					candidates = append(candidates, run)
+				}
+			}
+			truncated := len(candidates) > MaxFlakyJobRunInspections
+			if truncated {
+				candidates = candidates[:MaxFlakyJobRunInspections]
+			}
+
+			// conclusions tracks, per workflow, job and commit, which outcomes were seen.
+			type jobCommit struct{ workflow, job, sha string }
+			conclusions := make(map[jobCommit]map[string]bool)
+			exampleRuns := make(map[jobCommit]*github.WorkflowRun)
+			for _, run := range candidates {
+				jobs, resp, err := client.Actions.ListWorkflowJobs(ctx, owner, repo, run.GetID(), &github.ListWorkflowJobsOptions{
+					Filter:      "all",
+					ListOptions: github.ListOptions{PerPage: 100},
+				})
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx,
+						fmt.Sprintf("failed to list jobs for workflow run %d", run.GetID()),
+						resp,
+						err,
+					), nil
+				}
+				_ = resp.Body.Close()
+
+				for _, job := range jobs.Jobs {
+					key := jobCommit{workflow: run.GetName(), job: job.GetName(), sha: run.GetHeadSHA()}
+					if conclusions[key] == nil {
+						conclusions[key] = make(map[string]bool)
+					}
+					conclusions[key][job.GetConclusion()] = true
+					if job.GetConclusion() != "success" {
+						exampleRuns[key] = run
+					}
+				}
+			}
+
+			byJob := make(map[string]*FlakyJob)
+			for key, seen := range conclusions {
+				if !seen["success"] || !(seen["failure"] || seen["timed_out"]) {
+					continue
+				}
+				jobKey := key.workflow + "/" + key.job
+				flaky, ok := byJob[jobKey]
+				if !ok {
+					flaky = &FlakyJob{
+						Workflow:        key.workflow,
+						Job:             key.job,
+						CommitsAnalyzed: len(commitsPerWorkflow[key.workflow]),
+						Examples:        []FlakyJobExample{},
+						commits:         make(map[string]bool),
+					}
+					byJob[jobKey] = flaky
+				}
+				flaky.commits[key.sha] = true
+				if run := exampleRuns[key]; run != nil && len(flaky.Examples) < 5 {
+					flaky.Examples = append(flaky.Examples, FlakyJobExample{
+						HeadSHA: key.sha,
+						RunID:   run.GetID(),
+						HTMLURL: run.GetHTMLURL(),
+					})
+				}
+			}
+
+			flakyJobs := make([]*FlakyJob, 0, len(byJob))
+			for _, flaky := range byJob {
+				flaky.FlakyCommits = len(flaky.commits)
+				if flaky.CommitsAnalyzed > 0 {
+					flaky.FlakeRate = float64(flaky.FlakyCommits) / float64(flaky.CommitsAnalyzed)
+				}
+				sort.Slice(flaky.Examples, func(i, j int) bool {
+					return flaky.Examples[i].RunID > flaky.Examples[j].RunID
+				})
+				flakyJobs = append(flakyJobs, flaky)
+			}
+			sort.Slice(flakyJobs, func(i, j int) bool {
+				if flakyJobs[i].FlakeRate != flakyJobs[j].FlakeRate {
+					return flakyJobs[i].FlakeRate > flakyJobs[j].FlakeRate
+				}
+				if flakyJobs[i].FlakyCommits != flakyJobs[j].FlakyCommits {
+					return flakyJobs[i].FlakyCommits > flakyJobs[j].FlakyCommits
+				}
+				return flakyJobs[i].Workflow+"/"+flakyJobs[i].Job < flakyJobs[j].Workflow+"/"+flakyJobs[j].Job
+			})
+
+			r, err := json.Marshal(map[string]any{
+				"since":          sinceTime.Format("2006-01-02"),
+				"runs_analyzed":  len(runs),
+				"runs_inspected": len(candidates),
+				"truncated":      truncated || len(runs) >= maxRuns,
+				"flaky_jobs":     flakyJobs,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
@@ -0,0 +1,319 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v79/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func mockWorkflowRun(id, workflowID int64, name, status, conclusion string, duration time.Duration) *github.WorkflowRun {
+	started := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	return &github.WorkflowRun{
+		ID:           github.Ptr(id),
+		WorkflowID:   github.Ptr(workflowID),
+		Name:         github.Ptr(name),
+		Status:       github.Ptr(status),
+		Conclusion:   github.Ptr(conclusion),
+		RunStartedAt: &github.Timestamp{Time: started},
+		UpdatedAt:    &github.Timestamp{Time: started.Add(duration)},
+	}
+}
+
+func Test_GetWorkflowUsage(t *testing.T) {
+	mockClient := github.NewClient(nil)
+	tool, _ := GetWorkflowUsage(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "get_workflow_usage", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "workflow_id")
+	assert.Contains(t, tool.InputSchema.Properties, "since")
+	assert.Contains(t, tool.InputSchema.Properties, "max_runs")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+
+	firstPage := &github.WorkflowRuns{
+		TotalCount: github.Ptr(5),
+		WorkflowRuns: []*github.WorkflowRun{
+			mockWorkflowRun(1, 10, "CI", "completed", "success", 2*time.Minute),
+			mockWorkflowRun(2, 10, "CI", "completed", "failure", 4*time.Minute),
+			mockWorkflowRun(3, 10, "CI", "completed", "success", 6*time.Minute),
+		},
+	}
+	secondPage := &github.WorkflowRuns{
+		TotalCount: github.Ptr(5),
+		WorkflowRuns: []*github.WorkflowRun{
+			mockWorkflowRun(4, 10, "CI", "in_progress", "", time.Minute),
+			mockWorkflowRun(5, 20, "Release", "completed", "success", 10*time.Minute),
+		},
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedRuns   int
+		expectedErrMsg string
+	}{
+		{
+			name: "all workflows across pages",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposActionsRunsByOwnerByRepo,
+					http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+						assert.Equal(t, ">=2025-03-01", r.URL.Query().Get("created"))
+						if r.URL.Query().Get("page") == "2" {
+							mockResponse(t, http.StatusOK, secondPage)(w, r)
+							return
+						}
+						w.Header().Set("Link", `<https://api.github.com/repos/owner/repo/actions/runs?page=2>; rel="next"`)
+						mockResponse(t, http.StatusOK, firstPage)(w, r)
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"since": "2025-03-01",
+			},
+			expectedRuns: 5,
+		},
+		{
+			name: "single workflow",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposActionsWorkflowsRunsByOwnerByRepoByWorkflowId,
+					expectPath(t, "/repos/owner/repo/actions/workflows/ci.yml/runs").andThen(
+						mockResponse(t, http.StatusOK, firstPage),
+					),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner":       "owner",
+				"repo":        "repo",
+				"workflow_id": "ci.yml",
+				"since":       "2025-03-01",
+			},
+			expectedRuns: 3,
+		},
+		{
+			name:         "invalid since",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"since": "yesterday",
+			},
+			expectError:    true,
+			expectedErrMsg: "invalid since timestamp",
+		},
+		{
+			name: "repository not found",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposActionsRunsByOwnerByRepo,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusNotFound)
+						_, _ = w.Write([]byte(`{"message": "Not Found"}`))
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "missing",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to list workflow runs for owner/missing",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := GetWorkflowUsage(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+			require.NoError(t, err)
+
+			if tc.expectError {
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			textContent := getTextResult(t, result)
+			var response struct {
+				RunsAnalyzed int                  `json:"runs_analyzed"`
+				Workflows    []WorkflowUsageStats `json:"workflows"`
+			}
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &response))
+			assert.Equal(t, tc.expectedRuns, response.RunsAnalyzed)
+			require.NotEmpty(t, response.Workflows)
+
+			ci := response.Workflows[0]
+			assert.Equal(t, "CI", ci.Name)
+			assert.Equal(t, 2, ci.SuccessfulRuns)
+			assert.Equal(t, 1, ci.FailedRuns)
+			assert.InDelta(t, 2.0/3.0, ci.SuccessRate, 0.0001)
+			assert.Equal(t, float64(240), ci.AverageDurationSeconds)
+			assert.Equal(t, float64(240), ci.P50DurationSeconds)
+			assert.Equal(t, float64(360), ci.P95DurationSeconds)
+			assert.Equal(t, float64(360), ci.MaxDurationSeconds)
+		})
+	}
+}
+
+func Test_Percentile(t *testing.T) {
+	values := []float64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+	assert.Equal(t, float64(5), percentile(values, 50))
+	assert.Equal(t, float64(9), percentile(values, 90))
+	assert.Equal(t, float64(10), percentile(values, 95))
+	assert.Equal(t, float64(1), percentile(values, 0))
+	assert.Equal(t, float64(0), percentile(nil, 50))
+}
+
+func Test_FindFlakyJobs(t *testing.T) {
+	mockClient := github.NewClient(nil)
+	tool, _ := FindFlakyJobs(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "find_flaky_jobs", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "workflow_id")
+	assert.Contains(t, tool.InputSchema.Properties, "since")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"owner", "repo"})
+
+	withCommit := func(run *github.WorkflowRun, sha string, attempt int) *github.WorkflowRun {
+		run.HeadSHA = github.Ptr(sha)
+		run.RunAttempt = github.Ptr(attempt)
+		run.HTMLURL = github.Ptr(fmt.Sprintf("https://github.com/owner/repo/actions/runs/%d", run.GetID()))
+		return run
+	}
+	runs := &github.WorkflowRuns{
+		TotalCount: github.Ptr(4),
+		WorkflowRuns: []*github.WorkflowRun{
+			withCommit(mockWorkflowRun(4, 10, "CI", "completed", "failure", time.Minute), "ccc", 1),
+			withCommit(mockWorkflowRun(3, 10, "CI", "completed", "success", time.Minute), "ccc", 1),
+			withCommit(mockWorkflowRun(2, 10, "CI", "completed", "success", time.Minute), "bbb", 1),
+			withCommit(mockWorkflowRun(1, 10, "CI", "completed", "success", time.Minute), "aaa", 2),
+		},
+	}
+	job := func(name, conclusion string) *github.WorkflowJob {
+		return &github.WorkflowJob{Name: github.Ptr(name), Conclusion: github.Ptr(conclusion)}
+	}
+	jobsByRun := map[string]*github.Jobs{
+		"/repos/owner/repo/actions/runs/1/jobs": {Jobs: []*github.WorkflowJob{
+			job("test", "failure"), job("lint", "success"),
+			job("test", "success"), job("lint", "success"),
+		}},
+		"/repos/owner/repo/actions/runs/3/jobs": {Jobs: []*github.WorkflowJob{job("test", "success"), job("lint", "success")}},
+		"/repos/owner/repo/actions/runs/4/jobs": {Jobs: []*github.WorkflowJob{job("test", "timed_out"), job("lint", "success")}},
+	}
+
+	tests := []struct {
+		name           string
+		mockedClient   *http.Client
+		requestArgs    map[string]interface{}
+		expectError    bool
+		expectedErrMsg string
+	}{
+		{
+			name: "ranks jobs that failed and passed on the same commit",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetReposActionsRunsByOwnerByRepo,
+					expectQueryParams(t, map[string]string{
+						"created":  ">=2025-03-01",
+						"status":   "completed",
+						"page":     "1",
+						"per_page": "100",
+					}).andThen(
+						mockResponse(t, http.StatusOK, runs),
+					),
+				),
+				mock.WithRequestMatchHandler(
+					mock.GetReposActionsRunsJobsByOwnerByRepoByRunId,
+					http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+						assert.Equal(t, "all", r.URL.Query().Get("filter"))
+						jobs, ok := jobsByRun[r.URL.Path]
+						require.True(t, ok, "unexpected jobs request for %s", r.URL.Path)
+						mockResponse(t, http.StatusOK, jobs)(w, r)
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"since": "2025-03-01",
+			},
+		},
+		{
+			name: "jobs request fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(mock.GetReposActionsRunsByOwnerByRepo, runs),
+				mock.WithRequestMatchHandler(
+					mock.GetReposActionsRunsJobsByOwnerByRepoByRunId,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusInternalServerError)
+						_, _ = w.Write([]byte(`{"message": "Internal Server Error"}`))
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"owner": "owner",
+				"repo":  "repo",
+				"since": "2025-03-01",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to list jobs for workflow run 4",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := FindFlakyJobs(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+			require.NoError(t, err)
+
+			if tc.expectError {
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			textContent := getTextResult(t, result)
+			var response struct {
+				RunsAnalyzed  int        `json:"runs_analyzed"`
+				RunsInspected int        `json:"runs_inspected"`
+				FlakyJobs     []FlakyJob `json:"flaky_jobs"`
+			}
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &response))
+			assert.Equal(t, 4, response.RunsAnalyzed)
+			assert.Equal(t, 3, response.RunsInspected)
+			require.Len(t, response.FlakyJobs, 1)
+
+			flaky := response.FlakyJobs[0]
+			assert.Equal(t, "CI", flaky.Workflow)
+			assert.Equal(t, "test", flaky.Job)
+			assert.Equal(t, 2, flaky.FlakyCommits)
+			assert.Equal(t, 3, flaky.CommitsAnalyzed)
+			assert.InDelta(t, 2.0/3.0, flaky.FlakeRate, 0.0001)
+			require.Len(t, flaky.Examples, 2)
+			assert.Equal(t, int64(4), flaky.Examples[0].RunID)
+			assert.Equal(t, "ccc", flaky.Examples[0].HeadSHA)
+			assert.Equal(t, int64(1), flaky.Examples[1].RunID)
+		})
+	}
+}
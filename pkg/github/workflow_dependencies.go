@@ -0,0 +1,157 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v79/github"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// MaxWorkflowDependencyFiles caps how many workflow files are inspected per call.
+const MaxWorkflowDependencyFiles = 100
+
+// usesPattern matches the target of a `uses:` key in a workflow file, for both steps and reusable workflow jobs.
+var usesPattern = regexp.MustCompile(`(?m)^[ \t]*(?:-[ \t]*)?uses:[ \t]*["']?([^\s"'#]+)`)
+
+// WorkflowDependent is a single `uses:` reference to the requested action or reusable workflow.
+type WorkflowDependent struct {
+	Repository string `json:"repository"`
+	Workflow   string `json:"workflow"`
+	Ref        string `json:"ref"`
+	Line       int    `json:"line"`
+	HTMLURL    string `json:"html_url,omitempty"`
+}
+
+// FindWorkflowDependents creates a tool to find the workflows in an organization that use a given action or reusable workflow.
+func FindWorkflowDependents(getClient GetClientFn, t translations.TranslationHelperFunc) (tool mcp.Tool, handler server.ToolHandlerFunc) {
+	return mcp.NewTool("find_workflow_dependents",
+			mcp.WithDescription(t("TOOL_FIND_WORKFLOW_DEPENDENTS_DESCRIPTION", "Find the workflows across an organization that reference an action or reusable workflow through `uses:`, and which version (ref) each one pins. Use this for impact analysis before upgrading or deprecating a shared action or workflow. Relies on code search, so only default branches of indexed repositories are covered.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_FIND_WORKFLOW_DEPENDENTS_USER_TITLE", "Find workflow dependents"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("org",
+				mcp.Required(),
+				mcp.Description("Organization whose workflows are searched"),
+			),
+			mcp.WithString("uses",
+				mcp.Required(),
+				mcp.Description("The action or reusable workflow to look for, without a ref. For example 'actions/checkout' or 'octo-org/shared/.github/workflows/build.yml'."),
+			),
+			mcp.WithString("ref",
+				mcp.Description("Only return references pinned to this ref (tag, branch or SHA)"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			org, err := RequiredParam[string](request, "org")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			uses, err := RequiredParam[string](request, "uses")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			ref, err := OptionalParam[string](request, "ref")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if strings.Contains(uses, "@") {
+				return mcp.NewToolResultError("uses must not contain a ref; pass the ref parameter instead"), nil
+			}
+
+			client, err := getClient(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get GitHub client: %w", err)
+			}
+
+			query := fmt.Sprintf(`"%s@" org:%s path:.github/workflows`, uses, org)
+			result, resp, err := client.Search.Code(ctx, query, &github.SearchOptions{
+				ListOptions: github.ListOptions{PerPage: MaxWorkflowDependencyFiles},
+			})
+			if err != nil {
+				return ghErrors.NewGitHubAPIErrorResponse(ctx,
+					fmt.Sprintf("failed to search code with query '%s'", query),
+					resp,
+					err,
+				), nil
+			}
+			_ = resp.Body.Close()
+
+			dependents := []WorkflowDependent{}
+			refCounts := make(map[string]int)
+			for _, file := range result.CodeResults {
+				repo := file.GetRepository()
+				content, resp, err := client.Git.GetBlobRaw(ctx, repo.GetOwner().GetLogin(), repo.GetName(), file.GetSHA())
+				if err != nil {
+					return ghErrors.NewGitHubAPIErrorResponse(ctx,
+						fmt.Sprintf("failed to get %s in %s", file.GetPath(), repo.GetFullName()),
+						resp,
+						err,
+					), nil
+				}
+				_ = resp.Body.Close()
+
+				for _, match := range findUsesReferences(string(content), uses) {
+					if ref != "" && match.ref != ref {
+						continue
+					}
+					refCounts[match.ref]++
+					dependents = append(dependents, WorkflowDependent{
+						Repository: repo.GetFullName(),
+						Workflow:   file.GetPath(),
+						Ref:        match.ref,
+						Line:       match.line,
+						HTMLURL:    file.GetHTMLURL(),
+					})
+				}
+			}
+			sort.SliceStable(dependents, func(i, j int) bool {
+				if dependents[i].Repository != dependents[j].Repository {
+					return dependents[i].Repository < dependents[j].Repository
+				}
+				return dependents[i].Workflow < dependents[j].Workflow
+			})
+
+			r, err := json.Marshal(map[string]any{
+				"uses":       uses,
+				"dependents": dependents,
+				"refs":       refCounts,
+				"truncated":  result.GetTotal() > len(result.CodeResults),
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal response: %w", err)
+			}
+
+			return mcp.NewToolResultText(string(r)), nil
+		}
+}
+
+type usesReference struct {
+	ref  string
+	line int
+}
+
+// findUsesReferences returns the refs and line numbers of every `uses:` in a workflow file that points at target.
+func findUsesReferences(content, target string) []usesReference {
+	var refs []usesReference
+	for _, match := range usesPattern.FindAllStringSubmatchIndex(content, -1) {
+		value := content[match[2]:match[3]]
+		path, ref, ok := strings.Cut(value, "@")
+		if !ok || !strings.EqualFold(path, target) {
+			continue
+		}
+		refs = append(refs, usesReference{
+			ref:  ref,
+			line: strings.Count(content[:match[2]], "\n") + 1,
+		})
+	}
+	return refs
+}
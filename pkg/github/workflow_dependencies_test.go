@@ -0,0 +1,194 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/github/github-mcp-server/internal/toolsnaps"
+	"github.com/github/github-mcp-server/pkg/translations"
+	"github.com/google/go-github/v79/github"
+	"github.com/migueleliasweb/go-github-mock/src/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_FindWorkflowDependents(t *testing.T) {
+	mockClient := github.NewClient(nil)
+	tool, _ := FindWorkflowDependents(stubGetClientFn(mockClient), translations.NullTranslationHelper)
+	require.NoError(t, toolsnaps.Test(tool.Name, tool))
+
+	assert.Equal(t, "find_workflow_dependents", tool.Name)
+	assert.NotEmpty(t, tool.Description)
+	assert.Contains(t, tool.InputSchema.Properties, "org")
+	assert.Contains(t, tool.InputSchema.Properties, "uses")
+	assert.Contains(t, tool.InputSchema.Properties, "ref")
+	assert.ElementsMatch(t, tool.InputSchema.Required, []string{"org", "uses"})
+
+	searchResult := &github.CodeSearchResult{
+		Total: github.Ptr(2),
+		CodeResults: []*github.CodeResult{
+			{
+				Path:    github.Ptr(".github/workflows/ci.yml"),
+				SHA:     github.Ptr("blob-web"),
+				HTMLURL: github.Ptr("https://github.com/octo-org/web/blob/main/.github/workflows/ci.yml"),
+				Repository: &github.Repository{
+					Name:     github.Ptr("web"),
+					FullName: github.Ptr("octo-org/web"),
+					Owner:    &github.User{Login: github.Ptr("octo-org")},
+				},
+			},
+			{
+				Path: github.Ptr(".github/workflows/release.yml"),
+				SHA:  github.Ptr("blob-api"),
+				Repository: &github.Repository{
+					Name:     github.Ptr("api"),
+					FullName: github.Ptr("octo-org/api"),
+					Owner:    &github.User{Login: github.Ptr("octo-org")},
+				},
+			},
+		},
+	}
+	blobs := map[string]string{
+		"/repos/octo-org/web/git/blobs/blob-web": `name: CI
+on: push
+jobs:
+  build:
+    uses: octo-org/shared/.github/workflows/build.yml@v1
+  test:
+    runs-on: ubuntu-latest
+    steps:
+      - uses: actions/checkout@v4
+`,
+		"/repos/octo-org/api/git/blobs/blob-api": `name: Release
+jobs:
+  build:
+    uses: 'octo-org/shared/.github/workflows/build.yml@v2' # upgraded
+  other:
+    uses: octo-org/shared/.github/workflows/build-docs.yml@v1
+`,
+	}
+	blobHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		content, ok := blobs[r.URL.Path]
+		require.True(t, ok, "unexpected blob request for %s", r.URL.Path)
+		_, _ = w.Write([]byte(content))
+	})
+
+	tests := []struct {
+		name               string
+		mockedClient       *http.Client
+		requestArgs        map[string]interface{}
+		expectError        bool
+		expectedDependents []WorkflowDependent
+		expectedErrMsg     string
+	}{
+		{
+			name: "all versions of a reusable workflow",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetSearchCode,
+					expectQueryParams(t, map[string]string{
+						"q":        `"octo-org/shared/.github/workflows/build.yml@" org:octo-org path:.github/workflows`,
+						"per_page": "100",
+					}).andThen(
+						mockResponse(t, http.StatusOK, searchResult),
+					),
+				),
+				mock.WithRequestMatchHandler(mock.GetReposGitBlobsByOwnerByRepoByFileSha, blobHandler),
+			),
+			requestArgs: map[string]interface{}{
+				"org":  "octo-org",
+				"uses": "octo-org/shared/.github/workflows/build.yml",
+			},
+			expectedDependents: []WorkflowDependent{
+				{Repository: "octo-org/api", Workflow: ".github/workflows/release.yml", Ref: "v2", Line: 4},
+				{Repository: "octo-org/web", Workflow: ".github/workflows/ci.yml", Ref: "v1", Line: 5, HTMLURL: "https://github.com/octo-org/web/blob/main/.github/workflows/ci.yml"},
+			},
+		},
+		{
+			name: "filtered to a single ref",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatch(mock.GetSearchCode, searchResult),
+				mock.WithRequestMatchHandler(mock.GetReposGitBlobsByOwnerByRepoByFileSha, blobHandler),
+			),
+			requestArgs: map[string]interface{}{
+				"org":  "octo-org",
+				"uses": "octo-org/shared/.github/workflows/build.yml",
+				"ref":  "v1",
+			},
+			expectedDependents: []WorkflowDependent{
+				{Repository: "octo-org/web", Workflow: ".github/workflows/ci.yml", Ref: "v1", Line: 5, HTMLURL: "https://github.com/octo-org/web/blob/main/.github/workflows/ci.yml"},
+			},
+		},
+		{
+			name:         "uses with a ref",
+			mockedClient: mock.NewMockedHTTPClient(),
+			requestArgs: map[string]interface{}{
+				"org":  "octo-org",
+				"uses": "actions/checkout@v4",
+			},
+			expectError:    true,
+			expectedErrMsg: "uses must not contain a ref",
+		},
+		{
+			name: "search fails",
+			mockedClient: mock.NewMockedHTTPClient(
+				mock.WithRequestMatchHandler(
+					mock.GetSearchCode,
+					http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+						w.WriteHeader(http.StatusUnprocessableEntity)
+						_, _ = w.Write([]byte(`{"message": "Validation Failed"}`))
+					}),
+				),
+			),
+			requestArgs: map[string]interface{}{
+				"org":  "octo-org",
+				"uses": "actions/checkout",
+			},
+			expectError:    true,
+			expectedErrMsg: "failed to search code",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := github.NewClient(tc.mockedClient)
+			_, handler := FindWorkflowDependents(stubGetClientFn(client), translations.NullTranslationHelper)
+
+			result, err := handler(context.Background(), createMCPRequest(tc.requestArgs))
+			require.NoError(t, err)
+
+			if tc.expectError {
+				errorContent := getErrorResult(t, result)
+				assert.Contains(t, errorContent.Text, tc.expectedErrMsg)
+				return
+			}
+
+			textContent := getTextResult(t, result)
+			var response struct {
+				Dependents []WorkflowDependent `json:"dependents"`
+				Truncated  bool                `json:"truncated"`
+			}
+			require.NoError(t, json.Unmarshal([]byte(textContent.Text), &response))
+			assert.Equal(t, tc.expectedDependents, response.Dependents)
+			assert.False(t, response.Truncated)
+		})
+	}
+}
+
+func Test_FindUsesReferences(t *testing.T) {
+	content := `steps:
+  - uses: actions/checkout@v4
+  - name: setup
+    uses: "actions/setup-go@v5"
+  - uses: Actions/Checkout@8ade135a41bc03ea155e62e844d188df1ea18608 # v4.1.0
+  - uses: ./.github/actions/local
+  - run: echo "uses: actions/checkout@v1"
+`
+	refs := findUsesReferences(content, "actions/checkout")
+	assert.Equal(t, []usesReference{
+		{ref: "v4", line: 2},
+		{ref: "8ade135a41bc03ea155e62e844d188df1ea18608", line: 5},
+	}, refs)
+}
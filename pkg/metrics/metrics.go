@@ -0,0 +1,290 @@
+// Package metrics collects metrics about tool calls and GitHub API requests and serves them in the Prometheus text
+// exposition format. It implements the few metric types it needs itself, so that exposing metrics does not require
+// the Prometheus client library or an OpenTelemetry pipeline.
+package metrics
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// durationBuckets are the upper bounds, in seconds, of the GitHub API latency histogram buckets.
+var durationBuckets = []float64{0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10}
+
+type toolCallKey struct {
+	tool    string
+	outcome string
+}
+
+type toolErrorKey struct {
+	tool string
+	code ghErrors.ErrorCode
+}
+
+type requestKey struct {
+	api    string
+	status string
+}
+
+type histogram struct {
+	buckets []uint64
+	sum     float64
+	count   uint64
+}
+
+type rateLimit struct {
+	limit     float64
+	remaining float64
+	reset     float64
+}
+
+// Metrics collects the metrics of one server. The zero value is not usable; create it with New.
+type Metrics struct {
+	mu         sync.Mutex
+	toolCalls  map[toolCallKey]uint64
+	toolErrors map[toolErrorKey]uint64
+	requests   map[requestKey]*histogram
+	rateLimits map[string]rateLimit
+}
+
+// New creates an empty Metrics.
+func New() *Metrics {
+	return &Metrics{
+		toolCalls:  make(map[toolCallKey]uint64),
+		toolErrors: make(map[toolErrorKey]uint64),
+		requests:   make(map[requestKey]*histogram),
+		rateLimits: make(map[string]rateLimit),
+	}
+}
+
+// ToolMiddleware counts tool calls by outcome, and failed calls by the code of their tool error.
+func (m *Metrics) ToolMiddleware() server.ToolHandlerMiddleware {
+	return func(next server.ToolHandlerFunc) server.ToolHandlerFunc {
+		return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			result, err := next(ctx, request)
+			tool := request.Params.Name
+			switch {
+			case err != nil:
+				m.recordToolCall(tool, "error", ghErrors.CodeUpstreamError)
+			case result != nil && result.IsError:
+				m.recordToolCall(tool, "error", errorCode(ctx, result, request))
+			default:
+				m.recordToolCall(tool, "success", "")
+			}
+			return result, err
+		}
+	}
+}
+
+// errorCode returns the code of a tool error result, classifying unstructured error text the same way the
+// structured error middleware does.
+func errorCode(ctx context.Context, result *mcp.CallToolResult, request mcp.CallToolRequest) ghErrors.ErrorCode {
+	if len(result.Content) != 1 {
+		return ghErrors.CodeUpstreamError
+	}
+	text, ok := result.Content[0].(mcp.TextContent)
+	if !ok {
+		return ghErrors.CodeUpstreamError
+	}
+	var toolErr ghErrors.ToolError
+	if json.Unmarshal([]byte(text.Text), &toolErr) == nil && toolErr.Code != "" {
+		return toolErr.Code
+	}
+	return ghErrors.ToolErrorFromResult(ctx, text.Text, request.GetArguments()).Code
+}
+
+func (m *Metrics) recordToolCall(tool, outcome string, code ghErrors.ErrorCode) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.toolCalls[toolCallKey{tool, outcome}]++
+	if code != "" {
+		m.toolErrors[toolErrorKey{tool, code}]++
+	}
+}
+
+// Transport wraps an http.RoundTripper used for GitHub API requests to record their latency and the rate limit
+// reported in the response headers.
+func (m *Metrics) Transport(next http.RoundTripper) http.RoundTripper {
+	return &transport{metrics: m, next: next}
+}
+
+type transport struct {
+	metrics *Metrics
+	next    http.RoundTripper
+}
+
+func (t *transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	start := time.Now()
+	resp, err := t.next.RoundTrip(req)
+	duration := time.Since(start)
+
+	api := "rest"
+	if strings.HasSuffix(req.URL.Path, "/graphql") {
+		api = "graphql"
+	}
+	status := "error"
+	if err == nil {
+		status = strconv.Itoa(resp.StatusCode)
+	}
+	t.metrics.recordRequest(api, status, duration)
+	if err == nil {
+		t.metrics.recordRateLimit(resp.Header)
+	}
+	return resp, err
+}
+
+func (m *Metrics) recordRequest(api, status string, duration time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	key := requestKey{api, status}
+	h, ok := m.requests[key]
+	if !ok {
+		h = &histogram{buckets: make([]uint64, len(durationBuckets))}
+		m.requests[key] = h
+	}
+	seconds := duration.Seconds()
+	for i, bound := range durationBuckets {
+		if seconds <= bound {
+			h.buckets[i]++
+		}
+	}
+	h.sum += seconds
+	h.count++
+}
+
+func (m *Metrics) recordRateLimit(header http.Header) {
+	limit, errLimit := strconv.ParseFloat(header.Get("X-RateLimit-Limit"), 64)
+	remaining, errRemaining := strconv.ParseFloat(header.Get("X-RateLimit-Remaining"), 64)
+	if errLimit != nil || errRemaining != nil {
+		return
+	}
+	reset, _ := strconv.ParseFloat(header.Get("X-RateLimit-Reset"), 64)
+	resource := header.Get("X-RateLimit-Resource")
+	if resource == "" {
+		resource = "core"
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.rateLimits[resource] = rateLimit{limit: limit, remaining: remaining, reset: reset}
+}
+
+// Handler serves the metrics in the Prometheus text exposition format.
+func (m *Metrics) Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+		_ = m.Write(w)
+	})
+}
+
+// Write writes the metrics in the Prometheus text exposition format.
+func (m *Metrics) Write(w io.Writer) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var b strings.Builder
+
+	writeHeader(&b, "github_mcp_tool_calls_total", "counter", "Number of tool calls, by tool and outcome.")
+	for _, key := range sortedKeys(m.toolCalls, func(a, b toolCallKey) int {
+		return cmpStrings(a.tool, b.tool, a.outcome, b.outcome)
+	}) {
+		writeSample(&b, "github_mcp_tool_calls_total", labels("tool", key.tool, "outcome", key.outcome), float64(m.toolCalls[key]))
+	}
+
+	writeHeader(&b, "github_mcp_tool_errors_total", "counter", "Number of tool calls that returned an error, by tool and error code.")
+	for _, key := range sortedKeys(m.toolErrors, func(a, b toolErrorKey) int {
+		return cmpStrings(a.tool, b.tool, string(a.code), string(b.code))
+	}) {
+		writeSample(&b, "github_mcp_tool_errors_total", labels("tool", key.tool, "code", string(key.code)), float64(m.toolErrors[key]))
+	}
+
+	writeHeader(&b, "github_mcp_github_request_duration_seconds", "histogram", "Latency of GitHub API requests, by API and HTTP status.")
+	for _, key := range sortedKeys(m.requests, func(a, b requestKey) int {
+		return cmpStrings(a.api, b.api, a.status, b.status)
+	}) {
+		h := m.requests[key]
+		for i, bound := range durationBuckets {
+			writeSample(&b, "github_mcp_github_request_duration_seconds_bucket",
+				labels("api", key.api, "status", key.status, "le", formatFloat(bound)), float64(h.buckets[i]))
+		}
+		writeSample(&b, "github_mcp_github_request_duration_seconds_bucket",
+			labels("api", key.api, "status", key.status, "le", "+Inf"), float64(h.count))
+		writeSample(&b, "github_mcp_github_request_duration_seconds_sum", labels("api", key.api, "status", key.status), h.sum)
+		writeSample(&b, "github_mcp_github_request_duration_seconds_count", labels("api", key.api, "status", key.status), float64(h.count))
+	}
+
+	resources := sortedKeys(m.rateLimits, strings.Compare)
+	for _, gauge := range []struct {
+		name  string
+		help  string
+		value func(rateLimit) float64
+	}{
+		{"github_mcp_github_rate_limit_limit", "GitHub API rate limit of the token, by rate limit resource.", func(r rateLimit) float64 { return r.limit }},
+		{"github_mcp_github_rate_limit_remaining", "Requests remaining in the current GitHub API rate limit window, by rate limit resource.", func(r rateLimit) float64 { return r.remaining }},
+		{"github_mcp_github_rate_limit_reset_timestamp_seconds", "Unix time at which the GitHub API rate limit window resets, by rate limit resource.", func(r rateLimit) float64 { return r.reset }},
+	} {
+		writeHeader(&b, gauge.name, "gauge", gauge.help)
+		for _, resource := range resources {
+			writeSample(&b, gauge.name, labels("resource", resource), gauge.value(m.rateLimits[resource]))
+		}
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+func writeHeader(b *strings.Builder, name, metricType, help string) {
+	fmt.Fprintf(b, "# HELP %s %s\n# TYPE %s %s\n", name, help, name, metricType)
+}
+
+func writeSample(b *strings.Builder, name, labels string, value float64) {
+	fmt.Fprintf(b, "%s{%s} %s\n", name, labels, formatFloat(value))
+}
+
+var labelValueEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+// labels formats alternating label names and values.
+func labels(pairs ...string) string {
+	parts := make([]string, 0, len(pairs)/2)
+	for i := 0; i+1 < len(pairs); i += 2 {
+		parts = append(parts, pairs[i]+`="`+labelValueEscaper.Replace(pairs[i+1])+`"`)
+	}
+	return strings.Join(parts, ",")
+}
+
+func formatFloat(v float64) string {
+	// Print whole numbers such as counts and timestamps without an exponent.
+	if v == math.Trunc(v) && math.Abs(v) < 1e15 {
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	}
+	return strconv.FormatFloat(v, 'g', -1, 64)
+}
+
+func sortedKeys[K comparable, V any](m map[K]V, cmp func(a, b K) int) []K {
+	keys := make([]K, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	slices.SortFunc(keys, cmp)
+	return keys
+}
+
+func cmpStrings(a1, b1, a2, b2 string) int {
+	if c := strings.Compare(a1, b1); c != 0 {
+		return c
+	}
+	return strings.Compare(a2, b2)
+}
@@ -0,0 +1,100 @@
+package metrics
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func scrape(t *testing.T, m *Metrics) string {
+	t.Helper()
+	rec := httptest.NewRecorder()
+	m.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "text/plain; version=0.0.4; charset=utf-8", rec.Header().Get("Content-Type"))
+	return rec.Body.String()
+}
+
+func TestToolMiddleware(t *testing.T) {
+	m := New()
+	results := map[string]*mcp.CallToolResult{
+		"get_me":         mcp.NewToolResultText("ok"),
+		"get_issue":      ghErrors.NewToolError(ghErrors.CodeNotFound, "issue not found", "").Result(),
+		"create_issue":   mcp.NewToolResultError("missing required parameter: title"),
+		"list_workflows": nil,
+	}
+	handler := m.ToolMiddleware()(func(_ context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		if request.Params.Name == "list_workflows" {
+			return nil, fmt.Errorf("failed to get GitHub client")
+		}
+		return results[request.Params.Name], nil
+	})
+
+	for _, name := range []string{"get_me", "get_me", "get_issue", "create_issue", "list_workflows"} {
+		request := mcp.CallToolRequest{}
+		request.Params.Name = name
+		_, _ = handler(context.Background(), request)
+	}
+
+	body := scrape(t, m)
+	for _, line := range []string{
+		`github_mcp_tool_calls_total{tool="get_me",outcome="success"} 2`,
+		`github_mcp_tool_calls_total{tool="get_issue",outcome="error"} 1`,
+		`github_mcp_tool_errors_total{tool="get_issue",code="NOT_FOUND"} 1`,
+		`github_mcp_tool_errors_total{tool="create_issue",code="INVALID_INPUT"} 1`,
+		`github_mcp_tool_errors_total{tool="list_workflows",code="UPSTREAM_ERROR"} 1`,
+	} {
+		assert.Contains(t, body, line+"\n")
+	}
+	assert.NotContains(t, body, `github_mcp_tool_errors_total{tool="get_me"`)
+}
+
+func TestTransport(t *testing.T) {
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-RateLimit-Limit", "5000")
+		w.Header().Set("X-RateLimit-Remaining", "4990")
+		w.Header().Set("X-RateLimit-Reset", "1760000000")
+		if r.URL.Path == "/graphql" {
+			w.Header().Set("X-RateLimit-Resource", "graphql")
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer upstream.Close()
+
+	m := New()
+	client := &http.Client{Transport: m.Transport(http.DefaultTransport)}
+	for _, path := range []string{"/repos/octo/repo", "/graphql"} {
+		resp, err := client.Get(upstream.URL + path)
+		require.NoError(t, err)
+		_, _ = io.Copy(io.Discard, resp.Body)
+		_ = resp.Body.Close()
+	}
+
+	body := scrape(t, m)
+	for _, line := range []string{
+		`# TYPE github_mcp_github_request_duration_seconds histogram`,
+		`github_mcp_github_request_duration_seconds_bucket{api="rest",status="404",le="+Inf"} 1`,
+		`github_mcp_github_request_duration_seconds_count{api="rest",status="404"} 1`,
+		`github_mcp_github_request_duration_seconds_count{api="graphql",status="200"} 1`,
+		`github_mcp_github_rate_limit_remaining{resource="core"} 4990`,
+		`github_mcp_github_rate_limit_limit{resource="graphql"} 5000`,
+		`github_mcp_github_rate_limit_reset_timestamp_seconds{resource="core"} 1760000000`,
+	} {
+		assert.Contains(t, body, line+"\n")
+	}
+}
+
+func TestLabelEscaping(t *testing.T) {
+	assert.Equal(t, `tool="a\"b\\c\nd"`, labels("tool", "a\"b\\c\nd"))
+	assert.True(t, strings.HasPrefix(labels("a", "1", "b", "2"), `a="1",b="2"`))
+}
@@ -0,0 +1,325 @@
+// Package middleware provides tool handler middlewares for the GitHub MCP Server. A middleware wraps the handler of
+// every registered tool, so embedders can add authorization checks, caching or telemetry around each tool call.
+package middleware
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"golang.org/x/time/rate"
+)
+
+// ToolMiddleware wraps a tool handler. It can inspect or change the request before calling next, and the result
+// after it returns, or return without calling next at all.
+type ToolMiddleware = server.ToolHandlerMiddleware
+
+// Chain combines middlewares into one. The first middleware is the outermost: it sees the request first and the
+// result last.
+func Chain(middlewares ...ToolMiddleware) ToolMiddleware {
+	return func(next server.ToolHandlerFunc) server.ToolHandlerFunc {
+		for i := len(middlewares) - 1; i >= 0; i-- {
+			next = middlewares[i](next)
+		}
+		return next
+	}
+}
+
+// Logging logs the name, duration and outcome of every tool call.
+func Logging(logger *slog.Logger) ToolMiddleware {
+	return func(next server.ToolHandlerFunc) server.ToolHandlerFunc {
+		return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			start := time.Now()
+			result, err := next(ctx, request)
+			attrs := []any{
+				"tool", request.Params.Name,
+				"duration", time.Since(start),
+			}
+			switch {
+			case err != nil:
+				logger.ErrorContext(ctx, "tool call failed", append(attrs, "error", err)...)
+			case result != nil && result.IsError:
+				logger.WarnContext(ctx, "tool call returned an error", attrs...)
+			default:
+				logger.InfoContext(ctx, "tool call succeeded", attrs...)
+			}
+			return result, err
+		}
+	}
+}
+
+// RateLimit limits tool calls to perSecond calls per second on average, allowing bursts of up to burst calls. Calls
+// over the limit are not run and fail with a RATE_LIMITED tool error, so the limit also protects the GitHub rate
+// limit of the token the server uses.
+func RateLimit(perSecond float64, burst int) ToolMiddleware {
+	return NewRateLimiter(perSecond, burst).Middleware()
+}
+
+// RateLimiter is a rate limiting middleware whose limit can be changed while the server runs, e.g. when its
+// configuration is reloaded.
+type RateLimiter struct {
+	limiter *rate.Limiter
+}
+
+// NewRateLimiter creates a RateLimiter with the limits of RateLimit. A perSecond of zero or less disables the limit.
+func NewRateLimiter(perSecond float64, burst int) *RateLimiter {
+	l := &RateLimiter{limiter: rate.NewLimiter(rate.Inf, 0)}
+	l.SetLimit(perSecond, burst)
+	return l
+}
+
+// SetLimit changes the limit. A perSecond of zero or less disables it.
+func (l *RateLimiter) SetLimit(perSecond float64, burst int) {
+	if perSecond <= 0 {
+		l.limiter.SetLimit(rate.Inf)
+		return
+	}
+	l.limiter.SetBurst(burst)
+	l.limiter.SetLimit(rate.Limit(perSecond))
+}
+
+// Middleware returns the middleware that applies the limit.
+func (l *RateLimiter) Middleware() ToolMiddleware {
+	return func(next server.ToolHandlerFunc) server.ToolHandlerFunc {
+		return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			if !l.limiter.Allow() {
+				return ghErrors.NewToolError(ghErrors.CodeRateLimited,
+					fmt.Sprintf("tool call rate limit exceeded: the server allows %g calls per second", float64(l.limiter.Limit())),
+					"",
+				).Result(), nil
+			}
+			return next(ctx, request)
+		}
+	}
+}
+
+// Timeout cancels tool calls that run longer than their timeout: the timeout in perTool for the tool, or else
+// defaultTimeout. The deadline is set on the context the tool passes to the GitHub REST and GraphQL clients, and a
+// call that exceeds it fails with a TIMEOUT tool error right away, even if the tool does not return. The Drain and
+// ConcurrencyLimiter middleware that wrap it keep counting such a tool as in flight until it returns. A timeout of
+// zero or less disables it.
+func Timeout(defaultTimeout time.Duration, perTool map[string]time.Duration) ToolMiddleware {
+	return func(next server.ToolHandlerFunc) server.ToolHandlerFunc {
+		return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			timeout := defaultTimeout
+			if toolTimeout, ok := perTool[request.Params.Name]; ok {
+				timeout = toolTimeout
+			}
+			if timeout <= 0 {
+				return next(ctx, request)
+			}
+
+			callCtx, cancel := context.WithTimeout(ctx, timeout)
+			defer cancel()
+
+			type outcome struct {
+				result *mcp.CallToolResult
+				err    error
+			}
+			done := make(chan outcome, 1)
+			returned := make(chan struct{})
+			go func() {
+				defer close(returned)
+				result, err := next(callCtx, request)
+				done <- outcome{result, err}
+			}()
+
+			select {
+			case o := <-done:
+				failed := o.err != nil || (o.result != nil && o.result.IsError)
+				if failed && ctx.Err() == nil && errors.Is(callCtx.Err(), context.DeadlineExceeded) {
+					return timeoutResult(request.Params.Name, timeout), nil
+				}
+				return o.result, o.err
+			case <-callCtx.Done():
+				detach(ctx, returned)
+				if err := ctx.Err(); err != nil {
+					return nil, err
+				}
+				return timeoutResult(request.Params.Name, timeout), nil
+			}
+		}
+	}
+}
+
+func timeoutResult(tool string, timeout time.Duration) *mcp.CallToolResult {
+	return ghErrors.NewToolError(ghErrors.CodeTimeout, fmt.Sprintf("%s did not complete within %s", tool, timeout), "").Result()
+}
+
+type detachedKey struct{}
+
+// detachedCall records the tool handler that Timeout left running when it returned, if any.
+type detachedCall struct {
+	mu       sync.Mutex
+	returned <-chan struct{}
+}
+
+// trackDetached returns a context in which Timeout records a tool handler it leaves running, and a function that
+// calls release once that handler returns, or right away if Timeout did not leave it running.
+func trackDetached(ctx context.Context) (context.Context, func(release func())) {
+	call, ok := ctx.Value(detachedKey{}).(*detachedCall)
+	if !ok {
+		call = &detachedCall{}
+		ctx = context.WithValue(ctx, detachedKey{}, call)
+	}
+	return ctx, func(release func()) {
+		call.mu.Lock()
+		returned := call.returned
+		call.mu.Unlock()
+		if returned == nil {
+			release()
+			return
+		}
+		go func() {
+			<-returned
+			release()
+		}()
+	}
+}
+
+// detach records that the tool handler of the call is still running until returned is closed.
+func detach(ctx context.Context, returned <-chan struct{}) {
+	if call, ok := ctx.Value(detachedKey{}).(*detachedCall); ok {
+		call.mu.Lock()
+		call.returned = returned
+		call.mu.Unlock()
+	}
+}
+
+// ConcurrencyLimiter limits how many tool calls run at once, with a separate, usually lower, limit for calls that
+// mutate GitHub data. GitHub enforces secondary rate limits on concurrent requests and on bursts of content creation,
+// so a client that fires many tool calls in parallel could otherwise get the token blocked. Calls over a limit wait
+// for a free slot, and fail with a RATE_LIMITED tool error if none frees up within the queue timeout.
+type ConcurrencyLimiter struct {
+	calls        chan struct{}
+	mutations    chan struct{}
+	queueTimeout time.Duration
+	isMutation   func(tool string) bool
+}
+
+// NewConcurrencyLimiter creates a ConcurrencyLimiter that runs at most maxCalls tool calls at once, of which at most
+// maxMutations are calls to tools for which isMutation returns true. A limit of zero or less disables it. Calls wait
+// at most queueTimeout for a slot, or indefinitely if it is zero.
+func NewConcurrencyLimiter(maxCalls, maxMutations int, queueTimeout time.Duration, isMutation func(tool string) bool) *ConcurrencyLimiter {
+	l := &ConcurrencyLimiter{queueTimeout: queueTimeout, isMutation: isMutation}
+	if maxCalls > 0 {
+		l.calls = make(chan struct{}, maxCalls)
+	}
+	if maxMutations > 0 && isMutation != nil {
+		l.mutations = make(chan struct{}, maxMutations)
+	}
+	return l
+}
+
+// Middleware returns the middleware that applies the limits.
+func (l *ConcurrencyLimiter) Middleware() ToolMiddleware {
+	return func(next server.ToolHandlerFunc) server.ToolHandlerFunc {
+		return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			ctx, afterCall := trackDetached(ctx)
+			waitCtx := ctx
+			if l.queueTimeout > 0 {
+				var cancel context.CancelFunc
+				waitCtx, cancel = context.WithTimeout(ctx, l.queueTimeout)
+				defer cancel()
+			}
+
+			if l.mutations != nil && l.isMutation(request.Params.Name) {
+				if !acquire(waitCtx, l.mutations) {
+					return l.busyResult(ctx, "mutating tool calls", cap(l.mutations)), nil
+				}
+				defer afterCall(func() { release(l.mutations) })
+			}
+			if l.calls != nil {
+				if !acquire(waitCtx, l.calls) {
+					return l.busyResult(ctx, "tool calls", cap(l.calls)), nil
+				}
+				defer afterCall(func() { release(l.calls) })
+			}
+			return next(ctx, request)
+		}
+	}
+}
+
+func (l *ConcurrencyLimiter) busyResult(ctx context.Context, kind string, limit int) *mcp.CallToolResult {
+	if ctx.Err() != nil {
+		return ghErrors.NewToolError(ghErrors.CodeUnavailable, "the tool call was cancelled while waiting to run", "").Result()
+	}
+	toolErr := ghErrors.NewToolError(ghErrors.CodeRateLimited,
+		fmt.Sprintf("too many concurrent %s: the server runs at most %d at once and none finished within %s", kind, limit, l.queueTimeout),
+		"",
+	)
+	toolErr.Hint = "Wait for other tool calls to complete, or make fewer tool calls in parallel."
+	return toolErr.Result()
+}
+
+// acquire takes a slot of sem, waiting until one is free or ctx is done.
+func acquire(ctx context.Context, sem chan struct{}) bool {
+	select {
+	case sem <- struct{}{}:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+func release(sem chan struct{}) {
+	<-sem
+}
+
+// Drain tracks in-flight tool calls so that a server can shut down without interrupting them, e.g. a bulk mutation
+// that has updated some items but not others.
+type Drain struct {
+	mu       sync.Mutex
+	draining bool
+	inFlight sync.WaitGroup
+}
+
+// NewDrain creates a Drain that accepts tool calls until Wait is called.
+func NewDrain() *Drain {
+	return &Drain{}
+}
+
+// Middleware returns the middleware that tracks tool calls. Once Wait has been called, it rejects new calls.
+func (d *Drain) Middleware() ToolMiddleware {
+	return func(next server.ToolHandlerFunc) server.ToolHandlerFunc {
+		return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			d.mu.Lock()
+			if d.draining {
+				d.mu.Unlock()
+				return ghErrors.NewToolError(ghErrors.CodeUnavailable, "the server is shutting down and no longer accepts tool calls", "").Result(), nil
+			}
+			d.inFlight.Add(1)
+			d.mu.Unlock()
+			ctx, afterCall := trackDetached(ctx)
+			defer afterCall(d.inFlight.Done)
+
+			return next(ctx, request)
+		}
+	}
+}
+
+// Wait stops accepting new tool calls and waits until the in-flight ones complete or ctx is done, in which case it
+// returns the error of ctx.
+func (d *Drain) Wait(ctx context.Context) error {
+	d.mu.Lock()
+	d.draining = true
+	d.mu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		d.inFlight.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,351 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"testing"
+	"time"
+
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func callTool(t *testing.T, handler server.ToolHandlerFunc, name string) (*mcp.CallToolResult, error) {
+	t.Helper()
+	request := mcp.CallToolRequest{}
+	request.Params.Name = name
+	return handler(context.Background(), request)
+}
+
+func TestChain(t *testing.T) {
+	var calls []string
+	record := func(name string) ToolMiddleware {
+		return func(next server.ToolHandlerFunc) server.ToolHandlerFunc {
+			return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+				calls = append(calls, "before "+name)
+				result, err := next(ctx, request)
+				calls = append(calls, "after "+name)
+				return result, err
+			}
+		}
+	}
+	handler := Chain(record("outer"), record("inner"))(func(_ context.Context, _ mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		calls = append(calls, "handler")
+		return mcp.NewToolResultText("ok"), nil
+	})
+
+	_, err := callTool(t, handler, "get_me")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"before outer", "before inner", "handler", "after inner", "after outer"}, calls)
+}
+
+func TestLogging(t *testing.T) {
+	tests := []struct {
+		name          string
+		result        *mcp.CallToolResult
+		err           error
+		expectedLevel string
+		expectedMsg   string
+	}{
+		{
+			name:          "success",
+			result:        mcp.NewToolResultText("ok"),
+			expectedLevel: "INFO",
+			expectedMsg:   "tool call succeeded",
+		},
+		{
+			name:          "tool error",
+			result:        mcp.NewToolResultError("missing required parameter: owner"),
+			expectedLevel: "WARN",
+			expectedMsg:   "tool call returned an error",
+		},
+		{
+			name:          "handler error",
+			err:           fmt.Errorf("failed to get GitHub client"),
+			expectedLevel: "ERROR",
+			expectedMsg:   "tool call failed",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			logger := slog.New(slog.NewJSONHandler(&buf, nil))
+			handler := Logging(logger)(func(_ context.Context, _ mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+				return tc.result, tc.err
+			})
+
+			result, err := callTool(t, handler, "get_me")
+			assert.Equal(t, tc.result, result)
+			assert.Equal(t, tc.err, err)
+
+			var entry map[string]any
+			require.NoError(t, json.Unmarshal(buf.Bytes(), &entry))
+			assert.Equal(t, tc.expectedLevel, entry["level"])
+			assert.Equal(t, tc.expectedMsg, entry["msg"])
+			assert.Equal(t, "get_me", entry["tool"])
+			assert.Contains(t, entry, "duration")
+		})
+	}
+}
+
+func TestRateLimit(t *testing.T) {
+	calls := 0
+	handler := RateLimit(0.001, 2)(func(_ context.Context, _ mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		calls++
+		return mcp.NewToolResultText("ok"), nil
+	})
+
+	for i := 0; i < 2; i++ {
+		result, err := callTool(t, handler, "get_me")
+		require.NoError(t, err)
+		assert.False(t, result.IsError)
+	}
+
+	result, err := callTool(t, handler, "get_me")
+	require.NoError(t, err)
+	require.True(t, result.IsError)
+	assert.Equal(t, 2, calls, "calls over the limit should not reach the handler")
+
+	var toolErr ghErrors.ToolError
+	require.NoError(t, json.Unmarshal([]byte(result.Content[0].(mcp.TextContent).Text), &toolErr))
+	assert.Equal(t, ghErrors.CodeRateLimited, toolErr.Code)
+}
+
+func TestRateLimiter_SetLimit(t *testing.T) {
+	limiter := NewRateLimiter(0, 0)
+	handler := limiter.Middleware()(func(_ context.Context, _ mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return mcp.NewToolResultText("ok"), nil
+	})
+
+	for i := 0; i < 5; i++ {
+		result, err := callTool(t, handler, "get_me")
+		require.NoError(t, err)
+		assert.False(t, result.IsError, "a limiter without a limit should allow every call")
+	}
+
+	limiter.SetLimit(0.001, 1)
+	result, err := callTool(t, handler, "get_me")
+	require.NoError(t, err)
+	assert.False(t, result.IsError)
+	result, err = callTool(t, handler, "get_me")
+	require.NoError(t, err)
+	assert.True(t, result.IsError, "calls over the new limit should be rejected")
+
+	limiter.SetLimit(0, 0)
+	result, err = callTool(t, handler, "get_me")
+	require.NoError(t, err)
+	assert.False(t, result.IsError, "removing the limit should allow calls again")
+}
+
+func TestDrain(t *testing.T) {
+	drain := NewDrain()
+	started := make(chan struct{})
+	release := make(chan struct{})
+	handler := drain.Middleware()(func(_ context.Context, _ mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		close(started)
+		<-release
+		return mcp.NewToolResultText("ok"), nil
+	})
+
+	inFlight := make(chan *mcp.CallToolResult)
+	go func() {
+		result, _ := callTool(t, handler, "create_issue")
+		inFlight <- result
+	}()
+	<-started
+
+	waitErr := make(chan error)
+	go func() {
+		waitErr <- drain.Wait(context.Background())
+	}()
+
+	// Wait marks the drain as draining before it blocks, so poll until new calls are rejected.
+	require.Eventually(t, func() bool {
+		drain.mu.Lock()
+		defer drain.mu.Unlock()
+		return drain.draining
+	}, time.Second, time.Millisecond)
+	result, err := callTool(t, handler, "create_issue")
+	require.NoError(t, err)
+	require.True(t, result.IsError, "new calls should be rejected while draining")
+	var toolErr ghErrors.ToolError
+	require.NoError(t, json.Unmarshal([]byte(result.Content[0].(mcp.TextContent).Text), &toolErr))
+	assert.Equal(t, ghErrors.CodeUnavailable, toolErr.Code)
+
+	close(release)
+	assert.False(t, (<-inFlight).IsError, "the in-flight call should complete")
+	require.NoError(t, <-waitErr)
+}
+
+func TestDrain_Timeout(t *testing.T) {
+	drain := NewDrain()
+	started := make(chan struct{})
+	release := make(chan struct{})
+	defer close(release)
+	handler := drain.Middleware()(func(_ context.Context, _ mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		close(started)
+		<-release
+		return mcp.NewToolResultText("ok"), nil
+	})
+	go func() { _, _ = callTool(t, handler, "create_issue") }()
+	<-started
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	assert.ErrorIs(t, drain.Wait(ctx), context.DeadlineExceeded)
+}
+
+func TestConcurrencyLimiter(t *testing.T) {
+	isMutation := func(tool string) bool { return tool == "create_issue" }
+	limiter := NewConcurrencyLimiter(2, 1, 20*time.Millisecond, isMutation)
+	started := make(chan string, 2)
+	release := make(chan struct{})
+	handler := limiter.Middleware()(func(_ context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		started <- request.Params.Name
+		<-release
+		return mcp.NewToolResultText("ok"), nil
+	})
+
+	results := make(chan *mcp.CallToolResult, 2)
+	for _, name := range []string{"create_issue", "get_me"} {
+		go func() {
+			result, _ := callTool(t, handler, name)
+			results <- result
+		}()
+		<-started
+	}
+
+	// A second mutation and a third call both exceed a limit and time out in the queue.
+	for _, name := range []string{"create_issue", "get_issue"} {
+		result, err := callTool(t, handler, name)
+		require.NoError(t, err)
+		require.True(t, result.IsError, name)
+
+		var toolErr ghErrors.ToolError
+		require.NoError(t, json.Unmarshal([]byte(result.Content[0].(mcp.TextContent).Text), &toolErr))
+		assert.Equal(t, ghErrors.CodeRateLimited, toolErr.Code)
+		assert.Contains(t, toolErr.Message, "too many concurrent")
+	}
+
+	close(release)
+	for i := 0; i < 2; i++ {
+		assert.False(t, (<-results).IsError)
+	}
+
+	// Once the slots are free, calls run again.
+	result, err := callTool(t, handler, "create_issue")
+	require.NoError(t, err)
+	assert.False(t, result.IsError)
+}
+
+func TestConcurrencyLimiter_Queue(t *testing.T) {
+	limiter := NewConcurrencyLimiter(1, 0, time.Second, nil)
+	release := make(chan struct{})
+	started := make(chan struct{}, 2)
+	handler := limiter.Middleware()(func(_ context.Context, _ mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		started <- struct{}{}
+		<-release
+		return mcp.NewToolResultText("ok"), nil
+	})
+
+	results := make(chan *mcp.CallToolResult, 2)
+	for i := 0; i < 2; i++ {
+		go func() {
+			result, _ := callTool(t, handler, "get_me")
+			results <- result
+		}()
+	}
+	<-started
+	select {
+	case <-started:
+		t.Fatal("the second call should wait for the first one")
+	case <-time.After(10 * time.Millisecond):
+	}
+
+	close(release)
+	for i := 0; i < 2; i++ {
+		assert.False(t, (<-results).IsError, "queued calls should run once a slot is free")
+	}
+}
+
+func TestTimeout(t *testing.T) {
+	release := make(chan struct{})
+	defer close(release)
+	handler := Timeout(time.Hour, map[string]time.Duration{
+		"get_job_logs": 10 * time.Millisecond,
+		"hangs":        10 * time.Millisecond,
+		"get_me":       0,
+	})(func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		switch request.Params.Name {
+		case "get_job_logs":
+			// Tools see the deadline through the context they pass to the GitHub clients.
+			<-ctx.Done()
+			return mcp.NewToolResultError(fmt.Sprintf("failed to get job logs: %v", ctx.Err())), nil
+		case "hangs":
+			<-release
+		case "get_me":
+			if _, ok := ctx.Deadline(); ok {
+				return mcp.NewToolResultError("unexpected deadline"), nil
+			}
+		}
+		return mcp.NewToolResultText("ok"), nil
+	})
+
+	for _, name := range []string{"get_job_logs", "hangs"} {
+		result, err := callTool(t, handler, name)
+		require.NoError(t, err)
+		require.True(t, result.IsError, name)
+
+		var toolErr ghErrors.ToolError
+		require.NoError(t, json.Unmarshal([]byte(result.Content[0].(mcp.TextContent).Text), &toolErr))
+		assert.Equal(t, ghErrors.CodeTimeout, toolErr.Code)
+		assert.Equal(t, name+" did not complete within 10ms", toolErr.Message)
+	}
+
+	result, err := callTool(t, handler, "get_me")
+	require.NoError(t, err)
+	assert.False(t, result.IsError, "a zero per-tool timeout disables the default timeout")
+
+	result, err = callTool(t, handler, "get_issue")
+	require.NoError(t, err)
+	assert.False(t, result.IsError)
+}
+
+func TestTimeout_HoldsSlots(t *testing.T) {
+	drain := NewDrain()
+	limiter := NewConcurrencyLimiter(1, 0, 10*time.Millisecond, nil)
+	release := make(chan struct{})
+	handler := Chain(drain.Middleware(), limiter.Middleware(), Timeout(10*time.Millisecond, nil))(
+		func(_ context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			if request.Params.Name == "hangs" {
+				<-release
+			}
+			return mcp.NewToolResultText("ok"), nil
+		})
+
+	result, err := callTool(t, handler, "hangs")
+	require.NoError(t, err)
+	require.True(t, result.IsError, "the call should time out")
+
+	// The timed-out tool is still running, so it keeps its concurrency slot and the drain waits for it.
+	result, err = callTool(t, handler, "get_me")
+	require.NoError(t, err)
+	require.True(t, result.IsError, "the slot should be held until the tool returns")
+	var toolErr ghErrors.ToolError
+	require.NoError(t, json.Unmarshal([]byte(result.Content[0].(mcp.TextContent).Text), &toolErr))
+	assert.Equal(t, ghErrors.CodeRateLimited, toolErr.Code)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	assert.ErrorIs(t, drain.Wait(ctx), context.DeadlineExceeded)
+
+	close(release)
+	require.NoError(t, drain.Wait(context.Background()))
+}
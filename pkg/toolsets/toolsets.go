@@ -3,6 +3,7 @@ package toolsets
 import (
 	"fmt"
 	"os"
+	"slices"
 	"strings"
 
 	"github.com/mark3labs/mcp-go/mcp"
@@ -162,6 +163,15 @@ func (t *Toolset) AddReadTools(tools ...server.ServerTool) *Toolset {
 	return t
 }
 
+// RemoveTools removes the named tools from the toolset. Names of tools it does not contain are ignored.
+func (t *Toolset) RemoveTools(names ...string) {
+	named := func(tool server.ServerTool) bool {
+		return slices.Contains(names, tool.Tool.Name)
+	}
+	t.readTools = slices.DeleteFunc(t.readTools, named)
+	t.writeTools = slices.DeleteFunc(t.writeTools, named)
+}
+
 type ToolsetGroup struct {
 	Toolsets     map[string]*Toolset
 	everythingOn bool
@@ -258,6 +268,14 @@ func (tg *ToolsetGroup) RegisterAll(s *server.MCPServer) {
 	}
 }
 
+// RemoveTools removes the named tools from every toolset in the group, so that they are never registered,
+// whichever toolsets or tools are enabled.
+func (tg *ToolsetGroup) RemoveTools(names ...string) {
+	for _, toolset := range tg.Toolsets {
+		toolset.RemoveTools(names...)
+	}
+}
+
 func (tg *ToolsetGroup) GetToolset(name string) (*Toolset, error) {
 	toolset, exists := tg.Toolsets[name]
 	if !exists {
@@ -1,8 +1,12 @@
 package toolsets
 
 import (
+	"context"
 	"errors"
 	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
 )
 
 func TestNewToolsetGroupIsEmptyWithoutEverythingOn(t *testing.T) {
@@ -262,3 +266,29 @@ func TestToolsetGroup_GetToolset(t *testing.T) {
 		t.Errorf("expected error to be ToolsetDoesNotExistError, got %v", err)
 	}
 }
+
+func TestToolsetGroup_RemoveTools(t *testing.T) {
+	readOnly, write := true, false
+	newTool := func(name string, readOnlyHint *bool) server.ServerTool {
+		return NewServerTool(
+			mcp.NewTool(name, mcp.WithToolAnnotation(mcp.ToolAnnotation{ReadOnlyHint: readOnlyHint})),
+			func(_ context.Context, _ mcp.CallToolRequest) (*mcp.CallToolResult, error) { return nil, nil },
+		)
+	}
+
+	tsg := NewToolsetGroup(false)
+	repos := NewToolset("repos", "desc").
+		AddReadTools(newTool("get_file_contents", &readOnly), newTool("list_branches", &readOnly)).
+		AddWriteTools(newTool("delete_file", &write))
+	tsg.AddToolset(repos)
+
+	tsg.RemoveTools("delete_file", "list_branches", "does_not_exist")
+
+	available := repos.GetAvailableTools()
+	if len(available) != 1 || available[0].Tool.Name != "get_file_contents" {
+		t.Errorf("expected only get_file_contents to remain, got %v", available)
+	}
+	if _, _, err := tsg.FindToolByName("delete_file"); err == nil {
+		t.Error("expected removed tool not to be found")
+	}
+}
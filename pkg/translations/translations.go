@@ -56,6 +56,21 @@ func TranslationHelper() (TranslationHelperFunc, func()) {
 		}
 }
 
+// WithOverrides returns a TranslationHelperFunc that returns the value of overrides for a key if there is one,
+// and otherwise defers to t. Keys are matched case-insensitively.
+func WithOverrides(t TranslationHelperFunc, overrides map[string]string) TranslationHelperFunc {
+	upper := make(map[string]string, len(overrides))
+	for key, value := range overrides {
+		upper[strings.ToUpper(key)] = value
+	}
+	return func(key string, defaultValue string) string {
+		if value, exists := upper[strings.ToUpper(key)]; exists {
+			return value
+		}
+		return t(key, defaultValue)
+	}
+}
+
 // DumpTranslationKeyMap writes the translation map to a json file called github-mcp-server-config.json
 func DumpTranslationKeyMap(translationKeyMap map[string]string) error {
 	file, err := os.Create("github-mcp-server-config.json")